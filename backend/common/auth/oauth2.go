@@ -8,6 +8,7 @@ import (
 	"github.com/MicahParks/keyfunc/v3"
 	jwt "github.com/golang-jwt/jwt/v5"
 	"github.com/hm-edu/portal-common/helper"
+	"github.com/hm-edu/portal-common/model"
 	"github.com/labstack/echo/v5"
 )
 
@@ -36,6 +37,17 @@ func UserFromRequest(c *echo.Context) (string, error) {
 	return "", errors.New("unable to extract user from request")
 }
 
+// StringsClaimFromRequest extracts the values of a string or string array
+// claim (e.g. the groups of the user) from the previously claimset.
+func StringsClaimFromRequest(c *echo.Context, name string) []string {
+	if token, ok := c.Get("user").(*jwt.Token); ok {
+		if claims, ok := token.Claims.(jwt.MapClaims); ok {
+			return model.StringsClaim(claims, name)
+		}
+	}
+	return nil
+}
+
 // GetToken extracts the JWT Token from header and also performs a check on the passed audience.
 func GetToken(auth string, keyfunc keyfunc.Keyfunc, aud string) (interface{}, error) {
 	// claims are of type `jwt.MapClaims` when token is created with `jwt.Parse`
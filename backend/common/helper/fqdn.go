@@ -0,0 +1,33 @@
+package helper
+
+import (
+	"net/netip"
+	"strings"
+)
+
+// NormalizeFqdn returns the canonical representation of a domain name or IP address as stored in the fqdn columns.
+// Domain names are lower-cased without trailing dot, IP addresses use their canonical textual form (IPv4-mapped
+// IPv6 addresses are unmapped, IPv6 addresses are compressed and lower-cased).
+func NormalizeFqdn(name string) string {
+	name = strings.TrimSpace(name)
+	if ip, ok := ParseIP(name); ok {
+		return ip.String()
+	}
+	return strings.ToLower(strings.TrimSuffix(name, "."))
+}
+
+// ParseIP parses the given name as IP address. Bracketed IPv6 addresses (e.g. [::1]) are accepted, zones are not.
+func ParseIP(name string) (netip.Addr, bool) {
+	name = strings.TrimSuffix(strings.TrimPrefix(name, "["), "]")
+	ip, err := netip.ParseAddr(name)
+	if err != nil || ip.Zone() != "" {
+		return netip.Addr{}, false
+	}
+	return ip.Unmap(), true
+}
+
+// IsIP reports whether the given name is an IP address.
+func IsIP(name string) bool {
+	_, ok := ParseIP(name)
+	return ok
+}
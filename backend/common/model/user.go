@@ -16,6 +16,31 @@ type User struct {
 	AdditionalSmimeEmails []string
 	CommonName            string `validate:"required"`
 	Student               bool
+	// Affiliations are the values of the eduPersonScopedAffiliation claim.
+	Affiliations []string
+	// Claims are all claims of the token, e.g. for evaluating policies.
+	Claims jwt.MapClaims
+}
+
+// StringsClaim returns the values of a claim that is either a single string
+// or an array of strings. Depending on the token parser, arrays are either
+// []string or []interface{}; non-string entries are skipped.
+func StringsClaim(claims jwt.MapClaims, name string) []string {
+	switch claim := claims[name].(type) {
+	case string:
+		return []string{claim}
+	case []string:
+		return claim
+	case []interface{}:
+		values := make([]string, 0, len(claim))
+		for _, v := range claim {
+			if value, ok := v.(string); ok {
+				values = append(values, value)
+			}
+		}
+		return values
+	}
+	return nil
 }
 
 // Bind binds an incoming echo request to the the User and perfoms a validation
@@ -23,6 +48,7 @@ func (r *User) Bind(c *echo.Context, v *Validator) error {
 	token := c.Get("user").(*jwt.Token)
 	claims := token.Claims.(jwt.MapClaims)
 
+	r.Claims = claims
 	r.FirstName = claims["given_name"].(string)
 	r.LastName = claims["family_name"].(string)
 	r.CommonName = claims["name"].(string)
@@ -34,20 +60,12 @@ func (r *User) Bind(c *echo.Context, v *Validator) error {
 			}
 		}
 	}
-	if claim, ok := claims["eduPersonScopedAffiliation"]; ok {
-		if affiliations, ok := claim.([]string); ok {
-			for _, affiliation := range affiliations {
-				if strings.Contains(affiliation, "student@") {
-					r.Student = true
-				}
-			}
-		} else if affiliation, ok := claim.(string); ok {
-			if strings.Contains(affiliation, "student@") {
-				r.Student = true
-			}
+	r.Affiliations = StringsClaim(claims, "eduPersonScopedAffiliation")
+	r.Student = false
+	for _, affiliation := range r.Affiliations {
+		if strings.Contains(affiliation, "student@") {
+			r.Student = true
 		}
-	} else {
-		r.Student = false
 	}
 	err := v.Validate(r)
 	return err
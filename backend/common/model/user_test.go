@@ -0,0 +1,52 @@
+package model
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func bindUser(t *testing.T, affiliation interface{}) *User {
+	t.Helper()
+	c := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), httptest.NewRecorder())
+	claims := jwt.MapClaims{
+		"given_name":  "Jane",
+		"family_name": "Doe",
+		"name":        "Jane Doe",
+		"email":       "jane.doe@hm.edu",
+	}
+	if affiliation != nil {
+		claims["eduPersonScopedAffiliation"] = affiliation
+	}
+	c.Set("user", &jwt.Token{Claims: claims})
+	user := &User{}
+	assert.NoError(t, user.Bind(c, NewValidator()))
+	return user
+}
+
+func TestBindAffiliations(t *testing.T) {
+	tc := []struct {
+		Name         string
+		Claim        interface{}
+		Affiliations []string
+		Student      bool
+	}{
+		{Name: "missing", Claim: nil, Affiliations: nil, Student: false},
+		{Name: "string", Claim: "student@hm.edu", Affiliations: []string{"student@hm.edu"}, Student: true},
+		{Name: "strings", Claim: []string{"member@hm.edu", "employee@hm.edu"}, Affiliations: []string{"member@hm.edu", "employee@hm.edu"}, Student: false},
+		// Arrays parsed from JSON are []interface{}.
+		{Name: "interfaces", Claim: []interface{}{"member@hm.edu", "student@hm.edu", 42}, Affiliations: []string{"member@hm.edu", "student@hm.edu"}, Student: true},
+	}
+	for _, c := range tc {
+		t.Run(c.Name, func(t *testing.T) {
+			user := bindUser(t, c.Claim)
+			assert.Equal(t, c.Affiliations, user.Affiliations)
+			assert.Equal(t, c.Student, user.Student)
+			assert.NotNil(t, user.Claims)
+		})
+	}
+}
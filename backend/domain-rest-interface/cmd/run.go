@@ -8,6 +8,7 @@ import (
 	"github.com/hm-edu/domain-rest-interface/pkg/database"
 	"github.com/hm-edu/domain-rest-interface/pkg/grpc"
 	"github.com/hm-edu/domain-rest-interface/pkg/store"
+	"github.com/hm-edu/domain-rest-interface/pkg/verification"
 	pb "github.com/hm-edu/portal-apis"
 	commonApi "github.com/hm-edu/portal-common/api"
 	commonInterceptor "github.com/hm-edu/portal-common/interceptor"
@@ -50,8 +51,10 @@ var runCmd = &cobra.Command{
 		database.ConnectDb(logger, viper.GetString("db"))
 
 		store := store.NewDomainStore(database.DB.Db)
+		store.ApprovalExpiry = viper.GetDuration("approval_expiry")
 		store.Preseed(logger)
 		stopCh := signals.SetupSignalHandler()
+		go store.RunExpiry(logger, stopCh)
 
 		adminsVar := viper.GetStringSlice("admins")
 		admins := []string{}
@@ -76,8 +79,22 @@ var runCmd = &cobra.Command{
 			logger.Fatal("Error connecting to ssl service", zap.Error(err))
 		}
 
+		// The notification service is provided by the pki-service as well.
+		notificationHost := viper.GetString("notification_service")
+		if notificationHost == "" {
+			notificationHost = viper.GetString("ssl_service")
+		}
+		notifications, err := notificationClient(notificationHost, grpcCfg.SentryDSN)
+		if err != nil {
+			logger.Fatal("Error connecting to notification service", zap.Error(err))
+		}
+
 		// start HTTP server
-		srv := api.NewServer(logger, &srvCfg, store, client, admins)
+		verifier := &verification.Verifier{
+			Resolver:    verification.NewResolver(viper.GetString("verification_resolver")),
+			AutoApprove: viper.GetBool("verification_auto_approve"),
+		}
+		srv := api.NewServer(logger, &srvCfg, store, client, notifications, verifier, admins)
 		srv.ListenAndServe(stopCh)
 	},
 }
@@ -94,6 +111,18 @@ func sslClient(host string, sentryDSN string) (pb.SSLServiceClient, error) {
 	return pb.NewSSLServiceClient(conn), nil
 }
 
+func notificationClient(host string, sentryDSN string) (pb.NotificationServiceClient, error) {
+	var interceptor []googleGrpc.UnaryClientInterceptor
+	if sentryDSN != "" {
+		interceptor = append(interceptor, commonInterceptor.UnaryClientInterceptor())
+	}
+	conn, err := commonApi.ConnectGRPC(host, googleGrpc.WithChainUnaryInterceptor(interceptor...))
+	if err != nil {
+		return nil, err
+	}
+	return pb.NewNotificationServiceClient(conn), nil
+}
+
 func init() {
 	rootCmd.AddCommand(runCmd)
 
@@ -106,7 +135,11 @@ func init() {
 	runCmd.Flags().String("db", "", "connection string for the database")
 	runCmd.Flags().String("sentry_dsn", "", "sentry dsn")
 	runCmd.Flags().String("ssl_service", "", "pki backend")
+	runCmd.Flags().String("notification_service", "", "The notification service delivering the domain events (defaults to ssl_service)")
 	runCmd.Flags().String("preseed", "", "path to the preseed file")
 	runCmd.Flags().String("level", "info", "log level debug, info, warn, error, flat or panic")
 	runCmd.Flags().StringSlice("admins", []string{}, "list of admin emails")
+	runCmd.Flags().String("verification_resolver", "", "The DNS server (host:port) used to look up the _pki-portal-verify TXT records (defaults to the system resolver)")
+	runCmd.Flags().Bool("verification_auto_approve", false, "Approve domains once their _pki-portal-verify TXT record was found instead of only marking them as verified")
+	runCmd.Flags().Duration("approval_expiry", store.DefaultApprovalExpiry, "The time after which pending domain approval requests expire")
 }
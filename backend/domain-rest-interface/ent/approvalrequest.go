@@ -0,0 +1,253 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/hm-edu/domain-rest-interface/ent/approvalrequest"
+	"github.com/hm-edu/domain-rest-interface/ent/domain"
+)
+
+// ApprovalRequest is the model entity for the ApprovalRequest schema.
+type ApprovalRequest struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// Fqdn holds the value of the "fqdn" field.
+	Fqdn string `json:"fqdn,omitempty"`
+	// Requester holds the value of the "requester" field.
+	Requester string `json:"requester,omitempty"`
+	// Justification holds the value of the "justification" field.
+	Justification string `json:"justification,omitempty"`
+	// Status holds the value of the "status" field.
+	Status approvalrequest.Status `json:"status,omitempty"`
+	// Reviewer holds the value of the "reviewer" field.
+	Reviewer *string `json:"reviewer,omitempty"`
+	// Comment holds the value of the "comment" field.
+	Comment *string `json:"comment,omitempty"`
+	// DecidedAt holds the value of the "decided_at" field.
+	DecidedAt *time.Time `json:"decided_at,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ApprovalRequestQuery when eager-loading is set.
+	Edges           ApprovalRequestEdges `json:"edges"`
+	domain_requests *int
+	selectValues    sql.SelectValues
+}
+
+// ApprovalRequestEdges holds the relations/edges for other nodes in the graph.
+type ApprovalRequestEdges struct {
+	// Domain holds the value of the domain edge.
+	Domain *Domain `json:"domain,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// DomainOrErr returns the Domain value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ApprovalRequestEdges) DomainOrErr() (*Domain, error) {
+	if e.Domain != nil {
+		return e.Domain, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: domain.Label}
+	}
+	return nil, &NotLoadedError{edge: "domain"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ApprovalRequest) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case approvalrequest.FieldID:
+			values[i] = new(sql.NullInt64)
+		case approvalrequest.FieldFqdn, approvalrequest.FieldRequester, approvalrequest.FieldJustification, approvalrequest.FieldStatus, approvalrequest.FieldReviewer, approvalrequest.FieldComment:
+			values[i] = new(sql.NullString)
+		case approvalrequest.FieldCreateTime, approvalrequest.FieldUpdateTime, approvalrequest.FieldDecidedAt, approvalrequest.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		case approvalrequest.ForeignKeys[0]: // domain_requests
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ApprovalRequest fields.
+func (_m *ApprovalRequest) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case approvalrequest.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case approvalrequest.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = value.Time
+			}
+		case approvalrequest.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = value.Time
+			}
+		case approvalrequest.FieldFqdn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field fqdn", values[i])
+			} else if value.Valid {
+				_m.Fqdn = value.String
+			}
+		case approvalrequest.FieldRequester:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field requester", values[i])
+			} else if value.Valid {
+				_m.Requester = value.String
+			}
+		case approvalrequest.FieldJustification:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field justification", values[i])
+			} else if value.Valid {
+				_m.Justification = value.String
+			}
+		case approvalrequest.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = approvalrequest.Status(value.String)
+			}
+		case approvalrequest.FieldReviewer:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewer", values[i])
+			} else if value.Valid {
+				_m.Reviewer = new(string)
+				*_m.Reviewer = value.String
+			}
+		case approvalrequest.FieldComment:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field comment", values[i])
+			} else if value.Valid {
+				_m.Comment = new(string)
+				*_m.Comment = value.String
+			}
+		case approvalrequest.FieldDecidedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field decided_at", values[i])
+			} else if value.Valid {
+				_m.DecidedAt = new(time.Time)
+				*_m.DecidedAt = value.Time
+			}
+		case approvalrequest.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = value.Time
+			}
+		case approvalrequest.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field domain_requests", value)
+			} else if value.Valid {
+				_m.domain_requests = new(int)
+				*_m.domain_requests = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ApprovalRequest.
+// This includes values selected through modifiers, order, etc.
+func (_m *ApprovalRequest) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryDomain queries the "domain" edge of the ApprovalRequest entity.
+func (_m *ApprovalRequest) QueryDomain() *DomainQuery {
+	return NewApprovalRequestClient(_m.config).QueryDomain(_m)
+}
+
+// Update returns a builder for updating this ApprovalRequest.
+// Note that you need to call ApprovalRequest.Unwrap() before calling this method if this ApprovalRequest
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ApprovalRequest) Update() *ApprovalRequestUpdateOne {
+	return NewApprovalRequestClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ApprovalRequest entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ApprovalRequest) Unwrap() *ApprovalRequest {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ApprovalRequest is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ApprovalRequest) String() string {
+	var builder strings.Builder
+	builder.WriteString("ApprovalRequest(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(_m.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("fqdn=")
+	builder.WriteString(_m.Fqdn)
+	builder.WriteString(", ")
+	builder.WriteString("requester=")
+	builder.WriteString(_m.Requester)
+	builder.WriteString(", ")
+	builder.WriteString("justification=")
+	builder.WriteString(_m.Justification)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	if v := _m.Reviewer; v != nil {
+		builder.WriteString("reviewer=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.Comment; v != nil {
+		builder.WriteString("comment=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.DecidedAt; v != nil {
+		builder.WriteString("decided_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(_m.ExpiresAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ApprovalRequests is a parsable slice of ApprovalRequest.
+type ApprovalRequests []*ApprovalRequest
@@ -0,0 +1,415 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hm-edu/domain-rest-interface/ent/approvalrequest"
+	"github.com/hm-edu/domain-rest-interface/ent/domain"
+)
+
+// ApprovalRequestCreate is the builder for creating a ApprovalRequest entity.
+type ApprovalRequestCreate struct {
+	config
+	mutation *ApprovalRequestMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *ApprovalRequestCreate) SetCreateTime(v time.Time) *ApprovalRequestCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *ApprovalRequestCreate) SetNillableCreateTime(v *time.Time) *ApprovalRequestCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *ApprovalRequestCreate) SetUpdateTime(v time.Time) *ApprovalRequestCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *ApprovalRequestCreate) SetNillableUpdateTime(v *time.Time) *ApprovalRequestCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetFqdn sets the "fqdn" field.
+func (_c *ApprovalRequestCreate) SetFqdn(v string) *ApprovalRequestCreate {
+	_c.mutation.SetFqdn(v)
+	return _c
+}
+
+// SetRequester sets the "requester" field.
+func (_c *ApprovalRequestCreate) SetRequester(v string) *ApprovalRequestCreate {
+	_c.mutation.SetRequester(v)
+	return _c
+}
+
+// SetJustification sets the "justification" field.
+func (_c *ApprovalRequestCreate) SetJustification(v string) *ApprovalRequestCreate {
+	_c.mutation.SetJustification(v)
+	return _c
+}
+
+// SetNillableJustification sets the "justification" field if the given value is not nil.
+func (_c *ApprovalRequestCreate) SetNillableJustification(v *string) *ApprovalRequestCreate {
+	if v != nil {
+		_c.SetJustification(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *ApprovalRequestCreate) SetStatus(v approvalrequest.Status) *ApprovalRequestCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *ApprovalRequestCreate) SetNillableStatus(v *approvalrequest.Status) *ApprovalRequestCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetReviewer sets the "reviewer" field.
+func (_c *ApprovalRequestCreate) SetReviewer(v string) *ApprovalRequestCreate {
+	_c.mutation.SetReviewer(v)
+	return _c
+}
+
+// SetNillableReviewer sets the "reviewer" field if the given value is not nil.
+func (_c *ApprovalRequestCreate) SetNillableReviewer(v *string) *ApprovalRequestCreate {
+	if v != nil {
+		_c.SetReviewer(*v)
+	}
+	return _c
+}
+
+// SetComment sets the "comment" field.
+func (_c *ApprovalRequestCreate) SetComment(v string) *ApprovalRequestCreate {
+	_c.mutation.SetComment(v)
+	return _c
+}
+
+// SetNillableComment sets the "comment" field if the given value is not nil.
+func (_c *ApprovalRequestCreate) SetNillableComment(v *string) *ApprovalRequestCreate {
+	if v != nil {
+		_c.SetComment(*v)
+	}
+	return _c
+}
+
+// SetDecidedAt sets the "decided_at" field.
+func (_c *ApprovalRequestCreate) SetDecidedAt(v time.Time) *ApprovalRequestCreate {
+	_c.mutation.SetDecidedAt(v)
+	return _c
+}
+
+// SetNillableDecidedAt sets the "decided_at" field if the given value is not nil.
+func (_c *ApprovalRequestCreate) SetNillableDecidedAt(v *time.Time) *ApprovalRequestCreate {
+	if v != nil {
+		_c.SetDecidedAt(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *ApprovalRequestCreate) SetExpiresAt(v time.Time) *ApprovalRequestCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetDomainID sets the "domain" edge to the Domain entity by ID.
+func (_c *ApprovalRequestCreate) SetDomainID(id int) *ApprovalRequestCreate {
+	_c.mutation.SetDomainID(id)
+	return _c
+}
+
+// SetNillableDomainID sets the "domain" edge to the Domain entity by ID if the given value is not nil.
+func (_c *ApprovalRequestCreate) SetNillableDomainID(id *int) *ApprovalRequestCreate {
+	if id != nil {
+		_c = _c.SetDomainID(*id)
+	}
+	return _c
+}
+
+// SetDomain sets the "domain" edge to the Domain entity.
+func (_c *ApprovalRequestCreate) SetDomain(v *Domain) *ApprovalRequestCreate {
+	return _c.SetDomainID(v.ID)
+}
+
+// Mutation returns the ApprovalRequestMutation object of the builder.
+func (_c *ApprovalRequestCreate) Mutation() *ApprovalRequestMutation {
+	return _c.mutation
+}
+
+// Save creates the ApprovalRequest in the database.
+func (_c *ApprovalRequestCreate) Save(ctx context.Context) (*ApprovalRequest, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ApprovalRequestCreate) SaveX(ctx context.Context) *ApprovalRequest {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ApprovalRequestCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ApprovalRequestCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ApprovalRequestCreate) defaults() {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		v := approvalrequest.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		v := approvalrequest.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := approvalrequest.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ApprovalRequestCreate) check() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "ApprovalRequest.create_time"`)}
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "ApprovalRequest.update_time"`)}
+	}
+	if _, ok := _c.mutation.Fqdn(); !ok {
+		return &ValidationError{Name: "fqdn", err: errors.New(`ent: missing required field "ApprovalRequest.fqdn"`)}
+	}
+	if v, ok := _c.mutation.Fqdn(); ok {
+		if err := approvalrequest.FqdnValidator(v); err != nil {
+			return &ValidationError{Name: "fqdn", err: fmt.Errorf(`ent: validator failed for field "ApprovalRequest.fqdn": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Requester(); !ok {
+		return &ValidationError{Name: "requester", err: errors.New(`ent: missing required field "ApprovalRequest.requester"`)}
+	}
+	if v, ok := _c.mutation.Requester(); ok {
+		if err := approvalrequest.RequesterValidator(v); err != nil {
+			return &ValidationError{Name: "requester", err: fmt.Errorf(`ent: validator failed for field "ApprovalRequest.requester": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ApprovalRequest.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := approvalrequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ApprovalRequest.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "ApprovalRequest.expires_at"`)}
+	}
+	return nil
+}
+
+func (_c *ApprovalRequestCreate) sqlSave(ctx context.Context) (*ApprovalRequest, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ApprovalRequestCreate) createSpec() (*ApprovalRequest, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ApprovalRequest{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(approvalrequest.Table, sqlgraph.NewFieldSpec(approvalrequest.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(approvalrequest.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(approvalrequest.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.Fqdn(); ok {
+		_spec.SetField(approvalrequest.FieldFqdn, field.TypeString, value)
+		_node.Fqdn = value
+	}
+	if value, ok := _c.mutation.Requester(); ok {
+		_spec.SetField(approvalrequest.FieldRequester, field.TypeString, value)
+		_node.Requester = value
+	}
+	if value, ok := _c.mutation.Justification(); ok {
+		_spec.SetField(approvalrequest.FieldJustification, field.TypeString, value)
+		_node.Justification = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(approvalrequest.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Reviewer(); ok {
+		_spec.SetField(approvalrequest.FieldReviewer, field.TypeString, value)
+		_node.Reviewer = &value
+	}
+	if value, ok := _c.mutation.Comment(); ok {
+		_spec.SetField(approvalrequest.FieldComment, field.TypeString, value)
+		_node.Comment = &value
+	}
+	if value, ok := _c.mutation.DecidedAt(); ok {
+		_spec.SetField(approvalrequest.FieldDecidedAt, field.TypeTime, value)
+		_node.DecidedAt = &value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(approvalrequest.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if nodes := _c.mutation.DomainIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   approvalrequest.DomainTable,
+			Columns: []string{approvalrequest.DomainColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(domain.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.domain_requests = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ApprovalRequestCreateBulk is the builder for creating many ApprovalRequest entities in bulk.
+type ApprovalRequestCreateBulk struct {
+	config
+	err      error
+	builders []*ApprovalRequestCreate
+}
+
+// Save creates the ApprovalRequest entities in the database.
+func (_c *ApprovalRequestCreateBulk) Save(ctx context.Context) ([]*ApprovalRequest, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ApprovalRequest, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ApprovalRequestMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ApprovalRequestCreateBulk) SaveX(ctx context.Context) []*ApprovalRequest {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ApprovalRequestCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ApprovalRequestCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,711 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hm-edu/domain-rest-interface/ent/approvalrequest"
+	"github.com/hm-edu/domain-rest-interface/ent/domain"
+	"github.com/hm-edu/domain-rest-interface/ent/predicate"
+)
+
+// ApprovalRequestUpdate is the builder for updating ApprovalRequest entities.
+type ApprovalRequestUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ApprovalRequestMutation
+}
+
+// Where appends a list predicates to the ApprovalRequestUpdate builder.
+func (_u *ApprovalRequestUpdate) Where(ps ...predicate.ApprovalRequest) *ApprovalRequestUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ApprovalRequestUpdate) SetUpdateTime(v time.Time) *ApprovalRequestUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetFqdn sets the "fqdn" field.
+func (_u *ApprovalRequestUpdate) SetFqdn(v string) *ApprovalRequestUpdate {
+	_u.mutation.SetFqdn(v)
+	return _u
+}
+
+// SetNillableFqdn sets the "fqdn" field if the given value is not nil.
+func (_u *ApprovalRequestUpdate) SetNillableFqdn(v *string) *ApprovalRequestUpdate {
+	if v != nil {
+		_u.SetFqdn(*v)
+	}
+	return _u
+}
+
+// SetRequester sets the "requester" field.
+func (_u *ApprovalRequestUpdate) SetRequester(v string) *ApprovalRequestUpdate {
+	_u.mutation.SetRequester(v)
+	return _u
+}
+
+// SetNillableRequester sets the "requester" field if the given value is not nil.
+func (_u *ApprovalRequestUpdate) SetNillableRequester(v *string) *ApprovalRequestUpdate {
+	if v != nil {
+		_u.SetRequester(*v)
+	}
+	return _u
+}
+
+// SetJustification sets the "justification" field.
+func (_u *ApprovalRequestUpdate) SetJustification(v string) *ApprovalRequestUpdate {
+	_u.mutation.SetJustification(v)
+	return _u
+}
+
+// SetNillableJustification sets the "justification" field if the given value is not nil.
+func (_u *ApprovalRequestUpdate) SetNillableJustification(v *string) *ApprovalRequestUpdate {
+	if v != nil {
+		_u.SetJustification(*v)
+	}
+	return _u
+}
+
+// ClearJustification clears the value of the "justification" field.
+func (_u *ApprovalRequestUpdate) ClearJustification() *ApprovalRequestUpdate {
+	_u.mutation.ClearJustification()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ApprovalRequestUpdate) SetStatus(v approvalrequest.Status) *ApprovalRequestUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ApprovalRequestUpdate) SetNillableStatus(v *approvalrequest.Status) *ApprovalRequestUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetReviewer sets the "reviewer" field.
+func (_u *ApprovalRequestUpdate) SetReviewer(v string) *ApprovalRequestUpdate {
+	_u.mutation.SetReviewer(v)
+	return _u
+}
+
+// SetNillableReviewer sets the "reviewer" field if the given value is not nil.
+func (_u *ApprovalRequestUpdate) SetNillableReviewer(v *string) *ApprovalRequestUpdate {
+	if v != nil {
+		_u.SetReviewer(*v)
+	}
+	return _u
+}
+
+// ClearReviewer clears the value of the "reviewer" field.
+func (_u *ApprovalRequestUpdate) ClearReviewer() *ApprovalRequestUpdate {
+	_u.mutation.ClearReviewer()
+	return _u
+}
+
+// SetComment sets the "comment" field.
+func (_u *ApprovalRequestUpdate) SetComment(v string) *ApprovalRequestUpdate {
+	_u.mutation.SetComment(v)
+	return _u
+}
+
+// SetNillableComment sets the "comment" field if the given value is not nil.
+func (_u *ApprovalRequestUpdate) SetNillableComment(v *string) *ApprovalRequestUpdate {
+	if v != nil {
+		_u.SetComment(*v)
+	}
+	return _u
+}
+
+// ClearComment clears the value of the "comment" field.
+func (_u *ApprovalRequestUpdate) ClearComment() *ApprovalRequestUpdate {
+	_u.mutation.ClearComment()
+	return _u
+}
+
+// SetDecidedAt sets the "decided_at" field.
+func (_u *ApprovalRequestUpdate) SetDecidedAt(v time.Time) *ApprovalRequestUpdate {
+	_u.mutation.SetDecidedAt(v)
+	return _u
+}
+
+// SetNillableDecidedAt sets the "decided_at" field if the given value is not nil.
+func (_u *ApprovalRequestUpdate) SetNillableDecidedAt(v *time.Time) *ApprovalRequestUpdate {
+	if v != nil {
+		_u.SetDecidedAt(*v)
+	}
+	return _u
+}
+
+// ClearDecidedAt clears the value of the "decided_at" field.
+func (_u *ApprovalRequestUpdate) ClearDecidedAt() *ApprovalRequestUpdate {
+	_u.mutation.ClearDecidedAt()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *ApprovalRequestUpdate) SetExpiresAt(v time.Time) *ApprovalRequestUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *ApprovalRequestUpdate) SetNillableExpiresAt(v *time.Time) *ApprovalRequestUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetDomainID sets the "domain" edge to the Domain entity by ID.
+func (_u *ApprovalRequestUpdate) SetDomainID(id int) *ApprovalRequestUpdate {
+	_u.mutation.SetDomainID(id)
+	return _u
+}
+
+// SetNillableDomainID sets the "domain" edge to the Domain entity by ID if the given value is not nil.
+func (_u *ApprovalRequestUpdate) SetNillableDomainID(id *int) *ApprovalRequestUpdate {
+	if id != nil {
+		_u = _u.SetDomainID(*id)
+	}
+	return _u
+}
+
+// SetDomain sets the "domain" edge to the Domain entity.
+func (_u *ApprovalRequestUpdate) SetDomain(v *Domain) *ApprovalRequestUpdate {
+	return _u.SetDomainID(v.ID)
+}
+
+// Mutation returns the ApprovalRequestMutation object of the builder.
+func (_u *ApprovalRequestUpdate) Mutation() *ApprovalRequestMutation {
+	return _u.mutation
+}
+
+// ClearDomain clears the "domain" edge to the Domain entity.
+func (_u *ApprovalRequestUpdate) ClearDomain() *ApprovalRequestUpdate {
+	_u.mutation.ClearDomain()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ApprovalRequestUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ApprovalRequestUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ApprovalRequestUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ApprovalRequestUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ApprovalRequestUpdate) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := approvalrequest.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ApprovalRequestUpdate) check() error {
+	if v, ok := _u.mutation.Fqdn(); ok {
+		if err := approvalrequest.FqdnValidator(v); err != nil {
+			return &ValidationError{Name: "fqdn", err: fmt.Errorf(`ent: validator failed for field "ApprovalRequest.fqdn": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Requester(); ok {
+		if err := approvalrequest.RequesterValidator(v); err != nil {
+			return &ValidationError{Name: "requester", err: fmt.Errorf(`ent: validator failed for field "ApprovalRequest.requester": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := approvalrequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ApprovalRequest.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ApprovalRequestUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(approvalrequest.Table, approvalrequest.Columns, sqlgraph.NewFieldSpec(approvalrequest.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(approvalrequest.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Fqdn(); ok {
+		_spec.SetField(approvalrequest.FieldFqdn, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Requester(); ok {
+		_spec.SetField(approvalrequest.FieldRequester, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Justification(); ok {
+		_spec.SetField(approvalrequest.FieldJustification, field.TypeString, value)
+	}
+	if _u.mutation.JustificationCleared() {
+		_spec.ClearField(approvalrequest.FieldJustification, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(approvalrequest.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Reviewer(); ok {
+		_spec.SetField(approvalrequest.FieldReviewer, field.TypeString, value)
+	}
+	if _u.mutation.ReviewerCleared() {
+		_spec.ClearField(approvalrequest.FieldReviewer, field.TypeString)
+	}
+	if value, ok := _u.mutation.Comment(); ok {
+		_spec.SetField(approvalrequest.FieldComment, field.TypeString, value)
+	}
+	if _u.mutation.CommentCleared() {
+		_spec.ClearField(approvalrequest.FieldComment, field.TypeString)
+	}
+	if value, ok := _u.mutation.DecidedAt(); ok {
+		_spec.SetField(approvalrequest.FieldDecidedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DecidedAtCleared() {
+		_spec.ClearField(approvalrequest.FieldDecidedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(approvalrequest.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.DomainCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   approvalrequest.DomainTable,
+			Columns: []string{approvalrequest.DomainColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(domain.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DomainIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   approvalrequest.DomainTable,
+			Columns: []string{approvalrequest.DomainColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(domain.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{approvalrequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ApprovalRequestUpdateOne is the builder for updating a single ApprovalRequest entity.
+type ApprovalRequestUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ApprovalRequestMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ApprovalRequestUpdateOne) SetUpdateTime(v time.Time) *ApprovalRequestUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetFqdn sets the "fqdn" field.
+func (_u *ApprovalRequestUpdateOne) SetFqdn(v string) *ApprovalRequestUpdateOne {
+	_u.mutation.SetFqdn(v)
+	return _u
+}
+
+// SetNillableFqdn sets the "fqdn" field if the given value is not nil.
+func (_u *ApprovalRequestUpdateOne) SetNillableFqdn(v *string) *ApprovalRequestUpdateOne {
+	if v != nil {
+		_u.SetFqdn(*v)
+	}
+	return _u
+}
+
+// SetRequester sets the "requester" field.
+func (_u *ApprovalRequestUpdateOne) SetRequester(v string) *ApprovalRequestUpdateOne {
+	_u.mutation.SetRequester(v)
+	return _u
+}
+
+// SetNillableRequester sets the "requester" field if the given value is not nil.
+func (_u *ApprovalRequestUpdateOne) SetNillableRequester(v *string) *ApprovalRequestUpdateOne {
+	if v != nil {
+		_u.SetRequester(*v)
+	}
+	return _u
+}
+
+// SetJustification sets the "justification" field.
+func (_u *ApprovalRequestUpdateOne) SetJustification(v string) *ApprovalRequestUpdateOne {
+	_u.mutation.SetJustification(v)
+	return _u
+}
+
+// SetNillableJustification sets the "justification" field if the given value is not nil.
+func (_u *ApprovalRequestUpdateOne) SetNillableJustification(v *string) *ApprovalRequestUpdateOne {
+	if v != nil {
+		_u.SetJustification(*v)
+	}
+	return _u
+}
+
+// ClearJustification clears the value of the "justification" field.
+func (_u *ApprovalRequestUpdateOne) ClearJustification() *ApprovalRequestUpdateOne {
+	_u.mutation.ClearJustification()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ApprovalRequestUpdateOne) SetStatus(v approvalrequest.Status) *ApprovalRequestUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ApprovalRequestUpdateOne) SetNillableStatus(v *approvalrequest.Status) *ApprovalRequestUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetReviewer sets the "reviewer" field.
+func (_u *ApprovalRequestUpdateOne) SetReviewer(v string) *ApprovalRequestUpdateOne {
+	_u.mutation.SetReviewer(v)
+	return _u
+}
+
+// SetNillableReviewer sets the "reviewer" field if the given value is not nil.
+func (_u *ApprovalRequestUpdateOne) SetNillableReviewer(v *string) *ApprovalRequestUpdateOne {
+	if v != nil {
+		_u.SetReviewer(*v)
+	}
+	return _u
+}
+
+// ClearReviewer clears the value of the "reviewer" field.
+func (_u *ApprovalRequestUpdateOne) ClearReviewer() *ApprovalRequestUpdateOne {
+	_u.mutation.ClearReviewer()
+	return _u
+}
+
+// SetComment sets the "comment" field.
+func (_u *ApprovalRequestUpdateOne) SetComment(v string) *ApprovalRequestUpdateOne {
+	_u.mutation.SetComment(v)
+	return _u
+}
+
+// SetNillableComment sets the "comment" field if the given value is not nil.
+func (_u *ApprovalRequestUpdateOne) SetNillableComment(v *string) *ApprovalRequestUpdateOne {
+	if v != nil {
+		_u.SetComment(*v)
+	}
+	return _u
+}
+
+// ClearComment clears the value of the "comment" field.
+func (_u *ApprovalRequestUpdateOne) ClearComment() *ApprovalRequestUpdateOne {
+	_u.mutation.ClearComment()
+	return _u
+}
+
+// SetDecidedAt sets the "decided_at" field.
+func (_u *ApprovalRequestUpdateOne) SetDecidedAt(v time.Time) *ApprovalRequestUpdateOne {
+	_u.mutation.SetDecidedAt(v)
+	return _u
+}
+
+// SetNillableDecidedAt sets the "decided_at" field if the given value is not nil.
+func (_u *ApprovalRequestUpdateOne) SetNillableDecidedAt(v *time.Time) *ApprovalRequestUpdateOne {
+	if v != nil {
+		_u.SetDecidedAt(*v)
+	}
+	return _u
+}
+
+// ClearDecidedAt clears the value of the "decided_at" field.
+func (_u *ApprovalRequestUpdateOne) ClearDecidedAt() *ApprovalRequestUpdateOne {
+	_u.mutation.ClearDecidedAt()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *ApprovalRequestUpdateOne) SetExpiresAt(v time.Time) *ApprovalRequestUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *ApprovalRequestUpdateOne) SetNillableExpiresAt(v *time.Time) *ApprovalRequestUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetDomainID sets the "domain" edge to the Domain entity by ID.
+func (_u *ApprovalRequestUpdateOne) SetDomainID(id int) *ApprovalRequestUpdateOne {
+	_u.mutation.SetDomainID(id)
+	return _u
+}
+
+// SetNillableDomainID sets the "domain" edge to the Domain entity by ID if the given value is not nil.
+func (_u *ApprovalRequestUpdateOne) SetNillableDomainID(id *int) *ApprovalRequestUpdateOne {
+	if id != nil {
+		_u = _u.SetDomainID(*id)
+	}
+	return _u
+}
+
+// SetDomain sets the "domain" edge to the Domain entity.
+func (_u *ApprovalRequestUpdateOne) SetDomain(v *Domain) *ApprovalRequestUpdateOne {
+	return _u.SetDomainID(v.ID)
+}
+
+// Mutation returns the ApprovalRequestMutation object of the builder.
+func (_u *ApprovalRequestUpdateOne) Mutation() *ApprovalRequestMutation {
+	return _u.mutation
+}
+
+// ClearDomain clears the "domain" edge to the Domain entity.
+func (_u *ApprovalRequestUpdateOne) ClearDomain() *ApprovalRequestUpdateOne {
+	_u.mutation.ClearDomain()
+	return _u
+}
+
+// Where appends a list predicates to the ApprovalRequestUpdate builder.
+func (_u *ApprovalRequestUpdateOne) Where(ps ...predicate.ApprovalRequest) *ApprovalRequestUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ApprovalRequestUpdateOne) Select(field string, fields ...string) *ApprovalRequestUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ApprovalRequest entity.
+func (_u *ApprovalRequestUpdateOne) Save(ctx context.Context) (*ApprovalRequest, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ApprovalRequestUpdateOne) SaveX(ctx context.Context) *ApprovalRequest {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ApprovalRequestUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ApprovalRequestUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ApprovalRequestUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := approvalrequest.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ApprovalRequestUpdateOne) check() error {
+	if v, ok := _u.mutation.Fqdn(); ok {
+		if err := approvalrequest.FqdnValidator(v); err != nil {
+			return &ValidationError{Name: "fqdn", err: fmt.Errorf(`ent: validator failed for field "ApprovalRequest.fqdn": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Requester(); ok {
+		if err := approvalrequest.RequesterValidator(v); err != nil {
+			return &ValidationError{Name: "requester", err: fmt.Errorf(`ent: validator failed for field "ApprovalRequest.requester": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := approvalrequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ApprovalRequest.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ApprovalRequestUpdateOne) sqlSave(ctx context.Context) (_node *ApprovalRequest, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(approvalrequest.Table, approvalrequest.Columns, sqlgraph.NewFieldSpec(approvalrequest.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ApprovalRequest.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, approvalrequest.FieldID)
+		for _, f := range fields {
+			if !approvalrequest.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != approvalrequest.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(approvalrequest.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Fqdn(); ok {
+		_spec.SetField(approvalrequest.FieldFqdn, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Requester(); ok {
+		_spec.SetField(approvalrequest.FieldRequester, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Justification(); ok {
+		_spec.SetField(approvalrequest.FieldJustification, field.TypeString, value)
+	}
+	if _u.mutation.JustificationCleared() {
+		_spec.ClearField(approvalrequest.FieldJustification, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(approvalrequest.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Reviewer(); ok {
+		_spec.SetField(approvalrequest.FieldReviewer, field.TypeString, value)
+	}
+	if _u.mutation.ReviewerCleared() {
+		_spec.ClearField(approvalrequest.FieldReviewer, field.TypeString)
+	}
+	if value, ok := _u.mutation.Comment(); ok {
+		_spec.SetField(approvalrequest.FieldComment, field.TypeString, value)
+	}
+	if _u.mutation.CommentCleared() {
+		_spec.ClearField(approvalrequest.FieldComment, field.TypeString)
+	}
+	if value, ok := _u.mutation.DecidedAt(); ok {
+		_spec.SetField(approvalrequest.FieldDecidedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DecidedAtCleared() {
+		_spec.ClearField(approvalrequest.FieldDecidedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(approvalrequest.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.DomainCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   approvalrequest.DomainTable,
+			Columns: []string{approvalrequest.DomainColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(domain.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DomainIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   approvalrequest.DomainTable,
+			Columns: []string{approvalrequest.DomainColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(domain.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ApprovalRequest{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{approvalrequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -73,7 +73,7 @@ func (*Delegation) scanValues(columns []string) ([]any, error) {
 
 // assignValues assigns the values that were returned from sql.Rows (after scanning)
 // to the Delegation fields.
-func (d *Delegation) assignValues(columns []string, values []any) error {
+func (_m *Delegation) assignValues(columns []string, values []any) error {
 	if m, n := len(values), len(columns); m < n {
 		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
 	}
@@ -84,34 +84,34 @@ func (d *Delegation) assignValues(columns []string, values []any) error {
 			if !ok {
 				return fmt.Errorf("unexpected type %T for field id", value)
 			}
-			d.ID = int(value.Int64)
+			_m.ID = int(value.Int64)
 		case delegation.FieldCreateTime:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field create_time", values[i])
 			} else if value.Valid {
-				d.CreateTime = value.Time
+				_m.CreateTime = value.Time
 			}
 		case delegation.FieldUpdateTime:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field update_time", values[i])
 			} else if value.Valid {
-				d.UpdateTime = value.Time
+				_m.UpdateTime = value.Time
 			}
 		case delegation.FieldUser:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field user", values[i])
 			} else if value.Valid {
-				d.User = value.String
+				_m.User = value.String
 			}
 		case delegation.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field domain_delegations", value)
 			} else if value.Valid {
-				d.domain_delegations = new(int)
-				*d.domain_delegations = int(value.Int64)
+				_m.domain_delegations = new(int)
+				*_m.domain_delegations = int(value.Int64)
 			}
 		default:
-			d.selectValues.Set(columns[i], values[i])
+			_m.selectValues.Set(columns[i], values[i])
 		}
 	}
 	return nil
@@ -119,46 +119,46 @@ func (d *Delegation) assignValues(columns []string, values []any) error {
 
 // Value returns the ent.Value that was dynamically selected and assigned to the Delegation.
 // This includes values selected through modifiers, order, etc.
-func (d *Delegation) Value(name string) (ent.Value, error) {
-	return d.selectValues.Get(name)
+func (_m *Delegation) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
 }
 
 // QueryDomain queries the "domain" edge of the Delegation entity.
-func (d *Delegation) QueryDomain() *DomainQuery {
-	return NewDelegationClient(d.config).QueryDomain(d)
+func (_m *Delegation) QueryDomain() *DomainQuery {
+	return NewDelegationClient(_m.config).QueryDomain(_m)
 }
 
 // Update returns a builder for updating this Delegation.
 // Note that you need to call Delegation.Unwrap() before calling this method if this Delegation
 // was returned from a transaction, and the transaction was committed or rolled back.
-func (d *Delegation) Update() *DelegationUpdateOne {
-	return NewDelegationClient(d.config).UpdateOne(d)
+func (_m *Delegation) Update() *DelegationUpdateOne {
+	return NewDelegationClient(_m.config).UpdateOne(_m)
 }
 
 // Unwrap unwraps the Delegation entity that was returned from a transaction after it was closed,
 // so that all future queries will be executed through the driver which created the transaction.
-func (d *Delegation) Unwrap() *Delegation {
-	_tx, ok := d.config.driver.(*txDriver)
+func (_m *Delegation) Unwrap() *Delegation {
+	_tx, ok := _m.config.driver.(*txDriver)
 	if !ok {
 		panic("ent: Delegation is not a transactional entity")
 	}
-	d.config.driver = _tx.drv
-	return d
+	_m.config.driver = _tx.drv
+	return _m
 }
 
 // String implements the fmt.Stringer.
-func (d *Delegation) String() string {
+func (_m *Delegation) String() string {
 	var builder strings.Builder
 	builder.WriteString("Delegation(")
-	builder.WriteString(fmt.Sprintf("id=%v, ", d.ID))
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
 	builder.WriteString("create_time=")
-	builder.WriteString(d.CreateTime.Format(time.ANSIC))
+	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
 	builder.WriteString(", ")
 	builder.WriteString("update_time=")
-	builder.WriteString(d.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(_m.UpdateTime.Format(time.ANSIC))
 	builder.WriteString(", ")
 	builder.WriteString("user=")
-	builder.WriteString(d.User)
+	builder.WriteString(_m.User)
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -22,64 +22,64 @@ type DelegationCreate struct {
 }
 
 // SetCreateTime sets the "create_time" field.
-func (dc *DelegationCreate) SetCreateTime(t time.Time) *DelegationCreate {
-	dc.mutation.SetCreateTime(t)
-	return dc
+func (_c *DelegationCreate) SetCreateTime(v time.Time) *DelegationCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
 }
 
 // SetNillableCreateTime sets the "create_time" field if the given value is not nil.
-func (dc *DelegationCreate) SetNillableCreateTime(t *time.Time) *DelegationCreate {
-	if t != nil {
-		dc.SetCreateTime(*t)
+func (_c *DelegationCreate) SetNillableCreateTime(v *time.Time) *DelegationCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
 	}
-	return dc
+	return _c
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (dc *DelegationCreate) SetUpdateTime(t time.Time) *DelegationCreate {
-	dc.mutation.SetUpdateTime(t)
-	return dc
+func (_c *DelegationCreate) SetUpdateTime(v time.Time) *DelegationCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
 }
 
 // SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
-func (dc *DelegationCreate) SetNillableUpdateTime(t *time.Time) *DelegationCreate {
-	if t != nil {
-		dc.SetUpdateTime(*t)
+func (_c *DelegationCreate) SetNillableUpdateTime(v *time.Time) *DelegationCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
 	}
-	return dc
+	return _c
 }
 
 // SetUser sets the "user" field.
-func (dc *DelegationCreate) SetUser(s string) *DelegationCreate {
-	dc.mutation.SetUser(s)
-	return dc
+func (_c *DelegationCreate) SetUser(v string) *DelegationCreate {
+	_c.mutation.SetUser(v)
+	return _c
 }
 
 // SetDomainID sets the "domain" edge to the Domain entity by ID.
-func (dc *DelegationCreate) SetDomainID(id int) *DelegationCreate {
-	dc.mutation.SetDomainID(id)
-	return dc
+func (_c *DelegationCreate) SetDomainID(id int) *DelegationCreate {
+	_c.mutation.SetDomainID(id)
+	return _c
 }
 
 // SetDomain sets the "domain" edge to the Domain entity.
-func (dc *DelegationCreate) SetDomain(d *Domain) *DelegationCreate {
-	return dc.SetDomainID(d.ID)
+func (_c *DelegationCreate) SetDomain(v *Domain) *DelegationCreate {
+	return _c.SetDomainID(v.ID)
 }
 
 // Mutation returns the DelegationMutation object of the builder.
-func (dc *DelegationCreate) Mutation() *DelegationMutation {
-	return dc.mutation
+func (_c *DelegationCreate) Mutation() *DelegationMutation {
+	return _c.mutation
 }
 
 // Save creates the Delegation in the database.
-func (dc *DelegationCreate) Save(ctx context.Context) (*Delegation, error) {
-	dc.defaults()
-	return withHooks(ctx, dc.sqlSave, dc.mutation, dc.hooks)
+func (_c *DelegationCreate) Save(ctx context.Context) (*Delegation, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
 // SaveX calls Save and panics if Save returns an error.
-func (dc *DelegationCreate) SaveX(ctx context.Context) *Delegation {
-	v, err := dc.Save(ctx)
+func (_c *DelegationCreate) SaveX(ctx context.Context) *Delegation {
+	v, err := _c.Save(ctx)
 	if err != nil {
 		panic(err)
 	}
@@ -87,58 +87,58 @@ func (dc *DelegationCreate) SaveX(ctx context.Context) *Delegation {
 }
 
 // Exec executes the query.
-func (dc *DelegationCreate) Exec(ctx context.Context) error {
-	_, err := dc.Save(ctx)
+func (_c *DelegationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (dc *DelegationCreate) ExecX(ctx context.Context) {
-	if err := dc.Exec(ctx); err != nil {
+func (_c *DelegationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
 
 // defaults sets the default values of the builder before save.
-func (dc *DelegationCreate) defaults() {
-	if _, ok := dc.mutation.CreateTime(); !ok {
+func (_c *DelegationCreate) defaults() {
+	if _, ok := _c.mutation.CreateTime(); !ok {
 		v := delegation.DefaultCreateTime()
-		dc.mutation.SetCreateTime(v)
+		_c.mutation.SetCreateTime(v)
 	}
-	if _, ok := dc.mutation.UpdateTime(); !ok {
+	if _, ok := _c.mutation.UpdateTime(); !ok {
 		v := delegation.DefaultUpdateTime()
-		dc.mutation.SetUpdateTime(v)
+		_c.mutation.SetUpdateTime(v)
 	}
 }
 
 // check runs all checks and user-defined validators on the builder.
-func (dc *DelegationCreate) check() error {
-	if _, ok := dc.mutation.CreateTime(); !ok {
+func (_c *DelegationCreate) check() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
 		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "Delegation.create_time"`)}
 	}
-	if _, ok := dc.mutation.UpdateTime(); !ok {
+	if _, ok := _c.mutation.UpdateTime(); !ok {
 		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "Delegation.update_time"`)}
 	}
-	if _, ok := dc.mutation.User(); !ok {
+	if _, ok := _c.mutation.User(); !ok {
 		return &ValidationError{Name: "user", err: errors.New(`ent: missing required field "Delegation.user"`)}
 	}
-	if v, ok := dc.mutation.User(); ok {
+	if v, ok := _c.mutation.User(); ok {
 		if err := delegation.UserValidator(v); err != nil {
 			return &ValidationError{Name: "user", err: fmt.Errorf(`ent: validator failed for field "Delegation.user": %w`, err)}
 		}
 	}
-	if len(dc.mutation.DomainIDs()) == 0 {
+	if len(_c.mutation.DomainIDs()) == 0 {
 		return &ValidationError{Name: "domain", err: errors.New(`ent: missing required edge "Delegation.domain"`)}
 	}
 	return nil
 }
 
-func (dc *DelegationCreate) sqlSave(ctx context.Context) (*Delegation, error) {
-	if err := dc.check(); err != nil {
+func (_c *DelegationCreate) sqlSave(ctx context.Context) (*Delegation, error) {
+	if err := _c.check(); err != nil {
 		return nil, err
 	}
-	_node, _spec := dc.createSpec()
-	if err := sqlgraph.CreateNode(ctx, dc.driver, _spec); err != nil {
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -146,29 +146,29 @@ func (dc *DelegationCreate) sqlSave(ctx context.Context) (*Delegation, error) {
 	}
 	id := _spec.ID.Value.(int64)
 	_node.ID = int(id)
-	dc.mutation.id = &_node.ID
-	dc.mutation.done = true
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
 	return _node, nil
 }
 
-func (dc *DelegationCreate) createSpec() (*Delegation, *sqlgraph.CreateSpec) {
+func (_c *DelegationCreate) createSpec() (*Delegation, *sqlgraph.CreateSpec) {
 	var (
-		_node = &Delegation{config: dc.config}
+		_node = &Delegation{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(delegation.Table, sqlgraph.NewFieldSpec(delegation.FieldID, field.TypeInt))
 	)
-	if value, ok := dc.mutation.CreateTime(); ok {
+	if value, ok := _c.mutation.CreateTime(); ok {
 		_spec.SetField(delegation.FieldCreateTime, field.TypeTime, value)
 		_node.CreateTime = value
 	}
-	if value, ok := dc.mutation.UpdateTime(); ok {
+	if value, ok := _c.mutation.UpdateTime(); ok {
 		_spec.SetField(delegation.FieldUpdateTime, field.TypeTime, value)
 		_node.UpdateTime = value
 	}
-	if value, ok := dc.mutation.User(); ok {
+	if value, ok := _c.mutation.User(); ok {
 		_spec.SetField(delegation.FieldUser, field.TypeString, value)
 		_node.User = value
 	}
-	if nodes := dc.mutation.DomainIDs(); len(nodes) > 0 {
+	if nodes := _c.mutation.DomainIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
 			Inverse: true,
@@ -196,16 +196,16 @@ type DelegationCreateBulk struct {
 }
 
 // Save creates the Delegation entities in the database.
-func (dcb *DelegationCreateBulk) Save(ctx context.Context) ([]*Delegation, error) {
-	if dcb.err != nil {
-		return nil, dcb.err
+func (_c *DelegationCreateBulk) Save(ctx context.Context) ([]*Delegation, error) {
+	if _c.err != nil {
+		return nil, _c.err
 	}
-	specs := make([]*sqlgraph.CreateSpec, len(dcb.builders))
-	nodes := make([]*Delegation, len(dcb.builders))
-	mutators := make([]Mutator, len(dcb.builders))
-	for i := range dcb.builders {
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Delegation, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
 		func(i int, root context.Context) {
-			builder := dcb.builders[i]
+			builder := _c.builders[i]
 			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*DelegationMutation)
@@ -219,11 +219,11 @@ func (dcb *DelegationCreateBulk) Save(ctx context.Context) ([]*Delegation, error
 				var err error
 				nodes[i], specs[i] = builder.createSpec()
 				if i < len(mutators)-1 {
-					_, err = mutators[i+1].Mutate(root, dcb.builders[i+1].mutation)
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
 					// Invoke the actual operation on the latest mutation in the chain.
-					if err = sqlgraph.BatchCreate(ctx, dcb.driver, spec); err != nil {
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
 							err = &ConstraintError{msg: err.Error(), wrap: err}
 						}
@@ -247,7 +247,7 @@ func (dcb *DelegationCreateBulk) Save(ctx context.Context) ([]*Delegation, error
 		}(i, ctx)
 	}
 	if len(mutators) > 0 {
-		if _, err := mutators[0].Mutate(ctx, dcb.builders[0].mutation); err != nil {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
 			return nil, err
 		}
 	}
@@ -255,8 +255,8 @@ func (dcb *DelegationCreateBulk) Save(ctx context.Context) ([]*Delegation, error
 }
 
 // SaveX is like Save, but panics if an error occurs.
-func (dcb *DelegationCreateBulk) SaveX(ctx context.Context) []*Delegation {
-	v, err := dcb.Save(ctx)
+func (_c *DelegationCreateBulk) SaveX(ctx context.Context) []*Delegation {
+	v, err := _c.Save(ctx)
 	if err != nil {
 		panic(err)
 	}
@@ -264,14 +264,14 @@ func (dcb *DelegationCreateBulk) SaveX(ctx context.Context) []*Delegation {
 }
 
 // Exec executes the query.
-func (dcb *DelegationCreateBulk) Exec(ctx context.Context) error {
-	_, err := dcb.Save(ctx)
+func (_c *DelegationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (dcb *DelegationCreateBulk) ExecX(ctx context.Context) {
-	if err := dcb.Exec(ctx); err != nil {
+func (_c *DelegationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
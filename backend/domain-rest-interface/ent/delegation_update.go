@@ -24,62 +24,62 @@ type DelegationUpdate struct {
 }
 
 // Where appends a list predicates to the DelegationUpdate builder.
-func (du *DelegationUpdate) Where(ps ...predicate.Delegation) *DelegationUpdate {
-	du.mutation.Where(ps...)
-	return du
+func (_u *DelegationUpdate) Where(ps ...predicate.Delegation) *DelegationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (du *DelegationUpdate) SetUpdateTime(t time.Time) *DelegationUpdate {
-	du.mutation.SetUpdateTime(t)
-	return du
+func (_u *DelegationUpdate) SetUpdateTime(v time.Time) *DelegationUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
 }
 
 // SetUser sets the "user" field.
-func (du *DelegationUpdate) SetUser(s string) *DelegationUpdate {
-	du.mutation.SetUser(s)
-	return du
+func (_u *DelegationUpdate) SetUser(v string) *DelegationUpdate {
+	_u.mutation.SetUser(v)
+	return _u
 }
 
 // SetNillableUser sets the "user" field if the given value is not nil.
-func (du *DelegationUpdate) SetNillableUser(s *string) *DelegationUpdate {
-	if s != nil {
-		du.SetUser(*s)
+func (_u *DelegationUpdate) SetNillableUser(v *string) *DelegationUpdate {
+	if v != nil {
+		_u.SetUser(*v)
 	}
-	return du
+	return _u
 }
 
 // SetDomainID sets the "domain" edge to the Domain entity by ID.
-func (du *DelegationUpdate) SetDomainID(id int) *DelegationUpdate {
-	du.mutation.SetDomainID(id)
-	return du
+func (_u *DelegationUpdate) SetDomainID(id int) *DelegationUpdate {
+	_u.mutation.SetDomainID(id)
+	return _u
 }
 
 // SetDomain sets the "domain" edge to the Domain entity.
-func (du *DelegationUpdate) SetDomain(d *Domain) *DelegationUpdate {
-	return du.SetDomainID(d.ID)
+func (_u *DelegationUpdate) SetDomain(v *Domain) *DelegationUpdate {
+	return _u.SetDomainID(v.ID)
 }
 
 // Mutation returns the DelegationMutation object of the builder.
-func (du *DelegationUpdate) Mutation() *DelegationMutation {
-	return du.mutation
+func (_u *DelegationUpdate) Mutation() *DelegationMutation {
+	return _u.mutation
 }
 
 // ClearDomain clears the "domain" edge to the Domain entity.
-func (du *DelegationUpdate) ClearDomain() *DelegationUpdate {
-	du.mutation.ClearDomain()
-	return du
+func (_u *DelegationUpdate) ClearDomain() *DelegationUpdate {
+	_u.mutation.ClearDomain()
+	return _u
 }
 
 // Save executes the query and returns the number of nodes affected by the update operation.
-func (du *DelegationUpdate) Save(ctx context.Context) (int, error) {
-	du.defaults()
-	return withHooks(ctx, du.sqlSave, du.mutation, du.hooks)
+func (_u *DelegationUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
 // SaveX is like Save, but panics if an error occurs.
-func (du *DelegationUpdate) SaveX(ctx context.Context) int {
-	affected, err := du.Save(ctx)
+func (_u *DelegationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
 	if err != nil {
 		panic(err)
 	}
@@ -87,58 +87,58 @@ func (du *DelegationUpdate) SaveX(ctx context.Context) int {
 }
 
 // Exec executes the query.
-func (du *DelegationUpdate) Exec(ctx context.Context) error {
-	_, err := du.Save(ctx)
+func (_u *DelegationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (du *DelegationUpdate) ExecX(ctx context.Context) {
-	if err := du.Exec(ctx); err != nil {
+func (_u *DelegationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
 
 // defaults sets the default values of the builder before save.
-func (du *DelegationUpdate) defaults() {
-	if _, ok := du.mutation.UpdateTime(); !ok {
+func (_u *DelegationUpdate) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
 		v := delegation.UpdateDefaultUpdateTime()
-		du.mutation.SetUpdateTime(v)
+		_u.mutation.SetUpdateTime(v)
 	}
 }
 
 // check runs all checks and user-defined validators on the builder.
-func (du *DelegationUpdate) check() error {
-	if v, ok := du.mutation.User(); ok {
+func (_u *DelegationUpdate) check() error {
+	if v, ok := _u.mutation.User(); ok {
 		if err := delegation.UserValidator(v); err != nil {
 			return &ValidationError{Name: "user", err: fmt.Errorf(`ent: validator failed for field "Delegation.user": %w`, err)}
 		}
 	}
-	if du.mutation.DomainCleared() && len(du.mutation.DomainIDs()) > 0 {
+	if _u.mutation.DomainCleared() && len(_u.mutation.DomainIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Delegation.domain"`)
 	}
 	return nil
 }
 
-func (du *DelegationUpdate) sqlSave(ctx context.Context) (n int, err error) {
-	if err := du.check(); err != nil {
-		return n, err
+func (_u *DelegationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
 	}
 	_spec := sqlgraph.NewUpdateSpec(delegation.Table, delegation.Columns, sqlgraph.NewFieldSpec(delegation.FieldID, field.TypeInt))
-	if ps := du.mutation.predicates; len(ps) > 0 {
+	if ps := _u.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
 				ps[i](selector)
 			}
 		}
 	}
-	if value, ok := du.mutation.UpdateTime(); ok {
+	if value, ok := _u.mutation.UpdateTime(); ok {
 		_spec.SetField(delegation.FieldUpdateTime, field.TypeTime, value)
 	}
-	if value, ok := du.mutation.User(); ok {
+	if value, ok := _u.mutation.User(); ok {
 		_spec.SetField(delegation.FieldUser, field.TypeString, value)
 	}
-	if du.mutation.DomainCleared() {
+	if _u.mutation.DomainCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
 			Inverse: true,
@@ -151,7 +151,7 @@ func (du *DelegationUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := du.mutation.DomainIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.DomainIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
 			Inverse: true,
@@ -167,7 +167,7 @@ func (du *DelegationUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, du.driver, _spec); err != nil {
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{delegation.Label}
 		} else if sqlgraph.IsConstraintError(err) {
@@ -175,8 +175,8 @@ func (du *DelegationUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		return 0, err
 	}
-	du.mutation.done = true
-	return n, nil
+	_u.mutation.done = true
+	return _node, nil
 }
 
 // DelegationUpdateOne is the builder for updating a single Delegation entity.
@@ -188,69 +188,69 @@ type DelegationUpdateOne struct {
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (duo *DelegationUpdateOne) SetUpdateTime(t time.Time) *DelegationUpdateOne {
-	duo.mutation.SetUpdateTime(t)
-	return duo
+func (_u *DelegationUpdateOne) SetUpdateTime(v time.Time) *DelegationUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
 }
 
 // SetUser sets the "user" field.
-func (duo *DelegationUpdateOne) SetUser(s string) *DelegationUpdateOne {
-	duo.mutation.SetUser(s)
-	return duo
+func (_u *DelegationUpdateOne) SetUser(v string) *DelegationUpdateOne {
+	_u.mutation.SetUser(v)
+	return _u
 }
 
 // SetNillableUser sets the "user" field if the given value is not nil.
-func (duo *DelegationUpdateOne) SetNillableUser(s *string) *DelegationUpdateOne {
-	if s != nil {
-		duo.SetUser(*s)
+func (_u *DelegationUpdateOne) SetNillableUser(v *string) *DelegationUpdateOne {
+	if v != nil {
+		_u.SetUser(*v)
 	}
-	return duo
+	return _u
 }
 
 // SetDomainID sets the "domain" edge to the Domain entity by ID.
-func (duo *DelegationUpdateOne) SetDomainID(id int) *DelegationUpdateOne {
-	duo.mutation.SetDomainID(id)
-	return duo
+func (_u *DelegationUpdateOne) SetDomainID(id int) *DelegationUpdateOne {
+	_u.mutation.SetDomainID(id)
+	return _u
 }
 
 // SetDomain sets the "domain" edge to the Domain entity.
-func (duo *DelegationUpdateOne) SetDomain(d *Domain) *DelegationUpdateOne {
-	return duo.SetDomainID(d.ID)
+func (_u *DelegationUpdateOne) SetDomain(v *Domain) *DelegationUpdateOne {
+	return _u.SetDomainID(v.ID)
 }
 
 // Mutation returns the DelegationMutation object of the builder.
-func (duo *DelegationUpdateOne) Mutation() *DelegationMutation {
-	return duo.mutation
+func (_u *DelegationUpdateOne) Mutation() *DelegationMutation {
+	return _u.mutation
 }
 
 // ClearDomain clears the "domain" edge to the Domain entity.
-func (duo *DelegationUpdateOne) ClearDomain() *DelegationUpdateOne {
-	duo.mutation.ClearDomain()
-	return duo
+func (_u *DelegationUpdateOne) ClearDomain() *DelegationUpdateOne {
+	_u.mutation.ClearDomain()
+	return _u
 }
 
 // Where appends a list predicates to the DelegationUpdate builder.
-func (duo *DelegationUpdateOne) Where(ps ...predicate.Delegation) *DelegationUpdateOne {
-	duo.mutation.Where(ps...)
-	return duo
+func (_u *DelegationUpdateOne) Where(ps ...predicate.Delegation) *DelegationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
 }
 
 // Select allows selecting one or more fields (columns) of the returned entity.
 // The default is selecting all fields defined in the entity schema.
-func (duo *DelegationUpdateOne) Select(field string, fields ...string) *DelegationUpdateOne {
-	duo.fields = append([]string{field}, fields...)
-	return duo
+func (_u *DelegationUpdateOne) Select(field string, fields ...string) *DelegationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
 }
 
 // Save executes the query and returns the updated Delegation entity.
-func (duo *DelegationUpdateOne) Save(ctx context.Context) (*Delegation, error) {
-	duo.defaults()
-	return withHooks(ctx, duo.sqlSave, duo.mutation, duo.hooks)
+func (_u *DelegationUpdateOne) Save(ctx context.Context) (*Delegation, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
 // SaveX is like Save, but panics if an error occurs.
-func (duo *DelegationUpdateOne) SaveX(ctx context.Context) *Delegation {
-	node, err := duo.Save(ctx)
+func (_u *DelegationUpdateOne) SaveX(ctx context.Context) *Delegation {
+	node, err := _u.Save(ctx)
 	if err != nil {
 		panic(err)
 	}
@@ -258,50 +258,50 @@ func (duo *DelegationUpdateOne) SaveX(ctx context.Context) *Delegation {
 }
 
 // Exec executes the query on the entity.
-func (duo *DelegationUpdateOne) Exec(ctx context.Context) error {
-	_, err := duo.Save(ctx)
+func (_u *DelegationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (duo *DelegationUpdateOne) ExecX(ctx context.Context) {
-	if err := duo.Exec(ctx); err != nil {
+func (_u *DelegationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
 
 // defaults sets the default values of the builder before save.
-func (duo *DelegationUpdateOne) defaults() {
-	if _, ok := duo.mutation.UpdateTime(); !ok {
+func (_u *DelegationUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
 		v := delegation.UpdateDefaultUpdateTime()
-		duo.mutation.SetUpdateTime(v)
+		_u.mutation.SetUpdateTime(v)
 	}
 }
 
 // check runs all checks and user-defined validators on the builder.
-func (duo *DelegationUpdateOne) check() error {
-	if v, ok := duo.mutation.User(); ok {
+func (_u *DelegationUpdateOne) check() error {
+	if v, ok := _u.mutation.User(); ok {
 		if err := delegation.UserValidator(v); err != nil {
 			return &ValidationError{Name: "user", err: fmt.Errorf(`ent: validator failed for field "Delegation.user": %w`, err)}
 		}
 	}
-	if duo.mutation.DomainCleared() && len(duo.mutation.DomainIDs()) > 0 {
+	if _u.mutation.DomainCleared() && len(_u.mutation.DomainIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Delegation.domain"`)
 	}
 	return nil
 }
 
-func (duo *DelegationUpdateOne) sqlSave(ctx context.Context) (_node *Delegation, err error) {
-	if err := duo.check(); err != nil {
+func (_u *DelegationUpdateOne) sqlSave(ctx context.Context) (_node *Delegation, err error) {
+	if err := _u.check(); err != nil {
 		return _node, err
 	}
 	_spec := sqlgraph.NewUpdateSpec(delegation.Table, delegation.Columns, sqlgraph.NewFieldSpec(delegation.FieldID, field.TypeInt))
-	id, ok := duo.mutation.ID()
+	id, ok := _u.mutation.ID()
 	if !ok {
 		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Delegation.id" for update`)}
 	}
 	_spec.Node.ID.Value = id
-	if fields := duo.fields; len(fields) > 0 {
+	if fields := _u.fields; len(fields) > 0 {
 		_spec.Node.Columns = make([]string, 0, len(fields))
 		_spec.Node.Columns = append(_spec.Node.Columns, delegation.FieldID)
 		for _, f := range fields {
@@ -313,20 +313,20 @@ func (duo *DelegationUpdateOne) sqlSave(ctx context.Context) (_node *Delegation,
 			}
 		}
 	}
-	if ps := duo.mutation.predicates; len(ps) > 0 {
+	if ps := _u.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
 				ps[i](selector)
 			}
 		}
 	}
-	if value, ok := duo.mutation.UpdateTime(); ok {
+	if value, ok := _u.mutation.UpdateTime(); ok {
 		_spec.SetField(delegation.FieldUpdateTime, field.TypeTime, value)
 	}
-	if value, ok := duo.mutation.User(); ok {
+	if value, ok := _u.mutation.User(); ok {
 		_spec.SetField(delegation.FieldUser, field.TypeString, value)
 	}
-	if duo.mutation.DomainCleared() {
+	if _u.mutation.DomainCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
 			Inverse: true,
@@ -339,7 +339,7 @@ func (duo *DelegationUpdateOne) sqlSave(ctx context.Context) (_node *Delegation,
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := duo.mutation.DomainIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.DomainIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
 			Inverse: true,
@@ -355,10 +355,10 @@ func (duo *DelegationUpdateOne) sqlSave(ctx context.Context) (_node *Delegation,
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	_node = &Delegation{config: duo.config}
+	_node = &Delegation{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
-	if err = sqlgraph.UpdateNode(ctx, duo.driver, _spec); err != nil {
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{delegation.Label}
 		} else if sqlgraph.IsConstraintError(err) {
@@ -366,6 +366,6 @@ func (duo *DelegationUpdateOne) sqlSave(ctx context.Context) (_node *Delegation,
 		}
 		return nil, err
 	}
-	duo.mutation.done = true
+	_u.mutation.done = true
 	return _node, nil
 }
@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -27,6 +28,16 @@ type Domain struct {
 	Owner string `json:"owner,omitempty"`
 	// Approved holds the value of the "approved" field.
 	Approved bool `json:"approved,omitempty"`
+	// Contacts holds the value of the "contacts" field.
+	Contacts []string `json:"contacts,omitempty"`
+	// VerificationToken holds the value of the "verification_token" field.
+	VerificationToken *string `json:"verification_token,omitempty"`
+	// VerificationStatus holds the value of the "verification_status" field.
+	VerificationStatus domain.VerificationStatus `json:"verification_status,omitempty"`
+	// VerificationCheckedAt holds the value of the "verification_checked_at" field.
+	VerificationCheckedAt *time.Time `json:"verification_checked_at,omitempty"`
+	// VerifiedAt holds the value of the "verified_at" field.
+	VerifiedAt *time.Time `json:"verified_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the DomainQuery when eager-loading is set.
 	Edges        DomainEdges `json:"edges"`
@@ -37,9 +48,11 @@ type Domain struct {
 type DomainEdges struct {
 	// Delegations holds the value of the delegations edge.
 	Delegations []*Delegation `json:"delegations,omitempty"`
+	// Requests holds the value of the requests edge.
+	Requests []*ApprovalRequest `json:"requests,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // DelegationsOrErr returns the Delegations value or an error if the edge
@@ -51,18 +64,29 @@ func (e DomainEdges) DelegationsOrErr() ([]*Delegation, error) {
 	return nil, &NotLoadedError{edge: "delegations"}
 }
 
+// RequestsOrErr returns the Requests value or an error if the edge
+// was not loaded in eager-loading.
+func (e DomainEdges) RequestsOrErr() ([]*ApprovalRequest, error) {
+	if e.loadedTypes[1] {
+		return e.Requests, nil
+	}
+	return nil, &NotLoadedError{edge: "requests"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Domain) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case domain.FieldContacts:
+			values[i] = new([]byte)
 		case domain.FieldApproved:
 			values[i] = new(sql.NullBool)
 		case domain.FieldID:
 			values[i] = new(sql.NullInt64)
-		case domain.FieldFqdn, domain.FieldOwner:
+		case domain.FieldFqdn, domain.FieldOwner, domain.FieldVerificationToken, domain.FieldVerificationStatus:
 			values[i] = new(sql.NullString)
-		case domain.FieldCreateTime, domain.FieldUpdateTime:
+		case domain.FieldCreateTime, domain.FieldUpdateTime, domain.FieldVerificationCheckedAt, domain.FieldVerifiedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -73,7 +97,7 @@ func (*Domain) scanValues(columns []string) ([]any, error) {
 
 // assignValues assigns the values that were returned from sql.Rows (after scanning)
 // to the Domain fields.
-func (d *Domain) assignValues(columns []string, values []any) error {
+func (_m *Domain) assignValues(columns []string, values []any) error {
 	if m, n := len(values), len(columns); m < n {
 		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
 	}
@@ -84,39 +108,74 @@ func (d *Domain) assignValues(columns []string, values []any) error {
 			if !ok {
 				return fmt.Errorf("unexpected type %T for field id", value)
 			}
-			d.ID = int(value.Int64)
+			_m.ID = int(value.Int64)
 		case domain.FieldCreateTime:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field create_time", values[i])
 			} else if value.Valid {
-				d.CreateTime = value.Time
+				_m.CreateTime = value.Time
 			}
 		case domain.FieldUpdateTime:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field update_time", values[i])
 			} else if value.Valid {
-				d.UpdateTime = value.Time
+				_m.UpdateTime = value.Time
 			}
 		case domain.FieldFqdn:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field fqdn", values[i])
 			} else if value.Valid {
-				d.Fqdn = value.String
+				_m.Fqdn = value.String
 			}
 		case domain.FieldOwner:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field owner", values[i])
 			} else if value.Valid {
-				d.Owner = value.String
+				_m.Owner = value.String
 			}
 		case domain.FieldApproved:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field approved", values[i])
 			} else if value.Valid {
-				d.Approved = value.Bool
+				_m.Approved = value.Bool
+			}
+		case domain.FieldContacts:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field contacts", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Contacts); err != nil {
+					return fmt.Errorf("unmarshal field contacts: %w", err)
+				}
+			}
+		case domain.FieldVerificationToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field verification_token", values[i])
+			} else if value.Valid {
+				_m.VerificationToken = new(string)
+				*_m.VerificationToken = value.String
+			}
+		case domain.FieldVerificationStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field verification_status", values[i])
+			} else if value.Valid {
+				_m.VerificationStatus = domain.VerificationStatus(value.String)
+			}
+		case domain.FieldVerificationCheckedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field verification_checked_at", values[i])
+			} else if value.Valid {
+				_m.VerificationCheckedAt = new(time.Time)
+				*_m.VerificationCheckedAt = value.Time
+			}
+		case domain.FieldVerifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field verified_at", values[i])
+			} else if value.Valid {
+				_m.VerifiedAt = new(time.Time)
+				*_m.VerifiedAt = value.Time
 			}
 		default:
-			d.selectValues.Set(columns[i], values[i])
+			_m.selectValues.Set(columns[i], values[i])
 		}
 	}
 	return nil
@@ -124,52 +183,78 @@ func (d *Domain) assignValues(columns []string, values []any) error {
 
 // Value returns the ent.Value that was dynamically selected and assigned to the Domain.
 // This includes values selected through modifiers, order, etc.
-func (d *Domain) Value(name string) (ent.Value, error) {
-	return d.selectValues.Get(name)
+func (_m *Domain) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
 }
 
 // QueryDelegations queries the "delegations" edge of the Domain entity.
-func (d *Domain) QueryDelegations() *DelegationQuery {
-	return NewDomainClient(d.config).QueryDelegations(d)
+func (_m *Domain) QueryDelegations() *DelegationQuery {
+	return NewDomainClient(_m.config).QueryDelegations(_m)
+}
+
+// QueryRequests queries the "requests" edge of the Domain entity.
+func (_m *Domain) QueryRequests() *ApprovalRequestQuery {
+	return NewDomainClient(_m.config).QueryRequests(_m)
 }
 
 // Update returns a builder for updating this Domain.
 // Note that you need to call Domain.Unwrap() before calling this method if this Domain
 // was returned from a transaction, and the transaction was committed or rolled back.
-func (d *Domain) Update() *DomainUpdateOne {
-	return NewDomainClient(d.config).UpdateOne(d)
+func (_m *Domain) Update() *DomainUpdateOne {
+	return NewDomainClient(_m.config).UpdateOne(_m)
 }
 
 // Unwrap unwraps the Domain entity that was returned from a transaction after it was closed,
 // so that all future queries will be executed through the driver which created the transaction.
-func (d *Domain) Unwrap() *Domain {
-	_tx, ok := d.config.driver.(*txDriver)
+func (_m *Domain) Unwrap() *Domain {
+	_tx, ok := _m.config.driver.(*txDriver)
 	if !ok {
 		panic("ent: Domain is not a transactional entity")
 	}
-	d.config.driver = _tx.drv
-	return d
+	_m.config.driver = _tx.drv
+	return _m
 }
 
 // String implements the fmt.Stringer.
-func (d *Domain) String() string {
+func (_m *Domain) String() string {
 	var builder strings.Builder
 	builder.WriteString("Domain(")
-	builder.WriteString(fmt.Sprintf("id=%v, ", d.ID))
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
 	builder.WriteString("create_time=")
-	builder.WriteString(d.CreateTime.Format(time.ANSIC))
+	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
 	builder.WriteString(", ")
 	builder.WriteString("update_time=")
-	builder.WriteString(d.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(_m.UpdateTime.Format(time.ANSIC))
 	builder.WriteString(", ")
 	builder.WriteString("fqdn=")
-	builder.WriteString(d.Fqdn)
+	builder.WriteString(_m.Fqdn)
 	builder.WriteString(", ")
 	builder.WriteString("owner=")
-	builder.WriteString(d.Owner)
+	builder.WriteString(_m.Owner)
 	builder.WriteString(", ")
 	builder.WriteString("approved=")
-	builder.WriteString(fmt.Sprintf("%v", d.Approved))
+	builder.WriteString(fmt.Sprintf("%v", _m.Approved))
+	builder.WriteString(", ")
+	builder.WriteString("contacts=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Contacts))
+	builder.WriteString(", ")
+	if v := _m.VerificationToken; v != nil {
+		builder.WriteString("verification_token=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("verification_status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.VerificationStatus))
+	builder.WriteString(", ")
+	if v := _m.VerificationCheckedAt; v != nil {
+		builder.WriteString("verification_checked_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.VerifiedAt; v != nil {
+		builder.WriteString("verified_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -3,6 +3,7 @@
 package domain
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -24,8 +25,20 @@ const (
 	FieldOwner = "owner"
 	// FieldApproved holds the string denoting the approved field in the database.
 	FieldApproved = "approved"
+	// FieldContacts holds the string denoting the contacts field in the database.
+	FieldContacts = "contacts"
+	// FieldVerificationToken holds the string denoting the verification_token field in the database.
+	FieldVerificationToken = "verification_token"
+	// FieldVerificationStatus holds the string denoting the verification_status field in the database.
+	FieldVerificationStatus = "verification_status"
+	// FieldVerificationCheckedAt holds the string denoting the verification_checked_at field in the database.
+	FieldVerificationCheckedAt = "verification_checked_at"
+	// FieldVerifiedAt holds the string denoting the verified_at field in the database.
+	FieldVerifiedAt = "verified_at"
 	// EdgeDelegations holds the string denoting the delegations edge name in mutations.
 	EdgeDelegations = "delegations"
+	// EdgeRequests holds the string denoting the requests edge name in mutations.
+	EdgeRequests = "requests"
 	// Table holds the table name of the domain in the database.
 	Table = "domains"
 	// DelegationsTable is the table that holds the delegations relation/edge.
@@ -35,6 +48,13 @@ const (
 	DelegationsInverseTable = "delegations"
 	// DelegationsColumn is the table column denoting the delegations relation/edge.
 	DelegationsColumn = "domain_delegations"
+	// RequestsTable is the table that holds the requests relation/edge.
+	RequestsTable = "approval_requests"
+	// RequestsInverseTable is the table name for the ApprovalRequest entity.
+	// It exists in this package in order to avoid circular dependency with the "approvalrequest" package.
+	RequestsInverseTable = "approval_requests"
+	// RequestsColumn is the table column denoting the requests relation/edge.
+	RequestsColumn = "domain_requests"
 )
 
 // Columns holds all SQL columns for domain fields.
@@ -45,6 +65,11 @@ var Columns = []string{
 	FieldFqdn,
 	FieldOwner,
 	FieldApproved,
+	FieldContacts,
+	FieldVerificationToken,
+	FieldVerificationStatus,
+	FieldVerificationCheckedAt,
+	FieldVerifiedAt,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -72,6 +97,34 @@ var (
 	DefaultApproved bool
 )
 
+// VerificationStatus defines the type for the "verification_status" enum field.
+type VerificationStatus string
+
+// VerificationStatusUnverified is the default value of the VerificationStatus enum.
+const DefaultVerificationStatus = VerificationStatusUnverified
+
+// VerificationStatus values.
+const (
+	VerificationStatusUnverified VerificationStatus = "unverified"
+	VerificationStatusPending    VerificationStatus = "pending"
+	VerificationStatusVerified   VerificationStatus = "verified"
+	VerificationStatusFailed     VerificationStatus = "failed"
+)
+
+func (vs VerificationStatus) String() string {
+	return string(vs)
+}
+
+// VerificationStatusValidator is a validator for the "verification_status" field enum values. It is called by the builders before save.
+func VerificationStatusValidator(vs VerificationStatus) error {
+	switch vs {
+	case VerificationStatusUnverified, VerificationStatusPending, VerificationStatusVerified, VerificationStatusFailed:
+		return nil
+	default:
+		return fmt.Errorf("domain: invalid enum value for verification_status field: %q", vs)
+	}
+}
+
 // OrderOption defines the ordering options for the Domain queries.
 type OrderOption func(*sql.Selector)
 
@@ -105,6 +158,26 @@ func ByApproved(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldApproved, opts...).ToFunc()
 }
 
+// ByVerificationToken orders the results by the verification_token field.
+func ByVerificationToken(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVerificationToken, opts...).ToFunc()
+}
+
+// ByVerificationStatus orders the results by the verification_status field.
+func ByVerificationStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVerificationStatus, opts...).ToFunc()
+}
+
+// ByVerificationCheckedAt orders the results by the verification_checked_at field.
+func ByVerificationCheckedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVerificationCheckedAt, opts...).ToFunc()
+}
+
+// ByVerifiedAt orders the results by the verified_at field.
+func ByVerifiedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVerifiedAt, opts...).ToFunc()
+}
+
 // ByDelegationsCount orders the results by delegations count.
 func ByDelegationsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -118,6 +191,20 @@ func ByDelegations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newDelegationsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByRequestsCount orders the results by requests count.
+func ByRequestsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRequestsStep(), opts...)
+	}
+}
+
+// ByRequests orders the results by requests terms.
+func ByRequests(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRequestsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newDelegationsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -125,3 +212,10 @@ func newDelegationsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, DelegationsTable, DelegationsColumn),
 	)
 }
+func newRequestsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RequestsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RequestsTable, RequestsColumn),
+	)
+}
@@ -80,6 +80,21 @@ func Approved(v bool) predicate.Domain {
 	return predicate.Domain(sql.FieldEQ(FieldApproved, v))
 }
 
+// VerificationToken applies equality check predicate on the "verification_token" field. It's identical to VerificationTokenEQ.
+func VerificationToken(v string) predicate.Domain {
+	return predicate.Domain(sql.FieldEQ(FieldVerificationToken, v))
+}
+
+// VerificationCheckedAt applies equality check predicate on the "verification_checked_at" field. It's identical to VerificationCheckedAtEQ.
+func VerificationCheckedAt(v time.Time) predicate.Domain {
+	return predicate.Domain(sql.FieldEQ(FieldVerificationCheckedAt, v))
+}
+
+// VerifiedAt applies equality check predicate on the "verified_at" field. It's identical to VerifiedAtEQ.
+func VerifiedAt(v time.Time) predicate.Domain {
+	return predicate.Domain(sql.FieldEQ(FieldVerifiedAt, v))
+}
+
 // CreateTimeEQ applies the EQ predicate on the "create_time" field.
 func CreateTimeEQ(v time.Time) predicate.Domain {
 	return predicate.Domain(sql.FieldEQ(FieldCreateTime, v))
@@ -300,6 +315,211 @@ func ApprovedNEQ(v bool) predicate.Domain {
 	return predicate.Domain(sql.FieldNEQ(FieldApproved, v))
 }
 
+// ContactsIsNil applies the IsNil predicate on the "contacts" field.
+func ContactsIsNil() predicate.Domain {
+	return predicate.Domain(sql.FieldIsNull(FieldContacts))
+}
+
+// ContactsNotNil applies the NotNil predicate on the "contacts" field.
+func ContactsNotNil() predicate.Domain {
+	return predicate.Domain(sql.FieldNotNull(FieldContacts))
+}
+
+// VerificationTokenEQ applies the EQ predicate on the "verification_token" field.
+func VerificationTokenEQ(v string) predicate.Domain {
+	return predicate.Domain(sql.FieldEQ(FieldVerificationToken, v))
+}
+
+// VerificationTokenNEQ applies the NEQ predicate on the "verification_token" field.
+func VerificationTokenNEQ(v string) predicate.Domain {
+	return predicate.Domain(sql.FieldNEQ(FieldVerificationToken, v))
+}
+
+// VerificationTokenIn applies the In predicate on the "verification_token" field.
+func VerificationTokenIn(vs ...string) predicate.Domain {
+	return predicate.Domain(sql.FieldIn(FieldVerificationToken, vs...))
+}
+
+// VerificationTokenNotIn applies the NotIn predicate on the "verification_token" field.
+func VerificationTokenNotIn(vs ...string) predicate.Domain {
+	return predicate.Domain(sql.FieldNotIn(FieldVerificationToken, vs...))
+}
+
+// VerificationTokenGT applies the GT predicate on the "verification_token" field.
+func VerificationTokenGT(v string) predicate.Domain {
+	return predicate.Domain(sql.FieldGT(FieldVerificationToken, v))
+}
+
+// VerificationTokenGTE applies the GTE predicate on the "verification_token" field.
+func VerificationTokenGTE(v string) predicate.Domain {
+	return predicate.Domain(sql.FieldGTE(FieldVerificationToken, v))
+}
+
+// VerificationTokenLT applies the LT predicate on the "verification_token" field.
+func VerificationTokenLT(v string) predicate.Domain {
+	return predicate.Domain(sql.FieldLT(FieldVerificationToken, v))
+}
+
+// VerificationTokenLTE applies the LTE predicate on the "verification_token" field.
+func VerificationTokenLTE(v string) predicate.Domain {
+	return predicate.Domain(sql.FieldLTE(FieldVerificationToken, v))
+}
+
+// VerificationTokenContains applies the Contains predicate on the "verification_token" field.
+func VerificationTokenContains(v string) predicate.Domain {
+	return predicate.Domain(sql.FieldContains(FieldVerificationToken, v))
+}
+
+// VerificationTokenHasPrefix applies the HasPrefix predicate on the "verification_token" field.
+func VerificationTokenHasPrefix(v string) predicate.Domain {
+	return predicate.Domain(sql.FieldHasPrefix(FieldVerificationToken, v))
+}
+
+// VerificationTokenHasSuffix applies the HasSuffix predicate on the "verification_token" field.
+func VerificationTokenHasSuffix(v string) predicate.Domain {
+	return predicate.Domain(sql.FieldHasSuffix(FieldVerificationToken, v))
+}
+
+// VerificationTokenIsNil applies the IsNil predicate on the "verification_token" field.
+func VerificationTokenIsNil() predicate.Domain {
+	return predicate.Domain(sql.FieldIsNull(FieldVerificationToken))
+}
+
+// VerificationTokenNotNil applies the NotNil predicate on the "verification_token" field.
+func VerificationTokenNotNil() predicate.Domain {
+	return predicate.Domain(sql.FieldNotNull(FieldVerificationToken))
+}
+
+// VerificationTokenEqualFold applies the EqualFold predicate on the "verification_token" field.
+func VerificationTokenEqualFold(v string) predicate.Domain {
+	return predicate.Domain(sql.FieldEqualFold(FieldVerificationToken, v))
+}
+
+// VerificationTokenContainsFold applies the ContainsFold predicate on the "verification_token" field.
+func VerificationTokenContainsFold(v string) predicate.Domain {
+	return predicate.Domain(sql.FieldContainsFold(FieldVerificationToken, v))
+}
+
+// VerificationStatusEQ applies the EQ predicate on the "verification_status" field.
+func VerificationStatusEQ(v VerificationStatus) predicate.Domain {
+	return predicate.Domain(sql.FieldEQ(FieldVerificationStatus, v))
+}
+
+// VerificationStatusNEQ applies the NEQ predicate on the "verification_status" field.
+func VerificationStatusNEQ(v VerificationStatus) predicate.Domain {
+	return predicate.Domain(sql.FieldNEQ(FieldVerificationStatus, v))
+}
+
+// VerificationStatusIn applies the In predicate on the "verification_status" field.
+func VerificationStatusIn(vs ...VerificationStatus) predicate.Domain {
+	return predicate.Domain(sql.FieldIn(FieldVerificationStatus, vs...))
+}
+
+// VerificationStatusNotIn applies the NotIn predicate on the "verification_status" field.
+func VerificationStatusNotIn(vs ...VerificationStatus) predicate.Domain {
+	return predicate.Domain(sql.FieldNotIn(FieldVerificationStatus, vs...))
+}
+
+// VerificationCheckedAtEQ applies the EQ predicate on the "verification_checked_at" field.
+func VerificationCheckedAtEQ(v time.Time) predicate.Domain {
+	return predicate.Domain(sql.FieldEQ(FieldVerificationCheckedAt, v))
+}
+
+// VerificationCheckedAtNEQ applies the NEQ predicate on the "verification_checked_at" field.
+func VerificationCheckedAtNEQ(v time.Time) predicate.Domain {
+	return predicate.Domain(sql.FieldNEQ(FieldVerificationCheckedAt, v))
+}
+
+// VerificationCheckedAtIn applies the In predicate on the "verification_checked_at" field.
+func VerificationCheckedAtIn(vs ...time.Time) predicate.Domain {
+	return predicate.Domain(sql.FieldIn(FieldVerificationCheckedAt, vs...))
+}
+
+// VerificationCheckedAtNotIn applies the NotIn predicate on the "verification_checked_at" field.
+func VerificationCheckedAtNotIn(vs ...time.Time) predicate.Domain {
+	return predicate.Domain(sql.FieldNotIn(FieldVerificationCheckedAt, vs...))
+}
+
+// VerificationCheckedAtGT applies the GT predicate on the "verification_checked_at" field.
+func VerificationCheckedAtGT(v time.Time) predicate.Domain {
+	return predicate.Domain(sql.FieldGT(FieldVerificationCheckedAt, v))
+}
+
+// VerificationCheckedAtGTE applies the GTE predicate on the "verification_checked_at" field.
+func VerificationCheckedAtGTE(v time.Time) predicate.Domain {
+	return predicate.Domain(sql.FieldGTE(FieldVerificationCheckedAt, v))
+}
+
+// VerificationCheckedAtLT applies the LT predicate on the "verification_checked_at" field.
+func VerificationCheckedAtLT(v time.Time) predicate.Domain {
+	return predicate.Domain(sql.FieldLT(FieldVerificationCheckedAt, v))
+}
+
+// VerificationCheckedAtLTE applies the LTE predicate on the "verification_checked_at" field.
+func VerificationCheckedAtLTE(v time.Time) predicate.Domain {
+	return predicate.Domain(sql.FieldLTE(FieldVerificationCheckedAt, v))
+}
+
+// VerificationCheckedAtIsNil applies the IsNil predicate on the "verification_checked_at" field.
+func VerificationCheckedAtIsNil() predicate.Domain {
+	return predicate.Domain(sql.FieldIsNull(FieldVerificationCheckedAt))
+}
+
+// VerificationCheckedAtNotNil applies the NotNil predicate on the "verification_checked_at" field.
+func VerificationCheckedAtNotNil() predicate.Domain {
+	return predicate.Domain(sql.FieldNotNull(FieldVerificationCheckedAt))
+}
+
+// VerifiedAtEQ applies the EQ predicate on the "verified_at" field.
+func VerifiedAtEQ(v time.Time) predicate.Domain {
+	return predicate.Domain(sql.FieldEQ(FieldVerifiedAt, v))
+}
+
+// VerifiedAtNEQ applies the NEQ predicate on the "verified_at" field.
+func VerifiedAtNEQ(v time.Time) predicate.Domain {
+	return predicate.Domain(sql.FieldNEQ(FieldVerifiedAt, v))
+}
+
+// VerifiedAtIn applies the In predicate on the "verified_at" field.
+func VerifiedAtIn(vs ...time.Time) predicate.Domain {
+	return predicate.Domain(sql.FieldIn(FieldVerifiedAt, vs...))
+}
+
+// VerifiedAtNotIn applies the NotIn predicate on the "verified_at" field.
+func VerifiedAtNotIn(vs ...time.Time) predicate.Domain {
+	return predicate.Domain(sql.FieldNotIn(FieldVerifiedAt, vs...))
+}
+
+// VerifiedAtGT applies the GT predicate on the "verified_at" field.
+func VerifiedAtGT(v time.Time) predicate.Domain {
+	return predicate.Domain(sql.FieldGT(FieldVerifiedAt, v))
+}
+
+// VerifiedAtGTE applies the GTE predicate on the "verified_at" field.
+func VerifiedAtGTE(v time.Time) predicate.Domain {
+	return predicate.Domain(sql.FieldGTE(FieldVerifiedAt, v))
+}
+
+// VerifiedAtLT applies the LT predicate on the "verified_at" field.
+func VerifiedAtLT(v time.Time) predicate.Domain {
+	return predicate.Domain(sql.FieldLT(FieldVerifiedAt, v))
+}
+
+// VerifiedAtLTE applies the LTE predicate on the "verified_at" field.
+func VerifiedAtLTE(v time.Time) predicate.Domain {
+	return predicate.Domain(sql.FieldLTE(FieldVerifiedAt, v))
+}
+
+// VerifiedAtIsNil applies the IsNil predicate on the "verified_at" field.
+func VerifiedAtIsNil() predicate.Domain {
+	return predicate.Domain(sql.FieldIsNull(FieldVerifiedAt))
+}
+
+// VerifiedAtNotNil applies the NotNil predicate on the "verified_at" field.
+func VerifiedAtNotNil() predicate.Domain {
+	return predicate.Domain(sql.FieldNotNull(FieldVerifiedAt))
+}
+
 // HasDelegations applies the HasEdge predicate on the "delegations" edge.
 func HasDelegations() predicate.Domain {
 	return predicate.Domain(func(s *sql.Selector) {
@@ -323,6 +543,29 @@ func HasDelegationsWith(preds ...predicate.Delegation) predicate.Domain {
 	})
 }
 
+// HasRequests applies the HasEdge predicate on the "requests" edge.
+func HasRequests() predicate.Domain {
+	return predicate.Domain(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RequestsTable, RequestsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRequestsWith applies the HasEdge predicate on the "requests" edge with a given conditions (other predicates).
+func HasRequestsWith(preds ...predicate.ApprovalRequest) predicate.Domain {
+	return predicate.Domain(func(s *sql.Selector) {
+		step := newRequestsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Domain) predicate.Domain {
 	return predicate.Domain(sql.AndPredicates(predicates...))
@@ -10,6 +10,7 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/hm-edu/domain-rest-interface/ent/approvalrequest"
 	"github.com/hm-edu/domain-rest-interface/ent/delegation"
 	"github.com/hm-edu/domain-rest-interface/ent/domain"
 )
@@ -22,88 +23,165 @@ type DomainCreate struct {
 }
 
 // SetCreateTime sets the "create_time" field.
-func (dc *DomainCreate) SetCreateTime(t time.Time) *DomainCreate {
-	dc.mutation.SetCreateTime(t)
-	return dc
+func (_c *DomainCreate) SetCreateTime(v time.Time) *DomainCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
 }
 
 // SetNillableCreateTime sets the "create_time" field if the given value is not nil.
-func (dc *DomainCreate) SetNillableCreateTime(t *time.Time) *DomainCreate {
-	if t != nil {
-		dc.SetCreateTime(*t)
+func (_c *DomainCreate) SetNillableCreateTime(v *time.Time) *DomainCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
 	}
-	return dc
+	return _c
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (dc *DomainCreate) SetUpdateTime(t time.Time) *DomainCreate {
-	dc.mutation.SetUpdateTime(t)
-	return dc
+func (_c *DomainCreate) SetUpdateTime(v time.Time) *DomainCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
 }
 
 // SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
-func (dc *DomainCreate) SetNillableUpdateTime(t *time.Time) *DomainCreate {
-	if t != nil {
-		dc.SetUpdateTime(*t)
+func (_c *DomainCreate) SetNillableUpdateTime(v *time.Time) *DomainCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
 	}
-	return dc
+	return _c
 }
 
 // SetFqdn sets the "fqdn" field.
-func (dc *DomainCreate) SetFqdn(s string) *DomainCreate {
-	dc.mutation.SetFqdn(s)
-	return dc
+func (_c *DomainCreate) SetFqdn(v string) *DomainCreate {
+	_c.mutation.SetFqdn(v)
+	return _c
 }
 
 // SetOwner sets the "owner" field.
-func (dc *DomainCreate) SetOwner(s string) *DomainCreate {
-	dc.mutation.SetOwner(s)
-	return dc
+func (_c *DomainCreate) SetOwner(v string) *DomainCreate {
+	_c.mutation.SetOwner(v)
+	return _c
 }
 
 // SetApproved sets the "approved" field.
-func (dc *DomainCreate) SetApproved(b bool) *DomainCreate {
-	dc.mutation.SetApproved(b)
-	return dc
+func (_c *DomainCreate) SetApproved(v bool) *DomainCreate {
+	_c.mutation.SetApproved(v)
+	return _c
 }
 
 // SetNillableApproved sets the "approved" field if the given value is not nil.
-func (dc *DomainCreate) SetNillableApproved(b *bool) *DomainCreate {
-	if b != nil {
-		dc.SetApproved(*b)
+func (_c *DomainCreate) SetNillableApproved(v *bool) *DomainCreate {
+	if v != nil {
+		_c.SetApproved(*v)
 	}
-	return dc
+	return _c
+}
+
+// SetContacts sets the "contacts" field.
+func (_c *DomainCreate) SetContacts(v []string) *DomainCreate {
+	_c.mutation.SetContacts(v)
+	return _c
+}
+
+// SetVerificationToken sets the "verification_token" field.
+func (_c *DomainCreate) SetVerificationToken(v string) *DomainCreate {
+	_c.mutation.SetVerificationToken(v)
+	return _c
+}
+
+// SetNillableVerificationToken sets the "verification_token" field if the given value is not nil.
+func (_c *DomainCreate) SetNillableVerificationToken(v *string) *DomainCreate {
+	if v != nil {
+		_c.SetVerificationToken(*v)
+	}
+	return _c
+}
+
+// SetVerificationStatus sets the "verification_status" field.
+func (_c *DomainCreate) SetVerificationStatus(v domain.VerificationStatus) *DomainCreate {
+	_c.mutation.SetVerificationStatus(v)
+	return _c
+}
+
+// SetNillableVerificationStatus sets the "verification_status" field if the given value is not nil.
+func (_c *DomainCreate) SetNillableVerificationStatus(v *domain.VerificationStatus) *DomainCreate {
+	if v != nil {
+		_c.SetVerificationStatus(*v)
+	}
+	return _c
+}
+
+// SetVerificationCheckedAt sets the "verification_checked_at" field.
+func (_c *DomainCreate) SetVerificationCheckedAt(v time.Time) *DomainCreate {
+	_c.mutation.SetVerificationCheckedAt(v)
+	return _c
+}
+
+// SetNillableVerificationCheckedAt sets the "verification_checked_at" field if the given value is not nil.
+func (_c *DomainCreate) SetNillableVerificationCheckedAt(v *time.Time) *DomainCreate {
+	if v != nil {
+		_c.SetVerificationCheckedAt(*v)
+	}
+	return _c
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (_c *DomainCreate) SetVerifiedAt(v time.Time) *DomainCreate {
+	_c.mutation.SetVerifiedAt(v)
+	return _c
+}
+
+// SetNillableVerifiedAt sets the "verified_at" field if the given value is not nil.
+func (_c *DomainCreate) SetNillableVerifiedAt(v *time.Time) *DomainCreate {
+	if v != nil {
+		_c.SetVerifiedAt(*v)
+	}
+	return _c
 }
 
 // AddDelegationIDs adds the "delegations" edge to the Delegation entity by IDs.
-func (dc *DomainCreate) AddDelegationIDs(ids ...int) *DomainCreate {
-	dc.mutation.AddDelegationIDs(ids...)
-	return dc
+func (_c *DomainCreate) AddDelegationIDs(ids ...int) *DomainCreate {
+	_c.mutation.AddDelegationIDs(ids...)
+	return _c
 }
 
 // AddDelegations adds the "delegations" edges to the Delegation entity.
-func (dc *DomainCreate) AddDelegations(d ...*Delegation) *DomainCreate {
-	ids := make([]int, len(d))
-	for i := range d {
-		ids[i] = d[i].ID
+func (_c *DomainCreate) AddDelegations(v ...*Delegation) *DomainCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	return dc.AddDelegationIDs(ids...)
+	return _c.AddDelegationIDs(ids...)
+}
+
+// AddRequestIDs adds the "requests" edge to the ApprovalRequest entity by IDs.
+func (_c *DomainCreate) AddRequestIDs(ids ...int) *DomainCreate {
+	_c.mutation.AddRequestIDs(ids...)
+	return _c
+}
+
+// AddRequests adds the "requests" edges to the ApprovalRequest entity.
+func (_c *DomainCreate) AddRequests(v ...*ApprovalRequest) *DomainCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRequestIDs(ids...)
 }
 
 // Mutation returns the DomainMutation object of the builder.
-func (dc *DomainCreate) Mutation() *DomainMutation {
-	return dc.mutation
+func (_c *DomainCreate) Mutation() *DomainMutation {
+	return _c.mutation
 }
 
 // Save creates the Domain in the database.
-func (dc *DomainCreate) Save(ctx context.Context) (*Domain, error) {
-	dc.defaults()
-	return withHooks(ctx, dc.sqlSave, dc.mutation, dc.hooks)
+func (_c *DomainCreate) Save(ctx context.Context) (*Domain, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
 // SaveX calls Save and panics if Save returns an error.
-func (dc *DomainCreate) SaveX(ctx context.Context) *Domain {
-	v, err := dc.Save(ctx)
+func (_c *DomainCreate) SaveX(ctx context.Context) *Domain {
+	v, err := _c.Save(ctx)
 	if err != nil {
 		panic(err)
 	}
@@ -111,70 +189,82 @@ func (dc *DomainCreate) SaveX(ctx context.Context) *Domain {
 }
 
 // Exec executes the query.
-func (dc *DomainCreate) Exec(ctx context.Context) error {
-	_, err := dc.Save(ctx)
+func (_c *DomainCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (dc *DomainCreate) ExecX(ctx context.Context) {
-	if err := dc.Exec(ctx); err != nil {
+func (_c *DomainCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
 
 // defaults sets the default values of the builder before save.
-func (dc *DomainCreate) defaults() {
-	if _, ok := dc.mutation.CreateTime(); !ok {
+func (_c *DomainCreate) defaults() {
+	if _, ok := _c.mutation.CreateTime(); !ok {
 		v := domain.DefaultCreateTime()
-		dc.mutation.SetCreateTime(v)
+		_c.mutation.SetCreateTime(v)
 	}
-	if _, ok := dc.mutation.UpdateTime(); !ok {
+	if _, ok := _c.mutation.UpdateTime(); !ok {
 		v := domain.DefaultUpdateTime()
-		dc.mutation.SetUpdateTime(v)
+		_c.mutation.SetUpdateTime(v)
 	}
-	if _, ok := dc.mutation.Approved(); !ok {
+	if _, ok := _c.mutation.Approved(); !ok {
 		v := domain.DefaultApproved
-		dc.mutation.SetApproved(v)
+		_c.mutation.SetApproved(v)
+	}
+	if _, ok := _c.mutation.VerificationStatus(); !ok {
+		v := domain.DefaultVerificationStatus
+		_c.mutation.SetVerificationStatus(v)
 	}
 }
 
 // check runs all checks and user-defined validators on the builder.
-func (dc *DomainCreate) check() error {
-	if _, ok := dc.mutation.CreateTime(); !ok {
+func (_c *DomainCreate) check() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
 		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "Domain.create_time"`)}
 	}
-	if _, ok := dc.mutation.UpdateTime(); !ok {
+	if _, ok := _c.mutation.UpdateTime(); !ok {
 		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "Domain.update_time"`)}
 	}
-	if _, ok := dc.mutation.Fqdn(); !ok {
+	if _, ok := _c.mutation.Fqdn(); !ok {
 		return &ValidationError{Name: "fqdn", err: errors.New(`ent: missing required field "Domain.fqdn"`)}
 	}
-	if v, ok := dc.mutation.Fqdn(); ok {
+	if v, ok := _c.mutation.Fqdn(); ok {
 		if err := domain.FqdnValidator(v); err != nil {
 			return &ValidationError{Name: "fqdn", err: fmt.Errorf(`ent: validator failed for field "Domain.fqdn": %w`, err)}
 		}
 	}
-	if _, ok := dc.mutation.Owner(); !ok {
+	if _, ok := _c.mutation.Owner(); !ok {
 		return &ValidationError{Name: "owner", err: errors.New(`ent: missing required field "Domain.owner"`)}
 	}
-	if v, ok := dc.mutation.Owner(); ok {
+	if v, ok := _c.mutation.Owner(); ok {
 		if err := domain.OwnerValidator(v); err != nil {
 			return &ValidationError{Name: "owner", err: fmt.Errorf(`ent: validator failed for field "Domain.owner": %w`, err)}
 		}
 	}
-	if _, ok := dc.mutation.Approved(); !ok {
+	if _, ok := _c.mutation.Approved(); !ok {
 		return &ValidationError{Name: "approved", err: errors.New(`ent: missing required field "Domain.approved"`)}
 	}
+	if _, ok := _c.mutation.VerificationStatus(); !ok {
+		return &ValidationError{Name: "verification_status", err: errors.New(`ent: missing required field "Domain.verification_status"`)}
+	}
+	if v, ok := _c.mutation.VerificationStatus(); ok {
+		if err := domain.VerificationStatusValidator(v); err != nil {
+			return &ValidationError{Name: "verification_status", err: fmt.Errorf(`ent: validator failed for field "Domain.verification_status": %w`, err)}
+		}
+	}
 	return nil
 }
 
-func (dc *DomainCreate) sqlSave(ctx context.Context) (*Domain, error) {
-	if err := dc.check(); err != nil {
+func (_c *DomainCreate) sqlSave(ctx context.Context) (*Domain, error) {
+	if err := _c.check(); err != nil {
 		return nil, err
 	}
-	_node, _spec := dc.createSpec()
-	if err := sqlgraph.CreateNode(ctx, dc.driver, _spec); err != nil {
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -182,37 +272,57 @@ func (dc *DomainCreate) sqlSave(ctx context.Context) (*Domain, error) {
 	}
 	id := _spec.ID.Value.(int64)
 	_node.ID = int(id)
-	dc.mutation.id = &_node.ID
-	dc.mutation.done = true
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
 	return _node, nil
 }
 
-func (dc *DomainCreate) createSpec() (*Domain, *sqlgraph.CreateSpec) {
+func (_c *DomainCreate) createSpec() (*Domain, *sqlgraph.CreateSpec) {
 	var (
-		_node = &Domain{config: dc.config}
+		_node = &Domain{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(domain.Table, sqlgraph.NewFieldSpec(domain.FieldID, field.TypeInt))
 	)
-	if value, ok := dc.mutation.CreateTime(); ok {
+	if value, ok := _c.mutation.CreateTime(); ok {
 		_spec.SetField(domain.FieldCreateTime, field.TypeTime, value)
 		_node.CreateTime = value
 	}
-	if value, ok := dc.mutation.UpdateTime(); ok {
+	if value, ok := _c.mutation.UpdateTime(); ok {
 		_spec.SetField(domain.FieldUpdateTime, field.TypeTime, value)
 		_node.UpdateTime = value
 	}
-	if value, ok := dc.mutation.Fqdn(); ok {
+	if value, ok := _c.mutation.Fqdn(); ok {
 		_spec.SetField(domain.FieldFqdn, field.TypeString, value)
 		_node.Fqdn = value
 	}
-	if value, ok := dc.mutation.Owner(); ok {
+	if value, ok := _c.mutation.Owner(); ok {
 		_spec.SetField(domain.FieldOwner, field.TypeString, value)
 		_node.Owner = value
 	}
-	if value, ok := dc.mutation.Approved(); ok {
+	if value, ok := _c.mutation.Approved(); ok {
 		_spec.SetField(domain.FieldApproved, field.TypeBool, value)
 		_node.Approved = value
 	}
-	if nodes := dc.mutation.DelegationsIDs(); len(nodes) > 0 {
+	if value, ok := _c.mutation.Contacts(); ok {
+		_spec.SetField(domain.FieldContacts, field.TypeJSON, value)
+		_node.Contacts = value
+	}
+	if value, ok := _c.mutation.VerificationToken(); ok {
+		_spec.SetField(domain.FieldVerificationToken, field.TypeString, value)
+		_node.VerificationToken = &value
+	}
+	if value, ok := _c.mutation.VerificationStatus(); ok {
+		_spec.SetField(domain.FieldVerificationStatus, field.TypeEnum, value)
+		_node.VerificationStatus = value
+	}
+	if value, ok := _c.mutation.VerificationCheckedAt(); ok {
+		_spec.SetField(domain.FieldVerificationCheckedAt, field.TypeTime, value)
+		_node.VerificationCheckedAt = &value
+	}
+	if value, ok := _c.mutation.VerifiedAt(); ok {
+		_spec.SetField(domain.FieldVerifiedAt, field.TypeTime, value)
+		_node.VerifiedAt = &value
+	}
+	if nodes := _c.mutation.DelegationsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
@@ -228,6 +338,22 @@ func (dc *DomainCreate) createSpec() (*Domain, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.RequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   domain.RequestsTable,
+			Columns: []string{domain.RequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(approvalrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -239,16 +365,16 @@ type DomainCreateBulk struct {
 }
 
 // Save creates the Domain entities in the database.
-func (dcb *DomainCreateBulk) Save(ctx context.Context) ([]*Domain, error) {
-	if dcb.err != nil {
-		return nil, dcb.err
-	}
-	specs := make([]*sqlgraph.CreateSpec, len(dcb.builders))
-	nodes := make([]*Domain, len(dcb.builders))
-	mutators := make([]Mutator, len(dcb.builders))
-	for i := range dcb.builders {
+func (_c *DomainCreateBulk) Save(ctx context.Context) ([]*Domain, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Domain, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
 		func(i int, root context.Context) {
-			builder := dcb.builders[i]
+			builder := _c.builders[i]
 			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*DomainMutation)
@@ -262,11 +388,11 @@ func (dcb *DomainCreateBulk) Save(ctx context.Context) ([]*Domain, error) {
 				var err error
 				nodes[i], specs[i] = builder.createSpec()
 				if i < len(mutators)-1 {
-					_, err = mutators[i+1].Mutate(root, dcb.builders[i+1].mutation)
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
 					// Invoke the actual operation on the latest mutation in the chain.
-					if err = sqlgraph.BatchCreate(ctx, dcb.driver, spec); err != nil {
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
 							err = &ConstraintError{msg: err.Error(), wrap: err}
 						}
@@ -290,7 +416,7 @@ func (dcb *DomainCreateBulk) Save(ctx context.Context) ([]*Domain, error) {
 		}(i, ctx)
 	}
 	if len(mutators) > 0 {
-		if _, err := mutators[0].Mutate(ctx, dcb.builders[0].mutation); err != nil {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
 			return nil, err
 		}
 	}
@@ -298,8 +424,8 @@ func (dcb *DomainCreateBulk) Save(ctx context.Context) ([]*Domain, error) {
 }
 
 // SaveX is like Save, but panics if an error occurs.
-func (dcb *DomainCreateBulk) SaveX(ctx context.Context) []*Domain {
-	v, err := dcb.Save(ctx)
+func (_c *DomainCreateBulk) SaveX(ctx context.Context) []*Domain {
+	v, err := _c.Save(ctx)
 	if err != nil {
 		panic(err)
 	}
@@ -307,14 +433,14 @@ func (dcb *DomainCreateBulk) SaveX(ctx context.Context) []*Domain {
 }
 
 // Exec executes the query.
-func (dcb *DomainCreateBulk) Exec(ctx context.Context) error {
-	_, err := dcb.Save(ctx)
+func (_c *DomainCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (dcb *DomainCreateBulk) ExecX(ctx context.Context) {
-	if err := dcb.Exec(ctx); err != nil {
+func (_c *DomainCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
@@ -10,7 +10,9 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
+	"github.com/hm-edu/domain-rest-interface/ent/approvalrequest"
 	"github.com/hm-edu/domain-rest-interface/ent/delegation"
 	"github.com/hm-edu/domain-rest-interface/ent/domain"
 	"github.com/hm-edu/domain-rest-interface/ent/predicate"
@@ -24,109 +26,237 @@ type DomainUpdate struct {
 }
 
 // Where appends a list predicates to the DomainUpdate builder.
-func (du *DomainUpdate) Where(ps ...predicate.Domain) *DomainUpdate {
-	du.mutation.Where(ps...)
-	return du
+func (_u *DomainUpdate) Where(ps ...predicate.Domain) *DomainUpdate {
+	_u.mutation.Where(ps...)
+	return _u
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (du *DomainUpdate) SetUpdateTime(t time.Time) *DomainUpdate {
-	du.mutation.SetUpdateTime(t)
-	return du
+func (_u *DomainUpdate) SetUpdateTime(v time.Time) *DomainUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
 }
 
 // SetFqdn sets the "fqdn" field.
-func (du *DomainUpdate) SetFqdn(s string) *DomainUpdate {
-	du.mutation.SetFqdn(s)
-	return du
+func (_u *DomainUpdate) SetFqdn(v string) *DomainUpdate {
+	_u.mutation.SetFqdn(v)
+	return _u
 }
 
 // SetNillableFqdn sets the "fqdn" field if the given value is not nil.
-func (du *DomainUpdate) SetNillableFqdn(s *string) *DomainUpdate {
-	if s != nil {
-		du.SetFqdn(*s)
+func (_u *DomainUpdate) SetNillableFqdn(v *string) *DomainUpdate {
+	if v != nil {
+		_u.SetFqdn(*v)
 	}
-	return du
+	return _u
 }
 
 // SetOwner sets the "owner" field.
-func (du *DomainUpdate) SetOwner(s string) *DomainUpdate {
-	du.mutation.SetOwner(s)
-	return du
+func (_u *DomainUpdate) SetOwner(v string) *DomainUpdate {
+	_u.mutation.SetOwner(v)
+	return _u
 }
 
 // SetNillableOwner sets the "owner" field if the given value is not nil.
-func (du *DomainUpdate) SetNillableOwner(s *string) *DomainUpdate {
-	if s != nil {
-		du.SetOwner(*s)
+func (_u *DomainUpdate) SetNillableOwner(v *string) *DomainUpdate {
+	if v != nil {
+		_u.SetOwner(*v)
 	}
-	return du
+	return _u
 }
 
 // SetApproved sets the "approved" field.
-func (du *DomainUpdate) SetApproved(b bool) *DomainUpdate {
-	du.mutation.SetApproved(b)
-	return du
+func (_u *DomainUpdate) SetApproved(v bool) *DomainUpdate {
+	_u.mutation.SetApproved(v)
+	return _u
 }
 
 // SetNillableApproved sets the "approved" field if the given value is not nil.
-func (du *DomainUpdate) SetNillableApproved(b *bool) *DomainUpdate {
-	if b != nil {
-		du.SetApproved(*b)
+func (_u *DomainUpdate) SetNillableApproved(v *bool) *DomainUpdate {
+	if v != nil {
+		_u.SetApproved(*v)
 	}
-	return du
+	return _u
+}
+
+// SetContacts sets the "contacts" field.
+func (_u *DomainUpdate) SetContacts(v []string) *DomainUpdate {
+	_u.mutation.SetContacts(v)
+	return _u
+}
+
+// AppendContacts appends value to the "contacts" field.
+func (_u *DomainUpdate) AppendContacts(v []string) *DomainUpdate {
+	_u.mutation.AppendContacts(v)
+	return _u
+}
+
+// ClearContacts clears the value of the "contacts" field.
+func (_u *DomainUpdate) ClearContacts() *DomainUpdate {
+	_u.mutation.ClearContacts()
+	return _u
+}
+
+// SetVerificationToken sets the "verification_token" field.
+func (_u *DomainUpdate) SetVerificationToken(v string) *DomainUpdate {
+	_u.mutation.SetVerificationToken(v)
+	return _u
+}
+
+// SetNillableVerificationToken sets the "verification_token" field if the given value is not nil.
+func (_u *DomainUpdate) SetNillableVerificationToken(v *string) *DomainUpdate {
+	if v != nil {
+		_u.SetVerificationToken(*v)
+	}
+	return _u
+}
+
+// ClearVerificationToken clears the value of the "verification_token" field.
+func (_u *DomainUpdate) ClearVerificationToken() *DomainUpdate {
+	_u.mutation.ClearVerificationToken()
+	return _u
+}
+
+// SetVerificationStatus sets the "verification_status" field.
+func (_u *DomainUpdate) SetVerificationStatus(v domain.VerificationStatus) *DomainUpdate {
+	_u.mutation.SetVerificationStatus(v)
+	return _u
+}
+
+// SetNillableVerificationStatus sets the "verification_status" field if the given value is not nil.
+func (_u *DomainUpdate) SetNillableVerificationStatus(v *domain.VerificationStatus) *DomainUpdate {
+	if v != nil {
+		_u.SetVerificationStatus(*v)
+	}
+	return _u
+}
+
+// SetVerificationCheckedAt sets the "verification_checked_at" field.
+func (_u *DomainUpdate) SetVerificationCheckedAt(v time.Time) *DomainUpdate {
+	_u.mutation.SetVerificationCheckedAt(v)
+	return _u
+}
+
+// SetNillableVerificationCheckedAt sets the "verification_checked_at" field if the given value is not nil.
+func (_u *DomainUpdate) SetNillableVerificationCheckedAt(v *time.Time) *DomainUpdate {
+	if v != nil {
+		_u.SetVerificationCheckedAt(*v)
+	}
+	return _u
+}
+
+// ClearVerificationCheckedAt clears the value of the "verification_checked_at" field.
+func (_u *DomainUpdate) ClearVerificationCheckedAt() *DomainUpdate {
+	_u.mutation.ClearVerificationCheckedAt()
+	return _u
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (_u *DomainUpdate) SetVerifiedAt(v time.Time) *DomainUpdate {
+	_u.mutation.SetVerifiedAt(v)
+	return _u
+}
+
+// SetNillableVerifiedAt sets the "verified_at" field if the given value is not nil.
+func (_u *DomainUpdate) SetNillableVerifiedAt(v *time.Time) *DomainUpdate {
+	if v != nil {
+		_u.SetVerifiedAt(*v)
+	}
+	return _u
+}
+
+// ClearVerifiedAt clears the value of the "verified_at" field.
+func (_u *DomainUpdate) ClearVerifiedAt() *DomainUpdate {
+	_u.mutation.ClearVerifiedAt()
+	return _u
 }
 
 // AddDelegationIDs adds the "delegations" edge to the Delegation entity by IDs.
-func (du *DomainUpdate) AddDelegationIDs(ids ...int) *DomainUpdate {
-	du.mutation.AddDelegationIDs(ids...)
-	return du
+func (_u *DomainUpdate) AddDelegationIDs(ids ...int) *DomainUpdate {
+	_u.mutation.AddDelegationIDs(ids...)
+	return _u
 }
 
 // AddDelegations adds the "delegations" edges to the Delegation entity.
-func (du *DomainUpdate) AddDelegations(d ...*Delegation) *DomainUpdate {
-	ids := make([]int, len(d))
-	for i := range d {
-		ids[i] = d[i].ID
+func (_u *DomainUpdate) AddDelegations(v ...*Delegation) *DomainUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDelegationIDs(ids...)
+}
+
+// AddRequestIDs adds the "requests" edge to the ApprovalRequest entity by IDs.
+func (_u *DomainUpdate) AddRequestIDs(ids ...int) *DomainUpdate {
+	_u.mutation.AddRequestIDs(ids...)
+	return _u
+}
+
+// AddRequests adds the "requests" edges to the ApprovalRequest entity.
+func (_u *DomainUpdate) AddRequests(v ...*ApprovalRequest) *DomainUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	return du.AddDelegationIDs(ids...)
+	return _u.AddRequestIDs(ids...)
 }
 
 // Mutation returns the DomainMutation object of the builder.
-func (du *DomainUpdate) Mutation() *DomainMutation {
-	return du.mutation
+func (_u *DomainUpdate) Mutation() *DomainMutation {
+	return _u.mutation
 }
 
 // ClearDelegations clears all "delegations" edges to the Delegation entity.
-func (du *DomainUpdate) ClearDelegations() *DomainUpdate {
-	du.mutation.ClearDelegations()
-	return du
+func (_u *DomainUpdate) ClearDelegations() *DomainUpdate {
+	_u.mutation.ClearDelegations()
+	return _u
 }
 
 // RemoveDelegationIDs removes the "delegations" edge to Delegation entities by IDs.
-func (du *DomainUpdate) RemoveDelegationIDs(ids ...int) *DomainUpdate {
-	du.mutation.RemoveDelegationIDs(ids...)
-	return du
+func (_u *DomainUpdate) RemoveDelegationIDs(ids ...int) *DomainUpdate {
+	_u.mutation.RemoveDelegationIDs(ids...)
+	return _u
 }
 
 // RemoveDelegations removes "delegations" edges to Delegation entities.
-func (du *DomainUpdate) RemoveDelegations(d ...*Delegation) *DomainUpdate {
-	ids := make([]int, len(d))
-	for i := range d {
-		ids[i] = d[i].ID
+func (_u *DomainUpdate) RemoveDelegations(v ...*Delegation) *DomainUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	return du.RemoveDelegationIDs(ids...)
+	return _u.RemoveDelegationIDs(ids...)
+}
+
+// ClearRequests clears all "requests" edges to the ApprovalRequest entity.
+func (_u *DomainUpdate) ClearRequests() *DomainUpdate {
+	_u.mutation.ClearRequests()
+	return _u
+}
+
+// RemoveRequestIDs removes the "requests" edge to ApprovalRequest entities by IDs.
+func (_u *DomainUpdate) RemoveRequestIDs(ids ...int) *DomainUpdate {
+	_u.mutation.RemoveRequestIDs(ids...)
+	return _u
+}
+
+// RemoveRequests removes "requests" edges to ApprovalRequest entities.
+func (_u *DomainUpdate) RemoveRequests(v ...*ApprovalRequest) *DomainUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRequestIDs(ids...)
 }
 
 // Save executes the query and returns the number of nodes affected by the update operation.
-func (du *DomainUpdate) Save(ctx context.Context) (int, error) {
-	du.defaults()
-	return withHooks(ctx, du.sqlSave, du.mutation, du.hooks)
+func (_u *DomainUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
 // SaveX is like Save, but panics if an error occurs.
-func (du *DomainUpdate) SaveX(ctx context.Context) int {
-	affected, err := du.Save(ctx)
+func (_u *DomainUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
 	if err != nil {
 		panic(err)
 	}
@@ -134,66 +264,103 @@ func (du *DomainUpdate) SaveX(ctx context.Context) int {
 }
 
 // Exec executes the query.
-func (du *DomainUpdate) Exec(ctx context.Context) error {
-	_, err := du.Save(ctx)
+func (_u *DomainUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (du *DomainUpdate) ExecX(ctx context.Context) {
-	if err := du.Exec(ctx); err != nil {
+func (_u *DomainUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
 
 // defaults sets the default values of the builder before save.
-func (du *DomainUpdate) defaults() {
-	if _, ok := du.mutation.UpdateTime(); !ok {
+func (_u *DomainUpdate) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
 		v := domain.UpdateDefaultUpdateTime()
-		du.mutation.SetUpdateTime(v)
+		_u.mutation.SetUpdateTime(v)
 	}
 }
 
 // check runs all checks and user-defined validators on the builder.
-func (du *DomainUpdate) check() error {
-	if v, ok := du.mutation.Fqdn(); ok {
+func (_u *DomainUpdate) check() error {
+	if v, ok := _u.mutation.Fqdn(); ok {
 		if err := domain.FqdnValidator(v); err != nil {
 			return &ValidationError{Name: "fqdn", err: fmt.Errorf(`ent: validator failed for field "Domain.fqdn": %w`, err)}
 		}
 	}
-	if v, ok := du.mutation.Owner(); ok {
+	if v, ok := _u.mutation.Owner(); ok {
 		if err := domain.OwnerValidator(v); err != nil {
 			return &ValidationError{Name: "owner", err: fmt.Errorf(`ent: validator failed for field "Domain.owner": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.VerificationStatus(); ok {
+		if err := domain.VerificationStatusValidator(v); err != nil {
+			return &ValidationError{Name: "verification_status", err: fmt.Errorf(`ent: validator failed for field "Domain.verification_status": %w`, err)}
+		}
+	}
 	return nil
 }
 
-func (du *DomainUpdate) sqlSave(ctx context.Context) (n int, err error) {
-	if err := du.check(); err != nil {
-		return n, err
+func (_u *DomainUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
 	}
 	_spec := sqlgraph.NewUpdateSpec(domain.Table, domain.Columns, sqlgraph.NewFieldSpec(domain.FieldID, field.TypeInt))
-	if ps := du.mutation.predicates; len(ps) > 0 {
+	if ps := _u.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
 				ps[i](selector)
 			}
 		}
 	}
-	if value, ok := du.mutation.UpdateTime(); ok {
+	if value, ok := _u.mutation.UpdateTime(); ok {
 		_spec.SetField(domain.FieldUpdateTime, field.TypeTime, value)
 	}
-	if value, ok := du.mutation.Fqdn(); ok {
+	if value, ok := _u.mutation.Fqdn(); ok {
 		_spec.SetField(domain.FieldFqdn, field.TypeString, value)
 	}
-	if value, ok := du.mutation.Owner(); ok {
+	if value, ok := _u.mutation.Owner(); ok {
 		_spec.SetField(domain.FieldOwner, field.TypeString, value)
 	}
-	if value, ok := du.mutation.Approved(); ok {
+	if value, ok := _u.mutation.Approved(); ok {
 		_spec.SetField(domain.FieldApproved, field.TypeBool, value)
 	}
-	if du.mutation.DelegationsCleared() {
+	if value, ok := _u.mutation.Contacts(); ok {
+		_spec.SetField(domain.FieldContacts, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedContacts(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, domain.FieldContacts, value)
+		})
+	}
+	if _u.mutation.ContactsCleared() {
+		_spec.ClearField(domain.FieldContacts, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.VerificationToken(); ok {
+		_spec.SetField(domain.FieldVerificationToken, field.TypeString, value)
+	}
+	if _u.mutation.VerificationTokenCleared() {
+		_spec.ClearField(domain.FieldVerificationToken, field.TypeString)
+	}
+	if value, ok := _u.mutation.VerificationStatus(); ok {
+		_spec.SetField(domain.FieldVerificationStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.VerificationCheckedAt(); ok {
+		_spec.SetField(domain.FieldVerificationCheckedAt, field.TypeTime, value)
+	}
+	if _u.mutation.VerificationCheckedAtCleared() {
+		_spec.ClearField(domain.FieldVerificationCheckedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.VerifiedAt(); ok {
+		_spec.SetField(domain.FieldVerifiedAt, field.TypeTime, value)
+	}
+	if _u.mutation.VerifiedAtCleared() {
+		_spec.ClearField(domain.FieldVerifiedAt, field.TypeTime)
+	}
+	if _u.mutation.DelegationsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
@@ -206,7 +373,7 @@ func (du *DomainUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := du.mutation.RemovedDelegationsIDs(); len(nodes) > 0 && !du.mutation.DelegationsCleared() {
+	if nodes := _u.mutation.RemovedDelegationsIDs(); len(nodes) > 0 && !_u.mutation.DelegationsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
@@ -222,7 +389,7 @@ func (du *DomainUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := du.mutation.DelegationsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.DelegationsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
@@ -238,7 +405,52 @@ func (du *DomainUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, du.driver, _spec); err != nil {
+	if _u.mutation.RequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   domain.RequestsTable,
+			Columns: []string{domain.RequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(approvalrequest.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRequestsIDs(); len(nodes) > 0 && !_u.mutation.RequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   domain.RequestsTable,
+			Columns: []string{domain.RequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(approvalrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   domain.RequestsTable,
+			Columns: []string{domain.RequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(approvalrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{domain.Label}
 		} else if sqlgraph.IsConstraintError(err) {
@@ -246,8 +458,8 @@ func (du *DomainUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		return 0, err
 	}
-	du.mutation.done = true
-	return n, nil
+	_u.mutation.done = true
+	return _node, nil
 }
 
 // DomainUpdateOne is the builder for updating a single Domain entity.
@@ -259,116 +471,244 @@ type DomainUpdateOne struct {
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (duo *DomainUpdateOne) SetUpdateTime(t time.Time) *DomainUpdateOne {
-	duo.mutation.SetUpdateTime(t)
-	return duo
+func (_u *DomainUpdateOne) SetUpdateTime(v time.Time) *DomainUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
 }
 
 // SetFqdn sets the "fqdn" field.
-func (duo *DomainUpdateOne) SetFqdn(s string) *DomainUpdateOne {
-	duo.mutation.SetFqdn(s)
-	return duo
+func (_u *DomainUpdateOne) SetFqdn(v string) *DomainUpdateOne {
+	_u.mutation.SetFqdn(v)
+	return _u
 }
 
 // SetNillableFqdn sets the "fqdn" field if the given value is not nil.
-func (duo *DomainUpdateOne) SetNillableFqdn(s *string) *DomainUpdateOne {
-	if s != nil {
-		duo.SetFqdn(*s)
+func (_u *DomainUpdateOne) SetNillableFqdn(v *string) *DomainUpdateOne {
+	if v != nil {
+		_u.SetFqdn(*v)
 	}
-	return duo
+	return _u
 }
 
 // SetOwner sets the "owner" field.
-func (duo *DomainUpdateOne) SetOwner(s string) *DomainUpdateOne {
-	duo.mutation.SetOwner(s)
-	return duo
+func (_u *DomainUpdateOne) SetOwner(v string) *DomainUpdateOne {
+	_u.mutation.SetOwner(v)
+	return _u
 }
 
 // SetNillableOwner sets the "owner" field if the given value is not nil.
-func (duo *DomainUpdateOne) SetNillableOwner(s *string) *DomainUpdateOne {
-	if s != nil {
-		duo.SetOwner(*s)
+func (_u *DomainUpdateOne) SetNillableOwner(v *string) *DomainUpdateOne {
+	if v != nil {
+		_u.SetOwner(*v)
 	}
-	return duo
+	return _u
 }
 
 // SetApproved sets the "approved" field.
-func (duo *DomainUpdateOne) SetApproved(b bool) *DomainUpdateOne {
-	duo.mutation.SetApproved(b)
-	return duo
+func (_u *DomainUpdateOne) SetApproved(v bool) *DomainUpdateOne {
+	_u.mutation.SetApproved(v)
+	return _u
 }
 
 // SetNillableApproved sets the "approved" field if the given value is not nil.
-func (duo *DomainUpdateOne) SetNillableApproved(b *bool) *DomainUpdateOne {
-	if b != nil {
-		duo.SetApproved(*b)
+func (_u *DomainUpdateOne) SetNillableApproved(v *bool) *DomainUpdateOne {
+	if v != nil {
+		_u.SetApproved(*v)
+	}
+	return _u
+}
+
+// SetContacts sets the "contacts" field.
+func (_u *DomainUpdateOne) SetContacts(v []string) *DomainUpdateOne {
+	_u.mutation.SetContacts(v)
+	return _u
+}
+
+// AppendContacts appends value to the "contacts" field.
+func (_u *DomainUpdateOne) AppendContacts(v []string) *DomainUpdateOne {
+	_u.mutation.AppendContacts(v)
+	return _u
+}
+
+// ClearContacts clears the value of the "contacts" field.
+func (_u *DomainUpdateOne) ClearContacts() *DomainUpdateOne {
+	_u.mutation.ClearContacts()
+	return _u
+}
+
+// SetVerificationToken sets the "verification_token" field.
+func (_u *DomainUpdateOne) SetVerificationToken(v string) *DomainUpdateOne {
+	_u.mutation.SetVerificationToken(v)
+	return _u
+}
+
+// SetNillableVerificationToken sets the "verification_token" field if the given value is not nil.
+func (_u *DomainUpdateOne) SetNillableVerificationToken(v *string) *DomainUpdateOne {
+	if v != nil {
+		_u.SetVerificationToken(*v)
 	}
-	return duo
+	return _u
+}
+
+// ClearVerificationToken clears the value of the "verification_token" field.
+func (_u *DomainUpdateOne) ClearVerificationToken() *DomainUpdateOne {
+	_u.mutation.ClearVerificationToken()
+	return _u
+}
+
+// SetVerificationStatus sets the "verification_status" field.
+func (_u *DomainUpdateOne) SetVerificationStatus(v domain.VerificationStatus) *DomainUpdateOne {
+	_u.mutation.SetVerificationStatus(v)
+	return _u
+}
+
+// SetNillableVerificationStatus sets the "verification_status" field if the given value is not nil.
+func (_u *DomainUpdateOne) SetNillableVerificationStatus(v *domain.VerificationStatus) *DomainUpdateOne {
+	if v != nil {
+		_u.SetVerificationStatus(*v)
+	}
+	return _u
+}
+
+// SetVerificationCheckedAt sets the "verification_checked_at" field.
+func (_u *DomainUpdateOne) SetVerificationCheckedAt(v time.Time) *DomainUpdateOne {
+	_u.mutation.SetVerificationCheckedAt(v)
+	return _u
+}
+
+// SetNillableVerificationCheckedAt sets the "verification_checked_at" field if the given value is not nil.
+func (_u *DomainUpdateOne) SetNillableVerificationCheckedAt(v *time.Time) *DomainUpdateOne {
+	if v != nil {
+		_u.SetVerificationCheckedAt(*v)
+	}
+	return _u
+}
+
+// ClearVerificationCheckedAt clears the value of the "verification_checked_at" field.
+func (_u *DomainUpdateOne) ClearVerificationCheckedAt() *DomainUpdateOne {
+	_u.mutation.ClearVerificationCheckedAt()
+	return _u
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (_u *DomainUpdateOne) SetVerifiedAt(v time.Time) *DomainUpdateOne {
+	_u.mutation.SetVerifiedAt(v)
+	return _u
+}
+
+// SetNillableVerifiedAt sets the "verified_at" field if the given value is not nil.
+func (_u *DomainUpdateOne) SetNillableVerifiedAt(v *time.Time) *DomainUpdateOne {
+	if v != nil {
+		_u.SetVerifiedAt(*v)
+	}
+	return _u
+}
+
+// ClearVerifiedAt clears the value of the "verified_at" field.
+func (_u *DomainUpdateOne) ClearVerifiedAt() *DomainUpdateOne {
+	_u.mutation.ClearVerifiedAt()
+	return _u
 }
 
 // AddDelegationIDs adds the "delegations" edge to the Delegation entity by IDs.
-func (duo *DomainUpdateOne) AddDelegationIDs(ids ...int) *DomainUpdateOne {
-	duo.mutation.AddDelegationIDs(ids...)
-	return duo
+func (_u *DomainUpdateOne) AddDelegationIDs(ids ...int) *DomainUpdateOne {
+	_u.mutation.AddDelegationIDs(ids...)
+	return _u
 }
 
 // AddDelegations adds the "delegations" edges to the Delegation entity.
-func (duo *DomainUpdateOne) AddDelegations(d ...*Delegation) *DomainUpdateOne {
-	ids := make([]int, len(d))
-	for i := range d {
-		ids[i] = d[i].ID
+func (_u *DomainUpdateOne) AddDelegations(v ...*Delegation) *DomainUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDelegationIDs(ids...)
+}
+
+// AddRequestIDs adds the "requests" edge to the ApprovalRequest entity by IDs.
+func (_u *DomainUpdateOne) AddRequestIDs(ids ...int) *DomainUpdateOne {
+	_u.mutation.AddRequestIDs(ids...)
+	return _u
+}
+
+// AddRequests adds the "requests" edges to the ApprovalRequest entity.
+func (_u *DomainUpdateOne) AddRequests(v ...*ApprovalRequest) *DomainUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	return duo.AddDelegationIDs(ids...)
+	return _u.AddRequestIDs(ids...)
 }
 
 // Mutation returns the DomainMutation object of the builder.
-func (duo *DomainUpdateOne) Mutation() *DomainMutation {
-	return duo.mutation
+func (_u *DomainUpdateOne) Mutation() *DomainMutation {
+	return _u.mutation
 }
 
 // ClearDelegations clears all "delegations" edges to the Delegation entity.
-func (duo *DomainUpdateOne) ClearDelegations() *DomainUpdateOne {
-	duo.mutation.ClearDelegations()
-	return duo
+func (_u *DomainUpdateOne) ClearDelegations() *DomainUpdateOne {
+	_u.mutation.ClearDelegations()
+	return _u
 }
 
 // RemoveDelegationIDs removes the "delegations" edge to Delegation entities by IDs.
-func (duo *DomainUpdateOne) RemoveDelegationIDs(ids ...int) *DomainUpdateOne {
-	duo.mutation.RemoveDelegationIDs(ids...)
-	return duo
+func (_u *DomainUpdateOne) RemoveDelegationIDs(ids ...int) *DomainUpdateOne {
+	_u.mutation.RemoveDelegationIDs(ids...)
+	return _u
 }
 
 // RemoveDelegations removes "delegations" edges to Delegation entities.
-func (duo *DomainUpdateOne) RemoveDelegations(d ...*Delegation) *DomainUpdateOne {
-	ids := make([]int, len(d))
-	for i := range d {
-		ids[i] = d[i].ID
+func (_u *DomainUpdateOne) RemoveDelegations(v ...*Delegation) *DomainUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDelegationIDs(ids...)
+}
+
+// ClearRequests clears all "requests" edges to the ApprovalRequest entity.
+func (_u *DomainUpdateOne) ClearRequests() *DomainUpdateOne {
+	_u.mutation.ClearRequests()
+	return _u
+}
+
+// RemoveRequestIDs removes the "requests" edge to ApprovalRequest entities by IDs.
+func (_u *DomainUpdateOne) RemoveRequestIDs(ids ...int) *DomainUpdateOne {
+	_u.mutation.RemoveRequestIDs(ids...)
+	return _u
+}
+
+// RemoveRequests removes "requests" edges to ApprovalRequest entities.
+func (_u *DomainUpdateOne) RemoveRequests(v ...*ApprovalRequest) *DomainUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	return duo.RemoveDelegationIDs(ids...)
+	return _u.RemoveRequestIDs(ids...)
 }
 
 // Where appends a list predicates to the DomainUpdate builder.
-func (duo *DomainUpdateOne) Where(ps ...predicate.Domain) *DomainUpdateOne {
-	duo.mutation.Where(ps...)
-	return duo
+func (_u *DomainUpdateOne) Where(ps ...predicate.Domain) *DomainUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
 }
 
 // Select allows selecting one or more fields (columns) of the returned entity.
 // The default is selecting all fields defined in the entity schema.
-func (duo *DomainUpdateOne) Select(field string, fields ...string) *DomainUpdateOne {
-	duo.fields = append([]string{field}, fields...)
-	return duo
+func (_u *DomainUpdateOne) Select(field string, fields ...string) *DomainUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
 }
 
 // Save executes the query and returns the updated Domain entity.
-func (duo *DomainUpdateOne) Save(ctx context.Context) (*Domain, error) {
-	duo.defaults()
-	return withHooks(ctx, duo.sqlSave, duo.mutation, duo.hooks)
+func (_u *DomainUpdateOne) Save(ctx context.Context) (*Domain, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
 // SaveX is like Save, but panics if an error occurs.
-func (duo *DomainUpdateOne) SaveX(ctx context.Context) *Domain {
-	node, err := duo.Save(ctx)
+func (_u *DomainUpdateOne) SaveX(ctx context.Context) *Domain {
+	node, err := _u.Save(ctx)
 	if err != nil {
 		panic(err)
 	}
@@ -376,52 +716,57 @@ func (duo *DomainUpdateOne) SaveX(ctx context.Context) *Domain {
 }
 
 // Exec executes the query on the entity.
-func (duo *DomainUpdateOne) Exec(ctx context.Context) error {
-	_, err := duo.Save(ctx)
+func (_u *DomainUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (duo *DomainUpdateOne) ExecX(ctx context.Context) {
-	if err := duo.Exec(ctx); err != nil {
+func (_u *DomainUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
 
 // defaults sets the default values of the builder before save.
-func (duo *DomainUpdateOne) defaults() {
-	if _, ok := duo.mutation.UpdateTime(); !ok {
+func (_u *DomainUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
 		v := domain.UpdateDefaultUpdateTime()
-		duo.mutation.SetUpdateTime(v)
+		_u.mutation.SetUpdateTime(v)
 	}
 }
 
 // check runs all checks and user-defined validators on the builder.
-func (duo *DomainUpdateOne) check() error {
-	if v, ok := duo.mutation.Fqdn(); ok {
+func (_u *DomainUpdateOne) check() error {
+	if v, ok := _u.mutation.Fqdn(); ok {
 		if err := domain.FqdnValidator(v); err != nil {
 			return &ValidationError{Name: "fqdn", err: fmt.Errorf(`ent: validator failed for field "Domain.fqdn": %w`, err)}
 		}
 	}
-	if v, ok := duo.mutation.Owner(); ok {
+	if v, ok := _u.mutation.Owner(); ok {
 		if err := domain.OwnerValidator(v); err != nil {
 			return &ValidationError{Name: "owner", err: fmt.Errorf(`ent: validator failed for field "Domain.owner": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.VerificationStatus(); ok {
+		if err := domain.VerificationStatusValidator(v); err != nil {
+			return &ValidationError{Name: "verification_status", err: fmt.Errorf(`ent: validator failed for field "Domain.verification_status": %w`, err)}
+		}
+	}
 	return nil
 }
 
-func (duo *DomainUpdateOne) sqlSave(ctx context.Context) (_node *Domain, err error) {
-	if err := duo.check(); err != nil {
+func (_u *DomainUpdateOne) sqlSave(ctx context.Context) (_node *Domain, err error) {
+	if err := _u.check(); err != nil {
 		return _node, err
 	}
 	_spec := sqlgraph.NewUpdateSpec(domain.Table, domain.Columns, sqlgraph.NewFieldSpec(domain.FieldID, field.TypeInt))
-	id, ok := duo.mutation.ID()
+	id, ok := _u.mutation.ID()
 	if !ok {
 		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Domain.id" for update`)}
 	}
 	_spec.Node.ID.Value = id
-	if fields := duo.fields; len(fields) > 0 {
+	if fields := _u.fields; len(fields) > 0 {
 		_spec.Node.Columns = make([]string, 0, len(fields))
 		_spec.Node.Columns = append(_spec.Node.Columns, domain.FieldID)
 		for _, f := range fields {
@@ -433,26 +778,58 @@ func (duo *DomainUpdateOne) sqlSave(ctx context.Context) (_node *Domain, err err
 			}
 		}
 	}
-	if ps := duo.mutation.predicates; len(ps) > 0 {
+	if ps := _u.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
 				ps[i](selector)
 			}
 		}
 	}
-	if value, ok := duo.mutation.UpdateTime(); ok {
+	if value, ok := _u.mutation.UpdateTime(); ok {
 		_spec.SetField(domain.FieldUpdateTime, field.TypeTime, value)
 	}
-	if value, ok := duo.mutation.Fqdn(); ok {
+	if value, ok := _u.mutation.Fqdn(); ok {
 		_spec.SetField(domain.FieldFqdn, field.TypeString, value)
 	}
-	if value, ok := duo.mutation.Owner(); ok {
+	if value, ok := _u.mutation.Owner(); ok {
 		_spec.SetField(domain.FieldOwner, field.TypeString, value)
 	}
-	if value, ok := duo.mutation.Approved(); ok {
+	if value, ok := _u.mutation.Approved(); ok {
 		_spec.SetField(domain.FieldApproved, field.TypeBool, value)
 	}
-	if duo.mutation.DelegationsCleared() {
+	if value, ok := _u.mutation.Contacts(); ok {
+		_spec.SetField(domain.FieldContacts, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedContacts(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, domain.FieldContacts, value)
+		})
+	}
+	if _u.mutation.ContactsCleared() {
+		_spec.ClearField(domain.FieldContacts, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.VerificationToken(); ok {
+		_spec.SetField(domain.FieldVerificationToken, field.TypeString, value)
+	}
+	if _u.mutation.VerificationTokenCleared() {
+		_spec.ClearField(domain.FieldVerificationToken, field.TypeString)
+	}
+	if value, ok := _u.mutation.VerificationStatus(); ok {
+		_spec.SetField(domain.FieldVerificationStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.VerificationCheckedAt(); ok {
+		_spec.SetField(domain.FieldVerificationCheckedAt, field.TypeTime, value)
+	}
+	if _u.mutation.VerificationCheckedAtCleared() {
+		_spec.ClearField(domain.FieldVerificationCheckedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.VerifiedAt(); ok {
+		_spec.SetField(domain.FieldVerifiedAt, field.TypeTime, value)
+	}
+	if _u.mutation.VerifiedAtCleared() {
+		_spec.ClearField(domain.FieldVerifiedAt, field.TypeTime)
+	}
+	if _u.mutation.DelegationsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
@@ -465,7 +842,7 @@ func (duo *DomainUpdateOne) sqlSave(ctx context.Context) (_node *Domain, err err
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := duo.mutation.RemovedDelegationsIDs(); len(nodes) > 0 && !duo.mutation.DelegationsCleared() {
+	if nodes := _u.mutation.RemovedDelegationsIDs(); len(nodes) > 0 && !_u.mutation.DelegationsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
@@ -481,7 +858,7 @@ func (duo *DomainUpdateOne) sqlSave(ctx context.Context) (_node *Domain, err err
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := duo.mutation.DelegationsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.DelegationsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
@@ -497,10 +874,55 @@ func (duo *DomainUpdateOne) sqlSave(ctx context.Context) (_node *Domain, err err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	_node = &Domain{config: duo.config}
+	if _u.mutation.RequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   domain.RequestsTable,
+			Columns: []string{domain.RequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(approvalrequest.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRequestsIDs(); len(nodes) > 0 && !_u.mutation.RequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   domain.RequestsTable,
+			Columns: []string{domain.RequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(approvalrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   domain.RequestsTable,
+			Columns: []string{domain.RequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(approvalrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Domain{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
-	if err = sqlgraph.UpdateNode(ctx, duo.driver, _spec); err != nil {
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{domain.Label}
 		} else if sqlgraph.IsConstraintError(err) {
@@ -508,6 +930,6 @@ func (duo *DomainUpdateOne) sqlSave(ctx context.Context) (_node *Domain, err err
 		}
 		return nil, err
 	}
-	duo.mutation.done = true
+	_u.mutation.done = true
 	return _node, nil
 }
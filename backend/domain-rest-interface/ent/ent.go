@@ -12,8 +12,12 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/hm-edu/domain-rest-interface/ent/approvalrequest"
 	"github.com/hm-edu/domain-rest-interface/ent/delegation"
 	"github.com/hm-edu/domain-rest-interface/ent/domain"
+	"github.com/hm-edu/domain-rest-interface/ent/entitlement"
+	"github.com/hm-edu/domain-rest-interface/ent/mailbox"
+	"github.com/hm-edu/domain-rest-interface/ent/mailboxdelegation"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -71,14 +75,18 @@ var (
 )
 
 // checkColumn checks if the column exists in the given table.
-func checkColumn(table, column string) error {
+func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			delegation.Table: delegation.ValidColumn,
-			domain.Table:     domain.ValidColumn,
+			approvalrequest.Table:   approvalrequest.ValidColumn,
+			delegation.Table:        delegation.ValidColumn,
+			domain.Table:            domain.ValidColumn,
+			entitlement.Table:       entitlement.ValidColumn,
+			mailbox.Table:           mailbox.ValidColumn,
+			mailboxdelegation.Table: mailboxdelegation.ValidColumn,
 		})
 	})
-	return columnCheck(table, column)
+	return columnCheck(t, c)
 }
 
 // Asc applies the given fields in ASC order.
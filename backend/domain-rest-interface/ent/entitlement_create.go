@@ -0,0 +1,335 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hm-edu/domain-rest-interface/ent/entitlement"
+)
+
+// EntitlementCreate is the builder for creating a Entitlement entity.
+type EntitlementCreate struct {
+	config
+	mutation *EntitlementMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *EntitlementCreate) SetCreateTime(v time.Time) *EntitlementCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *EntitlementCreate) SetNillableCreateTime(v *time.Time) *EntitlementCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *EntitlementCreate) SetUpdateTime(v time.Time) *EntitlementCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *EntitlementCreate) SetNillableUpdateTime(v *time.Time) *EntitlementCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *EntitlementCreate) SetKind(v entitlement.Kind) *EntitlementCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetValue sets the "value" field.
+func (_c *EntitlementCreate) SetValue(v string) *EntitlementCreate {
+	_c.mutation.SetValue(v)
+	return _c
+}
+
+// SetOwner sets the "owner" field.
+func (_c *EntitlementCreate) SetOwner(v string) *EntitlementCreate {
+	_c.mutation.SetOwner(v)
+	return _c
+}
+
+// SetJustification sets the "justification" field.
+func (_c *EntitlementCreate) SetJustification(v string) *EntitlementCreate {
+	_c.mutation.SetJustification(v)
+	return _c
+}
+
+// SetGrantedBy sets the "granted_by" field.
+func (_c *EntitlementCreate) SetGrantedBy(v string) *EntitlementCreate {
+	_c.mutation.SetGrantedBy(v)
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *EntitlementCreate) SetExpiresAt(v time.Time) *EntitlementCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_c *EntitlementCreate) SetNillableExpiresAt(v *time.Time) *EntitlementCreate {
+	if v != nil {
+		_c.SetExpiresAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the EntitlementMutation object of the builder.
+func (_c *EntitlementCreate) Mutation() *EntitlementMutation {
+	return _c.mutation
+}
+
+// Save creates the Entitlement in the database.
+func (_c *EntitlementCreate) Save(ctx context.Context) (*Entitlement, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *EntitlementCreate) SaveX(ctx context.Context) *Entitlement {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EntitlementCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EntitlementCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *EntitlementCreate) defaults() {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		v := entitlement.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		v := entitlement.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *EntitlementCreate) check() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "Entitlement.create_time"`)}
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "Entitlement.update_time"`)}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "Entitlement.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := entitlement.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Entitlement.kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Value(); !ok {
+		return &ValidationError{Name: "value", err: errors.New(`ent: missing required field "Entitlement.value"`)}
+	}
+	if v, ok := _c.mutation.Value(); ok {
+		if err := entitlement.ValueValidator(v); err != nil {
+			return &ValidationError{Name: "value", err: fmt.Errorf(`ent: validator failed for field "Entitlement.value": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Owner(); !ok {
+		return &ValidationError{Name: "owner", err: errors.New(`ent: missing required field "Entitlement.owner"`)}
+	}
+	if v, ok := _c.mutation.Owner(); ok {
+		if err := entitlement.OwnerValidator(v); err != nil {
+			return &ValidationError{Name: "owner", err: fmt.Errorf(`ent: validator failed for field "Entitlement.owner": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Justification(); !ok {
+		return &ValidationError{Name: "justification", err: errors.New(`ent: missing required field "Entitlement.justification"`)}
+	}
+	if v, ok := _c.mutation.Justification(); ok {
+		if err := entitlement.JustificationValidator(v); err != nil {
+			return &ValidationError{Name: "justification", err: fmt.Errorf(`ent: validator failed for field "Entitlement.justification": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.GrantedBy(); !ok {
+		return &ValidationError{Name: "granted_by", err: errors.New(`ent: missing required field "Entitlement.granted_by"`)}
+	}
+	if v, ok := _c.mutation.GrantedBy(); ok {
+		if err := entitlement.GrantedByValidator(v); err != nil {
+			return &ValidationError{Name: "granted_by", err: fmt.Errorf(`ent: validator failed for field "Entitlement.granted_by": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *EntitlementCreate) sqlSave(ctx context.Context) (*Entitlement, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *EntitlementCreate) createSpec() (*Entitlement, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Entitlement{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(entitlement.Table, sqlgraph.NewFieldSpec(entitlement.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(entitlement.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(entitlement.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(entitlement.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.Value(); ok {
+		_spec.SetField(entitlement.FieldValue, field.TypeString, value)
+		_node.Value = value
+	}
+	if value, ok := _c.mutation.Owner(); ok {
+		_spec.SetField(entitlement.FieldOwner, field.TypeString, value)
+		_node.Owner = value
+	}
+	if value, ok := _c.mutation.Justification(); ok {
+		_spec.SetField(entitlement.FieldJustification, field.TypeString, value)
+		_node.Justification = value
+	}
+	if value, ok := _c.mutation.GrantedBy(); ok {
+		_spec.SetField(entitlement.FieldGrantedBy, field.TypeString, value)
+		_node.GrantedBy = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(entitlement.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	return _node, _spec
+}
+
+// EntitlementCreateBulk is the builder for creating many Entitlement entities in bulk.
+type EntitlementCreateBulk struct {
+	config
+	err      error
+	builders []*EntitlementCreate
+}
+
+// Save creates the Entitlement entities in the database.
+func (_c *EntitlementCreateBulk) Save(ctx context.Context) ([]*Entitlement, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Entitlement, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EntitlementMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *EntitlementCreateBulk) SaveX(ctx context.Context) []*Entitlement {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EntitlementCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EntitlementCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,500 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hm-edu/domain-rest-interface/ent/entitlement"
+	"github.com/hm-edu/domain-rest-interface/ent/predicate"
+)
+
+// EntitlementUpdate is the builder for updating Entitlement entities.
+type EntitlementUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EntitlementMutation
+}
+
+// Where appends a list predicates to the EntitlementUpdate builder.
+func (_u *EntitlementUpdate) Where(ps ...predicate.Entitlement) *EntitlementUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *EntitlementUpdate) SetUpdateTime(v time.Time) *EntitlementUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *EntitlementUpdate) SetKind(v entitlement.Kind) *EntitlementUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *EntitlementUpdate) SetNillableKind(v *entitlement.Kind) *EntitlementUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetValue sets the "value" field.
+func (_u *EntitlementUpdate) SetValue(v string) *EntitlementUpdate {
+	_u.mutation.SetValue(v)
+	return _u
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (_u *EntitlementUpdate) SetNillableValue(v *string) *EntitlementUpdate {
+	if v != nil {
+		_u.SetValue(*v)
+	}
+	return _u
+}
+
+// SetOwner sets the "owner" field.
+func (_u *EntitlementUpdate) SetOwner(v string) *EntitlementUpdate {
+	_u.mutation.SetOwner(v)
+	return _u
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (_u *EntitlementUpdate) SetNillableOwner(v *string) *EntitlementUpdate {
+	if v != nil {
+		_u.SetOwner(*v)
+	}
+	return _u
+}
+
+// SetJustification sets the "justification" field.
+func (_u *EntitlementUpdate) SetJustification(v string) *EntitlementUpdate {
+	_u.mutation.SetJustification(v)
+	return _u
+}
+
+// SetNillableJustification sets the "justification" field if the given value is not nil.
+func (_u *EntitlementUpdate) SetNillableJustification(v *string) *EntitlementUpdate {
+	if v != nil {
+		_u.SetJustification(*v)
+	}
+	return _u
+}
+
+// SetGrantedBy sets the "granted_by" field.
+func (_u *EntitlementUpdate) SetGrantedBy(v string) *EntitlementUpdate {
+	_u.mutation.SetGrantedBy(v)
+	return _u
+}
+
+// SetNillableGrantedBy sets the "granted_by" field if the given value is not nil.
+func (_u *EntitlementUpdate) SetNillableGrantedBy(v *string) *EntitlementUpdate {
+	if v != nil {
+		_u.SetGrantedBy(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *EntitlementUpdate) SetExpiresAt(v time.Time) *EntitlementUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *EntitlementUpdate) SetNillableExpiresAt(v *time.Time) *EntitlementUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *EntitlementUpdate) ClearExpiresAt() *EntitlementUpdate {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// Mutation returns the EntitlementMutation object of the builder.
+func (_u *EntitlementUpdate) Mutation() *EntitlementMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *EntitlementUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EntitlementUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *EntitlementUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EntitlementUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *EntitlementUpdate) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := entitlement.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EntitlementUpdate) check() error {
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := entitlement.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Entitlement.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Value(); ok {
+		if err := entitlement.ValueValidator(v); err != nil {
+			return &ValidationError{Name: "value", err: fmt.Errorf(`ent: validator failed for field "Entitlement.value": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Owner(); ok {
+		if err := entitlement.OwnerValidator(v); err != nil {
+			return &ValidationError{Name: "owner", err: fmt.Errorf(`ent: validator failed for field "Entitlement.owner": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Justification(); ok {
+		if err := entitlement.JustificationValidator(v); err != nil {
+			return &ValidationError{Name: "justification", err: fmt.Errorf(`ent: validator failed for field "Entitlement.justification": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GrantedBy(); ok {
+		if err := entitlement.GrantedByValidator(v); err != nil {
+			return &ValidationError{Name: "granted_by", err: fmt.Errorf(`ent: validator failed for field "Entitlement.granted_by": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *EntitlementUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(entitlement.Table, entitlement.Columns, sqlgraph.NewFieldSpec(entitlement.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(entitlement.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(entitlement.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Value(); ok {
+		_spec.SetField(entitlement.FieldValue, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Owner(); ok {
+		_spec.SetField(entitlement.FieldOwner, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Justification(); ok {
+		_spec.SetField(entitlement.FieldJustification, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.GrantedBy(); ok {
+		_spec.SetField(entitlement.FieldGrantedBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(entitlement.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(entitlement.FieldExpiresAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{entitlement.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// EntitlementUpdateOne is the builder for updating a single Entitlement entity.
+type EntitlementUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EntitlementMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *EntitlementUpdateOne) SetUpdateTime(v time.Time) *EntitlementUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *EntitlementUpdateOne) SetKind(v entitlement.Kind) *EntitlementUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *EntitlementUpdateOne) SetNillableKind(v *entitlement.Kind) *EntitlementUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetValue sets the "value" field.
+func (_u *EntitlementUpdateOne) SetValue(v string) *EntitlementUpdateOne {
+	_u.mutation.SetValue(v)
+	return _u
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (_u *EntitlementUpdateOne) SetNillableValue(v *string) *EntitlementUpdateOne {
+	if v != nil {
+		_u.SetValue(*v)
+	}
+	return _u
+}
+
+// SetOwner sets the "owner" field.
+func (_u *EntitlementUpdateOne) SetOwner(v string) *EntitlementUpdateOne {
+	_u.mutation.SetOwner(v)
+	return _u
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (_u *EntitlementUpdateOne) SetNillableOwner(v *string) *EntitlementUpdateOne {
+	if v != nil {
+		_u.SetOwner(*v)
+	}
+	return _u
+}
+
+// SetJustification sets the "justification" field.
+func (_u *EntitlementUpdateOne) SetJustification(v string) *EntitlementUpdateOne {
+	_u.mutation.SetJustification(v)
+	return _u
+}
+
+// SetNillableJustification sets the "justification" field if the given value is not nil.
+func (_u *EntitlementUpdateOne) SetNillableJustification(v *string) *EntitlementUpdateOne {
+	if v != nil {
+		_u.SetJustification(*v)
+	}
+	return _u
+}
+
+// SetGrantedBy sets the "granted_by" field.
+func (_u *EntitlementUpdateOne) SetGrantedBy(v string) *EntitlementUpdateOne {
+	_u.mutation.SetGrantedBy(v)
+	return _u
+}
+
+// SetNillableGrantedBy sets the "granted_by" field if the given value is not nil.
+func (_u *EntitlementUpdateOne) SetNillableGrantedBy(v *string) *EntitlementUpdateOne {
+	if v != nil {
+		_u.SetGrantedBy(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *EntitlementUpdateOne) SetExpiresAt(v time.Time) *EntitlementUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *EntitlementUpdateOne) SetNillableExpiresAt(v *time.Time) *EntitlementUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *EntitlementUpdateOne) ClearExpiresAt() *EntitlementUpdateOne {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// Mutation returns the EntitlementMutation object of the builder.
+func (_u *EntitlementUpdateOne) Mutation() *EntitlementMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the EntitlementUpdate builder.
+func (_u *EntitlementUpdateOne) Where(ps ...predicate.Entitlement) *EntitlementUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *EntitlementUpdateOne) Select(field string, fields ...string) *EntitlementUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Entitlement entity.
+func (_u *EntitlementUpdateOne) Save(ctx context.Context) (*Entitlement, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EntitlementUpdateOne) SaveX(ctx context.Context) *Entitlement {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *EntitlementUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EntitlementUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *EntitlementUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := entitlement.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EntitlementUpdateOne) check() error {
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := entitlement.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Entitlement.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Value(); ok {
+		if err := entitlement.ValueValidator(v); err != nil {
+			return &ValidationError{Name: "value", err: fmt.Errorf(`ent: validator failed for field "Entitlement.value": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Owner(); ok {
+		if err := entitlement.OwnerValidator(v); err != nil {
+			return &ValidationError{Name: "owner", err: fmt.Errorf(`ent: validator failed for field "Entitlement.owner": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Justification(); ok {
+		if err := entitlement.JustificationValidator(v); err != nil {
+			return &ValidationError{Name: "justification", err: fmt.Errorf(`ent: validator failed for field "Entitlement.justification": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GrantedBy(); ok {
+		if err := entitlement.GrantedByValidator(v); err != nil {
+			return &ValidationError{Name: "granted_by", err: fmt.Errorf(`ent: validator failed for field "Entitlement.granted_by": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *EntitlementUpdateOne) sqlSave(ctx context.Context) (_node *Entitlement, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(entitlement.Table, entitlement.Columns, sqlgraph.NewFieldSpec(entitlement.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Entitlement.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, entitlement.FieldID)
+		for _, f := range fields {
+			if !entitlement.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != entitlement.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(entitlement.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(entitlement.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Value(); ok {
+		_spec.SetField(entitlement.FieldValue, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Owner(); ok {
+		_spec.SetField(entitlement.FieldOwner, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Justification(); ok {
+		_spec.SetField(entitlement.FieldJustification, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.GrantedBy(); ok {
+		_spec.SetField(entitlement.FieldGrantedBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(entitlement.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(entitlement.FieldExpiresAt, field.TypeTime)
+	}
+	_node = &Entitlement{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{entitlement.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
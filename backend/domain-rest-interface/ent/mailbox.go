@@ -0,0 +1,165 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/hm-edu/domain-rest-interface/ent/mailbox"
+)
+
+// Mailbox is the model entity for the Mailbox schema.
+type Mailbox struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// Email holds the value of the "email" field.
+	Email string `json:"email,omitempty"`
+	// Owner holds the value of the "owner" field.
+	Owner string `json:"owner,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the MailboxQuery when eager-loading is set.
+	Edges        MailboxEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// MailboxEdges holds the relations/edges for other nodes in the graph.
+type MailboxEdges struct {
+	// Delegations holds the value of the delegations edge.
+	Delegations []*MailboxDelegation `json:"delegations,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// DelegationsOrErr returns the Delegations value or an error if the edge
+// was not loaded in eager-loading.
+func (e MailboxEdges) DelegationsOrErr() ([]*MailboxDelegation, error) {
+	if e.loadedTypes[0] {
+		return e.Delegations, nil
+	}
+	return nil, &NotLoadedError{edge: "delegations"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Mailbox) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case mailbox.FieldID:
+			values[i] = new(sql.NullInt64)
+		case mailbox.FieldEmail, mailbox.FieldOwner:
+			values[i] = new(sql.NullString)
+		case mailbox.FieldCreateTime, mailbox.FieldUpdateTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Mailbox fields.
+func (_m *Mailbox) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case mailbox.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case mailbox.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = value.Time
+			}
+		case mailbox.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = value.Time
+			}
+		case mailbox.FieldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[i])
+			} else if value.Valid {
+				_m.Email = value.String
+			}
+		case mailbox.FieldOwner:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field owner", values[i])
+			} else if value.Valid {
+				_m.Owner = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Mailbox.
+// This includes values selected through modifiers, order, etc.
+func (_m *Mailbox) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryDelegations queries the "delegations" edge of the Mailbox entity.
+func (_m *Mailbox) QueryDelegations() *MailboxDelegationQuery {
+	return NewMailboxClient(_m.config).QueryDelegations(_m)
+}
+
+// Update returns a builder for updating this Mailbox.
+// Note that you need to call Mailbox.Unwrap() before calling this method if this Mailbox
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Mailbox) Update() *MailboxUpdateOne {
+	return NewMailboxClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Mailbox entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Mailbox) Unwrap() *Mailbox {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Mailbox is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Mailbox) String() string {
+	var builder strings.Builder
+	builder.WriteString("Mailbox(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(_m.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("email=")
+	builder.WriteString(_m.Email)
+	builder.WriteString(", ")
+	builder.WriteString("owner=")
+	builder.WriteString(_m.Owner)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Mailboxes is a parsable slice of Mailbox.
+type Mailboxes []*Mailbox
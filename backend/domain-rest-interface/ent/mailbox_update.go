@@ -0,0 +1,479 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hm-edu/domain-rest-interface/ent/mailbox"
+	"github.com/hm-edu/domain-rest-interface/ent/mailboxdelegation"
+	"github.com/hm-edu/domain-rest-interface/ent/predicate"
+)
+
+// MailboxUpdate is the builder for updating Mailbox entities.
+type MailboxUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MailboxMutation
+}
+
+// Where appends a list predicates to the MailboxUpdate builder.
+func (_u *MailboxUpdate) Where(ps ...predicate.Mailbox) *MailboxUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *MailboxUpdate) SetUpdateTime(v time.Time) *MailboxUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetEmail sets the "email" field.
+func (_u *MailboxUpdate) SetEmail(v string) *MailboxUpdate {
+	_u.mutation.SetEmail(v)
+	return _u
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *MailboxUpdate) SetNillableEmail(v *string) *MailboxUpdate {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
+	return _u
+}
+
+// SetOwner sets the "owner" field.
+func (_u *MailboxUpdate) SetOwner(v string) *MailboxUpdate {
+	_u.mutation.SetOwner(v)
+	return _u
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (_u *MailboxUpdate) SetNillableOwner(v *string) *MailboxUpdate {
+	if v != nil {
+		_u.SetOwner(*v)
+	}
+	return _u
+}
+
+// AddDelegationIDs adds the "delegations" edge to the MailboxDelegation entity by IDs.
+func (_u *MailboxUpdate) AddDelegationIDs(ids ...int) *MailboxUpdate {
+	_u.mutation.AddDelegationIDs(ids...)
+	return _u
+}
+
+// AddDelegations adds the "delegations" edges to the MailboxDelegation entity.
+func (_u *MailboxUpdate) AddDelegations(v ...*MailboxDelegation) *MailboxUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDelegationIDs(ids...)
+}
+
+// Mutation returns the MailboxMutation object of the builder.
+func (_u *MailboxUpdate) Mutation() *MailboxMutation {
+	return _u.mutation
+}
+
+// ClearDelegations clears all "delegations" edges to the MailboxDelegation entity.
+func (_u *MailboxUpdate) ClearDelegations() *MailboxUpdate {
+	_u.mutation.ClearDelegations()
+	return _u
+}
+
+// RemoveDelegationIDs removes the "delegations" edge to MailboxDelegation entities by IDs.
+func (_u *MailboxUpdate) RemoveDelegationIDs(ids ...int) *MailboxUpdate {
+	_u.mutation.RemoveDelegationIDs(ids...)
+	return _u
+}
+
+// RemoveDelegations removes "delegations" edges to MailboxDelegation entities.
+func (_u *MailboxUpdate) RemoveDelegations(v ...*MailboxDelegation) *MailboxUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDelegationIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *MailboxUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MailboxUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *MailboxUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MailboxUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *MailboxUpdate) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := mailbox.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MailboxUpdate) check() error {
+	if v, ok := _u.mutation.Email(); ok {
+		if err := mailbox.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "Mailbox.email": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Owner(); ok {
+		if err := mailbox.OwnerValidator(v); err != nil {
+			return &ValidationError{Name: "owner", err: fmt.Errorf(`ent: validator failed for field "Mailbox.owner": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *MailboxUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(mailbox.Table, mailbox.Columns, sqlgraph.NewFieldSpec(mailbox.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(mailbox.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(mailbox.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Owner(); ok {
+		_spec.SetField(mailbox.FieldOwner, field.TypeString, value)
+	}
+	if _u.mutation.DelegationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   mailbox.DelegationsTable,
+			Columns: []string{mailbox.DelegationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mailboxdelegation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDelegationsIDs(); len(nodes) > 0 && !_u.mutation.DelegationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   mailbox.DelegationsTable,
+			Columns: []string{mailbox.DelegationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mailboxdelegation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DelegationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   mailbox.DelegationsTable,
+			Columns: []string{mailbox.DelegationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mailboxdelegation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{mailbox.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// MailboxUpdateOne is the builder for updating a single Mailbox entity.
+type MailboxUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MailboxMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *MailboxUpdateOne) SetUpdateTime(v time.Time) *MailboxUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetEmail sets the "email" field.
+func (_u *MailboxUpdateOne) SetEmail(v string) *MailboxUpdateOne {
+	_u.mutation.SetEmail(v)
+	return _u
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *MailboxUpdateOne) SetNillableEmail(v *string) *MailboxUpdateOne {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
+	return _u
+}
+
+// SetOwner sets the "owner" field.
+func (_u *MailboxUpdateOne) SetOwner(v string) *MailboxUpdateOne {
+	_u.mutation.SetOwner(v)
+	return _u
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (_u *MailboxUpdateOne) SetNillableOwner(v *string) *MailboxUpdateOne {
+	if v != nil {
+		_u.SetOwner(*v)
+	}
+	return _u
+}
+
+// AddDelegationIDs adds the "delegations" edge to the MailboxDelegation entity by IDs.
+func (_u *MailboxUpdateOne) AddDelegationIDs(ids ...int) *MailboxUpdateOne {
+	_u.mutation.AddDelegationIDs(ids...)
+	return _u
+}
+
+// AddDelegations adds the "delegations" edges to the MailboxDelegation entity.
+func (_u *MailboxUpdateOne) AddDelegations(v ...*MailboxDelegation) *MailboxUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDelegationIDs(ids...)
+}
+
+// Mutation returns the MailboxMutation object of the builder.
+func (_u *MailboxUpdateOne) Mutation() *MailboxMutation {
+	return _u.mutation
+}
+
+// ClearDelegations clears all "delegations" edges to the MailboxDelegation entity.
+func (_u *MailboxUpdateOne) ClearDelegations() *MailboxUpdateOne {
+	_u.mutation.ClearDelegations()
+	return _u
+}
+
+// RemoveDelegationIDs removes the "delegations" edge to MailboxDelegation entities by IDs.
+func (_u *MailboxUpdateOne) RemoveDelegationIDs(ids ...int) *MailboxUpdateOne {
+	_u.mutation.RemoveDelegationIDs(ids...)
+	return _u
+}
+
+// RemoveDelegations removes "delegations" edges to MailboxDelegation entities.
+func (_u *MailboxUpdateOne) RemoveDelegations(v ...*MailboxDelegation) *MailboxUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDelegationIDs(ids...)
+}
+
+// Where appends a list predicates to the MailboxUpdate builder.
+func (_u *MailboxUpdateOne) Where(ps ...predicate.Mailbox) *MailboxUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *MailboxUpdateOne) Select(field string, fields ...string) *MailboxUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Mailbox entity.
+func (_u *MailboxUpdateOne) Save(ctx context.Context) (*Mailbox, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MailboxUpdateOne) SaveX(ctx context.Context) *Mailbox {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *MailboxUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MailboxUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *MailboxUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := mailbox.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MailboxUpdateOne) check() error {
+	if v, ok := _u.mutation.Email(); ok {
+		if err := mailbox.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "Mailbox.email": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Owner(); ok {
+		if err := mailbox.OwnerValidator(v); err != nil {
+			return &ValidationError{Name: "owner", err: fmt.Errorf(`ent: validator failed for field "Mailbox.owner": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *MailboxUpdateOne) sqlSave(ctx context.Context) (_node *Mailbox, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(mailbox.Table, mailbox.Columns, sqlgraph.NewFieldSpec(mailbox.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Mailbox.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, mailbox.FieldID)
+		for _, f := range fields {
+			if !mailbox.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != mailbox.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(mailbox.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(mailbox.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Owner(); ok {
+		_spec.SetField(mailbox.FieldOwner, field.TypeString, value)
+	}
+	if _u.mutation.DelegationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   mailbox.DelegationsTable,
+			Columns: []string{mailbox.DelegationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mailboxdelegation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDelegationsIDs(); len(nodes) > 0 && !_u.mutation.DelegationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   mailbox.DelegationsTable,
+			Columns: []string{mailbox.DelegationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mailboxdelegation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DelegationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   mailbox.DelegationsTable,
+			Columns: []string{mailbox.DelegationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mailboxdelegation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Mailbox{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{mailbox.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
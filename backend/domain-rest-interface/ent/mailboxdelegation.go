@@ -0,0 +1,167 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/hm-edu/domain-rest-interface/ent/mailbox"
+	"github.com/hm-edu/domain-rest-interface/ent/mailboxdelegation"
+)
+
+// MailboxDelegation is the model entity for the MailboxDelegation schema.
+type MailboxDelegation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// User holds the value of the "user" field.
+	User string `json:"user,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the MailboxDelegationQuery when eager-loading is set.
+	Edges               MailboxDelegationEdges `json:"edges"`
+	mailbox_delegations *int
+	selectValues        sql.SelectValues
+}
+
+// MailboxDelegationEdges holds the relations/edges for other nodes in the graph.
+type MailboxDelegationEdges struct {
+	// Mailbox holds the value of the mailbox edge.
+	Mailbox *Mailbox `json:"mailbox,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// MailboxOrErr returns the Mailbox value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MailboxDelegationEdges) MailboxOrErr() (*Mailbox, error) {
+	if e.Mailbox != nil {
+		return e.Mailbox, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: mailbox.Label}
+	}
+	return nil, &NotLoadedError{edge: "mailbox"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MailboxDelegation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case mailboxdelegation.FieldID:
+			values[i] = new(sql.NullInt64)
+		case mailboxdelegation.FieldUser:
+			values[i] = new(sql.NullString)
+		case mailboxdelegation.FieldCreateTime, mailboxdelegation.FieldUpdateTime:
+			values[i] = new(sql.NullTime)
+		case mailboxdelegation.ForeignKeys[0]: // mailbox_delegations
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MailboxDelegation fields.
+func (_m *MailboxDelegation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case mailboxdelegation.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case mailboxdelegation.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = value.Time
+			}
+		case mailboxdelegation.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = value.Time
+			}
+		case mailboxdelegation.FieldUser:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user", values[i])
+			} else if value.Valid {
+				_m.User = value.String
+			}
+		case mailboxdelegation.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field mailbox_delegations", value)
+			} else if value.Valid {
+				_m.mailbox_delegations = new(int)
+				*_m.mailbox_delegations = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MailboxDelegation.
+// This includes values selected through modifiers, order, etc.
+func (_m *MailboxDelegation) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryMailbox queries the "mailbox" edge of the MailboxDelegation entity.
+func (_m *MailboxDelegation) QueryMailbox() *MailboxQuery {
+	return NewMailboxDelegationClient(_m.config).QueryMailbox(_m)
+}
+
+// Update returns a builder for updating this MailboxDelegation.
+// Note that you need to call MailboxDelegation.Unwrap() before calling this method if this MailboxDelegation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *MailboxDelegation) Update() *MailboxDelegationUpdateOne {
+	return NewMailboxDelegationClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the MailboxDelegation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *MailboxDelegation) Unwrap() *MailboxDelegation {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MailboxDelegation is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *MailboxDelegation) String() string {
+	var builder strings.Builder
+	builder.WriteString("MailboxDelegation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(_m.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("user=")
+	builder.WriteString(_m.User)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MailboxDelegations is a parsable slice of MailboxDelegation.
+type MailboxDelegations []*MailboxDelegation
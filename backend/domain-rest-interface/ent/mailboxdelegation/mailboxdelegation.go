@@ -0,0 +1,111 @@
+// Code generated by ent, DO NOT EDIT.
+
+package mailboxdelegation
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the mailboxdelegation type in the database.
+	Label = "mailbox_delegation"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldUser holds the string denoting the user field in the database.
+	FieldUser = "user"
+	// EdgeMailbox holds the string denoting the mailbox edge name in mutations.
+	EdgeMailbox = "mailbox"
+	// Table holds the table name of the mailboxdelegation in the database.
+	Table = "mailbox_delegations"
+	// MailboxTable is the table that holds the mailbox relation/edge.
+	MailboxTable = "mailbox_delegations"
+	// MailboxInverseTable is the table name for the Mailbox entity.
+	// It exists in this package in order to avoid circular dependency with the "mailbox" package.
+	MailboxInverseTable = "mailboxes"
+	// MailboxColumn is the table column denoting the mailbox relation/edge.
+	MailboxColumn = "mailbox_delegations"
+)
+
+// Columns holds all SQL columns for mailboxdelegation fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldUser,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "mailbox_delegations"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"mailbox_delegations",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreateTime holds the default value on creation for the "create_time" field.
+	DefaultCreateTime func() time.Time
+	// DefaultUpdateTime holds the default value on creation for the "update_time" field.
+	DefaultUpdateTime func() time.Time
+	// UpdateDefaultUpdateTime holds the default value on update for the "update_time" field.
+	UpdateDefaultUpdateTime func() time.Time
+	// UserValidator is a validator for the "user" field. It is called by the builders before save.
+	UserValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the MailboxDelegation queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByUser orders the results by the user field.
+func ByUser(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUser, opts...).ToFunc()
+}
+
+// ByMailboxField orders the results by mailbox field.
+func ByMailboxField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMailboxStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newMailboxStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MailboxInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, MailboxTable, MailboxColumn),
+	)
+}
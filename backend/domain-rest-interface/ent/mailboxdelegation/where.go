@@ -0,0 +1,254 @@
+// Code generated by ent, DO NOT EDIT.
+
+package mailboxdelegation
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/hm-edu/domain-rest-interface/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.MailboxDelegation {
+	return predicate.MailboxDelegation(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.MailboxDelegation {
+	return predicate.MailboxDelegation(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.MailboxDelegation {
+	return predicate.MailboxDelegation(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.MailboxDelegation {
+	return predicate.MailboxDelegation(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.MailboxDelegation {
+	return predicate.MailboxDelegation(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.MailboxDelegation {
+	return predicate.MailboxDelegation(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.MailboxDelegation {
+	return predicate.MailboxDelegation(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.MailboxDelegation {
+	return predicate.MailboxDelegation(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.MailboxDelegation {
+	return predicate.MailboxDelegation(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.MailboxDelegation {
+	return predicate.MailboxDelegation(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.MailboxDelegation {
+	return predicate.MailboxDelegation(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// User applies equality check predicate on the "user" field. It's identical to UserEQ.
+func User(v string) predicate.MailboxDelegation {
+	return predicate.MailboxDelegation(sql.FieldEQ(FieldUser, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.MailboxDelegation {
+	return predicate.MailboxDelegation(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.MailboxDelegation {
+	return predicate.MailboxDelegation(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.MailboxDelegation {
+	return predicate.MailboxDelegation(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.MailboxDelegation {
+	return predicate.MailboxDelegation(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.MailboxDelegation {
+	return predicate.MailboxDelegation(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.MailboxDelegation {
+	return predicate.MailboxDelegation(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.MailboxDelegation {
+	return predicate.MailboxDelegation(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.MailboxDelegation {
+	return predicate.MailboxDelegation(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.MailboxDelegation {
+	return predicate.MailboxDelegation(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.MailboxDelegation {
+	return predicate.MailboxDelegation(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.MailboxDelegation {
+	return predicate.MailboxDelegation(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.MailboxDelegation {
+	return predicate.MailboxDelegation(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.MailboxDelegation {
+	return predicate.MailboxDelegation(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.MailboxDelegation {
+	return predicate.MailboxDelegation(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.MailboxDelegation {
+	return predicate.MailboxDelegation(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.MailboxDelegation {
+	return predicate.MailboxDelegation(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// UserEQ applies the EQ predicate on the "user" field.
+func UserEQ(v string) predicate.MailboxDelegation {
+	return predicate.MailboxDelegation(sql.FieldEQ(FieldUser, v))
+}
+
+// UserNEQ applies the NEQ predicate on the "user" field.
+func UserNEQ(v string) predicate.MailboxDelegation {
+	return predicate.MailboxDelegation(sql.FieldNEQ(FieldUser, v))
+}
+
+// UserIn applies the In predicate on the "user" field.
+func UserIn(vs ...string) predicate.MailboxDelegation {
+	return predicate.MailboxDelegation(sql.FieldIn(FieldUser, vs...))
+}
+
+// UserNotIn applies the NotIn predicate on the "user" field.
+func UserNotIn(vs ...string) predicate.MailboxDelegation {
+	return predicate.MailboxDelegation(sql.FieldNotIn(FieldUser, vs...))
+}
+
+// UserGT applies the GT predicate on the "user" field.
+func UserGT(v string) predicate.MailboxDelegation {
+	return predicate.MailboxDelegation(sql.FieldGT(FieldUser, v))
+}
+
+// UserGTE applies the GTE predicate on the "user" field.
+func UserGTE(v string) predicate.MailboxDelegation {
+	return predicate.MailboxDelegation(sql.FieldGTE(FieldUser, v))
+}
+
+// UserLT applies the LT predicate on the "user" field.
+func UserLT(v string) predicate.MailboxDelegation {
+	return predicate.MailboxDelegation(sql.FieldLT(FieldUser, v))
+}
+
+// UserLTE applies the LTE predicate on the "user" field.
+func UserLTE(v string) predicate.MailboxDelegation {
+	return predicate.MailboxDelegation(sql.FieldLTE(FieldUser, v))
+}
+
+// UserContains applies the Contains predicate on the "user" field.
+func UserContains(v string) predicate.MailboxDelegation {
+	return predicate.MailboxDelegation(sql.FieldContains(FieldUser, v))
+}
+
+// UserHasPrefix applies the HasPrefix predicate on the "user" field.
+func UserHasPrefix(v string) predicate.MailboxDelegation {
+	return predicate.MailboxDelegation(sql.FieldHasPrefix(FieldUser, v))
+}
+
+// UserHasSuffix applies the HasSuffix predicate on the "user" field.
+func UserHasSuffix(v string) predicate.MailboxDelegation {
+	return predicate.MailboxDelegation(sql.FieldHasSuffix(FieldUser, v))
+}
+
+// UserEqualFold applies the EqualFold predicate on the "user" field.
+func UserEqualFold(v string) predicate.MailboxDelegation {
+	return predicate.MailboxDelegation(sql.FieldEqualFold(FieldUser, v))
+}
+
+// UserContainsFold applies the ContainsFold predicate on the "user" field.
+func UserContainsFold(v string) predicate.MailboxDelegation {
+	return predicate.MailboxDelegation(sql.FieldContainsFold(FieldUser, v))
+}
+
+// HasMailbox applies the HasEdge predicate on the "mailbox" edge.
+func HasMailbox() predicate.MailboxDelegation {
+	return predicate.MailboxDelegation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, MailboxTable, MailboxColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMailboxWith applies the HasEdge predicate on the "mailbox" edge with a given conditions (other predicates).
+func HasMailboxWith(preds ...predicate.Mailbox) predicate.MailboxDelegation {
+	return predicate.MailboxDelegation(func(s *sql.Selector) {
+		step := newMailboxStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.MailboxDelegation) predicate.MailboxDelegation {
+	return predicate.MailboxDelegation(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.MailboxDelegation) predicate.MailboxDelegation {
+	return predicate.MailboxDelegation(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.MailboxDelegation) predicate.MailboxDelegation {
+	return predicate.MailboxDelegation(sql.NotPredicates(p))
+}
@@ -0,0 +1,277 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hm-edu/domain-rest-interface/ent/mailbox"
+	"github.com/hm-edu/domain-rest-interface/ent/mailboxdelegation"
+)
+
+// MailboxDelegationCreate is the builder for creating a MailboxDelegation entity.
+type MailboxDelegationCreate struct {
+	config
+	mutation *MailboxDelegationMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *MailboxDelegationCreate) SetCreateTime(v time.Time) *MailboxDelegationCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *MailboxDelegationCreate) SetNillableCreateTime(v *time.Time) *MailboxDelegationCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *MailboxDelegationCreate) SetUpdateTime(v time.Time) *MailboxDelegationCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *MailboxDelegationCreate) SetNillableUpdateTime(v *time.Time) *MailboxDelegationCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetUser sets the "user" field.
+func (_c *MailboxDelegationCreate) SetUser(v string) *MailboxDelegationCreate {
+	_c.mutation.SetUser(v)
+	return _c
+}
+
+// SetMailboxID sets the "mailbox" edge to the Mailbox entity by ID.
+func (_c *MailboxDelegationCreate) SetMailboxID(id int) *MailboxDelegationCreate {
+	_c.mutation.SetMailboxID(id)
+	return _c
+}
+
+// SetMailbox sets the "mailbox" edge to the Mailbox entity.
+func (_c *MailboxDelegationCreate) SetMailbox(v *Mailbox) *MailboxDelegationCreate {
+	return _c.SetMailboxID(v.ID)
+}
+
+// Mutation returns the MailboxDelegationMutation object of the builder.
+func (_c *MailboxDelegationCreate) Mutation() *MailboxDelegationMutation {
+	return _c.mutation
+}
+
+// Save creates the MailboxDelegation in the database.
+func (_c *MailboxDelegationCreate) Save(ctx context.Context) (*MailboxDelegation, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *MailboxDelegationCreate) SaveX(ctx context.Context) *MailboxDelegation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MailboxDelegationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MailboxDelegationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *MailboxDelegationCreate) defaults() {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		v := mailboxdelegation.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		v := mailboxdelegation.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *MailboxDelegationCreate) check() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "MailboxDelegation.create_time"`)}
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "MailboxDelegation.update_time"`)}
+	}
+	if _, ok := _c.mutation.User(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required field "MailboxDelegation.user"`)}
+	}
+	if v, ok := _c.mutation.User(); ok {
+		if err := mailboxdelegation.UserValidator(v); err != nil {
+			return &ValidationError{Name: "user", err: fmt.Errorf(`ent: validator failed for field "MailboxDelegation.user": %w`, err)}
+		}
+	}
+	if len(_c.mutation.MailboxIDs()) == 0 {
+		return &ValidationError{Name: "mailbox", err: errors.New(`ent: missing required edge "MailboxDelegation.mailbox"`)}
+	}
+	return nil
+}
+
+func (_c *MailboxDelegationCreate) sqlSave(ctx context.Context) (*MailboxDelegation, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *MailboxDelegationCreate) createSpec() (*MailboxDelegation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MailboxDelegation{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(mailboxdelegation.Table, sqlgraph.NewFieldSpec(mailboxdelegation.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(mailboxdelegation.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(mailboxdelegation.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.User(); ok {
+		_spec.SetField(mailboxdelegation.FieldUser, field.TypeString, value)
+		_node.User = value
+	}
+	if nodes := _c.mutation.MailboxIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   mailboxdelegation.MailboxTable,
+			Columns: []string{mailboxdelegation.MailboxColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mailbox.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.mailbox_delegations = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// MailboxDelegationCreateBulk is the builder for creating many MailboxDelegation entities in bulk.
+type MailboxDelegationCreateBulk struct {
+	config
+	err      error
+	builders []*MailboxDelegationCreate
+}
+
+// Save creates the MailboxDelegation entities in the database.
+func (_c *MailboxDelegationCreateBulk) Save(ctx context.Context) ([]*MailboxDelegation, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*MailboxDelegation, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MailboxDelegationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *MailboxDelegationCreateBulk) SaveX(ctx context.Context) []*MailboxDelegation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MailboxDelegationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MailboxDelegationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
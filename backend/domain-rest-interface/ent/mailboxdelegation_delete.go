@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hm-edu/domain-rest-interface/ent/mailboxdelegation"
+	"github.com/hm-edu/domain-rest-interface/ent/predicate"
+)
+
+// MailboxDelegationDelete is the builder for deleting a MailboxDelegation entity.
+type MailboxDelegationDelete struct {
+	config
+	hooks    []Hook
+	mutation *MailboxDelegationMutation
+}
+
+// Where appends a list predicates to the MailboxDelegationDelete builder.
+func (_d *MailboxDelegationDelete) Where(ps ...predicate.MailboxDelegation) *MailboxDelegationDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *MailboxDelegationDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *MailboxDelegationDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *MailboxDelegationDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(mailboxdelegation.Table, sqlgraph.NewFieldSpec(mailboxdelegation.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// MailboxDelegationDeleteOne is the builder for deleting a single MailboxDelegation entity.
+type MailboxDelegationDeleteOne struct {
+	_d *MailboxDelegationDelete
+}
+
+// Where appends a list predicates to the MailboxDelegationDelete builder.
+func (_d *MailboxDelegationDeleteOne) Where(ps ...predicate.MailboxDelegation) *MailboxDelegationDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *MailboxDelegationDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{mailboxdelegation.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *MailboxDelegationDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,614 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hm-edu/domain-rest-interface/ent/mailbox"
+	"github.com/hm-edu/domain-rest-interface/ent/mailboxdelegation"
+	"github.com/hm-edu/domain-rest-interface/ent/predicate"
+)
+
+// MailboxDelegationQuery is the builder for querying MailboxDelegation entities.
+type MailboxDelegationQuery struct {
+	config
+	ctx         *QueryContext
+	order       []mailboxdelegation.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.MailboxDelegation
+	withMailbox *MailboxQuery
+	withFKs     bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the MailboxDelegationQuery builder.
+func (_q *MailboxDelegationQuery) Where(ps ...predicate.MailboxDelegation) *MailboxDelegationQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *MailboxDelegationQuery) Limit(limit int) *MailboxDelegationQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *MailboxDelegationQuery) Offset(offset int) *MailboxDelegationQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *MailboxDelegationQuery) Unique(unique bool) *MailboxDelegationQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *MailboxDelegationQuery) Order(o ...mailboxdelegation.OrderOption) *MailboxDelegationQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// QueryMailbox chains the current query on the "mailbox" edge.
+func (_q *MailboxDelegationQuery) QueryMailbox() *MailboxQuery {
+	query := (&MailboxClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(mailboxdelegation.Table, mailboxdelegation.FieldID, selector),
+			sqlgraph.To(mailbox.Table, mailbox.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, mailboxdelegation.MailboxTable, mailboxdelegation.MailboxColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first MailboxDelegation entity from the query.
+// Returns a *NotFoundError when no MailboxDelegation was found.
+func (_q *MailboxDelegationQuery) First(ctx context.Context) (*MailboxDelegation, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{mailboxdelegation.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *MailboxDelegationQuery) FirstX(ctx context.Context) *MailboxDelegation {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first MailboxDelegation ID from the query.
+// Returns a *NotFoundError when no MailboxDelegation ID was found.
+func (_q *MailboxDelegationQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{mailboxdelegation.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *MailboxDelegationQuery) FirstIDX(ctx context.Context) int {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single MailboxDelegation entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one MailboxDelegation entity is found.
+// Returns a *NotFoundError when no MailboxDelegation entities are found.
+func (_q *MailboxDelegationQuery) Only(ctx context.Context) (*MailboxDelegation, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{mailboxdelegation.Label}
+	default:
+		return nil, &NotSingularError{mailboxdelegation.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *MailboxDelegationQuery) OnlyX(ctx context.Context) *MailboxDelegation {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only MailboxDelegation ID in the query.
+// Returns a *NotSingularError when more than one MailboxDelegation ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *MailboxDelegationQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{mailboxdelegation.Label}
+	default:
+		err = &NotSingularError{mailboxdelegation.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *MailboxDelegationQuery) OnlyIDX(ctx context.Context) int {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of MailboxDelegations.
+func (_q *MailboxDelegationQuery) All(ctx context.Context) ([]*MailboxDelegation, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*MailboxDelegation, *MailboxDelegationQuery]()
+	return withInterceptors[[]*MailboxDelegation](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *MailboxDelegationQuery) AllX(ctx context.Context) []*MailboxDelegation {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of MailboxDelegation IDs.
+func (_q *MailboxDelegationQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(mailboxdelegation.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *MailboxDelegationQuery) IDsX(ctx context.Context) []int {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *MailboxDelegationQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*MailboxDelegationQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *MailboxDelegationQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *MailboxDelegationQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *MailboxDelegationQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the MailboxDelegationQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *MailboxDelegationQuery) Clone() *MailboxDelegationQuery {
+	if _q == nil {
+		return nil
+	}
+	return &MailboxDelegationQuery{
+		config:      _q.config,
+		ctx:         _q.ctx.Clone(),
+		order:       append([]mailboxdelegation.OrderOption{}, _q.order...),
+		inters:      append([]Interceptor{}, _q.inters...),
+		predicates:  append([]predicate.MailboxDelegation{}, _q.predicates...),
+		withMailbox: _q.withMailbox.Clone(),
+		// clone intermediate query.
+		sql:  _q.sql.Clone(),
+		path: _q.path,
+	}
+}
+
+// WithMailbox tells the query-builder to eager-load the nodes that are connected to
+// the "mailbox" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *MailboxDelegationQuery) WithMailbox(opts ...func(*MailboxQuery)) *MailboxDelegationQuery {
+	query := (&MailboxClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withMailbox = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.MailboxDelegation.Query().
+//		GroupBy(mailboxdelegation.FieldCreateTime).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *MailboxDelegationQuery) GroupBy(field string, fields ...string) *MailboxDelegationGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &MailboxDelegationGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = mailboxdelegation.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//	}
+//
+//	client.MailboxDelegation.Query().
+//		Select(mailboxdelegation.FieldCreateTime).
+//		Scan(ctx, &v)
+func (_q *MailboxDelegationQuery) Select(fields ...string) *MailboxDelegationSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &MailboxDelegationSelect{MailboxDelegationQuery: _q}
+	sbuild.label = mailboxdelegation.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a MailboxDelegationSelect configured with the given aggregations.
+func (_q *MailboxDelegationQuery) Aggregate(fns ...AggregateFunc) *MailboxDelegationSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *MailboxDelegationQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !mailboxdelegation.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *MailboxDelegationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*MailboxDelegation, error) {
+	var (
+		nodes       = []*MailboxDelegation{}
+		withFKs     = _q.withFKs
+		_spec       = _q.querySpec()
+		loadedTypes = [1]bool{
+			_q.withMailbox != nil,
+		}
+	)
+	if _q.withMailbox != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, mailboxdelegation.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*MailboxDelegation).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &MailboxDelegation{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withMailbox; query != nil {
+		if err := _q.loadMailbox(ctx, query, nodes, nil,
+			func(n *MailboxDelegation, e *Mailbox) { n.Edges.Mailbox = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *MailboxDelegationQuery) loadMailbox(ctx context.Context, query *MailboxQuery, nodes []*MailboxDelegation, init func(*MailboxDelegation), assign func(*MailboxDelegation, *Mailbox)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*MailboxDelegation)
+	for i := range nodes {
+		if nodes[i].mailbox_delegations == nil {
+			continue
+		}
+		fk := *nodes[i].mailbox_delegations
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(mailbox.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "mailbox_delegations" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (_q *MailboxDelegationQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *MailboxDelegationQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(mailboxdelegation.Table, mailboxdelegation.Columns, sqlgraph.NewFieldSpec(mailboxdelegation.FieldID, field.TypeInt))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, mailboxdelegation.FieldID)
+		for i := range fields {
+			if fields[i] != mailboxdelegation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *MailboxDelegationQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(mailboxdelegation.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = mailboxdelegation.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// MailboxDelegationGroupBy is the group-by builder for MailboxDelegation entities.
+type MailboxDelegationGroupBy struct {
+	selector
+	build *MailboxDelegationQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *MailboxDelegationGroupBy) Aggregate(fns ...AggregateFunc) *MailboxDelegationGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *MailboxDelegationGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MailboxDelegationQuery, *MailboxDelegationGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *MailboxDelegationGroupBy) sqlScan(ctx context.Context, root *MailboxDelegationQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// MailboxDelegationSelect is the builder for selecting fields of MailboxDelegation entities.
+type MailboxDelegationSelect struct {
+	*MailboxDelegationQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *MailboxDelegationSelect) Aggregate(fns ...AggregateFunc) *MailboxDelegationSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *MailboxDelegationSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MailboxDelegationQuery, *MailboxDelegationSelect](ctx, _s.MailboxDelegationQuery, _s, _s.inters, v)
+}
+
+func (_s *MailboxDelegationSelect) sqlScan(ctx context.Context, root *MailboxDelegationQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,371 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hm-edu/domain-rest-interface/ent/mailbox"
+	"github.com/hm-edu/domain-rest-interface/ent/mailboxdelegation"
+	"github.com/hm-edu/domain-rest-interface/ent/predicate"
+)
+
+// MailboxDelegationUpdate is the builder for updating MailboxDelegation entities.
+type MailboxDelegationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MailboxDelegationMutation
+}
+
+// Where appends a list predicates to the MailboxDelegationUpdate builder.
+func (_u *MailboxDelegationUpdate) Where(ps ...predicate.MailboxDelegation) *MailboxDelegationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *MailboxDelegationUpdate) SetUpdateTime(v time.Time) *MailboxDelegationUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetUser sets the "user" field.
+func (_u *MailboxDelegationUpdate) SetUser(v string) *MailboxDelegationUpdate {
+	_u.mutation.SetUser(v)
+	return _u
+}
+
+// SetNillableUser sets the "user" field if the given value is not nil.
+func (_u *MailboxDelegationUpdate) SetNillableUser(v *string) *MailboxDelegationUpdate {
+	if v != nil {
+		_u.SetUser(*v)
+	}
+	return _u
+}
+
+// SetMailboxID sets the "mailbox" edge to the Mailbox entity by ID.
+func (_u *MailboxDelegationUpdate) SetMailboxID(id int) *MailboxDelegationUpdate {
+	_u.mutation.SetMailboxID(id)
+	return _u
+}
+
+// SetMailbox sets the "mailbox" edge to the Mailbox entity.
+func (_u *MailboxDelegationUpdate) SetMailbox(v *Mailbox) *MailboxDelegationUpdate {
+	return _u.SetMailboxID(v.ID)
+}
+
+// Mutation returns the MailboxDelegationMutation object of the builder.
+func (_u *MailboxDelegationUpdate) Mutation() *MailboxDelegationMutation {
+	return _u.mutation
+}
+
+// ClearMailbox clears the "mailbox" edge to the Mailbox entity.
+func (_u *MailboxDelegationUpdate) ClearMailbox() *MailboxDelegationUpdate {
+	_u.mutation.ClearMailbox()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *MailboxDelegationUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MailboxDelegationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *MailboxDelegationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MailboxDelegationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *MailboxDelegationUpdate) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := mailboxdelegation.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MailboxDelegationUpdate) check() error {
+	if v, ok := _u.mutation.User(); ok {
+		if err := mailboxdelegation.UserValidator(v); err != nil {
+			return &ValidationError{Name: "user", err: fmt.Errorf(`ent: validator failed for field "MailboxDelegation.user": %w`, err)}
+		}
+	}
+	if _u.mutation.MailboxCleared() && len(_u.mutation.MailboxIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MailboxDelegation.mailbox"`)
+	}
+	return nil
+}
+
+func (_u *MailboxDelegationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(mailboxdelegation.Table, mailboxdelegation.Columns, sqlgraph.NewFieldSpec(mailboxdelegation.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(mailboxdelegation.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.User(); ok {
+		_spec.SetField(mailboxdelegation.FieldUser, field.TypeString, value)
+	}
+	if _u.mutation.MailboxCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   mailboxdelegation.MailboxTable,
+			Columns: []string{mailboxdelegation.MailboxColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mailbox.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MailboxIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   mailboxdelegation.MailboxTable,
+			Columns: []string{mailboxdelegation.MailboxColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mailbox.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{mailboxdelegation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// MailboxDelegationUpdateOne is the builder for updating a single MailboxDelegation entity.
+type MailboxDelegationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MailboxDelegationMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *MailboxDelegationUpdateOne) SetUpdateTime(v time.Time) *MailboxDelegationUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetUser sets the "user" field.
+func (_u *MailboxDelegationUpdateOne) SetUser(v string) *MailboxDelegationUpdateOne {
+	_u.mutation.SetUser(v)
+	return _u
+}
+
+// SetNillableUser sets the "user" field if the given value is not nil.
+func (_u *MailboxDelegationUpdateOne) SetNillableUser(v *string) *MailboxDelegationUpdateOne {
+	if v != nil {
+		_u.SetUser(*v)
+	}
+	return _u
+}
+
+// SetMailboxID sets the "mailbox" edge to the Mailbox entity by ID.
+func (_u *MailboxDelegationUpdateOne) SetMailboxID(id int) *MailboxDelegationUpdateOne {
+	_u.mutation.SetMailboxID(id)
+	return _u
+}
+
+// SetMailbox sets the "mailbox" edge to the Mailbox entity.
+func (_u *MailboxDelegationUpdateOne) SetMailbox(v *Mailbox) *MailboxDelegationUpdateOne {
+	return _u.SetMailboxID(v.ID)
+}
+
+// Mutation returns the MailboxDelegationMutation object of the builder.
+func (_u *MailboxDelegationUpdateOne) Mutation() *MailboxDelegationMutation {
+	return _u.mutation
+}
+
+// ClearMailbox clears the "mailbox" edge to the Mailbox entity.
+func (_u *MailboxDelegationUpdateOne) ClearMailbox() *MailboxDelegationUpdateOne {
+	_u.mutation.ClearMailbox()
+	return _u
+}
+
+// Where appends a list predicates to the MailboxDelegationUpdate builder.
+func (_u *MailboxDelegationUpdateOne) Where(ps ...predicate.MailboxDelegation) *MailboxDelegationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *MailboxDelegationUpdateOne) Select(field string, fields ...string) *MailboxDelegationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated MailboxDelegation entity.
+func (_u *MailboxDelegationUpdateOne) Save(ctx context.Context) (*MailboxDelegation, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MailboxDelegationUpdateOne) SaveX(ctx context.Context) *MailboxDelegation {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *MailboxDelegationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MailboxDelegationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *MailboxDelegationUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := mailboxdelegation.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MailboxDelegationUpdateOne) check() error {
+	if v, ok := _u.mutation.User(); ok {
+		if err := mailboxdelegation.UserValidator(v); err != nil {
+			return &ValidationError{Name: "user", err: fmt.Errorf(`ent: validator failed for field "MailboxDelegation.user": %w`, err)}
+		}
+	}
+	if _u.mutation.MailboxCleared() && len(_u.mutation.MailboxIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MailboxDelegation.mailbox"`)
+	}
+	return nil
+}
+
+func (_u *MailboxDelegationUpdateOne) sqlSave(ctx context.Context) (_node *MailboxDelegation, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(mailboxdelegation.Table, mailboxdelegation.Columns, sqlgraph.NewFieldSpec(mailboxdelegation.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MailboxDelegation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, mailboxdelegation.FieldID)
+		for _, f := range fields {
+			if !mailboxdelegation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != mailboxdelegation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(mailboxdelegation.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.User(); ok {
+		_spec.SetField(mailboxdelegation.FieldUser, field.TypeString, value)
+	}
+	if _u.mutation.MailboxCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   mailboxdelegation.MailboxTable,
+			Columns: []string{mailboxdelegation.MailboxColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mailbox.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MailboxIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   mailboxdelegation.MailboxTable,
+			Columns: []string{mailboxdelegation.MailboxColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mailbox.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &MailboxDelegation{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{mailboxdelegation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
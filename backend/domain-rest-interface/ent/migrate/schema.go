@@ -8,6 +8,47 @@ import (
 )
 
 var (
+	// ApprovalRequestsColumns holds the columns for the "approval_requests" table.
+	ApprovalRequestsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "fqdn", Type: field.TypeString},
+		{Name: "requester", Type: field.TypeString},
+		{Name: "justification", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "approved", "rejected", "expired"}, Default: "pending"},
+		{Name: "reviewer", Type: field.TypeString, Nullable: true},
+		{Name: "comment", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "decided_at", Type: field.TypeTime, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "domain_requests", Type: field.TypeInt, Nullable: true},
+	}
+	// ApprovalRequestsTable holds the schema information for the "approval_requests" table.
+	ApprovalRequestsTable = &schema.Table{
+		Name:       "approval_requests",
+		Columns:    ApprovalRequestsColumns,
+		PrimaryKey: []*schema.Column{ApprovalRequestsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "approval_requests_domains_requests",
+				Columns:    []*schema.Column{ApprovalRequestsColumns[11]},
+				RefColumns: []*schema.Column{DomainsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "approvalrequest_status",
+				Unique:  false,
+				Columns: []*schema.Column{ApprovalRequestsColumns[6]},
+			},
+			{
+				Name:    "approvalrequest_requester",
+				Unique:  false,
+				Columns: []*schema.Column{ApprovalRequestsColumns[4]},
+			},
+		},
+	}
 	// DelegationsColumns holds the columns for the "delegations" table.
 	DelegationsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -45,6 +86,11 @@ var (
 		{Name: "fqdn", Type: field.TypeString, Unique: true},
 		{Name: "owner", Type: field.TypeString},
 		{Name: "approved", Type: field.TypeBool, Default: false},
+		{Name: "contacts", Type: field.TypeJSON, Nullable: true},
+		{Name: "verification_token", Type: field.TypeString, Nullable: true},
+		{Name: "verification_status", Type: field.TypeEnum, Enums: []string{"unverified", "pending", "verified", "failed"}, Default: "unverified"},
+		{Name: "verification_checked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "verified_at", Type: field.TypeTime, Nullable: true},
 	}
 	// DomainsTable holds the schema information for the "domains" table.
 	DomainsTable = &schema.Table{
@@ -52,13 +98,92 @@ var (
 		Columns:    DomainsColumns,
 		PrimaryKey: []*schema.Column{DomainsColumns[0]},
 	}
+	// EntitlementsColumns holds the columns for the "entitlements" table.
+	EntitlementsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"wildcard", "ip"}},
+		{Name: "value", Type: field.TypeString},
+		{Name: "owner", Type: field.TypeString},
+		{Name: "justification", Type: field.TypeString, Size: 2147483647},
+		{Name: "granted_by", Type: field.TypeString},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+	}
+	// EntitlementsTable holds the schema information for the "entitlements" table.
+	EntitlementsTable = &schema.Table{
+		Name:       "entitlements",
+		Columns:    EntitlementsColumns,
+		PrimaryKey: []*schema.Column{EntitlementsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "entitlement_value_owner",
+				Unique:  true,
+				Columns: []*schema.Column{EntitlementsColumns[4], EntitlementsColumns[5]},
+			},
+			{
+				Name:    "entitlement_owner",
+				Unique:  false,
+				Columns: []*schema.Column{EntitlementsColumns[5]},
+			},
+		},
+	}
+	// MailboxesColumns holds the columns for the "mailboxes" table.
+	MailboxesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "email", Type: field.TypeString, Unique: true},
+		{Name: "owner", Type: field.TypeString},
+	}
+	// MailboxesTable holds the schema information for the "mailboxes" table.
+	MailboxesTable = &schema.Table{
+		Name:       "mailboxes",
+		Columns:    MailboxesColumns,
+		PrimaryKey: []*schema.Column{MailboxesColumns[0]},
+	}
+	// MailboxDelegationsColumns holds the columns for the "mailbox_delegations" table.
+	MailboxDelegationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "user", Type: field.TypeString},
+		{Name: "mailbox_delegations", Type: field.TypeInt},
+	}
+	// MailboxDelegationsTable holds the schema information for the "mailbox_delegations" table.
+	MailboxDelegationsTable = &schema.Table{
+		Name:       "mailbox_delegations",
+		Columns:    MailboxDelegationsColumns,
+		PrimaryKey: []*schema.Column{MailboxDelegationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "mailbox_delegations_mailboxes_delegations",
+				Columns:    []*schema.Column{MailboxDelegationsColumns[4]},
+				RefColumns: []*schema.Column{MailboxesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "mailboxdelegation_user_mailbox_delegations",
+				Unique:  true,
+				Columns: []*schema.Column{MailboxDelegationsColumns[3], MailboxDelegationsColumns[4]},
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		ApprovalRequestsTable,
 		DelegationsTable,
 		DomainsTable,
+		EntitlementsTable,
+		MailboxesTable,
+		MailboxDelegationsTable,
 	}
 )
 
 func init() {
+	ApprovalRequestsTable.ForeignKeys[0].RefTable = DomainsTable
 	DelegationsTable.ForeignKeys[0].RefTable = DomainsTable
+	MailboxDelegationsTable.ForeignKeys[0].RefTable = MailboxesTable
 }
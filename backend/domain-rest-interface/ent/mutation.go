@@ -11,8 +11,12 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/hm-edu/domain-rest-interface/ent/approvalrequest"
 	"github.com/hm-edu/domain-rest-interface/ent/delegation"
 	"github.com/hm-edu/domain-rest-interface/ent/domain"
+	"github.com/hm-edu/domain-rest-interface/ent/entitlement"
+	"github.com/hm-edu/domain-rest-interface/ent/mailbox"
+	"github.com/hm-edu/domain-rest-interface/ent/mailboxdelegation"
 	"github.com/hm-edu/domain-rest-interface/ent/predicate"
 )
 
@@ -25,38 +29,49 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeDelegation = "Delegation"
-	TypeDomain     = "Domain"
+	TypeApprovalRequest   = "ApprovalRequest"
+	TypeDelegation        = "Delegation"
+	TypeDomain            = "Domain"
+	TypeEntitlement       = "Entitlement"
+	TypeMailbox           = "Mailbox"
+	TypeMailboxDelegation = "MailboxDelegation"
 )
 
-// DelegationMutation represents an operation that mutates the Delegation nodes in the graph.
-type DelegationMutation struct {
+// ApprovalRequestMutation represents an operation that mutates the ApprovalRequest nodes in the graph.
+type ApprovalRequestMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *int
 	create_time   *time.Time
 	update_time   *time.Time
-	user          *string
+	fqdn          *string
+	requester     *string
+	justification *string
+	status        *approvalrequest.Status
+	reviewer      *string
+	comment       *string
+	decided_at    *time.Time
+	expires_at    *time.Time
 	clearedFields map[string]struct{}
 	domain        *int
 	cleareddomain bool
 	done          bool
-	oldValue      func(context.Context) (*Delegation, error)
-	predicates    []predicate.Delegation
+	oldValue      func(context.Context) (*ApprovalRequest, error)
+	predicates    []predicate.ApprovalRequest
 }
 
-var _ ent.Mutation = (*DelegationMutation)(nil)
+var _ ent.Mutation = (*ApprovalRequestMutation)(nil)
 
-// delegationOption allows management of the mutation configuration using functional options.
-type delegationOption func(*DelegationMutation)
+// approvalrequestOption allows management of the mutation configuration using functional options.
+type approvalrequestOption func(*ApprovalRequestMutation)
 
-// newDelegationMutation creates new mutation for the Delegation entity.
-func newDelegationMutation(c config, op Op, opts ...delegationOption) *DelegationMutation {
-	m := &DelegationMutation{
+// newApprovalRequestMutation creates new mutation for the ApprovalRequest entity.
+func newApprovalRequestMutation(c config, op Op, opts ...approvalrequestOption) *ApprovalRequestMutation {
+	m := &ApprovalRequestMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeDelegation,
+		typ:           TypeApprovalRequest,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -65,20 +80,20 @@ func newDelegationMutation(c config, op Op, opts ...delegationOption) *Delegatio
 	return m
 }
 
-// withDelegationID sets the ID field of the mutation.
-func withDelegationID(id int) delegationOption {
-	return func(m *DelegationMutation) {
+// withApprovalRequestID sets the ID field of the mutation.
+func withApprovalRequestID(id int) approvalrequestOption {
+	return func(m *ApprovalRequestMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Delegation
+			value *ApprovalRequest
 		)
-		m.oldValue = func(ctx context.Context) (*Delegation, error) {
+		m.oldValue = func(ctx context.Context) (*ApprovalRequest, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Delegation.Get(ctx, id)
+					value, err = m.Client().ApprovalRequest.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -87,10 +102,10 @@ func withDelegationID(id int) delegationOption {
 	}
 }
 
-// withDelegation sets the old Delegation of the mutation.
-func withDelegation(node *Delegation) delegationOption {
-	return func(m *DelegationMutation) {
-		m.oldValue = func(context.Context) (*Delegation, error) {
+// withApprovalRequest sets the old ApprovalRequest of the mutation.
+func withApprovalRequest(node *ApprovalRequest) approvalrequestOption {
+	return func(m *ApprovalRequestMutation) {
+		m.oldValue = func(context.Context) (*ApprovalRequest, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -99,7 +114,7 @@ func withDelegation(node *Delegation) delegationOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DelegationMutation) Client() *Client {
+func (m ApprovalRequestMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -107,7 +122,7 @@ func (m DelegationMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m DelegationMutation) Tx() (*Tx, error) {
+func (m ApprovalRequestMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -118,7 +133,7 @@ func (m DelegationMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *DelegationMutation) ID() (id int, exists bool) {
+func (m *ApprovalRequestMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -129,7 +144,7 @@ func (m *DelegationMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *DelegationMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ApprovalRequestMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -138,19 +153,19 @@ func (m *DelegationMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Delegation.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ApprovalRequest.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreateTime sets the "create_time" field.
-func (m *DelegationMutation) SetCreateTime(t time.Time) {
+func (m *ApprovalRequestMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
 // CreateTime returns the value of the "create_time" field in the mutation.
-func (m *DelegationMutation) CreateTime() (r time.Time, exists bool) {
+func (m *ApprovalRequestMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.create_time
 	if v == nil {
 		return
@@ -158,10 +173,10 @@ func (m *DelegationMutation) CreateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the Delegation entity.
-// If the Delegation object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the ApprovalRequest entity.
+// If the ApprovalRequest object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DelegationMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *ApprovalRequestMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
@@ -176,17 +191,17 @@ func (m *DelegationMutation) OldCreateTime(ctx context.Context) (v time.Time, er
 }
 
 // ResetCreateTime resets all changes to the "create_time" field.
-func (m *DelegationMutation) ResetCreateTime() {
+func (m *ApprovalRequestMutation) ResetCreateTime() {
 	m.create_time = nil
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (m *DelegationMutation) SetUpdateTime(t time.Time) {
+func (m *ApprovalRequestMutation) SetUpdateTime(t time.Time) {
 	m.update_time = &t
 }
 
 // UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *DelegationMutation) UpdateTime() (r time.Time, exists bool) {
+func (m *ApprovalRequestMutation) UpdateTime() (r time.Time, exists bool) {
 	v := m.update_time
 	if v == nil {
 		return
@@ -194,10 +209,10 @@ func (m *DelegationMutation) UpdateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the Delegation entity.
-// If the Delegation object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the ApprovalRequest entity.
+// If the ApprovalRequest object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DelegationMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+func (m *ApprovalRequestMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
@@ -212,94 +227,3409 @@ func (m *DelegationMutation) OldUpdateTime(ctx context.Context) (v time.Time, er
 }
 
 // ResetUpdateTime resets all changes to the "update_time" field.
-func (m *DelegationMutation) ResetUpdateTime() {
+func (m *ApprovalRequestMutation) ResetUpdateTime() {
 	m.update_time = nil
 }
 
-// SetUser sets the "user" field.
-func (m *DelegationMutation) SetUser(s string) {
-	m.user = &s
+// SetFqdn sets the "fqdn" field.
+func (m *ApprovalRequestMutation) SetFqdn(s string) {
+	m.fqdn = &s
 }
 
-// User returns the value of the "user" field in the mutation.
-func (m *DelegationMutation) User() (r string, exists bool) {
-	v := m.user
+// Fqdn returns the value of the "fqdn" field in the mutation.
+func (m *ApprovalRequestMutation) Fqdn() (r string, exists bool) {
+	v := m.fqdn
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUser returns the old "user" field's value of the Delegation entity.
-// If the Delegation object wasn't provided to the builder, the object is fetched from the database.
+// OldFqdn returns the old "fqdn" field's value of the ApprovalRequest entity.
+// If the ApprovalRequest object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DelegationMutation) OldUser(ctx context.Context) (v string, err error) {
+func (m *ApprovalRequestMutation) OldFqdn(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUser is only allowed on UpdateOne operations")
+		return v, errors.New("OldFqdn is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUser requires an ID field in the mutation")
+		return v, errors.New("OldFqdn requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUser: %w", err)
+		return v, fmt.Errorf("querying old value for OldFqdn: %w", err)
 	}
-	return oldValue.User, nil
+	return oldValue.Fqdn, nil
 }
 
-// ResetUser resets all changes to the "user" field.
-func (m *DelegationMutation) ResetUser() {
-	m.user = nil
+// ResetFqdn resets all changes to the "fqdn" field.
+func (m *ApprovalRequestMutation) ResetFqdn() {
+	m.fqdn = nil
+}
+
+// SetRequester sets the "requester" field.
+func (m *ApprovalRequestMutation) SetRequester(s string) {
+	m.requester = &s
+}
+
+// Requester returns the value of the "requester" field in the mutation.
+func (m *ApprovalRequestMutation) Requester() (r string, exists bool) {
+	v := m.requester
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequester returns the old "requester" field's value of the ApprovalRequest entity.
+// If the ApprovalRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ApprovalRequestMutation) OldRequester(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequester is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequester requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequester: %w", err)
+	}
+	return oldValue.Requester, nil
+}
+
+// ResetRequester resets all changes to the "requester" field.
+func (m *ApprovalRequestMutation) ResetRequester() {
+	m.requester = nil
+}
+
+// SetJustification sets the "justification" field.
+func (m *ApprovalRequestMutation) SetJustification(s string) {
+	m.justification = &s
+}
+
+// Justification returns the value of the "justification" field in the mutation.
+func (m *ApprovalRequestMutation) Justification() (r string, exists bool) {
+	v := m.justification
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJustification returns the old "justification" field's value of the ApprovalRequest entity.
+// If the ApprovalRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ApprovalRequestMutation) OldJustification(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJustification is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJustification requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJustification: %w", err)
+	}
+	return oldValue.Justification, nil
+}
+
+// ClearJustification clears the value of the "justification" field.
+func (m *ApprovalRequestMutation) ClearJustification() {
+	m.justification = nil
+	m.clearedFields[approvalrequest.FieldJustification] = struct{}{}
+}
+
+// JustificationCleared returns if the "justification" field was cleared in this mutation.
+func (m *ApprovalRequestMutation) JustificationCleared() bool {
+	_, ok := m.clearedFields[approvalrequest.FieldJustification]
+	return ok
+}
+
+// ResetJustification resets all changes to the "justification" field.
+func (m *ApprovalRequestMutation) ResetJustification() {
+	m.justification = nil
+	delete(m.clearedFields, approvalrequest.FieldJustification)
+}
+
+// SetStatus sets the "status" field.
+func (m *ApprovalRequestMutation) SetStatus(a approvalrequest.Status) {
+	m.status = &a
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ApprovalRequestMutation) Status() (r approvalrequest.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the ApprovalRequest entity.
+// If the ApprovalRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ApprovalRequestMutation) OldStatus(ctx context.Context) (v approvalrequest.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ApprovalRequestMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetReviewer sets the "reviewer" field.
+func (m *ApprovalRequestMutation) SetReviewer(s string) {
+	m.reviewer = &s
+}
+
+// Reviewer returns the value of the "reviewer" field in the mutation.
+func (m *ApprovalRequestMutation) Reviewer() (r string, exists bool) {
+	v := m.reviewer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReviewer returns the old "reviewer" field's value of the ApprovalRequest entity.
+// If the ApprovalRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ApprovalRequestMutation) OldReviewer(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReviewer is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReviewer requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReviewer: %w", err)
+	}
+	return oldValue.Reviewer, nil
+}
+
+// ClearReviewer clears the value of the "reviewer" field.
+func (m *ApprovalRequestMutation) ClearReviewer() {
+	m.reviewer = nil
+	m.clearedFields[approvalrequest.FieldReviewer] = struct{}{}
+}
+
+// ReviewerCleared returns if the "reviewer" field was cleared in this mutation.
+func (m *ApprovalRequestMutation) ReviewerCleared() bool {
+	_, ok := m.clearedFields[approvalrequest.FieldReviewer]
+	return ok
+}
+
+// ResetReviewer resets all changes to the "reviewer" field.
+func (m *ApprovalRequestMutation) ResetReviewer() {
+	m.reviewer = nil
+	delete(m.clearedFields, approvalrequest.FieldReviewer)
+}
+
+// SetComment sets the "comment" field.
+func (m *ApprovalRequestMutation) SetComment(s string) {
+	m.comment = &s
+}
+
+// Comment returns the value of the "comment" field in the mutation.
+func (m *ApprovalRequestMutation) Comment() (r string, exists bool) {
+	v := m.comment
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldComment returns the old "comment" field's value of the ApprovalRequest entity.
+// If the ApprovalRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ApprovalRequestMutation) OldComment(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldComment is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldComment requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldComment: %w", err)
+	}
+	return oldValue.Comment, nil
+}
+
+// ClearComment clears the value of the "comment" field.
+func (m *ApprovalRequestMutation) ClearComment() {
+	m.comment = nil
+	m.clearedFields[approvalrequest.FieldComment] = struct{}{}
+}
+
+// CommentCleared returns if the "comment" field was cleared in this mutation.
+func (m *ApprovalRequestMutation) CommentCleared() bool {
+	_, ok := m.clearedFields[approvalrequest.FieldComment]
+	return ok
+}
+
+// ResetComment resets all changes to the "comment" field.
+func (m *ApprovalRequestMutation) ResetComment() {
+	m.comment = nil
+	delete(m.clearedFields, approvalrequest.FieldComment)
+}
+
+// SetDecidedAt sets the "decided_at" field.
+func (m *ApprovalRequestMutation) SetDecidedAt(t time.Time) {
+	m.decided_at = &t
+}
+
+// DecidedAt returns the value of the "decided_at" field in the mutation.
+func (m *ApprovalRequestMutation) DecidedAt() (r time.Time, exists bool) {
+	v := m.decided_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDecidedAt returns the old "decided_at" field's value of the ApprovalRequest entity.
+// If the ApprovalRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ApprovalRequestMutation) OldDecidedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDecidedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDecidedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDecidedAt: %w", err)
+	}
+	return oldValue.DecidedAt, nil
+}
+
+// ClearDecidedAt clears the value of the "decided_at" field.
+func (m *ApprovalRequestMutation) ClearDecidedAt() {
+	m.decided_at = nil
+	m.clearedFields[approvalrequest.FieldDecidedAt] = struct{}{}
+}
+
+// DecidedAtCleared returns if the "decided_at" field was cleared in this mutation.
+func (m *ApprovalRequestMutation) DecidedAtCleared() bool {
+	_, ok := m.clearedFields[approvalrequest.FieldDecidedAt]
+	return ok
+}
+
+// ResetDecidedAt resets all changes to the "decided_at" field.
+func (m *ApprovalRequestMutation) ResetDecidedAt() {
+	m.decided_at = nil
+	delete(m.clearedFields, approvalrequest.FieldDecidedAt)
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *ApprovalRequestMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *ApprovalRequestMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the ApprovalRequest entity.
+// If the ApprovalRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ApprovalRequestMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *ApprovalRequestMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// SetDomainID sets the "domain" edge to the Domain entity by id.
+func (m *ApprovalRequestMutation) SetDomainID(id int) {
+	m.domain = &id
+}
+
+// ClearDomain clears the "domain" edge to the Domain entity.
+func (m *ApprovalRequestMutation) ClearDomain() {
+	m.cleareddomain = true
+}
+
+// DomainCleared reports if the "domain" edge to the Domain entity was cleared.
+func (m *ApprovalRequestMutation) DomainCleared() bool {
+	return m.cleareddomain
+}
+
+// DomainID returns the "domain" edge ID in the mutation.
+func (m *ApprovalRequestMutation) DomainID() (id int, exists bool) {
+	if m.domain != nil {
+		return *m.domain, true
+	}
+	return
+}
+
+// DomainIDs returns the "domain" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DomainID instead. It exists only for internal usage by the builders.
+func (m *ApprovalRequestMutation) DomainIDs() (ids []int) {
+	if id := m.domain; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDomain resets all changes to the "domain" edge.
+func (m *ApprovalRequestMutation) ResetDomain() {
+	m.domain = nil
+	m.cleareddomain = false
+}
+
+// Where appends a list predicates to the ApprovalRequestMutation builder.
+func (m *ApprovalRequestMutation) Where(ps ...predicate.ApprovalRequest) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ApprovalRequestMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ApprovalRequestMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ApprovalRequest, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ApprovalRequestMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ApprovalRequestMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ApprovalRequest).
+func (m *ApprovalRequestMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ApprovalRequestMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.create_time != nil {
+		fields = append(fields, approvalrequest.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, approvalrequest.FieldUpdateTime)
+	}
+	if m.fqdn != nil {
+		fields = append(fields, approvalrequest.FieldFqdn)
+	}
+	if m.requester != nil {
+		fields = append(fields, approvalrequest.FieldRequester)
+	}
+	if m.justification != nil {
+		fields = append(fields, approvalrequest.FieldJustification)
+	}
+	if m.status != nil {
+		fields = append(fields, approvalrequest.FieldStatus)
+	}
+	if m.reviewer != nil {
+		fields = append(fields, approvalrequest.FieldReviewer)
+	}
+	if m.comment != nil {
+		fields = append(fields, approvalrequest.FieldComment)
+	}
+	if m.decided_at != nil {
+		fields = append(fields, approvalrequest.FieldDecidedAt)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, approvalrequest.FieldExpiresAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ApprovalRequestMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case approvalrequest.FieldCreateTime:
+		return m.CreateTime()
+	case approvalrequest.FieldUpdateTime:
+		return m.UpdateTime()
+	case approvalrequest.FieldFqdn:
+		return m.Fqdn()
+	case approvalrequest.FieldRequester:
+		return m.Requester()
+	case approvalrequest.FieldJustification:
+		return m.Justification()
+	case approvalrequest.FieldStatus:
+		return m.Status()
+	case approvalrequest.FieldReviewer:
+		return m.Reviewer()
+	case approvalrequest.FieldComment:
+		return m.Comment()
+	case approvalrequest.FieldDecidedAt:
+		return m.DecidedAt()
+	case approvalrequest.FieldExpiresAt:
+		return m.ExpiresAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ApprovalRequestMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case approvalrequest.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case approvalrequest.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case approvalrequest.FieldFqdn:
+		return m.OldFqdn(ctx)
+	case approvalrequest.FieldRequester:
+		return m.OldRequester(ctx)
+	case approvalrequest.FieldJustification:
+		return m.OldJustification(ctx)
+	case approvalrequest.FieldStatus:
+		return m.OldStatus(ctx)
+	case approvalrequest.FieldReviewer:
+		return m.OldReviewer(ctx)
+	case approvalrequest.FieldComment:
+		return m.OldComment(ctx)
+	case approvalrequest.FieldDecidedAt:
+		return m.OldDecidedAt(ctx)
+	case approvalrequest.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ApprovalRequest field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ApprovalRequestMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case approvalrequest.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case approvalrequest.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case approvalrequest.FieldFqdn:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFqdn(v)
+		return nil
+	case approvalrequest.FieldRequester:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequester(v)
+		return nil
+	case approvalrequest.FieldJustification:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJustification(v)
+		return nil
+	case approvalrequest.FieldStatus:
+		v, ok := value.(approvalrequest.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case approvalrequest.FieldReviewer:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReviewer(v)
+		return nil
+	case approvalrequest.FieldComment:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetComment(v)
+		return nil
+	case approvalrequest.FieldDecidedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDecidedAt(v)
+		return nil
+	case approvalrequest.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ApprovalRequest field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ApprovalRequestMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ApprovalRequestMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ApprovalRequestMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ApprovalRequest numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ApprovalRequestMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(approvalrequest.FieldJustification) {
+		fields = append(fields, approvalrequest.FieldJustification)
+	}
+	if m.FieldCleared(approvalrequest.FieldReviewer) {
+		fields = append(fields, approvalrequest.FieldReviewer)
+	}
+	if m.FieldCleared(approvalrequest.FieldComment) {
+		fields = append(fields, approvalrequest.FieldComment)
+	}
+	if m.FieldCleared(approvalrequest.FieldDecidedAt) {
+		fields = append(fields, approvalrequest.FieldDecidedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ApprovalRequestMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ApprovalRequestMutation) ClearField(name string) error {
+	switch name {
+	case approvalrequest.FieldJustification:
+		m.ClearJustification()
+		return nil
+	case approvalrequest.FieldReviewer:
+		m.ClearReviewer()
+		return nil
+	case approvalrequest.FieldComment:
+		m.ClearComment()
+		return nil
+	case approvalrequest.FieldDecidedAt:
+		m.ClearDecidedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ApprovalRequest nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ApprovalRequestMutation) ResetField(name string) error {
+	switch name {
+	case approvalrequest.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case approvalrequest.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case approvalrequest.FieldFqdn:
+		m.ResetFqdn()
+		return nil
+	case approvalrequest.FieldRequester:
+		m.ResetRequester()
+		return nil
+	case approvalrequest.FieldJustification:
+		m.ResetJustification()
+		return nil
+	case approvalrequest.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case approvalrequest.FieldReviewer:
+		m.ResetReviewer()
+		return nil
+	case approvalrequest.FieldComment:
+		m.ResetComment()
+		return nil
+	case approvalrequest.FieldDecidedAt:
+		m.ResetDecidedAt()
+		return nil
+	case approvalrequest.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ApprovalRequest field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ApprovalRequestMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.domain != nil {
+		edges = append(edges, approvalrequest.EdgeDomain)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ApprovalRequestMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case approvalrequest.EdgeDomain:
+		if id := m.domain; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ApprovalRequestMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ApprovalRequestMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ApprovalRequestMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareddomain {
+		edges = append(edges, approvalrequest.EdgeDomain)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ApprovalRequestMutation) EdgeCleared(name string) bool {
+	switch name {
+	case approvalrequest.EdgeDomain:
+		return m.cleareddomain
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ApprovalRequestMutation) ClearEdge(name string) error {
+	switch name {
+	case approvalrequest.EdgeDomain:
+		m.ClearDomain()
+		return nil
+	}
+	return fmt.Errorf("unknown ApprovalRequest unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ApprovalRequestMutation) ResetEdge(name string) error {
+	switch name {
+	case approvalrequest.EdgeDomain:
+		m.ResetDomain()
+		return nil
+	}
+	return fmt.Errorf("unknown ApprovalRequest edge %s", name)
+}
+
+// DelegationMutation represents an operation that mutates the Delegation nodes in the graph.
+type DelegationMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	create_time   *time.Time
+	update_time   *time.Time
+	user          *string
+	clearedFields map[string]struct{}
+	domain        *int
+	cleareddomain bool
+	done          bool
+	oldValue      func(context.Context) (*Delegation, error)
+	predicates    []predicate.Delegation
+}
+
+var _ ent.Mutation = (*DelegationMutation)(nil)
+
+// delegationOption allows management of the mutation configuration using functional options.
+type delegationOption func(*DelegationMutation)
+
+// newDelegationMutation creates new mutation for the Delegation entity.
+func newDelegationMutation(c config, op Op, opts ...delegationOption) *DelegationMutation {
+	m := &DelegationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDelegation,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDelegationID sets the ID field of the mutation.
+func withDelegationID(id int) delegationOption {
+	return func(m *DelegationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Delegation
+		)
+		m.oldValue = func(ctx context.Context) (*Delegation, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Delegation.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDelegation sets the old Delegation of the mutation.
+func withDelegation(node *Delegation) delegationOption {
+	return func(m *DelegationMutation) {
+		m.oldValue = func(context.Context) (*Delegation, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DelegationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DelegationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DelegationMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DelegationMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Delegation.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *DelegationMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *DelegationMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the Delegation entity.
+// If the Delegation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DelegationMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *DelegationMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *DelegationMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *DelegationMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the Delegation entity.
+// If the Delegation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DelegationMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *DelegationMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetUser sets the "user" field.
+func (m *DelegationMutation) SetUser(s string) {
+	m.user = &s
+}
+
+// User returns the value of the "user" field in the mutation.
+func (m *DelegationMutation) User() (r string, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUser returns the old "user" field's value of the Delegation entity.
+// If the Delegation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DelegationMutation) OldUser(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUser is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUser requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUser: %w", err)
+	}
+	return oldValue.User, nil
+}
+
+// ResetUser resets all changes to the "user" field.
+func (m *DelegationMutation) ResetUser() {
+	m.user = nil
+}
+
+// SetDomainID sets the "domain" edge to the Domain entity by id.
+func (m *DelegationMutation) SetDomainID(id int) {
+	m.domain = &id
+}
+
+// ClearDomain clears the "domain" edge to the Domain entity.
+func (m *DelegationMutation) ClearDomain() {
+	m.cleareddomain = true
+}
+
+// DomainCleared reports if the "domain" edge to the Domain entity was cleared.
+func (m *DelegationMutation) DomainCleared() bool {
+	return m.cleareddomain
+}
+
+// DomainID returns the "domain" edge ID in the mutation.
+func (m *DelegationMutation) DomainID() (id int, exists bool) {
+	if m.domain != nil {
+		return *m.domain, true
+	}
+	return
+}
+
+// DomainIDs returns the "domain" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DomainID instead. It exists only for internal usage by the builders.
+func (m *DelegationMutation) DomainIDs() (ids []int) {
+	if id := m.domain; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDomain resets all changes to the "domain" edge.
+func (m *DelegationMutation) ResetDomain() {
+	m.domain = nil
+	m.cleareddomain = false
+}
+
+// Where appends a list predicates to the DelegationMutation builder.
+func (m *DelegationMutation) Where(ps ...predicate.Delegation) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DelegationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DelegationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Delegation, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DelegationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DelegationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Delegation).
+func (m *DelegationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DelegationMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.create_time != nil {
+		fields = append(fields, delegation.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, delegation.FieldUpdateTime)
+	}
+	if m.user != nil {
+		fields = append(fields, delegation.FieldUser)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DelegationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case delegation.FieldCreateTime:
+		return m.CreateTime()
+	case delegation.FieldUpdateTime:
+		return m.UpdateTime()
+	case delegation.FieldUser:
+		return m.User()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DelegationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case delegation.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case delegation.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case delegation.FieldUser:
+		return m.OldUser(ctx)
+	}
+	return nil, fmt.Errorf("unknown Delegation field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DelegationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case delegation.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case delegation.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case delegation.FieldUser:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUser(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Delegation field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DelegationMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DelegationMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DelegationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Delegation numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DelegationMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DelegationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DelegationMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Delegation nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DelegationMutation) ResetField(name string) error {
+	switch name {
+	case delegation.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case delegation.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case delegation.FieldUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Delegation field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DelegationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.domain != nil {
+		edges = append(edges, delegation.EdgeDomain)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DelegationMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case delegation.EdgeDomain:
+		if id := m.domain; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DelegationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DelegationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DelegationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareddomain {
+		edges = append(edges, delegation.EdgeDomain)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DelegationMutation) EdgeCleared(name string) bool {
+	switch name {
+	case delegation.EdgeDomain:
+		return m.cleareddomain
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DelegationMutation) ClearEdge(name string) error {
+	switch name {
+	case delegation.EdgeDomain:
+		m.ClearDomain()
+		return nil
+	}
+	return fmt.Errorf("unknown Delegation unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DelegationMutation) ResetEdge(name string) error {
+	switch name {
+	case delegation.EdgeDomain:
+		m.ResetDomain()
+		return nil
+	}
+	return fmt.Errorf("unknown Delegation edge %s", name)
+}
+
+// DomainMutation represents an operation that mutates the Domain nodes in the graph.
+type DomainMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *int
+	create_time             *time.Time
+	update_time             *time.Time
+	fqdn                    *string
+	owner                   *string
+	approved                *bool
+	contacts                *[]string
+	appendcontacts          []string
+	verification_token      *string
+	verification_status     *domain.VerificationStatus
+	verification_checked_at *time.Time
+	verified_at             *time.Time
+	clearedFields           map[string]struct{}
+	delegations             map[int]struct{}
+	removeddelegations      map[int]struct{}
+	cleareddelegations      bool
+	requests                map[int]struct{}
+	removedrequests         map[int]struct{}
+	clearedrequests         bool
+	done                    bool
+	oldValue                func(context.Context) (*Domain, error)
+	predicates              []predicate.Domain
+}
+
+var _ ent.Mutation = (*DomainMutation)(nil)
+
+// domainOption allows management of the mutation configuration using functional options.
+type domainOption func(*DomainMutation)
+
+// newDomainMutation creates new mutation for the Domain entity.
+func newDomainMutation(c config, op Op, opts ...domainOption) *DomainMutation {
+	m := &DomainMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDomain,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDomainID sets the ID field of the mutation.
+func withDomainID(id int) domainOption {
+	return func(m *DomainMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Domain
+		)
+		m.oldValue = func(ctx context.Context) (*Domain, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Domain.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDomain sets the old Domain of the mutation.
+func withDomain(node *Domain) domainOption {
+	return func(m *DomainMutation) {
+		m.oldValue = func(context.Context) (*Domain, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DomainMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DomainMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DomainMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DomainMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Domain.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *DomainMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *DomainMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the Domain entity.
+// If the Domain object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DomainMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *DomainMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *DomainMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *DomainMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the Domain entity.
+// If the Domain object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DomainMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *DomainMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetFqdn sets the "fqdn" field.
+func (m *DomainMutation) SetFqdn(s string) {
+	m.fqdn = &s
+}
+
+// Fqdn returns the value of the "fqdn" field in the mutation.
+func (m *DomainMutation) Fqdn() (r string, exists bool) {
+	v := m.fqdn
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFqdn returns the old "fqdn" field's value of the Domain entity.
+// If the Domain object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DomainMutation) OldFqdn(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFqdn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFqdn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFqdn: %w", err)
+	}
+	return oldValue.Fqdn, nil
+}
+
+// ResetFqdn resets all changes to the "fqdn" field.
+func (m *DomainMutation) ResetFqdn() {
+	m.fqdn = nil
+}
+
+// SetOwner sets the "owner" field.
+func (m *DomainMutation) SetOwner(s string) {
+	m.owner = &s
+}
+
+// Owner returns the value of the "owner" field in the mutation.
+func (m *DomainMutation) Owner() (r string, exists bool) {
+	v := m.owner
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwner returns the old "owner" field's value of the Domain entity.
+// If the Domain object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DomainMutation) OldOwner(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwner is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwner requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwner: %w", err)
+	}
+	return oldValue.Owner, nil
+}
+
+// ResetOwner resets all changes to the "owner" field.
+func (m *DomainMutation) ResetOwner() {
+	m.owner = nil
+}
+
+// SetApproved sets the "approved" field.
+func (m *DomainMutation) SetApproved(b bool) {
+	m.approved = &b
+}
+
+// Approved returns the value of the "approved" field in the mutation.
+func (m *DomainMutation) Approved() (r bool, exists bool) {
+	v := m.approved
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldApproved returns the old "approved" field's value of the Domain entity.
+// If the Domain object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DomainMutation) OldApproved(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldApproved is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldApproved requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldApproved: %w", err)
+	}
+	return oldValue.Approved, nil
+}
+
+// ResetApproved resets all changes to the "approved" field.
+func (m *DomainMutation) ResetApproved() {
+	m.approved = nil
+}
+
+// SetContacts sets the "contacts" field.
+func (m *DomainMutation) SetContacts(s []string) {
+	m.contacts = &s
+	m.appendcontacts = nil
+}
+
+// Contacts returns the value of the "contacts" field in the mutation.
+func (m *DomainMutation) Contacts() (r []string, exists bool) {
+	v := m.contacts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContacts returns the old "contacts" field's value of the Domain entity.
+// If the Domain object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DomainMutation) OldContacts(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContacts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContacts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContacts: %w", err)
+	}
+	return oldValue.Contacts, nil
+}
+
+// AppendContacts adds s to the "contacts" field.
+func (m *DomainMutation) AppendContacts(s []string) {
+	m.appendcontacts = append(m.appendcontacts, s...)
+}
+
+// AppendedContacts returns the list of values that were appended to the "contacts" field in this mutation.
+func (m *DomainMutation) AppendedContacts() ([]string, bool) {
+	if len(m.appendcontacts) == 0 {
+		return nil, false
+	}
+	return m.appendcontacts, true
+}
+
+// ClearContacts clears the value of the "contacts" field.
+func (m *DomainMutation) ClearContacts() {
+	m.contacts = nil
+	m.appendcontacts = nil
+	m.clearedFields[domain.FieldContacts] = struct{}{}
+}
+
+// ContactsCleared returns if the "contacts" field was cleared in this mutation.
+func (m *DomainMutation) ContactsCleared() bool {
+	_, ok := m.clearedFields[domain.FieldContacts]
+	return ok
+}
+
+// ResetContacts resets all changes to the "contacts" field.
+func (m *DomainMutation) ResetContacts() {
+	m.contacts = nil
+	m.appendcontacts = nil
+	delete(m.clearedFields, domain.FieldContacts)
+}
+
+// SetVerificationToken sets the "verification_token" field.
+func (m *DomainMutation) SetVerificationToken(s string) {
+	m.verification_token = &s
+}
+
+// VerificationToken returns the value of the "verification_token" field in the mutation.
+func (m *DomainMutation) VerificationToken() (r string, exists bool) {
+	v := m.verification_token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVerificationToken returns the old "verification_token" field's value of the Domain entity.
+// If the Domain object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DomainMutation) OldVerificationToken(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVerificationToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVerificationToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVerificationToken: %w", err)
+	}
+	return oldValue.VerificationToken, nil
+}
+
+// ClearVerificationToken clears the value of the "verification_token" field.
+func (m *DomainMutation) ClearVerificationToken() {
+	m.verification_token = nil
+	m.clearedFields[domain.FieldVerificationToken] = struct{}{}
+}
+
+// VerificationTokenCleared returns if the "verification_token" field was cleared in this mutation.
+func (m *DomainMutation) VerificationTokenCleared() bool {
+	_, ok := m.clearedFields[domain.FieldVerificationToken]
+	return ok
+}
+
+// ResetVerificationToken resets all changes to the "verification_token" field.
+func (m *DomainMutation) ResetVerificationToken() {
+	m.verification_token = nil
+	delete(m.clearedFields, domain.FieldVerificationToken)
+}
+
+// SetVerificationStatus sets the "verification_status" field.
+func (m *DomainMutation) SetVerificationStatus(ds domain.VerificationStatus) {
+	m.verification_status = &ds
+}
+
+// VerificationStatus returns the value of the "verification_status" field in the mutation.
+func (m *DomainMutation) VerificationStatus() (r domain.VerificationStatus, exists bool) {
+	v := m.verification_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVerificationStatus returns the old "verification_status" field's value of the Domain entity.
+// If the Domain object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DomainMutation) OldVerificationStatus(ctx context.Context) (v domain.VerificationStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVerificationStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVerificationStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVerificationStatus: %w", err)
+	}
+	return oldValue.VerificationStatus, nil
+}
+
+// ResetVerificationStatus resets all changes to the "verification_status" field.
+func (m *DomainMutation) ResetVerificationStatus() {
+	m.verification_status = nil
+}
+
+// SetVerificationCheckedAt sets the "verification_checked_at" field.
+func (m *DomainMutation) SetVerificationCheckedAt(t time.Time) {
+	m.verification_checked_at = &t
+}
+
+// VerificationCheckedAt returns the value of the "verification_checked_at" field in the mutation.
+func (m *DomainMutation) VerificationCheckedAt() (r time.Time, exists bool) {
+	v := m.verification_checked_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVerificationCheckedAt returns the old "verification_checked_at" field's value of the Domain entity.
+// If the Domain object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DomainMutation) OldVerificationCheckedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVerificationCheckedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVerificationCheckedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVerificationCheckedAt: %w", err)
+	}
+	return oldValue.VerificationCheckedAt, nil
+}
+
+// ClearVerificationCheckedAt clears the value of the "verification_checked_at" field.
+func (m *DomainMutation) ClearVerificationCheckedAt() {
+	m.verification_checked_at = nil
+	m.clearedFields[domain.FieldVerificationCheckedAt] = struct{}{}
+}
+
+// VerificationCheckedAtCleared returns if the "verification_checked_at" field was cleared in this mutation.
+func (m *DomainMutation) VerificationCheckedAtCleared() bool {
+	_, ok := m.clearedFields[domain.FieldVerificationCheckedAt]
+	return ok
+}
+
+// ResetVerificationCheckedAt resets all changes to the "verification_checked_at" field.
+func (m *DomainMutation) ResetVerificationCheckedAt() {
+	m.verification_checked_at = nil
+	delete(m.clearedFields, domain.FieldVerificationCheckedAt)
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (m *DomainMutation) SetVerifiedAt(t time.Time) {
+	m.verified_at = &t
+}
+
+// VerifiedAt returns the value of the "verified_at" field in the mutation.
+func (m *DomainMutation) VerifiedAt() (r time.Time, exists bool) {
+	v := m.verified_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVerifiedAt returns the old "verified_at" field's value of the Domain entity.
+// If the Domain object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DomainMutation) OldVerifiedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVerifiedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVerifiedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVerifiedAt: %w", err)
+	}
+	return oldValue.VerifiedAt, nil
+}
+
+// ClearVerifiedAt clears the value of the "verified_at" field.
+func (m *DomainMutation) ClearVerifiedAt() {
+	m.verified_at = nil
+	m.clearedFields[domain.FieldVerifiedAt] = struct{}{}
+}
+
+// VerifiedAtCleared returns if the "verified_at" field was cleared in this mutation.
+func (m *DomainMutation) VerifiedAtCleared() bool {
+	_, ok := m.clearedFields[domain.FieldVerifiedAt]
+	return ok
+}
+
+// ResetVerifiedAt resets all changes to the "verified_at" field.
+func (m *DomainMutation) ResetVerifiedAt() {
+	m.verified_at = nil
+	delete(m.clearedFields, domain.FieldVerifiedAt)
+}
+
+// AddDelegationIDs adds the "delegations" edge to the Delegation entity by ids.
+func (m *DomainMutation) AddDelegationIDs(ids ...int) {
+	if m.delegations == nil {
+		m.delegations = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.delegations[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDelegations clears the "delegations" edge to the Delegation entity.
+func (m *DomainMutation) ClearDelegations() {
+	m.cleareddelegations = true
+}
+
+// DelegationsCleared reports if the "delegations" edge to the Delegation entity was cleared.
+func (m *DomainMutation) DelegationsCleared() bool {
+	return m.cleareddelegations
+}
+
+// RemoveDelegationIDs removes the "delegations" edge to the Delegation entity by IDs.
+func (m *DomainMutation) RemoveDelegationIDs(ids ...int) {
+	if m.removeddelegations == nil {
+		m.removeddelegations = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.delegations, ids[i])
+		m.removeddelegations[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDelegations returns the removed IDs of the "delegations" edge to the Delegation entity.
+func (m *DomainMutation) RemovedDelegationsIDs() (ids []int) {
+	for id := range m.removeddelegations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DelegationsIDs returns the "delegations" edge IDs in the mutation.
+func (m *DomainMutation) DelegationsIDs() (ids []int) {
+	for id := range m.delegations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDelegations resets all changes to the "delegations" edge.
+func (m *DomainMutation) ResetDelegations() {
+	m.delegations = nil
+	m.cleareddelegations = false
+	m.removeddelegations = nil
+}
+
+// AddRequestIDs adds the "requests" edge to the ApprovalRequest entity by ids.
+func (m *DomainMutation) AddRequestIDs(ids ...int) {
+	if m.requests == nil {
+		m.requests = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.requests[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRequests clears the "requests" edge to the ApprovalRequest entity.
+func (m *DomainMutation) ClearRequests() {
+	m.clearedrequests = true
+}
+
+// RequestsCleared reports if the "requests" edge to the ApprovalRequest entity was cleared.
+func (m *DomainMutation) RequestsCleared() bool {
+	return m.clearedrequests
+}
+
+// RemoveRequestIDs removes the "requests" edge to the ApprovalRequest entity by IDs.
+func (m *DomainMutation) RemoveRequestIDs(ids ...int) {
+	if m.removedrequests == nil {
+		m.removedrequests = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.requests, ids[i])
+		m.removedrequests[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRequests returns the removed IDs of the "requests" edge to the ApprovalRequest entity.
+func (m *DomainMutation) RemovedRequestsIDs() (ids []int) {
+	for id := range m.removedrequests {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RequestsIDs returns the "requests" edge IDs in the mutation.
+func (m *DomainMutation) RequestsIDs() (ids []int) {
+	for id := range m.requests {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRequests resets all changes to the "requests" edge.
+func (m *DomainMutation) ResetRequests() {
+	m.requests = nil
+	m.clearedrequests = false
+	m.removedrequests = nil
+}
+
+// Where appends a list predicates to the DomainMutation builder.
+func (m *DomainMutation) Where(ps ...predicate.Domain) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DomainMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DomainMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Domain, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DomainMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DomainMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Domain).
+func (m *DomainMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DomainMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.create_time != nil {
+		fields = append(fields, domain.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, domain.FieldUpdateTime)
+	}
+	if m.fqdn != nil {
+		fields = append(fields, domain.FieldFqdn)
+	}
+	if m.owner != nil {
+		fields = append(fields, domain.FieldOwner)
+	}
+	if m.approved != nil {
+		fields = append(fields, domain.FieldApproved)
+	}
+	if m.contacts != nil {
+		fields = append(fields, domain.FieldContacts)
+	}
+	if m.verification_token != nil {
+		fields = append(fields, domain.FieldVerificationToken)
+	}
+	if m.verification_status != nil {
+		fields = append(fields, domain.FieldVerificationStatus)
+	}
+	if m.verification_checked_at != nil {
+		fields = append(fields, domain.FieldVerificationCheckedAt)
+	}
+	if m.verified_at != nil {
+		fields = append(fields, domain.FieldVerifiedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DomainMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case domain.FieldCreateTime:
+		return m.CreateTime()
+	case domain.FieldUpdateTime:
+		return m.UpdateTime()
+	case domain.FieldFqdn:
+		return m.Fqdn()
+	case domain.FieldOwner:
+		return m.Owner()
+	case domain.FieldApproved:
+		return m.Approved()
+	case domain.FieldContacts:
+		return m.Contacts()
+	case domain.FieldVerificationToken:
+		return m.VerificationToken()
+	case domain.FieldVerificationStatus:
+		return m.VerificationStatus()
+	case domain.FieldVerificationCheckedAt:
+		return m.VerificationCheckedAt()
+	case domain.FieldVerifiedAt:
+		return m.VerifiedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DomainMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case domain.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case domain.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case domain.FieldFqdn:
+		return m.OldFqdn(ctx)
+	case domain.FieldOwner:
+		return m.OldOwner(ctx)
+	case domain.FieldApproved:
+		return m.OldApproved(ctx)
+	case domain.FieldContacts:
+		return m.OldContacts(ctx)
+	case domain.FieldVerificationToken:
+		return m.OldVerificationToken(ctx)
+	case domain.FieldVerificationStatus:
+		return m.OldVerificationStatus(ctx)
+	case domain.FieldVerificationCheckedAt:
+		return m.OldVerificationCheckedAt(ctx)
+	case domain.FieldVerifiedAt:
+		return m.OldVerifiedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Domain field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DomainMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case domain.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case domain.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case domain.FieldFqdn:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFqdn(v)
+		return nil
+	case domain.FieldOwner:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwner(v)
+		return nil
+	case domain.FieldApproved:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetApproved(v)
+		return nil
+	case domain.FieldContacts:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContacts(v)
+		return nil
+	case domain.FieldVerificationToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVerificationToken(v)
+		return nil
+	case domain.FieldVerificationStatus:
+		v, ok := value.(domain.VerificationStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVerificationStatus(v)
+		return nil
+	case domain.FieldVerificationCheckedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVerificationCheckedAt(v)
+		return nil
+	case domain.FieldVerifiedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVerifiedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Domain field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DomainMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DomainMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DomainMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Domain numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DomainMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(domain.FieldContacts) {
+		fields = append(fields, domain.FieldContacts)
+	}
+	if m.FieldCleared(domain.FieldVerificationToken) {
+		fields = append(fields, domain.FieldVerificationToken)
+	}
+	if m.FieldCleared(domain.FieldVerificationCheckedAt) {
+		fields = append(fields, domain.FieldVerificationCheckedAt)
+	}
+	if m.FieldCleared(domain.FieldVerifiedAt) {
+		fields = append(fields, domain.FieldVerifiedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DomainMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DomainMutation) ClearField(name string) error {
+	switch name {
+	case domain.FieldContacts:
+		m.ClearContacts()
+		return nil
+	case domain.FieldVerificationToken:
+		m.ClearVerificationToken()
+		return nil
+	case domain.FieldVerificationCheckedAt:
+		m.ClearVerificationCheckedAt()
+		return nil
+	case domain.FieldVerifiedAt:
+		m.ClearVerifiedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Domain nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DomainMutation) ResetField(name string) error {
+	switch name {
+	case domain.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case domain.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case domain.FieldFqdn:
+		m.ResetFqdn()
+		return nil
+	case domain.FieldOwner:
+		m.ResetOwner()
+		return nil
+	case domain.FieldApproved:
+		m.ResetApproved()
+		return nil
+	case domain.FieldContacts:
+		m.ResetContacts()
+		return nil
+	case domain.FieldVerificationToken:
+		m.ResetVerificationToken()
+		return nil
+	case domain.FieldVerificationStatus:
+		m.ResetVerificationStatus()
+		return nil
+	case domain.FieldVerificationCheckedAt:
+		m.ResetVerificationCheckedAt()
+		return nil
+	case domain.FieldVerifiedAt:
+		m.ResetVerifiedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Domain field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DomainMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.delegations != nil {
+		edges = append(edges, domain.EdgeDelegations)
+	}
+	if m.requests != nil {
+		edges = append(edges, domain.EdgeRequests)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DomainMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case domain.EdgeDelegations:
+		ids := make([]ent.Value, 0, len(m.delegations))
+		for id := range m.delegations {
+			ids = append(ids, id)
+		}
+		return ids
+	case domain.EdgeRequests:
+		ids := make([]ent.Value, 0, len(m.requests))
+		for id := range m.requests {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DomainMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removeddelegations != nil {
+		edges = append(edges, domain.EdgeDelegations)
+	}
+	if m.removedrequests != nil {
+		edges = append(edges, domain.EdgeRequests)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DomainMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case domain.EdgeDelegations:
+		ids := make([]ent.Value, 0, len(m.removeddelegations))
+		for id := range m.removeddelegations {
+			ids = append(ids, id)
+		}
+		return ids
+	case domain.EdgeRequests:
+		ids := make([]ent.Value, 0, len(m.removedrequests))
+		for id := range m.removedrequests {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DomainMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareddelegations {
+		edges = append(edges, domain.EdgeDelegations)
+	}
+	if m.clearedrequests {
+		edges = append(edges, domain.EdgeRequests)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DomainMutation) EdgeCleared(name string) bool {
+	switch name {
+	case domain.EdgeDelegations:
+		return m.cleareddelegations
+	case domain.EdgeRequests:
+		return m.clearedrequests
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DomainMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Domain unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DomainMutation) ResetEdge(name string) error {
+	switch name {
+	case domain.EdgeDelegations:
+		m.ResetDelegations()
+		return nil
+	case domain.EdgeRequests:
+		m.ResetRequests()
+		return nil
+	}
+	return fmt.Errorf("unknown Domain edge %s", name)
+}
+
+// EntitlementMutation represents an operation that mutates the Entitlement nodes in the graph.
+type EntitlementMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	create_time   *time.Time
+	update_time   *time.Time
+	kind          *entitlement.Kind
+	value         *string
+	owner         *string
+	justification *string
+	granted_by    *string
+	expires_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Entitlement, error)
+	predicates    []predicate.Entitlement
+}
+
+var _ ent.Mutation = (*EntitlementMutation)(nil)
+
+// entitlementOption allows management of the mutation configuration using functional options.
+type entitlementOption func(*EntitlementMutation)
+
+// newEntitlementMutation creates new mutation for the Entitlement entity.
+func newEntitlementMutation(c config, op Op, opts ...entitlementOption) *EntitlementMutation {
+	m := &EntitlementMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEntitlement,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withEntitlementID sets the ID field of the mutation.
+func withEntitlementID(id int) entitlementOption {
+	return func(m *EntitlementMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Entitlement
+		)
+		m.oldValue = func(ctx context.Context) (*Entitlement, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Entitlement.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withEntitlement sets the old Entitlement of the mutation.
+func withEntitlement(node *Entitlement) entitlementOption {
+	return func(m *EntitlementMutation) {
+		m.oldValue = func(context.Context) (*Entitlement, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EntitlementMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EntitlementMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EntitlementMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EntitlementMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Entitlement.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *EntitlementMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *EntitlementMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the Entitlement entity.
+// If the Entitlement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntitlementMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *EntitlementMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *EntitlementMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *EntitlementMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the Entitlement entity.
+// If the Entitlement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntitlementMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *EntitlementMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetKind sets the "kind" field.
+func (m *EntitlementMutation) SetKind(e entitlement.Kind) {
+	m.kind = &e
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *EntitlementMutation) Kind() (r entitlement.Kind, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the Entitlement entity.
+// If the Entitlement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntitlementMutation) OldKind(ctx context.Context) (v entitlement.Kind, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *EntitlementMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetValue sets the "value" field.
+func (m *EntitlementMutation) SetValue(s string) {
+	m.value = &s
+}
+
+// Value returns the value of the "value" field in the mutation.
+func (m *EntitlementMutation) Value() (r string, exists bool) {
+	v := m.value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValue returns the old "value" field's value of the Entitlement entity.
+// If the Entitlement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntitlementMutation) OldValue(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+	}
+	return oldValue.Value, nil
+}
+
+// ResetValue resets all changes to the "value" field.
+func (m *EntitlementMutation) ResetValue() {
+	m.value = nil
+}
+
+// SetOwner sets the "owner" field.
+func (m *EntitlementMutation) SetOwner(s string) {
+	m.owner = &s
+}
+
+// Owner returns the value of the "owner" field in the mutation.
+func (m *EntitlementMutation) Owner() (r string, exists bool) {
+	v := m.owner
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwner returns the old "owner" field's value of the Entitlement entity.
+// If the Entitlement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntitlementMutation) OldOwner(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwner is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwner requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwner: %w", err)
+	}
+	return oldValue.Owner, nil
+}
+
+// ResetOwner resets all changes to the "owner" field.
+func (m *EntitlementMutation) ResetOwner() {
+	m.owner = nil
+}
+
+// SetJustification sets the "justification" field.
+func (m *EntitlementMutation) SetJustification(s string) {
+	m.justification = &s
+}
+
+// Justification returns the value of the "justification" field in the mutation.
+func (m *EntitlementMutation) Justification() (r string, exists bool) {
+	v := m.justification
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJustification returns the old "justification" field's value of the Entitlement entity.
+// If the Entitlement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntitlementMutation) OldJustification(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJustification is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJustification requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJustification: %w", err)
+	}
+	return oldValue.Justification, nil
+}
+
+// ResetJustification resets all changes to the "justification" field.
+func (m *EntitlementMutation) ResetJustification() {
+	m.justification = nil
+}
+
+// SetGrantedBy sets the "granted_by" field.
+func (m *EntitlementMutation) SetGrantedBy(s string) {
+	m.granted_by = &s
+}
+
+// GrantedBy returns the value of the "granted_by" field in the mutation.
+func (m *EntitlementMutation) GrantedBy() (r string, exists bool) {
+	v := m.granted_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGrantedBy returns the old "granted_by" field's value of the Entitlement entity.
+// If the Entitlement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntitlementMutation) OldGrantedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGrantedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGrantedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGrantedBy: %w", err)
+	}
+	return oldValue.GrantedBy, nil
+}
+
+// ResetGrantedBy resets all changes to the "granted_by" field.
+func (m *EntitlementMutation) ResetGrantedBy() {
+	m.granted_by = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *EntitlementMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *EntitlementMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the Entitlement entity.
+// If the Entitlement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntitlementMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *EntitlementMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[entitlement.FieldExpiresAt] = struct{}{}
+}
+
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *EntitlementMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[entitlement.FieldExpiresAt]
+	return ok
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *EntitlementMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, entitlement.FieldExpiresAt)
+}
+
+// Where appends a list predicates to the EntitlementMutation builder.
+func (m *EntitlementMutation) Where(ps ...predicate.Entitlement) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EntitlementMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EntitlementMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Entitlement, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EntitlementMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EntitlementMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Entitlement).
+func (m *EntitlementMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EntitlementMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.create_time != nil {
+		fields = append(fields, entitlement.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, entitlement.FieldUpdateTime)
+	}
+	if m.kind != nil {
+		fields = append(fields, entitlement.FieldKind)
+	}
+	if m.value != nil {
+		fields = append(fields, entitlement.FieldValue)
+	}
+	if m.owner != nil {
+		fields = append(fields, entitlement.FieldOwner)
+	}
+	if m.justification != nil {
+		fields = append(fields, entitlement.FieldJustification)
+	}
+	if m.granted_by != nil {
+		fields = append(fields, entitlement.FieldGrantedBy)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, entitlement.FieldExpiresAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EntitlementMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case entitlement.FieldCreateTime:
+		return m.CreateTime()
+	case entitlement.FieldUpdateTime:
+		return m.UpdateTime()
+	case entitlement.FieldKind:
+		return m.Kind()
+	case entitlement.FieldValue:
+		return m.Value()
+	case entitlement.FieldOwner:
+		return m.Owner()
+	case entitlement.FieldJustification:
+		return m.Justification()
+	case entitlement.FieldGrantedBy:
+		return m.GrantedBy()
+	case entitlement.FieldExpiresAt:
+		return m.ExpiresAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EntitlementMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case entitlement.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case entitlement.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case entitlement.FieldKind:
+		return m.OldKind(ctx)
+	case entitlement.FieldValue:
+		return m.OldValue(ctx)
+	case entitlement.FieldOwner:
+		return m.OldOwner(ctx)
+	case entitlement.FieldJustification:
+		return m.OldJustification(ctx)
+	case entitlement.FieldGrantedBy:
+		return m.OldGrantedBy(ctx)
+	case entitlement.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Entitlement field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EntitlementMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case entitlement.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case entitlement.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case entitlement.FieldKind:
+		v, ok := value.(entitlement.Kind)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case entitlement.FieldValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValue(v)
+		return nil
+	case entitlement.FieldOwner:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwner(v)
+		return nil
+	case entitlement.FieldJustification:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJustification(v)
+		return nil
+	case entitlement.FieldGrantedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGrantedBy(v)
+		return nil
+	case entitlement.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Entitlement field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EntitlementMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EntitlementMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EntitlementMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Entitlement numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EntitlementMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(entitlement.FieldExpiresAt) {
+		fields = append(fields, entitlement.FieldExpiresAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EntitlementMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EntitlementMutation) ClearField(name string) error {
+	switch name {
+	case entitlement.FieldExpiresAt:
+		m.ClearExpiresAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Entitlement nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EntitlementMutation) ResetField(name string) error {
+	switch name {
+	case entitlement.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case entitlement.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case entitlement.FieldKind:
+		m.ResetKind()
+		return nil
+	case entitlement.FieldValue:
+		m.ResetValue()
+		return nil
+	case entitlement.FieldOwner:
+		m.ResetOwner()
+		return nil
+	case entitlement.FieldJustification:
+		m.ResetJustification()
+		return nil
+	case entitlement.FieldGrantedBy:
+		m.ResetGrantedBy()
+		return nil
+	case entitlement.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Entitlement field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EntitlementMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EntitlementMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EntitlementMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EntitlementMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EntitlementMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EntitlementMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EntitlementMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Entitlement unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EntitlementMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Entitlement edge %s", name)
+}
+
+// MailboxMutation represents an operation that mutates the Mailbox nodes in the graph.
+type MailboxMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	create_time        *time.Time
+	update_time        *time.Time
+	email              *string
+	owner              *string
+	clearedFields      map[string]struct{}
+	delegations        map[int]struct{}
+	removeddelegations map[int]struct{}
+	cleareddelegations bool
+	done               bool
+	oldValue           func(context.Context) (*Mailbox, error)
+	predicates         []predicate.Mailbox
+}
+
+var _ ent.Mutation = (*MailboxMutation)(nil)
+
+// mailboxOption allows management of the mutation configuration using functional options.
+type mailboxOption func(*MailboxMutation)
+
+// newMailboxMutation creates new mutation for the Mailbox entity.
+func newMailboxMutation(c config, op Op, opts ...mailboxOption) *MailboxMutation {
+	m := &MailboxMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMailbox,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMailboxID sets the ID field of the mutation.
+func withMailboxID(id int) mailboxOption {
+	return func(m *MailboxMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Mailbox
+		)
+		m.oldValue = func(ctx context.Context) (*Mailbox, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Mailbox.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMailbox sets the old Mailbox of the mutation.
+func withMailbox(node *Mailbox) mailboxOption {
+	return func(m *MailboxMutation) {
+		m.oldValue = func(context.Context) (*Mailbox, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MailboxMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MailboxMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MailboxMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MailboxMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Mailbox.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *MailboxMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *MailboxMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the Mailbox entity.
+// If the Mailbox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MailboxMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *MailboxMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *MailboxMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *MailboxMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the Mailbox entity.
+// If the Mailbox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MailboxMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *MailboxMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetEmail sets the "email" field.
+func (m *MailboxMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *MailboxMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the Mailbox entity.
+// If the Mailbox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MailboxMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *MailboxMutation) ResetEmail() {
+	m.email = nil
+}
+
+// SetOwner sets the "owner" field.
+func (m *MailboxMutation) SetOwner(s string) {
+	m.owner = &s
+}
+
+// Owner returns the value of the "owner" field in the mutation.
+func (m *MailboxMutation) Owner() (r string, exists bool) {
+	v := m.owner
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwner returns the old "owner" field's value of the Mailbox entity.
+// If the Mailbox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MailboxMutation) OldOwner(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwner is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwner requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwner: %w", err)
+	}
+	return oldValue.Owner, nil
+}
+
+// ResetOwner resets all changes to the "owner" field.
+func (m *MailboxMutation) ResetOwner() {
+	m.owner = nil
+}
+
+// AddDelegationIDs adds the "delegations" edge to the MailboxDelegation entity by ids.
+func (m *MailboxMutation) AddDelegationIDs(ids ...int) {
+	if m.delegations == nil {
+		m.delegations = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.delegations[ids[i]] = struct{}{}
+	}
 }
 
-// SetDomainID sets the "domain" edge to the Domain entity by id.
-func (m *DelegationMutation) SetDomainID(id int) {
-	m.domain = &id
+// ClearDelegations clears the "delegations" edge to the MailboxDelegation entity.
+func (m *MailboxMutation) ClearDelegations() {
+	m.cleareddelegations = true
 }
 
-// ClearDomain clears the "domain" edge to the Domain entity.
-func (m *DelegationMutation) ClearDomain() {
-	m.cleareddomain = true
+// DelegationsCleared reports if the "delegations" edge to the MailboxDelegation entity was cleared.
+func (m *MailboxMutation) DelegationsCleared() bool {
+	return m.cleareddelegations
 }
 
-// DomainCleared reports if the "domain" edge to the Domain entity was cleared.
-func (m *DelegationMutation) DomainCleared() bool {
-	return m.cleareddomain
+// RemoveDelegationIDs removes the "delegations" edge to the MailboxDelegation entity by IDs.
+func (m *MailboxMutation) RemoveDelegationIDs(ids ...int) {
+	if m.removeddelegations == nil {
+		m.removeddelegations = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.delegations, ids[i])
+		m.removeddelegations[ids[i]] = struct{}{}
+	}
 }
 
-// DomainID returns the "domain" edge ID in the mutation.
-func (m *DelegationMutation) DomainID() (id int, exists bool) {
-	if m.domain != nil {
-		return *m.domain, true
+// RemovedDelegations returns the removed IDs of the "delegations" edge to the MailboxDelegation entity.
+func (m *MailboxMutation) RemovedDelegationsIDs() (ids []int) {
+	for id := range m.removeddelegations {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// DomainIDs returns the "domain" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// DomainID instead. It exists only for internal usage by the builders.
-func (m *DelegationMutation) DomainIDs() (ids []int) {
-	if id := m.domain; id != nil {
-		ids = append(ids, *id)
+// DelegationsIDs returns the "delegations" edge IDs in the mutation.
+func (m *MailboxMutation) DelegationsIDs() (ids []int) {
+	for id := range m.delegations {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetDomain resets all changes to the "domain" edge.
-func (m *DelegationMutation) ResetDomain() {
-	m.domain = nil
-	m.cleareddomain = false
+// ResetDelegations resets all changes to the "delegations" edge.
+func (m *MailboxMutation) ResetDelegations() {
+	m.delegations = nil
+	m.cleareddelegations = false
+	m.removeddelegations = nil
 }
 
-// Where appends a list predicates to the DelegationMutation builder.
-func (m *DelegationMutation) Where(ps ...predicate.Delegation) {
+// Where appends a list predicates to the MailboxMutation builder.
+func (m *MailboxMutation) Where(ps ...predicate.Mailbox) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the DelegationMutation builder. Using this method,
+// WhereP appends storage-level predicates to the MailboxMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DelegationMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Delegation, len(ps))
+func (m *MailboxMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Mailbox, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -307,33 +3637,36 @@ func (m *DelegationMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *DelegationMutation) Op() Op {
+func (m *MailboxMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *DelegationMutation) SetOp(op Op) {
+func (m *MailboxMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Delegation).
-func (m *DelegationMutation) Type() string {
+// Type returns the node type of this mutation (Mailbox).
+func (m *MailboxMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *DelegationMutation) Fields() []string {
-	fields := make([]string, 0, 3)
+func (m *MailboxMutation) Fields() []string {
+	fields := make([]string, 0, 4)
 	if m.create_time != nil {
-		fields = append(fields, delegation.FieldCreateTime)
+		fields = append(fields, mailbox.FieldCreateTime)
 	}
 	if m.update_time != nil {
-		fields = append(fields, delegation.FieldUpdateTime)
+		fields = append(fields, mailbox.FieldUpdateTime)
 	}
-	if m.user != nil {
-		fields = append(fields, delegation.FieldUser)
+	if m.email != nil {
+		fields = append(fields, mailbox.FieldEmail)
+	}
+	if m.owner != nil {
+		fields = append(fields, mailbox.FieldOwner)
 	}
 	return fields
 }
@@ -341,14 +3674,16 @@ func (m *DelegationMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *DelegationMutation) Field(name string) (ent.Value, bool) {
+func (m *MailboxMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case delegation.FieldCreateTime:
+	case mailbox.FieldCreateTime:
 		return m.CreateTime()
-	case delegation.FieldUpdateTime:
+	case mailbox.FieldUpdateTime:
 		return m.UpdateTime()
-	case delegation.FieldUser:
-		return m.User()
+	case mailbox.FieldEmail:
+		return m.Email()
+	case mailbox.FieldOwner:
+		return m.Owner()
 	}
 	return nil, false
 }
@@ -356,211 +3691,230 @@ func (m *DelegationMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *DelegationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *MailboxMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case delegation.FieldCreateTime:
+	case mailbox.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case delegation.FieldUpdateTime:
+	case mailbox.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case delegation.FieldUser:
-		return m.OldUser(ctx)
+	case mailbox.FieldEmail:
+		return m.OldEmail(ctx)
+	case mailbox.FieldOwner:
+		return m.OldOwner(ctx)
 	}
-	return nil, fmt.Errorf("unknown Delegation field %s", name)
+	return nil, fmt.Errorf("unknown Mailbox field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DelegationMutation) SetField(name string, value ent.Value) error {
+func (m *MailboxMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case delegation.FieldCreateTime:
+	case mailbox.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateTime(v)
 		return nil
-	case delegation.FieldUpdateTime:
+	case mailbox.FieldUpdateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdateTime(v)
 		return nil
-	case delegation.FieldUser:
+	case mailbox.FieldEmail:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUser(v)
+		m.SetEmail(v)
+		return nil
+	case mailbox.FieldOwner:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwner(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Delegation field %s", name)
+	return fmt.Errorf("unknown Mailbox field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *DelegationMutation) AddedFields() []string {
+func (m *MailboxMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *DelegationMutation) AddedField(name string) (ent.Value, bool) {
+func (m *MailboxMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DelegationMutation) AddField(name string, value ent.Value) error {
+func (m *MailboxMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Delegation numeric field %s", name)
+	return fmt.Errorf("unknown Mailbox numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *DelegationMutation) ClearedFields() []string {
+func (m *MailboxMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *DelegationMutation) FieldCleared(name string) bool {
+func (m *MailboxMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *DelegationMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Delegation nullable field %s", name)
+func (m *MailboxMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Mailbox nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *DelegationMutation) ResetField(name string) error {
+func (m *MailboxMutation) ResetField(name string) error {
 	switch name {
-	case delegation.FieldCreateTime:
+	case mailbox.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
-	case delegation.FieldUpdateTime:
+	case mailbox.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case delegation.FieldUser:
-		m.ResetUser()
+	case mailbox.FieldEmail:
+		m.ResetEmail()
+		return nil
+	case mailbox.FieldOwner:
+		m.ResetOwner()
 		return nil
 	}
-	return fmt.Errorf("unknown Delegation field %s", name)
+	return fmt.Errorf("unknown Mailbox field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DelegationMutation) AddedEdges() []string {
+func (m *MailboxMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.domain != nil {
-		edges = append(edges, delegation.EdgeDomain)
+	if m.delegations != nil {
+		edges = append(edges, mailbox.EdgeDelegations)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DelegationMutation) AddedIDs(name string) []ent.Value {
+func (m *MailboxMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case delegation.EdgeDomain:
-		if id := m.domain; id != nil {
-			return []ent.Value{*id}
+	case mailbox.EdgeDelegations:
+		ids := make([]ent.Value, 0, len(m.delegations))
+		for id := range m.delegations {
+			ids = append(ids, id)
 		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DelegationMutation) RemovedEdges() []string {
+func (m *MailboxMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
+	if m.removeddelegations != nil {
+		edges = append(edges, mailbox.EdgeDelegations)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *DelegationMutation) RemovedIDs(name string) []ent.Value {
+func (m *MailboxMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case mailbox.EdgeDelegations:
+		ids := make([]ent.Value, 0, len(m.removeddelegations))
+		for id := range m.removeddelegations {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DelegationMutation) ClearedEdges() []string {
+func (m *MailboxMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.cleareddomain {
-		edges = append(edges, delegation.EdgeDomain)
+	if m.cleareddelegations {
+		edges = append(edges, mailbox.EdgeDelegations)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DelegationMutation) EdgeCleared(name string) bool {
+func (m *MailboxMutation) EdgeCleared(name string) bool {
 	switch name {
-	case delegation.EdgeDomain:
-		return m.cleareddomain
+	case mailbox.EdgeDelegations:
+		return m.cleareddelegations
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *DelegationMutation) ClearEdge(name string) error {
+func (m *MailboxMutation) ClearEdge(name string) error {
 	switch name {
-	case delegation.EdgeDomain:
-		m.ClearDomain()
-		return nil
 	}
-	return fmt.Errorf("unknown Delegation unique edge %s", name)
+	return fmt.Errorf("unknown Mailbox unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *DelegationMutation) ResetEdge(name string) error {
+func (m *MailboxMutation) ResetEdge(name string) error {
 	switch name {
-	case delegation.EdgeDomain:
-		m.ResetDomain()
+	case mailbox.EdgeDelegations:
+		m.ResetDelegations()
 		return nil
 	}
-	return fmt.Errorf("unknown Delegation edge %s", name)
+	return fmt.Errorf("unknown Mailbox edge %s", name)
 }
 
-// DomainMutation represents an operation that mutates the Domain nodes in the graph.
-type DomainMutation struct {
+// MailboxDelegationMutation represents an operation that mutates the MailboxDelegation nodes in the graph.
+type MailboxDelegationMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *int
-	create_time        *time.Time
-	update_time        *time.Time
-	fqdn               *string
-	owner              *string
-	approved           *bool
-	clearedFields      map[string]struct{}
-	delegations        map[int]struct{}
-	removeddelegations map[int]struct{}
-	cleareddelegations bool
-	done               bool
-	oldValue           func(context.Context) (*Domain, error)
-	predicates         []predicate.Domain
+	op             Op
+	typ            string
+	id             *int
+	create_time    *time.Time
+	update_time    *time.Time
+	user           *string
+	clearedFields  map[string]struct{}
+	mailbox        *int
+	clearedmailbox bool
+	done           bool
+	oldValue       func(context.Context) (*MailboxDelegation, error)
+	predicates     []predicate.MailboxDelegation
 }
 
-var _ ent.Mutation = (*DomainMutation)(nil)
+var _ ent.Mutation = (*MailboxDelegationMutation)(nil)
 
-// domainOption allows management of the mutation configuration using functional options.
-type domainOption func(*DomainMutation)
+// mailboxdelegationOption allows management of the mutation configuration using functional options.
+type mailboxdelegationOption func(*MailboxDelegationMutation)
 
-// newDomainMutation creates new mutation for the Domain entity.
-func newDomainMutation(c config, op Op, opts ...domainOption) *DomainMutation {
-	m := &DomainMutation{
+// newMailboxDelegationMutation creates new mutation for the MailboxDelegation entity.
+func newMailboxDelegationMutation(c config, op Op, opts ...mailboxdelegationOption) *MailboxDelegationMutation {
+	m := &MailboxDelegationMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeDomain,
+		typ:           TypeMailboxDelegation,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -569,20 +3923,20 @@ func newDomainMutation(c config, op Op, opts ...domainOption) *DomainMutation {
 	return m
 }
 
-// withDomainID sets the ID field of the mutation.
-func withDomainID(id int) domainOption {
-	return func(m *DomainMutation) {
+// withMailboxDelegationID sets the ID field of the mutation.
+func withMailboxDelegationID(id int) mailboxdelegationOption {
+	return func(m *MailboxDelegationMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Domain
+			value *MailboxDelegation
 		)
-		m.oldValue = func(ctx context.Context) (*Domain, error) {
+		m.oldValue = func(ctx context.Context) (*MailboxDelegation, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Domain.Get(ctx, id)
+					value, err = m.Client().MailboxDelegation.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -591,10 +3945,10 @@ func withDomainID(id int) domainOption {
 	}
 }
 
-// withDomain sets the old Domain of the mutation.
-func withDomain(node *Domain) domainOption {
-	return func(m *DomainMutation) {
-		m.oldValue = func(context.Context) (*Domain, error) {
+// withMailboxDelegation sets the old MailboxDelegation of the mutation.
+func withMailboxDelegation(node *MailboxDelegation) mailboxdelegationOption {
+	return func(m *MailboxDelegationMutation) {
+		m.oldValue = func(context.Context) (*MailboxDelegation, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -603,7 +3957,7 @@ func withDomain(node *Domain) domainOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DomainMutation) Client() *Client {
+func (m MailboxDelegationMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -611,7 +3965,7 @@ func (m DomainMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m DomainMutation) Tx() (*Tx, error) {
+func (m MailboxDelegationMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -622,7 +3976,7 @@ func (m DomainMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *DomainMutation) ID() (id int, exists bool) {
+func (m *MailboxDelegationMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -632,265 +3986,178 @@ func (m *DomainMutation) ID() (id int, exists bool) {
 // IDs queries the database and returns the entity ids that match the mutation's predicate.
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *DomainMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Domain.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
-}
-
-// SetCreateTime sets the "create_time" field.
-func (m *DomainMutation) SetCreateTime(t time.Time) {
-	m.create_time = &t
-}
-
-// CreateTime returns the value of the "create_time" field in the mutation.
-func (m *DomainMutation) CreateTime() (r time.Time, exists bool) {
-	v := m.create_time
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldCreateTime returns the old "create_time" field's value of the Domain entity.
-// If the Domain object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DomainMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreateTime requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
-	}
-	return oldValue.CreateTime, nil
-}
-
-// ResetCreateTime resets all changes to the "create_time" field.
-func (m *DomainMutation) ResetCreateTime() {
-	m.create_time = nil
-}
-
-// SetUpdateTime sets the "update_time" field.
-func (m *DomainMutation) SetUpdateTime(t time.Time) {
-	m.update_time = &t
-}
-
-// UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *DomainMutation) UpdateTime() (r time.Time, exists bool) {
-	v := m.update_time
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldUpdateTime returns the old "update_time" field's value of the Domain entity.
-// If the Domain object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DomainMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+// or updated by the mutation.
+func (m *MailboxDelegationMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MailboxDelegation.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return oldValue.UpdateTime, nil
-}
-
-// ResetUpdateTime resets all changes to the "update_time" field.
-func (m *DomainMutation) ResetUpdateTime() {
-	m.update_time = nil
 }
 
-// SetFqdn sets the "fqdn" field.
-func (m *DomainMutation) SetFqdn(s string) {
-	m.fqdn = &s
+// SetCreateTime sets the "create_time" field.
+func (m *MailboxDelegationMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
 }
 
-// Fqdn returns the value of the "fqdn" field in the mutation.
-func (m *DomainMutation) Fqdn() (r string, exists bool) {
-	v := m.fqdn
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *MailboxDelegationMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFqdn returns the old "fqdn" field's value of the Domain entity.
-// If the Domain object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the MailboxDelegation entity.
+// If the MailboxDelegation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DomainMutation) OldFqdn(ctx context.Context) (v string, err error) {
+func (m *MailboxDelegationMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFqdn is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFqdn requires an ID field in the mutation")
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFqdn: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
 	}
-	return oldValue.Fqdn, nil
+	return oldValue.CreateTime, nil
 }
 
-// ResetFqdn resets all changes to the "fqdn" field.
-func (m *DomainMutation) ResetFqdn() {
-	m.fqdn = nil
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *MailboxDelegationMutation) ResetCreateTime() {
+	m.create_time = nil
 }
 
-// SetOwner sets the "owner" field.
-func (m *DomainMutation) SetOwner(s string) {
-	m.owner = &s
+// SetUpdateTime sets the "update_time" field.
+func (m *MailboxDelegationMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
 }
 
-// Owner returns the value of the "owner" field in the mutation.
-func (m *DomainMutation) Owner() (r string, exists bool) {
-	v := m.owner
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *MailboxDelegationMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOwner returns the old "owner" field's value of the Domain entity.
-// If the Domain object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the MailboxDelegation entity.
+// If the MailboxDelegation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DomainMutation) OldOwner(ctx context.Context) (v string, err error) {
+func (m *MailboxDelegationMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOwner is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOwner requires an ID field in the mutation")
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOwner: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
 	}
-	return oldValue.Owner, nil
+	return oldValue.UpdateTime, nil
 }
 
-// ResetOwner resets all changes to the "owner" field.
-func (m *DomainMutation) ResetOwner() {
-	m.owner = nil
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *MailboxDelegationMutation) ResetUpdateTime() {
+	m.update_time = nil
 }
 
-// SetApproved sets the "approved" field.
-func (m *DomainMutation) SetApproved(b bool) {
-	m.approved = &b
+// SetUser sets the "user" field.
+func (m *MailboxDelegationMutation) SetUser(s string) {
+	m.user = &s
 }
 
-// Approved returns the value of the "approved" field in the mutation.
-func (m *DomainMutation) Approved() (r bool, exists bool) {
-	v := m.approved
+// User returns the value of the "user" field in the mutation.
+func (m *MailboxDelegationMutation) User() (r string, exists bool) {
+	v := m.user
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldApproved returns the old "approved" field's value of the Domain entity.
-// If the Domain object wasn't provided to the builder, the object is fetched from the database.
+// OldUser returns the old "user" field's value of the MailboxDelegation entity.
+// If the MailboxDelegation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DomainMutation) OldApproved(ctx context.Context) (v bool, err error) {
+func (m *MailboxDelegationMutation) OldUser(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldApproved is only allowed on UpdateOne operations")
+		return v, errors.New("OldUser is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldApproved requires an ID field in the mutation")
+		return v, errors.New("OldUser requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldApproved: %w", err)
+		return v, fmt.Errorf("querying old value for OldUser: %w", err)
 	}
-	return oldValue.Approved, nil
-}
-
-// ResetApproved resets all changes to the "approved" field.
-func (m *DomainMutation) ResetApproved() {
-	m.approved = nil
+	return oldValue.User, nil
 }
 
-// AddDelegationIDs adds the "delegations" edge to the Delegation entity by ids.
-func (m *DomainMutation) AddDelegationIDs(ids ...int) {
-	if m.delegations == nil {
-		m.delegations = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.delegations[ids[i]] = struct{}{}
-	}
+// ResetUser resets all changes to the "user" field.
+func (m *MailboxDelegationMutation) ResetUser() {
+	m.user = nil
 }
 
-// ClearDelegations clears the "delegations" edge to the Delegation entity.
-func (m *DomainMutation) ClearDelegations() {
-	m.cleareddelegations = true
+// SetMailboxID sets the "mailbox" edge to the Mailbox entity by id.
+func (m *MailboxDelegationMutation) SetMailboxID(id int) {
+	m.mailbox = &id
 }
 
-// DelegationsCleared reports if the "delegations" edge to the Delegation entity was cleared.
-func (m *DomainMutation) DelegationsCleared() bool {
-	return m.cleareddelegations
+// ClearMailbox clears the "mailbox" edge to the Mailbox entity.
+func (m *MailboxDelegationMutation) ClearMailbox() {
+	m.clearedmailbox = true
 }
 
-// RemoveDelegationIDs removes the "delegations" edge to the Delegation entity by IDs.
-func (m *DomainMutation) RemoveDelegationIDs(ids ...int) {
-	if m.removeddelegations == nil {
-		m.removeddelegations = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.delegations, ids[i])
-		m.removeddelegations[ids[i]] = struct{}{}
-	}
+// MailboxCleared reports if the "mailbox" edge to the Mailbox entity was cleared.
+func (m *MailboxDelegationMutation) MailboxCleared() bool {
+	return m.clearedmailbox
 }
 
-// RemovedDelegations returns the removed IDs of the "delegations" edge to the Delegation entity.
-func (m *DomainMutation) RemovedDelegationsIDs() (ids []int) {
-	for id := range m.removeddelegations {
-		ids = append(ids, id)
+// MailboxID returns the "mailbox" edge ID in the mutation.
+func (m *MailboxDelegationMutation) MailboxID() (id int, exists bool) {
+	if m.mailbox != nil {
+		return *m.mailbox, true
 	}
 	return
 }
 
-// DelegationsIDs returns the "delegations" edge IDs in the mutation.
-func (m *DomainMutation) DelegationsIDs() (ids []int) {
-	for id := range m.delegations {
-		ids = append(ids, id)
+// MailboxIDs returns the "mailbox" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MailboxID instead. It exists only for internal usage by the builders.
+func (m *MailboxDelegationMutation) MailboxIDs() (ids []int) {
+	if id := m.mailbox; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetDelegations resets all changes to the "delegations" edge.
-func (m *DomainMutation) ResetDelegations() {
-	m.delegations = nil
-	m.cleareddelegations = false
-	m.removeddelegations = nil
+// ResetMailbox resets all changes to the "mailbox" edge.
+func (m *MailboxDelegationMutation) ResetMailbox() {
+	m.mailbox = nil
+	m.clearedmailbox = false
 }
 
-// Where appends a list predicates to the DomainMutation builder.
-func (m *DomainMutation) Where(ps ...predicate.Domain) {
+// Where appends a list predicates to the MailboxDelegationMutation builder.
+func (m *MailboxDelegationMutation) Where(ps ...predicate.MailboxDelegation) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the DomainMutation builder. Using this method,
+// WhereP appends storage-level predicates to the MailboxDelegationMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DomainMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Domain, len(ps))
+func (m *MailboxDelegationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MailboxDelegation, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -898,39 +4165,33 @@ func (m *DomainMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *DomainMutation) Op() Op {
+func (m *MailboxDelegationMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *DomainMutation) SetOp(op Op) {
+func (m *MailboxDelegationMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Domain).
-func (m *DomainMutation) Type() string {
+// Type returns the node type of this mutation (MailboxDelegation).
+func (m *MailboxDelegationMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *DomainMutation) Fields() []string {
-	fields := make([]string, 0, 5)
+func (m *MailboxDelegationMutation) Fields() []string {
+	fields := make([]string, 0, 3)
 	if m.create_time != nil {
-		fields = append(fields, domain.FieldCreateTime)
+		fields = append(fields, mailboxdelegation.FieldCreateTime)
 	}
 	if m.update_time != nil {
-		fields = append(fields, domain.FieldUpdateTime)
-	}
-	if m.fqdn != nil {
-		fields = append(fields, domain.FieldFqdn)
-	}
-	if m.owner != nil {
-		fields = append(fields, domain.FieldOwner)
+		fields = append(fields, mailboxdelegation.FieldUpdateTime)
 	}
-	if m.approved != nil {
-		fields = append(fields, domain.FieldApproved)
+	if m.user != nil {
+		fields = append(fields, mailboxdelegation.FieldUser)
 	}
 	return fields
 }
@@ -938,18 +4199,14 @@ func (m *DomainMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *DomainMutation) Field(name string) (ent.Value, bool) {
+func (m *MailboxDelegationMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case domain.FieldCreateTime:
+	case mailboxdelegation.FieldCreateTime:
 		return m.CreateTime()
-	case domain.FieldUpdateTime:
+	case mailboxdelegation.FieldUpdateTime:
 		return m.UpdateTime()
-	case domain.FieldFqdn:
-		return m.Fqdn()
-	case domain.FieldOwner:
-		return m.Owner()
-	case domain.FieldApproved:
-		return m.Approved()
+	case mailboxdelegation.FieldUser:
+		return m.User()
 	}
 	return nil, false
 }
@@ -957,210 +4214,176 @@ func (m *DomainMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *DomainMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *MailboxDelegationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case domain.FieldCreateTime:
+	case mailboxdelegation.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case domain.FieldUpdateTime:
+	case mailboxdelegation.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case domain.FieldFqdn:
-		return m.OldFqdn(ctx)
-	case domain.FieldOwner:
-		return m.OldOwner(ctx)
-	case domain.FieldApproved:
-		return m.OldApproved(ctx)
+	case mailboxdelegation.FieldUser:
+		return m.OldUser(ctx)
 	}
-	return nil, fmt.Errorf("unknown Domain field %s", name)
+	return nil, fmt.Errorf("unknown MailboxDelegation field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DomainMutation) SetField(name string, value ent.Value) error {
+func (m *MailboxDelegationMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case domain.FieldCreateTime:
+	case mailboxdelegation.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateTime(v)
 		return nil
-	case domain.FieldUpdateTime:
+	case mailboxdelegation.FieldUpdateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdateTime(v)
 		return nil
-	case domain.FieldFqdn:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFqdn(v)
-		return nil
-	case domain.FieldOwner:
+	case mailboxdelegation.FieldUser:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetOwner(v)
-		return nil
-	case domain.FieldApproved:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetApproved(v)
+		m.SetUser(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Domain field %s", name)
+	return fmt.Errorf("unknown MailboxDelegation field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *DomainMutation) AddedFields() []string {
+func (m *MailboxDelegationMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *DomainMutation) AddedField(name string) (ent.Value, bool) {
+func (m *MailboxDelegationMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DomainMutation) AddField(name string, value ent.Value) error {
+func (m *MailboxDelegationMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Domain numeric field %s", name)
+	return fmt.Errorf("unknown MailboxDelegation numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *DomainMutation) ClearedFields() []string {
+func (m *MailboxDelegationMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *DomainMutation) FieldCleared(name string) bool {
+func (m *MailboxDelegationMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *DomainMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Domain nullable field %s", name)
+func (m *MailboxDelegationMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MailboxDelegation nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *DomainMutation) ResetField(name string) error {
+func (m *MailboxDelegationMutation) ResetField(name string) error {
 	switch name {
-	case domain.FieldCreateTime:
+	case mailboxdelegation.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
-	case domain.FieldUpdateTime:
+	case mailboxdelegation.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case domain.FieldFqdn:
-		m.ResetFqdn()
-		return nil
-	case domain.FieldOwner:
-		m.ResetOwner()
-		return nil
-	case domain.FieldApproved:
-		m.ResetApproved()
+	case mailboxdelegation.FieldUser:
+		m.ResetUser()
 		return nil
 	}
-	return fmt.Errorf("unknown Domain field %s", name)
+	return fmt.Errorf("unknown MailboxDelegation field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DomainMutation) AddedEdges() []string {
+func (m *MailboxDelegationMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.delegations != nil {
-		edges = append(edges, domain.EdgeDelegations)
+	if m.mailbox != nil {
+		edges = append(edges, mailboxdelegation.EdgeMailbox)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DomainMutation) AddedIDs(name string) []ent.Value {
+func (m *MailboxDelegationMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case domain.EdgeDelegations:
-		ids := make([]ent.Value, 0, len(m.delegations))
-		for id := range m.delegations {
-			ids = append(ids, id)
+	case mailboxdelegation.EdgeMailbox:
+		if id := m.mailbox; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DomainMutation) RemovedEdges() []string {
+func (m *MailboxDelegationMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.removeddelegations != nil {
-		edges = append(edges, domain.EdgeDelegations)
-	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *DomainMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case domain.EdgeDelegations:
-		ids := make([]ent.Value, 0, len(m.removeddelegations))
-		for id := range m.removeddelegations {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *MailboxDelegationMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DomainMutation) ClearedEdges() []string {
+func (m *MailboxDelegationMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.cleareddelegations {
-		edges = append(edges, domain.EdgeDelegations)
+	if m.clearedmailbox {
+		edges = append(edges, mailboxdelegation.EdgeMailbox)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DomainMutation) EdgeCleared(name string) bool {
+func (m *MailboxDelegationMutation) EdgeCleared(name string) bool {
 	switch name {
-	case domain.EdgeDelegations:
-		return m.cleareddelegations
+	case mailboxdelegation.EdgeMailbox:
+		return m.clearedmailbox
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *DomainMutation) ClearEdge(name string) error {
+func (m *MailboxDelegationMutation) ClearEdge(name string) error {
 	switch name {
+	case mailboxdelegation.EdgeMailbox:
+		m.ClearMailbox()
+		return nil
 	}
-	return fmt.Errorf("unknown Domain unique edge %s", name)
+	return fmt.Errorf("unknown MailboxDelegation unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *DomainMutation) ResetEdge(name string) error {
+func (m *MailboxDelegationMutation) ResetEdge(name string) error {
 	switch name {
-	case domain.EdgeDelegations:
-		m.ResetDelegations()
+	case mailboxdelegation.EdgeMailbox:
+		m.ResetMailbox()
 		return nil
 	}
-	return fmt.Errorf("unknown Domain edge %s", name)
+	return fmt.Errorf("unknown MailboxDelegation edge %s", name)
 }
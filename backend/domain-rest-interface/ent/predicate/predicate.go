@@ -6,8 +6,20 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// ApprovalRequest is the predicate function for approvalrequest builders.
+type ApprovalRequest func(*sql.Selector)
+
 // Delegation is the predicate function for delegation builders.
 type Delegation func(*sql.Selector)
 
 // Domain is the predicate function for domain builders.
 type Domain func(*sql.Selector)
+
+// Entitlement is the predicate function for entitlement builders.
+type Entitlement func(*sql.Selector)
+
+// Mailbox is the predicate function for mailbox builders.
+type Mailbox func(*sql.Selector)
+
+// MailboxDelegation is the predicate function for mailboxdelegation builders.
+type MailboxDelegation func(*sql.Selector)
@@ -5,8 +5,12 @@ package ent
 import (
 	"time"
 
+	"github.com/hm-edu/domain-rest-interface/ent/approvalrequest"
 	"github.com/hm-edu/domain-rest-interface/ent/delegation"
 	"github.com/hm-edu/domain-rest-interface/ent/domain"
+	"github.com/hm-edu/domain-rest-interface/ent/entitlement"
+	"github.com/hm-edu/domain-rest-interface/ent/mailbox"
+	"github.com/hm-edu/domain-rest-interface/ent/mailboxdelegation"
 	"github.com/hm-edu/domain-rest-interface/ent/schema"
 )
 
@@ -14,6 +18,29 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	approvalrequestMixin := schema.ApprovalRequest{}.Mixin()
+	approvalrequestMixinFields0 := approvalrequestMixin[0].Fields()
+	_ = approvalrequestMixinFields0
+	approvalrequestFields := schema.ApprovalRequest{}.Fields()
+	_ = approvalrequestFields
+	// approvalrequestDescCreateTime is the schema descriptor for create_time field.
+	approvalrequestDescCreateTime := approvalrequestMixinFields0[0].Descriptor()
+	// approvalrequest.DefaultCreateTime holds the default value on creation for the create_time field.
+	approvalrequest.DefaultCreateTime = approvalrequestDescCreateTime.Default.(func() time.Time)
+	// approvalrequestDescUpdateTime is the schema descriptor for update_time field.
+	approvalrequestDescUpdateTime := approvalrequestMixinFields0[1].Descriptor()
+	// approvalrequest.DefaultUpdateTime holds the default value on creation for the update_time field.
+	approvalrequest.DefaultUpdateTime = approvalrequestDescUpdateTime.Default.(func() time.Time)
+	// approvalrequest.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	approvalrequest.UpdateDefaultUpdateTime = approvalrequestDescUpdateTime.UpdateDefault.(func() time.Time)
+	// approvalrequestDescFqdn is the schema descriptor for fqdn field.
+	approvalrequestDescFqdn := approvalrequestFields[0].Descriptor()
+	// approvalrequest.FqdnValidator is a validator for the "fqdn" field. It is called by the builders before save.
+	approvalrequest.FqdnValidator = approvalrequestDescFqdn.Validators[0].(func(string) error)
+	// approvalrequestDescRequester is the schema descriptor for requester field.
+	approvalrequestDescRequester := approvalrequestFields[1].Descriptor()
+	// approvalrequest.RequesterValidator is a validator for the "requester" field. It is called by the builders before save.
+	approvalrequest.RequesterValidator = approvalrequestDescRequester.Validators[0].(func(string) error)
 	delegationMixin := schema.Delegation{}.Mixin()
 	delegationMixinFields0 := delegationMixin[0].Fields()
 	_ = delegationMixinFields0
@@ -60,4 +87,77 @@ func init() {
 	domainDescApproved := domainFields[2].Descriptor()
 	// domain.DefaultApproved holds the default value on creation for the approved field.
 	domain.DefaultApproved = domainDescApproved.Default.(bool)
+	entitlementMixin := schema.Entitlement{}.Mixin()
+	entitlementMixinFields0 := entitlementMixin[0].Fields()
+	_ = entitlementMixinFields0
+	entitlementFields := schema.Entitlement{}.Fields()
+	_ = entitlementFields
+	// entitlementDescCreateTime is the schema descriptor for create_time field.
+	entitlementDescCreateTime := entitlementMixinFields0[0].Descriptor()
+	// entitlement.DefaultCreateTime holds the default value on creation for the create_time field.
+	entitlement.DefaultCreateTime = entitlementDescCreateTime.Default.(func() time.Time)
+	// entitlementDescUpdateTime is the schema descriptor for update_time field.
+	entitlementDescUpdateTime := entitlementMixinFields0[1].Descriptor()
+	// entitlement.DefaultUpdateTime holds the default value on creation for the update_time field.
+	entitlement.DefaultUpdateTime = entitlementDescUpdateTime.Default.(func() time.Time)
+	// entitlement.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	entitlement.UpdateDefaultUpdateTime = entitlementDescUpdateTime.UpdateDefault.(func() time.Time)
+	// entitlementDescValue is the schema descriptor for value field.
+	entitlementDescValue := entitlementFields[1].Descriptor()
+	// entitlement.ValueValidator is a validator for the "value" field. It is called by the builders before save.
+	entitlement.ValueValidator = entitlementDescValue.Validators[0].(func(string) error)
+	// entitlementDescOwner is the schema descriptor for owner field.
+	entitlementDescOwner := entitlementFields[2].Descriptor()
+	// entitlement.OwnerValidator is a validator for the "owner" field. It is called by the builders before save.
+	entitlement.OwnerValidator = entitlementDescOwner.Validators[0].(func(string) error)
+	// entitlementDescJustification is the schema descriptor for justification field.
+	entitlementDescJustification := entitlementFields[3].Descriptor()
+	// entitlement.JustificationValidator is a validator for the "justification" field. It is called by the builders before save.
+	entitlement.JustificationValidator = entitlementDescJustification.Validators[0].(func(string) error)
+	// entitlementDescGrantedBy is the schema descriptor for granted_by field.
+	entitlementDescGrantedBy := entitlementFields[4].Descriptor()
+	// entitlement.GrantedByValidator is a validator for the "granted_by" field. It is called by the builders before save.
+	entitlement.GrantedByValidator = entitlementDescGrantedBy.Validators[0].(func(string) error)
+	mailboxMixin := schema.Mailbox{}.Mixin()
+	mailboxMixinFields0 := mailboxMixin[0].Fields()
+	_ = mailboxMixinFields0
+	mailboxFields := schema.Mailbox{}.Fields()
+	_ = mailboxFields
+	// mailboxDescCreateTime is the schema descriptor for create_time field.
+	mailboxDescCreateTime := mailboxMixinFields0[0].Descriptor()
+	// mailbox.DefaultCreateTime holds the default value on creation for the create_time field.
+	mailbox.DefaultCreateTime = mailboxDescCreateTime.Default.(func() time.Time)
+	// mailboxDescUpdateTime is the schema descriptor for update_time field.
+	mailboxDescUpdateTime := mailboxMixinFields0[1].Descriptor()
+	// mailbox.DefaultUpdateTime holds the default value on creation for the update_time field.
+	mailbox.DefaultUpdateTime = mailboxDescUpdateTime.Default.(func() time.Time)
+	// mailbox.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	mailbox.UpdateDefaultUpdateTime = mailboxDescUpdateTime.UpdateDefault.(func() time.Time)
+	// mailboxDescEmail is the schema descriptor for email field.
+	mailboxDescEmail := mailboxFields[0].Descriptor()
+	// mailbox.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	mailbox.EmailValidator = mailboxDescEmail.Validators[0].(func(string) error)
+	// mailboxDescOwner is the schema descriptor for owner field.
+	mailboxDescOwner := mailboxFields[1].Descriptor()
+	// mailbox.OwnerValidator is a validator for the "owner" field. It is called by the builders before save.
+	mailbox.OwnerValidator = mailboxDescOwner.Validators[0].(func(string) error)
+	mailboxdelegationMixin := schema.MailboxDelegation{}.Mixin()
+	mailboxdelegationMixinFields0 := mailboxdelegationMixin[0].Fields()
+	_ = mailboxdelegationMixinFields0
+	mailboxdelegationFields := schema.MailboxDelegation{}.Fields()
+	_ = mailboxdelegationFields
+	// mailboxdelegationDescCreateTime is the schema descriptor for create_time field.
+	mailboxdelegationDescCreateTime := mailboxdelegationMixinFields0[0].Descriptor()
+	// mailboxdelegation.DefaultCreateTime holds the default value on creation for the create_time field.
+	mailboxdelegation.DefaultCreateTime = mailboxdelegationDescCreateTime.Default.(func() time.Time)
+	// mailboxdelegationDescUpdateTime is the schema descriptor for update_time field.
+	mailboxdelegationDescUpdateTime := mailboxdelegationMixinFields0[1].Descriptor()
+	// mailboxdelegation.DefaultUpdateTime holds the default value on creation for the update_time field.
+	mailboxdelegation.DefaultUpdateTime = mailboxdelegationDescUpdateTime.Default.(func() time.Time)
+	// mailboxdelegation.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	mailboxdelegation.UpdateDefaultUpdateTime = mailboxdelegationDescUpdateTime.UpdateDefault.(func() time.Time)
+	// mailboxdelegationDescUser is the schema descriptor for user field.
+	mailboxdelegationDescUser := mailboxdelegationFields[0].Descriptor()
+	// mailboxdelegation.UserValidator is a validator for the "user" field. It is called by the builders before save.
+	mailboxdelegation.UserValidator = mailboxdelegationDescUser.Validators[0].(func(string) error)
 }
@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// ApprovalRequest holds the schema definition for the ApprovalRequest
+// entity. Requests are created for domains that can not be approved
+// automatically and are kept after the decision, so the requester can see
+// its outcome even if the domain was deleted on rejection or expiry.
+type ApprovalRequest struct {
+	ent.Schema
+}
+
+// Fields of the ApprovalRequest.
+func (ApprovalRequest) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("fqdn").NotEmpty(),
+		field.String("requester").NotEmpty(),
+		field.Text("justification").Optional(),
+		field.Enum("status").Values("pending", "approved", "rejected", "expired").Default("pending"),
+		field.String("reviewer").Optional().Nillable(),
+		field.Text("comment").Optional().Nillable(),
+		field.Time("decided_at").Optional().Nillable(),
+		field.Time("expires_at"),
+	}
+}
+
+// Edges of the ApprovalRequest.
+func (ApprovalRequest) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("domain", Domain.Type).
+			Ref("requests").
+			Unique(),
+	}
+}
+
+// Indexes of the ApprovalRequest.
+func (ApprovalRequest) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status"),
+		index.Fields("requester"),
+	}
+}
+
+// Mixin adds default time fields to this model.
+func (ApprovalRequest) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
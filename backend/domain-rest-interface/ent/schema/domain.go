@@ -19,6 +19,15 @@ func (Domain) Fields() []ent.Field {
 		field.String("fqdn").NotEmpty().Unique(),
 		field.String("owner").NotEmpty(),
 		field.Bool("approved").Default(false),
+		// Technical contacts notified about expiring certificates in
+		// addition to the owner and the delegates.
+		field.Strings("contacts").Optional(),
+		// Ownership proof using a _pki-portal-verify TXT record containing
+		// the verification token.
+		field.String("verification_token").Optional().Nillable(),
+		field.Enum("verification_status").Values("unverified", "pending", "verified", "failed").Default("unverified"),
+		field.Time("verification_checked_at").Optional().Nillable(),
+		field.Time("verified_at").Optional().Nillable(),
 	}
 }
 
@@ -29,6 +38,10 @@ func (Domain) Edges() []ent.Edge {
 			Annotations(entsql.Annotation{
 				OnDelete: entsql.Cascade,
 			}),
+		edge.To("requests", ApprovalRequest.Type).
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.SetNull,
+			}),
 	}
 }
 
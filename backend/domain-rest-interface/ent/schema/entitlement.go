@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// Entitlement holds the schema definition for the Entitlement entity.
+// Entitlements permit names that can not be registered as domains, i.e.
+// wildcard names (e.g. *.kube.cs.hm.edu) and IP addresses or networks.
+type Entitlement struct {
+	ent.Schema
+}
+
+// Fields of the Entitlement.
+func (Entitlement) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("kind").Values("wildcard", "ip"),
+		// The wildcard name or the network in CIDR notation (single
+		// addresses are stored as /32 or /128 networks).
+		field.String("value").NotEmpty(),
+		field.String("owner").NotEmpty(),
+		field.Text("justification").NotEmpty(),
+		field.String("granted_by").NotEmpty(),
+		field.Time("expires_at").Optional().Nillable(),
+	}
+}
+
+// Indexes of the Entitlement.
+func (Entitlement) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("value", "owner").Unique(),
+		index.Fields("owner"),
+	}
+}
+
+// Mixin adds default time fields to this model.
+func (Entitlement) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
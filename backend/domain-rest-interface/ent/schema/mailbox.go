@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+)
+
+// Mailbox holds the schema definition for the Mailbox entity. Mailboxes are
+// functional or role mailboxes (e.g. it-support@) S/MIME certificates can be
+// requested for by their owner and delegates.
+type Mailbox struct {
+	ent.Schema
+}
+
+// Fields of the Mailbox.
+func (Mailbox) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("email").NotEmpty().Unique(),
+		field.String("owner").NotEmpty(),
+	}
+}
+
+// Edges of the Mailbox.
+func (Mailbox) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("delegations", MailboxDelegation.Type).
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
+	}
+}
+
+// Mixin adds default time fields to this model.
+func (Mailbox) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// MailboxDelegation holds the schema definition for the MailboxDelegation entity.
+type MailboxDelegation struct {
+	ent.Schema
+}
+
+// Fields of the MailboxDelegation.
+func (MailboxDelegation) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("user").NotEmpty(),
+	}
+}
+
+// Edges of the MailboxDelegation.
+func (MailboxDelegation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("mailbox", Mailbox.Type).
+			Ref("delegations").
+			Unique().Required(),
+	}
+}
+
+// Indexes of the MailboxDelegation.
+func (MailboxDelegation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user").
+			Edges("mailbox").
+			Unique(),
+	}
+}
+
+// Mixin adds default time fields to this model.
+func (MailboxDelegation) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
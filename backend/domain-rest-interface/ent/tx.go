@@ -12,10 +12,18 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// ApprovalRequest is the client for interacting with the ApprovalRequest builders.
+	ApprovalRequest *ApprovalRequestClient
 	// Delegation is the client for interacting with the Delegation builders.
 	Delegation *DelegationClient
 	// Domain is the client for interacting with the Domain builders.
 	Domain *DomainClient
+	// Entitlement is the client for interacting with the Entitlement builders.
+	Entitlement *EntitlementClient
+	// Mailbox is the client for interacting with the Mailbox builders.
+	Mailbox *MailboxClient
+	// MailboxDelegation is the client for interacting with the MailboxDelegation builders.
+	MailboxDelegation *MailboxDelegationClient
 
 	// lazily loaded.
 	client     *Client
@@ -147,8 +155,12 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.ApprovalRequest = NewApprovalRequestClient(tx.config)
 	tx.Delegation = NewDelegationClient(tx.config)
 	tx.Domain = NewDomainClient(tx.config)
+	tx.Entitlement = NewEntitlementClient(tx.config)
+	tx.Mailbox = NewMailboxClient(tx.config)
+	tx.MailboxDelegation = NewMailboxDelegationClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -158,7 +170,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Delegation.QueryXXX(), the query will be executed
+// applies a query, for example: ApprovalRequest.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -0,0 +1,76 @@
+package domains
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/hm-edu/domain-rest-interface/ent"
+	"github.com/hm-edu/domain-rest-interface/ent/enttest"
+	"github.com/hm-edu/domain-rest-interface/pkg/database"
+	"github.com/hm-edu/domain-rest-interface/pkg/model"
+	"github.com/hm-edu/domain-rest-interface/pkg/store"
+	"github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDomainContacts(t *testing.T) {
+	e := echo.New()
+	client := enttest.Open(t, "sqlite3", "file:domaincontacts?mode=memory&cache=shared&_fk=1")
+	defer func(*ent.Client) {
+		_ = client.Close()
+	}(client)
+	database.DB.Internal, _, _ = sqlmock.New()
+	st := store.NewDomainStore(client)
+	h := NewHandler(st, &MockPkiService{}, nil, nil, []string{})
+	bg := context.Background()
+
+	parent, _ := st.Create(bg, &ent.Domain{Fqdn: "example.com", Owner: "max", Approved: true})
+	_, _ = st.AddDelegation(bg, parent.ID, "erika")
+	_, _ = st.Create(bg, &ent.Domain{Fqdn: "www.example.com", Owner: "john", Approved: true})
+	_, _ = st.Create(bg, &ent.Domain{Fqdn: "example.org", Owner: "anna", Approved: false})
+	id := fmt.Sprint(parent.ID)
+
+	// Only those who may delegate the domain may set the contacts.
+	ctx, _ := mailboxContext(e, http.MethodPut, "/"+id+"/contacts", `{"contacts":["ops@example.com"]}`, "erika")
+	ctx.SetPath("/:id/contacts")
+	ctx.SetPathValues(echo.PathValues{{Name: "id", Value: id}})
+	assert.Error(t, h.SetContacts(ctx))
+
+	ctx, _ = mailboxContext(e, http.MethodPut, "/"+id+"/contacts", `{"contacts":["no-mail"]}`, "max")
+	ctx.SetPath("/:id/contacts")
+	ctx.SetPathValues(echo.PathValues{{Name: "id", Value: id}})
+	assert.Error(t, h.SetContacts(ctx))
+
+	ctx, rec := mailboxContext(e, http.MethodPut, "/"+id+"/contacts", `{"contacts":["Ops@example.com","ops@example.com"]}`, "max")
+	ctx.SetPath("/:id/contacts")
+	ctx.SetPathValues(echo.PathValues{{Name: "id", Value: id}})
+	assert.NoError(t, h.SetContacts(ctx))
+	var result model.Domain
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &result))
+	assert.Equal(t, []string{"ops@example.com"}, result.Contacts)
+
+	responsible, err := st.ResponsibleDomains(bg, []string{"www.example.com", "*.api.example.com", "Mail.Example.com.", "test.example.org", "example.org", "example.net"})
+	assert.NoError(t, err)
+	assert.Len(t, responsible, 4)
+	assert.Equal(t, "john", responsible["www.example.com"].Owner)
+	assert.Equal(t, "max", responsible["*.api.example.com"].Owner)
+	assert.Equal(t, []string{"ops@example.com"}, responsible["Mail.Example.com."].Contacts)
+	assert.Len(t, responsible["Mail.Example.com."].Edges.Delegations, 1)
+	// Unapproved domains are only responsible for themselves.
+	assert.Nil(t, responsible["test.example.org"])
+	assert.Equal(t, "anna", responsible["example.org"].Owner)
+
+	// Large lookups are split into several queries.
+	var names []string
+	for i := range 2500 {
+		names = append(names, fmt.Sprintf("host%d.sub%d.example.com", i, i))
+	}
+	responsible, err = st.ResponsibleDomains(bg, names)
+	assert.NoError(t, err)
+	assert.Len(t, responsible, len(names))
+	assert.Equal(t, "max", responsible[names[len(names)-1]].Owner)
+}
@@ -98,6 +98,12 @@ func (h *Handler) enumerateDomains(ctx context.Context, user string, logger *zap
 			}
 		}
 
+		// Admins may approve any domain, including domains without an
+		// approved upper zone.
+		if admin && !item.Approved {
+			item.Permissions.CanApprove = true
+		}
+
 		// Users may transfer or delete child domains
 		if helper.Any(filtered, func(i *ent.Domain) bool { return strings.HasSuffix(domain.Fqdn, "."+i.Fqdn) }) {
 			// Users may approve child domains
@@ -144,7 +150,7 @@ func (h *Handler) enumerateDomains(ctx context.Context, user string, logger *zap
 
 // CreateDomain godoc
 // @Summary Create a domain.
-// @Description Creates a new domain if the FQDN is not already taken. Approvement is automatically done, in case the user owns a upper zone or a upper zone was already delegated to him.
+// @Description Creates a new domain if the FQDN is not already taken. Approvement is automatically done, in case the user owns a upper zone or a upper zone was already delegated to him. Otherwise an approval request including the justification is passed to the admins and the users responsible for the upper zone.
 // @Tags Domains
 // @Accept json
 // @Produce json
@@ -182,6 +188,8 @@ func (h *Handler) CreateDomain(c *echo.Context) error {
 	}
 	logger = logger.With(zap.String("fqdn", req.FQDN))
 
+	// Expired requests release their domains.
+	h.expireRequests(ctx, logger)
 	allDomains, err := h.domainStore.ListAllDomains(ctx, false)
 	if err != nil {
 		logger.Error("Listing domains failed", zap.Error(err))
@@ -208,6 +216,13 @@ func (h *Handler) CreateDomain(c *echo.Context) error {
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, "Invalid Request").Wrap(err)
 	}
+	if !created.Approved {
+		if _, err := h.domainStore.RequestApproval(ctx, created, req.Justification); err != nil {
+			logger.Error("Creating approval request failed", zap.Error(err))
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to request approval").Wrap(err)
+		}
+	}
+	h.publishCreated(ctx, logger, created, req.Justification)
 	item := model.DomainToOutput(created)
 	item.Permissions.CanDelete = true
 	if item.Approved {
@@ -244,7 +259,8 @@ func (h *Handler) DeleteDomain(c *echo.Context) error {
 	}
 
 	if item.Approved {
-		_, err := h.pkiService.RevokeCertificate(ctx, &pb.RevokeSslRequest{Identifier: &pb.RevokeSslRequest_CommonName{CommonName: item.FQDN}, Reason: fmt.Sprintf("Domain '%s' deleted in PKI-Portal", item.FQDN)})
+		user, _ := auth.UserFromRequest(c)
+		_, err := h.pkiService.RevokeCertificate(ctx, &pb.RevokeSslRequest{Identifier: &pb.RevokeSslRequest_CommonName{CommonName: item.FQDN}, Reason: fmt.Sprintf("Domain '%s' deleted in PKI-Portal", item.FQDN), Actor: user})
 		if err != nil {
 			logger.Error("Failed to revoke certificate", zap.Error(err))
 			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to revoke certificate").Wrap(err)
@@ -301,6 +317,7 @@ func (h *Handler) evaluatePermission(ctx context.Context, c *echo.Context, logge
 // @Produce json
 // @Router /domains/{id}/approve [post]
 // @Param id path int true "Domain ID"
+// @Param decision body model.DecisionRequest false "An optional comment for the requester"
 // @Security API
 // @Success 200 {object} model.Domain The updated domain
 // @Response default {object} echo.HTTPError "Error processing the request"
@@ -312,17 +329,23 @@ func (h *Handler) ApproveDomain(c *echo.Context) error {
 	if span != nil {
 		ctx = span.Context()
 	}
+	req := &model.DecisionRequest{}
+	if err := req.Bind(c, h.validator); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid Request").Wrap(err)
+	}
 	item, err := h.evaluatePermission(ctx, c, logger, func(d *model.Domain) bool { return d.Permissions.CanApprove })
 	if err != nil {
 		return err
 	}
 
+	user, _ := auth.UserFromRequest(c)
 	logger.Info("Approving domain", zap.String("fqdn", item.FQDN))
-	updated, err := h.domainStore.Approve(ctx, item.ID)
+	updated, err := h.domainStore.Approve(ctx, item.ID, user, req.Comment)
 	if err != nil {
 		logger.Error("Approving domain failed", zap.Error(err))
 		return &echo.HTTPError{Code: http.StatusInternalServerError, Message: "Error while approving domain"}
 	}
+	h.publish(ctx, logger, eventDomainApproved, user, updated.Fqdn, []string{updated.Owner}, map[string]string{"owner": updated.Owner, "comment": req.Comment})
 
 	return c.JSON(http.StatusOK, model.DomainToOutput(updated))
 }
@@ -363,6 +386,9 @@ func (h *Handler) TransferDomain(c *echo.Context) error {
 		logger.Error("Transferring domain failed", zap.Error(err))
 		return &echo.HTTPError{Code: http.StatusBadRequest}
 	}
+	user, _ := auth.UserFromRequest(c)
+	h.publish(ctx, logger, eventDomainTransferred, user, updated.Fqdn, []string{updated.Owner, item.Owner},
+		map[string]string{"owner": updated.Owner, "previous_owner": item.Owner})
 
 	return c.JSON(http.StatusOK, model.DomainToOutput(updated))
 }
@@ -409,6 +435,8 @@ func (h *Handler) DeleteDelegation(c *echo.Context) error {
 		logger.Error("Deleting delegation failed", zap.Error(err))
 		return &echo.HTTPError{Code: http.StatusNotFound, Message: "Deleting delegation failed"}
 	}
+	user, _ := auth.UserFromRequest(c)
+	h.publish(ctx, logger, eventDelegationRemoved, user, updated.Fqdn, []string{delegation.User, updated.Owner}, map[string]string{"delegate": delegation.User})
 
 	return c.JSON(http.StatusOK, model.DomainToOutput(updated))
 }
@@ -453,6 +481,53 @@ func (h *Handler) AddDelegation(c *echo.Context) error {
 
 		return &echo.HTTPError{Code: http.StatusNotFound, Message: "Adding delegation failed"}
 	}
+	user, _ := auth.UserFromRequest(c)
+	h.publish(ctx, logger, eventDelegationAdded, user, updated.Fqdn, []string{req.User, updated.Owner}, map[string]string{"delegate": req.User})
+
+	return c.JSON(http.StatusOK, model.DomainToOutput(updated))
+}
+
+// SetContacts godoc
+// @Summary Set technical contacts.
+// @Description Replaces the technical contacts of a domain. Technical contacts are notified about expiring certificates in addition to the owner and the delegates.
+// @Tags Domains
+// @Accept json
+// @Produce json
+// @Router /domains/{id}/contacts [put]
+// @Param contacts body model.ContactsRequest true "The technical contacts"
+// @Param id path int true "Domain ID"
+// @Security API
+// @Success 200 {object} model.Domain The updated domain
+// @Response default {object} echo.HTTPError "Error processing the request"
+func (h *Handler) SetContacts(c *echo.Context) error {
+	logger := c.Request().Context().Value(logging.LoggingContextKey).(*zap.Logger)
+
+	span := sentryecho.GetSpanFromContext(c)
+	ctx := c.Request().Context()
+	if span != nil {
+		ctx = span.Context()
+	}
+	req := &model.ContactsRequest{}
+	if err := req.Bind(c, h.validator); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid Request").Wrap(err)
+	}
+	item, err := h.evaluatePermission(ctx, c, logger, func(d *model.Domain) bool { return d.Permissions.CanDelegate })
+	if err != nil {
+		return err
+	}
+	var contacts []string
+	for _, contact := range req.Contacts {
+		contact = strings.ToLower(contact)
+		if !helper.Contains(contacts, contact) {
+			contacts = append(contacts, contact)
+		}
+	}
+	logger.Info("Setting contacts", zap.String("fqdn", item.FQDN), zap.Strings("contacts", contacts))
+	updated, err := h.domainStore.SetContacts(ctx, item.ID, contacts)
+	if err != nil {
+		logger.Error("Setting contacts failed", zap.Error(err))
+		return &echo.HTTPError{Code: http.StatusNotFound, Message: "Setting contacts failed"}
+	}
 
 	return c.JSON(http.StatusOK, model.DomainToOutput(updated))
 }
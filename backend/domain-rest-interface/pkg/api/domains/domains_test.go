@@ -66,7 +66,7 @@ func TestCreateDomainsWithoutTokenAndMiddleware(t *testing.T) {
 	c := e.NewContext(req, rec)
 	c.SetRequest(c.Request().WithContext(context.WithValue(c.Request().Context(), logging.LoggingContextKey, zap.L())))
 
-	h := NewHandler(store.NewDomainStore(client), &MockPkiService{}, []string{})
+	h := NewHandler(store.NewDomainStore(client), &MockPkiService{}, nil, nil, []string{})
 	assert.Error(t, h.CreateDomain(c))
 }
 
@@ -77,7 +77,7 @@ func TestSimplePermssions(t *testing.T) {
 	}(client)
 	database.DB.Internal, _, _ = sqlmock.New()
 	st := store.NewDomainStore(client)
-	h := NewHandler(st, &MockPkiService{}, []string{})
+	h := NewHandler(st, &MockPkiService{}, nil, nil, []string{})
 
 	_, _ = st.Create(context.Background(), &ent.Domain{Fqdn: "example.com", Owner: "test", Approved: false})
 
@@ -111,7 +111,7 @@ func TestDeletePermissions(t *testing.T) {
 			}(client)
 			database.DB.Internal, _, _ = sqlmock.New()
 			st := store.NewDomainStore(client)
-			h := NewHandler(st, &MockPkiService{}, []string{})
+			h := NewHandler(st, &MockPkiService{}, nil, nil, []string{})
 
 			_, _ = st.Create(context.Background(), &ent.Domain{Fqdn: "bar.example.com", Owner: "test", Approved: false})
 			_, _ = st.Create(context.Background(), &ent.Domain{Fqdn: "foo.example.com", Owner: "test", Approved: true})
@@ -147,7 +147,7 @@ func TestListHandlerAdmin(t *testing.T) {
 	}(client)
 	database.DB.Internal, _, _ = sqlmock.New()
 	st := store.NewDomainStore(client)
-	h := NewHandler(st, &MockPkiService{}, []string{"test"})
+	h := NewHandler(st, &MockPkiService{}, nil, nil, []string{"test"})
 	_, _ = st.Create(context.Background(), &ent.Domain{Fqdn: "bar.example.com", Owner: "test", Approved: false})
 	_, _ = st.Create(context.Background(), &ent.Domain{Fqdn: "foo.example.com", Owner: "test", Approved: true})
 	_, _ = st.Create(context.Background(), &ent.Domain{Fqdn: "example.com", Owner: "max", Approved: true})
@@ -177,7 +177,7 @@ func TestListHandler(t *testing.T) {
 	}(client)
 	database.DB.Internal, _, _ = sqlmock.New()
 	st := store.NewDomainStore(client)
-	h := NewHandler(st, &MockPkiService{}, []string{})
+	h := NewHandler(st, &MockPkiService{}, nil, nil, []string{})
 	_, _ = st.Create(context.Background(), &ent.Domain{Fqdn: "bar.example.com", Owner: "test", Approved: false})
 	_, _ = st.Create(context.Background(), &ent.Domain{Fqdn: "foo.example.com", Owner: "test", Approved: true})
 	_, _ = st.Create(context.Background(), &ent.Domain{Fqdn: "example.com", Owner: "max", Approved: true})
@@ -215,7 +215,7 @@ func TestCreateDomainsFqdns(t *testing.T) {
 			c := e.NewContext(req, rec)
 			c.SetRequest(c.Request().WithContext(context.WithValue(c.Request().Context(), logging.LoggingContextKey, zap.L())))
 			c.Set("user", &jwt.Token{Claims: jwt.MapClaims{"email": "test"}})
-			h := NewHandler(store.NewDomainStore(client), &MockPkiService{}, []string{})
+			h := NewHandler(store.NewDomainStore(client), &MockPkiService{}, nil, nil, []string{})
 			resp := h.CreateDomain(c)
 			if assert.Error(t, resp) {
 				assert.Equal(t, http.StatusBadRequest, resp.(*echo.HTTPError).Code)
@@ -238,7 +238,7 @@ func TestCreateDomainsWithToken(t *testing.T) {
 	c := e.NewContext(req, rec)
 	c.SetRequest(c.Request().WithContext(context.WithValue(c.Request().Context(), logging.LoggingContextKey, zap.L())))
 	c.Set("user", &jwt.Token{Claims: jwt.MapClaims{"email": "test"}})
-	h := NewHandler(store.NewDomainStore(client), &MockPkiService{}, []string{})
+	h := NewHandler(store.NewDomainStore(client), &MockPkiService{}, nil, nil, []string{})
 	resp := h.CreateDomain(c)
 	if assert.NoError(t, resp) {
 		assert.Equal(t, http.StatusCreated, rec.Code)
@@ -262,7 +262,7 @@ func TestCreateDomainsTwice(t *testing.T) {
 	c := e.NewContext(req, rec)
 	c.SetRequest(c.Request().WithContext(context.WithValue(c.Request().Context(), logging.LoggingContextKey, zap.L())))
 	c.Set("user", &jwt.Token{Claims: jwt.MapClaims{"email": "test"}})
-	h := NewHandler(store.NewDomainStore(client), &MockPkiService{}, []string{})
+	h := NewHandler(store.NewDomainStore(client), &MockPkiService{}, nil, nil, []string{})
 	resp := h.CreateDomain(c)
 	if assert.NoError(t, resp) {
 		assert.Equal(t, http.StatusCreated, rec.Code)
@@ -296,7 +296,7 @@ func TestCreateDomainsAutoApproveAdmin(t *testing.T) {
 	c.SetRequest(c.Request().WithContext(context.WithValue(c.Request().Context(), logging.LoggingContextKey, zap.L())))
 	c.Set("user", &jwt.Token{Claims: jwt.MapClaims{"email": "test"}})
 	st := store.NewDomainStore(client)
-	h := NewHandler(st, &MockPkiService{}, []string{"test"})
+	h := NewHandler(st, &MockPkiService{}, nil, nil, []string{"test"})
 	resp := h.CreateDomain(c)
 	if assert.NoError(t, resp) {
 		assert.Equal(t, http.StatusCreated, rec.Code)
@@ -323,7 +323,7 @@ func TestCreateDomainsAutoApprove(t *testing.T) {
 	st := store.NewDomainStore(client)
 	_, err := st.Create(c.Request().Context(), &ent.Domain{Fqdn: "example.com", Owner: "test", Approved: true})
 	assert.NoError(t, err)
-	h := NewHandler(st, &MockPkiService{}, []string{})
+	h := NewHandler(st, &MockPkiService{}, nil, nil, []string{})
 	resp := h.CreateDomain(c)
 	if assert.NoError(t, resp) {
 		assert.Equal(t, http.StatusCreated, rec.Code)
@@ -350,7 +350,7 @@ func TestCreateDomainsNoAutoApproveOtherUser(t *testing.T) {
 	st := store.NewDomainStore(client)
 	_, err := st.Create(c.Request().Context(), &ent.Domain{Fqdn: "example.com", Owner: "test", Approved: true})
 	assert.NoError(t, err)
-	h := NewHandler(st, &MockPkiService{}, []string{})
+	h := NewHandler(st, &MockPkiService{}, nil, nil, []string{})
 	resp := h.CreateDomain(c)
 	if assert.NoError(t, resp) {
 		assert.Equal(t, http.StatusCreated, rec.Code)
@@ -379,7 +379,7 @@ func TestCreateDomainsNoAutoApproveOtherUserChild(t *testing.T) {
 	c := e.NewContext(req, rec)
 	c.SetRequest(c.Request().WithContext(context.WithValue(c.Request().Context(), logging.LoggingContextKey, zap.L())))
 	c.Set("user", &jwt.Token{Claims: jwt.MapClaims{"email": "max"}})
-	h := NewHandler(st, &MockPkiService{}, []string{})
+	h := NewHandler(st, &MockPkiService{}, nil, nil, []string{})
 	resp := h.CreateDomain(c)
 	if assert.NoError(t, resp) {
 		assert.Equal(t, http.StatusCreated, rec.Code)
@@ -448,7 +448,7 @@ func TestApproveDomainsNotAllowed(t *testing.T) {
 	c.SetRequest(c.Request().WithContext(context.WithValue(c.Request().Context(), logging.LoggingContextKey, zap.L())))
 	c.Set("user", &jwt.Token{Claims: jwt.MapClaims{"email": "max"}})
 	assert.NoError(t, err)
-	h := NewHandler(st, &MockPkiService{}, []string{})
+	h := NewHandler(st, &MockPkiService{}, nil, nil, []string{})
 	_ = h.CreateDomain(c)
 
 	req = httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"fqdn":"mail.foo.example.com"}`))
@@ -497,7 +497,7 @@ func TestApproveMissingId(t *testing.T) {
 	c := e.NewContext(req, rec)
 	c.SetRequest(c.Request().WithContext(context.WithValue(c.Request().Context(), logging.LoggingContextKey, zap.L())))
 	c.Set("user", &jwt.Token{Claims: jwt.MapClaims{"email": "test"}})
-	h := NewHandler(st, &MockPkiService{}, []string{})
+	h := NewHandler(st, &MockPkiService{}, nil, nil, []string{})
 	resp := h.ApproveDomain(c)
 	assert.Error(t, resp)
 }
@@ -520,7 +520,7 @@ func TestApproveDomainsAllowed(t *testing.T) {
 	c := e.NewContext(req, rec)
 	c.SetRequest(c.Request().WithContext(context.WithValue(c.Request().Context(), logging.LoggingContextKey, zap.L())))
 	c.Set("user", &jwt.Token{Claims: jwt.MapClaims{"email": "max"}})
-	h := NewHandler(st, &MockPkiService{}, []string{})
+	h := NewHandler(st, &MockPkiService{}, nil, nil, []string{})
 	_ = h.CreateDomain(c)
 
 	req = httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"fqdn":"mail.foo.example.com"}`))
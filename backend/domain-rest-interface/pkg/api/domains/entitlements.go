@@ -0,0 +1,141 @@
+package domains
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	sentryecho "github.com/getsentry/sentry-go/echo"
+	"github.com/hm-edu/domain-rest-interface/ent"
+	"github.com/hm-edu/domain-rest-interface/pkg/model"
+	"github.com/hm-edu/domain-rest-interface/pkg/store"
+	"github.com/hm-edu/portal-common/auth"
+	"github.com/hm-edu/portal-common/helper"
+	"github.com/hm-edu/portal-common/logging"
+	"github.com/labstack/echo/v5"
+	"go.uber.org/zap"
+)
+
+// ListEntitlements godoc
+// @Summary List entitlements.
+// @Description Lists the wildcard and IP entitlements. Admins see all entitlements, other users their own.
+// @Tags Entitlements
+// @Accept json
+// @Produce json
+// @Router /entitlements/ [get]
+// @Security API
+// @Success 200 {object} []model.Entitlement
+// @Response default {object} echo.HTTPError "Error processing the request"
+func (h *Handler) ListEntitlements(c *echo.Context) error {
+	logger := c.Request().Context().Value(logging.LoggingContextKey).(*zap.Logger)
+
+	span := sentryecho.GetSpanFromContext(c)
+	ctx := c.Request().Context()
+	if span != nil {
+		ctx = span.Context()
+	}
+	user, err := auth.UserFromRequest(c)
+	if err != nil {
+		logger.Error("Failed to get user from request", zap.Error(err))
+		return &echo.HTTPError{Code: http.StatusBadRequest, Message: "Invalid Request"}
+	}
+	owner := user
+	if helper.Contains(h.admins, user) {
+		owner = ""
+	}
+	entitlements, err := h.domainStore.ListEntitlements(ctx, owner)
+	if err != nil {
+		logger.Error("Listing entitlements failed", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Error while listing entitlements").Wrap(err)
+	}
+	return c.JSON(http.StatusOK, helper.Map(entitlements, model.EntitlementToOutput))
+}
+
+// GrantEntitlement godoc
+// @Summary Grant an entitlement.
+// @Description Permits the owner to request certificates for a wildcard name (e.g. *.kube.cs.hm.edu), an IP address or the addresses of a network. Wildcard entitlements only cover the wildcard name itself. Only admins may grant entitlements.
+// @Tags Entitlements
+// @Accept json
+// @Produce json
+// @Router /entitlements/ [post]
+// @Param entitlement body model.EntitlementRequest true "The entitlement to grant"
+// @Security API
+// @Success 201 {object} model.Entitlement
+// @Response default {object} echo.HTTPError "Error processing the request"
+func (h *Handler) GrantEntitlement(c *echo.Context) error {
+	logger := c.Request().Context().Value(logging.LoggingContextKey).(*zap.Logger)
+
+	span := sentryecho.GetSpanFromContext(c)
+	ctx := c.Request().Context()
+	if span != nil {
+		ctx = span.Context()
+	}
+	user, err := auth.UserFromRequest(c)
+	if err != nil {
+		logger.Error("Failed to get user from request", zap.Error(err))
+		return &echo.HTTPError{Code: http.StatusBadRequest, Message: "Invalid Request"}
+	}
+	if !helper.Contains(h.admins, user) {
+		return &echo.HTTPError{Code: http.StatusForbidden, Message: "Operation not allowed"}
+	}
+	req := &model.EntitlementRequest{}
+	if err := req.Bind(c, h.validator); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid Request").Wrap(err)
+	}
+	logger.Info("Granting entitlement", zap.String("value", req.Value), zap.String("owner", req.Owner))
+	created, err := h.domainStore.GrantEntitlement(ctx, req.Value, req.Owner, req.Justification, user, req.ExpiresAt)
+	if err != nil {
+		if errors.Is(err, store.ErrInvalidEntitlement) {
+			return &echo.HTTPError{Code: http.StatusBadRequest, Message: err.Error()}
+		}
+		if ent.IsConstraintError(err) {
+			return &echo.HTTPError{Code: http.StatusBadRequest, Message: "Entitlement already exists"}
+		}
+		logger.Error("Granting entitlement failed", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Error while granting entitlement").Wrap(err)
+	}
+	return c.JSON(http.StatusCreated, model.EntitlementToOutput(created))
+}
+
+// RevokeEntitlement godoc
+// @Summary Revoke an entitlement.
+// @Description Deletes an entitlement. Existing certificates are not revoked. Only admins may revoke entitlements.
+// @Tags Entitlements
+// @Accept json
+// @Produce json
+// @Router /entitlements/{id} [delete]
+// @Param id path int true "Entitlement ID"
+// @Security API
+// @Success 204
+// @Response default {object} echo.HTTPError "Error processing the request"
+func (h *Handler) RevokeEntitlement(c *echo.Context) error {
+	logger := c.Request().Context().Value(logging.LoggingContextKey).(*zap.Logger)
+
+	span := sentryecho.GetSpanFromContext(c)
+	ctx := c.Request().Context()
+	if span != nil {
+		ctx = span.Context()
+	}
+	user, err := auth.UserFromRequest(c)
+	if err != nil {
+		logger.Error("Failed to get user from request", zap.Error(err))
+		return &echo.HTTPError{Code: http.StatusBadRequest, Message: "Invalid Request"}
+	}
+	if !helper.Contains(h.admins, user) {
+		return &echo.HTTPError{Code: http.StatusForbidden, Message: "Operation not allowed"}
+	}
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return &echo.HTTPError{Code: http.StatusBadRequest, Message: "Invalid entitlement ID"}
+	}
+	revoked, err := h.domainStore.RevokeEntitlement(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &echo.HTTPError{Code: http.StatusNotFound, Message: "Entitlement not found"}
+		}
+		logger.Error("Revoking entitlement failed", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Error while revoking entitlement").Wrap(err)
+	}
+	logger.Info("Revoked entitlement", zap.String("value", revoked.Value), zap.String("owner", revoked.Owner))
+	return c.NoContent(http.StatusNoContent)
+}
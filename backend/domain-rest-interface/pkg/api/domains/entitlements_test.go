@@ -0,0 +1,66 @@
+package domains
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/hm-edu/domain-rest-interface/ent"
+	"github.com/hm-edu/domain-rest-interface/ent/enttest"
+	"github.com/hm-edu/domain-rest-interface/pkg/database"
+	"github.com/hm-edu/domain-rest-interface/pkg/model"
+	"github.com/hm-edu/domain-rest-interface/pkg/store"
+	"github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEntitlementHandlers(t *testing.T) {
+	e := echo.New()
+	client := enttest.Open(t, "sqlite3", "file:entitlementhandlers?mode=memory&cache=shared&_fk=1")
+	defer func(*ent.Client) {
+		_ = client.Close()
+	}(client)
+	database.DB.Internal, _, _ = sqlmock.New()
+	h := NewHandler(store.NewDomainStore(client), &MockPkiService{}, nil, nil, []string{"admin"})
+
+	body := `{"value":"*.kube.cs.hm.edu","owner":"max@hm.edu","justification":"k8s ingress"}`
+	ctx, _ := mailboxContext(e, http.MethodPost, "/", body, "max")
+	assert.Error(t, h.GrantEntitlement(ctx))
+	ctx, _ = mailboxContext(e, http.MethodPost, "/", `{"value":"*.kube.cs.hm.edu","owner":"max@hm.edu"}`, "admin")
+	assert.Error(t, h.GrantEntitlement(ctx))
+	ctx, _ = mailboxContext(e, http.MethodPost, "/", `{"value":"www.cs.hm.edu","owner":"max@hm.edu","justification":"no wildcard"}`, "admin")
+	assert.Error(t, h.GrantEntitlement(ctx))
+	ctx, _ = mailboxContext(e, http.MethodPost, "/", `{"value":"*.kube.cs.hm.edu","owner":"max","justification":"no mail"}`, "admin")
+	assert.Error(t, h.GrantEntitlement(ctx))
+
+	ctx, rec := mailboxContext(e, http.MethodPost, "/", body, "admin")
+	assert.NoError(t, h.GrantEntitlement(ctx))
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	granted := model.Entitlement{}
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &granted))
+	assert.Equal(t, "wildcard", granted.Kind)
+	assert.Equal(t, "admin", granted.GrantedBy)
+	ctx, _ = mailboxContext(e, http.MethodPost, "/", `{"value":"192.0.2.1","owner":"john@hm.edu","justification":"DoH","expires_at":"2999-01-01T00:00:00Z"}`, "admin")
+	assert.NoError(t, h.GrantEntitlement(ctx))
+
+	// Users only see their own entitlements.
+	for user, count := range map[string]int{"admin": 2, "Max@hm.edu": 1, "erika@hm.edu": 0} {
+		ctx, rec = mailboxContext(e, http.MethodGet, "/", "", user)
+		assert.NoError(t, h.ListEntitlements(ctx))
+		list := []model.Entitlement{}
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &list))
+		assert.Len(t, list, count, user)
+	}
+
+	revoke := func(user string) error {
+		ctx, _ := mailboxContext(e, http.MethodDelete, fmt.Sprintf("/%d", granted.ID), "", user)
+		ctx.SetPath("/:id")
+		ctx.SetPathValues(echo.PathValues{{Name: "id", Value: fmt.Sprint(granted.ID)}})
+		return h.RevokeEntitlement(ctx)
+	}
+	assert.Error(t, revoke("max"))
+	assert.NoError(t, revoke("admin"))
+	assert.Error(t, revoke("admin"))
+}
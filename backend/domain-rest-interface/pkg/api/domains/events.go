@@ -0,0 +1,83 @@
+package domains
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hm-edu/domain-rest-interface/ent"
+	pb "github.com/hm-edu/portal-apis"
+	"go.uber.org/zap"
+)
+
+// Domain events delivered by the notification service.
+const (
+	eventDomainCreated         = "domain_created"
+	eventDomainPendingApproval = "domain_pending_approval"
+	eventDomainApproved        = "domain_approved"
+	eventDomainRejected        = "domain_rejected"
+	eventDomainTransferred     = "domain_transferred"
+	eventDelegationAdded       = "delegation_added"
+	eventDelegationRemoved     = "delegation_removed"
+)
+
+// responsibleUsers returns the owner and the delegates of a domain.
+func responsibleUsers(d *ent.Domain) []string {
+	if d == nil {
+		return nil
+	}
+	users := []string{d.Owner}
+	for _, delegation := range d.Edges.Delegations {
+		users = append(users, delegation.User)
+	}
+	return users
+}
+
+// publish notifies the recipients about a domain event. The actor is not
+// notified about own actions. Failures are only logged since the action
+// itself succeeded.
+func (h *Handler) publish(ctx context.Context, logger *zap.Logger, event, actor, fqdn string, recipients []string, data map[string]string) {
+	if h.notifications == nil {
+		return
+	}
+	var filtered []string
+	for _, r := range recipients {
+		if r != "" && !strings.EqualFold(r, actor) {
+			filtered = append(filtered, r)
+		}
+	}
+	if len(filtered) == 0 {
+		return
+	}
+	_, err := h.notifications.PublishEvent(ctx, &pb.NotificationEvent{
+		Type:       event,
+		Actor:      actor,
+		Subject:    fqdn,
+		Recipients: filtered,
+		Data:       data,
+	})
+	if err != nil {
+		logger.Warn("Publishing domain event failed", zap.String("event", event), zap.Error(err))
+	}
+}
+
+// publishCreated notifies those responsible for the parent domain about a
+// new domain. Domains that require an approval are announced to the admins
+// and the users allowed to approve them instead, including the
+// justification of the requester.
+func (h *Handler) publishCreated(ctx context.Context, logger *zap.Logger, created *ent.Domain, justification string) {
+	if h.notifications == nil {
+		return
+	}
+	parent, err := h.domainStore.ApprovedParent(ctx, created.Fqdn)
+	if err != nil {
+		logger.Warn("Loading parent domain failed", zap.Error(err))
+	}
+	data := map[string]string{"owner": created.Owner}
+	if created.Approved {
+		h.publish(ctx, logger, eventDomainCreated, created.Owner, created.Fqdn, responsibleUsers(parent), data)
+		return
+	}
+	recipients := append(responsibleUsers(parent), h.admins...)
+	data["justification"] = justification
+	h.publish(ctx, logger, eventDomainPendingApproval, created.Owner, created.Fqdn, recipients, data)
+}
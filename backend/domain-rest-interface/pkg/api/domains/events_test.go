@@ -0,0 +1,103 @@
+package domains
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/hm-edu/domain-rest-interface/ent"
+	"github.com/hm-edu/domain-rest-interface/ent/enttest"
+	"github.com/hm-edu/domain-rest-interface/pkg/database"
+	"github.com/hm-edu/domain-rest-interface/pkg/store"
+	pb "github.com/hm-edu/portal-apis"
+	"github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// MockNotificationService records the published events.
+type MockNotificationService struct {
+	pb.NotificationServiceClient
+	events []*pb.NotificationEvent
+}
+
+func (s *MockNotificationService) PublishEvent(_ context.Context, in *pb.NotificationEvent, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	s.events = append(s.events, in)
+	return &emptypb.Empty{}, nil
+}
+
+func (s *MockNotificationService) take() []*pb.NotificationEvent {
+	events := s.events
+	s.events = nil
+	return events
+}
+
+func TestDomainEvents(t *testing.T) {
+	e := echo.New()
+	client := enttest.Open(t, "sqlite3", "file:domainevents?mode=memory&cache=shared&_fk=1")
+	defer func(*ent.Client) {
+		_ = client.Close()
+	}(client)
+	database.DB.Internal, _, _ = sqlmock.New()
+	st := store.NewDomainStore(client)
+	notifications := &MockNotificationService{}
+	h := NewHandler(st, &MockPkiService{}, notifications, nil, []string{"admin"})
+	bg := context.Background()
+
+	parent, _ := st.Create(bg, &ent.Domain{Fqdn: "example.com", Owner: "max", Approved: true})
+	_, _ = st.AddDelegation(bg, parent.ID, "erika")
+
+	// Pending domains are announced to the admins and those responsible
+	// for the parent domain.
+	ctx, _ := mailboxContext(e, http.MethodPost, "/", `{"fqdn":"www.example.com"}`, "john")
+	assert.NoError(t, h.CreateDomain(ctx))
+	events := notifications.take()
+	assert.Len(t, events, 1)
+	assert.Equal(t, eventDomainPendingApproval, events[0].Type)
+	assert.Equal(t, "john", events[0].Actor)
+	assert.ElementsMatch(t, []string{"max", "erika", "admin"}, events[0].Recipients)
+	created, err := st.GetDomain(bg, "www.example.com")
+	assert.NoError(t, err)
+	id := fmt.Sprint(created.ID)
+
+	ctx, _ = mailboxContext(e, http.MethodPost, "/"+id+"/approve", "", "max")
+	ctx.SetPath("/:id/approve")
+	ctx.SetPathValues(echo.PathValues{{Name: "id", Value: id}})
+	assert.NoError(t, h.ApproveDomain(ctx))
+	events = notifications.take()
+	assert.Len(t, events, 1)
+	assert.Equal(t, eventDomainApproved, events[0].Type)
+	assert.Equal(t, []string{"john"}, events[0].Recipients)
+
+	// The actor is not notified about own actions.
+	ctx, _ = mailboxContext(e, http.MethodPost, "/"+id+"/transfer", `{"owner":"anna"}`, "max")
+	ctx.SetPath("/:id/transfer")
+	ctx.SetPathValues(echo.PathValues{{Name: "id", Value: id}})
+	assert.NoError(t, h.TransferDomain(ctx))
+	events = notifications.take()
+	assert.Len(t, events, 1)
+	assert.Equal(t, eventDomainTransferred, events[0].Type)
+	assert.Equal(t, []string{"anna", "john"}, events[0].Recipients)
+	assert.Equal(t, map[string]string{"owner": "anna", "previous_owner": "john"}, events[0].Data)
+
+	ctx, _ = mailboxContext(e, http.MethodPost, "/"+id+"/delegation", `{"user":"lisa"}`, "anna")
+	ctx.SetPath("/:id/delegation")
+	ctx.SetPathValues(echo.PathValues{{Name: "id", Value: id}})
+	assert.NoError(t, h.AddDelegation(ctx))
+	events = notifications.take()
+	assert.Len(t, events, 1)
+	assert.Equal(t, eventDelegationAdded, events[0].Type)
+	assert.Equal(t, []string{"lisa"}, events[0].Recipients)
+
+	// Domains approved automatically are announced to those responsible for
+	// the parent domain.
+	ctx, _ = mailboxContext(e, http.MethodPost, "/", `{"fqdn":"api.example.com"}`, "max")
+	assert.NoError(t, h.CreateDomain(ctx))
+	events = notifications.take()
+	assert.Len(t, events, 1)
+	assert.Equal(t, eventDomainCreated, events[0].Type)
+	assert.Equal(t, []string{"erika"}, events[0].Recipients)
+}
@@ -2,6 +2,7 @@ package domains
 
 import (
 	"github.com/hm-edu/domain-rest-interface/pkg/store"
+	"github.com/hm-edu/domain-rest-interface/pkg/verification"
 	pb "github.com/hm-edu/portal-apis"
 	"github.com/hm-edu/portal-common/model"
 )
@@ -10,17 +11,23 @@ import (
 type Handler struct {
 	domainStore *store.DomainStore
 	pkiService  pb.SSLServiceClient
-	validator   *model.Validator
-	admins      []string
+	// notifications delivers the domain events (optional).
+	notifications pb.NotificationServiceClient
+	// verifier checks the ownership proofs of pending domains (optional).
+	verifier  *verification.Verifier
+	validator *model.Validator
+	admins    []string
 }
 
 // NewHandler generates a new handler for acting on the domain storage.
-func NewHandler(ds *store.DomainStore, pkiSerivce pb.SSLServiceClient, admins []string) *Handler {
+func NewHandler(ds *store.DomainStore, pkiSerivce pb.SSLServiceClient, notifications pb.NotificationServiceClient, verifier *verification.Verifier, admins []string) *Handler {
 	v := model.NewValidator()
 	return &Handler{
-		domainStore: ds,
-		validator:   v,
-		pkiService:  pkiSerivce,
-		admins:      admins,
+		domainStore:   ds,
+		validator:     v,
+		pkiService:    pkiSerivce,
+		notifications: notifications,
+		verifier:      verifier,
+		admins:        admins,
 	}
 }
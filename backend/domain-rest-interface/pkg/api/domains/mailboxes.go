@@ -0,0 +1,370 @@
+package domains
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/getsentry/sentry-go"
+	sentryecho "github.com/getsentry/sentry-go/echo"
+	"github.com/hm-edu/domain-rest-interface/ent"
+	"github.com/hm-edu/domain-rest-interface/pkg/model"
+	"github.com/hm-edu/portal-common/auth"
+	"github.com/hm-edu/portal-common/helper"
+	"github.com/hm-edu/portal-common/logging"
+
+	"github.com/labstack/echo/v5"
+	"go.uber.org/zap"
+)
+
+// mailDomain returns the domain part of a mail address.
+func mailDomain(email string) string {
+	return strings.ToLower(email[strings.LastIndex(email, "@")+1:])
+}
+
+// managesMailDomain reports whether one of the approved domains owned by the
+// user covers the domain of the mail address. Delegates of a domain do not
+// manage its mailboxes.
+func managesMailDomain(domains []*ent.Domain, user, email string) bool {
+	name := mailDomain(email)
+	return helper.Any(domains, func(d *ent.Domain) bool {
+		return d.Approved && strings.EqualFold(d.Owner, user) && (d.Fqdn == name || strings.HasSuffix(name, "."+d.Fqdn))
+	})
+}
+
+// enumerateMailboxes returns the functional mailboxes the user owns, is
+// delegated to or manages. Mailboxes are managed by their owner, the admins
+// and the owners of the mail domain.
+func (h *Handler) enumerateMailboxes(ctx context.Context, user string, logger *zap.Logger) ([]*model.Mailbox, error) {
+	admin := helper.Contains(h.admins, user)
+
+	domains, err := h.domainStore.ListDomains(ctx, user, true, false)
+	if err != nil {
+		logger.Error("Listing domains failed", zap.Error(err))
+		return nil, err
+	}
+	mailboxes, err := h.domainStore.ListAllMailboxes(ctx)
+	if err != nil {
+		logger.Error("Listing mailboxes failed", zap.Error(err))
+		return nil, err
+	}
+
+	results := []*model.Mailbox{}
+	for _, mailbox := range mailboxes {
+		manage := admin || strings.EqualFold(mailbox.Owner, user) || managesMailDomain(domains, user, mailbox.Email)
+		delegated := helper.Any(mailbox.Edges.Delegations, func(d *ent.MailboxDelegation) bool { return strings.EqualFold(d.User, user) })
+		if !manage && !delegated {
+			continue
+		}
+		item := model.MailboxToOutput(mailbox)
+		item.Permissions.CanDelete = manage
+		item.Permissions.CanTransfer = manage
+		item.Permissions.CanDelegate = manage
+		results = append(results, &item)
+	}
+	return results, nil
+}
+
+func (h *Handler) evaluateMailboxPermission(ctx context.Context, c *echo.Context, logger *zap.Logger, predicate func(*model.Mailbox) bool) (*model.Mailbox, error) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		logger.Error("Invalid mailbox id", zap.Error(err))
+		return nil, &echo.HTTPError{Code: http.StatusBadRequest, Message: "Invalid mailbox ID"}
+	}
+	hub := sentryecho.GetHubFromContext(c)
+	if hub == nil {
+		hub = sentry.CurrentHub().Clone()
+	}
+	user, err := auth.UserFromRequest(c)
+	if err != nil {
+		logger.Error("Failed to get user from request", zap.Error(err))
+		return nil, &echo.HTTPError{Code: http.StatusBadRequest, Message: "Invalid Request"}
+	}
+	hub.ConfigureScope(func(scope *sentry.Scope) {
+		scope.SetUser(sentry.User{Email: user})
+	})
+	logger = logger.With(zap.Int("mailbox_id", id))
+	mailboxes, err := h.enumerateMailboxes(ctx, user, logger)
+	if err != nil {
+		return nil, &echo.HTTPError{Code: http.StatusInternalServerError, Message: "Error listing mailboxes"}
+	}
+
+	item := helper.First(mailboxes, func(i *model.Mailbox) bool { return i.ID == id })
+	if item == nil {
+		return nil, &echo.HTTPError{Code: http.StatusNotFound, Message: "Mailbox not found"}
+	}
+	if !predicate(item) {
+		return nil, &echo.HTTPError{Code: http.StatusForbidden, Message: "Operation not allowed"}
+	}
+	return item, nil
+}
+
+// ListMailboxes godoc
+// @Summary List functional mailboxes.
+// @Description Lists all functional mailboxes that are either owned, delegated or belong to a mail domain owned by the user.
+// @Tags Mailboxes
+// @Accept json
+// @Produce json
+// @Router /mailboxes/ [get]
+// @Security API
+// @Success 200 {object} []model.Mailbox
+// @Response default {object} echo.HTTPError "Error processing the request"
+func (h *Handler) ListMailboxes(c *echo.Context) error {
+	logger := c.Request().Context().Value(logging.LoggingContextKey).(*zap.Logger)
+
+	span := sentryecho.GetSpanFromContext(c)
+	ctx := c.Request().Context()
+	if span != nil {
+		ctx = span.Context()
+	}
+	hub := sentryecho.GetHubFromContext(c)
+	if hub == nil {
+		hub = sentry.CurrentHub().Clone()
+	}
+
+	user, err := auth.UserFromRequest(c)
+	if err != nil {
+		logger.Error("Failed to get user from request", zap.Error(err))
+		return &echo.HTTPError{Code: http.StatusBadRequest, Message: "Invalid Request"}
+	}
+	hub.ConfigureScope(func(scope *sentry.Scope) {
+		scope.SetUser(sentry.User{Email: user})
+	})
+
+	mailboxes, err := h.enumerateMailboxes(ctx, user, logger)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Error while listing mailboxes").Wrap(err)
+	}
+	return c.JSON(http.StatusOK, mailboxes)
+}
+
+// CreateMailbox godoc
+// @Summary Create a functional mailbox.
+// @Description Creates a new functional mailbox (e.g. it-support@) owned by the user. Mailboxes can only be created by admins and must not use the address of a personal account.
+// @Tags Mailboxes
+// @Accept json
+// @Produce json
+// @Router /mailboxes/ [post]
+// @Param mailbox body model.MailboxRequest true "The mailbox to create"
+// @Security API
+// @Success 201 {object} model.Mailbox
+// @Response default {object} echo.HTTPError "Error processing the request"
+func (h *Handler) CreateMailbox(c *echo.Context) error {
+	logger := c.Request().Context().Value(logging.LoggingContextKey).(*zap.Logger)
+
+	span := sentryecho.GetSpanFromContext(c)
+	ctx := c.Request().Context()
+	if span != nil {
+		ctx = span.Context()
+	}
+	hub := sentryecho.GetHubFromContext(c)
+	if hub == nil {
+		hub = sentry.CurrentHub().Clone()
+	}
+
+	user, err := auth.UserFromRequest(c)
+	if err != nil {
+		logger.Error("Failed to get user from request", zap.Error(err))
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid Request").Wrap(err)
+	}
+	hub.ConfigureScope(func(scope *sentry.Scope) {
+		scope.SetUser(sentry.User{Email: user})
+	})
+
+	req := &model.MailboxRequest{}
+	if err := req.Bind(c, h.validator); err != nil {
+		logger.Error("Binding request failed", zap.Error(err))
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid Request").Wrap(err)
+	}
+	email := strings.ToLower(req.Email)
+	logger = logger.With(zap.String("email", email))
+
+	// Certificates for a mailbox are issued to its owner and delegates, so
+	// creating mailboxes is limited to admins and must not cover the
+	// addresses of personal accounts.
+	if !helper.Contains(h.admins, user) {
+		logger.Warn("Mailbox creation by non-admin")
+		return &echo.HTTPError{Code: http.StatusForbidden, Message: "Only admins can create functional mailboxes"}
+	}
+	personal, err := h.domainStore.IsUserAddress(ctx, email)
+	if err != nil {
+		logger.Error("Checking address failed", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Error while creating mailbox").Wrap(err)
+	}
+	if personal {
+		logger.Warn("Mailbox for personal account requested")
+		return &echo.HTTPError{Code: http.StatusBadRequest, Message: "The address belongs to a personal account"}
+	}
+
+	owner := strings.ToLower(user)
+	logger.Info("Creating mailbox", zap.String("owner", owner))
+	created, err := h.domainStore.CreateMailbox(ctx, email, owner)
+	if ent.IsConstraintError(err) {
+		logger.Error("Mailbox already exists")
+		return &echo.HTTPError{Code: http.StatusBadRequest, Message: "Mailbox already exists"}
+	}
+	if err != nil {
+		logger.Error("Creating mailbox failed", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Error while creating mailbox").Wrap(err)
+	}
+	item := model.MailboxToOutput(created)
+	item.Permissions = model.MailboxPermissions{CanDelete: true, CanTransfer: true, CanDelegate: true}
+	return c.JSON(http.StatusCreated, item)
+}
+
+// DeleteMailbox godoc
+// @Summary Delete a functional mailbox
+// @Description Deletes a functional mailbox. Existing certificates are not longer accessible.
+// @Tags Mailboxes
+// @Accept json
+// @Produce json
+// @Router /mailboxes/{id} [delete]
+// @Param id path int true "Mailbox ID"
+// @Security API
+// @Success 204
+// @Response default {object} echo.HTTPError "Error processing the request"
+func (h *Handler) DeleteMailbox(c *echo.Context) error {
+	logger := c.Request().Context().Value(logging.LoggingContextKey).(*zap.Logger)
+
+	span := sentryecho.GetSpanFromContext(c)
+	ctx := c.Request().Context()
+	if span != nil {
+		ctx = span.Context()
+	}
+	item, err := h.evaluateMailboxPermission(ctx, c, logger, func(m *model.Mailbox) bool { return m.Permissions.CanDelete })
+	if err != nil {
+		return err
+	}
+
+	logger.Info("Deleting mailbox", zap.String("email", item.Email))
+	if err := h.domainStore.DeleteMailbox(ctx, item.ID); err != nil {
+		logger.Error("Deleting mailbox failed", zap.Error(err))
+		return &echo.HTTPError{Code: http.StatusBadRequest, Message: "Error while deleting mailbox"}
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// TransferMailbox godoc
+// @Summary Transfer functional mailbox
+// @Description Transfers a functional mailbox to a new owner.
+// @Tags Mailboxes
+// @Accept json
+// @Produce json
+// @Router /mailboxes/{id}/transfer [post]
+// @Param mailbox body model.TransferRequest true "The new owner"
+// @Param id path int true "Mailbox ID"
+// @Security API
+// @Success 200 {object} model.Mailbox The updated mailbox
+// @Response default {object} echo.HTTPError "Error processing the request"
+func (h *Handler) TransferMailbox(c *echo.Context) error {
+	logger := c.Request().Context().Value(logging.LoggingContextKey).(*zap.Logger)
+
+	span := sentryecho.GetSpanFromContext(c)
+	ctx := c.Request().Context()
+	if span != nil {
+		ctx = span.Context()
+	}
+	req := &model.TransferRequest{}
+	if err := req.Bind(c, h.validator); err != nil {
+		return &echo.HTTPError{Code: http.StatusBadRequest}
+	}
+	item, err := h.evaluateMailboxPermission(ctx, c, logger, func(m *model.Mailbox) bool { return m.Permissions.CanTransfer })
+	if err != nil {
+		return err
+	}
+	req.Owner = strings.ToLower(req.Owner)
+
+	logger.Info("Transferring mailbox", zap.String("email", item.Email), zap.String("owner", req.Owner))
+	updated, err := h.domainStore.MailboxOwner(ctx, item.ID, req.Owner)
+	if err != nil {
+		logger.Error("Transferring mailbox failed", zap.Error(err))
+		return &echo.HTTPError{Code: http.StatusBadRequest}
+	}
+	return c.JSON(http.StatusOK, model.MailboxToOutput(updated))
+}
+
+// AddMailboxDelegation godoc
+// @Summary Add mailbox delegation.
+// @Description Adds a new delegate to a functional mailbox. Delegates may request, list and revoke S/MIME certificates for the mailbox.
+// @Tags Mailboxes
+// @Accept json
+// @Produce json
+// @Router /mailboxes/{id}/delegation [post]
+// @Param delegation body model.DelegationRequest true "The Delegation to add"
+// @Param id path int true "Mailbox ID"
+// @Security API
+// @Success 200 {object} model.Mailbox The updated mailbox
+// @Response default {object} echo.HTTPError "Error processing the request"
+func (h *Handler) AddMailboxDelegation(c *echo.Context) error {
+	logger := c.Request().Context().Value(logging.LoggingContextKey).(*zap.Logger)
+
+	span := sentryecho.GetSpanFromContext(c)
+	ctx := c.Request().Context()
+	if span != nil {
+		ctx = span.Context()
+	}
+	req := &model.DelegationRequest{}
+	if err := req.Bind(c, h.validator); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid Request").Wrap(err)
+	}
+	item, err := h.evaluateMailboxPermission(ctx, c, logger, func(m *model.Mailbox) bool { return m.Permissions.CanDelegate })
+	if err != nil {
+		return err
+	}
+	req.User = strings.ToLower(req.User)
+	exists := helper.Any(item.Delegations, func(t *model.Delegation) bool { return strings.EqualFold(t.User, req.User) })
+	if exists || strings.EqualFold(item.Owner, req.User) {
+		logger.Error("Delegation already exists", zap.String("email", item.Email), zap.String("user", req.User))
+		return &echo.HTTPError{Code: http.StatusBadRequest, Message: "Delegation already exists"}
+	}
+	logger.Info("Adding mailbox delegation", zap.String("email", item.Email), zap.String("user", req.User))
+	updated, err := h.domainStore.AddMailboxDelegation(ctx, item.ID, req.User)
+	if err != nil {
+		logger.Error("Adding mailbox delegation failed", zap.Error(err))
+		return &echo.HTTPError{Code: http.StatusNotFound, Message: "Adding delegation failed"}
+	}
+	return c.JSON(http.StatusOK, model.MailboxToOutput(updated))
+}
+
+// DeleteMailboxDelegation godoc
+// @Summary Delete mailbox delegation.
+// @Description Deletes an existing delegation of a functional mailbox.
+// @Tags Mailboxes
+// @Accept json
+// @Produce json
+// @Router /mailboxes/{id}/delegation/{delegation} [delete]
+// @Param id path int true "Mailbox ID"
+// @Param delegation path int true "Delegation ID"
+// @Security API
+// @Success 200 {object} model.Mailbox The updated mailbox
+// @Response default {object} echo.HTTPError "Error processing the request"
+func (h *Handler) DeleteMailboxDelegation(c *echo.Context) error {
+	logger := c.Request().Context().Value(logging.LoggingContextKey).(*zap.Logger)
+
+	span := sentryecho.GetSpanFromContext(c)
+	ctx := c.Request().Context()
+	if span != nil {
+		ctx = span.Context()
+	}
+	item, err := h.evaluateMailboxPermission(ctx, c, logger, func(m *model.Mailbox) bool { return m.Permissions.CanDelegate })
+	if err != nil {
+		return err
+	}
+	delegationID, err := strconv.Atoi(c.Param("delegation"))
+	if err != nil {
+		return &echo.HTTPError{Code: http.StatusBadRequest}
+	}
+	delegation := helper.First(item.Delegations, func(t *model.Delegation) bool { return delegationID == t.ID })
+	if delegation == nil {
+		logger.Error("Delegation not found", zap.Int("delegation", delegationID))
+		return &echo.HTTPError{Code: http.StatusNotFound, Message: "Delegation not found"}
+	}
+	logger.Info("Deleting mailbox delegation", zap.String("email", item.Email), zap.Int("delegation", delegationID), zap.String("user", delegation.User))
+	updated, err := h.domainStore.DeleteMailboxDelegation(ctx, item.ID, delegationID)
+	if err != nil {
+		logger.Error("Deleting mailbox delegation failed", zap.Error(err))
+		return &echo.HTTPError{Code: http.StatusNotFound, Message: "Deleting delegation failed"}
+	}
+	return c.JSON(http.StatusOK, model.MailboxToOutput(updated))
+}
@@ -0,0 +1,186 @@
+package domains
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/hm-edu/domain-rest-interface/ent"
+	"github.com/hm-edu/domain-rest-interface/ent/enttest"
+	"github.com/hm-edu/domain-rest-interface/pkg/database"
+	"github.com/hm-edu/domain-rest-interface/pkg/model"
+	"github.com/hm-edu/domain-rest-interface/pkg/store"
+	"github.com/hm-edu/portal-common/logging"
+	"github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func mailboxContext(e *echo.Echo, method, path, body, user string) (*echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetRequest(c.Request().WithContext(context.WithValue(c.Request().Context(), logging.LoggingContextKey, zap.L())))
+	c.Set("user", &jwt.Token{Claims: jwt.MapClaims{"email": user}})
+	return c, rec
+}
+
+func TestCreateMailbox(t *testing.T) {
+	tc := []struct {
+		User    string
+		Email   string
+		Success bool
+	}{
+		{User: "Admin", Email: "IT-Support@example.com", Success: true},
+		{User: "admin", Email: "it-support@example.org", Success: true},
+		{User: "max", Email: "it-support@example.com", Success: false},
+		{User: "test", Email: "it-support@foo.example.com", Success: false},
+		{User: "admin", Email: "Max.Mustermann@example.com", Success: false},
+		{User: "admin", Email: "erika.musterfrau@example.com", Success: false},
+		{User: "admin", Email: "not-a-mail", Success: false},
+	}
+	for _, c := range tc {
+		t.Run(fmt.Sprintf("%v_%v", c.User, c.Email), func(t *testing.T) {
+			e := echo.New()
+			client := enttest.Open(t, "sqlite3", "file:mailboxcreate?mode=memory&cache=shared&_fk=1")
+			defer func(*ent.Client) {
+				_ = client.Close()
+			}(client)
+			database.DB.Internal, _, _ = sqlmock.New()
+			st := store.NewDomainStore(client)
+			h := NewHandler(st, &MockPkiService{}, nil, nil, []string{"admin", "Admin"})
+
+			_, _ = st.Create(context.Background(), &ent.Domain{Fqdn: "example.com", Owner: "max.mustermann@example.com", Approved: true})
+			_, _ = st.Create(context.Background(), &ent.Domain{Fqdn: "foo.example.com", Owner: "test", Approved: false})
+			office, _ := st.CreateMailbox(context.Background(), "office@example.com", "max")
+			_, _ = st.AddMailboxDelegation(context.Background(), office.ID, "erika.musterfrau@example.com")
+
+			ctx, rec := mailboxContext(e, http.MethodPost, "/", fmt.Sprintf(`{"email":%q}`, c.Email), c.User)
+			err := h.CreateMailbox(ctx)
+			if !c.Success {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusCreated, rec.Code)
+			var mailbox model.Mailbox
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &mailbox))
+			assert.Equal(t, strings.ToLower(c.Email), mailbox.Email)
+			assert.Equal(t, strings.ToLower(c.User), mailbox.Owner)
+		})
+	}
+}
+
+func TestCreateMailboxTwice(t *testing.T) {
+	e := echo.New()
+	client := enttest.Open(t, "sqlite3", "file:mailboxtwice?mode=memory&cache=shared&_fk=1")
+	defer func(*ent.Client) {
+		_ = client.Close()
+	}(client)
+	database.DB.Internal, _, _ = sqlmock.New()
+	h := NewHandler(store.NewDomainStore(client), &MockPkiService{}, nil, nil, []string{"admin"})
+
+	ctx, _ := mailboxContext(e, http.MethodPost, "/", `{"email":"it-support@example.com"}`, "admin")
+	assert.NoError(t, h.CreateMailbox(ctx))
+	ctx, _ = mailboxContext(e, http.MethodPost, "/", `{"email":"it-support@example.com"}`, "admin")
+	assert.Error(t, h.CreateMailbox(ctx))
+}
+
+func TestMailboxPermissions(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:mailboxpermissions?mode=memory&cache=shared&_fk=1")
+	defer func(*ent.Client) {
+		_ = client.Close()
+	}(client)
+	database.DB.Internal, _, _ = sqlmock.New()
+	st := store.NewDomainStore(client)
+	h := NewHandler(st, &MockPkiService{}, nil, nil, []string{"admin"})
+	bg := context.Background()
+
+	domain, _ := st.Create(bg, &ent.Domain{Fqdn: "example.com", Owner: "max", Approved: true})
+	_, _ = st.AddDelegation(bg, domain.ID, "anna")
+	support, _ := st.CreateMailbox(bg, "it-support@example.com", "erika")
+	_, _ = st.AddMailboxDelegation(bg, support.ID, "test")
+	_, _ = st.CreateMailbox(bg, "office@example.org", "erika")
+
+	tc := []struct {
+		User      string
+		Mailboxes []string
+		Manage    bool
+	}{
+		{User: "erika", Mailboxes: []string{"it-support@example.com", "office@example.org"}, Manage: true},
+		{User: "max", Mailboxes: []string{"it-support@example.com"}, Manage: true},
+		{User: "test", Mailboxes: []string{"it-support@example.com"}, Manage: false},
+		{User: "anna", Mailboxes: []string{}, Manage: false},
+		{User: "admin", Mailboxes: []string{"it-support@example.com", "office@example.org"}, Manage: true},
+		{User: "john", Mailboxes: []string{}, Manage: false},
+	}
+	for _, c := range tc {
+		t.Run(c.User, func(t *testing.T) {
+			mailboxes, err := h.enumerateMailboxes(bg, c.User, zap.L())
+			assert.NoError(t, err)
+			emails := []string{}
+			for _, m := range mailboxes {
+				emails = append(emails, m.Email)
+				assert.Equal(t, c.Manage, m.Permissions.CanDelegate)
+				assert.Equal(t, c.Manage, m.Permissions.CanDelete)
+			}
+			assert.Equal(t, c.Mailboxes, emails)
+		})
+	}
+
+	// Only owners and delegates may use the mailbox for S/MIME certificates.
+	usable, err := st.ListMailboxes(bg, "max", false)
+	assert.NoError(t, err)
+	assert.Len(t, usable, 0)
+	usable, err = st.ListMailboxes(bg, "test", false)
+	assert.NoError(t, err)
+	assert.Len(t, usable, 1)
+}
+
+func TestMailboxDelegation(t *testing.T) {
+	e := echo.New()
+	client := enttest.Open(t, "sqlite3", "file:mailboxdelegation?mode=memory&cache=shared&_fk=1")
+	defer func(*ent.Client) {
+		_ = client.Close()
+	}(client)
+	database.DB.Internal, _, _ = sqlmock.New()
+	st := store.NewDomainStore(client)
+	h := NewHandler(st, &MockPkiService{}, nil, nil, []string{})
+	mailbox, _ := st.CreateMailbox(context.Background(), "it-support@example.com", "erika")
+	id := fmt.Sprint(mailbox.ID)
+
+	// Delegates may not delegate the mailbox further.
+	ctx, _ := mailboxContext(e, http.MethodPost, "/"+id+"/delegation", `{"user":"test"}`, "erika")
+	ctx.SetPath("/:id/delegation")
+	ctx.SetPathValues(echo.PathValues{{Name: "id", Value: id}})
+	assert.NoError(t, h.AddMailboxDelegation(ctx))
+
+	ctx, _ = mailboxContext(e, http.MethodPost, "/"+id+"/delegation", `{"user":"max"}`, "test")
+	ctx.SetPath("/:id/delegation")
+	ctx.SetPathValues(echo.PathValues{{Name: "id", Value: id}})
+	assert.Error(t, h.AddMailboxDelegation(ctx))
+
+	ctx, _ = mailboxContext(e, http.MethodPost, "/"+id+"/delegation", `{"user":"TEST"}`, "erika")
+	ctx.SetPath("/:id/delegation")
+	ctx.SetPathValues(echo.PathValues{{Name: "id", Value: id}})
+	assert.Error(t, h.AddMailboxDelegation(ctx))
+
+	updated, _ := st.GetMailboxByID(context.Background(), mailbox.ID)
+	assert.Len(t, updated.Edges.Delegations, 1)
+	delegation := fmt.Sprint(updated.Edges.Delegations[0].ID)
+
+	ctx, rec := mailboxContext(e, http.MethodDelete, "/"+id+"/delegation/"+delegation, "", "erika")
+	ctx.SetPath("/:id/delegation/:delegation")
+	ctx.SetPathValues(echo.PathValues{{Name: "id", Value: id}, {Name: "delegation", Value: delegation}})
+	assert.NoError(t, h.DeleteMailboxDelegation(ctx))
+	var result model.Mailbox
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &result))
+	assert.Len(t, result.Delegations, 0)
+}
@@ -0,0 +1,164 @@
+package domains
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/getsentry/sentry-go"
+	sentryecho "github.com/getsentry/sentry-go/echo"
+	"github.com/hm-edu/domain-rest-interface/ent"
+	"github.com/hm-edu/domain-rest-interface/pkg/model"
+	"github.com/hm-edu/portal-common/auth"
+	"github.com/hm-edu/portal-common/helper"
+	"github.com/hm-edu/portal-common/logging"
+	"github.com/labstack/echo/v5"
+	"go.uber.org/zap"
+)
+
+// expireRequests expires the pending approval requests whose expiry passed
+// before they are listed or their domains are taken, as the scheduled
+// expiry only runs periodically. Failures are only logged since the
+// requests are expired on the next call.
+func (h *Handler) expireRequests(ctx context.Context, logger *zap.Logger) {
+	expired, err := h.domainStore.ExpireRequests(ctx)
+	if err != nil {
+		logger.Warn("Expiring approval requests failed", zap.Error(err))
+		return
+	}
+	for _, r := range expired {
+		logger.Info("Approval request expired", zap.Int("request", r.ID), zap.String("fqdn", r.Fqdn), zap.String("requester", r.Requester))
+	}
+}
+
+// ListRequests godoc
+// @Summary List own approval requests.
+// @Description Lists the approval requests of the user including their outcome, the most recent first.
+// @Tags Domains
+// @Accept json
+// @Produce json
+// @Router /domains/requests [get]
+// @Security API
+// @Success 200 {object} []model.ApprovalRequest
+// @Response default {object} echo.HTTPError "Error processing the request"
+func (h *Handler) ListRequests(c *echo.Context) error {
+	logger := c.Request().Context().Value(logging.LoggingContextKey).(*zap.Logger)
+
+	span := sentryecho.GetSpanFromContext(c)
+	ctx := c.Request().Context()
+	if span != nil {
+		ctx = span.Context()
+	}
+	hub := sentryecho.GetHubFromContext(c)
+	if hub == nil {
+		hub = sentry.CurrentHub().Clone()
+	}
+
+	user, err := auth.UserFromRequest(c)
+	if err != nil {
+		logger.Error("Failed to get user from request", zap.Error(err))
+		return &echo.HTTPError{Code: http.StatusBadRequest, Message: "Invalid Request"}
+	}
+	hub.ConfigureScope(func(scope *sentry.Scope) {
+		scope.SetUser(sentry.User{Email: user})
+	})
+
+	h.expireRequests(ctx, logger)
+	requests, err := h.domainStore.ListRequests(ctx, user)
+	if err != nil {
+		logger.Error("Listing approval requests failed", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Error while listing approval requests").Wrap(err)
+	}
+	return c.JSON(http.StatusOK, helper.Map(requests, model.ApprovalRequestToOutput))
+}
+
+// ListInbox godoc
+// @Summary List approval requests awaiting a decision.
+// @Description Lists the pending approval requests the user may decide on, i.e. all pending requests for admins and the requests for child domains of owned or delegated domains.
+// @Tags Domains
+// @Accept json
+// @Produce json
+// @Router /domains/requests/inbox [get]
+// @Security API
+// @Success 200 {object} []model.ApprovalRequest
+// @Response default {object} echo.HTTPError "Error processing the request"
+func (h *Handler) ListInbox(c *echo.Context) error {
+	logger := c.Request().Context().Value(logging.LoggingContextKey).(*zap.Logger)
+
+	span := sentryecho.GetSpanFromContext(c)
+	ctx := c.Request().Context()
+	if span != nil {
+		ctx = span.Context()
+	}
+	hub := sentryecho.GetHubFromContext(c)
+	if hub == nil {
+		hub = sentry.CurrentHub().Clone()
+	}
+
+	user, err := auth.UserFromRequest(c)
+	if err != nil {
+		logger.Error("Failed to get user from request", zap.Error(err))
+		return &echo.HTTPError{Code: http.StatusBadRequest, Message: "Invalid Request"}
+	}
+	hub.ConfigureScope(func(scope *sentry.Scope) {
+		scope.SetUser(sentry.User{Email: user})
+	})
+
+	h.expireRequests(ctx, logger)
+	pending, err := h.domainStore.PendingRequests(ctx)
+	if err != nil {
+		logger.Error("Listing approval requests failed", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Error while listing approval requests").Wrap(err)
+	}
+	domains, err := h.enumerateDomains(ctx, user, logger)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Error while listing domains").Wrap(err)
+	}
+	approvable := helper.Where(domains, func(d *model.Domain) bool { return d.Permissions.CanApprove })
+	inbox := helper.Where(pending, func(r *ent.ApprovalRequest) bool {
+		return helper.Any(approvable, func(d *model.Domain) bool { return d.ID == r.Edges.Domain.ID })
+	})
+	return c.JSON(http.StatusOK, helper.Map(inbox, model.ApprovalRequestToOutput))
+}
+
+// RejectDomain godoc
+// @Summary Reject domain request
+// @Description Rejects an outstanding domain request and deletes the domain. The comment is shown to the requester.
+// @Tags Domains
+// @Accept json
+// @Produce json
+// @Router /domains/{id}/reject [post]
+// @Param id path int true "Domain ID"
+// @Param decision body model.DecisionRequest true "The reason for the rejection"
+// @Security API
+// @Success 204
+// @Response default {object} echo.HTTPError "Error processing the request"
+func (h *Handler) RejectDomain(c *echo.Context) error {
+	logger := c.Request().Context().Value(logging.LoggingContextKey).(*zap.Logger)
+
+	span := sentryecho.GetSpanFromContext(c)
+	ctx := c.Request().Context()
+	if span != nil {
+		ctx = span.Context()
+	}
+	req := &model.DecisionRequest{}
+	if err := req.Bind(c, h.validator); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid Request").Wrap(err)
+	}
+	if req.Comment == "" {
+		return &echo.HTTPError{Code: http.StatusBadRequest, Message: "A comment is required"}
+	}
+	item, err := h.evaluatePermission(ctx, c, logger, func(d *model.Domain) bool { return d.Permissions.CanApprove })
+	if err != nil {
+		return err
+	}
+
+	user, _ := auth.UserFromRequest(c)
+	logger.Info("Rejecting domain", zap.String("fqdn", item.FQDN))
+	if err := h.domainStore.Reject(ctx, item.ID, user, req.Comment); err != nil {
+		logger.Error("Rejecting domain failed", zap.Error(err))
+		return &echo.HTTPError{Code: http.StatusInternalServerError, Message: "Error while rejecting domain"}
+	}
+	h.publish(ctx, logger, eventDomainRejected, user, item.FQDN, []string{item.Owner}, map[string]string{"owner": item.Owner, "comment": req.Comment})
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,145 @@
+package domains
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/hm-edu/domain-rest-interface/ent"
+	"github.com/hm-edu/domain-rest-interface/ent/enttest"
+	"github.com/hm-edu/domain-rest-interface/pkg/database"
+	"github.com/hm-edu/domain-rest-interface/pkg/model"
+	"github.com/hm-edu/domain-rest-interface/pkg/store"
+	"github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func listRequests(t *testing.T, h *Handler, e *echo.Echo, user string, inbox bool) []model.ApprovalRequest {
+	ctx, rec := mailboxContext(e, http.MethodGet, "/requests", "", user)
+	if inbox {
+		assert.NoError(t, h.ListInbox(ctx))
+	} else {
+		assert.NoError(t, h.ListRequests(ctx))
+	}
+	requests := []model.ApprovalRequest{}
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &requests))
+	return requests
+}
+
+func decide(h *Handler, e *echo.Echo, action string, id int, body, user string) error {
+	ctx, _ := mailboxContext(e, http.MethodPost, fmt.Sprintf("/%d/%s", id, action), body, user)
+	ctx.SetPath("/:id/" + action)
+	ctx.SetPathValues(echo.PathValues{{Name: "id", Value: fmt.Sprint(id)}})
+	if action == "reject" {
+		return h.RejectDomain(ctx)
+	}
+	return h.ApproveDomain(ctx)
+}
+
+func TestApprovalRequests(t *testing.T) {
+	e := echo.New()
+	client := enttest.Open(t, "sqlite3", "file:requests?mode=memory&cache=shared&_fk=1")
+	defer func(*ent.Client) {
+		_ = client.Close()
+	}(client)
+	database.DB.Internal, _, _ = sqlmock.New()
+	st := store.NewDomainStore(client)
+	notifications := &MockNotificationService{}
+	h := NewHandler(st, &MockPkiService{}, notifications, nil, []string{"admin"})
+
+	_, _ = st.Create(context.Background(), &ent.Domain{Fqdn: "example.com", Owner: "max", Approved: true})
+
+	ctx, _ := mailboxContext(e, http.MethodPost, "/", `{"fqdn":"www.example.com","justification":"web server"}`, "john")
+	assert.NoError(t, h.CreateDomain(ctx))
+	assert.Equal(t, "web server", notifications.take()[0].Data["justification"])
+
+	// The request shows up in the inbox of the parent owner and the admins.
+	inbox := listRequests(t, h, e, "max", true)
+	if assert.Len(t, inbox, 1) {
+		assert.Equal(t, "www.example.com", inbox[0].FQDN)
+		assert.Equal(t, "john", inbox[0].Requester)
+		assert.Equal(t, "web server", inbox[0].Justification)
+		assert.Equal(t, "pending", inbox[0].Status)
+	}
+	assert.Len(t, listRequests(t, h, e, "admin", true), 1)
+	assert.Len(t, listRequests(t, h, e, "john", true), 0)
+	id := inbox[0].DomainID
+
+	// Rejections require a comment and may not be done by the requester.
+	assert.Error(t, decide(h, e, "reject", id, `{}`, "max"))
+	assert.Error(t, decide(h, e, "reject", id, `{"comment":"mine"}`, "john"))
+	assert.NoError(t, decide(h, e, "reject", id, `{"comment":"use the existing host"}`, "max"))
+	events := notifications.take()
+	if assert.Len(t, events, 1) {
+		assert.Equal(t, eventDomainRejected, events[0].Type)
+		assert.Equal(t, []string{"john"}, events[0].Recipients)
+	}
+	_, err := st.GetDomain(context.Background(), "www.example.com")
+	assert.True(t, ent.IsNotFound(err))
+	own := listRequests(t, h, e, "john", false)
+	if assert.Len(t, own, 1) {
+		assert.Equal(t, "rejected", own[0].Status)
+		assert.Equal(t, "max", own[0].Reviewer)
+		assert.Equal(t, "use the existing host", own[0].Comment)
+		assert.Zero(t, own[0].DomainID)
+		assert.NotNil(t, own[0].DecidedAt)
+	}
+	assert.Len(t, listRequests(t, h, e, "max", true), 0)
+
+	// Admins may approve domains without approved parent. They are only
+	// listed in the inbox of the admins.
+	ctx, _ = mailboxContext(e, http.MethodPost, "/", `{"fqdn":"example.org"}`, "john")
+	assert.NoError(t, h.CreateDomain(ctx))
+	assert.Len(t, listRequests(t, h, e, "max", true), 0)
+	inbox = listRequests(t, h, e, "admin", true)
+	if assert.Len(t, inbox, 1) {
+		assert.Equal(t, "example.org", inbox[0].FQDN)
+		assert.Error(t, decide(h, e, "approve", inbox[0].DomainID, `{"comment":"ok"}`, "max"))
+		assert.NoError(t, decide(h, e, "approve", inbox[0].DomainID, `{"comment":"ok"}`, "admin"))
+	}
+	assert.Len(t, listRequests(t, h, e, "admin", true), 0)
+	own = listRequests(t, h, e, "john", false)
+	if assert.Len(t, own, 2) {
+		assert.Equal(t, "example.org", own[0].FQDN)
+		assert.Equal(t, "approved", own[0].Status)
+		assert.Equal(t, "admin", own[0].Reviewer)
+		assert.Equal(t, "ok", own[0].Comment)
+	}
+	approved, err := st.GetDomain(context.Background(), "example.org")
+	assert.NoError(t, err)
+	assert.True(t, approved.Approved)
+}
+
+func TestExpireRequests(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:expiry?mode=memory&cache=shared&_fk=1")
+	defer func(*ent.Client) {
+		_ = client.Close()
+	}(client)
+	database.DB.Internal, _, _ = sqlmock.New()
+	st := store.NewDomainStore(client)
+	st.ApprovalExpiry = time.Millisecond
+	ctx := context.Background()
+
+	d, _ := st.Create(ctx, &ent.Domain{Fqdn: "example.org", Owner: "john"})
+	_, err := st.RequestApproval(ctx, d, "")
+	assert.NoError(t, err)
+	time.Sleep(10 * time.Millisecond)
+
+	expired, err := st.ExpireRequests(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, expired, 1)
+	_, err = st.GetDomain(ctx, "example.org")
+	assert.True(t, ent.IsNotFound(err))
+	requests, err := st.ListRequests(ctx, "john")
+	assert.NoError(t, err)
+	if assert.Len(t, requests, 1) {
+		assert.Equal(t, "expired", requests[0].Status.String())
+	}
+	expired, err = st.ExpireRequests(ctx)
+	assert.NoError(t, err)
+	assert.Empty(t, expired)
+}
@@ -0,0 +1,124 @@
+package domains
+
+import (
+	"net/http"
+	"strings"
+
+	sentryecho "github.com/getsentry/sentry-go/echo"
+	"github.com/hm-edu/domain-rest-interface/ent/domain"
+	"github.com/hm-edu/domain-rest-interface/pkg/model"
+	"github.com/hm-edu/domain-rest-interface/pkg/verification"
+	"github.com/hm-edu/portal-common/auth"
+	"github.com/hm-edu/portal-common/helper"
+	"github.com/hm-edu/portal-common/logging"
+	"github.com/labstack/echo/v5"
+	"go.uber.org/zap"
+)
+
+// verificationReviewer is recorded as reviewer of approval requests approved
+// by the ownership proof.
+const verificationReviewer = "dns-verification"
+
+// canVerify returns the predicate allowing the owner and the admins to prove
+// the ownership of pending domains.
+func (h *Handler) canVerify(c *echo.Context) func(*model.Domain) bool {
+	user, _ := auth.UserFromRequest(c)
+	return func(d *model.Domain) bool {
+		return !d.Approved && (strings.EqualFold(d.Owner, user) || helper.Contains(h.admins, user))
+	}
+}
+
+// StartVerification godoc
+// @Summary Start the ownership proof of a domain.
+// @Description Issues the token that must be published in a TXT record named _pki-portal-verify.<fqdn> to prove the ownership of a pending domain. An existing token is kept until the domain was verified.
+// @Tags Domains
+// @Accept json
+// @Produce json
+// @Router /domains/{id}/verification [post]
+// @Param id path int true "Domain ID"
+// @Security API
+// @Success 200 {object} model.Verification
+// @Response default {object} echo.HTTPError "Error processing the request"
+func (h *Handler) StartVerification(c *echo.Context) error {
+	logger := c.Request().Context().Value(logging.LoggingContextKey).(*zap.Logger)
+
+	span := sentryecho.GetSpanFromContext(c)
+	ctx := c.Request().Context()
+	if span != nil {
+		ctx = span.Context()
+	}
+
+	item, err := h.evaluatePermission(ctx, c, logger, h.canVerify(c))
+	if err != nil {
+		return err
+	}
+	if item.Verification != nil && item.Verification.Status != domain.VerificationStatusVerified.String() {
+		return c.JSON(http.StatusOK, item.Verification)
+	}
+	token, err := verification.NewToken()
+	if err != nil {
+		logger.Error("Generating verification token failed", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Error while starting the verification").Wrap(err)
+	}
+	logger.Info("Starting domain verification", zap.String("fqdn", item.FQDN))
+	updated, err := h.domainStore.StartVerification(ctx, item.ID, token)
+	if err != nil {
+		logger.Error("Starting domain verification failed", zap.Error(err))
+		return &echo.HTTPError{Code: http.StatusInternalServerError, Message: "Error while starting the verification"}
+	}
+	return c.JSON(http.StatusOK, model.VerificationToOutput(updated))
+}
+
+// CheckVerification godoc
+// @Summary Check the ownership proof of a domain.
+// @Description Looks up the _pki-portal-verify TXT record of a pending domain. Once the record contains the token, the domain is either approved or marked as verified for the approvers, depending on the configuration.
+// @Tags Domains
+// @Accept json
+// @Produce json
+// @Router /domains/{id}/verification/check [post]
+// @Param id path int true "Domain ID"
+// @Security API
+// @Success 200 {object} model.Domain The updated domain
+// @Response default {object} echo.HTTPError "Error processing the request"
+func (h *Handler) CheckVerification(c *echo.Context) error {
+	logger := c.Request().Context().Value(logging.LoggingContextKey).(*zap.Logger)
+
+	span := sentryecho.GetSpanFromContext(c)
+	ctx := c.Request().Context()
+	if span != nil {
+		ctx = span.Context()
+	}
+
+	if h.verifier == nil {
+		return &echo.HTTPError{Code: http.StatusNotImplemented, Message: "Domain verification is not available"}
+	}
+	item, err := h.evaluatePermission(ctx, c, logger, h.canVerify(c))
+	if err != nil {
+		return err
+	}
+	if item.Verification == nil {
+		return &echo.HTTPError{Code: http.StatusBadRequest, Message: "Verification not started"}
+	}
+	verified, err := h.verifier.Check(ctx, item.FQDN, item.Verification.Token)
+	if err != nil {
+		logger.Warn("Looking up verification record failed", zap.String("fqdn", item.FQDN), zap.Error(err))
+		return &echo.HTTPError{Code: http.StatusBadGateway, Message: "Error while looking up the verification record"}
+	}
+	logger.Info("Checked domain verification", zap.String("fqdn", item.FQDN), zap.Bool("verified", verified))
+	updated, err := h.domainStore.RecordVerification(ctx, item.ID, verified)
+	if err != nil {
+		logger.Error("Recording domain verification failed", zap.Error(err))
+		return &echo.HTTPError{Code: http.StatusInternalServerError, Message: "Error while recording the verification"}
+	}
+	if verified && h.verifier.AutoApprove {
+		logger.Info("Approving verified domain", zap.String("fqdn", item.FQDN))
+		updated, err = h.domainStore.Approve(ctx, item.ID, verificationReviewer, "Ownership proven by the "+verification.RecordName(item.FQDN)+" TXT record")
+		if err != nil {
+			logger.Error("Approving domain failed", zap.Error(err))
+			return &echo.HTTPError{Code: http.StatusInternalServerError, Message: "Error while approving domain"}
+		}
+		user, _ := auth.UserFromRequest(c)
+		h.publish(ctx, logger, eventDomainApproved, user, updated.Fqdn, []string{updated.Owner}, map[string]string{"owner": updated.Owner})
+	}
+	return c.JSON(http.StatusOK, model.DomainToOutput(updated))
+}
@@ -0,0 +1,114 @@
+package domains
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/hm-edu/domain-rest-interface/ent"
+	"github.com/hm-edu/domain-rest-interface/ent/enttest"
+	"github.com/hm-edu/domain-rest-interface/pkg/database"
+	"github.com/hm-edu/domain-rest-interface/pkg/model"
+	"github.com/hm-edu/domain-rest-interface/pkg/store"
+	"github.com/hm-edu/domain-rest-interface/pkg/verification"
+	"github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+// MockResolver answers TXT lookups from a map.
+type MockResolver map[string][]string
+
+func (r MockResolver) LookupTXT(_ context.Context, name string) ([]string, error) {
+	if records, ok := r[name]; ok {
+		return records, nil
+	}
+	return nil, &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+}
+
+func verificationContext(e *echo.Echo, path string, id int, user string) (*echo.Context, func() []byte) {
+	ctx, rec := mailboxContext(e, http.MethodPost, fmt.Sprintf("/%d%s", id, path), "", user)
+	ctx.SetPath("/:id" + path)
+	ctx.SetPathValues(echo.PathValues{{Name: "id", Value: fmt.Sprint(id)}})
+	return ctx, func() []byte { return rec.Body.Bytes() }
+}
+
+func TestVerification(t *testing.T) {
+	for _, autoApprove := range []bool{false, true} {
+		t.Run(fmt.Sprint(autoApprove), func(t *testing.T) {
+			e := echo.New()
+			client := enttest.Open(t, "sqlite3", fmt.Sprintf("file:verification%v?mode=memory&cache=shared&_fk=1", autoApprove))
+			defer func(*ent.Client) {
+				_ = client.Close()
+			}(client)
+			database.DB.Internal, _, _ = sqlmock.New()
+			st := store.NewDomainStore(client)
+			resolver := MockResolver{}
+			h := NewHandler(st, &MockPkiService{}, nil, &verification.Verifier{Resolver: resolver, AutoApprove: autoApprove}, []string{"admin"})
+
+			ctx, _ := mailboxContext(e, http.MethodPost, "/", `{"fqdn":"example.org"}`, "john")
+			assert.NoError(t, h.CreateDomain(ctx))
+			d, _ := st.GetDomain(context.Background(), "example.org")
+
+			// Only the owner and the admins may prove the ownership.
+			ctx, _ = verificationContext(e, "/verification", d.ID, "max")
+			assert.Error(t, h.StartVerification(ctx))
+			ctx, _ = verificationContext(e, "/verification/check", d.ID, "john")
+			assert.Error(t, h.CheckVerification(ctx))
+
+			ctx, body := verificationContext(e, "/verification", d.ID, "john")
+			assert.NoError(t, h.StartVerification(ctx))
+			started := model.Verification{}
+			assert.NoError(t, json.Unmarshal(body(), &started))
+			assert.Equal(t, "pending", started.Status)
+			assert.Equal(t, "_pki-portal-verify.example.org", started.Record)
+			assert.NotEmpty(t, started.Token)
+
+			// The token is kept until the domain was verified.
+			ctx, body = verificationContext(e, "/verification", d.ID, "john")
+			assert.NoError(t, h.StartVerification(ctx))
+			again := model.Verification{}
+			assert.NoError(t, json.Unmarshal(body(), &again))
+			assert.Equal(t, started.Token, again.Token)
+
+			ctx, body = verificationContext(e, "/verification/check", d.ID, "john")
+			assert.NoError(t, h.CheckVerification(ctx))
+			checked := model.Domain{}
+			assert.NoError(t, json.Unmarshal(body(), &checked))
+			assert.Equal(t, "failed", checked.Verification.Status)
+			assert.NotNil(t, checked.Verification.CheckedAt)
+			assert.Nil(t, checked.Verification.VerifiedAt)
+
+			resolver[started.Record] = []string{started.Token}
+			ctx, body = verificationContext(e, "/verification/check", d.ID, "john")
+			assert.NoError(t, h.CheckVerification(ctx))
+			checked = model.Domain{}
+			assert.NoError(t, json.Unmarshal(body(), &checked))
+			assert.Equal(t, "verified", checked.Verification.Status)
+			assert.NotNil(t, checked.Verification.VerifiedAt)
+			assert.Equal(t, autoApprove, checked.Approved)
+
+			requests, err := st.ListRequests(context.Background(), "john")
+			assert.NoError(t, err)
+			if autoApprove {
+				assert.Equal(t, "approved", requests[0].Status.String())
+				assert.Equal(t, verificationReviewer, *requests[0].Reviewer)
+			} else {
+				// Verified domains are marked in the inbox of the approvers.
+				// Verified domains without approved parent are approved by
+				// the admins.
+				inbox := listRequests(t, h, e, "admin", true)
+				if assert.Len(t, inbox, 1) {
+					assert.True(t, inbox[0].Verified)
+					assert.NoError(t, decide(h, e, "approve", inbox[0].DomainID, `{}`, "admin"))
+				}
+				approved, err := st.GetDomain(context.Background(), "example.org")
+				assert.NoError(t, err)
+				assert.True(t, approved.Approved)
+			}
+		})
+	}
+}
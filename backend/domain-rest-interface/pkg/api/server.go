@@ -12,6 +12,7 @@ import (
 
 	"github.com/hm-edu/domain-rest-interface/pkg/api/domains"
 	"github.com/hm-edu/domain-rest-interface/pkg/store"
+	"github.com/hm-edu/domain-rest-interface/pkg/verification"
 	pb "github.com/hm-edu/portal-apis"
 	commonApi "github.com/hm-edu/portal-common/api"
 	commonAuth "github.com/hm-edu/portal-common/auth"
@@ -51,13 +52,17 @@ type Server struct {
 	config     *commonApi.Config
 	store      *store.DomainStore
 	pkiSerivce pb.SSLServiceClient
-	admins     []string
+	// notifications delivers the domain events (optional).
+	notifications pb.NotificationServiceClient
+	// verifier checks the ownership proofs of pending domains.
+	verifier *verification.Verifier
+	admins   []string
 }
 
 // NewServer creates a new server
-func NewServer(logger *zap.Logger, config *commonApi.Config, store *store.DomainStore, pkiSerivce pb.SSLServiceClient, admins []string) *Server {
+func NewServer(logger *zap.Logger, config *commonApi.Config, store *store.DomainStore, pkiSerivce pb.SSLServiceClient, notifications pb.NotificationServiceClient, verifier *verification.Verifier, admins []string) *Server {
 
-	return &Server{app: echo.New(), logger: logger, config: config, store: store, pkiSerivce: pkiSerivce, admins: admins}
+	return &Server{app: echo.New(), logger: logger, config: config, store: store, pkiSerivce: pkiSerivce, notifications: notifications, verifier: verifier, admins: admins}
 }
 
 func (server *Server) wireRoutesAndMiddleware() {
@@ -120,17 +125,48 @@ func (server *Server) wireRoutesAndMiddleware() {
 
 	v1 := server.app.Group("/domains")
 	{
-		h := domains.NewHandler(server.store, server.pkiSerivce, server.admins)
+		h := domains.NewHandler(server.store, server.pkiSerivce, server.notifications, server.verifier, server.admins)
 		v1.Use(jwtMiddleware)
 		v1.Use(commonAuth.HasScope("Domains"))
 		v1.GET("/", h.ListDomains)
 		v1.POST("/", h.CreateDomain)
+		v1.GET("/requests", h.ListRequests)
+		v1.GET("/requests/inbox", h.ListInbox)
 		{
 			v1.DELETE("/:id", h.DeleteDomain)
 			v1.POST("/:id/approve", h.ApproveDomain)
+			v1.POST("/:id/reject", h.RejectDomain)
+			v1.POST("/:id/verification", h.StartVerification)
+			v1.POST("/:id/verification/check", h.CheckVerification)
 			v1.POST("/:id/transfer", h.TransferDomain)
 			v1.POST("/:id/delegation", h.AddDelegation)
 			v1.DELETE("/:id/delegation/:delegation", h.DeleteDelegation)
+			v1.PUT("/:id/contacts", h.SetContacts)
+		}
+	}
+
+	v1 = server.app.Group("/entitlements")
+	{
+		h := domains.NewHandler(server.store, server.pkiSerivce, server.notifications, server.verifier, server.admins)
+		v1.Use(jwtMiddleware)
+		v1.Use(commonAuth.HasScope("Domains"))
+		v1.GET("/", h.ListEntitlements)
+		v1.POST("/", h.GrantEntitlement)
+		v1.DELETE("/:id", h.RevokeEntitlement)
+	}
+
+	v1 = server.app.Group("/mailboxes")
+	{
+		h := domains.NewHandler(server.store, server.pkiSerivce, server.notifications, server.verifier, server.admins)
+		v1.Use(jwtMiddleware)
+		v1.Use(commonAuth.HasScope("Domains"))
+		v1.GET("/", h.ListMailboxes)
+		v1.POST("/", h.CreateMailbox)
+		{
+			v1.DELETE("/:id", h.DeleteMailbox)
+			v1.POST("/:id/transfer", h.TransferMailbox)
+			v1.POST("/:id/delegation", h.AddMailboxDelegation)
+			v1.DELETE("/:id/delegation/:delegation", h.DeleteMailboxDelegation)
 		}
 	}
 
@@ -2,6 +2,8 @@ package grpc
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/TheZeroSlave/zapsentry"
 	"github.com/getsentry/sentry-go"
@@ -11,6 +13,10 @@ import (
 	"github.com/hm-edu/portal-common/helper"
 
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type domainAPIServer struct {
@@ -38,12 +44,24 @@ func (api *domainAPIServer) CheckPermission(ctx context.Context, req *pb.CheckPe
 	if err != nil {
 		return nil, err
 	}
+	entitlements, err := api.store.ActiveEntitlements(ctx, req.User)
+	if err != nil {
+		return nil, err
+	}
 	log.Info("Checking permissions", zap.String("user", req.User), zap.Strings("domains", req.Domains))
+	// IP addresses may be stored and requested in different textual
+	// representations (e.g. compressed vs. expanded IPv6 addresses).
 	permissions := helper.Map(req.Domains, func(t string) *pb.Permission {
-		if helper.Any(domains, func(d *ent.Domain) bool { return d.Fqdn == t }) {
+		name := helper.NormalizeFqdn(t)
+		if helper.Any(domains, func(d *ent.Domain) bool { return helper.NormalizeFqdn(d.Fqdn) == name }) {
 			log.Info("Permission granted", zap.String("user", req.User), zap.String("domain", t))
 			return &pb.Permission{Domain: t, Granted: true}
 		}
+		// Wildcard names and IP addresses are permitted by entitlements.
+		if entitlement := helper.First(entitlements, func(e *ent.Entitlement) bool { return store.Covers(e, name) }); entitlement != nil {
+			log.Info("Permission granted by entitlement", zap.String("user", req.User), zap.String("domain", t), zap.String("entitlement", entitlement.Value))
+			return &pb.Permission{Domain: t, Granted: true}
+		}
 		log.Info("Permission denied", zap.String("user", req.User), zap.String("domain", t))
 		return &pb.Permission{Domain: t, Granted: false}
 	})
@@ -71,8 +89,9 @@ func (api *domainAPIServer) CheckRegistration(ctx context.Context, req *pb.Check
 	}
 
 	missing := helper.Where(req.Domains, func(t string) bool {
+		name := helper.NormalizeFqdn(t)
 		return !helper.Any(domains, func(d string) bool {
-			return d == t
+			return helper.NormalizeFqdn(d) == name
 		})
 	})
 	log.Info("Checked registrations", zap.Strings("domains", req.Domains), zap.Strings("missing", missing))
@@ -100,3 +119,138 @@ func (api *domainAPIServer) ListDomains(ctx context.Context, req *pb.ListDomains
 	resp := pb.ListDomainsResponse{Domains: helper.Map(domains, func(t *ent.Domain) string { return t.Fqdn })}
 	return &resp, nil
 }
+
+// ListMailboxes returns the functional mailboxes the user owns or is
+// delegated to, i.e. the addresses the user may request S/MIME certificates
+// for.
+func (api *domainAPIServer) ListMailboxes(ctx context.Context, req *pb.ListMailboxesRequest) (*pb.ListMailboxesResponse, error) {
+	log := api.logger
+	hub := sentry.GetHubFromContext(ctx)
+	if hub == nil {
+		hub = sentry.CurrentHub().Clone()
+	}
+	if hub != nil && hub.Scope() != nil {
+		log = log.With(zapsentry.NewScopeFromScope(hub.Scope()))
+		hub.Scope().SetUser(sentry.User{Email: req.User})
+	}
+
+	log.Info("Listing mailboxes", zap.String("user", req.User))
+	mailboxes, err := api.store.ListMailboxes(ctx, req.User, false)
+	if err != nil {
+		log.Error("Listing mailboxes failed", zap.String("user", req.User), zap.Error(err))
+		return nil, err
+	}
+	resp := pb.ListMailboxesResponse{Emails: helper.Map(mailboxes, func(t *ent.Mailbox) string { return t.Email })}
+	log.Debug("Listed mailboxes", zap.String("user", req.User), zap.Strings("emails", resp.Emails))
+	return &resp, nil
+}
+
+// ListDomainContacts returns the owner, the delegates and the technical
+// contacts responsible for each of the given names. Names that are not
+// registered are covered by the closest approved parent domain; names
+// without responsible domain are omitted.
+func (api *domainAPIServer) ListDomainContacts(ctx context.Context, req *pb.ListDomainContactsRequest) (*pb.ListDomainContactsResponse, error) {
+	log := api.logger
+	hub := sentry.GetHubFromContext(ctx)
+	if hub == nil {
+		hub = sentry.CurrentHub().Clone()
+	}
+	if hub != nil && hub.Scope() != nil {
+		log = log.With(zapsentry.NewScopeFromScope(hub.Scope()))
+	}
+
+	log.Debug("Listing domain contacts", zap.Strings("domains", req.Domains))
+	responsible, err := api.store.ResponsibleDomains(ctx, req.Domains)
+	if err != nil {
+		log.Error("Listing domain contacts failed", zap.Strings("domains", req.Domains), zap.Error(err))
+		return nil, err
+	}
+	resp := pb.ListDomainContactsResponse{}
+	for _, name := range req.Domains {
+		d, ok := responsible[name]
+		if !ok {
+			continue
+		}
+		resp.Contacts = append(resp.Contacts, &pb.DomainContacts{
+			Domain:    name,
+			Owner:     d.Owner,
+			Delegates: helper.Map(d.Edges.Delegations, func(t *ent.Delegation) string { return t.User }),
+			Contacts:  d.Contacts,
+		})
+	}
+	return &resp, nil
+}
+
+func entitlementToOutput(e *ent.Entitlement) *pb.Entitlement {
+	item := &pb.Entitlement{
+		Id:            int32(e.ID),
+		Kind:          e.Kind.String(),
+		Value:         e.Value,
+		Owner:         e.Owner,
+		Justification: e.Justification,
+		GrantedBy:     e.GrantedBy,
+		Created:       timestamppb.New(e.CreateTime),
+	}
+	if e.ExpiresAt != nil {
+		item.ExpiresAt = timestamppb.New(*e.ExpiresAt)
+	}
+	return item
+}
+
+// ListEntitlements returns the wildcard and IP entitlements of the owner or
+// all entitlements if no owner is given.
+func (api *domainAPIServer) ListEntitlements(ctx context.Context, req *pb.ListEntitlementsRequest) (*pb.ListEntitlementsResponse, error) {
+	entitlements, err := api.store.ListEntitlements(ctx, req.Owner)
+	if err != nil {
+		api.logger.Error("Listing entitlements failed", zap.String("owner", req.Owner), zap.Error(err))
+		return nil, status.Error(codes.Internal, "listing entitlements failed")
+	}
+	return &pb.ListEntitlementsResponse{Entitlements: helper.Map(entitlements, entitlementToOutput)}, nil
+}
+
+// GrantEntitlement grants a wildcard or IP entitlement. Only admins may
+// grant entitlements.
+func (api *domainAPIServer) GrantEntitlement(ctx context.Context, req *pb.GrantEntitlementRequest) (*pb.Entitlement, error) {
+	if !helper.Contains(api.admins, req.Actor) {
+		return nil, status.Error(codes.PermissionDenied, "only admins may grant entitlements")
+	}
+	if req.Owner == "" || req.Justification == "" {
+		return nil, status.Error(codes.InvalidArgument, "owner and justification are required")
+	}
+	var expiresAt *time.Time
+	if req.ExpiresAt != nil {
+		t := req.ExpiresAt.AsTime()
+		expiresAt = &t
+	}
+	e, err := api.store.GrantEntitlement(ctx, req.Value, req.Owner, req.Justification, req.Actor, expiresAt)
+	if err != nil {
+		if errors.Is(err, store.ErrInvalidEntitlement) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if ent.IsConstraintError(err) {
+			return nil, status.Error(codes.AlreadyExists, "entitlement already exists")
+		}
+		api.logger.Error("Granting entitlement failed", zap.String("value", req.Value), zap.Error(err))
+		return nil, status.Error(codes.Internal, "granting entitlement failed")
+	}
+	api.logger.Info("Granted entitlement", zap.String("value", e.Value), zap.String("owner", e.Owner), zap.String("actor", req.Actor))
+	return entitlementToOutput(e), nil
+}
+
+// RevokeEntitlement deletes an entitlement. Only admins may revoke
+// entitlements.
+func (api *domainAPIServer) RevokeEntitlement(ctx context.Context, req *pb.RevokeEntitlementRequest) (*emptypb.Empty, error) {
+	if !helper.Contains(api.admins, req.Actor) {
+		return nil, status.Error(codes.PermissionDenied, "only admins may revoke entitlements")
+	}
+	e, err := api.store.RevokeEntitlement(ctx, int(req.Id))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, status.Error(codes.NotFound, "entitlement not found")
+		}
+		api.logger.Error("Revoking entitlement failed", zap.Int32("id", req.Id), zap.Error(err))
+		return nil, status.Error(codes.Internal, "revoking entitlement failed")
+	}
+	api.logger.Info("Revoked entitlement", zap.String("value", e.Value), zap.String("owner", e.Owner), zap.String("actor", req.Actor))
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,97 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/hm-edu/domain-rest-interface/ent"
+	"github.com/hm-edu/domain-rest-interface/ent/enttest"
+	"github.com/hm-edu/domain-rest-interface/pkg/database"
+	"github.com/hm-edu/domain-rest-interface/pkg/store"
+	pb "github.com/hm-edu/portal-apis"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	// Importing the go-sqlite3 is required to create a sqlite3 database.
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestEntitlements(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:entitlements?mode=memory&cache=shared&_fk=1")
+	defer func(*ent.Client) {
+		_ = client.Close()
+	}(client)
+	database.DB.Internal, _, _ = sqlmock.New()
+	st := store.NewDomainStore(client)
+	api := newDomainAPIServer(st, zap.L(), []string{"admin"})
+	ctx := context.Background()
+
+	_, _ = st.Create(ctx, &ent.Domain{Fqdn: "cs.hm.edu", Owner: "max@hm.edu", Approved: true})
+
+	_, err := api.GrantEntitlement(ctx, &pb.GrantEntitlementRequest{Value: "*.kube.cs.hm.edu", Owner: "max@hm.edu", Justification: "k8s ingress", Actor: "max@hm.edu"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = api.GrantEntitlement(ctx, &pb.GrantEntitlementRequest{Value: "kube.cs.hm.edu", Owner: "max@hm.edu", Justification: "k8s ingress", Actor: "admin"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	wildcard, err := api.GrantEntitlement(ctx, &pb.GrantEntitlementRequest{Value: "*.kube.cs.hm.edu", Owner: "max@hm.edu", Justification: "k8s ingress", Actor: "admin"})
+	assert.NoError(t, err)
+	assert.Equal(t, "wildcard", wildcard.Kind)
+	_, err = api.GrantEntitlement(ctx, &pb.GrantEntitlementRequest{Value: "*.kube.cs.hm.edu", Owner: "max@hm.edu", Justification: "again", Actor: "admin"})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	_, err = api.GrantEntitlement(ctx, &pb.GrantEntitlementRequest{Value: "10.0.0.0/24", Owner: "max@hm.edu", Justification: "DoH", Actor: "admin"})
+	assert.NoError(t, err)
+	expiring, err := api.GrantEntitlement(ctx, &pb.GrantEntitlementRequest{Value: "2001:db8::53", Owner: "max@hm.edu", Justification: "DoT", Actor: "admin", ExpiresAt: timestamppb.New(time.Now().Add(time.Hour))})
+	assert.NoError(t, err)
+	assert.Equal(t, "2001:db8::53/128", expiring.Value)
+	// Expired entitlements do not permit anything.
+	expired, err := st.GrantEntitlement(ctx, "10.1.0.1", "max@hm.edu", "old", "admin", nil)
+	assert.NoError(t, err)
+	client.Entitlement.UpdateOne(expired).SetExpiresAt(time.Now().Add(-time.Hour)).ExecX(ctx)
+
+	resp, err := api.CheckPermission(ctx, &pb.CheckPermissionRequest{User: "max@hm.edu", Domains: []string{
+		"*.kube.cs.hm.edu", "*.cs.hm.edu", "10.0.0.42", "10.0.1.1", "2001:db8:0:0::53", "10.1.0.1", "www.cs.hm.edu",
+	}})
+	assert.NoError(t, err)
+	granted := map[string]bool{}
+	for _, p := range resp.Permissions {
+		granted[p.Domain] = p.Granted
+	}
+	assert.Equal(t, map[string]bool{
+		"*.kube.cs.hm.edu": true, "*.cs.hm.edu": false, "10.0.0.42": true, "10.0.1.1": false,
+		"2001:db8:0:0::53": true, "10.1.0.1": false, "www.cs.hm.edu": false,
+	}, granted)
+
+	// Entitlements are bound to their owner.
+	resp, err = api.CheckPermission(ctx, &pb.CheckPermissionRequest{User: "john@hm.edu", Domains: []string{"*.kube.cs.hm.edu", "10.0.0.42"}})
+	assert.NoError(t, err)
+	for _, p := range resp.Permissions {
+		assert.False(t, p.Granted, p.Domain)
+	}
+
+	// Owners are compared case-insensitively.
+	_, err = api.GrantEntitlement(ctx, &pb.GrantEntitlementRequest{Value: "*.kube.cs.hm.edu", Owner: " Max@HM.edu", Justification: "again", Actor: "admin"})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	_, err = api.GrantEntitlement(ctx, &pb.GrantEntitlementRequest{Value: "*.web.cs.hm.edu", Owner: "max", Justification: "no mail", Actor: "admin"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	resp, err = api.CheckPermission(ctx, &pb.CheckPermissionRequest{User: "Max@hm.edu", Domains: []string{"*.kube.cs.hm.edu"}})
+	assert.NoError(t, err)
+	assert.True(t, resp.Permissions[0].Granted)
+
+	list, err := api.ListEntitlements(ctx, &pb.ListEntitlementsRequest{Owner: "MAX@hm.edu"})
+	assert.NoError(t, err)
+	assert.Len(t, list.Entitlements, 4)
+	_, err = api.RevokeEntitlement(ctx, &pb.RevokeEntitlementRequest{Id: wildcard.Id, Actor: "max@hm.edu"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = api.RevokeEntitlement(ctx, &pb.RevokeEntitlementRequest{Id: wildcard.Id, Actor: "admin"})
+	assert.NoError(t, err)
+	_, err = api.RevokeEntitlement(ctx, &pb.RevokeEntitlementRequest{Id: wildcard.Id, Actor: "admin"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	resp, err = api.CheckPermission(ctx, &pb.CheckPermissionRequest{User: "max@hm.edu", Domains: []string{"*.kube.cs.hm.edu"}})
+	assert.NoError(t, err)
+	assert.False(t, resp.Permissions[0].Granted)
+}
@@ -0,0 +1,20 @@
+package model
+
+import (
+	"github.com/hm-edu/portal-common/model"
+	"github.com/labstack/echo/v5"
+)
+
+// ContactsRequest represents an request for setting the technical contacts of a domain.
+type ContactsRequest struct {
+	Contacts []string `json:"contacts" validate:"max=20,dive,email"`
+}
+
+// Bind binds an incoming echo request to the the ContactsRequest and perfoms a validation
+func (r *ContactsRequest) Bind(c *echo.Context, v *model.Validator) error {
+	if err := c.Bind(r); err != nil {
+		return err
+	}
+	err := v.Validate(r)
+	return err
+}
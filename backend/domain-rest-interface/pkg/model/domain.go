@@ -1,7 +1,10 @@
 package model
 
 import (
+	"time"
+
 	"github.com/hm-edu/domain-rest-interface/ent"
+	"github.com/hm-edu/domain-rest-interface/pkg/verification"
 	"github.com/hm-edu/portal-common/helper"
 	"github.com/hm-edu/portal-common/model"
 	"github.com/labstack/echo/v5"
@@ -10,6 +13,9 @@ import (
 // DomainRequest represents an request for an action on a domain.
 type DomainRequest struct {
 	FQDN string `json:"fqdn" validate:"required,fqdn"`
+	// Justification is passed to the approvers if the domain can not be
+	// approved automatically.
+	Justification string `json:"justification,omitempty" validate:"max=1000"`
 }
 
 // Bind binds an incoming echo request to the internal domain model and perfoms a validation
@@ -23,7 +29,33 @@ func (r *DomainRequest) Bind(c *echo.Context, v *model.Validator) error {
 
 // DomainToOutput converts the internal domain model to the REST representation.
 func DomainToOutput(d *ent.Domain) Domain {
-	return Domain{ID: d.ID, FQDN: d.Fqdn, Owner: d.Owner, Approved: d.Approved, Delegations: helper.Map(d.Edges.Delegations, DelegationToOutput)}
+	return Domain{ID: d.ID, FQDN: d.Fqdn, Owner: d.Owner, Approved: d.Approved, Delegations: helper.Map(d.Edges.Delegations, DelegationToOutput), Contacts: d.Contacts, Verification: VerificationToOutput(d)}
+}
+
+// Verification represents the ownership proof of a domain using a TXT
+// record.
+type Verification struct {
+	Status string `json:"status"`
+	// Record is the name of the TXT record that must contain the token.
+	Record     string     `json:"record"`
+	Token      string     `json:"token"`
+	CheckedAt  *time.Time `json:"checked_at,omitempty"`
+	VerifiedAt *time.Time `json:"verified_at,omitempty"`
+}
+
+// VerificationToOutput converts the verification state of a domain to the
+// REST representation. It is nil if no verification was started.
+func VerificationToOutput(d *ent.Domain) *Verification {
+	if d.VerificationToken == nil {
+		return nil
+	}
+	return &Verification{
+		Status:     d.VerificationStatus.String(),
+		Record:     verification.RecordName(d.Fqdn),
+		Token:      *d.VerificationToken,
+		CheckedAt:  d.VerificationCheckedAt,
+		VerifiedAt: d.VerifiedAt,
+	}
 }
 
 // Domain represents a domain.
@@ -33,7 +65,12 @@ type Domain struct {
 	Owner       string        `json:"owner"`
 	Delegations []*Delegation `json:"delegations"`
 	Approved    bool          `json:"approved"`
-	Permissions Permissions   `json:"permissions,omitempty"`
+	// Contacts are notified about expiring certificates in addition to the
+	// owner and the delegates.
+	Contacts []string `json:"contacts,omitempty"`
+	// Verification is the state of the ownership proof of a pending domain.
+	Verification *Verification `json:"verification,omitempty"`
+	Permissions  Permissions   `json:"permissions,omitempty"`
 }
 
 // Permissions holds the informations about the permissions on the domain
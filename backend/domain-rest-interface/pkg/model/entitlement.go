@@ -0,0 +1,55 @@
+package model
+
+import (
+	"time"
+
+	"github.com/hm-edu/domain-rest-interface/ent"
+	"github.com/hm-edu/portal-common/model"
+	"github.com/labstack/echo/v5"
+)
+
+// EntitlementRequest represents an request for granting a wildcard or IP
+// entitlement.
+type EntitlementRequest struct {
+	// Value is a wildcard name (e.g. *.kube.cs.hm.edu), an IP address or a
+	// network in CIDR notation.
+	Value         string     `json:"value" validate:"required,max=255"`
+	Owner         string     `json:"owner" validate:"required,email,max=255"`
+	Justification string     `json:"justification" validate:"required,max=1000"`
+	ExpiresAt     *time.Time `json:"expires_at,omitempty"`
+}
+
+// Bind binds an incoming echo request to the the EntitlementRequest and perfoms a validation
+func (r *EntitlementRequest) Bind(c *echo.Context, v *model.Validator) error {
+	if err := c.Bind(r); err != nil {
+		return err
+	}
+	err := v.Validate(r)
+	return err
+}
+
+// Entitlement represents a wildcard or IP entitlement.
+type Entitlement struct {
+	ID            int        `json:"id"`
+	Kind          string     `json:"kind"`
+	Value         string     `json:"value"`
+	Owner         string     `json:"owner"`
+	Justification string     `json:"justification"`
+	GrantedBy     string     `json:"granted_by"`
+	CreatedAt     time.Time  `json:"created_at"`
+	ExpiresAt     *time.Time `json:"expires_at,omitempty"`
+}
+
+// EntitlementToOutput converts the internal entitlement model to the REST representation.
+func EntitlementToOutput(e *ent.Entitlement) *Entitlement {
+	return &Entitlement{
+		ID:            e.ID,
+		Kind:          e.Kind.String(),
+		Value:         e.Value,
+		Owner:         e.Owner,
+		Justification: e.Justification,
+		GrantedBy:     e.GrantedBy,
+		CreatedAt:     e.CreateTime,
+		ExpiresAt:     e.ExpiresAt,
+	}
+}
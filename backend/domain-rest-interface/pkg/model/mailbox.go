@@ -0,0 +1,50 @@
+package model
+
+import (
+	"github.com/hm-edu/domain-rest-interface/ent"
+	"github.com/hm-edu/portal-common/helper"
+	"github.com/hm-edu/portal-common/model"
+	"github.com/labstack/echo/v5"
+)
+
+// MailboxRequest represents an request for creating a functional mailbox.
+type MailboxRequest struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+// Bind binds an incoming echo request to the MailboxRequest and perfoms a validation
+func (r *MailboxRequest) Bind(c *echo.Context, v *model.Validator) error {
+	if err := c.Bind(r); err != nil {
+		return err
+	}
+	err := v.Validate(r)
+	return err
+}
+
+// Mailbox represents a functional or role mailbox (e.g. it-support@) whose
+// owner and delegates may request S/MIME certificates for it.
+type Mailbox struct {
+	ID          int                `json:"id"`
+	Email       string             `json:"email"`
+	Owner       string             `json:"owner"`
+	Delegations []*Delegation      `json:"delegations"`
+	Permissions MailboxPermissions `json:"permissions,omitempty"`
+}
+
+// MailboxPermissions holds the informations about the permissions on the
+// functional mailbox.
+type MailboxPermissions struct {
+	CanDelete   bool `json:"can_delete"`
+	CanTransfer bool `json:"can_transfer"`
+	CanDelegate bool `json:"can_delegate"`
+}
+
+// MailboxToOutput converts the internal mailbox model to the REST representation.
+func MailboxToOutput(m *ent.Mailbox) Mailbox {
+	return Mailbox{ID: m.ID, Email: m.Email, Owner: m.Owner, Delegations: helper.Map(m.Edges.Delegations, MailboxDelegationToOutput)}
+}
+
+// MailboxDelegationToOutput converts the internal mailbox delegation model to the REST representation.
+func MailboxDelegationToOutput(d *ent.MailboxDelegation) *Delegation {
+	return &Delegation{ID: d.ID, User: d.User}
+}
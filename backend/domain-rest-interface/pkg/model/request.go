@@ -0,0 +1,68 @@
+package model
+
+import (
+	"time"
+
+	"github.com/hm-edu/domain-rest-interface/ent"
+	"github.com/hm-edu/domain-rest-interface/ent/domain"
+	"github.com/hm-edu/portal-common/model"
+	"github.com/labstack/echo/v5"
+)
+
+// DecisionRequest represents the decision on a pending domain. A comment is
+// required for rejections.
+type DecisionRequest struct {
+	Comment string `json:"comment" validate:"max=1000"`
+}
+
+// Bind binds an incoming echo request to the the DecisionRequest and perfoms a validation
+func (r *DecisionRequest) Bind(c *echo.Context, v *model.Validator) error {
+	if err := c.Bind(r); err != nil {
+		return err
+	}
+	err := v.Validate(r)
+	return err
+}
+
+// ApprovalRequest represents the request for approving a domain.
+type ApprovalRequest struct {
+	ID int `json:"id"`
+	// DomainID is empty once the domain was deleted (e.g. on rejection).
+	DomainID      int    `json:"domain_id,omitempty"`
+	FQDN          string `json:"fqdn"`
+	Requester     string `json:"requester"`
+	Justification string `json:"justification,omitempty"`
+	Status        string `json:"status"`
+	// Verified is set if the requester proved the ownership of the domain.
+	Verified  bool       `json:"verified,omitempty"`
+	Reviewer  string     `json:"reviewer,omitempty"`
+	Comment   string     `json:"comment,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	DecidedAt *time.Time `json:"decided_at,omitempty"`
+}
+
+// ApprovalRequestToOutput converts the internal approval request model to the REST representation.
+func ApprovalRequestToOutput(r *ent.ApprovalRequest) *ApprovalRequest {
+	item := &ApprovalRequest{
+		ID:            r.ID,
+		FQDN:          r.Fqdn,
+		Requester:     r.Requester,
+		Justification: r.Justification,
+		Status:        r.Status.String(),
+		CreatedAt:     r.CreateTime,
+		ExpiresAt:     r.ExpiresAt,
+		DecidedAt:     r.DecidedAt,
+	}
+	if r.Edges.Domain != nil {
+		item.DomainID = r.Edges.Domain.ID
+		item.Verified = r.Edges.Domain.VerificationStatus == domain.VerificationStatusVerified
+	}
+	if r.Reviewer != nil {
+		item.Reviewer = *r.Reviewer
+	}
+	if r.Comment != nil {
+		item.Comment = *r.Comment
+	}
+	return item
+}
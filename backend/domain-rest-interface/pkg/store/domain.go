@@ -3,9 +3,13 @@ package store
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"github.com/hm-edu/domain-rest-interface/ent"
+	"github.com/hm-edu/domain-rest-interface/ent/approvalrequest"
 	"github.com/hm-edu/domain-rest-interface/ent/delegation"
 	"github.com/hm-edu/domain-rest-interface/ent/domain"
 	"github.com/hm-edu/domain-rest-interface/ent/predicate"
@@ -16,6 +20,9 @@ import (
 // DomainStore provides the storage of domains
 type DomainStore struct {
 	db *ent.Client
+	// ApprovalExpiry is the time after which pending approval requests
+	// expire (DefaultApprovalExpiry if not set).
+	ApprovalExpiry time.Duration
 }
 
 // NewDomainStore creates a new instance of a DomainStore
@@ -121,12 +128,25 @@ func (s *DomainStore) Owner(ctx context.Context, id int, owner string) (*ent.Dom
 	return s.db.Domain.UpdateOneID(id).SetOwner(owner).Save(ctx)
 }
 
-// Approve sets the given domain on approved.
-func (s *DomainStore) Approve(ctx context.Context, id int) (*ent.Domain, error) {
+// Approve sets the given domain on approved and approves its pending
+// approval requests.
+func (s *DomainStore) Approve(ctx context.Context, id int, reviewer, comment string) (*ent.Domain, error) {
 	if err := database.DB.Internal.Ping(); err != nil {
 		return nil, fmt.Errorf("pinging the database: %w", err)
 	}
-	return s.db.Domain.UpdateOneID(id).SetApproved(true).Save(ctx)
+	var updated *ent.Domain
+	err := s.withTx(ctx, func(db *ent.Client) error {
+		var err error
+		updated, err = db.Domain.UpdateOneID(id).SetApproved(true).Save(ctx)
+		if err != nil {
+			return err
+		}
+		return decide(ctx, db, id, approvalrequest.StatusApproved, reviewer, comment)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated.Unwrap(), nil
 }
 
 // AddDelegation adds a delegation to a domain.
@@ -167,3 +187,131 @@ func (s *DomainStore) Delete(ctx context.Context, id int) error {
 
 	return nil
 }
+
+// SetContacts sets the technical contacts of a domain.
+func (s *DomainStore) SetContacts(ctx context.Context, id int, contacts []string) (*ent.Domain, error) {
+	if err := database.DB.Internal.Ping(); err != nil {
+		return nil, fmt.Errorf("pinging the database: %w", err)
+	}
+	err := s.db.Domain.UpdateOneID(id).SetContacts(contacts).Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.db.Domain.Query().Where(domain.ID(id)).WithDelegations().First(ctx)
+}
+
+// fqdnBatchSize bounds the number of names looked up per query, as the
+// databases limit the number of parameters of a statement.
+const fqdnBatchSize = 1000
+
+// ResponsibleDomains returns the domain responsible for each of the given
+// names: the domain itself or, if it is not registered, the closest
+// approved parent domain. Names without responsible domain are omitted.
+func (s *DomainStore) ResponsibleDomains(ctx context.Context, names []string) (map[string]*ent.Domain, error) {
+	if err := database.DB.Internal.Ping(); err != nil {
+		return nil, fmt.Errorf("pinging the database: %w", err)
+	}
+	candidates := make(map[string][]string)
+	var fqdns []string
+	for _, name := range names {
+		fqdn := strings.TrimPrefix(helper.NormalizeFqdn(name), "*.")
+		if _, ok := helper.ParseIP(fqdn); ok {
+			candidates[name] = []string{fqdn}
+			fqdns = append(fqdns, fqdn)
+			continue
+		}
+		for labels := strings.Split(fqdn, "."); len(labels) > 1; labels = labels[1:] {
+			candidates[name] = append(candidates[name], strings.Join(labels, "."))
+		}
+		fqdns = append(fqdns, candidates[name]...)
+	}
+	// Names share their parent domains.
+	slices.Sort(fqdns)
+	fqdns = slices.Compact(fqdns)
+	byFqdn := make(map[string]*ent.Domain)
+	for batch := range slices.Chunk(fqdns, fqdnBatchSize) {
+		domains, err := s.db.Domain.Query().Where(domain.FqdnIn(batch...)).WithDelegations().All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, d := range domains {
+			byFqdn[helper.NormalizeFqdn(d.Fqdn)] = d
+		}
+	}
+	responsible := make(map[string]*ent.Domain)
+	for name, fqdns := range candidates {
+		for i, fqdn := range fqdns {
+			// Parent domains are only responsible once they were approved.
+			if d, ok := byFqdn[fqdn]; ok && (i == 0 || d.Approved) {
+				responsible[name] = d
+				break
+			}
+		}
+	}
+	return responsible, nil
+}
+
+// ApprovedParent returns the closest approved parent domain of the fqdn or
+// nil if there is none.
+func (s *DomainStore) ApprovedParent(ctx context.Context, fqdn string) (*ent.Domain, error) {
+	if err := database.DB.Internal.Ping(); err != nil {
+		return nil, fmt.Errorf("pinging the database: %w", err)
+	}
+	fqdn = helper.NormalizeFqdn(fqdn)
+	if _, ok := helper.ParseIP(fqdn); ok {
+		return nil, nil
+	}
+	var parents []string
+	for labels := strings.Split(fqdn, ".")[1:]; len(labels) > 1; labels = labels[1:] {
+		parents = append(parents, strings.Join(labels, "."))
+	}
+	if len(parents) == 0 {
+		return nil, nil
+	}
+	domains, err := s.db.Domain.Query().Where(domain.FqdnIn(parents...), domain.Approved(true)).WithDelegations().All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var closest *ent.Domain
+	for _, d := range domains {
+		if closest == nil || len(d.Fqdn) > len(closest.Fqdn) {
+			closest = d
+		}
+	}
+	return closest, nil
+}
+
+// StartVerification stores the verification token of a domain and marks
+// its verification as pending.
+func (s *DomainStore) StartVerification(ctx context.Context, id int, token string) (*ent.Domain, error) {
+	if err := database.DB.Internal.Ping(); err != nil {
+		return nil, fmt.Errorf("pinging the database: %w", err)
+	}
+	err := s.db.Domain.UpdateOneID(id).
+		SetVerificationToken(token).
+		SetVerificationStatus(domain.VerificationStatusPending).
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.db.Domain.Query().Where(domain.ID(id)).WithDelegations().First(ctx)
+}
+
+// RecordVerification records the result of checking the verification
+// record of a domain.
+func (s *DomainStore) RecordVerification(ctx context.Context, id int, verified bool) (*ent.Domain, error) {
+	if err := database.DB.Internal.Ping(); err != nil {
+		return nil, fmt.Errorf("pinging the database: %w", err)
+	}
+	now := time.Now()
+	update := s.db.Domain.UpdateOneID(id).SetVerificationCheckedAt(now)
+	if verified {
+		update.SetVerificationStatus(domain.VerificationStatusVerified).SetVerifiedAt(now)
+	} else {
+		update.SetVerificationStatus(domain.VerificationStatusFailed)
+	}
+	if err := update.Exec(ctx); err != nil {
+		return nil, err
+	}
+	return s.db.Domain.Query().Where(domain.ID(id)).WithDelegations().First(ctx)
+}
@@ -0,0 +1,149 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/mail"
+	"net/netip"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hm-edu/domain-rest-interface/ent"
+	"github.com/hm-edu/domain-rest-interface/ent/entitlement"
+	"github.com/hm-edu/domain-rest-interface/pkg/database"
+	"github.com/hm-edu/portal-common/helper"
+	"golang.org/x/net/publicsuffix"
+)
+
+// ErrInvalidEntitlement is returned for entitlements that are neither a
+// valid wildcard name nor an IP address or network.
+var ErrInvalidEntitlement = errors.New("invalid entitlement")
+
+var label = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`)
+
+// ParseEntitlement returns the kind and the canonical form of an
+// entitlement. Wildcards must cover a single label below a domain that is
+// not a public suffix (e.g. *.kube.cs.hm.edu). IP addresses are converted to
+// networks containing only the address.
+func ParseEntitlement(value string) (entitlement.Kind, string, error) {
+	value = strings.TrimSpace(value)
+	if strings.Contains(value, "/") {
+		prefix, err := netip.ParsePrefix(value)
+		if err != nil || prefix.Bits() == 0 {
+			return "", "", fmt.Errorf("%w: %q is no valid network", ErrInvalidEntitlement, value)
+		}
+		return entitlement.KindIP, prefix.Masked().String(), nil
+	}
+	if ip, ok := helper.ParseIP(value); ok {
+		return entitlement.KindIP, netip.PrefixFrom(ip, ip.BitLen()).String(), nil
+	}
+	name := helper.NormalizeFqdn(value)
+	parent, ok := strings.CutPrefix(name, "*.")
+	if !ok {
+		return "", "", fmt.Errorf("%w: %q is neither a wildcard name nor an IP address", ErrInvalidEntitlement, value)
+	}
+	for _, l := range strings.Split(parent, ".") {
+		if !label.MatchString(l) {
+			return "", "", fmt.Errorf("%w: %q is no valid wildcard name", ErrInvalidEntitlement, value)
+		}
+	}
+	if _, err := publicsuffix.EffectiveTLDPlusOne(parent); err != nil {
+		return "", "", fmt.Errorf("%w: %q covers a public suffix", ErrInvalidEntitlement, value)
+	}
+	return entitlement.KindWildcard, name, nil
+}
+
+// Covers reports whether the entitlement permits the given name. Wildcard
+// entitlements only permit the wildcard name itself, IP entitlements permit
+// all addresses of their network.
+func Covers(e *ent.Entitlement, name string) bool {
+	if ip, ok := helper.ParseIP(name); ok {
+		if e.Kind != entitlement.KindIP {
+			return false
+		}
+		prefix, err := netip.ParsePrefix(e.Value)
+		return err == nil && prefix.Contains(ip)
+	}
+	return e.Kind == entitlement.KindWildcard && e.Value == helper.NormalizeFqdn(name)
+}
+
+// parseOwner returns the lowercased mail address of an entitlement owner.
+func parseOwner(owner string) (string, error) {
+	owner = strings.ToLower(strings.TrimSpace(owner))
+	if addr, err := mail.ParseAddress(owner); err != nil || addr.Address != owner {
+		return "", fmt.Errorf("%w: %q is no valid owner", ErrInvalidEntitlement, owner)
+	}
+	return owner, nil
+}
+
+// GrantEntitlement creates a new entitlement. The owner is stored in
+// lowercase.
+func (s *DomainStore) GrantEntitlement(ctx context.Context, value, owner, justification, grantedBy string, expiresAt *time.Time) (*ent.Entitlement, error) {
+	if err := database.DB.Internal.Ping(); err != nil {
+		return nil, fmt.Errorf("pinging the database: %w", err)
+	}
+	kind, value, err := ParseEntitlement(value)
+	if err != nil {
+		return nil, err
+	}
+	owner, err = parseOwner(owner)
+	if err != nil {
+		return nil, err
+	}
+	if expiresAt != nil && expiresAt.Before(time.Now()) {
+		return nil, fmt.Errorf("%w: the expiry must be in the future", ErrInvalidEntitlement)
+	}
+	return s.db.Entitlement.Create().
+		SetKind(kind).
+		SetValue(value).
+		SetOwner(owner).
+		SetJustification(justification).
+		SetGrantedBy(grantedBy).
+		SetNillableExpiresAt(expiresAt).
+		Save(ctx)
+}
+
+// ListEntitlements returns the entitlements of the owner or all entitlements
+// if the owner is empty. Owners are compared case-insensitively.
+func (s *DomainStore) ListEntitlements(ctx context.Context, owner string) ([]*ent.Entitlement, error) {
+	if err := database.DB.Internal.Ping(); err != nil {
+		return nil, fmt.Errorf("pinging the database: %w", err)
+	}
+	q := s.db.Entitlement.Query()
+	if owner != "" {
+		q = q.Where(entitlement.OwnerEqualFold(owner))
+	}
+	return q.Order(ent.Asc(entitlement.FieldValue), ent.Asc(entitlement.FieldOwner)).All(ctx)
+}
+
+// ActiveEntitlements returns the entitlements of the owner that did not
+// expire. Owners are compared case-insensitively.
+func (s *DomainStore) ActiveEntitlements(ctx context.Context, owner string) ([]*ent.Entitlement, error) {
+	if err := database.DB.Internal.Ping(); err != nil {
+		return nil, fmt.Errorf("pinging the database: %w", err)
+	}
+	owner = strings.TrimSpace(owner)
+	if owner == "" {
+		return nil, nil
+	}
+	return s.db.Entitlement.Query().
+		Where(entitlement.OwnerEqualFold(owner), entitlement.Or(entitlement.ExpiresAtIsNil(), entitlement.ExpiresAtGT(time.Now()))).
+		All(ctx)
+}
+
+// RevokeEntitlement deletes an entitlement and returns it.
+func (s *DomainStore) RevokeEntitlement(ctx context.Context, id int) (*ent.Entitlement, error) {
+	if err := database.DB.Internal.Ping(); err != nil {
+		return nil, fmt.Errorf("pinging the database: %w", err)
+	}
+	e, err := s.db.Entitlement.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.db.Entitlement.DeleteOne(e).Exec(ctx); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
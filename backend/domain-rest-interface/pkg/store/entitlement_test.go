@@ -0,0 +1,88 @@
+package store
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hm-edu/domain-rest-interface/ent"
+	"github.com/hm-edu/domain-rest-interface/ent/entitlement"
+)
+
+func TestParseEntitlement(t *testing.T) {
+	tc := []struct {
+		value string
+		kind  entitlement.Kind
+		want  string
+	}{
+		{value: "*.Kube.cs.hm.edu.", kind: entitlement.KindWildcard, want: "*.kube.cs.hm.edu"},
+		{value: "10.0.0.1", kind: entitlement.KindIP, want: "10.0.0.1/32"},
+		{value: "10.0.0.17/24", kind: entitlement.KindIP, want: "10.0.0.0/24"},
+		{value: "2001:0DB8:0:0::1", kind: entitlement.KindIP, want: "2001:db8::1/128"},
+		{value: "2001:db8::/48", kind: entitlement.KindIP, want: "2001:db8::/48"},
+		{value: "www.hm.edu"},
+		{value: "*.edu"},
+		{value: "*.*.hm.edu"},
+		{value: "*.hm_edu.de"},
+		{value: "0.0.0.0/0"},
+		{value: "10.0.0.1/33"},
+	}
+	for _, c := range tc {
+		kind, value, err := ParseEntitlement(c.value)
+		if c.want == "" {
+			if !errors.Is(err, ErrInvalidEntitlement) {
+				t.Errorf("%s: expected invalid entitlement, got %s %v", c.value, value, err)
+			}
+			continue
+		}
+		if err != nil || kind != c.kind || value != c.want {
+			t.Errorf("%s: expected %s %s, got %s %s (%v)", c.value, c.kind, c.want, kind, value, err)
+		}
+	}
+}
+
+func TestCovers(t *testing.T) {
+	wildcard := &ent.Entitlement{Kind: entitlement.KindWildcard, Value: "*.kube.cs.hm.edu"}
+	network := &ent.Entitlement{Kind: entitlement.KindIP, Value: "2001:db8::/64"}
+	tc := []struct {
+		e       *ent.Entitlement
+		name    string
+		covered bool
+	}{
+		{e: wildcard, name: "*.KUBE.cs.hm.edu", covered: true},
+		{e: wildcard, name: "*.foo.kube.cs.hm.edu"},
+		{e: wildcard, name: "kube.cs.hm.edu"},
+		{e: wildcard, name: "www.kube.cs.hm.edu"},
+		{e: network, name: "2001:0db8:0000:0000:0000:0000:0000:0001", covered: true},
+		{e: network, name: "[2001:db8::ff]", covered: true},
+		{e: network, name: "2001:db8:0:1::1"},
+		{e: network, name: "*.kube.cs.hm.edu"},
+		{e: wildcard, name: "10.0.0.1"},
+	}
+	for _, c := range tc {
+		if covered := Covers(c.e, c.name); covered != c.covered {
+			t.Errorf("%s/%s: expected %v, got %v", c.e.Value, c.name, c.covered, covered)
+		}
+	}
+}
+
+func TestParseOwner(t *testing.T) {
+	for value, want := range map[string]string{
+		" Max.Mustermann@HM.edu ": "max.mustermann@hm.edu",
+		"max@hm.edu":              "max@hm.edu",
+		"max":                     "",
+		"":                        "",
+		"Max <max@hm.edu>":        "",
+		"max@hm.edu, john@hm.edu": "",
+	} {
+		owner, err := parseOwner(value)
+		if want == "" {
+			if !errors.Is(err, ErrInvalidEntitlement) {
+				t.Errorf("%q: expected invalid owner, got %s %v", value, owner, err)
+			}
+			continue
+		}
+		if err != nil || owner != want {
+			t.Errorf("%q: expected %s, got %s (%v)", value, want, owner, err)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package store
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hm-edu/domain-rest-interface/ent"
+	"github.com/hm-edu/domain-rest-interface/ent/delegation"
+	"github.com/hm-edu/domain-rest-interface/ent/domain"
+	"github.com/hm-edu/domain-rest-interface/ent/mailbox"
+	"github.com/hm-edu/domain-rest-interface/ent/mailboxdelegation"
+	"github.com/hm-edu/domain-rest-interface/pkg/database"
+)
+
+// ListMailboxes returns all functional mailboxes that are owned or delegated
+// to one user.
+func (s *DomainStore) ListMailboxes(ctx context.Context, user string, admin bool) ([]*ent.Mailbox, error) {
+	if err := database.DB.Internal.Ping(); err != nil {
+		return nil, fmt.Errorf("pinging the database: %w", err)
+	}
+	q := s.db.Mailbox.Query().WithDelegations()
+	if !admin {
+		q = q.Where(mailbox.Or(mailbox.HasDelegationsWith(mailboxdelegation.UserEqualFold(user)), mailbox.OwnerEqualFold(user)))
+	}
+	return q.Order(ent.Asc(mailbox.FieldEmail)).All(ctx)
+}
+
+// ListAllMailboxes returns all functional mailboxes.
+func (s *DomainStore) ListAllMailboxes(ctx context.Context) ([]*ent.Mailbox, error) {
+	if err := database.DB.Internal.Ping(); err != nil {
+		return nil, fmt.Errorf("pinging the database: %w", err)
+	}
+	return s.db.Mailbox.Query().WithDelegations().Order(ent.Asc(mailbox.FieldEmail)).All(ctx)
+}
+
+// GetMailboxByID tries to find a functional mailbox with the given ID.
+func (s *DomainStore) GetMailboxByID(ctx context.Context, id int) (*ent.Mailbox, error) {
+	if err := database.DB.Internal.Ping(); err != nil {
+		return nil, fmt.Errorf("pinging the database: %w", err)
+	}
+	return s.db.Mailbox.Query().Where(mailbox.ID(id)).WithDelegations().First(ctx)
+}
+
+// CreateMailbox tries to create a new functional mailbox.
+func (s *DomainStore) CreateMailbox(ctx context.Context, email, owner string) (*ent.Mailbox, error) {
+	if err := database.DB.Internal.Ping(); err != nil {
+		return nil, fmt.Errorf("pinging the database: %w", err)
+	}
+	return s.db.Mailbox.Create().SetEmail(email).SetOwner(owner).Save(ctx)
+}
+
+// IsUserAddress reports whether the address is used by a personal account,
+// i.e. it owns or is delegated to a domain or functional mailbox.
+func (s *DomainStore) IsUserAddress(ctx context.Context, email string) (bool, error) {
+	if err := database.DB.Internal.Ping(); err != nil {
+		return false, fmt.Errorf("pinging the database: %w", err)
+	}
+	checks := []func() (bool, error){
+		func() (bool, error) { return s.db.Domain.Query().Where(domain.OwnerEqualFold(email)).Exist(ctx) },
+		func() (bool, error) { return s.db.Delegation.Query().Where(delegation.UserEqualFold(email)).Exist(ctx) },
+		func() (bool, error) { return s.db.Mailbox.Query().Where(mailbox.OwnerEqualFold(email)).Exist(ctx) },
+		func() (bool, error) {
+			return s.db.MailboxDelegation.Query().Where(mailboxdelegation.UserEqualFold(email)).Exist(ctx)
+		},
+	}
+	for _, check := range checks {
+		if exists, err := check(); err != nil || exists {
+			return exists, err
+		}
+	}
+	return false, nil
+}
+
+// MailboxOwner sets the owner of a functional mailbox.
+func (s *DomainStore) MailboxOwner(ctx context.Context, id int, owner string) (*ent.Mailbox, error) {
+	if err := database.DB.Internal.Ping(); err != nil {
+		return nil, fmt.Errorf("pinging the database: %w", err)
+	}
+	if err := s.db.Mailbox.UpdateOneID(id).SetOwner(owner).Exec(ctx); err != nil {
+		return nil, err
+	}
+	return s.db.Mailbox.Query().Where(mailbox.ID(id)).WithDelegations().First(ctx)
+}
+
+// AddMailboxDelegation adds a delegation to a functional mailbox.
+func (s *DomainStore) AddMailboxDelegation(ctx context.Context, id int, user string) (*ent.Mailbox, error) {
+	if err := database.DB.Internal.Ping(); err != nil {
+		return nil, fmt.Errorf("pinging the database: %w", err)
+	}
+	if err := s.db.MailboxDelegation.Create().SetMailboxID(id).SetUser(user).Exec(ctx); err != nil {
+		return nil, err
+	}
+	return s.db.Mailbox.Query().Where(mailbox.ID(id)).WithDelegations().First(ctx)
+}
+
+// DeleteMailboxDelegation tries to delete a delegation of a functional
+// mailbox.
+func (s *DomainStore) DeleteMailboxDelegation(ctx context.Context, id, delegationID int) (*ent.Mailbox, error) {
+	if err := database.DB.Internal.Ping(); err != nil {
+		return nil, fmt.Errorf("pinging the database: %w", err)
+	}
+	_, err := s.db.MailboxDelegation.Delete().
+		Where(mailboxdelegation.ID(delegationID), mailboxdelegation.HasMailboxWith(mailbox.ID(id))).
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.db.Mailbox.Query().Where(mailbox.ID(id)).WithDelegations().First(ctx)
+}
+
+// DeleteMailbox tries to delete a functional mailbox.
+func (s *DomainStore) DeleteMailbox(ctx context.Context, id int) error {
+	if err := database.DB.Internal.Ping(); err != nil {
+		return fmt.Errorf("pinging the database: %w", err)
+	}
+	return s.db.Mailbox.DeleteOneID(id).Exec(ctx)
+}
@@ -0,0 +1,174 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hm-edu/domain-rest-interface/ent"
+	"github.com/hm-edu/domain-rest-interface/ent/approvalrequest"
+	"github.com/hm-edu/domain-rest-interface/ent/domain"
+	"github.com/hm-edu/domain-rest-interface/pkg/database"
+	"go.uber.org/zap"
+)
+
+// DefaultApprovalExpiry is the time after which pending approval requests
+// expire by default.
+const DefaultApprovalExpiry = 30 * 24 * time.Hour
+
+func (s *DomainStore) approvalExpiry() time.Duration {
+	if s.ApprovalExpiry <= 0 {
+		return DefaultApprovalExpiry
+	}
+	return s.ApprovalExpiry
+}
+
+// RequestApproval creates a pending approval request for a domain.
+func (s *DomainStore) RequestApproval(ctx context.Context, d *ent.Domain, justification string) (*ent.ApprovalRequest, error) {
+	if err := database.DB.Internal.Ping(); err != nil {
+		return nil, fmt.Errorf("pinging the database: %w", err)
+	}
+	return s.db.ApprovalRequest.Create().
+		SetDomain(d).
+		SetFqdn(d.Fqdn).
+		SetRequester(d.Owner).
+		SetJustification(justification).
+		SetExpiresAt(time.Now().Add(s.approvalExpiry())).
+		Save(ctx)
+}
+
+// approvalExpiryInterval is the interval in which pending approval requests
+// are checked for their expiry.
+const approvalExpiryInterval = time.Hour
+
+// withTx runs fn in a transaction, which is rolled back if fn fails.
+func (s *DomainStore) withTx(ctx context.Context, fn func(db *ent.Client) error) error {
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx.Client()); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			return fmt.Errorf("%w: rolling back the transaction: %v", err, rerr)
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+// ExpireRequests marks the pending approval requests whose expiry passed as
+// expired and deletes their domains unless they were approved in the
+// meantime. The expired requests are returned.
+func (s *DomainStore) ExpireRequests(ctx context.Context) ([]*ent.ApprovalRequest, error) {
+	if err := database.DB.Internal.Ping(); err != nil {
+		return nil, fmt.Errorf("pinging the database: %w", err)
+	}
+	var expired []*ent.ApprovalRequest
+	err := s.withTx(ctx, func(db *ent.Client) error {
+		var err error
+		expired, err = db.ApprovalRequest.Query().
+			Where(approvalrequest.StatusEQ(approvalrequest.StatusPending), approvalrequest.ExpiresAtLT(time.Now())).
+			WithDomain().
+			All(ctx)
+		if err != nil || len(expired) == 0 {
+			return err
+		}
+		var ids, domains []int
+		for _, r := range expired {
+			ids = append(ids, r.ID)
+			if r.Edges.Domain != nil && !r.Edges.Domain.Approved {
+				domains = append(domains, r.Edges.Domain.ID)
+			}
+		}
+		err = db.ApprovalRequest.Update().
+			Where(approvalrequest.IDIn(ids...), approvalrequest.StatusEQ(approvalrequest.StatusPending)).
+			SetStatus(approvalrequest.StatusExpired).
+			SetDecidedAt(time.Now()).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		if len(domains) > 0 {
+			_, err = db.Domain.Delete().Where(domain.IDIn(domains...), domain.Approved(false)).Exec(ctx)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return expired, nil
+}
+
+// RunExpiry expires the pending approval requests whose expiry passed right
+// away and then periodically until stopCh is closed.
+func (s *DomainStore) RunExpiry(logger *zap.Logger, stopCh <-chan struct{}) {
+	ticker := time.NewTicker(approvalExpiryInterval)
+	defer ticker.Stop()
+	for {
+		expired, err := s.ExpireRequests(context.Background())
+		if err != nil {
+			logger.Error("Expiring approval requests failed", zap.Error(err))
+		}
+		for _, r := range expired {
+			logger.Info("Approval request expired", zap.Int("request", r.ID), zap.String("fqdn", r.Fqdn), zap.String("requester", r.Requester))
+		}
+		select {
+		case <-stopCh:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// PendingRequests returns the pending approval requests including their
+// domains.
+func (s *DomainStore) PendingRequests(ctx context.Context) ([]*ent.ApprovalRequest, error) {
+	if err := database.DB.Internal.Ping(); err != nil {
+		return nil, fmt.Errorf("pinging the database: %w", err)
+	}
+	return s.db.ApprovalRequest.Query().
+		Where(approvalrequest.StatusEQ(approvalrequest.StatusPending), approvalrequest.HasDomain()).
+		WithDomain().
+		Order(ent.Asc(approvalrequest.FieldCreateTime)).
+		All(ctx)
+}
+
+// ListRequests returns the approval requests of a user, the most recent
+// first.
+func (s *DomainStore) ListRequests(ctx context.Context, user string) ([]*ent.ApprovalRequest, error) {
+	if err := database.DB.Internal.Ping(); err != nil {
+		return nil, fmt.Errorf("pinging the database: %w", err)
+	}
+	return s.db.ApprovalRequest.Query().
+		Where(approvalrequest.Requester(user)).
+		WithDomain().
+		Order(ent.Desc(approvalrequest.FieldCreateTime), ent.Desc(approvalrequest.FieldID)).
+		All(ctx)
+}
+
+// decide records the decision on the pending approval requests of a domain.
+func decide(ctx context.Context, db *ent.Client, id int, status approvalrequest.Status, reviewer, comment string) error {
+	update := db.ApprovalRequest.Update().
+		Where(approvalrequest.StatusEQ(approvalrequest.StatusPending), approvalrequest.HasDomainWith(domain.ID(id))).
+		SetStatus(status).
+		SetReviewer(reviewer).
+		SetDecidedAt(time.Now())
+	if comment != "" {
+		update.SetComment(comment)
+	}
+	return update.Exec(ctx)
+}
+
+// Reject rejects the pending approval requests of a domain and deletes the
+// domain.
+func (s *DomainStore) Reject(ctx context.Context, id int, reviewer, comment string) error {
+	if err := database.DB.Internal.Ping(); err != nil {
+		return fmt.Errorf("pinging the database: %w", err)
+	}
+	return s.withTx(ctx, func(db *ent.Client) error {
+		if err := decide(ctx, db, id, approvalrequest.StatusRejected, reviewer, comment); err != nil {
+			return err
+		}
+		return db.Domain.DeleteOneID(id).Exec(ctx)
+	})
+}
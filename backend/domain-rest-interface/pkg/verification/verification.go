@@ -0,0 +1,77 @@
+// Package verification proves the ownership of domains using a TXT record
+// containing a random token.
+package verification
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"errors"
+	"net"
+	"strings"
+	"time"
+)
+
+// RecordPrefix is the label of the TXT record containing the token.
+const RecordPrefix = "_pki-portal-verify"
+
+// Resolver looks up TXT records. It is implemented by *net.Resolver.
+type Resolver interface {
+	LookupTXT(ctx context.Context, name string) ([]string, error)
+}
+
+// Verifier checks the verification records of domains.
+type Verifier struct {
+	Resolver Resolver
+	// AutoApprove approves domains once their record was found. Otherwise
+	// they are only marked as verified for the approvers.
+	AutoApprove bool
+}
+
+// NewResolver returns a resolver querying the given DNS server (host:port).
+// The system resolver is used if the address is empty.
+func NewResolver(address string) *net.Resolver {
+	if address == "" {
+		return net.DefaultResolver
+	}
+	return &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+			d := net.Dialer{Timeout: 5 * time.Second}
+			return d.DialContext(ctx, network, address)
+		},
+	}
+}
+
+// RecordName returns the name of the TXT record for the domain.
+func RecordName(fqdn string) string {
+	return RecordPrefix + "." + strings.TrimSuffix(strings.ToLower(fqdn), ".")
+}
+
+// NewToken generates a random verification token.
+func NewToken() (string, error) {
+	buf := make([]byte, 20)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return strings.ToLower(base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(buf)), nil
+}
+
+// Check reports whether the verification record of the domain contains the
+// token. Missing records are no error.
+func (v *Verifier) Check(ctx context.Context, fqdn, token string) (bool, error) {
+	records, err := v.Resolver.LookupTXT(ctx, RecordName(fqdn))
+	if err != nil {
+		var dnsErr *net.DNSError
+		if errors.As(err, &dnsErr) && dnsErr.IsNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	for _, record := range records {
+		if strings.TrimSpace(record) == token {
+			return true, nil
+		}
+	}
+	return false, nil
+}
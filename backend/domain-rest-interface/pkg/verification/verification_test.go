@@ -0,0 +1,57 @@
+package verification
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+)
+
+// staticResolver answers TXT lookups from a static map.
+type staticResolver map[string][]string
+
+func (r staticResolver) LookupTXT(_ context.Context, name string) ([]string, error) {
+	if name == "_pki-portal-verify.broken.example.org" {
+		return nil, errors.New("timeout")
+	}
+	records, ok := r[name]
+	if !ok {
+		return nil, &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+	}
+	return records, nil
+}
+
+func TestCheck(t *testing.T) {
+	v := &Verifier{Resolver: staticResolver{
+		"_pki-portal-verify.www.example.org": {"v=spf1 -all", " token "},
+	}}
+	tc := []struct {
+		fqdn     string
+		token    string
+		verified bool
+		err      bool
+	}{
+		{fqdn: "www.example.org", token: "token", verified: true},
+		{fqdn: "WWW.example.org.", token: "token", verified: true},
+		{fqdn: "www.example.org", token: "other"},
+		{fqdn: "example.org", token: "token"},
+		{fqdn: "broken.example.org", token: "token", err: true},
+	}
+	for _, c := range tc {
+		verified, err := v.Check(context.Background(), c.fqdn, c.token)
+		if verified != c.verified || (err != nil) != c.err {
+			t.Errorf("%s/%s: expected %v (error %v), got %v (%v)", c.fqdn, c.token, c.verified, c.err, verified, err)
+		}
+	}
+}
+
+func TestNewToken(t *testing.T) {
+	a, err := NewToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ := NewToken()
+	if len(a) != 32 || a == b {
+		t.Errorf("unexpected tokens %q and %q", a, b)
+	}
+}
@@ -75,7 +75,12 @@ func init() {
 	runCmd.Flags().StringSlice("cors_allowed_origins", []string{}, "The allowed origin for CORS")
 	runCmd.Flags().String("smime_service", "", "The smime service to use")
 	runCmd.Flags().String("ssl_service", "", "The ssl service to use")
+	runCmd.Flags().String("notification_service", "", "The notification service managing the notification preferences (defaults to smime_service)")
 	runCmd.Flags().String("domain_service", "", "The domain service to use")
+	runCmd.Flags().String("ssl_group_claim", "groups", "The token claim containing the groups used to check the allowed certificate types")
 	runCmd.Flags().Bool("reject_students", false, "Reject students")
+	runCmd.Flags().String("smime_eligibility", "", "Path to the YAML file with the S/MIME eligibility rules (replaces reject_students)")
+	runCmd.Flags().Bool("verify_additional_emails", false, "Require additional S/MIME addresses to be confirmed by mail (sent by the pki-service notification channels)")
+	runCmd.Flags().Int("email_verification_days", 365, "Number of days a confirmed address stays valid")
 	runCmd.Flags().String("level", "info", "log level debug, info, warn, error, flat or panic")
 }
@@ -0,0 +1,114 @@
+package notifications
+
+import (
+	"net/http"
+
+	"github.com/getsentry/sentry-go"
+	sentryecho "github.com/getsentry/sentry-go/echo"
+	"github.com/hm-edu/pki-rest-interface/pkg/model"
+	pb "github.com/hm-edu/portal-apis"
+	"github.com/hm-edu/portal-common/auth"
+	"github.com/hm-edu/portal-common/logging"
+	commonModel "github.com/hm-edu/portal-common/model"
+	"github.com/labstack/echo/v5"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Handler is a wrapper around the notification service and a validator.
+type Handler struct {
+	validator     *commonModel.Validator
+	notifications pb.NotificationServiceClient
+}
+
+// NewHandler generates a new handler for the notification preferences.
+func NewHandler(notifications pb.NotificationServiceClient) *Handler {
+	return &Handler{
+		validator:     commonModel.NewValidator(),
+		notifications: notifications,
+	}
+}
+
+func preferencesError(logger *zap.Logger, hub *sentry.Hub, err error) error {
+	if status.Code(err) == codes.InvalidArgument {
+		return &echo.HTTPError{Code: http.StatusBadRequest, Message: status.Convert(err).Message()}
+	}
+	hub.CaptureException(err)
+	logger.Error("error processing notification preferences", zap.Error(err))
+	return echo.NewHTTPError(http.StatusInternalServerError, "Error processing the request").Wrap(err)
+}
+
+// Get godoc
+// @Summary Notification Preferences Endpoint
+// @Description Returns the notification preferences of the user for all events and the channels the user can choose from. Events without preference are delivered on all channels handling them.
+// @Tags Notifications
+// @Produce json
+// @Router /notifications/preferences [get]
+// @Security API
+// @Success 200 {object} pb.NotificationPreferences "preferences"
+// @Response default {object} echo.HTTPError "Error processing the request"
+func (h *Handler) Get(c *echo.Context) error {
+	logger := c.Request().Context().Value(logging.LoggingContextKey).(*zap.Logger)
+	hub := sentryecho.GetHubFromContext(c)
+	if hub == nil {
+		hub = sentry.CurrentHub().Clone()
+	}
+	user, err := auth.UserFromRequest(c)
+	if err != nil {
+		return &echo.HTTPError{Code: http.StatusUnauthorized, Message: "Unauthorized"}
+	}
+	span := sentryecho.GetSpanFromContext(c)
+	ctx := c.Request().Context()
+	if span != nil {
+		ctx = span.Context()
+	}
+	preferences, err := h.notifications.GetNotificationPreferences(ctx, &pb.GetNotificationPreferencesRequest{User: user})
+	if err != nil {
+		return preferencesError(logger, hub, err)
+	}
+	return c.JSON(http.StatusOK, preferences)
+}
+
+// Update godoc
+// @Summary Notification Preferences Update Endpoint
+// @Description Updates the notification preferences of the user. Events not contained keep their preference.
+// @Tags Notifications
+// @Accept json
+// @Produce json
+// @Router /notifications/preferences [post]
+// @Param request body model.NotificationPreferencesRequest true "The preferences"
+// @Security API
+// @Success 200 {object} pb.NotificationPreferences "preferences"
+// @Response default {object} echo.HTTPError "Error processing the request"
+func (h *Handler) Update(c *echo.Context) error {
+	logger := c.Request().Context().Value(logging.LoggingContextKey).(*zap.Logger)
+	hub := sentryecho.GetHubFromContext(c)
+	if hub == nil {
+		hub = sentry.CurrentHub().Clone()
+	}
+	user, err := auth.UserFromRequest(c)
+	if err != nil {
+		return &echo.HTTPError{Code: http.StatusUnauthorized, Message: "Unauthorized"}
+	}
+	req := &model.NotificationPreferencesRequest{}
+	if err := req.Bind(c, h.validator); err != nil {
+		hub.CaptureException(err)
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request").Wrap(err)
+	}
+	span := sentryecho.GetSpanFromContext(c)
+	ctx := c.Request().Context()
+	if span != nil {
+		ctx = span.Context()
+	}
+	update := &pb.UpdateNotificationPreferencesRequest{User: user}
+	for _, p := range req.Preferences {
+		update.Preferences = append(update.Preferences, &pb.NotificationPreference{Event: p.Event, Enabled: p.Enabled, Channels: p.Channels})
+	}
+	logger.Info("updating notification preferences", zap.Int("preferences", len(update.Preferences)))
+	preferences, err := h.notifications.UpdateNotificationPreferences(ctx, update)
+	if err != nil {
+		return preferencesError(logger, hub, err)
+	}
+	return c.JSON(http.StatusOK, preferences)
+}
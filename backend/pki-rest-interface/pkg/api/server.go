@@ -16,9 +16,13 @@ import (
 	"github.com/labstack/echo/v5/middleware"
 	"github.com/labstack/gommon/log"
 
+	"github.com/hm-edu/pki-rest-interface/pkg/api/notifications"
 	"github.com/hm-edu/pki-rest-interface/pkg/api/smime"
 	"github.com/hm-edu/pki-rest-interface/pkg/api/ssl"
+	"github.com/hm-edu/pki-rest-interface/pkg/api/terms"
+	"github.com/hm-edu/pki-rest-interface/pkg/availability"
 	"github.com/hm-edu/pki-rest-interface/pkg/cfg"
+	"github.com/hm-edu/pki-rest-interface/pkg/eligibility"
 	pb "github.com/hm-edu/portal-apis"
 	"github.com/hm-edu/portal-common/api"
 	commonAuth "github.com/hm-edu/portal-common/auth"
@@ -116,38 +120,90 @@ func (server *Server) wireRoutesAndMiddleware() {
 	server.app.GET("/readyz", server.readyzHandler)
 	server.app.GET("/whoami", server.whoamiHandler, jwtMiddleware)
 
+	domainClient, err := domainClient(server.handlerCfg.DomainService, server.config.SentryDSN)
+	if err != nil {
+		server.logger.Fatal("failed to create domain client", zap.Error(err))
+	}
+
+	smimeClient, smimeAvailability, err := smimeClient(server.handlerCfg.SmimeService, server.config.SentryDSN)
+	if err != nil {
+		server.logger.Fatal("failed to create smime client", zap.Error(err))
+	}
+
 	group := server.app.Group("/ssl")
 	{
-		domainClient, err := domainClient(server.handlerCfg.DomainService, server.config.SentryDSN)
-		if err != nil {
-			server.logger.Fatal("failed to create domain client", zap.Error(err))
-		}
-
-		sslClient, err := sslClient(server.handlerCfg.SslService, server.config.SentryDSN)
+		sslClient, sslAvailability, err := sslClient(server.handlerCfg.SslService, server.config.SentryDSN)
 		if err != nil {
 			server.logger.Fatal("failed to create ssl client", zap.Error(err))
 		}
-		ssl := ssl.NewHandler(domainClient, sslClient)
+		ssl := ssl.NewHandler(domainClient, sslClient, sslAvailability, server.handlerCfg.SslGroupClaim)
 		group.Use(jwtMiddleware)
 		group.Use(commonAuth.HasScope("Certificates"))
 		group.GET("/", ssl.List)
 		group.GET("/active", ssl.Active)
+		group.GET("/status", ssl.Status)
 		group.POST("/revoke", ssl.Revoke)
 		group.POST("/csr", ssl.HandleCsr)
 	}
 
 	group = server.app.Group("/smime")
 	{
-		smimeClient, err := smimeClient(server.handlerCfg.SmimeService, server.config.SentryDSN)
-		if err != nil {
-			server.logger.Fatal("failed to create smime client", zap.Error(err))
+		var verification *smime.Verification
+		if server.handlerCfg.VerifyAdditionalEmails {
+			verification = &smime.Verification{Days: server.handlerCfg.EmailVerificationDays}
 		}
-		handler := smime.NewHandler(smimeClient, server.handlerCfg.RejectStudents)
+		var policy *eligibility.Policy
+		if server.handlerCfg.SmimeEligibility != "" {
+			policy, err = eligibility.Load(server.handlerCfg.SmimeEligibility)
+			if err != nil {
+				server.logger.Fatal("failed to load smime eligibility", zap.Error(err))
+			}
+		} else if server.handlerCfg.RejectStudents {
+			policy = eligibility.RejectStudents()
+		}
+		handler := smime.NewHandler(smimeClient, domainClient, policy, smimeAvailability, verification)
 		group.Use(jwtMiddleware)
 		group.Use(commonAuth.HasScope("Certificates"))
 		group.GET("/", handler.List)
+		group.GET("/status", handler.Status)
 		group.POST("/revoke", handler.Revoke)
 		group.POST("/csr", handler.HandleCsr)
+		group.POST("/generate", handler.HandleGenerate)
+		group.POST("/preview", handler.Preview)
+		group.GET("/emails", handler.ListEmails)
+		group.POST("/emails/verify", handler.StartEmailVerification)
+		group.POST("/emails/confirm", handler.ConfirmEmailVerification)
+		group.GET("/recovery", handler.ListRecoveries)
+		group.POST("/recovery", handler.RequestRecovery)
+		group.POST("/recovery/:id/approve", handler.ApproveRecovery)
+		group.POST("/recovery/:id/release", handler.ReleaseRecovery)
+		group.GET("/:serial", handler.Download)
+	}
+
+	group = server.app.Group("/terms")
+	{
+		handler := terms.NewHandler(smimeClient)
+		group.Use(jwtMiddleware)
+		group.Use(commonAuth.HasScope("Certificates"))
+		group.GET("", handler.Get)
+		group.POST("/accept", handler.Accept)
+	}
+
+	group = server.app.Group("/notifications")
+	{
+		host := server.handlerCfg.NotificationService
+		if host == "" {
+			host = server.handlerCfg.SmimeService
+		}
+		notificationClient, err := notificationClient(host, server.config.SentryDSN)
+		if err != nil {
+			server.logger.Fatal("failed to create notification client", zap.Error(err))
+		}
+		handler := notifications.NewHandler(notificationClient)
+		group.Use(jwtMiddleware)
+		group.Use(commonAuth.HasScope("Certificates"))
+		group.GET("/preferences", handler.Get)
+		group.POST("/preferences", handler.Update)
 	}
 	ready = 1
 	healthy = 1
@@ -165,7 +221,7 @@ func domainClient(host string, sentryDSN string) (pb.DomainServiceClient, error)
 	return pb.NewDomainServiceClient(conn), nil
 }
 
-func smimeClient(host string, sentryDSN string) (pb.SmimeServiceClient, error) {
+func notificationClient(host string, sentryDSN string) (pb.NotificationServiceClient, error) {
 	var interceptor []grpc.UnaryClientInterceptor
 	if sentryDSN != "" {
 		interceptor = append(interceptor, commonInterceptor.UnaryClientInterceptor())
@@ -174,19 +230,31 @@ func smimeClient(host string, sentryDSN string) (pb.SmimeServiceClient, error) {
 	if err != nil {
 		return nil, err
 	}
-	return pb.NewSmimeServiceClient(conn), nil
+	return pb.NewNotificationServiceClient(conn), nil
 }
 
-func sslClient(host string, sentryDSN string) (pb.SSLServiceClient, error) {
+func smimeClient(host string, sentryDSN string) (pb.SmimeServiceClient, *availability.Checker, error) {
 	var interceptor []grpc.UnaryClientInterceptor
 	if sentryDSN != "" {
 		interceptor = append(interceptor, commonInterceptor.UnaryClientInterceptor())
 	}
 	conn, err := api.ConnectGRPC(host, grpc.WithChainUnaryInterceptor(interceptor...))
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+	return pb.NewSmimeServiceClient(conn), availability.NewChecker(conn, pb.SmimeService_ServiceDesc.ServiceName), nil
+}
+
+func sslClient(host string, sentryDSN string) (pb.SSLServiceClient, *availability.Checker, error) {
+	var interceptor []grpc.UnaryClientInterceptor
+	if sentryDSN != "" {
+		interceptor = append(interceptor, commonInterceptor.UnaryClientInterceptor())
+	}
+	conn, err := api.ConnectGRPC(host, grpc.WithChainUnaryInterceptor(interceptor...))
+	if err != nil {
+		return nil, nil, err
 	}
-	return pb.NewSSLServiceClient(conn), nil
+	return pb.NewSSLServiceClient(conn), availability.NewChecker(conn, pb.SSLService_ServiceDesc.ServiceName), nil
 }
 
 // ListenAndServe starts the http server and waits for the channel to stop the server
@@ -0,0 +1,109 @@
+package smime
+
+import (
+	"encoding/pem"
+	"fmt"
+	"net/http"
+
+	"github.com/getsentry/sentry-go"
+	sentryecho "github.com/getsentry/sentry-go/echo"
+	"github.com/hm-edu/pki-rest-interface/pkg/model"
+	pb "github.com/hm-edu/portal-apis"
+	"github.com/hm-edu/portal-common/logging"
+	commonModel "github.com/hm-edu/portal-common/model"
+	"github.com/labstack/echo/v5"
+	"github.com/smallstep/pkcs7"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Download godoc
+// @Summary SMIME Download Endpoint
+// @Description Downloads an issued certificate of the user. PEM contains the certificate and its chain, DER only the certificate and PKCS#7 (p7b) the certificate and its chain.
+// @Tags SMIME
+// @Produce application/x-pem-file,application/pkix-cert,application/x-pkcs7-certificates
+// @Router /smime/{serial} [get]
+// @Param serial path string true "The serial of the certificate"
+// @Param request query model.SmimeDownloadRequest false "The format"
+// @Security API
+// @Success 200 {file} file "certificate"
+// @Response default {object} echo.HTTPError "Error processing the request"
+func (h *Handler) Download(c *echo.Context) error {
+	logger := c.Request().Context().Value(logging.LoggingContextKey).(*zap.Logger)
+	hub := sentryecho.GetHubFromContext(c)
+	if hub == nil {
+		hub = sentry.CurrentHub().Clone()
+	}
+	user := commonModel.User{}
+	if err := user.Bind(c, h.validator); err != nil {
+		hub.CaptureException(err)
+		return err
+	}
+	hub.ConfigureScope(func(scope *sentry.Scope) {
+		scope.SetUser(sentry.User{Email: user.Email})
+	})
+
+	req := &model.SmimeDownloadRequest{}
+	if err := req.Bind(c, h.validator); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request").Wrap(err)
+	}
+
+	span := sentryecho.GetSpanFromContext(c)
+	ctx := c.Request().Context()
+	if span != nil {
+		ctx = span.Context()
+	}
+
+	serial := c.Param("serial")
+	logger = logger.With(zap.String("serial", serial))
+	cert, err := h.smime.GetSmimeCertificate(ctx, &pb.GetSmimeCertificateRequest{Serial: serial})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return &echo.HTTPError{Code: http.StatusNotFound, Message: status.Convert(err).Message()}
+		}
+		hub.CaptureException(err)
+		logger.Error("error fetching smime certificate", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Error processing the request").Wrap(err)
+	}
+	// Certificates of other users and of addresses that are no longer
+	// verified are reported as missing.
+	if _, err := h.authorizeEmail(ctx, logger, hub, &user, cert.Email); err != nil {
+		if err != errEmailForbidden && err != errEmailUnverified {
+			return err
+		}
+		logger.Warn("smime certificate of an unauthorized address requested", zap.Error(err))
+		return &echo.HTTPError{Code: http.StatusNotFound, Message: "Certificate not found"}
+	}
+
+	var der []byte
+	var chain []byte
+	for block, rest := pem.Decode([]byte(cert.Certificate + cert.Chain)); block != nil; block, rest = pem.Decode(rest) {
+		if der == nil {
+			der = block.Bytes
+		}
+		chain = append(chain, block.Bytes...)
+	}
+	if der == nil {
+		logger.Error("invalid smime certificate")
+		return &echo.HTTPError{Code: http.StatusInternalServerError, Message: "Invalid certificate"}
+	}
+
+	switch req.Format {
+	case "der":
+		c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", serial+".cer"))
+		return c.Blob(http.StatusOK, "application/pkix-cert", der)
+	case "p7b":
+		p7, err := pkcs7.DegenerateCertificate(chain)
+		if err != nil {
+			hub.CaptureException(err)
+			logger.Error("error encoding pkcs7", zap.Error(err))
+			return echo.NewHTTPError(http.StatusInternalServerError, "Error processing the request").Wrap(err)
+		}
+		c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", serial+".p7b"))
+		return c.Blob(http.StatusOK, "application/x-pkcs7-certificates", p7)
+	default:
+		c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", serial+".pem"))
+		return c.Blob(http.StatusOK, "application/x-pem-file", []byte(cert.Certificate+cert.Chain))
+	}
+}
@@ -0,0 +1,29 @@
+package smime
+
+import (
+	"net/http"
+
+	"github.com/hm-edu/pki-rest-interface/pkg/eligibility"
+	commonModel "github.com/hm-edu/portal-common/model"
+	"github.com/labstack/echo/v5"
+	"go.uber.org/zap"
+)
+
+// checkEligibility evaluates the eligibility policy for a certificate for the
+// given address. The matched rule is logged for every decision.
+func (h *Handler) checkEligibility(logger *zap.Logger, user *commonModel.User, email string) (eligibility.Decision, error) {
+	decision := eligibility.Decision{Rule: eligibility.DefaultRule, Allowed: true}
+	if h.policy != nil {
+		decision = h.policy.Evaluate(user, email)
+	}
+	logger.Info("smime eligibility",
+		zap.String("email", email),
+		zap.String("rule", decision.Rule),
+		zap.Bool("allowed", decision.Allowed),
+		zap.Strings("cert_types", decision.CertTypes),
+		zap.Int("max_validity_days", decision.MaxValidityDays))
+	if !decision.Allowed {
+		return decision, &echo.HTTPError{Code: http.StatusForbidden, Message: "You are not allowed to request smime certificates"}
+	}
+	return decision, nil
+}
@@ -1,23 +1,32 @@
 package smime
 
 import (
+	"github.com/hm-edu/pki-rest-interface/pkg/availability"
+	"github.com/hm-edu/pki-rest-interface/pkg/eligibility"
 	pb "github.com/hm-edu/portal-apis"
 	"github.com/hm-edu/portal-common/model"
 )
 
 // Handler is a wrapper around the domainstore and a validator.
 type Handler struct {
-	validator      *model.Validator
-	smime          pb.SmimeServiceClient
-	rejectStudents bool
+	validator    *model.Validator
+	smime        pb.SmimeServiceClient
+	domain       pb.DomainServiceClient
+	policy       *eligibility.Policy
+	available    *availability.Checker
+	verification *Verification
 }
 
-// NewHandler generates a new handler for acting on the domain storage.
-func NewHandler(smime pb.SmimeServiceClient, rejectStudents bool) *Handler {
+// NewHandler generates a new handler for acting on the domain storage. The
+// verification of additional addresses is disabled if verification is nil.
+func NewHandler(smime pb.SmimeServiceClient, domain pb.DomainServiceClient, policy *eligibility.Policy, available *availability.Checker, verification *Verification) *Handler {
 	v := model.NewValidator()
 	return &Handler{
-		validator:      v,
-		smime:          smime,
-		rejectStudents: rejectStudents,
+		validator:    v,
+		smime:        smime,
+		domain:       domain,
+		policy:       policy,
+		available:    available,
+		verification: verification,
 	}
 }
@@ -0,0 +1,64 @@
+package smime
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/getsentry/sentry-go"
+	pb "github.com/hm-edu/portal-apis"
+	"github.com/hm-edu/portal-common/helper"
+	commonModel "github.com/hm-edu/portal-common/model"
+	"github.com/labstack/echo/v5"
+	"go.uber.org/zap"
+)
+
+// errEmailForbidden is returned if the user may not use an address.
+var errEmailForbidden = &echo.HTTPError{Code: http.StatusForbidden, Message: "You are not authorized to use this email."}
+
+// mailboxes returns the functional mailboxes the user owns or is delegated
+// to.
+func (h *Handler) mailboxes(ctx context.Context, user *commonModel.User) ([]string, error) {
+	if h.domain == nil {
+		return nil, nil
+	}
+	resp, err := h.domain.ListMailboxes(ctx, &pb.ListMailboxesRequest{User: user.Email})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Emails, nil
+}
+
+// authorizeEmail checks whether the user may request, list and revoke
+// certificates for the given address. Besides their own addresses, users may
+// use the functional mailboxes they own or are delegated to. Additional
+// addresses must be verified first if the verification is enabled. The
+// returned flag reports whether the address is a functional mailbox.
+func (h *Handler) authorizeEmail(ctx context.Context, logger *zap.Logger, hub *sentry.Hub, user *commonModel.User, email string) (bool, error) {
+	matches := func(m string) bool { return strings.EqualFold(m, email) }
+	if matches(user.Email) {
+		return false, nil
+	}
+	if helper.Any(user.AdditionalSmimeEmails, matches) {
+		verified, err := h.verifiedEmails(ctx, user)
+		if err != nil {
+			hub.CaptureException(err)
+			logger.Error("error listing verified emails", zap.Error(err))
+			return false, echo.NewHTTPError(http.StatusInternalServerError, "Error processing the request").Wrap(err)
+		}
+		if !helper.Any(verified, matches) {
+			return false, errEmailUnverified
+		}
+		return false, nil
+	}
+	mailboxes, err := h.mailboxes(ctx, user)
+	if err != nil {
+		hub.CaptureException(err)
+		logger.Error("error listing functional mailboxes", zap.Error(err))
+		return false, echo.NewHTTPError(http.StatusInternalServerError, "Error processing the request").Wrap(err)
+	}
+	if helper.Any(mailboxes, matches) {
+		return true, nil
+	}
+	return false, errEmailForbidden
+}
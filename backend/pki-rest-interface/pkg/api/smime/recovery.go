@@ -0,0 +1,195 @@
+package smime
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/getsentry/sentry-go"
+	sentryecho "github.com/getsentry/sentry-go/echo"
+	"github.com/hm-edu/pki-rest-interface/pkg/model"
+	pb "github.com/hm-edu/portal-apis"
+	"github.com/hm-edu/portal-common/logging"
+	commonModel "github.com/hm-edu/portal-common/model"
+	"github.com/labstack/echo/v5"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// recoveryRequest extracts the user and the tracing context of a key
+// recovery request.
+func (h *Handler) recoveryRequest(c *echo.Context) (context.Context, *zap.Logger, *sentry.Hub, *commonModel.User, error) {
+	logger := c.Request().Context().Value(logging.LoggingContextKey).(*zap.Logger)
+	hub := sentryecho.GetHubFromContext(c)
+	if hub == nil {
+		hub = sentry.CurrentHub().Clone()
+	}
+	user := &commonModel.User{}
+	if err := user.Bind(c, h.validator); err != nil {
+		hub.CaptureException(err)
+		return nil, nil, nil, nil, err
+	}
+	hub.ConfigureScope(func(scope *sentry.Scope) {
+		scope.SetUser(sentry.User{Email: user.Email})
+	})
+	span := sentryecho.GetSpanFromContext(c)
+	ctx := c.Request().Context()
+	if span != nil {
+		ctx = span.Context()
+	}
+	return ctx, logger.With(zap.String("user", user.Email)), hub, user, nil
+}
+
+// recoveryError maps an error of the key recovery to the HTTP error returned
+// to the user.
+func recoveryError(logger *zap.Logger, hub *sentry.Hub, err error) error {
+	msg := status.Convert(err).Message()
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		return &echo.HTTPError{Code: http.StatusBadRequest, Message: "Invalid request. " + msg}
+	case codes.NotFound:
+		return &echo.HTTPError{Code: http.StatusNotFound, Message: msg}
+	case codes.PermissionDenied:
+		logger.Warn("key recovery denied", zap.Error(err))
+		return &echo.HTTPError{Code: http.StatusForbidden, Message: msg}
+	case codes.AlreadyExists, codes.FailedPrecondition:
+		return &echo.HTTPError{Code: http.StatusConflict, Message: msg}
+	case codes.Unimplemented:
+		return &echo.HTTPError{Code: http.StatusNotImplemented, Message: msg}
+	}
+	hub.CaptureException(err)
+	logger.Error("error processing key recovery", zap.Error(err))
+	return echo.NewHTTPError(http.StatusInternalServerError, "Error processing the request").Wrap(err)
+}
+
+func recoveryID(c *echo.Context) (int32, error) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 32)
+	if err != nil {
+		return 0, &echo.HTTPError{Code: http.StatusBadRequest, Message: "Invalid recovery ID"}
+	}
+	return int32(id), nil
+}
+
+// ListRecoveries godoc
+// @Summary SMIME Key Recovery List Endpoint
+// @Description Lists the key recoveries of the user. Recovery officers see all recoveries; in split mode the encrypted share of the officer is included while the recovery awaits their approval.
+// @Tags SMIME
+// @Produce json
+// @Router /smime/recovery [get]
+// @Security API
+// @Success 200 {object} []pb.KeyRecovery "recoveries"
+// @Response default {object} echo.HTTPError "Error processing the request"
+func (h *Handler) ListRecoveries(c *echo.Context) error {
+	ctx, logger, hub, user, err := h.recoveryRequest(c)
+	if err != nil {
+		return err
+	}
+	res, err := h.smime.ListKeyRecoveries(ctx, &pb.ListKeyRecoveriesRequest{User: user.Email})
+	if err != nil {
+		return recoveryError(logger, hub, err)
+	}
+	return c.JSON(http.StatusOK, res.Items)
+}
+
+// RequestRecovery godoc
+// @Summary SMIME Key Recovery Request Endpoint
+// @Description Requests the recovery of an escrowed key. The recovery must be approved by several recovery officers before the key can be released to the owner of the certificate.
+// @Tags SMIME
+// @Accept json
+// @Produce json
+// @Router /smime/recovery [post]
+// @Param request body model.KeyRecoveryRequest true "The serial of the certificate and the reason"
+// @Security API
+// @Success 201 {object} pb.KeyRecovery "recovery"
+// @Response default {object} echo.HTTPError "Error processing the request"
+func (h *Handler) RequestRecovery(c *echo.Context) error {
+	ctx, logger, hub, user, err := h.recoveryRequest(c)
+	if err != nil {
+		return err
+	}
+	req := &model.KeyRecoveryRequest{}
+	if err := req.Bind(c, h.validator); err != nil {
+		hub.CaptureException(err)
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request").Wrap(err)
+	}
+	logger.Info("requesting key recovery", zap.String("serial", req.Serial))
+	recovery, err := h.smime.RequestKeyRecovery(ctx, &pb.RequestKeyRecoveryRequest{Serial: req.Serial, RequestedBy: user.Email, Reason: req.Reason})
+	if err != nil {
+		return recoveryError(logger, hub, err)
+	}
+	return c.JSON(http.StatusCreated, recovery)
+}
+
+// ApproveRecovery godoc
+// @Summary SMIME Key Recovery Approval Endpoint
+// @Description Approves or rejects a key recovery. Only recovery officers that neither requested the recovery nor own the certificate can approve it.
+// @Tags SMIME
+// @Accept json
+// @Produce json
+// @Router /smime/recovery/{id}/approve [post]
+// @Param id path int true "Recovery ID"
+// @Param request body model.ApproveKeyRecoveryRequest true "The decision"
+// @Security API
+// @Success 200 {object} pb.KeyRecovery "recovery"
+// @Response default {object} echo.HTTPError "Error processing the request"
+func (h *Handler) ApproveRecovery(c *echo.Context) error {
+	ctx, logger, hub, user, err := h.recoveryRequest(c)
+	if err != nil {
+		return err
+	}
+	id, err := recoveryID(c)
+	if err != nil {
+		return err
+	}
+	req := &model.ApproveKeyRecoveryRequest{}
+	if err := req.Bind(c, h.validator); err != nil {
+		hub.CaptureException(err)
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request").Wrap(err)
+	}
+	recovery, err := h.smime.ApproveKeyRecovery(ctx, &pb.ApproveKeyRecoveryRequest{
+		Id:      id,
+		Officer: user.Email,
+		Share:   req.Share,
+		Reject:  req.Reject,
+		Comment: req.Comment,
+	})
+	if err != nil {
+		return recoveryError(logger, hub, err)
+	}
+	return c.JSON(http.StatusOK, recovery)
+}
+
+// ReleaseRecovery godoc
+// @Summary SMIME Key Recovery Release Endpoint
+// @Description Releases the key of an approved recovery to the owner of the certificate as password protected PKCS#12 bundle. If no password is passed, a random password is generated and returned once.
+// @Tags SMIME
+// @Accept json
+// @Produce json
+// @Router /smime/recovery/{id}/release [post]
+// @Param id path int true "Recovery ID"
+// @Param request body model.ReleaseKeyRecoveryRequest true "The request"
+// @Security API
+// @Success 200 {object} model.Pkcs12Response "PKCS#12 bundle"
+// @Response default {object} echo.HTTPError "Error processing the request"
+func (h *Handler) ReleaseRecovery(c *echo.Context) error {
+	ctx, logger, hub, user, err := h.recoveryRequest(c)
+	if err != nil {
+		return err
+	}
+	id, err := recoveryID(c)
+	if err != nil {
+		return err
+	}
+	req := &model.ReleaseKeyRecoveryRequest{}
+	if err := req.Bind(c, h.validator); err != nil {
+		hub.CaptureException(err)
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request").Wrap(err)
+	}
+	logger.Info("releasing recovered key", zap.Int32("recovery_id", id))
+	res, err := h.smime.ReleaseKeyRecovery(ctx, &pb.ReleaseKeyRecoveryRequest{Id: id, User: user.Email, Pkcs12Password: req.Password})
+	if err != nil {
+		return recoveryError(logger, hub, err)
+	}
+	return c.JSON(http.StatusOK, model.Pkcs12Response{Pkcs12: res.Pkcs12, Password: res.Pkcs12Password})
+}
@@ -1,6 +1,7 @@
 package smime
 
 import (
+	"context"
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"encoding/pem"
@@ -8,6 +9,8 @@ import (
 
 	"github.com/getsentry/sentry-go"
 	sentryecho "github.com/getsentry/sentry-go/echo"
+	"github.com/hm-edu/pki-rest-interface/pkg/api/terms"
+	"github.com/hm-edu/pki-rest-interface/pkg/availability"
 	"github.com/hm-edu/pki-rest-interface/pkg/model"
 	pb "github.com/hm-edu/portal-apis"
 	"github.com/hm-edu/portal-common/helper"
@@ -15,6 +18,8 @@ import (
 	commonModel "github.com/hm-edu/portal-common/model"
 	"github.com/labstack/echo/v5"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // List godoc
@@ -61,8 +66,8 @@ func (h *Handler) List(c *echo.Context) error {
 	}
 
 	// check if the requested email belongs to the user
-	if requestedEmail != user.Email && !helper.Contains(user.AdditionalSmimeEmails, requestedEmail) {
-		return &echo.HTTPError{Code: http.StatusForbidden, Message: "You are not authorized to use this email."}
+	if _, err := h.authorizeEmail(ctx, logger, hub, &user, requestedEmail); err != nil {
+		return err
 	}
 
 	logger.Debug("requesting smime certificates")
@@ -123,9 +128,21 @@ func (h *Handler) Revoke(c *echo.Context) error {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Error processing the request").Wrap(err)
 	}
 
+	mailboxes, err := h.mailboxes(ctx, &user)
+	if err != nil {
+		hub.CaptureException(err)
+		logger.Error("error listing functional mailboxes", zap.Error(err))
+	}
+
+	additional, err := h.verifiedEmails(ctx, &user)
+	if err != nil {
+		hub.CaptureException(err)
+		logger.Error("error listing verified emails", zap.Error(err))
+	}
+
 	// list certificates for all emails
 	certs := listRes.Certificates
-	for _, email := range user.AdditionalSmimeEmails {
+	for _, email := range append(additional, mailboxes...) {
 		listRes, err := h.smime.ListCertificates(ctx, &pb.ListSmimeRequest{Email: email})
 		if err != nil {
 			hub.CaptureException(err)
@@ -140,7 +157,7 @@ func (h *Handler) Revoke(c *echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request").Wrap(err)
 	}
 
-	_, err = h.smime.RevokeCertificate(ctx, &pb.RevokeSmimeRequest{Reason: "", Identifier: &pb.RevokeSmimeRequest_Serial{Serial: req.Serial}})
+	_, err = h.smime.RevokeCertificate(ctx, &pb.RevokeSmimeRequest{Reason: "", Identifier: &pb.RevokeSmimeRequest_Serial{Serial: req.Serial}, Actor: user.Email})
 	if err != nil {
 		hub.CaptureException(err)
 		logger.Error("error requesting smime certificate revocation", zap.Error(err))
@@ -151,9 +168,12 @@ func (h *Handler) Revoke(c *echo.Context) error {
 
 // HandleCsr godoc
 // @Summary SMIME CSR Endpoint
+// @Description Supported keys are RSA (2048, 3072 or 4096 bit) and ECDSA (P-256 or P-384) keys, depending on the server configuration. Unsupported keys are rejected with 400.
 // @Description This endpoint handles a provided CSR. The validity of the CSR is checked and passed to the harica server in combination with the basic user information extracted from the JWT.
 // @Description The server uses his own configuration, so the profile and the lifetime of the certificate can not be modified.
 // @Description Afterwards the new certificate is returned as X509 certificate.
+// @Description Subjects with transliterated names or without names (if the names cannot be represented) must be confirmed first (see /smime/preview); unconfirmed requests are rejected with 409.
+// @Description Owners and delegates of functional mailboxes (managed in the domain service) may request certificates for them. These certificates never contain the names of the user and are issued for the organization instead.
 // @Tags SMIME
 // @Accept json
 // @Produce json
@@ -184,11 +204,6 @@ func (h *Handler) HandleCsr(c *echo.Context) error {
 		ctx = span.Context()
 	}
 
-	if user.Student && h.rejectStudents {
-		logger.Warn("rejecting student", zap.String("email", user.Email))
-		return &echo.HTTPError{Code: http.StatusForbidden, Message: "Students are not allowed to request smime certificates"}
-	}
-
 	req := &model.CsrRequest{}
 	if err := req.Bind(c, h.validator); err != nil {
 		hub.CaptureException(err)
@@ -220,25 +235,223 @@ func (h *Handler) HandleCsr(c *echo.Context) error {
 	if len(emailSubjects) > 0 {
 		requestedEmail = emailSubjects[0].Value.(string)
 	}
-	if requestedEmail != user.Email && !helper.Contains(user.AdditionalSmimeEmails, requestedEmail) {
-		return &echo.HTTPError{Code: http.StatusForbidden, Message: "You are not authorized to use this email."}
+	issue, err := h.issueRequest(ctx, logger, hub, &user, requestedEmail)
+	if err != nil {
+		return err
+	}
+	issue.Csr = req.CSR
+	issue.ConfirmedSubject = req.ConfirmedSubject
+
+	logger.Info("Issuing new smime certificate", zap.Bool("functional_mailbox", issue.FunctionalMailbox))
+	cert, err := h.smime.IssueCertificate(ctx, issue)
+	if err != nil {
+		return issueError(logger, hub, err)
+	}
+
+	return c.JSON(http.StatusOK, cert.Certificate)
+}
+
+// issueRequest checks that the user may request a certificate for the given
+// address and that the smime service is available. The returned request
+// contains the data of the user and the limits of the eligibility policy.
+func (h *Handler) issueRequest(ctx context.Context, logger *zap.Logger, hub *sentry.Hub, user *commonModel.User, email string) (*pb.IssueSmimeRequest, error) {
+	functional, err := h.authorizeEmail(ctx, logger, hub, user, email)
+	if err != nil {
+		return nil, err
+	}
+	decision, err := h.checkEligibility(logger, user, email)
+	if err != nil {
+		return nil, err
+	}
+
+	if !h.available.Available(ctx) {
+		logger.Warn("smime service unavailable")
+		return nil, &echo.HTTPError{Code: http.StatusServiceUnavailable, Message: availability.Message}
+	}
+
+	return &pb.IssueSmimeRequest{
+		Email:             email,
+		FirstName:         user.FirstName,
+		LastName:          user.LastName,
+		MiddleName:        user.MiddleName,
+		CommonName:        user.CommonName,
+		Student:           user.Student,
+		PrimaryEmail:      user.Email,
+		FunctionalMailbox: functional,
+		AllowedCertTypes:  decision.CertTypes,
+		MaxValidityDays:   int32(decision.MaxValidityDays), // #nosec G115 -- the validity is configured by the operator
+	}, nil
+}
+
+// issueError maps an error of the smime service to the HTTP error returned
+// to the user.
+func issueError(logger *zap.Logger, hub *sentry.Hub, err error) error {
+	if availability.IsUnavailable(err) {
+		logger.Warn("smime service unavailable", zap.Error(err))
+		return &echo.HTTPError{Code: http.StatusServiceUnavailable, Message: availability.Message}
+	}
+	if termsErr := terms.NotAccepted(err); termsErr != nil {
+		logger.Warn("terms not accepted", zap.Error(err))
+		return termsErr
 	}
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		// e.g. an unsupported key type or size
+		logger.Warn("smime request rejected", zap.Error(err))
+		return &echo.HTTPError{Code: http.StatusBadRequest, Message: "Invalid request. " + status.Convert(err).Message()}
+	case codes.FailedPrecondition:
+		// e.g. a subject that must be confirmed first
+		logger.Warn("smime request not possible", zap.Error(err))
+		return &echo.HTTPError{Code: http.StatusConflict, Message: status.Convert(err).Message()}
+	case codes.PermissionDenied:
+		// e.g. a certificate type or validity not permitted by the policy
+		logger.Warn("smime request not permitted", zap.Error(err))
+		return &echo.HTTPError{Code: http.StatusForbidden, Message: status.Convert(err).Message()}
+	case codes.Unimplemented:
+		logger.Warn("smime request not supported", zap.Error(err))
+		return &echo.HTTPError{Code: http.StatusNotImplemented, Message: status.Convert(err).Message()}
+	}
+	hub.CaptureException(err)
+	logger.Error("error requesting smime certificate", zap.Error(err))
+	return echo.NewHTTPError(http.StatusInternalServerError, "Handling CSR failed").Wrap(err)
+}
 
-	logger.Info("Issuing new smime certificate")
-	cert, err := h.smime.IssueCertificate(ctx, &pb.IssueSmimeRequest{
-		Csr:        req.CSR,
-		Email:      requestedEmail,
-		FirstName:  user.FirstName,
-		LastName:   user.LastName,
-		MiddleName: user.MiddleName,
-		CommonName: user.CommonName,
-		Student:    user.Student,
+// HandleGenerate godoc
+// @Summary SMIME Key Generation Endpoint
+// @Description This endpoint requests a certificate for a key that is generated by the server, for users who cannot create a CSR themselves.
+// @Description The key, the certificate and its chain are returned as password protected PKCS#12 bundle. If no password is passed, a random password is generated and returned once.
+// @Description The key is not stored by the server unless key escrow is enabled; escrowed keys can be recovered using the /smime/recovery endpoints.
+// @Tags SMIME
+// @Accept json
+// @Produce json
+// @Router /smime/generate [post]
+// @Param request body model.SmimeGenerateRequest true "The request"
+// @Security API
+// @Success 200 {object} model.Pkcs12Response "PKCS#12 bundle"
+// @Response default {object} echo.HTTPError "Error processing the request"
+func (h *Handler) HandleGenerate(c *echo.Context) error {
+	logger := c.Request().Context().Value(logging.LoggingContextKey).(*zap.Logger)
+	hub := sentryecho.GetHubFromContext(c)
+	if hub == nil {
+		hub = sentry.CurrentHub().Clone()
+	}
+	user := commonModel.User{}
+	if err := user.Bind(c, h.validator); err != nil {
+		hub.CaptureException(err)
+		return err
+	}
+
+	hub.ConfigureScope(func(scope *sentry.Scope) {
+		scope.SetUser(sentry.User{Email: user.Email})
 	})
+
+	span := sentryecho.GetSpanFromContext(c)
+	ctx := c.Request().Context()
+	if span != nil {
+		ctx = span.Context()
+	}
+
+	req := &model.SmimeGenerateRequest{}
+	if err := req.Bind(c, h.validator); err != nil {
+		hub.CaptureException(err)
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request").Wrap(err)
+	}
+
+	requestedEmail := user.Email
+	if req.Email != "" {
+		requestedEmail = req.Email
+	}
+	issue, err := h.issueRequest(ctx, logger, hub, &user, requestedEmail)
 	if err != nil {
+		return err
+	}
+	issue.GenerateKey = true
+	issue.Pkcs12Password = req.Password
+	issue.ConfirmedSubject = req.ConfirmedSubject
+
+	logger.Info("Issuing new smime certificate with generated key", zap.Bool("functional_mailbox", issue.FunctionalMailbox))
+	cert, err := h.smime.IssueCertificate(ctx, issue)
+	if err != nil {
+		return issueError(logger, hub, err)
+	}
+
+	return c.JSON(http.StatusOK, model.Pkcs12Response{Pkcs12: cert.Pkcs12, Password: cert.Pkcs12Password})
+}
+
+// Preview godoc
+// @Summary SMIME Subject Preview Endpoint
+// @Description Returns the subject a certificate would be issued with. Names are validated against the character set accepted by HARICA and transliterated if required.
+// @Description If confirmation_required is set, the returned subject must be passed as confirmed_subject when requesting the certificate; otherwise the request is rejected with 409.
+// @Tags SMIME
+// @Accept json
+// @Produce json
+// @Router /smime/preview [post]
+// @Param request body model.SmimePreviewRequest true "The request"
+// @Security API
+// @Success 200 {object} pb.SmimeSubject "subject"
+// @Response default {object} echo.HTTPError "Error processing the request"
+func (h *Handler) Preview(c *echo.Context) error {
+	logger := c.Request().Context().Value(logging.LoggingContextKey).(*zap.Logger)
+	hub := sentryecho.GetHubFromContext(c)
+	if hub == nil {
+		hub = sentry.CurrentHub().Clone()
+	}
+	user := commonModel.User{}
+	if err := user.Bind(c, h.validator); err != nil {
 		hub.CaptureException(err)
-		logger.Error("error requesting smime certificate", zap.Error(err))
-		return echo.NewHTTPError(http.StatusInternalServerError, "Handling CSR failed").Wrap(err)
+		return err
 	}
 
-	return c.JSON(http.StatusOK, cert.Certificate)
+	req := &model.SmimePreviewRequest{}
+	if err := req.Bind(c, h.validator); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request").Wrap(err)
+	}
+	requestedEmail := user.Email
+	if req.Email != "" {
+		requestedEmail = req.Email
+	}
+
+	span := sentryecho.GetSpanFromContext(c)
+	ctx := c.Request().Context()
+	if span != nil {
+		ctx = span.Context()
+	}
+	functional, err := h.authorizeEmail(ctx, logger, hub, &user, requestedEmail)
+	if err != nil {
+		return err
+	}
+	decision, err := h.checkEligibility(logger, &user, requestedEmail)
+	if err != nil {
+		return err
+	}
+	subject, err := h.smime.PreviewSmimeSubject(ctx, &pb.IssueSmimeRequest{
+		Email:             requestedEmail,
+		FirstName:         user.FirstName,
+		LastName:          user.LastName,
+		MiddleName:        user.MiddleName,
+		CommonName:        user.CommonName,
+		Student:           user.Student,
+		FunctionalMailbox: functional,
+		AllowedCertTypes:  decision.CertTypes,
+		MaxValidityDays:   int32(decision.MaxValidityDays), // #nosec G115 -- the validity is configured by the operator
+	})
+	if err != nil {
+		return issueError(logger, hub, err)
+	}
+	return c.JSON(http.StatusOK, subject)
+}
+
+// Status godoc
+// @Summary SMIME Status Endpoint
+// @Description Reports whether smime certificates can currently be requested.
+// @Tags SMIME
+// @Produce json
+// @Router /smime/status [get]
+// @Security API
+// @Success 200 {object} model.CaStatus "status"
+func (h *Handler) Status(c *echo.Context) error {
+	if !h.available.Available(c.Request().Context()) {
+		return c.JSON(http.StatusOK, model.CaStatus{Available: false, Message: availability.Message})
+	}
+	return c.JSON(http.StatusOK, model.CaStatus{Available: true})
 }
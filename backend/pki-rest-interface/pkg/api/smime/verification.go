@@ -0,0 +1,218 @@
+package smime
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/getsentry/sentry-go"
+	sentryecho "github.com/getsentry/sentry-go/echo"
+	"github.com/hm-edu/pki-rest-interface/pkg/model"
+	pb "github.com/hm-edu/portal-apis"
+	"github.com/hm-edu/portal-common/helper"
+	"github.com/hm-edu/portal-common/logging"
+	commonModel "github.com/hm-edu/portal-common/model"
+	"github.com/labstack/echo/v5"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errEmailUnverified is returned if an additional address of the user has not
+// been confirmed yet.
+var errEmailUnverified = &echo.HTTPError{Code: http.StatusForbidden, Message: "The email address must be verified first (see /smime/emails)."}
+
+// Verification holds the settings for confirming the additional addresses of
+// the users. Additional addresses are taken from the claims of the token and
+// are often editable by the users themselves, so they have to prove control
+// over the mailbox first. The pki-service mails the one-time codes.
+type Verification struct {
+	// Days is the number of days a confirmation is valid.
+	Days int
+}
+
+// verificationRequest extracts the user and the tracing context of an email
+// verification request.
+func (h *Handler) verificationRequest(c *echo.Context) (context.Context, *zap.Logger, *sentry.Hub, *commonModel.User, error) {
+	logger := c.Request().Context().Value(logging.LoggingContextKey).(*zap.Logger)
+	hub := sentryecho.GetHubFromContext(c)
+	if hub == nil {
+		hub = sentry.CurrentHub().Clone()
+	}
+	user := &commonModel.User{}
+	if err := user.Bind(c, h.validator); err != nil {
+		hub.CaptureException(err)
+		return nil, nil, nil, nil, err
+	}
+	hub.ConfigureScope(func(scope *sentry.Scope) {
+		scope.SetUser(sentry.User{Email: user.Email})
+	})
+	span := sentryecho.GetSpanFromContext(c)
+	ctx := c.Request().Context()
+	if span != nil {
+		ctx = span.Context()
+	}
+	return ctx, logger.With(zap.String("user", user.Email)), hub, user, nil
+}
+
+// verifiedEmails returns the additional addresses of the user that may be
+// used for S/MIME certificates.
+func (h *Handler) verifiedEmails(ctx context.Context, user *commonModel.User) ([]string, error) {
+	if h.verification == nil || len(user.AdditionalSmimeEmails) == 0 {
+		return user.AdditionalSmimeEmails, nil
+	}
+	resp, err := h.smime.ListVerifiedEmails(ctx, &pb.ListVerifiedEmailsRequest{User: user.Email})
+	if err != nil {
+		return nil, err
+	}
+	return helper.Where(user.AdditionalSmimeEmails, func(email string) bool {
+		return helper.Any(resp.Emails, func(v *pb.VerifiedEmail) bool { return strings.EqualFold(v.Email, email) })
+	}), nil
+}
+
+// verificationError maps an error of the email verification to the HTTP
+// error returned to the user.
+func verificationError(logger *zap.Logger, hub *sentry.Hub, err error) error {
+	msg := status.Convert(err).Message()
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		return &echo.HTTPError{Code: http.StatusBadRequest, Message: msg}
+	case codes.FailedPrecondition:
+		return &echo.HTTPError{Code: http.StatusConflict, Message: msg}
+	case codes.ResourceExhausted:
+		return &echo.HTTPError{Code: http.StatusTooManyRequests, Message: msg}
+	case codes.Unimplemented:
+		return &echo.HTTPError{Code: http.StatusNotImplemented, Message: msg}
+	}
+	hub.CaptureException(err)
+	logger.Error("error processing email verification", zap.Error(err))
+	return echo.NewHTTPError(http.StatusInternalServerError, "Error processing the request").Wrap(err)
+}
+
+// ListEmails godoc
+// @Summary SMIME Email List Endpoint
+// @Description Lists the addresses the user may request S/MIME certificates for. Additional addresses must be verified first if the verification is enabled.
+// @Tags SMIME
+// @Produce json
+// @Router /smime/emails [get]
+// @Security API
+// @Success 200 {object} []model.SmimeEmail "emails"
+// @Response default {object} echo.HTTPError "Error processing the request"
+func (h *Handler) ListEmails(c *echo.Context) error {
+	ctx, logger, hub, user, err := h.verificationRequest(c)
+	if err != nil {
+		return err
+	}
+	emails := []model.SmimeEmail{{Email: user.Email, Primary: true, Verified: true}}
+	verified := map[string]*pb.VerifiedEmail{}
+	if h.verification != nil && len(user.AdditionalSmimeEmails) > 0 {
+		resp, err := h.smime.ListVerifiedEmails(ctx, &pb.ListVerifiedEmailsRequest{User: user.Email})
+		if err != nil {
+			return verificationError(logger, hub, err)
+		}
+		for _, v := range resp.Emails {
+			verified[strings.ToLower(v.Email)] = v
+		}
+	}
+	for _, email := range user.AdditionalSmimeEmails {
+		item := model.SmimeEmail{Email: email, Verified: h.verification == nil}
+		if v, ok := verified[strings.ToLower(email)]; ok {
+			item.Verified = true
+			if v.ValidUntil != nil {
+				validUntil := v.ValidUntil.AsTime()
+				item.ValidUntil = &validUntil
+			}
+		}
+		emails = append(emails, item)
+	}
+	mailboxes, err := h.mailboxes(ctx, user)
+	if err != nil {
+		hub.CaptureException(err)
+		logger.Error("error listing functional mailboxes", zap.Error(err))
+	}
+	for _, mailbox := range mailboxes {
+		emails = append(emails, model.SmimeEmail{Email: mailbox, Functional: true, Verified: true})
+	}
+	return c.JSON(http.StatusOK, emails)
+}
+
+// StartEmailVerification godoc
+// @Summary SMIME Email Verification Endpoint
+// @Description Sends a one-time code to an additional address of the user. The code must be confirmed using /smime/emails/confirm before certificates can be requested for the address.
+// @Tags SMIME
+// @Accept json
+// @Produce json
+// @Router /smime/emails/verify [post]
+// @Param request body model.EmailVerificationRequest true "The address to verify"
+// @Security API
+// @Success 202
+// @Response default {object} echo.HTTPError "Error processing the request"
+func (h *Handler) StartEmailVerification(c *echo.Context) error {
+	ctx, logger, hub, user, err := h.verificationRequest(c)
+	if err != nil {
+		return err
+	}
+	if h.verification == nil {
+		return &echo.HTTPError{Code: http.StatusNotImplemented, Message: "Email verification is not enabled"}
+	}
+	req := &model.EmailVerificationRequest{}
+	if err := req.Bind(c, h.validator); err != nil {
+		hub.CaptureException(err)
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request").Wrap(err)
+	}
+	if !helper.Any(user.AdditionalSmimeEmails, func(email string) bool { return strings.EqualFold(email, req.Email) }) {
+		return errEmailForbidden
+	}
+	logger = logger.With(zap.String("email", req.Email))
+	challenge, err := h.smime.StartEmailVerification(ctx, &pb.StartEmailVerificationRequest{User: user.Email, Email: req.Email})
+	if err != nil {
+		return verificationError(logger, hub, err)
+	}
+	logger.Info("verification mail sent", zap.Time("expires", challenge.Expires.AsTime()))
+	return c.NoContent(http.StatusAccepted)
+}
+
+// ConfirmEmailVerification godoc
+// @Summary SMIME Email Confirmation Endpoint
+// @Description Confirms an additional address of the user using the one-time code sent to it.
+// @Tags SMIME
+// @Accept json
+// @Produce json
+// @Router /smime/emails/confirm [post]
+// @Param request body model.ConfirmEmailRequest true "The address and the code"
+// @Security API
+// @Success 200 {object} model.SmimeEmail "email"
+// @Response default {object} echo.HTTPError "Error processing the request"
+func (h *Handler) ConfirmEmailVerification(c *echo.Context) error {
+	ctx, logger, hub, user, err := h.verificationRequest(c)
+	if err != nil {
+		return err
+	}
+	if h.verification == nil {
+		return &echo.HTTPError{Code: http.StatusNotImplemented, Message: "Email verification is not enabled"}
+	}
+	req := &model.ConfirmEmailRequest{}
+	if err := req.Bind(c, h.validator); err != nil {
+		hub.CaptureException(err)
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request").Wrap(err)
+	}
+	if !helper.Any(user.AdditionalSmimeEmails, func(email string) bool { return strings.EqualFold(email, req.Email) }) {
+		return errEmailForbidden
+	}
+	verified, err := h.smime.ConfirmEmailVerification(ctx, &pb.ConfirmEmailVerificationRequest{
+		User:      user.Email,
+		Email:     req.Email,
+		Code:      req.Code,
+		ValidDays: int32(h.verification.Days), // #nosec G115 -- the validity is configured by the operator
+	})
+	if err != nil {
+		return verificationError(logger.With(zap.String("email", req.Email)), hub, err)
+	}
+	var validUntil *time.Time
+	if verified.ValidUntil != nil {
+		t := verified.ValidUntil.AsTime()
+		validUntil = &t
+	}
+	return c.JSON(http.StatusOK, model.SmimeEmail{Email: verified.Email, Verified: true, ValidUntil: validUntil})
+}
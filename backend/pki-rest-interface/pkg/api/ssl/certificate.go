@@ -11,6 +11,8 @@ import (
 	sentryecho "github.com/getsentry/sentry-go/echo"
 
 	"github.com/getsentry/sentry-go"
+	"github.com/hm-edu/pki-rest-interface/pkg/api/terms"
+	"github.com/hm-edu/pki-rest-interface/pkg/availability"
 	"github.com/hm-edu/pki-rest-interface/pkg/model"
 	"github.com/hm-edu/portal-common/auth"
 	"github.com/hm-edu/portal-common/helper"
@@ -19,6 +21,8 @@ import (
 	pb "github.com/hm-edu/portal-apis"
 	"github.com/labstack/echo/v5"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // Active godoc
@@ -212,7 +216,7 @@ func (h *Handler) Revoke(c *echo.Context) error {
 			return &echo.HTTPError{Code: http.StatusForbidden, Message: "You are not authorized to revoke this certificate"}
 		}
 	}
-	_, err = h.ssl.RevokeCertificate(ctx, &pb.RevokeSslRequest{Identifier: &pb.RevokeSslRequest_Serial{Serial: req.Serial}, Reason: req.Reason})
+	_, err = h.ssl.RevokeCertificate(ctx, &pb.RevokeSslRequest{Identifier: &pb.RevokeSslRequest_Serial{Serial: req.Serial}, Reason: req.Reason, Actor: user})
 	if err != nil {
 		hub.CaptureException(err)
 		logger.Error("error while revoking certificate", zap.Error(err))
@@ -294,11 +298,13 @@ func (h *Handler) HandleCsr(c *echo.Context) error {
 	}
 	sans := make([]string, 0, len(csr.DNSNames)+len(csr.IPAddresses)+len(csr.URIs)+1)
 	if csr.Subject.CommonName != "" {
-		sans = append(sans, csr.Subject.CommonName)
+		sans = append(sans, helper.NormalizeFqdn(csr.Subject.CommonName))
+	}
+	for _, name := range csr.DNSNames {
+		sans = append(sans, helper.NormalizeFqdn(name))
 	}
-	sans = append(sans, csr.DNSNames...)
 	for _, ip := range csr.IPAddresses {
-		sans = append(sans, ip.String())
+		sans = append(sans, helper.NormalizeFqdn(ip.String()))
 	}
 	for _, u := range csr.URIs {
 		sans = append(sans, u.String())
@@ -321,11 +327,48 @@ func (h *Handler) HandleCsr(c *echo.Context) error {
 		return &echo.HTTPError{Code: http.StatusForbidden, Message: "You are not authorized to issue this certificate. Missing permissions for domains: " + strings.Join(missing, ", ")}
 	}
 
-	resp, err := h.ssl.IssueCertificate(ctx, &pb.IssueSslRequest{Csr: req.CSR, SubjectAlternativeNames: sans, Issuer: user, Source: "API", WaitForIssue: true})
+	if !h.available.Available(ctx) {
+		logger.Warn("ssl service unavailable")
+		return &echo.HTTPError{Code: http.StatusServiceUnavailable, Message: availability.Message}
+	}
+
+	groups := auth.StringsClaimFromRequest(c, h.groupClaim)
+	resp, err := h.ssl.IssueCertificate(ctx, &pb.IssueSslRequest{Csr: req.CSR, SubjectAlternativeNames: sans, Issuer: user, Source: "API", WaitForIssue: true, CertType: req.CertType, Groups: groups})
 	if err != nil {
+		if availability.IsUnavailable(err) {
+			logger.Warn("ssl service unavailable", zap.Error(err))
+			return &echo.HTTPError{Code: http.StatusServiceUnavailable, Message: availability.Message}
+		}
+		if termsErr := terms.NotAccepted(err); termsErr != nil {
+			logger.Warn("terms not accepted", zap.Error(err))
+			return termsErr
+		}
+		switch status.Code(err) {
+		case codes.PermissionDenied:
+			logger.Warn("certificate type not allowed", zap.Error(err))
+			return &echo.HTTPError{Code: http.StatusForbidden, Message: status.Convert(err).Message()}
+		case codes.InvalidArgument, codes.FailedPrecondition:
+			logger.Warn("certificate request rejected", zap.Error(err))
+			return &echo.HTTPError{Code: http.StatusBadRequest, Message: status.Convert(err).Message()}
+		}
 		hub.CaptureException(err)
 		logger.Error("error while processing CSR", zap.Error(err))
 		return &echo.HTTPError{Code: http.StatusInternalServerError, Message: "Internal Error while processing the request."}
 	}
 	return c.JSON(http.StatusOK, resp.Certificate)
 }
+
+// Status godoc
+// @Summary SSL Status Endpoint
+// @Description Reports whether ssl certificates can currently be requested.
+// @Tags SSL
+// @Produce json
+// @Router /ssl/status [get]
+// @Security API
+// @Success 200 {object} model.CaStatus "status"
+func (h *Handler) Status(c *echo.Context) error {
+	if !h.available.Available(c.Request().Context()) {
+		return c.JSON(http.StatusOK, model.CaStatus{Available: false, Message: availability.Message})
+	}
+	return c.JSON(http.StatusOK, model.CaStatus{Available: true})
+}
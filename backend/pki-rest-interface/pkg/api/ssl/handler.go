@@ -1,6 +1,7 @@
 package ssl
 
 import (
+	"github.com/hm-edu/pki-rest-interface/pkg/availability"
 	pb "github.com/hm-edu/portal-apis"
 	"github.com/hm-edu/portal-common/model"
 )
@@ -10,14 +11,19 @@ type Handler struct {
 	validator *model.Validator
 	domain    pb.DomainServiceClient
 	ssl       pb.SSLServiceClient
+	available *availability.Checker
+	// groupClaim is the token claim containing the groups of the user.
+	groupClaim string
 }
 
 // NewHandler generates a new handler for acting on the domain storage.
-func NewHandler(domain pb.DomainServiceClient, ssl pb.SSLServiceClient) *Handler {
+func NewHandler(domain pb.DomainServiceClient, ssl pb.SSLServiceClient, available *availability.Checker, groupClaim string) *Handler {
 	v := model.NewValidator()
 	return &Handler{
-		validator: v,
-		domain:    domain,
-		ssl:       ssl,
+		validator:  v,
+		domain:     domain,
+		ssl:        ssl,
+		available:  available,
+		groupClaim: groupClaim,
 	}
 }
@@ -0,0 +1,140 @@
+package terms
+
+import (
+	"net/http"
+
+	"github.com/getsentry/sentry-go"
+	sentryecho "github.com/getsentry/sentry-go/echo"
+	"github.com/hm-edu/pki-rest-interface/pkg/model"
+	pb "github.com/hm-edu/portal-apis"
+	"github.com/hm-edu/portal-common/auth"
+	"github.com/hm-edu/portal-common/logging"
+	commonModel "github.com/hm-edu/portal-common/model"
+	"github.com/labstack/echo/v5"
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// notAcceptedReason is the reason the pki-service attaches to errors caused
+// by terms that have not been accepted.
+const notAcceptedReason = "TERMS_NOT_ACCEPTED"
+
+// Handler is a wrapper around the smime service and a validator.
+type Handler struct {
+	validator *commonModel.Validator
+	smime     pb.SmimeServiceClient
+}
+
+// NewHandler generates a new handler for the terms of use.
+func NewHandler(smime pb.SmimeServiceClient) *Handler {
+	return &Handler{
+		validator: commonModel.NewValidator(),
+		smime:     smime,
+	}
+}
+
+// NotAccepted maps the error returned by the pki-service if the user has not
+// accepted the current terms. It returns nil for all other errors.
+func NotAccepted(err error) *echo.HTTPError {
+	st := status.Convert(err)
+	if st.Code() != codes.FailedPrecondition {
+		return nil
+	}
+	for _, d := range st.Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok && info.Reason == notAcceptedReason {
+			return &echo.HTTPError{Code: http.StatusPreconditionRequired, Message: st.Message() + " (see /terms)"}
+		}
+	}
+	return nil
+}
+
+func termsError(logger *zap.Logger, hub *sentry.Hub, err error) error {
+	msg := status.Convert(err).Message()
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		return &echo.HTTPError{Code: http.StatusBadRequest, Message: msg}
+	case codes.NotFound:
+		return &echo.HTTPError{Code: http.StatusNotFound, Message: msg}
+	case codes.FailedPrecondition:
+		return &echo.HTTPError{Code: http.StatusConflict, Message: msg}
+	}
+	hub.CaptureException(err)
+	logger.Error("error processing terms", zap.Error(err))
+	return echo.NewHTTPError(http.StatusInternalServerError, "Error processing the request").Wrap(err)
+}
+
+// Get godoc
+// @Summary Terms Endpoint
+// @Description Returns the current terms of use and whether the user accepted them. Certificates are only issued after the current version was accepted.
+// @Tags Terms
+// @Produce json
+// @Router /terms [get]
+// @Security API
+// @Success 200 {object} pb.Terms "terms"
+// @Response default {object} echo.HTTPError "Error processing the request"
+func (h *Handler) Get(c *echo.Context) error {
+	logger := c.Request().Context().Value(logging.LoggingContextKey).(*zap.Logger)
+	hub := sentryecho.GetHubFromContext(c)
+	if hub == nil {
+		hub = sentry.CurrentHub().Clone()
+	}
+	user, err := auth.UserFromRequest(c)
+	if err != nil {
+		return &echo.HTTPError{Code: http.StatusUnauthorized, Message: "Unauthorized"}
+	}
+	span := sentryecho.GetSpanFromContext(c)
+	ctx := c.Request().Context()
+	if span != nil {
+		ctx = span.Context()
+	}
+	terms, err := h.smime.GetTerms(ctx, &pb.GetTermsRequest{User: user})
+	if err != nil {
+		return termsError(logger, hub, err)
+	}
+	return c.JSON(http.StatusOK, terms)
+}
+
+// Accept godoc
+// @Summary Terms Acceptance Endpoint
+// @Description Accepts the current version of the terms of use. Older versions cannot be accepted.
+// @Tags Terms
+// @Accept json
+// @Produce json
+// @Router /terms/accept [post]
+// @Param request body model.AcceptTermsRequest true "The accepted version"
+// @Security API
+// @Success 200 {object} pb.Terms "terms"
+// @Response default {object} echo.HTTPError "Error processing the request"
+func (h *Handler) Accept(c *echo.Context) error {
+	logger := c.Request().Context().Value(logging.LoggingContextKey).(*zap.Logger)
+	hub := sentryecho.GetHubFromContext(c)
+	if hub == nil {
+		hub = sentry.CurrentHub().Clone()
+	}
+	user, err := auth.UserFromRequest(c)
+	if err != nil {
+		return &echo.HTTPError{Code: http.StatusUnauthorized, Message: "Unauthorized"}
+	}
+	req := &model.AcceptTermsRequest{}
+	if err := req.Bind(c, h.validator); err != nil {
+		hub.CaptureException(err)
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request").Wrap(err)
+	}
+	source := req.Source
+	if source == "" {
+		source = "API"
+	}
+	span := sentryecho.GetSpanFromContext(c)
+	ctx := c.Request().Context()
+	if span != nil {
+		ctx = span.Context()
+	}
+	logger.Info("accepting terms", zap.String("version", req.Version), zap.String("source", source))
+	terms, err := h.smime.AcceptTerms(ctx, &pb.AcceptTermsRequest{User: user, Version: req.Version, Source: source})
+	if err != nil {
+		return termsError(logger, hub, err)
+	}
+	return c.JSON(http.StatusOK, terms)
+}
@@ -0,0 +1,47 @@
+// Package availability reports whether the CAs used by the pki-service are
+// currently available. The pki-service reports a service as NOT_SERVING as
+// long as the CA it depends on is unavailable.
+package availability
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// Message is the message shown to the user while the CA is unavailable.
+const Message = "CA temporarily unavailable"
+
+// Checker checks the gRPC health status of a single service.
+type Checker struct {
+	health  grpc_health_v1.HealthClient
+	service string
+}
+
+// NewChecker creates a new checker for the given service.
+func NewChecker(conn grpc.ClientConnInterface, service string) *Checker {
+	return &Checker{health: grpc_health_v1.NewHealthClient(conn), service: service}
+}
+
+// Available reports whether the service is available. Only an explicit
+// NOT_SERVING status is treated as unavailable; failing health checks are
+// ignored as the actual request reports its own error.
+func (c *Checker) Available(ctx context.Context) bool {
+	if c == nil {
+		return true
+	}
+	resp, err := c.health.Check(ctx, &grpc_health_v1.HealthCheckRequest{Service: c.service})
+	if err != nil {
+		return !IsUnavailable(err)
+	}
+	return resp.Status != grpc_health_v1.HealthCheckResponse_NOT_SERVING
+}
+
+// IsUnavailable reports whether the error returned by the pki-service
+// indicates that the CA is unavailable.
+func IsUnavailable(err error) bool {
+	return status.Code(err) == codes.Unavailable
+}
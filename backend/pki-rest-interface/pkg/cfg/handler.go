@@ -2,8 +2,23 @@ package cfg
 
 // HandlerConfiguration holds the configuration of the different service endpoints.
 type HandlerConfiguration struct {
-	SmimeService   string `mapstructure:"smime_service"`
-	SslService     string `mapstructure:"ssl_service"`
-	DomainService  string `mapstructure:"domain_service"`
-	RejectStudents bool   `mapstructure:"reject_students"`
+	SmimeService  string `mapstructure:"smime_service"`
+	SslService    string `mapstructure:"ssl_service"`
+	DomainService string `mapstructure:"domain_service"`
+	// SslGroupClaim is the name of the token claim containing the groups of
+	// the user. The groups are passed to the ssl service to check the
+	// allowed certificate types.
+	SslGroupClaim string `mapstructure:"ssl_group_claim"`
+	// NotificationService manages the notification preferences. Defaults
+	// to SmimeService since both are provided by the pki-service.
+	NotificationService string `mapstructure:"notification_service"`
+	RejectStudents      bool   `mapstructure:"reject_students"`
+	// SmimeEligibility is the path to the YAML file configuring which users
+	// may request S/MIME certificates. It replaces RejectStudents if set.
+	SmimeEligibility string `mapstructure:"smime_eligibility"`
+	// VerifyAdditionalEmails requires the additional S/MIME addresses of a
+	// user to be confirmed using a one-time code sent to the address.
+	VerifyAdditionalEmails bool `mapstructure:"verify_additional_emails"`
+	// EmailVerificationDays is the number of days a confirmation is valid.
+	EmailVerificationDays int `mapstructure:"email_verification_days"`
 }
@@ -0,0 +1,178 @@
+// Package eligibility decides which users may request S/MIME certificates.
+// The decision is taken by an ordered list of rules over the claims of the
+// access token (affiliations, entitlements, groups, arbitrary claims) and the
+// domain of the requested address. The first matching rule wins.
+package eligibility
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/hm-edu/portal-common/helper"
+	"github.com/hm-edu/portal-common/model"
+	"go.yaml.in/yaml/v3"
+)
+
+// Effects of a rule.
+const (
+	EffectAllow = "allow"
+	EffectDeny  = "deny"
+)
+
+// DefaultRule is the name reported if no rule matched.
+const DefaultRule = "default"
+
+// Rule matches users by their claims. All conditions given must match; a
+// condition matches if any of its patterns matches any of the values.
+// Patterns are case insensitive and may contain shell wildcards (e.g.
+// "student@*").
+type Rule struct {
+	// Name identifies the rule in the logs.
+	Name string `yaml:"name"`
+	// Effect is either allow or deny.
+	Effect string `yaml:"effect"`
+	// Affiliations match the eduPersonScopedAffiliation claim.
+	Affiliations []string `yaml:"affiliations"`
+	// Entitlements match the entitlement claim.
+	Entitlements []string `yaml:"entitlements"`
+	// Groups match the group claim.
+	Groups []string `yaml:"groups"`
+	// EmailDomains match the domain of the requested address.
+	EmailDomains []string `yaml:"email_domains"`
+	// Claims match arbitrary string or string array claims.
+	Claims map[string][]string `yaml:"claims"`
+	// CertTypes limits the certificate types (e.g. email_only) that may be
+	// issued to users matching an allow rule.
+	CertTypes []string `yaml:"cert_types"`
+	// MaxValidityDays limits the validity of the certificates that may be
+	// issued to users matching an allow rule.
+	MaxValidityDays int `yaml:"max_validity_days"`
+}
+
+// Policy is the content of the eligibility configuration file.
+type Policy struct {
+	Rules []Rule `yaml:"rules"`
+	// Default is the effect if no rule matches (defaults to allow).
+	Default string `yaml:"default"`
+	// EntitlementClaim is the name of the entitlement claim (defaults to
+	// eduPersonEntitlement).
+	EntitlementClaim string `yaml:"entitlement_claim"`
+	// GroupClaim is the name of the group claim (defaults to groups).
+	GroupClaim string `yaml:"group_claim"`
+}
+
+// Decision is the result of evaluating the policy.
+type Decision struct {
+	// Rule is the name of the matched rule.
+	Rule            string
+	Allowed         bool
+	CertTypes       []string
+	MaxValidityDays int
+}
+
+// Load reads and validates the eligibility configuration file.
+func Load(path string) (*Policy, error) {
+	data, err := os.ReadFile(path) // #nosec G304 -- path is provided by the operator
+	if err != nil {
+		return nil, fmt.Errorf("reading eligibility config %s: %w", path, err)
+	}
+	p := &Policy{}
+	if err := yaml.Unmarshal(data, p); err != nil {
+		return nil, fmt.Errorf("parsing eligibility config %s: %w", path, err)
+	}
+	if err := p.init(); err != nil {
+		return nil, fmt.Errorf("invalid eligibility config %s: %w", path, err)
+	}
+	return p, nil
+}
+
+// RejectStudents returns the policy equivalent to the former reject_students
+// flag.
+func RejectStudents() *Policy {
+	p := &Policy{Rules: []Rule{{Name: "reject_students", Effect: EffectDeny, Affiliations: []string{"*student@*"}}}}
+	_ = p.init()
+	return p
+}
+
+func (p *Policy) init() error {
+	if p.Default == "" {
+		p.Default = EffectAllow
+	}
+	if p.Default != EffectAllow && p.Default != EffectDeny {
+		return fmt.Errorf("invalid default %q", p.Default)
+	}
+	if p.EntitlementClaim == "" {
+		p.EntitlementClaim = "eduPersonEntitlement"
+	}
+	if p.GroupClaim == "" {
+		p.GroupClaim = "groups"
+	}
+	for i, r := range p.Rules {
+		if r.Name == "" {
+			return fmt.Errorf("rule %d has no name", i)
+		}
+		if r.Effect != EffectAllow && r.Effect != EffectDeny {
+			return fmt.Errorf("rule %s has invalid effect %q", r.Name, r.Effect)
+		}
+		if r.MaxValidityDays < 0 {
+			return fmt.Errorf("rule %s has a negative validity", r.Name)
+		}
+		patterns := append(append(append(append([]string{}, r.Affiliations...), r.Entitlements...), r.Groups...), r.EmailDomains...)
+		for _, values := range r.Claims {
+			patterns = append(patterns, values...)
+		}
+		for _, pattern := range patterns {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return fmt.Errorf("rule %s has invalid pattern %q: %w", r.Name, pattern, err)
+			}
+		}
+	}
+	return nil
+}
+
+// matchAny reports whether any of the patterns matches any of the values.
+// Empty pattern lists are no condition and always match.
+func matchAny(patterns, values []string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	return helper.Any(patterns, func(pattern string) bool {
+		return helper.Any(values, func(value string) bool {
+			ok, _ := path.Match(strings.ToLower(pattern), strings.ToLower(value))
+			return ok
+		})
+	})
+}
+
+func (p *Policy) matches(r Rule, user *model.User, email string) bool {
+	domain := email[strings.LastIndex(email, "@")+1:]
+	if !matchAny(r.Affiliations, user.Affiliations) ||
+		!matchAny(r.Entitlements, model.StringsClaim(user.Claims, p.EntitlementClaim)) ||
+		!matchAny(r.Groups, model.StringsClaim(user.Claims, p.GroupClaim)) ||
+		!matchAny(r.EmailDomains, []string{domain}) {
+		return false
+	}
+	for claim, patterns := range r.Claims {
+		if !matchAny(patterns, model.StringsClaim(user.Claims, claim)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Evaluate decides whether the user may request a certificate for the given
+// address.
+func (p *Policy) Evaluate(user *model.User, email string) Decision {
+	for _, r := range p.Rules {
+		if !p.matches(r, user, email) {
+			continue
+		}
+		if r.Effect == EffectDeny {
+			return Decision{Rule: r.Name}
+		}
+		return Decision{Rule: r.Name, Allowed: true, CertTypes: r.CertTypes, MaxValidityDays: r.MaxValidityDays}
+	}
+	return Decision{Rule: DefaultRule, Allowed: p.Default == EffectAllow}
+}
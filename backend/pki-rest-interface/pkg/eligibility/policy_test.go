@@ -0,0 +1,101 @@
+package eligibility
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/hm-edu/portal-common/model"
+)
+
+const testPolicy = `
+default: deny
+rules:
+  - name: deny-students
+    effect: deny
+    affiliations: ["student@*"]
+  - name: staff
+    effect: allow
+    affiliations: ["employee@hm.edu", "staff@hm.edu"]
+  - name: guests
+    effect: allow
+    entitlements: ["urn:mace:hm.edu:smime"]
+    email_domains: ["*.hm.edu"]
+    cert_types: [email_only]
+    max_validity_days: 365
+  - name: projects
+    effect: allow
+    groups: ["smime-*"]
+    claims:
+      department: ["fk07"]
+`
+
+func loadTestPolicy(t *testing.T) *Policy {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "eligibility.yaml")
+	if err := os.WriteFile(path, []byte(testPolicy), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	p, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func TestEvaluate(t *testing.T) {
+	p := loadTestPolicy(t)
+	tc := []struct {
+		Name      string
+		Claims    map[string]interface{}
+		Email     string
+		Rule      string
+		Allowed   bool
+		CertTypes []string
+	}{
+		{Name: "student", Claims: map[string]interface{}{"eduPersonScopedAffiliation": []interface{}{"member@hm.edu", "student@hm.edu"}}, Email: "jane@hm.edu", Rule: "deny-students"},
+		{Name: "employee", Claims: map[string]interface{}{"eduPersonScopedAffiliation": "EMPLOYEE@hm.edu"}, Email: "jane@hm.edu", Rule: "staff", Allowed: true},
+		{Name: "guest", Claims: map[string]interface{}{"eduPersonEntitlement": []string{"urn:mace:hm.edu:smime"}}, Email: "jane@cs.hm.edu", Rule: "guests", Allowed: true, CertTypes: []string{"email_only"}},
+		{Name: "guest other domain", Claims: map[string]interface{}{"eduPersonEntitlement": []string{"urn:mace:hm.edu:smime"}}, Email: "jane@example.com", Rule: DefaultRule},
+		{Name: "project", Claims: map[string]interface{}{"groups": []interface{}{"smime-users"}, "department": "fk07"}, Email: "jane@hm.edu", Rule: "projects", Allowed: true},
+		{Name: "project other department", Claims: map[string]interface{}{"groups": []interface{}{"smime-users"}, "department": "fk03"}, Email: "jane@hm.edu", Rule: DefaultRule},
+		{Name: "unknown", Claims: map[string]interface{}{}, Email: "jane@hm.edu", Rule: DefaultRule},
+	}
+	for _, c := range tc {
+		t.Run(c.Name, func(t *testing.T) {
+			user := &model.User{Claims: c.Claims, Affiliations: model.StringsClaim(c.Claims, "eduPersonScopedAffiliation")}
+			d := p.Evaluate(user, c.Email)
+			if d.Rule != c.Rule || d.Allowed != c.Allowed || !slices.Equal(d.CertTypes, c.CertTypes) {
+				t.Fatalf("unexpected decision %+v", d)
+			}
+		})
+	}
+}
+
+func TestRejectStudents(t *testing.T) {
+	p := RejectStudents()
+	if d := p.Evaluate(&model.User{Affiliations: []string{"student@hm.edu"}}, "jane@hm.edu"); d.Allowed {
+		t.Fatalf("students must be rejected: %+v", d)
+	}
+	if d := p.Evaluate(&model.User{Affiliations: []string{"employee@hm.edu"}}, "jane@hm.edu"); !d.Allowed || d.Rule != DefaultRule {
+		t.Fatalf("employees must be allowed: %+v", d)
+	}
+}
+
+func TestLoadInvalid(t *testing.T) {
+	for _, content := range []string{
+		"default: maybe\n",
+		"rules: [{effect: allow}]\n",
+		"rules: [{name: x, effect: permit}]\n",
+		"rules: [{name: x, effect: allow, groups: ['[']}]\n",
+	} {
+		path := filepath.Join(t.TempDir(), "eligibility.yaml")
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := Load(path); err == nil {
+			t.Fatalf("expected error for %q", content)
+		}
+	}
+}
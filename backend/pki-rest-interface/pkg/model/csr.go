@@ -8,6 +8,12 @@ import (
 // CsrRequest holds a CSR.
 type CsrRequest struct {
 	CSR string `json:"csr" validate:"required"`
+	// CertType optionally selects the certificate type (e.g. DV or OV) of a
+	// server certificate. The default type is used if empty.
+	CertType string `json:"cert_type,omitempty"`
+	// ConfirmedSubject is the subject of a S/MIME certificate confirmed by
+	// the user (see /smime/preview).
+	ConfirmedSubject string `json:"confirmed_subject,omitempty"`
 }
 
 // Bind binds an incoming echo request to the the CsrRequest and perfoms a validation
@@ -0,0 +1,31 @@
+package model
+
+import (
+	"github.com/hm-edu/portal-common/model"
+	"github.com/labstack/echo/v5"
+)
+
+// NotificationPreference represents the choice of a user for an event.
+type NotificationPreference struct {
+	// Event is the event the preference applies to (e.g. domain_approved).
+	Event string `json:"event" validate:"required"`
+	// Enabled controls whether the event is delivered at all.
+	Enabled bool `json:"enabled"`
+	// Channels optionally restricts the delivery to the named channels.
+	Channels []string `json:"channels,omitempty" validate:"max=10"`
+}
+
+// NotificationPreferencesRequest represents the update of the notification
+// preferences. Events not contained keep their preference.
+type NotificationPreferencesRequest struct {
+	Preferences []NotificationPreference `json:"preferences" validate:"required,max=50,dive"`
+}
+
+// Bind binds an incoming echo request to the NotificationPreferencesRequest and perfoms a validation
+func (r *NotificationPreferencesRequest) Bind(c *echo.Context, v *model.Validator) error {
+	if err := c.Bind(r); err != nil {
+		return err
+	}
+	err := v.Validate(r)
+	return err
+}
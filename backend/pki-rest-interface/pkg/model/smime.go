@@ -1,6 +1,8 @@
 package model
 
 import (
+	"time"
+
 	"github.com/hm-edu/portal-common/model"
 	"github.com/labstack/echo/v5"
 )
@@ -18,3 +20,166 @@ func (r *ListSmimeCertificatesRequest) Bind(c *echo.Context, v *model.Validator)
 	err := v.Validate(r)
 	return err
 }
+
+// SmimeGenerateRequest represents a request for a smime certificate with a
+// key generated by the server.
+type SmimeGenerateRequest struct {
+	// Email optionally selects one of the additional mail addresses of the
+	// user.
+	Email string `json:"email,omitempty" validate:"omitempty,email"`
+	// Password protects the PKCS#12 bundle. A random password is generated
+	// if empty.
+	Password string `json:"password,omitempty" validate:"omitempty,min=8"`
+	// ConfirmedSubject is the subject confirmed by the user (see
+	// /smime/preview).
+	ConfirmedSubject string `json:"confirmed_subject,omitempty"`
+}
+
+// Bind binds an incoming echo request to the SmimeGenerateRequest and perfoms a validation
+func (r *SmimeGenerateRequest) Bind(c *echo.Context, v *model.Validator) error {
+	if err := c.Bind(r); err != nil {
+		return err
+	}
+	err := v.Validate(r)
+	return err
+}
+
+// Pkcs12Response holds a PKCS#12 bundle.
+type Pkcs12Response struct {
+	// Pkcs12 is the base64 encoded PKCS#12 bundle.
+	Pkcs12 []byte `json:"pkcs12"`
+	// Password is the generated password of the bundle. It is only set if
+	// no password was passed.
+	Password string `json:"password,omitempty"`
+}
+
+// KeyRecoveryRequest represents a request for the recovery of an escrowed
+// smime key.
+type KeyRecoveryRequest struct {
+	// Serial is the serial of the certificate whose key should be recovered.
+	Serial string `json:"serial" validate:"required"`
+	// Reason is shown to the recovery officers.
+	Reason string `json:"reason" validate:"required"`
+}
+
+// Bind binds an incoming echo request to the KeyRecoveryRequest and perfoms a validation
+func (r *KeyRecoveryRequest) Bind(c *echo.Context, v *model.Validator) error {
+	if err := c.Bind(r); err != nil {
+		return err
+	}
+	err := v.Validate(r)
+	return err
+}
+
+// ApproveKeyRecoveryRequest represents the decision of a recovery officer.
+type ApproveKeyRecoveryRequest struct {
+	// Share is the base64 encoded decrypted share of the officer. It is only
+	// required if the keys are split between the officers.
+	Share []byte `json:"share,omitempty"`
+	// Reject rejects the recovery instead of approving it.
+	Reject  bool   `json:"reject,omitempty"`
+	Comment string `json:"comment,omitempty"`
+}
+
+// Bind binds an incoming echo request to the ApproveKeyRecoveryRequest and perfoms a validation
+func (r *ApproveKeyRecoveryRequest) Bind(c *echo.Context, v *model.Validator) error {
+	if err := c.Bind(r); err != nil {
+		return err
+	}
+	err := v.Validate(r)
+	return err
+}
+
+// ReleaseKeyRecoveryRequest represents the request of the owner to release
+// an approved recovery.
+type ReleaseKeyRecoveryRequest struct {
+	// Password protects the PKCS#12 bundle. A random password is generated
+	// if empty.
+	Password string `json:"password,omitempty" validate:"omitempty,min=8"`
+}
+
+// Bind binds an incoming echo request to the ReleaseKeyRecoveryRequest and perfoms a validation
+func (r *ReleaseKeyRecoveryRequest) Bind(c *echo.Context, v *model.Validator) error {
+	if err := c.Bind(r); err != nil {
+		return err
+	}
+	err := v.Validate(r)
+	return err
+}
+
+// SmimeDownloadRequest selects the format of a downloaded smime certificate.
+type SmimeDownloadRequest struct {
+	// Format is either "pem" (default), "der" or "p7b" (PKCS#7).
+	Format string `query:"format" validate:"omitempty,oneof=pem der p7b"`
+}
+
+// Bind binds an incoming echo request to the SmimeDownloadRequest and perfoms a validation
+func (r *SmimeDownloadRequest) Bind(c *echo.Context, v *model.Validator) error {
+	if err := c.Bind(r); err != nil {
+		return err
+	}
+	err := v.Validate(r)
+	return err
+}
+
+// SmimePreviewRequest represents a request for the preview of the subject of
+// a smime certificate.
+type SmimePreviewRequest struct {
+	// Email optionally selects one of the additional mail addresses of the
+	// user.
+	Email string `json:"email,omitempty" validate:"omitempty,email"`
+}
+
+// Bind binds an incoming echo request to the SmimePreviewRequest and perfoms a validation
+func (r *SmimePreviewRequest) Bind(c *echo.Context, v *model.Validator) error {
+	if err := c.Bind(r); err != nil {
+		return err
+	}
+	err := v.Validate(r)
+	return err
+}
+
+// EmailVerificationRequest represents a request for a one-time code for an
+// additional mail address.
+type EmailVerificationRequest struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+// Bind binds an incoming echo request to the EmailVerificationRequest and perfoms a validation
+func (r *EmailVerificationRequest) Bind(c *echo.Context, v *model.Validator) error {
+	if err := c.Bind(r); err != nil {
+		return err
+	}
+	err := v.Validate(r)
+	return err
+}
+
+// ConfirmEmailRequest represents the confirmation of an additional mail
+// address using the one-time code sent to it.
+type ConfirmEmailRequest struct {
+	Email string `json:"email" validate:"required,email"`
+	Code  string `json:"code" validate:"required"`
+}
+
+// Bind binds an incoming echo request to the ConfirmEmailRequest and perfoms a validation
+func (r *ConfirmEmailRequest) Bind(c *echo.Context, v *model.Validator) error {
+	if err := c.Bind(r); err != nil {
+		return err
+	}
+	err := v.Validate(r)
+	return err
+}
+
+// SmimeEmail describes a mail address the user may request S/MIME
+// certificates for.
+type SmimeEmail struct {
+	Email string `json:"email"`
+	// Primary marks the primary address of the user.
+	Primary bool `json:"primary"`
+	// Functional marks functional mailboxes.
+	Functional bool `json:"functional"`
+	// Verified reports whether certificates may be requested for the address.
+	Verified bool `json:"verified"`
+	// ValidUntil is the end of the verification of additional addresses.
+	ValidUntil *time.Time `json:"valid_until,omitempty"`
+}
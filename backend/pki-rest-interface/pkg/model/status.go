@@ -0,0 +1,7 @@
+package model
+
+// CaStatus describes whether certificates can currently be requested.
+type CaStatus struct {
+	Available bool   `json:"available"`
+	Message   string `json:"message,omitempty"`
+}
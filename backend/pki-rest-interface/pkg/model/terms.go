@@ -0,0 +1,24 @@
+package model
+
+import (
+	"github.com/hm-edu/portal-common/model"
+	"github.com/labstack/echo/v5"
+)
+
+// AcceptTermsRequest represents the acceptance of the terms of use.
+type AcceptTermsRequest struct {
+	// Version is the accepted version of the terms.
+	Version string `json:"version" validate:"required"`
+	// Source optionally names the client the terms were accepted with
+	// (defaults to API).
+	Source string `json:"source,omitempty" validate:"omitempty,max=64"`
+}
+
+// Bind binds an incoming echo request to the AcceptTermsRequest and perfoms a validation
+func (r *AcceptTermsRequest) Bind(c *echo.Context, v *model.Validator) error {
+	if err := c.Bind(r); err != nil {
+		return err
+	}
+	err := v.Validate(r)
+	return err
+}
@@ -3,12 +3,57 @@ package cmd
 import (
 	"github.com/hm-edu/pki-service/pkg/database"
 	"github.com/hm-edu/pki-service/pkg/grpc"
+	"github.com/hm-edu/pki-service/pkg/notify"
 	"github.com/hm-edu/pki-service/pkg/worker"
+	pb "github.com/hm-edu/portal-apis"
 	"github.com/hm-edu/portal-common/api"
+	commonInterceptor "github.com/hm-edu/portal-common/interceptor"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 	"go.uber.org/zap"
+	googleGrpc "google.golang.org/grpc"
 )
 
+// notificationDomains connects to the domain service used to resolve the
+// recipients of reminders. It returns nil if no domain service is configured.
+func notificationDomains(v *viper.Viper) (pb.DomainServiceClient, error) {
+	host := v.GetString("domain_service")
+	if host == "" {
+		return nil, nil
+	}
+	var interceptor []googleGrpc.UnaryClientInterceptor
+	if v.GetString("sentry_dsn") != "" {
+		interceptor = append(interceptor, commonInterceptor.UnaryClientInterceptor())
+	}
+	conn, err := api.ConnectGRPC(host, googleGrpc.WithChainUnaryInterceptor(interceptor...))
+	if err != nil {
+		return nil, err
+	}
+	return pb.NewDomainServiceClient(conn), nil
+}
+
+// notificationDispatcher loads the notification channels. Without
+// configuration file the mail_* flags configure a single SMTP channel.
+func notificationDispatcher(v *viper.Viper) (*notify.Dispatcher, error) {
+	var cfg *notify.Config
+	var err error
+	if path := v.GetString("notifications"); path != "" {
+		cfg, err = notify.Load(path)
+	} else {
+		cfg, err = notify.MailConfig(
+			v.GetString("mail_host"),
+			v.GetInt("mail_port"),
+			v.GetString("mail_from"),
+			v.GetString("mail_username"),
+			v.GetString("mail_password"),
+		)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return notify.New(cfg), nil
+}
+
 // notifyCmd represents the run command
 var notifyCmd = &cobra.Command{
 	Use:   "notify",
@@ -23,14 +68,23 @@ var notifyCmd = &cobra.Command{
 		}
 
 		database.ConnectDb(logger, viper.GetString("db"))
+		dispatcher, err := notificationDispatcher(viper)
+		if err != nil {
+			logger.Fatal("Error loading notification config", zap.Error(err))
+		}
+		domains, err := notificationDomains(viper)
+		if err != nil {
+			logger.Fatal("Error connecting to the domain service", zap.Error(err))
+		}
 		w := worker.Notifier{
-			Db:        database.DB.Db,
-			MailHost:  viper.GetString("mail_host"),
-			MailPort:  viper.GetInt("mail_port"),
-			MailFrom:  viper.GetString("mail_from"),
-			MailTo:    viper.GetString("mail_to"),
-			MailToBcc: viper.GetString("mail_bcc"),
-			Force:     viper.GetBool("force"),
+			Db:                database.DB.Db,
+			Dispatcher:        dispatcher,
+			MailTo:            viper.GetString("mail_to"),
+			MailToBcc:         viper.GetString("mail_bcc"),
+			Intervals:         viper.GetIntSlice("notification_days"),
+			SmimeIntervals:    viper.GetIntSlice("smime_notification_days"),
+			Domains:           domains,
+			FallbackRecipient: viper.GetString("notification_fallback"),
 		}
 
 		if err := w.Notify(logger); err != nil {
@@ -43,10 +97,18 @@ var notifyCmd = &cobra.Command{
 func init() {
 	rootCmd.AddCommand(notifyCmd)
 	notifyCmd.Flags().String("db", "", "connection string for the database")
+	notifyCmd.Flags().String("notifications", "", "Path to the YAML file configuring the notification channels and templates (replaces the mail_* flags)")
 	notifyCmd.Flags().String("mail_host", "", "The mail host")
 	notifyCmd.Flags().Int("mail_port", 25, "The mail port")
 	notifyCmd.Flags().String("mail_from", "", "The mail from")
+	notifyCmd.Flags().String("mail_username", "", "Username for Mail Authentication")
+	notifyCmd.Flags().String("mail_password", "", "Password for Mail Authentication")
 	notifyCmd.Flags().String("mail_to", "", "Optional param to send notifications to a specific mail address instead of the orignal issuer.")
 	notifyCmd.Flags().String("mail_bcc", "", "Optional param to send notifications as blind copy to a specific mail address instead of the orignal issuer.")
 	notifyCmd.Flags().Bool("force", false, "Optional param to force sending notifications.")
+	_ = notifyCmd.Flags().MarkDeprecated("force", "missed reminders are sent automatically and reminders are never sent twice")
+	notifyCmd.Flags().IntSlice("notification_days", worker.DefaultIntervals, "Days before the expiry of a SSL certificate on which reminders are sent")
+	notifyCmd.Flags().String("domain_service", "", "The domain service used to notify the owners, delegates and technical contacts of the domains (only the requester is notified if empty)")
+	notifyCmd.Flags().String("notification_fallback", "", "Mail address (e.g. a team mailbox) notified about certificates without responsible domain owner")
+	notifyCmd.Flags().IntSlice("smime_notification_days", worker.DefaultSmimeIntervals, "Days before the expiry of a S/MIME certificate on which reminders are sent")
 }
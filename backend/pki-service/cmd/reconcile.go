@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"context"
+
+	harica "github.com/hm-edu/harica/client"
+	"github.com/hm-edu/pki-service/pkg/database"
+	"github.com/hm-edu/pki-service/pkg/worker"
+	"github.com/hm-edu/portal-common/api"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+// reconcileCmd represents the reconcile command
+var reconcileCmd = &cobra.Command{
+	Use:   "reconcile",
+	Short: "Reconciles the HARICA transactions with the database",
+	Long:  `Looks up the HARICA transactions of the local certificates, fixes statuses and serials, reports pending HARICA transactions that are unknown locally and writes a drift report.`,
+	Run: func(cmd *cobra.Command, _ []string) {
+		logger, deferFunc, viper := api.PrepareEnv(cmd)
+		defer deferFunc(logger)
+
+		database.ConnectDb(logger, viper.GetString("db"))
+		client, err := harica.NewClient(
+			viper.GetString("validation_user"),
+			viper.GetString("validation_password"),
+			viper.GetString("validation_totp_seed"),
+		)
+		if err != nil {
+			logger.Fatal("Error while logging in to HARICA", zap.Error(err))
+		}
+		r := worker.Reconciler{
+			Db:     database.DB.Db,
+			Harica: client,
+			DryRun: viper.GetBool("dry_run"),
+		}
+		report, err := r.Reconcile(context.Background(), logger)
+		if err != nil {
+			logger.Fatal("Error while reconciling", zap.Error(err))
+		}
+		if err := worker.WriteReport(report, viper.GetString("report")); err != nil {
+			logger.Error("Error while writing drift report", zap.Error(err))
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(reconcileCmd)
+	reconcileCmd.Flags().String("db", "", "connection string for the database")
+	reconcileCmd.Flags().String("validation_user", "", "The user for the HARICA API")
+	reconcileCmd.Flags().String("validation_password", "", "The password for the HARICA API")
+	reconcileCmd.Flags().String("validation_totp_seed", "", "The totp seed for the HARICA API")
+	reconcileCmd.Flags().String("report", "-", "Path the drift report is written to (- for stdout)")
+	reconcileCmd.Flags().Bool("dry_run", false, "Only report the drift without changing the database")
+}
@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/hm-edu/pki-service/pkg/database"
+	"github.com/hm-edu/pki-service/pkg/publisher"
+	"github.com/hm-edu/pki-service/pkg/worker"
+	"github.com/hm-edu/portal-common/api"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+// resyncCmd represents the resync command
+var resyncCmd = &cobra.Command{
+	Use:   "resync",
+	Short: "Resyncs the S/MIME certificates in the LDAP directory",
+	Long:  `Publishes all valid S/MIME certificates to the LDAP directory and withdraws all revoked and expired ones.`,
+	Run: func(cmd *cobra.Command, _ []string) {
+		logger, deferFunc, viper := api.PrepareEnv(cmd)
+		defer deferFunc(logger)
+
+		path := viper.GetString("smime_ldap_publisher")
+		if path == "" {
+			logger.Fatal("No LDAP publisher config given")
+		}
+		pub, err := publisher.Load(path)
+		if err != nil {
+			logger.Fatal("Error loading LDAP publisher config", zap.Error(err))
+		}
+		database.ConnectDb(logger, viper.GetString("db"))
+		if _, err := worker.Resync(context.Background(), logger, database.DB.Db, pub); err != nil {
+			logger.Fatal("Error while resyncing the directory", zap.Error(err))
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(resyncCmd)
+	resyncCmd.Flags().String("db", "", "connection string for the database")
+	resyncCmd.Flags().String("smime_ldap_publisher", "", "Path to the YAML file configuring the publication of S/MIME certificates to the LDAP directory")
+}
@@ -5,10 +5,13 @@ import (
 	"time"
 
 	"github.com/go-co-op/gocron/v2"
+	harica "github.com/hm-edu/harica/client"
 	"github.com/hm-edu/pki-service/ent/certificate"
 	"github.com/hm-edu/pki-service/pkg/cfg"
 	"github.com/hm-edu/pki-service/pkg/database"
+	"github.com/hm-edu/pki-service/pkg/events"
 	"github.com/hm-edu/pki-service/pkg/grpc"
+	"github.com/hm-edu/pki-service/pkg/publisher"
 	"github.com/hm-edu/pki-service/pkg/worker"
 	"github.com/hm-edu/portal-common/api"
 	"github.com/hm-edu/portal-common/signals"
@@ -57,19 +60,32 @@ var runCmd = &cobra.Command{
 		if err != nil {
 			logger.Fatal("Error creating scheduler", zap.Error(err))
 		}
+		// Preferences can be managed without notifications, events are only
+		// delivered if notifications are enabled.
+		hub := &events.Hub{Db: database.DB.Db, Logger: logger}
 		if viper.GetBool("enable_notifications") {
 
+			dispatcher, err := notificationDispatcher(viper)
+			if err != nil {
+				logger.Fatal("Error loading notification config", zap.Error(err))
+			}
+			domains, err := notificationDomains(viper)
+			if err != nil {
+				logger.Fatal("Error connecting to the domain service", zap.Error(err))
+			}
 			w := worker.Notifier{Db: database.DB.Db,
-				MailHost:     viper.GetString("mail_host"),
-				MailPort:     viper.GetInt("mail_port"),
-				MailFrom:     viper.GetString("mail_from"),
-				MailTo:       viper.GetString("mail_to"),
-				MailToBcc:    viper.GetString("mail_bcc"),
-				MailUsername: viper.GetString("mail_username"),
-				MailPassword: viper.GetString("mail_password"),
+				Dispatcher:        dispatcher,
+				MailTo:            viper.GetString("mail_to"),
+				MailToBcc:         viper.GetString("mail_bcc"),
+				Intervals:         viper.GetIntSlice("notification_days"),
+				SmimeIntervals:    viper.GetIntSlice("smime_notification_days"),
+				Domains:           domains,
+				FallbackRecipient: viper.GetString("notification_fallback"),
 			}
+			hub.Dispatcher = dispatcher
+			hub.Domains = domains
 
-			_, err := s.NewJob(
+			_, err = s.NewJob(
 				gocron.DailyJob(1,
 					gocron.NewAtTimes(gocron.NewAtTime(9, 0, 0)),
 				),
@@ -84,12 +100,52 @@ var runCmd = &cobra.Command{
 			}
 		}
 
+		// Queued event notifications are delivered before shutting down.
+		hub.Start()
+		defer hub.Stop()
+
+		if viper.GetBool("enable_reconcile") {
+			_, err := s.NewJob(
+				gocron.DailyJob(1,
+					gocron.NewAtTimes(gocron.NewAtTime(2, 0, 0)),
+				),
+				gocron.NewTask(func() {
+					client, err := harica.NewClient(pkiCfg.ValidationUser, pkiCfg.ValidationPassword, pkiCfg.ValidationTotpSeed)
+					if err != nil {
+						logger.Error("Error while logging in to HARICA for reconciliation", zap.Error(err))
+						return
+					}
+					r := worker.Reconciler{
+						Db:        database.DB.Db,
+						Harica:    client,
+						ReportDir: viper.GetString("reconcile_report_dir"),
+					}
+					if _, err := r.Reconcile(context.Background(), logger); err != nil {
+						logger.Error("Error while reconciling", zap.Error(err))
+					}
+				}),
+			)
+			if err != nil {
+				logger.Error("Error while scheduling reconciliation", zap.Error(err))
+			}
+		}
+
+		// Expired S/MIME certificates are withdrawn from the directory.
+		var pub publisher.Publisher
+		if pkiCfg.SmimeLdapPublisher != "" {
+			ldapCfg, err := publisher.Load(pkiCfg.SmimeLdapPublisher)
+			if err != nil {
+				logger.Fatal("Error loading LDAP publisher config", zap.Error(err))
+			}
+			pub = ldapCfg
+		}
+
 		_, err = s.NewJob(
 			gocron.DailyJob(1,
 				gocron.NewAtTimes(gocron.NewAtTime(1, 0, 0)),
 			),
 			gocron.NewTask(func() {
-				if err := worker.Cleanup(logger, database.DB.Db); err != nil {
+				if err := worker.Cleanup(logger, database.DB.Db, pub); err != nil {
 					logger.Error("Error while cleaning up", zap.Error(err))
 				}
 			}),
@@ -100,7 +156,7 @@ var runCmd = &cobra.Command{
 		s.Start()
 		// start gRPC server
 		if grpcCfg.Port > 0 {
-			grpcSrv, _ := grpc.NewServer(&grpcCfg, logger, &pkiCfg, database.DB.Db)
+			grpcSrv, _ := grpc.NewServer(&grpcCfg, logger, &pkiCfg, database.DB.Db, hub)
 			grpcSrv.ListenAndServe(stopCh)
 		}
 	},
@@ -112,10 +168,15 @@ func init() {
 	runCmd.Flags().String("host", "", "Host to bind service to")
 	runCmd.Flags().Int("grpc-port", 8081, "GRPC port to bind service to")
 	runCmd.Flags().String("sentry_dsn", "", "The sentry dsn to use")
-	runCmd.Flags().Int("smime_key_length", 365, "The (expected) key length")
+	runCmd.Flags().Int("smime_key_length", 0, "Only accept RSA keys of exactly this length for S/MIME certificates (0 uses smime_key_types)")
+	_ = runCmd.Flags().MarkDeprecated("smime_key_length", "use smime_key_types instead")
+	runCmd.Flags().StringSlice("smime_key_types", []string{"RSA-2048", "RSA-3072", "RSA-4096", "ECDSA-P256", "ECDSA-P384"}, "The key types accepted for S/MIME certificates (RSA-2048, RSA-3072, RSA-4096, ECDSA-P256 or ECDSA-P384)")
 	runCmd.Flags().String("db", "", "connection string for the database")
 	runCmd.Flags().String("level", "info", "log level debug, info, warn, error, flat or panic")
-	runCmd.Flags().Bool("enable_notifications", false, "Enable notifications")
+	runCmd.Flags().Bool("enable_notifications", false, "Enable the expiry reminders and the notifications about certificate and domain events")
+	runCmd.Flags().Bool("enable_reconcile", false, "Enable the daily reconciliation of the HARICA transactions")
+	runCmd.Flags().String("reconcile_report_dir", "", "Directory the drift reports of the reconciliation are written to")
+	runCmd.Flags().String("notifications", "", "Path to the YAML file configuring the notification channels and templates (replaces the mail_* flags)")
 	runCmd.Flags().String("mail_host", "", "The mail host")
 	runCmd.Flags().Int("mail_port", 25, "The mail port")
 	runCmd.Flags().String("mail_to", "", "Optional param to send notifications to a specific mail address instead of the orignal issuer.")
@@ -123,16 +184,39 @@ func init() {
 	runCmd.Flags().String("mail_from", "", "The mail from")
 	runCmd.Flags().String("mail_username", "", "Username for Mail Authentication")
 	runCmd.Flags().String("mail_password", "", "Password for Mail Authentication")
+	runCmd.Flags().IntSlice("notification_days", worker.DefaultIntervals, "Days before the expiry of a SSL certificate on which reminders are sent")
+	runCmd.Flags().String("domain_service", "", "The domain service used to notify the owners, delegates and technical contacts of the domains (only the requester is notified if empty)")
+	runCmd.Flags().String("notification_fallback", "", "Mail address (e.g. a team mailbox) notified about certificates without responsible domain owner")
+	runCmd.Flags().IntSlice("smime_notification_days", worker.DefaultSmimeIntervals, "Days before the expiry of a S/MIME certificate on which reminders are sent")
 	runCmd.Flags().String("user", "", "The user for the HARICA API")
 	runCmd.Flags().String("password", "", "The password for the HARICA API")
 	runCmd.Flags().String("totp_seed", "", "The totp seed for the HARICA API")
 	runCmd.Flags().String("validation_user", "", "The user for the HARICA API")
 	runCmd.Flags().String("validation_password", "", "The password for the HARICA API")
 	runCmd.Flags().String("validation_totp_seed", "", "The totp seed for the HARICA API")
-	runCmd.Flags().String("cert_type", "OV", "The certificate type to use")
+	runCmd.Flags().String("cert_type", "OV", "The default certificate type to use")
 	runCmd.Flags().String("ssl_ca", "harica", "The CA to use for server certificates (harica or letsencrypt)")
 	runCmd.Flags().String("acme_email", "", "The contact mail address for the ACME account")
 	runCmd.Flags().String("acme_directory", "https://acme-v02.api.letsencrypt.org/directory", "The directory URL of the ACME CA")
 	runCmd.Flags().String("acme_account_key", "acme-account-key.pem", "Path to the PEM encoded ACME account key (created on first start)")
 	runCmd.Flags().String("acme_dns_config", "", "Path to the YAML file mapping DNS zones to TSIG keys for the DNS-01 validation")
+	runCmd.Flags().Duration("acme_rate_limit_window", 7*24*time.Hour, "The sliding window of the ACME CA rate limits")
+	runCmd.Flags().Int("acme_certificates_per_domain", 50, "The number of new certificates per registered domain within the rate limit window (0 disables the check)")
+	runCmd.Flags().Int("acme_duplicate_certificates", 5, "The number of certificates for the same set of names within the rate limit window (0 disables the check)")
+	runCmd.Flags().String("acme_rate_limit_fallback", "harica", "How to handle requests hitting an ACME rate limit (harica or queue)")
+	runCmd.Flags().Int("acme_queue_max_attempts", 5, "The number of failed ACME orders after which a queued certificate request is marked as invalid")
+	runCmd.Flags().Int("harica_breaker_threshold", 5, "The number of consecutive failed HARICA requests after which requests fail fast (0 disables the circuit breaker)")
+	runCmd.Flags().Duration("harica_breaker_probe_interval", 30*time.Second, "The interval in which HARICA is probed while requests fail fast")
+	runCmd.Flags().String("harica_organizations", "", "Path to the YAML file mapping domains and mail domains to HARICA organizations")
+	runCmd.Flags().Int("smime_validity_days", 0, "Validity of the S/MIME certificates issued by HARICA (in days), required for validity limits of the eligibility policy")
+	runCmd.Flags().Bool("smime_key_generation", false, "Enable the generation of S/MIME keys delivered as PKCS#12 bundle")
+	runCmd.Flags().String("smime_generated_key_type", "RSA-3072", "The type of generated S/MIME keys (RSA-2048, RSA-3072, RSA-4096, ECDSA-P256 or ECDSA-P384)")
+	runCmd.Flags().String("smime_pkcs12_encryption", "modern", "The encryption of PKCS#12 bundles (modern or legacy for old clients)")
+	runCmd.Flags().String("smime_key_escrow", "", "Path to the YAML file configuring the escrow of generated S/MIME keys")
+	runCmd.Flags().String("terms", "", "Path to the YAML file describing the terms of use that must be accepted before certificates are issued")
+	runCmd.Flags().String("smime_names", "", "Path to the YAML file configuring the character set and transliteration of names in S/MIME certificates")
+	runCmd.Flags().String("smime_chain", "", "Path to the PEM bundle with the intermediate and root certificates of the S/MIME issuing CAs (defaults to the bundled HARICA chain)")
+	runCmd.Flags().String("smime_verification_url", "", "Optional page linked in the mails confirming additional S/MIME addresses; email and code are appended")
+	runCmd.Flags().String("smime_ldap_publisher", "", "Path to the YAML file configuring the publication of S/MIME certificates to the LDAP directory")
+	runCmd.Flags().String("ssl_cert_types", "", "Path to the YAML file listing the certificate types that can be requested per domain")
 }
@@ -0,0 +1,222 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/hm-edu/pki-service/ent/acmeorder"
+	"github.com/hm-edu/pki-service/ent/certificate"
+)
+
+// AcmeOrder is the model entity for the AcmeOrder schema.
+type AcmeOrder struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// Identifiers holds the value of the "identifiers" field.
+	Identifiers string `json:"identifiers,omitempty"`
+	// RegisteredDomains holds the value of the "registeredDomains" field.
+	RegisteredDomains []string `json:"registeredDomains,omitempty"`
+	// Status holds the value of the "status" field.
+	Status acmeorder.Status `json:"status,omitempty"`
+	// RetryAfter holds the value of the "retryAfter" field.
+	RetryAfter *time.Time `json:"retryAfter,omitempty"`
+	// Error holds the value of the "error" field.
+	Error *string `json:"error,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AcmeOrderQuery when eager-loading is set.
+	Edges                   AcmeOrderEdges `json:"edges"`
+	certificate_acme_orders *int
+	selectValues            sql.SelectValues
+}
+
+// AcmeOrderEdges holds the relations/edges for other nodes in the graph.
+type AcmeOrderEdges struct {
+	// Certificate holds the value of the certificate edge.
+	Certificate *Certificate `json:"certificate,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// CertificateOrErr returns the Certificate value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AcmeOrderEdges) CertificateOrErr() (*Certificate, error) {
+	if e.Certificate != nil {
+		return e.Certificate, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: certificate.Label}
+	}
+	return nil, &NotLoadedError{edge: "certificate"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AcmeOrder) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case acmeorder.FieldRegisteredDomains:
+			values[i] = new([]byte)
+		case acmeorder.FieldID:
+			values[i] = new(sql.NullInt64)
+		case acmeorder.FieldIdentifiers, acmeorder.FieldStatus, acmeorder.FieldError:
+			values[i] = new(sql.NullString)
+		case acmeorder.FieldCreateTime, acmeorder.FieldUpdateTime, acmeorder.FieldRetryAfter:
+			values[i] = new(sql.NullTime)
+		case acmeorder.ForeignKeys[0]: // certificate_acme_orders
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AcmeOrder fields.
+func (_m *AcmeOrder) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case acmeorder.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case acmeorder.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = value.Time
+			}
+		case acmeorder.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = value.Time
+			}
+		case acmeorder.FieldIdentifiers:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field identifiers", values[i])
+			} else if value.Valid {
+				_m.Identifiers = value.String
+			}
+		case acmeorder.FieldRegisteredDomains:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field registeredDomains", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.RegisteredDomains); err != nil {
+					return fmt.Errorf("unmarshal field registeredDomains: %w", err)
+				}
+			}
+		case acmeorder.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = acmeorder.Status(value.String)
+			}
+		case acmeorder.FieldRetryAfter:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field retryAfter", values[i])
+			} else if value.Valid {
+				_m.RetryAfter = new(time.Time)
+				*_m.RetryAfter = value.Time
+			}
+		case acmeorder.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				_m.Error = new(string)
+				*_m.Error = value.String
+			}
+		case acmeorder.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field certificate_acme_orders", value)
+			} else if value.Valid {
+				_m.certificate_acme_orders = new(int)
+				*_m.certificate_acme_orders = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AcmeOrder.
+// This includes values selected through modifiers, order, etc.
+func (_m *AcmeOrder) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryCertificate queries the "certificate" edge of the AcmeOrder entity.
+func (_m *AcmeOrder) QueryCertificate() *CertificateQuery {
+	return NewAcmeOrderClient(_m.config).QueryCertificate(_m)
+}
+
+// Update returns a builder for updating this AcmeOrder.
+// Note that you need to call AcmeOrder.Unwrap() before calling this method if this AcmeOrder
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AcmeOrder) Update() *AcmeOrderUpdateOne {
+	return NewAcmeOrderClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AcmeOrder entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AcmeOrder) Unwrap() *AcmeOrder {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AcmeOrder is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AcmeOrder) String() string {
+	var builder strings.Builder
+	builder.WriteString("AcmeOrder(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(_m.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("identifiers=")
+	builder.WriteString(_m.Identifiers)
+	builder.WriteString(", ")
+	builder.WriteString("registeredDomains=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RegisteredDomains))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	if v := _m.RetryAfter; v != nil {
+		builder.WriteString("retryAfter=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.Error; v != nil {
+		builder.WriteString("error=")
+		builder.WriteString(*v)
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AcmeOrders is a parsable slice of AcmeOrder.
+type AcmeOrders []*AcmeOrder
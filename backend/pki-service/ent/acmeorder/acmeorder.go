@@ -0,0 +1,167 @@
+// Code generated by ent, DO NOT EDIT.
+
+package acmeorder
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the acmeorder type in the database.
+	Label = "acme_order"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldIdentifiers holds the string denoting the identifiers field in the database.
+	FieldIdentifiers = "identifiers"
+	// FieldRegisteredDomains holds the string denoting the registereddomains field in the database.
+	FieldRegisteredDomains = "registered_domains"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldRetryAfter holds the string denoting the retryafter field in the database.
+	FieldRetryAfter = "retry_after"
+	// FieldError holds the string denoting the error field in the database.
+	FieldError = "error"
+	// EdgeCertificate holds the string denoting the certificate edge name in mutations.
+	EdgeCertificate = "certificate"
+	// Table holds the table name of the acmeorder in the database.
+	Table = "acme_orders"
+	// CertificateTable is the table that holds the certificate relation/edge.
+	CertificateTable = "acme_orders"
+	// CertificateInverseTable is the table name for the Certificate entity.
+	// It exists in this package in order to avoid circular dependency with the "certificate" package.
+	CertificateInverseTable = "certificates"
+	// CertificateColumn is the table column denoting the certificate relation/edge.
+	CertificateColumn = "certificate_acme_orders"
+)
+
+// Columns holds all SQL columns for acmeorder fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldIdentifiers,
+	FieldRegisteredDomains,
+	FieldStatus,
+	FieldRetryAfter,
+	FieldError,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "acme_orders"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"certificate_acme_orders",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreateTime holds the default value on creation for the "create_time" field.
+	DefaultCreateTime func() time.Time
+	// DefaultUpdateTime holds the default value on creation for the "update_time" field.
+	DefaultUpdateTime func() time.Time
+	// UpdateDefaultUpdateTime holds the default value on update for the "update_time" field.
+	UpdateDefaultUpdateTime func() time.Time
+	// IdentifiersValidator is a validator for the "identifiers" field. It is called by the builders before save.
+	IdentifiersValidator func(string) error
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusPending is the default value of the Status enum.
+const DefaultStatus = StatusPending
+
+// Status values.
+const (
+	StatusPending     Status = "Pending"
+	StatusValid       Status = "Valid"
+	StatusInvalid     Status = "Invalid"
+	StatusRateLimited Status = "RateLimited"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPending, StatusValid, StatusInvalid, StatusRateLimited:
+		return nil
+	default:
+		return fmt.Errorf("acmeorder: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the AcmeOrder queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByIdentifiers orders the results by the identifiers field.
+func ByIdentifiers(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIdentifiers, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByRetryAfter orders the results by the retryAfter field.
+func ByRetryAfter(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRetryAfter, opts...).ToFunc()
+}
+
+// ByError orders the results by the error field.
+func ByError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldError, opts...).ToFunc()
+}
+
+// ByCertificateField orders the results by certificate field.
+func ByCertificateField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCertificateStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newCertificateStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CertificateInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, CertificateTable, CertificateColumn),
+	)
+}
@@ -0,0 +1,419 @@
+// Code generated by ent, DO NOT EDIT.
+
+package acmeorder
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/hm-edu/pki-service/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// Identifiers applies equality check predicate on the "identifiers" field. It's identical to IdentifiersEQ.
+func Identifiers(v string) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldEQ(FieldIdentifiers, v))
+}
+
+// RetryAfter applies equality check predicate on the "retryAfter" field. It's identical to RetryAfterEQ.
+func RetryAfter(v time.Time) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldEQ(FieldRetryAfter, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldEQ(FieldError, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// IdentifiersEQ applies the EQ predicate on the "identifiers" field.
+func IdentifiersEQ(v string) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldEQ(FieldIdentifiers, v))
+}
+
+// IdentifiersNEQ applies the NEQ predicate on the "identifiers" field.
+func IdentifiersNEQ(v string) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldNEQ(FieldIdentifiers, v))
+}
+
+// IdentifiersIn applies the In predicate on the "identifiers" field.
+func IdentifiersIn(vs ...string) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldIn(FieldIdentifiers, vs...))
+}
+
+// IdentifiersNotIn applies the NotIn predicate on the "identifiers" field.
+func IdentifiersNotIn(vs ...string) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldNotIn(FieldIdentifiers, vs...))
+}
+
+// IdentifiersGT applies the GT predicate on the "identifiers" field.
+func IdentifiersGT(v string) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldGT(FieldIdentifiers, v))
+}
+
+// IdentifiersGTE applies the GTE predicate on the "identifiers" field.
+func IdentifiersGTE(v string) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldGTE(FieldIdentifiers, v))
+}
+
+// IdentifiersLT applies the LT predicate on the "identifiers" field.
+func IdentifiersLT(v string) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldLT(FieldIdentifiers, v))
+}
+
+// IdentifiersLTE applies the LTE predicate on the "identifiers" field.
+func IdentifiersLTE(v string) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldLTE(FieldIdentifiers, v))
+}
+
+// IdentifiersContains applies the Contains predicate on the "identifiers" field.
+func IdentifiersContains(v string) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldContains(FieldIdentifiers, v))
+}
+
+// IdentifiersHasPrefix applies the HasPrefix predicate on the "identifiers" field.
+func IdentifiersHasPrefix(v string) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldHasPrefix(FieldIdentifiers, v))
+}
+
+// IdentifiersHasSuffix applies the HasSuffix predicate on the "identifiers" field.
+func IdentifiersHasSuffix(v string) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldHasSuffix(FieldIdentifiers, v))
+}
+
+// IdentifiersEqualFold applies the EqualFold predicate on the "identifiers" field.
+func IdentifiersEqualFold(v string) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldEqualFold(FieldIdentifiers, v))
+}
+
+// IdentifiersContainsFold applies the ContainsFold predicate on the "identifiers" field.
+func IdentifiersContainsFold(v string) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldContainsFold(FieldIdentifiers, v))
+}
+
+// RegisteredDomainsIsNil applies the IsNil predicate on the "registeredDomains" field.
+func RegisteredDomainsIsNil() predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldIsNull(FieldRegisteredDomains))
+}
+
+// RegisteredDomainsNotNil applies the NotNil predicate on the "registeredDomains" field.
+func RegisteredDomainsNotNil() predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldNotNull(FieldRegisteredDomains))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// RetryAfterEQ applies the EQ predicate on the "retryAfter" field.
+func RetryAfterEQ(v time.Time) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldEQ(FieldRetryAfter, v))
+}
+
+// RetryAfterNEQ applies the NEQ predicate on the "retryAfter" field.
+func RetryAfterNEQ(v time.Time) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldNEQ(FieldRetryAfter, v))
+}
+
+// RetryAfterIn applies the In predicate on the "retryAfter" field.
+func RetryAfterIn(vs ...time.Time) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldIn(FieldRetryAfter, vs...))
+}
+
+// RetryAfterNotIn applies the NotIn predicate on the "retryAfter" field.
+func RetryAfterNotIn(vs ...time.Time) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldNotIn(FieldRetryAfter, vs...))
+}
+
+// RetryAfterGT applies the GT predicate on the "retryAfter" field.
+func RetryAfterGT(v time.Time) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldGT(FieldRetryAfter, v))
+}
+
+// RetryAfterGTE applies the GTE predicate on the "retryAfter" field.
+func RetryAfterGTE(v time.Time) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldGTE(FieldRetryAfter, v))
+}
+
+// RetryAfterLT applies the LT predicate on the "retryAfter" field.
+func RetryAfterLT(v time.Time) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldLT(FieldRetryAfter, v))
+}
+
+// RetryAfterLTE applies the LTE predicate on the "retryAfter" field.
+func RetryAfterLTE(v time.Time) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldLTE(FieldRetryAfter, v))
+}
+
+// RetryAfterIsNil applies the IsNil predicate on the "retryAfter" field.
+func RetryAfterIsNil() predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldIsNull(FieldRetryAfter))
+}
+
+// RetryAfterNotNil applies the NotNil predicate on the "retryAfter" field.
+func RetryAfterNotNil() predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldNotNull(FieldRetryAfter))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.FieldContainsFold(FieldError, v))
+}
+
+// HasCertificate applies the HasEdge predicate on the "certificate" edge.
+func HasCertificate() predicate.AcmeOrder {
+	return predicate.AcmeOrder(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CertificateTable, CertificateColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCertificateWith applies the HasEdge predicate on the "certificate" edge with a given conditions (other predicates).
+func HasCertificateWith(preds ...predicate.Certificate) predicate.AcmeOrder {
+	return predicate.AcmeOrder(func(s *sql.Selector) {
+		step := newCertificateStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AcmeOrder) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AcmeOrder) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AcmeOrder) predicate.AcmeOrder {
+	return predicate.AcmeOrder(sql.NotPredicates(p))
+}
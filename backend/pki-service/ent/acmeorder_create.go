@@ -0,0 +1,901 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hm-edu/pki-service/ent/acmeorder"
+	"github.com/hm-edu/pki-service/ent/certificate"
+)
+
+// AcmeOrderCreate is the builder for creating a AcmeOrder entity.
+type AcmeOrderCreate struct {
+	config
+	mutation *AcmeOrderMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *AcmeOrderCreate) SetCreateTime(v time.Time) *AcmeOrderCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *AcmeOrderCreate) SetNillableCreateTime(v *time.Time) *AcmeOrderCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *AcmeOrderCreate) SetUpdateTime(v time.Time) *AcmeOrderCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *AcmeOrderCreate) SetNillableUpdateTime(v *time.Time) *AcmeOrderCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetIdentifiers sets the "identifiers" field.
+func (_c *AcmeOrderCreate) SetIdentifiers(v string) *AcmeOrderCreate {
+	_c.mutation.SetIdentifiers(v)
+	return _c
+}
+
+// SetRegisteredDomains sets the "registeredDomains" field.
+func (_c *AcmeOrderCreate) SetRegisteredDomains(v []string) *AcmeOrderCreate {
+	_c.mutation.SetRegisteredDomains(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *AcmeOrderCreate) SetStatus(v acmeorder.Status) *AcmeOrderCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *AcmeOrderCreate) SetNillableStatus(v *acmeorder.Status) *AcmeOrderCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetRetryAfter sets the "retryAfter" field.
+func (_c *AcmeOrderCreate) SetRetryAfter(v time.Time) *AcmeOrderCreate {
+	_c.mutation.SetRetryAfter(v)
+	return _c
+}
+
+// SetNillableRetryAfter sets the "retryAfter" field if the given value is not nil.
+func (_c *AcmeOrderCreate) SetNillableRetryAfter(v *time.Time) *AcmeOrderCreate {
+	if v != nil {
+		_c.SetRetryAfter(*v)
+	}
+	return _c
+}
+
+// SetError sets the "error" field.
+func (_c *AcmeOrderCreate) SetError(v string) *AcmeOrderCreate {
+	_c.mutation.SetError(v)
+	return _c
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_c *AcmeOrderCreate) SetNillableError(v *string) *AcmeOrderCreate {
+	if v != nil {
+		_c.SetError(*v)
+	}
+	return _c
+}
+
+// SetCertificateID sets the "certificate" edge to the Certificate entity by ID.
+func (_c *AcmeOrderCreate) SetCertificateID(id int) *AcmeOrderCreate {
+	_c.mutation.SetCertificateID(id)
+	return _c
+}
+
+// SetNillableCertificateID sets the "certificate" edge to the Certificate entity by ID if the given value is not nil.
+func (_c *AcmeOrderCreate) SetNillableCertificateID(id *int) *AcmeOrderCreate {
+	if id != nil {
+		_c = _c.SetCertificateID(*id)
+	}
+	return _c
+}
+
+// SetCertificate sets the "certificate" edge to the Certificate entity.
+func (_c *AcmeOrderCreate) SetCertificate(v *Certificate) *AcmeOrderCreate {
+	return _c.SetCertificateID(v.ID)
+}
+
+// Mutation returns the AcmeOrderMutation object of the builder.
+func (_c *AcmeOrderCreate) Mutation() *AcmeOrderMutation {
+	return _c.mutation
+}
+
+// Save creates the AcmeOrder in the database.
+func (_c *AcmeOrderCreate) Save(ctx context.Context) (*AcmeOrder, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AcmeOrderCreate) SaveX(ctx context.Context) *AcmeOrder {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AcmeOrderCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AcmeOrderCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AcmeOrderCreate) defaults() {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		v := acmeorder.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		v := acmeorder.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := acmeorder.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AcmeOrderCreate) check() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "AcmeOrder.create_time"`)}
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "AcmeOrder.update_time"`)}
+	}
+	if _, ok := _c.mutation.Identifiers(); !ok {
+		return &ValidationError{Name: "identifiers", err: errors.New(`ent: missing required field "AcmeOrder.identifiers"`)}
+	}
+	if v, ok := _c.mutation.Identifiers(); ok {
+		if err := acmeorder.IdentifiersValidator(v); err != nil {
+			return &ValidationError{Name: "identifiers", err: fmt.Errorf(`ent: validator failed for field "AcmeOrder.identifiers": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "AcmeOrder.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := acmeorder.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "AcmeOrder.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *AcmeOrderCreate) sqlSave(ctx context.Context) (*AcmeOrder, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AcmeOrderCreate) createSpec() (*AcmeOrder, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AcmeOrder{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(acmeorder.Table, sqlgraph.NewFieldSpec(acmeorder.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(acmeorder.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(acmeorder.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.Identifiers(); ok {
+		_spec.SetField(acmeorder.FieldIdentifiers, field.TypeString, value)
+		_node.Identifiers = value
+	}
+	if value, ok := _c.mutation.RegisteredDomains(); ok {
+		_spec.SetField(acmeorder.FieldRegisteredDomains, field.TypeJSON, value)
+		_node.RegisteredDomains = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(acmeorder.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.RetryAfter(); ok {
+		_spec.SetField(acmeorder.FieldRetryAfter, field.TypeTime, value)
+		_node.RetryAfter = &value
+	}
+	if value, ok := _c.mutation.Error(); ok {
+		_spec.SetField(acmeorder.FieldError, field.TypeString, value)
+		_node.Error = &value
+	}
+	if nodes := _c.mutation.CertificateIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   acmeorder.CertificateTable,
+			Columns: []string{acmeorder.CertificateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(certificate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.certificate_acme_orders = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AcmeOrder.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AcmeOrderUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AcmeOrderCreate) OnConflict(opts ...sql.ConflictOption) *AcmeOrderUpsertOne {
+	_c.conflict = opts
+	return &AcmeOrderUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AcmeOrder.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AcmeOrderCreate) OnConflictColumns(columns ...string) *AcmeOrderUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AcmeOrderUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// AcmeOrderUpsertOne is the builder for "upsert"-ing
+	//  one AcmeOrder node.
+	AcmeOrderUpsertOne struct {
+		create *AcmeOrderCreate
+	}
+
+	// AcmeOrderUpsert is the "OnConflict" setter.
+	AcmeOrderUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *AcmeOrderUpsert) SetUpdateTime(v time.Time) *AcmeOrderUpsert {
+	u.Set(acmeorder.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *AcmeOrderUpsert) UpdateUpdateTime() *AcmeOrderUpsert {
+	u.SetExcluded(acmeorder.FieldUpdateTime)
+	return u
+}
+
+// SetIdentifiers sets the "identifiers" field.
+func (u *AcmeOrderUpsert) SetIdentifiers(v string) *AcmeOrderUpsert {
+	u.Set(acmeorder.FieldIdentifiers, v)
+	return u
+}
+
+// UpdateIdentifiers sets the "identifiers" field to the value that was provided on create.
+func (u *AcmeOrderUpsert) UpdateIdentifiers() *AcmeOrderUpsert {
+	u.SetExcluded(acmeorder.FieldIdentifiers)
+	return u
+}
+
+// SetRegisteredDomains sets the "registeredDomains" field.
+func (u *AcmeOrderUpsert) SetRegisteredDomains(v []string) *AcmeOrderUpsert {
+	u.Set(acmeorder.FieldRegisteredDomains, v)
+	return u
+}
+
+// UpdateRegisteredDomains sets the "registeredDomains" field to the value that was provided on create.
+func (u *AcmeOrderUpsert) UpdateRegisteredDomains() *AcmeOrderUpsert {
+	u.SetExcluded(acmeorder.FieldRegisteredDomains)
+	return u
+}
+
+// ClearRegisteredDomains clears the value of the "registeredDomains" field.
+func (u *AcmeOrderUpsert) ClearRegisteredDomains() *AcmeOrderUpsert {
+	u.SetNull(acmeorder.FieldRegisteredDomains)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *AcmeOrderUpsert) SetStatus(v acmeorder.Status) *AcmeOrderUpsert {
+	u.Set(acmeorder.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *AcmeOrderUpsert) UpdateStatus() *AcmeOrderUpsert {
+	u.SetExcluded(acmeorder.FieldStatus)
+	return u
+}
+
+// SetRetryAfter sets the "retryAfter" field.
+func (u *AcmeOrderUpsert) SetRetryAfter(v time.Time) *AcmeOrderUpsert {
+	u.Set(acmeorder.FieldRetryAfter, v)
+	return u
+}
+
+// UpdateRetryAfter sets the "retryAfter" field to the value that was provided on create.
+func (u *AcmeOrderUpsert) UpdateRetryAfter() *AcmeOrderUpsert {
+	u.SetExcluded(acmeorder.FieldRetryAfter)
+	return u
+}
+
+// ClearRetryAfter clears the value of the "retryAfter" field.
+func (u *AcmeOrderUpsert) ClearRetryAfter() *AcmeOrderUpsert {
+	u.SetNull(acmeorder.FieldRetryAfter)
+	return u
+}
+
+// SetError sets the "error" field.
+func (u *AcmeOrderUpsert) SetError(v string) *AcmeOrderUpsert {
+	u.Set(acmeorder.FieldError, v)
+	return u
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *AcmeOrderUpsert) UpdateError() *AcmeOrderUpsert {
+	u.SetExcluded(acmeorder.FieldError)
+	return u
+}
+
+// ClearError clears the value of the "error" field.
+func (u *AcmeOrderUpsert) ClearError() *AcmeOrderUpsert {
+	u.SetNull(acmeorder.FieldError)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.AcmeOrder.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *AcmeOrderUpsertOne) UpdateNewValues() *AcmeOrderUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(acmeorder.FieldCreateTime)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AcmeOrder.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AcmeOrderUpsertOne) Ignore() *AcmeOrderUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AcmeOrderUpsertOne) DoNothing() *AcmeOrderUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AcmeOrderCreate.OnConflict
+// documentation for more info.
+func (u *AcmeOrderUpsertOne) Update(set func(*AcmeOrderUpsert)) *AcmeOrderUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AcmeOrderUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *AcmeOrderUpsertOne) SetUpdateTime(v time.Time) *AcmeOrderUpsertOne {
+	return u.Update(func(s *AcmeOrderUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *AcmeOrderUpsertOne) UpdateUpdateTime() *AcmeOrderUpsertOne {
+	return u.Update(func(s *AcmeOrderUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetIdentifiers sets the "identifiers" field.
+func (u *AcmeOrderUpsertOne) SetIdentifiers(v string) *AcmeOrderUpsertOne {
+	return u.Update(func(s *AcmeOrderUpsert) {
+		s.SetIdentifiers(v)
+	})
+}
+
+// UpdateIdentifiers sets the "identifiers" field to the value that was provided on create.
+func (u *AcmeOrderUpsertOne) UpdateIdentifiers() *AcmeOrderUpsertOne {
+	return u.Update(func(s *AcmeOrderUpsert) {
+		s.UpdateIdentifiers()
+	})
+}
+
+// SetRegisteredDomains sets the "registeredDomains" field.
+func (u *AcmeOrderUpsertOne) SetRegisteredDomains(v []string) *AcmeOrderUpsertOne {
+	return u.Update(func(s *AcmeOrderUpsert) {
+		s.SetRegisteredDomains(v)
+	})
+}
+
+// UpdateRegisteredDomains sets the "registeredDomains" field to the value that was provided on create.
+func (u *AcmeOrderUpsertOne) UpdateRegisteredDomains() *AcmeOrderUpsertOne {
+	return u.Update(func(s *AcmeOrderUpsert) {
+		s.UpdateRegisteredDomains()
+	})
+}
+
+// ClearRegisteredDomains clears the value of the "registeredDomains" field.
+func (u *AcmeOrderUpsertOne) ClearRegisteredDomains() *AcmeOrderUpsertOne {
+	return u.Update(func(s *AcmeOrderUpsert) {
+		s.ClearRegisteredDomains()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *AcmeOrderUpsertOne) SetStatus(v acmeorder.Status) *AcmeOrderUpsertOne {
+	return u.Update(func(s *AcmeOrderUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *AcmeOrderUpsertOne) UpdateStatus() *AcmeOrderUpsertOne {
+	return u.Update(func(s *AcmeOrderUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetRetryAfter sets the "retryAfter" field.
+func (u *AcmeOrderUpsertOne) SetRetryAfter(v time.Time) *AcmeOrderUpsertOne {
+	return u.Update(func(s *AcmeOrderUpsert) {
+		s.SetRetryAfter(v)
+	})
+}
+
+// UpdateRetryAfter sets the "retryAfter" field to the value that was provided on create.
+func (u *AcmeOrderUpsertOne) UpdateRetryAfter() *AcmeOrderUpsertOne {
+	return u.Update(func(s *AcmeOrderUpsert) {
+		s.UpdateRetryAfter()
+	})
+}
+
+// ClearRetryAfter clears the value of the "retryAfter" field.
+func (u *AcmeOrderUpsertOne) ClearRetryAfter() *AcmeOrderUpsertOne {
+	return u.Update(func(s *AcmeOrderUpsert) {
+		s.ClearRetryAfter()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *AcmeOrderUpsertOne) SetError(v string) *AcmeOrderUpsertOne {
+	return u.Update(func(s *AcmeOrderUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *AcmeOrderUpsertOne) UpdateError() *AcmeOrderUpsertOne {
+	return u.Update(func(s *AcmeOrderUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *AcmeOrderUpsertOne) ClearError() *AcmeOrderUpsertOne {
+	return u.Update(func(s *AcmeOrderUpsert) {
+		s.ClearError()
+	})
+}
+
+// Exec executes the query.
+func (u *AcmeOrderUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AcmeOrderCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AcmeOrderUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AcmeOrderUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AcmeOrderUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// AcmeOrderCreateBulk is the builder for creating many AcmeOrder entities in bulk.
+type AcmeOrderCreateBulk struct {
+	config
+	err      error
+	builders []*AcmeOrderCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the AcmeOrder entities in the database.
+func (_c *AcmeOrderCreateBulk) Save(ctx context.Context) ([]*AcmeOrder, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AcmeOrder, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AcmeOrderMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AcmeOrderCreateBulk) SaveX(ctx context.Context) []*AcmeOrder {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AcmeOrderCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AcmeOrderCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AcmeOrder.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AcmeOrderUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AcmeOrderCreateBulk) OnConflict(opts ...sql.ConflictOption) *AcmeOrderUpsertBulk {
+	_c.conflict = opts
+	return &AcmeOrderUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AcmeOrder.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AcmeOrderCreateBulk) OnConflictColumns(columns ...string) *AcmeOrderUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AcmeOrderUpsertBulk{
+		create: _c,
+	}
+}
+
+// AcmeOrderUpsertBulk is the builder for "upsert"-ing
+// a bulk of AcmeOrder nodes.
+type AcmeOrderUpsertBulk struct {
+	create *AcmeOrderCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.AcmeOrder.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *AcmeOrderUpsertBulk) UpdateNewValues() *AcmeOrderUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(acmeorder.FieldCreateTime)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AcmeOrder.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AcmeOrderUpsertBulk) Ignore() *AcmeOrderUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AcmeOrderUpsertBulk) DoNothing() *AcmeOrderUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AcmeOrderCreateBulk.OnConflict
+// documentation for more info.
+func (u *AcmeOrderUpsertBulk) Update(set func(*AcmeOrderUpsert)) *AcmeOrderUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AcmeOrderUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *AcmeOrderUpsertBulk) SetUpdateTime(v time.Time) *AcmeOrderUpsertBulk {
+	return u.Update(func(s *AcmeOrderUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *AcmeOrderUpsertBulk) UpdateUpdateTime() *AcmeOrderUpsertBulk {
+	return u.Update(func(s *AcmeOrderUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetIdentifiers sets the "identifiers" field.
+func (u *AcmeOrderUpsertBulk) SetIdentifiers(v string) *AcmeOrderUpsertBulk {
+	return u.Update(func(s *AcmeOrderUpsert) {
+		s.SetIdentifiers(v)
+	})
+}
+
+// UpdateIdentifiers sets the "identifiers" field to the value that was provided on create.
+func (u *AcmeOrderUpsertBulk) UpdateIdentifiers() *AcmeOrderUpsertBulk {
+	return u.Update(func(s *AcmeOrderUpsert) {
+		s.UpdateIdentifiers()
+	})
+}
+
+// SetRegisteredDomains sets the "registeredDomains" field.
+func (u *AcmeOrderUpsertBulk) SetRegisteredDomains(v []string) *AcmeOrderUpsertBulk {
+	return u.Update(func(s *AcmeOrderUpsert) {
+		s.SetRegisteredDomains(v)
+	})
+}
+
+// UpdateRegisteredDomains sets the "registeredDomains" field to the value that was provided on create.
+func (u *AcmeOrderUpsertBulk) UpdateRegisteredDomains() *AcmeOrderUpsertBulk {
+	return u.Update(func(s *AcmeOrderUpsert) {
+		s.UpdateRegisteredDomains()
+	})
+}
+
+// ClearRegisteredDomains clears the value of the "registeredDomains" field.
+func (u *AcmeOrderUpsertBulk) ClearRegisteredDomains() *AcmeOrderUpsertBulk {
+	return u.Update(func(s *AcmeOrderUpsert) {
+		s.ClearRegisteredDomains()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *AcmeOrderUpsertBulk) SetStatus(v acmeorder.Status) *AcmeOrderUpsertBulk {
+	return u.Update(func(s *AcmeOrderUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *AcmeOrderUpsertBulk) UpdateStatus() *AcmeOrderUpsertBulk {
+	return u.Update(func(s *AcmeOrderUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetRetryAfter sets the "retryAfter" field.
+func (u *AcmeOrderUpsertBulk) SetRetryAfter(v time.Time) *AcmeOrderUpsertBulk {
+	return u.Update(func(s *AcmeOrderUpsert) {
+		s.SetRetryAfter(v)
+	})
+}
+
+// UpdateRetryAfter sets the "retryAfter" field to the value that was provided on create.
+func (u *AcmeOrderUpsertBulk) UpdateRetryAfter() *AcmeOrderUpsertBulk {
+	return u.Update(func(s *AcmeOrderUpsert) {
+		s.UpdateRetryAfter()
+	})
+}
+
+// ClearRetryAfter clears the value of the "retryAfter" field.
+func (u *AcmeOrderUpsertBulk) ClearRetryAfter() *AcmeOrderUpsertBulk {
+	return u.Update(func(s *AcmeOrderUpsert) {
+		s.ClearRetryAfter()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *AcmeOrderUpsertBulk) SetError(v string) *AcmeOrderUpsertBulk {
+	return u.Update(func(s *AcmeOrderUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *AcmeOrderUpsertBulk) UpdateError() *AcmeOrderUpsertBulk {
+	return u.Update(func(s *AcmeOrderUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *AcmeOrderUpsertBulk) ClearError() *AcmeOrderUpsertBulk {
+	return u.Update(func(s *AcmeOrderUpsert) {
+		s.ClearError()
+	})
+}
+
+// Exec executes the query.
+func (u *AcmeOrderUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the AcmeOrderCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AcmeOrderCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AcmeOrderUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hm-edu/pki-service/ent/acmeorder"
+	"github.com/hm-edu/pki-service/ent/predicate"
+)
+
+// AcmeOrderDelete is the builder for deleting a AcmeOrder entity.
+type AcmeOrderDelete struct {
+	config
+	hooks    []Hook
+	mutation *AcmeOrderMutation
+}
+
+// Where appends a list predicates to the AcmeOrderDelete builder.
+func (_d *AcmeOrderDelete) Where(ps ...predicate.AcmeOrder) *AcmeOrderDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *AcmeOrderDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *AcmeOrderDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *AcmeOrderDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(acmeorder.Table, sqlgraph.NewFieldSpec(acmeorder.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// AcmeOrderDeleteOne is the builder for deleting a single AcmeOrder entity.
+type AcmeOrderDeleteOne struct {
+	_d *AcmeOrderDelete
+}
+
+// Where appends a list predicates to the AcmeOrderDelete builder.
+func (_d *AcmeOrderDeleteOne) Where(ps ...predicate.AcmeOrder) *AcmeOrderDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *AcmeOrderDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{acmeorder.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *AcmeOrderDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
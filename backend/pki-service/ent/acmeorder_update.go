@@ -0,0 +1,588 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/hm-edu/pki-service/ent/acmeorder"
+	"github.com/hm-edu/pki-service/ent/certificate"
+	"github.com/hm-edu/pki-service/ent/predicate"
+)
+
+// AcmeOrderUpdate is the builder for updating AcmeOrder entities.
+type AcmeOrderUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AcmeOrderMutation
+}
+
+// Where appends a list predicates to the AcmeOrderUpdate builder.
+func (_u *AcmeOrderUpdate) Where(ps ...predicate.AcmeOrder) *AcmeOrderUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *AcmeOrderUpdate) SetUpdateTime(v time.Time) *AcmeOrderUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetIdentifiers sets the "identifiers" field.
+func (_u *AcmeOrderUpdate) SetIdentifiers(v string) *AcmeOrderUpdate {
+	_u.mutation.SetIdentifiers(v)
+	return _u
+}
+
+// SetNillableIdentifiers sets the "identifiers" field if the given value is not nil.
+func (_u *AcmeOrderUpdate) SetNillableIdentifiers(v *string) *AcmeOrderUpdate {
+	if v != nil {
+		_u.SetIdentifiers(*v)
+	}
+	return _u
+}
+
+// SetRegisteredDomains sets the "registeredDomains" field.
+func (_u *AcmeOrderUpdate) SetRegisteredDomains(v []string) *AcmeOrderUpdate {
+	_u.mutation.SetRegisteredDomains(v)
+	return _u
+}
+
+// AppendRegisteredDomains appends value to the "registeredDomains" field.
+func (_u *AcmeOrderUpdate) AppendRegisteredDomains(v []string) *AcmeOrderUpdate {
+	_u.mutation.AppendRegisteredDomains(v)
+	return _u
+}
+
+// ClearRegisteredDomains clears the value of the "registeredDomains" field.
+func (_u *AcmeOrderUpdate) ClearRegisteredDomains() *AcmeOrderUpdate {
+	_u.mutation.ClearRegisteredDomains()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *AcmeOrderUpdate) SetStatus(v acmeorder.Status) *AcmeOrderUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *AcmeOrderUpdate) SetNillableStatus(v *acmeorder.Status) *AcmeOrderUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetRetryAfter sets the "retryAfter" field.
+func (_u *AcmeOrderUpdate) SetRetryAfter(v time.Time) *AcmeOrderUpdate {
+	_u.mutation.SetRetryAfter(v)
+	return _u
+}
+
+// SetNillableRetryAfter sets the "retryAfter" field if the given value is not nil.
+func (_u *AcmeOrderUpdate) SetNillableRetryAfter(v *time.Time) *AcmeOrderUpdate {
+	if v != nil {
+		_u.SetRetryAfter(*v)
+	}
+	return _u
+}
+
+// ClearRetryAfter clears the value of the "retryAfter" field.
+func (_u *AcmeOrderUpdate) ClearRetryAfter() *AcmeOrderUpdate {
+	_u.mutation.ClearRetryAfter()
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *AcmeOrderUpdate) SetError(v string) *AcmeOrderUpdate {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *AcmeOrderUpdate) SetNillableError(v *string) *AcmeOrderUpdate {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *AcmeOrderUpdate) ClearError() *AcmeOrderUpdate {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetCertificateID sets the "certificate" edge to the Certificate entity by ID.
+func (_u *AcmeOrderUpdate) SetCertificateID(id int) *AcmeOrderUpdate {
+	_u.mutation.SetCertificateID(id)
+	return _u
+}
+
+// SetNillableCertificateID sets the "certificate" edge to the Certificate entity by ID if the given value is not nil.
+func (_u *AcmeOrderUpdate) SetNillableCertificateID(id *int) *AcmeOrderUpdate {
+	if id != nil {
+		_u = _u.SetCertificateID(*id)
+	}
+	return _u
+}
+
+// SetCertificate sets the "certificate" edge to the Certificate entity.
+func (_u *AcmeOrderUpdate) SetCertificate(v *Certificate) *AcmeOrderUpdate {
+	return _u.SetCertificateID(v.ID)
+}
+
+// Mutation returns the AcmeOrderMutation object of the builder.
+func (_u *AcmeOrderUpdate) Mutation() *AcmeOrderMutation {
+	return _u.mutation
+}
+
+// ClearCertificate clears the "certificate" edge to the Certificate entity.
+func (_u *AcmeOrderUpdate) ClearCertificate() *AcmeOrderUpdate {
+	_u.mutation.ClearCertificate()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AcmeOrderUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AcmeOrderUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AcmeOrderUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AcmeOrderUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AcmeOrderUpdate) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := acmeorder.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AcmeOrderUpdate) check() error {
+	if v, ok := _u.mutation.Identifiers(); ok {
+		if err := acmeorder.IdentifiersValidator(v); err != nil {
+			return &ValidationError{Name: "identifiers", err: fmt.Errorf(`ent: validator failed for field "AcmeOrder.identifiers": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := acmeorder.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "AcmeOrder.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *AcmeOrderUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(acmeorder.Table, acmeorder.Columns, sqlgraph.NewFieldSpec(acmeorder.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(acmeorder.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Identifiers(); ok {
+		_spec.SetField(acmeorder.FieldIdentifiers, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RegisteredDomains(); ok {
+		_spec.SetField(acmeorder.FieldRegisteredDomains, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRegisteredDomains(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, acmeorder.FieldRegisteredDomains, value)
+		})
+	}
+	if _u.mutation.RegisteredDomainsCleared() {
+		_spec.ClearField(acmeorder.FieldRegisteredDomains, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(acmeorder.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.RetryAfter(); ok {
+		_spec.SetField(acmeorder.FieldRetryAfter, field.TypeTime, value)
+	}
+	if _u.mutation.RetryAfterCleared() {
+		_spec.ClearField(acmeorder.FieldRetryAfter, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(acmeorder.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(acmeorder.FieldError, field.TypeString)
+	}
+	if _u.mutation.CertificateCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   acmeorder.CertificateTable,
+			Columns: []string{acmeorder.CertificateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(certificate.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CertificateIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   acmeorder.CertificateTable,
+			Columns: []string{acmeorder.CertificateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(certificate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{acmeorder.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AcmeOrderUpdateOne is the builder for updating a single AcmeOrder entity.
+type AcmeOrderUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AcmeOrderMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *AcmeOrderUpdateOne) SetUpdateTime(v time.Time) *AcmeOrderUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetIdentifiers sets the "identifiers" field.
+func (_u *AcmeOrderUpdateOne) SetIdentifiers(v string) *AcmeOrderUpdateOne {
+	_u.mutation.SetIdentifiers(v)
+	return _u
+}
+
+// SetNillableIdentifiers sets the "identifiers" field if the given value is not nil.
+func (_u *AcmeOrderUpdateOne) SetNillableIdentifiers(v *string) *AcmeOrderUpdateOne {
+	if v != nil {
+		_u.SetIdentifiers(*v)
+	}
+	return _u
+}
+
+// SetRegisteredDomains sets the "registeredDomains" field.
+func (_u *AcmeOrderUpdateOne) SetRegisteredDomains(v []string) *AcmeOrderUpdateOne {
+	_u.mutation.SetRegisteredDomains(v)
+	return _u
+}
+
+// AppendRegisteredDomains appends value to the "registeredDomains" field.
+func (_u *AcmeOrderUpdateOne) AppendRegisteredDomains(v []string) *AcmeOrderUpdateOne {
+	_u.mutation.AppendRegisteredDomains(v)
+	return _u
+}
+
+// ClearRegisteredDomains clears the value of the "registeredDomains" field.
+func (_u *AcmeOrderUpdateOne) ClearRegisteredDomains() *AcmeOrderUpdateOne {
+	_u.mutation.ClearRegisteredDomains()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *AcmeOrderUpdateOne) SetStatus(v acmeorder.Status) *AcmeOrderUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *AcmeOrderUpdateOne) SetNillableStatus(v *acmeorder.Status) *AcmeOrderUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetRetryAfter sets the "retryAfter" field.
+func (_u *AcmeOrderUpdateOne) SetRetryAfter(v time.Time) *AcmeOrderUpdateOne {
+	_u.mutation.SetRetryAfter(v)
+	return _u
+}
+
+// SetNillableRetryAfter sets the "retryAfter" field if the given value is not nil.
+func (_u *AcmeOrderUpdateOne) SetNillableRetryAfter(v *time.Time) *AcmeOrderUpdateOne {
+	if v != nil {
+		_u.SetRetryAfter(*v)
+	}
+	return _u
+}
+
+// ClearRetryAfter clears the value of the "retryAfter" field.
+func (_u *AcmeOrderUpdateOne) ClearRetryAfter() *AcmeOrderUpdateOne {
+	_u.mutation.ClearRetryAfter()
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *AcmeOrderUpdateOne) SetError(v string) *AcmeOrderUpdateOne {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *AcmeOrderUpdateOne) SetNillableError(v *string) *AcmeOrderUpdateOne {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *AcmeOrderUpdateOne) ClearError() *AcmeOrderUpdateOne {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetCertificateID sets the "certificate" edge to the Certificate entity by ID.
+func (_u *AcmeOrderUpdateOne) SetCertificateID(id int) *AcmeOrderUpdateOne {
+	_u.mutation.SetCertificateID(id)
+	return _u
+}
+
+// SetNillableCertificateID sets the "certificate" edge to the Certificate entity by ID if the given value is not nil.
+func (_u *AcmeOrderUpdateOne) SetNillableCertificateID(id *int) *AcmeOrderUpdateOne {
+	if id != nil {
+		_u = _u.SetCertificateID(*id)
+	}
+	return _u
+}
+
+// SetCertificate sets the "certificate" edge to the Certificate entity.
+func (_u *AcmeOrderUpdateOne) SetCertificate(v *Certificate) *AcmeOrderUpdateOne {
+	return _u.SetCertificateID(v.ID)
+}
+
+// Mutation returns the AcmeOrderMutation object of the builder.
+func (_u *AcmeOrderUpdateOne) Mutation() *AcmeOrderMutation {
+	return _u.mutation
+}
+
+// ClearCertificate clears the "certificate" edge to the Certificate entity.
+func (_u *AcmeOrderUpdateOne) ClearCertificate() *AcmeOrderUpdateOne {
+	_u.mutation.ClearCertificate()
+	return _u
+}
+
+// Where appends a list predicates to the AcmeOrderUpdate builder.
+func (_u *AcmeOrderUpdateOne) Where(ps ...predicate.AcmeOrder) *AcmeOrderUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AcmeOrderUpdateOne) Select(field string, fields ...string) *AcmeOrderUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AcmeOrder entity.
+func (_u *AcmeOrderUpdateOne) Save(ctx context.Context) (*AcmeOrder, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AcmeOrderUpdateOne) SaveX(ctx context.Context) *AcmeOrder {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AcmeOrderUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AcmeOrderUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AcmeOrderUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := acmeorder.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AcmeOrderUpdateOne) check() error {
+	if v, ok := _u.mutation.Identifiers(); ok {
+		if err := acmeorder.IdentifiersValidator(v); err != nil {
+			return &ValidationError{Name: "identifiers", err: fmt.Errorf(`ent: validator failed for field "AcmeOrder.identifiers": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := acmeorder.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "AcmeOrder.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *AcmeOrderUpdateOne) sqlSave(ctx context.Context) (_node *AcmeOrder, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(acmeorder.Table, acmeorder.Columns, sqlgraph.NewFieldSpec(acmeorder.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AcmeOrder.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, acmeorder.FieldID)
+		for _, f := range fields {
+			if !acmeorder.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != acmeorder.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(acmeorder.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Identifiers(); ok {
+		_spec.SetField(acmeorder.FieldIdentifiers, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RegisteredDomains(); ok {
+		_spec.SetField(acmeorder.FieldRegisteredDomains, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRegisteredDomains(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, acmeorder.FieldRegisteredDomains, value)
+		})
+	}
+	if _u.mutation.RegisteredDomainsCleared() {
+		_spec.ClearField(acmeorder.FieldRegisteredDomains, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(acmeorder.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.RetryAfter(); ok {
+		_spec.SetField(acmeorder.FieldRetryAfter, field.TypeTime, value)
+	}
+	if _u.mutation.RetryAfterCleared() {
+		_spec.ClearField(acmeorder.FieldRetryAfter, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(acmeorder.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(acmeorder.FieldError, field.TypeString)
+	}
+	if _u.mutation.CertificateCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   acmeorder.CertificateTable,
+			Columns: []string{acmeorder.CertificateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(certificate.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CertificateIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   acmeorder.CertificateTable,
+			Columns: []string{acmeorder.CertificateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(certificate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &AcmeOrder{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{acmeorder.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
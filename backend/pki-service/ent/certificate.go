@@ -45,6 +45,14 @@ type Certificate struct {
 	Ca *string `json:"ca,omitempty"`
 	// Certificate holds the value of the "certificate" field.
 	Certificate *string `json:"certificate,omitempty"`
+	// CaReason holds the value of the "caReason" field.
+	CaReason *string `json:"caReason,omitempty"`
+	// Csr holds the value of the "csr" field.
+	Csr *string `json:"csr,omitempty"`
+	// Organization holds the value of the "organization" field.
+	Organization *string `json:"organization,omitempty"`
+	// CertType holds the value of the "certType" field.
+	CertType *string `json:"certType,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the CertificateQuery when eager-loading is set.
 	Edges        CertificateEdges `json:"edges"`
@@ -55,9 +63,11 @@ type Certificate struct {
 type CertificateEdges struct {
 	// Domains holds the value of the domains edge.
 	Domains []*Domain `json:"domains,omitempty"`
+	// AcmeOrders holds the value of the acmeOrders edge.
+	AcmeOrders []*AcmeOrder `json:"acmeOrders,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // DomainsOrErr returns the Domains value or an error if the edge
@@ -69,6 +79,15 @@ func (e CertificateEdges) DomainsOrErr() ([]*Domain, error) {
 	return nil, &NotLoadedError{edge: "domains"}
 }
 
+// AcmeOrdersOrErr returns the AcmeOrders value or an error if the edge
+// was not loaded in eager-loading.
+func (e CertificateEdges) AcmeOrdersOrErr() ([]*AcmeOrder, error) {
+	if e.loadedTypes[1] {
+		return e.AcmeOrders, nil
+	}
+	return nil, &NotLoadedError{edge: "acmeOrders"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Certificate) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -76,7 +95,7 @@ func (*Certificate) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case certificate.FieldID, certificate.FieldSslId:
 			values[i] = new(sql.NullInt64)
-		case certificate.FieldTransactionId, certificate.FieldSerial, certificate.FieldCommonName, certificate.FieldIssuedBy, certificate.FieldSource, certificate.FieldStatus, certificate.FieldCa, certificate.FieldCertificate:
+		case certificate.FieldTransactionId, certificate.FieldSerial, certificate.FieldCommonName, certificate.FieldIssuedBy, certificate.FieldSource, certificate.FieldStatus, certificate.FieldCa, certificate.FieldCertificate, certificate.FieldCaReason, certificate.FieldCsr, certificate.FieldOrganization, certificate.FieldCertType:
 			values[i] = new(sql.NullString)
 		case certificate.FieldCreateTime, certificate.FieldUpdateTime, certificate.FieldNotBefore, certificate.FieldNotAfter, certificate.FieldCreated:
 			values[i] = new(sql.NullTime)
@@ -191,6 +210,34 @@ func (_m *Certificate) assignValues(columns []string, values []any) error {
 				_m.Certificate = new(string)
 				*_m.Certificate = value.String
 			}
+		case certificate.FieldCaReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field caReason", values[i])
+			} else if value.Valid {
+				_m.CaReason = new(string)
+				*_m.CaReason = value.String
+			}
+		case certificate.FieldCsr:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field csr", values[i])
+			} else if value.Valid {
+				_m.Csr = new(string)
+				*_m.Csr = value.String
+			}
+		case certificate.FieldOrganization:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field organization", values[i])
+			} else if value.Valid {
+				_m.Organization = new(string)
+				*_m.Organization = value.String
+			}
+		case certificate.FieldCertType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field certType", values[i])
+			} else if value.Valid {
+				_m.CertType = new(string)
+				*_m.CertType = value.String
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -209,6 +256,11 @@ func (_m *Certificate) QueryDomains() *DomainQuery {
 	return NewCertificateClient(_m.config).QueryDomains(_m)
 }
 
+// QueryAcmeOrders queries the "acmeOrders" edge of the Certificate entity.
+func (_m *Certificate) QueryAcmeOrders() *AcmeOrderQuery {
+	return NewCertificateClient(_m.config).QueryAcmeOrders(_m)
+}
+
 // Update returns a builder for updating this Certificate.
 // Note that you need to call Certificate.Unwrap() before calling this method if this Certificate
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -285,6 +337,26 @@ func (_m *Certificate) String() string {
 		builder.WriteString("certificate=")
 		builder.WriteString(*v)
 	}
+	builder.WriteString(", ")
+	if v := _m.CaReason; v != nil {
+		builder.WriteString("caReason=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.Csr; v != nil {
+		builder.WriteString("csr=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.Organization; v != nil {
+		builder.WriteString("organization=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.CertType; v != nil {
+		builder.WriteString("certType=")
+		builder.WriteString(*v)
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
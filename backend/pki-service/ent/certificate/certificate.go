@@ -44,8 +44,18 @@ const (
 	FieldCa = "ca"
 	// FieldCertificate holds the string denoting the certificate field in the database.
 	FieldCertificate = "certificate"
+	// FieldCaReason holds the string denoting the careason field in the database.
+	FieldCaReason = "ca_reason"
+	// FieldCsr holds the string denoting the csr field in the database.
+	FieldCsr = "csr"
+	// FieldOrganization holds the string denoting the organization field in the database.
+	FieldOrganization = "organization"
+	// FieldCertType holds the string denoting the certtype field in the database.
+	FieldCertType = "cert_type"
 	// EdgeDomains holds the string denoting the domains edge name in mutations.
 	EdgeDomains = "domains"
+	// EdgeAcmeOrders holds the string denoting the acmeorders edge name in mutations.
+	EdgeAcmeOrders = "acmeOrders"
 	// Table holds the table name of the certificate in the database.
 	Table = "certificates"
 	// DomainsTable is the table that holds the domains relation/edge. The primary key declared below.
@@ -53,6 +63,13 @@ const (
 	// DomainsInverseTable is the table name for the Domain entity.
 	// It exists in this package in order to avoid circular dependency with the "domain" package.
 	DomainsInverseTable = "domains"
+	// AcmeOrdersTable is the table that holds the acmeOrders relation/edge.
+	AcmeOrdersTable = "acme_orders"
+	// AcmeOrdersInverseTable is the table name for the AcmeOrder entity.
+	// It exists in this package in order to avoid circular dependency with the "acmeorder" package.
+	AcmeOrdersInverseTable = "acme_orders"
+	// AcmeOrdersColumn is the table column denoting the acmeOrders relation/edge.
+	AcmeOrdersColumn = "certificate_acme_orders"
 )
 
 // Columns holds all SQL columns for certificate fields.
@@ -72,6 +89,10 @@ var Columns = []string{
 	FieldStatus,
 	FieldCa,
 	FieldCertificate,
+	FieldCaReason,
+	FieldCsr,
+	FieldOrganization,
+	FieldCertType,
 }
 
 var (
@@ -222,6 +243,26 @@ func ByCertificate(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCertificate, opts...).ToFunc()
 }
 
+// ByCaReason orders the results by the caReason field.
+func ByCaReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCaReason, opts...).ToFunc()
+}
+
+// ByCsr orders the results by the csr field.
+func ByCsr(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCsr, opts...).ToFunc()
+}
+
+// ByOrganization orders the results by the organization field.
+func ByOrganization(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrganization, opts...).ToFunc()
+}
+
+// ByCertType orders the results by the certType field.
+func ByCertType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCertType, opts...).ToFunc()
+}
+
 // ByDomainsCount orders the results by domains count.
 func ByDomainsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -235,6 +276,20 @@ func ByDomains(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newDomainsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByAcmeOrdersCount orders the results by acmeOrders count.
+func ByAcmeOrdersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAcmeOrdersStep(), opts...)
+	}
+}
+
+// ByAcmeOrders orders the results by acmeOrders terms.
+func ByAcmeOrders(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAcmeOrdersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newDomainsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -242,3 +297,10 @@ func newDomainsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2M, false, DomainsTable, DomainsPrimaryKey...),
 	)
 }
+func newAcmeOrdersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AcmeOrdersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AcmeOrdersTable, AcmeOrdersColumn),
+	)
+}
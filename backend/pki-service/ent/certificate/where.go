@@ -120,6 +120,26 @@ func Certificate(v string) predicate.Certificate {
 	return predicate.Certificate(sql.FieldEQ(FieldCertificate, v))
 }
 
+// CaReason applies equality check predicate on the "caReason" field. It's identical to CaReasonEQ.
+func CaReason(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldCaReason, v))
+}
+
+// Csr applies equality check predicate on the "csr" field. It's identical to CsrEQ.
+func Csr(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldCsr, v))
+}
+
+// Organization applies equality check predicate on the "organization" field. It's identical to OrganizationEQ.
+func Organization(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldOrganization, v))
+}
+
+// CertType applies equality check predicate on the "certType" field. It's identical to CertTypeEQ.
+func CertType(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldCertType, v))
+}
+
 // CreateTimeEQ applies the EQ predicate on the "create_time" field.
 func CreateTimeEQ(v time.Time) predicate.Certificate {
 	return predicate.Certificate(sql.FieldEQ(FieldCreateTime, v))
@@ -935,6 +955,306 @@ func CertificateContainsFold(v string) predicate.Certificate {
 	return predicate.Certificate(sql.FieldContainsFold(FieldCertificate, v))
 }
 
+// CaReasonEQ applies the EQ predicate on the "caReason" field.
+func CaReasonEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldCaReason, v))
+}
+
+// CaReasonNEQ applies the NEQ predicate on the "caReason" field.
+func CaReasonNEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldCaReason, v))
+}
+
+// CaReasonIn applies the In predicate on the "caReason" field.
+func CaReasonIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldIn(FieldCaReason, vs...))
+}
+
+// CaReasonNotIn applies the NotIn predicate on the "caReason" field.
+func CaReasonNotIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotIn(FieldCaReason, vs...))
+}
+
+// CaReasonGT applies the GT predicate on the "caReason" field.
+func CaReasonGT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGT(FieldCaReason, v))
+}
+
+// CaReasonGTE applies the GTE predicate on the "caReason" field.
+func CaReasonGTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGTE(FieldCaReason, v))
+}
+
+// CaReasonLT applies the LT predicate on the "caReason" field.
+func CaReasonLT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLT(FieldCaReason, v))
+}
+
+// CaReasonLTE applies the LTE predicate on the "caReason" field.
+func CaReasonLTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLTE(FieldCaReason, v))
+}
+
+// CaReasonContains applies the Contains predicate on the "caReason" field.
+func CaReasonContains(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContains(FieldCaReason, v))
+}
+
+// CaReasonHasPrefix applies the HasPrefix predicate on the "caReason" field.
+func CaReasonHasPrefix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasPrefix(FieldCaReason, v))
+}
+
+// CaReasonHasSuffix applies the HasSuffix predicate on the "caReason" field.
+func CaReasonHasSuffix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasSuffix(FieldCaReason, v))
+}
+
+// CaReasonIsNil applies the IsNil predicate on the "caReason" field.
+func CaReasonIsNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldIsNull(FieldCaReason))
+}
+
+// CaReasonNotNil applies the NotNil predicate on the "caReason" field.
+func CaReasonNotNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotNull(FieldCaReason))
+}
+
+// CaReasonEqualFold applies the EqualFold predicate on the "caReason" field.
+func CaReasonEqualFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEqualFold(FieldCaReason, v))
+}
+
+// CaReasonContainsFold applies the ContainsFold predicate on the "caReason" field.
+func CaReasonContainsFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContainsFold(FieldCaReason, v))
+}
+
+// CsrEQ applies the EQ predicate on the "csr" field.
+func CsrEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldCsr, v))
+}
+
+// CsrNEQ applies the NEQ predicate on the "csr" field.
+func CsrNEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldCsr, v))
+}
+
+// CsrIn applies the In predicate on the "csr" field.
+func CsrIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldIn(FieldCsr, vs...))
+}
+
+// CsrNotIn applies the NotIn predicate on the "csr" field.
+func CsrNotIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotIn(FieldCsr, vs...))
+}
+
+// CsrGT applies the GT predicate on the "csr" field.
+func CsrGT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGT(FieldCsr, v))
+}
+
+// CsrGTE applies the GTE predicate on the "csr" field.
+func CsrGTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGTE(FieldCsr, v))
+}
+
+// CsrLT applies the LT predicate on the "csr" field.
+func CsrLT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLT(FieldCsr, v))
+}
+
+// CsrLTE applies the LTE predicate on the "csr" field.
+func CsrLTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLTE(FieldCsr, v))
+}
+
+// CsrContains applies the Contains predicate on the "csr" field.
+func CsrContains(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContains(FieldCsr, v))
+}
+
+// CsrHasPrefix applies the HasPrefix predicate on the "csr" field.
+func CsrHasPrefix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasPrefix(FieldCsr, v))
+}
+
+// CsrHasSuffix applies the HasSuffix predicate on the "csr" field.
+func CsrHasSuffix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasSuffix(FieldCsr, v))
+}
+
+// CsrIsNil applies the IsNil predicate on the "csr" field.
+func CsrIsNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldIsNull(FieldCsr))
+}
+
+// CsrNotNil applies the NotNil predicate on the "csr" field.
+func CsrNotNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotNull(FieldCsr))
+}
+
+// CsrEqualFold applies the EqualFold predicate on the "csr" field.
+func CsrEqualFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEqualFold(FieldCsr, v))
+}
+
+// CsrContainsFold applies the ContainsFold predicate on the "csr" field.
+func CsrContainsFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContainsFold(FieldCsr, v))
+}
+
+// OrganizationEQ applies the EQ predicate on the "organization" field.
+func OrganizationEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldOrganization, v))
+}
+
+// OrganizationNEQ applies the NEQ predicate on the "organization" field.
+func OrganizationNEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldOrganization, v))
+}
+
+// OrganizationIn applies the In predicate on the "organization" field.
+func OrganizationIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldIn(FieldOrganization, vs...))
+}
+
+// OrganizationNotIn applies the NotIn predicate on the "organization" field.
+func OrganizationNotIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotIn(FieldOrganization, vs...))
+}
+
+// OrganizationGT applies the GT predicate on the "organization" field.
+func OrganizationGT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGT(FieldOrganization, v))
+}
+
+// OrganizationGTE applies the GTE predicate on the "organization" field.
+func OrganizationGTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGTE(FieldOrganization, v))
+}
+
+// OrganizationLT applies the LT predicate on the "organization" field.
+func OrganizationLT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLT(FieldOrganization, v))
+}
+
+// OrganizationLTE applies the LTE predicate on the "organization" field.
+func OrganizationLTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLTE(FieldOrganization, v))
+}
+
+// OrganizationContains applies the Contains predicate on the "organization" field.
+func OrganizationContains(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContains(FieldOrganization, v))
+}
+
+// OrganizationHasPrefix applies the HasPrefix predicate on the "organization" field.
+func OrganizationHasPrefix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasPrefix(FieldOrganization, v))
+}
+
+// OrganizationHasSuffix applies the HasSuffix predicate on the "organization" field.
+func OrganizationHasSuffix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasSuffix(FieldOrganization, v))
+}
+
+// OrganizationIsNil applies the IsNil predicate on the "organization" field.
+func OrganizationIsNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldIsNull(FieldOrganization))
+}
+
+// OrganizationNotNil applies the NotNil predicate on the "organization" field.
+func OrganizationNotNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotNull(FieldOrganization))
+}
+
+// OrganizationEqualFold applies the EqualFold predicate on the "organization" field.
+func OrganizationEqualFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEqualFold(FieldOrganization, v))
+}
+
+// OrganizationContainsFold applies the ContainsFold predicate on the "organization" field.
+func OrganizationContainsFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContainsFold(FieldOrganization, v))
+}
+
+// CertTypeEQ applies the EQ predicate on the "certType" field.
+func CertTypeEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldCertType, v))
+}
+
+// CertTypeNEQ applies the NEQ predicate on the "certType" field.
+func CertTypeNEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldCertType, v))
+}
+
+// CertTypeIn applies the In predicate on the "certType" field.
+func CertTypeIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldIn(FieldCertType, vs...))
+}
+
+// CertTypeNotIn applies the NotIn predicate on the "certType" field.
+func CertTypeNotIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotIn(FieldCertType, vs...))
+}
+
+// CertTypeGT applies the GT predicate on the "certType" field.
+func CertTypeGT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGT(FieldCertType, v))
+}
+
+// CertTypeGTE applies the GTE predicate on the "certType" field.
+func CertTypeGTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGTE(FieldCertType, v))
+}
+
+// CertTypeLT applies the LT predicate on the "certType" field.
+func CertTypeLT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLT(FieldCertType, v))
+}
+
+// CertTypeLTE applies the LTE predicate on the "certType" field.
+func CertTypeLTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLTE(FieldCertType, v))
+}
+
+// CertTypeContains applies the Contains predicate on the "certType" field.
+func CertTypeContains(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContains(FieldCertType, v))
+}
+
+// CertTypeHasPrefix applies the HasPrefix predicate on the "certType" field.
+func CertTypeHasPrefix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasPrefix(FieldCertType, v))
+}
+
+// CertTypeHasSuffix applies the HasSuffix predicate on the "certType" field.
+func CertTypeHasSuffix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasSuffix(FieldCertType, v))
+}
+
+// CertTypeIsNil applies the IsNil predicate on the "certType" field.
+func CertTypeIsNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldIsNull(FieldCertType))
+}
+
+// CertTypeNotNil applies the NotNil predicate on the "certType" field.
+func CertTypeNotNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotNull(FieldCertType))
+}
+
+// CertTypeEqualFold applies the EqualFold predicate on the "certType" field.
+func CertTypeEqualFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEqualFold(FieldCertType, v))
+}
+
+// CertTypeContainsFold applies the ContainsFold predicate on the "certType" field.
+func CertTypeContainsFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContainsFold(FieldCertType, v))
+}
+
 // HasDomains applies the HasEdge predicate on the "domains" edge.
 func HasDomains() predicate.Certificate {
 	return predicate.Certificate(func(s *sql.Selector) {
@@ -958,6 +1278,29 @@ func HasDomainsWith(preds ...predicate.Domain) predicate.Certificate {
 	})
 }
 
+// HasAcmeOrders applies the HasEdge predicate on the "acmeOrders" edge.
+func HasAcmeOrders() predicate.Certificate {
+	return predicate.Certificate(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AcmeOrdersTable, AcmeOrdersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAcmeOrdersWith applies the HasEdge predicate on the "acmeOrders" edge with a given conditions (other predicates).
+func HasAcmeOrdersWith(preds ...predicate.AcmeOrder) predicate.Certificate {
+	return predicate.Certificate(func(s *sql.Selector) {
+		step := newAcmeOrdersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Certificate) predicate.Certificate {
 	return predicate.Certificate(sql.AndPredicates(predicates...))
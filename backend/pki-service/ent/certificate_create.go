@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/hm-edu/pki-service/ent/acmeorder"
 	"github.com/hm-edu/pki-service/ent/certificate"
 	"github.com/hm-edu/pki-service/ent/domain"
 )
@@ -211,6 +212,62 @@ func (_c *CertificateCreate) SetNillableCertificate(v *string) *CertificateCreat
 	return _c
 }
 
+// SetCaReason sets the "caReason" field.
+func (_c *CertificateCreate) SetCaReason(v string) *CertificateCreate {
+	_c.mutation.SetCaReason(v)
+	return _c
+}
+
+// SetNillableCaReason sets the "caReason" field if the given value is not nil.
+func (_c *CertificateCreate) SetNillableCaReason(v *string) *CertificateCreate {
+	if v != nil {
+		_c.SetCaReason(*v)
+	}
+	return _c
+}
+
+// SetCsr sets the "csr" field.
+func (_c *CertificateCreate) SetCsr(v string) *CertificateCreate {
+	_c.mutation.SetCsr(v)
+	return _c
+}
+
+// SetNillableCsr sets the "csr" field if the given value is not nil.
+func (_c *CertificateCreate) SetNillableCsr(v *string) *CertificateCreate {
+	if v != nil {
+		_c.SetCsr(*v)
+	}
+	return _c
+}
+
+// SetOrganization sets the "organization" field.
+func (_c *CertificateCreate) SetOrganization(v string) *CertificateCreate {
+	_c.mutation.SetOrganization(v)
+	return _c
+}
+
+// SetNillableOrganization sets the "organization" field if the given value is not nil.
+func (_c *CertificateCreate) SetNillableOrganization(v *string) *CertificateCreate {
+	if v != nil {
+		_c.SetOrganization(*v)
+	}
+	return _c
+}
+
+// SetCertType sets the "certType" field.
+func (_c *CertificateCreate) SetCertType(v string) *CertificateCreate {
+	_c.mutation.SetCertType(v)
+	return _c
+}
+
+// SetNillableCertType sets the "certType" field if the given value is not nil.
+func (_c *CertificateCreate) SetNillableCertType(v *string) *CertificateCreate {
+	if v != nil {
+		_c.SetCertType(*v)
+	}
+	return _c
+}
+
 // AddDomainIDs adds the "domains" edge to the Domain entity by IDs.
 func (_c *CertificateCreate) AddDomainIDs(ids ...int) *CertificateCreate {
 	_c.mutation.AddDomainIDs(ids...)
@@ -226,6 +283,21 @@ func (_c *CertificateCreate) AddDomains(v ...*Domain) *CertificateCreate {
 	return _c.AddDomainIDs(ids...)
 }
 
+// AddAcmeOrderIDs adds the "acmeOrders" edge to the AcmeOrder entity by IDs.
+func (_c *CertificateCreate) AddAcmeOrderIDs(ids ...int) *CertificateCreate {
+	_c.mutation.AddAcmeOrderIDs(ids...)
+	return _c
+}
+
+// AddAcmeOrders adds the "acmeOrders" edges to the AcmeOrder entity.
+func (_c *CertificateCreate) AddAcmeOrders(v ...*AcmeOrder) *CertificateCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAcmeOrderIDs(ids...)
+}
+
 // Mutation returns the CertificateMutation object of the builder.
 func (_c *CertificateCreate) Mutation() *CertificateMutation {
 	return _c.mutation
@@ -391,6 +463,22 @@ func (_c *CertificateCreate) createSpec() (*Certificate, *sqlgraph.CreateSpec) {
 		_spec.SetField(certificate.FieldCertificate, field.TypeString, value)
 		_node.Certificate = &value
 	}
+	if value, ok := _c.mutation.CaReason(); ok {
+		_spec.SetField(certificate.FieldCaReason, field.TypeString, value)
+		_node.CaReason = &value
+	}
+	if value, ok := _c.mutation.Csr(); ok {
+		_spec.SetField(certificate.FieldCsr, field.TypeString, value)
+		_node.Csr = &value
+	}
+	if value, ok := _c.mutation.Organization(); ok {
+		_spec.SetField(certificate.FieldOrganization, field.TypeString, value)
+		_node.Organization = &value
+	}
+	if value, ok := _c.mutation.CertType(); ok {
+		_spec.SetField(certificate.FieldCertType, field.TypeString, value)
+		_node.CertType = &value
+	}
 	if nodes := _c.mutation.DomainsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -407,6 +495,22 @@ func (_c *CertificateCreate) createSpec() (*Certificate, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.AcmeOrdersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   certificate.AcmeOrdersTable,
+			Columns: []string{certificate.AcmeOrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(acmeorder.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -681,6 +785,78 @@ func (u *CertificateUpsert) ClearCertificate() *CertificateUpsert {
 	return u
 }
 
+// SetCaReason sets the "caReason" field.
+func (u *CertificateUpsert) SetCaReason(v string) *CertificateUpsert {
+	u.Set(certificate.FieldCaReason, v)
+	return u
+}
+
+// UpdateCaReason sets the "caReason" field to the value that was provided on create.
+func (u *CertificateUpsert) UpdateCaReason() *CertificateUpsert {
+	u.SetExcluded(certificate.FieldCaReason)
+	return u
+}
+
+// ClearCaReason clears the value of the "caReason" field.
+func (u *CertificateUpsert) ClearCaReason() *CertificateUpsert {
+	u.SetNull(certificate.FieldCaReason)
+	return u
+}
+
+// SetCsr sets the "csr" field.
+func (u *CertificateUpsert) SetCsr(v string) *CertificateUpsert {
+	u.Set(certificate.FieldCsr, v)
+	return u
+}
+
+// UpdateCsr sets the "csr" field to the value that was provided on create.
+func (u *CertificateUpsert) UpdateCsr() *CertificateUpsert {
+	u.SetExcluded(certificate.FieldCsr)
+	return u
+}
+
+// ClearCsr clears the value of the "csr" field.
+func (u *CertificateUpsert) ClearCsr() *CertificateUpsert {
+	u.SetNull(certificate.FieldCsr)
+	return u
+}
+
+// SetOrganization sets the "organization" field.
+func (u *CertificateUpsert) SetOrganization(v string) *CertificateUpsert {
+	u.Set(certificate.FieldOrganization, v)
+	return u
+}
+
+// UpdateOrganization sets the "organization" field to the value that was provided on create.
+func (u *CertificateUpsert) UpdateOrganization() *CertificateUpsert {
+	u.SetExcluded(certificate.FieldOrganization)
+	return u
+}
+
+// ClearOrganization clears the value of the "organization" field.
+func (u *CertificateUpsert) ClearOrganization() *CertificateUpsert {
+	u.SetNull(certificate.FieldOrganization)
+	return u
+}
+
+// SetCertType sets the "certType" field.
+func (u *CertificateUpsert) SetCertType(v string) *CertificateUpsert {
+	u.Set(certificate.FieldCertType, v)
+	return u
+}
+
+// UpdateCertType sets the "certType" field to the value that was provided on create.
+func (u *CertificateUpsert) UpdateCertType() *CertificateUpsert {
+	u.SetExcluded(certificate.FieldCertType)
+	return u
+}
+
+// ClearCertType clears the value of the "certType" field.
+func (u *CertificateUpsert) ClearCertType() *CertificateUpsert {
+	u.SetNull(certificate.FieldCertType)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -985,6 +1161,90 @@ func (u *CertificateUpsertOne) ClearCertificate() *CertificateUpsertOne {
 	})
 }
 
+// SetCaReason sets the "caReason" field.
+func (u *CertificateUpsertOne) SetCaReason(v string) *CertificateUpsertOne {
+	return u.Update(func(s *CertificateUpsert) {
+		s.SetCaReason(v)
+	})
+}
+
+// UpdateCaReason sets the "caReason" field to the value that was provided on create.
+func (u *CertificateUpsertOne) UpdateCaReason() *CertificateUpsertOne {
+	return u.Update(func(s *CertificateUpsert) {
+		s.UpdateCaReason()
+	})
+}
+
+// ClearCaReason clears the value of the "caReason" field.
+func (u *CertificateUpsertOne) ClearCaReason() *CertificateUpsertOne {
+	return u.Update(func(s *CertificateUpsert) {
+		s.ClearCaReason()
+	})
+}
+
+// SetCsr sets the "csr" field.
+func (u *CertificateUpsertOne) SetCsr(v string) *CertificateUpsertOne {
+	return u.Update(func(s *CertificateUpsert) {
+		s.SetCsr(v)
+	})
+}
+
+// UpdateCsr sets the "csr" field to the value that was provided on create.
+func (u *CertificateUpsertOne) UpdateCsr() *CertificateUpsertOne {
+	return u.Update(func(s *CertificateUpsert) {
+		s.UpdateCsr()
+	})
+}
+
+// ClearCsr clears the value of the "csr" field.
+func (u *CertificateUpsertOne) ClearCsr() *CertificateUpsertOne {
+	return u.Update(func(s *CertificateUpsert) {
+		s.ClearCsr()
+	})
+}
+
+// SetOrganization sets the "organization" field.
+func (u *CertificateUpsertOne) SetOrganization(v string) *CertificateUpsertOne {
+	return u.Update(func(s *CertificateUpsert) {
+		s.SetOrganization(v)
+	})
+}
+
+// UpdateOrganization sets the "organization" field to the value that was provided on create.
+func (u *CertificateUpsertOne) UpdateOrganization() *CertificateUpsertOne {
+	return u.Update(func(s *CertificateUpsert) {
+		s.UpdateOrganization()
+	})
+}
+
+// ClearOrganization clears the value of the "organization" field.
+func (u *CertificateUpsertOne) ClearOrganization() *CertificateUpsertOne {
+	return u.Update(func(s *CertificateUpsert) {
+		s.ClearOrganization()
+	})
+}
+
+// SetCertType sets the "certType" field.
+func (u *CertificateUpsertOne) SetCertType(v string) *CertificateUpsertOne {
+	return u.Update(func(s *CertificateUpsert) {
+		s.SetCertType(v)
+	})
+}
+
+// UpdateCertType sets the "certType" field to the value that was provided on create.
+func (u *CertificateUpsertOne) UpdateCertType() *CertificateUpsertOne {
+	return u.Update(func(s *CertificateUpsert) {
+		s.UpdateCertType()
+	})
+}
+
+// ClearCertType clears the value of the "certType" field.
+func (u *CertificateUpsertOne) ClearCertType() *CertificateUpsertOne {
+	return u.Update(func(s *CertificateUpsert) {
+		s.ClearCertType()
+	})
+}
+
 // Exec executes the query.
 func (u *CertificateUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1455,6 +1715,90 @@ func (u *CertificateUpsertBulk) ClearCertificate() *CertificateUpsertBulk {
 	})
 }
 
+// SetCaReason sets the "caReason" field.
+func (u *CertificateUpsertBulk) SetCaReason(v string) *CertificateUpsertBulk {
+	return u.Update(func(s *CertificateUpsert) {
+		s.SetCaReason(v)
+	})
+}
+
+// UpdateCaReason sets the "caReason" field to the value that was provided on create.
+func (u *CertificateUpsertBulk) UpdateCaReason() *CertificateUpsertBulk {
+	return u.Update(func(s *CertificateUpsert) {
+		s.UpdateCaReason()
+	})
+}
+
+// ClearCaReason clears the value of the "caReason" field.
+func (u *CertificateUpsertBulk) ClearCaReason() *CertificateUpsertBulk {
+	return u.Update(func(s *CertificateUpsert) {
+		s.ClearCaReason()
+	})
+}
+
+// SetCsr sets the "csr" field.
+func (u *CertificateUpsertBulk) SetCsr(v string) *CertificateUpsertBulk {
+	return u.Update(func(s *CertificateUpsert) {
+		s.SetCsr(v)
+	})
+}
+
+// UpdateCsr sets the "csr" field to the value that was provided on create.
+func (u *CertificateUpsertBulk) UpdateCsr() *CertificateUpsertBulk {
+	return u.Update(func(s *CertificateUpsert) {
+		s.UpdateCsr()
+	})
+}
+
+// ClearCsr clears the value of the "csr" field.
+func (u *CertificateUpsertBulk) ClearCsr() *CertificateUpsertBulk {
+	return u.Update(func(s *CertificateUpsert) {
+		s.ClearCsr()
+	})
+}
+
+// SetOrganization sets the "organization" field.
+func (u *CertificateUpsertBulk) SetOrganization(v string) *CertificateUpsertBulk {
+	return u.Update(func(s *CertificateUpsert) {
+		s.SetOrganization(v)
+	})
+}
+
+// UpdateOrganization sets the "organization" field to the value that was provided on create.
+func (u *CertificateUpsertBulk) UpdateOrganization() *CertificateUpsertBulk {
+	return u.Update(func(s *CertificateUpsert) {
+		s.UpdateOrganization()
+	})
+}
+
+// ClearOrganization clears the value of the "organization" field.
+func (u *CertificateUpsertBulk) ClearOrganization() *CertificateUpsertBulk {
+	return u.Update(func(s *CertificateUpsert) {
+		s.ClearOrganization()
+	})
+}
+
+// SetCertType sets the "certType" field.
+func (u *CertificateUpsertBulk) SetCertType(v string) *CertificateUpsertBulk {
+	return u.Update(func(s *CertificateUpsert) {
+		s.SetCertType(v)
+	})
+}
+
+// UpdateCertType sets the "certType" field to the value that was provided on create.
+func (u *CertificateUpsertBulk) UpdateCertType() *CertificateUpsertBulk {
+	return u.Update(func(s *CertificateUpsert) {
+		s.UpdateCertType()
+	})
+}
+
+// ClearCertType clears the value of the "certType" field.
+func (u *CertificateUpsertBulk) ClearCertType() *CertificateUpsertBulk {
+	return u.Update(func(s *CertificateUpsert) {
+		s.ClearCertType()
+	})
+}
+
 // Exec executes the query.
 func (u *CertificateUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/hm-edu/pki-service/ent/acmeorder"
 	"github.com/hm-edu/pki-service/ent/certificate"
 	"github.com/hm-edu/pki-service/ent/domain"
 	"github.com/hm-edu/pki-service/ent/predicate"
@@ -20,11 +21,12 @@ import (
 // CertificateQuery is the builder for querying Certificate entities.
 type CertificateQuery struct {
 	config
-	ctx         *QueryContext
-	order       []certificate.OrderOption
-	inters      []Interceptor
-	predicates  []predicate.Certificate
-	withDomains *DomainQuery
+	ctx            *QueryContext
+	order          []certificate.OrderOption
+	inters         []Interceptor
+	predicates     []predicate.Certificate
+	withDomains    *DomainQuery
+	withAcmeOrders *AcmeOrderQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -83,6 +85,28 @@ func (_q *CertificateQuery) QueryDomains() *DomainQuery {
 	return query
 }
 
+// QueryAcmeOrders chains the current query on the "acmeOrders" edge.
+func (_q *CertificateQuery) QueryAcmeOrders() *AcmeOrderQuery {
+	query := (&AcmeOrderClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(certificate.Table, certificate.FieldID, selector),
+			sqlgraph.To(acmeorder.Table, acmeorder.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, certificate.AcmeOrdersTable, certificate.AcmeOrdersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Certificate entity from the query.
 // Returns a *NotFoundError when no Certificate was found.
 func (_q *CertificateQuery) First(ctx context.Context) (*Certificate, error) {
@@ -270,12 +294,13 @@ func (_q *CertificateQuery) Clone() *CertificateQuery {
 		return nil
 	}
 	return &CertificateQuery{
-		config:      _q.config,
-		ctx:         _q.ctx.Clone(),
-		order:       append([]certificate.OrderOption{}, _q.order...),
-		inters:      append([]Interceptor{}, _q.inters...),
-		predicates:  append([]predicate.Certificate{}, _q.predicates...),
-		withDomains: _q.withDomains.Clone(),
+		config:         _q.config,
+		ctx:            _q.ctx.Clone(),
+		order:          append([]certificate.OrderOption{}, _q.order...),
+		inters:         append([]Interceptor{}, _q.inters...),
+		predicates:     append([]predicate.Certificate{}, _q.predicates...),
+		withDomains:    _q.withDomains.Clone(),
+		withAcmeOrders: _q.withAcmeOrders.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -293,6 +318,17 @@ func (_q *CertificateQuery) WithDomains(opts ...func(*DomainQuery)) *Certificate
 	return _q
 }
 
+// WithAcmeOrders tells the query-builder to eager-load the nodes that are connected to
+// the "acmeOrders" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *CertificateQuery) WithAcmeOrders(opts ...func(*AcmeOrderQuery)) *CertificateQuery {
+	query := (&AcmeOrderClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAcmeOrders = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -371,8 +407,9 @@ func (_q *CertificateQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 	var (
 		nodes       = []*Certificate{}
 		_spec       = _q.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [2]bool{
 			_q.withDomains != nil,
+			_q.withAcmeOrders != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -400,6 +437,13 @@ func (_q *CertificateQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 			return nil, err
 		}
 	}
+	if query := _q.withAcmeOrders; query != nil {
+		if err := _q.loadAcmeOrders(ctx, query, nodes,
+			func(n *Certificate) { n.Edges.AcmeOrders = []*AcmeOrder{} },
+			func(n *Certificate, e *AcmeOrder) { n.Edges.AcmeOrders = append(n.Edges.AcmeOrders, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -464,6 +508,37 @@ func (_q *CertificateQuery) loadDomains(ctx context.Context, query *DomainQuery,
 	}
 	return nil
 }
+func (_q *CertificateQuery) loadAcmeOrders(ctx context.Context, query *AcmeOrderQuery, nodes []*Certificate, init func(*Certificate), assign func(*Certificate, *AcmeOrder)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Certificate)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.AcmeOrder(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(certificate.AcmeOrdersColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.certificate_acme_orders
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "certificate_acme_orders" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "certificate_acme_orders" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *CertificateQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
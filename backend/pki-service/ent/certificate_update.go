@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/hm-edu/pki-service/ent/acmeorder"
 	"github.com/hm-edu/pki-service/ent/certificate"
 	"github.com/hm-edu/pki-service/ent/domain"
 	"github.com/hm-edu/pki-service/ent/predicate"
@@ -270,6 +271,86 @@ func (_u *CertificateUpdate) ClearCertificate() *CertificateUpdate {
 	return _u
 }
 
+// SetCaReason sets the "caReason" field.
+func (_u *CertificateUpdate) SetCaReason(v string) *CertificateUpdate {
+	_u.mutation.SetCaReason(v)
+	return _u
+}
+
+// SetNillableCaReason sets the "caReason" field if the given value is not nil.
+func (_u *CertificateUpdate) SetNillableCaReason(v *string) *CertificateUpdate {
+	if v != nil {
+		_u.SetCaReason(*v)
+	}
+	return _u
+}
+
+// ClearCaReason clears the value of the "caReason" field.
+func (_u *CertificateUpdate) ClearCaReason() *CertificateUpdate {
+	_u.mutation.ClearCaReason()
+	return _u
+}
+
+// SetCsr sets the "csr" field.
+func (_u *CertificateUpdate) SetCsr(v string) *CertificateUpdate {
+	_u.mutation.SetCsr(v)
+	return _u
+}
+
+// SetNillableCsr sets the "csr" field if the given value is not nil.
+func (_u *CertificateUpdate) SetNillableCsr(v *string) *CertificateUpdate {
+	if v != nil {
+		_u.SetCsr(*v)
+	}
+	return _u
+}
+
+// ClearCsr clears the value of the "csr" field.
+func (_u *CertificateUpdate) ClearCsr() *CertificateUpdate {
+	_u.mutation.ClearCsr()
+	return _u
+}
+
+// SetOrganization sets the "organization" field.
+func (_u *CertificateUpdate) SetOrganization(v string) *CertificateUpdate {
+	_u.mutation.SetOrganization(v)
+	return _u
+}
+
+// SetNillableOrganization sets the "organization" field if the given value is not nil.
+func (_u *CertificateUpdate) SetNillableOrganization(v *string) *CertificateUpdate {
+	if v != nil {
+		_u.SetOrganization(*v)
+	}
+	return _u
+}
+
+// ClearOrganization clears the value of the "organization" field.
+func (_u *CertificateUpdate) ClearOrganization() *CertificateUpdate {
+	_u.mutation.ClearOrganization()
+	return _u
+}
+
+// SetCertType sets the "certType" field.
+func (_u *CertificateUpdate) SetCertType(v string) *CertificateUpdate {
+	_u.mutation.SetCertType(v)
+	return _u
+}
+
+// SetNillableCertType sets the "certType" field if the given value is not nil.
+func (_u *CertificateUpdate) SetNillableCertType(v *string) *CertificateUpdate {
+	if v != nil {
+		_u.SetCertType(*v)
+	}
+	return _u
+}
+
+// ClearCertType clears the value of the "certType" field.
+func (_u *CertificateUpdate) ClearCertType() *CertificateUpdate {
+	_u.mutation.ClearCertType()
+	return _u
+}
+
 // AddDomainIDs adds the "domains" edge to the Domain entity by IDs.
 func (_u *CertificateUpdate) AddDomainIDs(ids ...int) *CertificateUpdate {
 	_u.mutation.AddDomainIDs(ids...)
@@ -285,6 +366,21 @@ func (_u *CertificateUpdate) AddDomains(v ...*Domain) *CertificateUpdate {
 	return _u.AddDomainIDs(ids...)
 }
 
+// AddAcmeOrderIDs adds the "acmeOrders" edge to the AcmeOrder entity by IDs.
+func (_u *CertificateUpdate) AddAcmeOrderIDs(ids ...int) *CertificateUpdate {
+	_u.mutation.AddAcmeOrderIDs(ids...)
+	return _u
+}
+
+// AddAcmeOrders adds the "acmeOrders" edges to the AcmeOrder entity.
+func (_u *CertificateUpdate) AddAcmeOrders(v ...*AcmeOrder) *CertificateUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAcmeOrderIDs(ids...)
+}
+
 // Mutation returns the CertificateMutation object of the builder.
 func (_u *CertificateUpdate) Mutation() *CertificateMutation {
 	return _u.mutation
@@ -311,6 +407,27 @@ func (_u *CertificateUpdate) RemoveDomains(v ...*Domain) *CertificateUpdate {
 	return _u.RemoveDomainIDs(ids...)
 }
 
+// ClearAcmeOrders clears all "acmeOrders" edges to the AcmeOrder entity.
+func (_u *CertificateUpdate) ClearAcmeOrders() *CertificateUpdate {
+	_u.mutation.ClearAcmeOrders()
+	return _u
+}
+
+// RemoveAcmeOrderIDs removes the "acmeOrders" edge to AcmeOrder entities by IDs.
+func (_u *CertificateUpdate) RemoveAcmeOrderIDs(ids ...int) *CertificateUpdate {
+	_u.mutation.RemoveAcmeOrderIDs(ids...)
+	return _u
+}
+
+// RemoveAcmeOrders removes "acmeOrders" edges to AcmeOrder entities.
+func (_u *CertificateUpdate) RemoveAcmeOrders(v ...*AcmeOrder) *CertificateUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAcmeOrderIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *CertificateUpdate) Save(ctx context.Context) (int, error) {
 	if err := _u.defaults(); err != nil {
@@ -452,6 +569,30 @@ func (_u *CertificateUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if _u.mutation.CertificateCleared() {
 		_spec.ClearField(certificate.FieldCertificate, field.TypeString)
 	}
+	if value, ok := _u.mutation.CaReason(); ok {
+		_spec.SetField(certificate.FieldCaReason, field.TypeString, value)
+	}
+	if _u.mutation.CaReasonCleared() {
+		_spec.ClearField(certificate.FieldCaReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.Csr(); ok {
+		_spec.SetField(certificate.FieldCsr, field.TypeString, value)
+	}
+	if _u.mutation.CsrCleared() {
+		_spec.ClearField(certificate.FieldCsr, field.TypeString)
+	}
+	if value, ok := _u.mutation.Organization(); ok {
+		_spec.SetField(certificate.FieldOrganization, field.TypeString, value)
+	}
+	if _u.mutation.OrganizationCleared() {
+		_spec.ClearField(certificate.FieldOrganization, field.TypeString)
+	}
+	if value, ok := _u.mutation.CertType(); ok {
+		_spec.SetField(certificate.FieldCertType, field.TypeString, value)
+	}
+	if _u.mutation.CertTypeCleared() {
+		_spec.ClearField(certificate.FieldCertType, field.TypeString)
+	}
 	if _u.mutation.DomainsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -497,6 +638,51 @@ func (_u *CertificateUpdate) sqlSave(ctx context.Context) (_node int, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.AcmeOrdersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   certificate.AcmeOrdersTable,
+			Columns: []string{certificate.AcmeOrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(acmeorder.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAcmeOrdersIDs(); len(nodes) > 0 && !_u.mutation.AcmeOrdersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   certificate.AcmeOrdersTable,
+			Columns: []string{certificate.AcmeOrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(acmeorder.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AcmeOrdersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   certificate.AcmeOrdersTable,
+			Columns: []string{certificate.AcmeOrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(acmeorder.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{certificate.Label}
@@ -758,6 +944,86 @@ func (_u *CertificateUpdateOne) ClearCertificate() *CertificateUpdateOne {
 	return _u
 }
 
+// SetCaReason sets the "caReason" field.
+func (_u *CertificateUpdateOne) SetCaReason(v string) *CertificateUpdateOne {
+	_u.mutation.SetCaReason(v)
+	return _u
+}
+
+// SetNillableCaReason sets the "caReason" field if the given value is not nil.
+func (_u *CertificateUpdateOne) SetNillableCaReason(v *string) *CertificateUpdateOne {
+	if v != nil {
+		_u.SetCaReason(*v)
+	}
+	return _u
+}
+
+// ClearCaReason clears the value of the "caReason" field.
+func (_u *CertificateUpdateOne) ClearCaReason() *CertificateUpdateOne {
+	_u.mutation.ClearCaReason()
+	return _u
+}
+
+// SetCsr sets the "csr" field.
+func (_u *CertificateUpdateOne) SetCsr(v string) *CertificateUpdateOne {
+	_u.mutation.SetCsr(v)
+	return _u
+}
+
+// SetNillableCsr sets the "csr" field if the given value is not nil.
+func (_u *CertificateUpdateOne) SetNillableCsr(v *string) *CertificateUpdateOne {
+	if v != nil {
+		_u.SetCsr(*v)
+	}
+	return _u
+}
+
+// ClearCsr clears the value of the "csr" field.
+func (_u *CertificateUpdateOne) ClearCsr() *CertificateUpdateOne {
+	_u.mutation.ClearCsr()
+	return _u
+}
+
+// SetOrganization sets the "organization" field.
+func (_u *CertificateUpdateOne) SetOrganization(v string) *CertificateUpdateOne {
+	_u.mutation.SetOrganization(v)
+	return _u
+}
+
+// SetNillableOrganization sets the "organization" field if the given value is not nil.
+func (_u *CertificateUpdateOne) SetNillableOrganization(v *string) *CertificateUpdateOne {
+	if v != nil {
+		_u.SetOrganization(*v)
+	}
+	return _u
+}
+
+// ClearOrganization clears the value of the "organization" field.
+func (_u *CertificateUpdateOne) ClearOrganization() *CertificateUpdateOne {
+	_u.mutation.ClearOrganization()
+	return _u
+}
+
+// SetCertType sets the "certType" field.
+func (_u *CertificateUpdateOne) SetCertType(v string) *CertificateUpdateOne {
+	_u.mutation.SetCertType(v)
+	return _u
+}
+
+// SetNillableCertType sets the "certType" field if the given value is not nil.
+func (_u *CertificateUpdateOne) SetNillableCertType(v *string) *CertificateUpdateOne {
+	if v != nil {
+		_u.SetCertType(*v)
+	}
+	return _u
+}
+
+// ClearCertType clears the value of the "certType" field.
+func (_u *CertificateUpdateOne) ClearCertType() *CertificateUpdateOne {
+	_u.mutation.ClearCertType()
+	return _u
+}
+
 // AddDomainIDs adds the "domains" edge to the Domain entity by IDs.
 func (_u *CertificateUpdateOne) AddDomainIDs(ids ...int) *CertificateUpdateOne {
 	_u.mutation.AddDomainIDs(ids...)
@@ -773,6 +1039,21 @@ func (_u *CertificateUpdateOne) AddDomains(v ...*Domain) *CertificateUpdateOne {
 	return _u.AddDomainIDs(ids...)
 }
 
+// AddAcmeOrderIDs adds the "acmeOrders" edge to the AcmeOrder entity by IDs.
+func (_u *CertificateUpdateOne) AddAcmeOrderIDs(ids ...int) *CertificateUpdateOne {
+	_u.mutation.AddAcmeOrderIDs(ids...)
+	return _u
+}
+
+// AddAcmeOrders adds the "acmeOrders" edges to the AcmeOrder entity.
+func (_u *CertificateUpdateOne) AddAcmeOrders(v ...*AcmeOrder) *CertificateUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAcmeOrderIDs(ids...)
+}
+
 // Mutation returns the CertificateMutation object of the builder.
 func (_u *CertificateUpdateOne) Mutation() *CertificateMutation {
 	return _u.mutation
@@ -799,6 +1080,27 @@ func (_u *CertificateUpdateOne) RemoveDomains(v ...*Domain) *CertificateUpdateOn
 	return _u.RemoveDomainIDs(ids...)
 }
 
+// ClearAcmeOrders clears all "acmeOrders" edges to the AcmeOrder entity.
+func (_u *CertificateUpdateOne) ClearAcmeOrders() *CertificateUpdateOne {
+	_u.mutation.ClearAcmeOrders()
+	return _u
+}
+
+// RemoveAcmeOrderIDs removes the "acmeOrders" edge to AcmeOrder entities by IDs.
+func (_u *CertificateUpdateOne) RemoveAcmeOrderIDs(ids ...int) *CertificateUpdateOne {
+	_u.mutation.RemoveAcmeOrderIDs(ids...)
+	return _u
+}
+
+// RemoveAcmeOrders removes "acmeOrders" edges to AcmeOrder entities.
+func (_u *CertificateUpdateOne) RemoveAcmeOrders(v ...*AcmeOrder) *CertificateUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAcmeOrderIDs(ids...)
+}
+
 // Where appends a list predicates to the CertificateUpdate builder.
 func (_u *CertificateUpdateOne) Where(ps ...predicate.Certificate) *CertificateUpdateOne {
 	_u.mutation.Where(ps...)
@@ -970,6 +1272,30 @@ func (_u *CertificateUpdateOne) sqlSave(ctx context.Context) (_node *Certificate
 	if _u.mutation.CertificateCleared() {
 		_spec.ClearField(certificate.FieldCertificate, field.TypeString)
 	}
+	if value, ok := _u.mutation.CaReason(); ok {
+		_spec.SetField(certificate.FieldCaReason, field.TypeString, value)
+	}
+	if _u.mutation.CaReasonCleared() {
+		_spec.ClearField(certificate.FieldCaReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.Csr(); ok {
+		_spec.SetField(certificate.FieldCsr, field.TypeString, value)
+	}
+	if _u.mutation.CsrCleared() {
+		_spec.ClearField(certificate.FieldCsr, field.TypeString)
+	}
+	if value, ok := _u.mutation.Organization(); ok {
+		_spec.SetField(certificate.FieldOrganization, field.TypeString, value)
+	}
+	if _u.mutation.OrganizationCleared() {
+		_spec.ClearField(certificate.FieldOrganization, field.TypeString)
+	}
+	if value, ok := _u.mutation.CertType(); ok {
+		_spec.SetField(certificate.FieldCertType, field.TypeString, value)
+	}
+	if _u.mutation.CertTypeCleared() {
+		_spec.ClearField(certificate.FieldCertType, field.TypeString)
+	}
 	if _u.mutation.DomainsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -1015,6 +1341,51 @@ func (_u *CertificateUpdateOne) sqlSave(ctx context.Context) (_node *Certificate
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.AcmeOrdersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   certificate.AcmeOrdersTable,
+			Columns: []string{certificate.AcmeOrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(acmeorder.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAcmeOrdersIDs(); len(nodes) > 0 && !_u.mutation.AcmeOrdersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   certificate.AcmeOrdersTable,
+			Columns: []string{certificate.AcmeOrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(acmeorder.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AcmeOrdersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   certificate.AcmeOrdersTable,
+			Columns: []string{certificate.AcmeOrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(acmeorder.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Certificate{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -15,9 +15,17 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/hm-edu/pki-service/ent/acmeorder"
 	"github.com/hm-edu/pki-service/ent/certificate"
 	"github.com/hm-edu/pki-service/ent/domain"
+	"github.com/hm-edu/pki-service/ent/emailverification"
+	"github.com/hm-edu/pki-service/ent/escrowaudit"
+	"github.com/hm-edu/pki-service/ent/keyescrow"
+	"github.com/hm-edu/pki-service/ent/keyrecovery"
+	"github.com/hm-edu/pki-service/ent/notificationpreference"
+	"github.com/hm-edu/pki-service/ent/sentnotification"
 	"github.com/hm-edu/pki-service/ent/smimecertificate"
+	"github.com/hm-edu/pki-service/ent/termsacceptance"
 )
 
 // Client is the client that holds all ent builders.
@@ -25,12 +33,28 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// AcmeOrder is the client for interacting with the AcmeOrder builders.
+	AcmeOrder *AcmeOrderClient
 	// Certificate is the client for interacting with the Certificate builders.
 	Certificate *CertificateClient
 	// Domain is the client for interacting with the Domain builders.
 	Domain *DomainClient
+	// EmailVerification is the client for interacting with the EmailVerification builders.
+	EmailVerification *EmailVerificationClient
+	// EscrowAudit is the client for interacting with the EscrowAudit builders.
+	EscrowAudit *EscrowAuditClient
+	// KeyEscrow is the client for interacting with the KeyEscrow builders.
+	KeyEscrow *KeyEscrowClient
+	// KeyRecovery is the client for interacting with the KeyRecovery builders.
+	KeyRecovery *KeyRecoveryClient
+	// NotificationPreference is the client for interacting with the NotificationPreference builders.
+	NotificationPreference *NotificationPreferenceClient
+	// SentNotification is the client for interacting with the SentNotification builders.
+	SentNotification *SentNotificationClient
 	// SmimeCertificate is the client for interacting with the SmimeCertificate builders.
 	SmimeCertificate *SmimeCertificateClient
+	// TermsAcceptance is the client for interacting with the TermsAcceptance builders.
+	TermsAcceptance *TermsAcceptanceClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -42,9 +66,17 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.AcmeOrder = NewAcmeOrderClient(c.config)
 	c.Certificate = NewCertificateClient(c.config)
 	c.Domain = NewDomainClient(c.config)
+	c.EmailVerification = NewEmailVerificationClient(c.config)
+	c.EscrowAudit = NewEscrowAuditClient(c.config)
+	c.KeyEscrow = NewKeyEscrowClient(c.config)
+	c.KeyRecovery = NewKeyRecoveryClient(c.config)
+	c.NotificationPreference = NewNotificationPreferenceClient(c.config)
+	c.SentNotification = NewSentNotificationClient(c.config)
 	c.SmimeCertificate = NewSmimeCertificateClient(c.config)
+	c.TermsAcceptance = NewTermsAcceptanceClient(c.config)
 }
 
 type (
@@ -135,11 +167,19 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:              ctx,
-		config:           cfg,
-		Certificate:      NewCertificateClient(cfg),
-		Domain:           NewDomainClient(cfg),
-		SmimeCertificate: NewSmimeCertificateClient(cfg),
+		ctx:                    ctx,
+		config:                 cfg,
+		AcmeOrder:              NewAcmeOrderClient(cfg),
+		Certificate:            NewCertificateClient(cfg),
+		Domain:                 NewDomainClient(cfg),
+		EmailVerification:      NewEmailVerificationClient(cfg),
+		EscrowAudit:            NewEscrowAuditClient(cfg),
+		KeyEscrow:              NewKeyEscrowClient(cfg),
+		KeyRecovery:            NewKeyRecoveryClient(cfg),
+		NotificationPreference: NewNotificationPreferenceClient(cfg),
+		SentNotification:       NewSentNotificationClient(cfg),
+		SmimeCertificate:       NewSmimeCertificateClient(cfg),
+		TermsAcceptance:        NewTermsAcceptanceClient(cfg),
 	}, nil
 }
 
@@ -157,18 +197,26 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:              ctx,
-		config:           cfg,
-		Certificate:      NewCertificateClient(cfg),
-		Domain:           NewDomainClient(cfg),
-		SmimeCertificate: NewSmimeCertificateClient(cfg),
+		ctx:                    ctx,
+		config:                 cfg,
+		AcmeOrder:              NewAcmeOrderClient(cfg),
+		Certificate:            NewCertificateClient(cfg),
+		Domain:                 NewDomainClient(cfg),
+		EmailVerification:      NewEmailVerificationClient(cfg),
+		EscrowAudit:            NewEscrowAuditClient(cfg),
+		KeyEscrow:              NewKeyEscrowClient(cfg),
+		KeyRecovery:            NewKeyRecoveryClient(cfg),
+		NotificationPreference: NewNotificationPreferenceClient(cfg),
+		SentNotification:       NewSentNotificationClient(cfg),
+		SmimeCertificate:       NewSmimeCertificateClient(cfg),
+		TermsAcceptance:        NewTermsAcceptanceClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Certificate.
+//		AcmeOrder.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -190,33 +238,206 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.Certificate.Use(hooks...)
-	c.Domain.Use(hooks...)
-	c.SmimeCertificate.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.AcmeOrder, c.Certificate, c.Domain, c.EmailVerification, c.EscrowAudit,
+		c.KeyEscrow, c.KeyRecovery, c.NotificationPreference, c.SentNotification,
+		c.SmimeCertificate, c.TermsAcceptance,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.Certificate.Intercept(interceptors...)
-	c.Domain.Intercept(interceptors...)
-	c.SmimeCertificate.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.AcmeOrder, c.Certificate, c.Domain, c.EmailVerification, c.EscrowAudit,
+		c.KeyEscrow, c.KeyRecovery, c.NotificationPreference, c.SentNotification,
+		c.SmimeCertificate, c.TermsAcceptance,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *AcmeOrderMutation:
+		return c.AcmeOrder.mutate(ctx, m)
 	case *CertificateMutation:
 		return c.Certificate.mutate(ctx, m)
 	case *DomainMutation:
 		return c.Domain.mutate(ctx, m)
+	case *EmailVerificationMutation:
+		return c.EmailVerification.mutate(ctx, m)
+	case *EscrowAuditMutation:
+		return c.EscrowAudit.mutate(ctx, m)
+	case *KeyEscrowMutation:
+		return c.KeyEscrow.mutate(ctx, m)
+	case *KeyRecoveryMutation:
+		return c.KeyRecovery.mutate(ctx, m)
+	case *NotificationPreferenceMutation:
+		return c.NotificationPreference.mutate(ctx, m)
+	case *SentNotificationMutation:
+		return c.SentNotification.mutate(ctx, m)
 	case *SmimeCertificateMutation:
 		return c.SmimeCertificate.mutate(ctx, m)
+	case *TermsAcceptanceMutation:
+		return c.TermsAcceptance.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
 }
 
+// AcmeOrderClient is a client for the AcmeOrder schema.
+type AcmeOrderClient struct {
+	config
+}
+
+// NewAcmeOrderClient returns a client for the AcmeOrder from the given config.
+func NewAcmeOrderClient(c config) *AcmeOrderClient {
+	return &AcmeOrderClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `acmeorder.Hooks(f(g(h())))`.
+func (c *AcmeOrderClient) Use(hooks ...Hook) {
+	c.hooks.AcmeOrder = append(c.hooks.AcmeOrder, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `acmeorder.Intercept(f(g(h())))`.
+func (c *AcmeOrderClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AcmeOrder = append(c.inters.AcmeOrder, interceptors...)
+}
+
+// Create returns a builder for creating a AcmeOrder entity.
+func (c *AcmeOrderClient) Create() *AcmeOrderCreate {
+	mutation := newAcmeOrderMutation(c.config, OpCreate)
+	return &AcmeOrderCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AcmeOrder entities.
+func (c *AcmeOrderClient) CreateBulk(builders ...*AcmeOrderCreate) *AcmeOrderCreateBulk {
+	return &AcmeOrderCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AcmeOrderClient) MapCreateBulk(slice any, setFunc func(*AcmeOrderCreate, int)) *AcmeOrderCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AcmeOrderCreateBulk{err: fmt.Errorf("calling to AcmeOrderClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AcmeOrderCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AcmeOrderCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AcmeOrder.
+func (c *AcmeOrderClient) Update() *AcmeOrderUpdate {
+	mutation := newAcmeOrderMutation(c.config, OpUpdate)
+	return &AcmeOrderUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AcmeOrderClient) UpdateOne(_m *AcmeOrder) *AcmeOrderUpdateOne {
+	mutation := newAcmeOrderMutation(c.config, OpUpdateOne, withAcmeOrder(_m))
+	return &AcmeOrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AcmeOrderClient) UpdateOneID(id int) *AcmeOrderUpdateOne {
+	mutation := newAcmeOrderMutation(c.config, OpUpdateOne, withAcmeOrderID(id))
+	return &AcmeOrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AcmeOrder.
+func (c *AcmeOrderClient) Delete() *AcmeOrderDelete {
+	mutation := newAcmeOrderMutation(c.config, OpDelete)
+	return &AcmeOrderDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AcmeOrderClient) DeleteOne(_m *AcmeOrder) *AcmeOrderDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AcmeOrderClient) DeleteOneID(id int) *AcmeOrderDeleteOne {
+	builder := c.Delete().Where(acmeorder.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AcmeOrderDeleteOne{builder}
+}
+
+// Query returns a query builder for AcmeOrder.
+func (c *AcmeOrderClient) Query() *AcmeOrderQuery {
+	return &AcmeOrderQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAcmeOrder},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AcmeOrder entity by its id.
+func (c *AcmeOrderClient) Get(ctx context.Context, id int) (*AcmeOrder, error) {
+	return c.Query().Where(acmeorder.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AcmeOrderClient) GetX(ctx context.Context, id int) *AcmeOrder {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCertificate queries the certificate edge of a AcmeOrder.
+func (c *AcmeOrderClient) QueryCertificate(_m *AcmeOrder) *CertificateQuery {
+	query := (&CertificateClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(acmeorder.Table, acmeorder.FieldID, id),
+			sqlgraph.To(certificate.Table, certificate.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, acmeorder.CertificateTable, acmeorder.CertificateColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *AcmeOrderClient) Hooks() []Hook {
+	return c.hooks.AcmeOrder
+}
+
+// Interceptors returns the client interceptors.
+func (c *AcmeOrderClient) Interceptors() []Interceptor {
+	return c.inters.AcmeOrder
+}
+
+func (c *AcmeOrderClient) mutate(ctx context.Context, m *AcmeOrderMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AcmeOrderCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AcmeOrderUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AcmeOrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AcmeOrderDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AcmeOrder mutation op: %q", m.Op())
+	}
+}
+
 // CertificateClient is a client for the Certificate schema.
 type CertificateClient struct {
 	config
@@ -341,6 +562,22 @@ func (c *CertificateClient) QueryDomains(_m *Certificate) *DomainQuery {
 	return query
 }
 
+// QueryAcmeOrders queries the acmeOrders edge of a Certificate.
+func (c *CertificateClient) QueryAcmeOrders(_m *Certificate) *AcmeOrderQuery {
+	query := (&AcmeOrderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(certificate.Table, certificate.FieldID, id),
+			sqlgraph.To(acmeorder.Table, acmeorder.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, certificate.AcmeOrdersTable, certificate.AcmeOrdersColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *CertificateClient) Hooks() []Hook {
 	hooks := c.hooks.Certificate
@@ -516,107 +753,107 @@ func (c *DomainClient) mutate(ctx context.Context, m *DomainMutation) (Value, er
 	}
 }
 
-// SmimeCertificateClient is a client for the SmimeCertificate schema.
-type SmimeCertificateClient struct {
+// EmailVerificationClient is a client for the EmailVerification schema.
+type EmailVerificationClient struct {
 	config
 }
 
-// NewSmimeCertificateClient returns a client for the SmimeCertificate from the given config.
-func NewSmimeCertificateClient(c config) *SmimeCertificateClient {
-	return &SmimeCertificateClient{config: c}
+// NewEmailVerificationClient returns a client for the EmailVerification from the given config.
+func NewEmailVerificationClient(c config) *EmailVerificationClient {
+	return &EmailVerificationClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `smimecertificate.Hooks(f(g(h())))`.
-func (c *SmimeCertificateClient) Use(hooks ...Hook) {
-	c.hooks.SmimeCertificate = append(c.hooks.SmimeCertificate, hooks...)
+// A call to `Use(f, g, h)` equals to `emailverification.Hooks(f(g(h())))`.
+func (c *EmailVerificationClient) Use(hooks ...Hook) {
+	c.hooks.EmailVerification = append(c.hooks.EmailVerification, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `smimecertificate.Intercept(f(g(h())))`.
-func (c *SmimeCertificateClient) Intercept(interceptors ...Interceptor) {
-	c.inters.SmimeCertificate = append(c.inters.SmimeCertificate, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `emailverification.Intercept(f(g(h())))`.
+func (c *EmailVerificationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EmailVerification = append(c.inters.EmailVerification, interceptors...)
 }
 
-// Create returns a builder for creating a SmimeCertificate entity.
-func (c *SmimeCertificateClient) Create() *SmimeCertificateCreate {
-	mutation := newSmimeCertificateMutation(c.config, OpCreate)
-	return &SmimeCertificateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a EmailVerification entity.
+func (c *EmailVerificationClient) Create() *EmailVerificationCreate {
+	mutation := newEmailVerificationMutation(c.config, OpCreate)
+	return &EmailVerificationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of SmimeCertificate entities.
-func (c *SmimeCertificateClient) CreateBulk(builders ...*SmimeCertificateCreate) *SmimeCertificateCreateBulk {
-	return &SmimeCertificateCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of EmailVerification entities.
+func (c *EmailVerificationClient) CreateBulk(builders ...*EmailVerificationCreate) *EmailVerificationCreateBulk {
+	return &EmailVerificationCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *SmimeCertificateClient) MapCreateBulk(slice any, setFunc func(*SmimeCertificateCreate, int)) *SmimeCertificateCreateBulk {
+func (c *EmailVerificationClient) MapCreateBulk(slice any, setFunc func(*EmailVerificationCreate, int)) *EmailVerificationCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &SmimeCertificateCreateBulk{err: fmt.Errorf("calling to SmimeCertificateClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &EmailVerificationCreateBulk{err: fmt.Errorf("calling to EmailVerificationClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*SmimeCertificateCreate, rv.Len())
+	builders := make([]*EmailVerificationCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &SmimeCertificateCreateBulk{config: c.config, builders: builders}
+	return &EmailVerificationCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for SmimeCertificate.
-func (c *SmimeCertificateClient) Update() *SmimeCertificateUpdate {
-	mutation := newSmimeCertificateMutation(c.config, OpUpdate)
-	return &SmimeCertificateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for EmailVerification.
+func (c *EmailVerificationClient) Update() *EmailVerificationUpdate {
+	mutation := newEmailVerificationMutation(c.config, OpUpdate)
+	return &EmailVerificationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *SmimeCertificateClient) UpdateOne(_m *SmimeCertificate) *SmimeCertificateUpdateOne {
-	mutation := newSmimeCertificateMutation(c.config, OpUpdateOne, withSmimeCertificate(_m))
-	return &SmimeCertificateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *EmailVerificationClient) UpdateOne(_m *EmailVerification) *EmailVerificationUpdateOne {
+	mutation := newEmailVerificationMutation(c.config, OpUpdateOne, withEmailVerification(_m))
+	return &EmailVerificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *SmimeCertificateClient) UpdateOneID(id int) *SmimeCertificateUpdateOne {
-	mutation := newSmimeCertificateMutation(c.config, OpUpdateOne, withSmimeCertificateID(id))
-	return &SmimeCertificateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *EmailVerificationClient) UpdateOneID(id int) *EmailVerificationUpdateOne {
+	mutation := newEmailVerificationMutation(c.config, OpUpdateOne, withEmailVerificationID(id))
+	return &EmailVerificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for SmimeCertificate.
-func (c *SmimeCertificateClient) Delete() *SmimeCertificateDelete {
-	mutation := newSmimeCertificateMutation(c.config, OpDelete)
-	return &SmimeCertificateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for EmailVerification.
+func (c *EmailVerificationClient) Delete() *EmailVerificationDelete {
+	mutation := newEmailVerificationMutation(c.config, OpDelete)
+	return &EmailVerificationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *SmimeCertificateClient) DeleteOne(_m *SmimeCertificate) *SmimeCertificateDeleteOne {
+func (c *EmailVerificationClient) DeleteOne(_m *EmailVerification) *EmailVerificationDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *SmimeCertificateClient) DeleteOneID(id int) *SmimeCertificateDeleteOne {
-	builder := c.Delete().Where(smimecertificate.ID(id))
+func (c *EmailVerificationClient) DeleteOneID(id int) *EmailVerificationDeleteOne {
+	builder := c.Delete().Where(emailverification.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &SmimeCertificateDeleteOne{builder}
+	return &EmailVerificationDeleteOne{builder}
 }
 
-// Query returns a query builder for SmimeCertificate.
-func (c *SmimeCertificateClient) Query() *SmimeCertificateQuery {
-	return &SmimeCertificateQuery{
+// Query returns a query builder for EmailVerification.
+func (c *EmailVerificationClient) Query() *EmailVerificationQuery {
+	return &EmailVerificationQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeSmimeCertificate},
+		ctx:    &QueryContext{Type: TypeEmailVerification},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a SmimeCertificate entity by its id.
-func (c *SmimeCertificateClient) Get(ctx context.Context, id int) (*SmimeCertificate, error) {
-	return c.Query().Where(smimecertificate.ID(id)).Only(ctx)
+// Get returns a EmailVerification entity by its id.
+func (c *EmailVerificationClient) Get(ctx context.Context, id int) (*EmailVerification, error) {
+	return c.Query().Where(emailverification.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *SmimeCertificateClient) GetX(ctx context.Context, id int) *SmimeCertificate {
+func (c *EmailVerificationClient) GetX(ctx context.Context, id int) *EmailVerification {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -625,37 +862,1039 @@ func (c *SmimeCertificateClient) GetX(ctx context.Context, id int) *SmimeCertifi
 }
 
 // Hooks returns the client hooks.
-func (c *SmimeCertificateClient) Hooks() []Hook {
-	hooks := c.hooks.SmimeCertificate
-	return append(hooks[:len(hooks):len(hooks)], smimecertificate.Hooks[:]...)
+func (c *EmailVerificationClient) Hooks() []Hook {
+	return c.hooks.EmailVerification
 }
 
 // Interceptors returns the client interceptors.
-func (c *SmimeCertificateClient) Interceptors() []Interceptor {
-	return c.inters.SmimeCertificate
+func (c *EmailVerificationClient) Interceptors() []Interceptor {
+	return c.inters.EmailVerification
 }
 
-func (c *SmimeCertificateClient) mutate(ctx context.Context, m *SmimeCertificateMutation) (Value, error) {
+func (c *EmailVerificationClient) mutate(ctx context.Context, m *EmailVerificationMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&SmimeCertificateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&EmailVerificationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&SmimeCertificateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&EmailVerificationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&SmimeCertificateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&EmailVerificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&SmimeCertificateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&EmailVerificationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown SmimeCertificate mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown EmailVerification mutation op: %q", m.Op())
+	}
+}
+
+// EscrowAuditClient is a client for the EscrowAudit schema.
+type EscrowAuditClient struct {
+	config
+}
+
+// NewEscrowAuditClient returns a client for the EscrowAudit from the given config.
+func NewEscrowAuditClient(c config) *EscrowAuditClient {
+	return &EscrowAuditClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `escrowaudit.Hooks(f(g(h())))`.
+func (c *EscrowAuditClient) Use(hooks ...Hook) {
+	c.hooks.EscrowAudit = append(c.hooks.EscrowAudit, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `escrowaudit.Intercept(f(g(h())))`.
+func (c *EscrowAuditClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EscrowAudit = append(c.inters.EscrowAudit, interceptors...)
+}
+
+// Create returns a builder for creating a EscrowAudit entity.
+func (c *EscrowAuditClient) Create() *EscrowAuditCreate {
+	mutation := newEscrowAuditMutation(c.config, OpCreate)
+	return &EscrowAuditCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EscrowAudit entities.
+func (c *EscrowAuditClient) CreateBulk(builders ...*EscrowAuditCreate) *EscrowAuditCreateBulk {
+	return &EscrowAuditCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EscrowAuditClient) MapCreateBulk(slice any, setFunc func(*EscrowAuditCreate, int)) *EscrowAuditCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EscrowAuditCreateBulk{err: fmt.Errorf("calling to EscrowAuditClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EscrowAuditCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EscrowAuditCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for EscrowAudit.
+func (c *EscrowAuditClient) Update() *EscrowAuditUpdate {
+	mutation := newEscrowAuditMutation(c.config, OpUpdate)
+	return &EscrowAuditUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EscrowAuditClient) UpdateOne(_m *EscrowAudit) *EscrowAuditUpdateOne {
+	mutation := newEscrowAuditMutation(c.config, OpUpdateOne, withEscrowAudit(_m))
+	return &EscrowAuditUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EscrowAuditClient) UpdateOneID(id int) *EscrowAuditUpdateOne {
+	mutation := newEscrowAuditMutation(c.config, OpUpdateOne, withEscrowAuditID(id))
+	return &EscrowAuditUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EscrowAudit.
+func (c *EscrowAuditClient) Delete() *EscrowAuditDelete {
+	mutation := newEscrowAuditMutation(c.config, OpDelete)
+	return &EscrowAuditDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EscrowAuditClient) DeleteOne(_m *EscrowAudit) *EscrowAuditDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EscrowAuditClient) DeleteOneID(id int) *EscrowAuditDeleteOne {
+	builder := c.Delete().Where(escrowaudit.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EscrowAuditDeleteOne{builder}
+}
+
+// Query returns a query builder for EscrowAudit.
+func (c *EscrowAuditClient) Query() *EscrowAuditQuery {
+	return &EscrowAuditQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEscrowAudit},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a EscrowAudit entity by its id.
+func (c *EscrowAuditClient) Get(ctx context.Context, id int) (*EscrowAudit, error) {
+	return c.Query().Where(escrowaudit.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EscrowAuditClient) GetX(ctx context.Context, id int) *EscrowAudit {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *EscrowAuditClient) Hooks() []Hook {
+	hooks := c.hooks.EscrowAudit
+	return append(hooks[:len(hooks):len(hooks)], escrowaudit.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *EscrowAuditClient) Interceptors() []Interceptor {
+	return c.inters.EscrowAudit
+}
+
+func (c *EscrowAuditClient) mutate(ctx context.Context, m *EscrowAuditMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EscrowAuditCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EscrowAuditUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EscrowAuditUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EscrowAuditDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown EscrowAudit mutation op: %q", m.Op())
+	}
+}
+
+// KeyEscrowClient is a client for the KeyEscrow schema.
+type KeyEscrowClient struct {
+	config
+}
+
+// NewKeyEscrowClient returns a client for the KeyEscrow from the given config.
+func NewKeyEscrowClient(c config) *KeyEscrowClient {
+	return &KeyEscrowClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `keyescrow.Hooks(f(g(h())))`.
+func (c *KeyEscrowClient) Use(hooks ...Hook) {
+	c.hooks.KeyEscrow = append(c.hooks.KeyEscrow, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `keyescrow.Intercept(f(g(h())))`.
+func (c *KeyEscrowClient) Intercept(interceptors ...Interceptor) {
+	c.inters.KeyEscrow = append(c.inters.KeyEscrow, interceptors...)
+}
+
+// Create returns a builder for creating a KeyEscrow entity.
+func (c *KeyEscrowClient) Create() *KeyEscrowCreate {
+	mutation := newKeyEscrowMutation(c.config, OpCreate)
+	return &KeyEscrowCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of KeyEscrow entities.
+func (c *KeyEscrowClient) CreateBulk(builders ...*KeyEscrowCreate) *KeyEscrowCreateBulk {
+	return &KeyEscrowCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *KeyEscrowClient) MapCreateBulk(slice any, setFunc func(*KeyEscrowCreate, int)) *KeyEscrowCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &KeyEscrowCreateBulk{err: fmt.Errorf("calling to KeyEscrowClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*KeyEscrowCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &KeyEscrowCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for KeyEscrow.
+func (c *KeyEscrowClient) Update() *KeyEscrowUpdate {
+	mutation := newKeyEscrowMutation(c.config, OpUpdate)
+	return &KeyEscrowUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *KeyEscrowClient) UpdateOne(_m *KeyEscrow) *KeyEscrowUpdateOne {
+	mutation := newKeyEscrowMutation(c.config, OpUpdateOne, withKeyEscrow(_m))
+	return &KeyEscrowUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *KeyEscrowClient) UpdateOneID(id int) *KeyEscrowUpdateOne {
+	mutation := newKeyEscrowMutation(c.config, OpUpdateOne, withKeyEscrowID(id))
+	return &KeyEscrowUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for KeyEscrow.
+func (c *KeyEscrowClient) Delete() *KeyEscrowDelete {
+	mutation := newKeyEscrowMutation(c.config, OpDelete)
+	return &KeyEscrowDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *KeyEscrowClient) DeleteOne(_m *KeyEscrow) *KeyEscrowDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *KeyEscrowClient) DeleteOneID(id int) *KeyEscrowDeleteOne {
+	builder := c.Delete().Where(keyescrow.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &KeyEscrowDeleteOne{builder}
+}
+
+// Query returns a query builder for KeyEscrow.
+func (c *KeyEscrowClient) Query() *KeyEscrowQuery {
+	return &KeyEscrowQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeKeyEscrow},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a KeyEscrow entity by its id.
+func (c *KeyEscrowClient) Get(ctx context.Context, id int) (*KeyEscrow, error) {
+	return c.Query().Where(keyescrow.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *KeyEscrowClient) GetX(ctx context.Context, id int) *KeyEscrow {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCertificate queries the certificate edge of a KeyEscrow.
+func (c *KeyEscrowClient) QueryCertificate(_m *KeyEscrow) *SmimeCertificateQuery {
+	query := (&SmimeCertificateClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(keyescrow.Table, keyescrow.FieldID, id),
+			sqlgraph.To(smimecertificate.Table, smimecertificate.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, keyescrow.CertificateTable, keyescrow.CertificateColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRecoveries queries the recoveries edge of a KeyEscrow.
+func (c *KeyEscrowClient) QueryRecoveries(_m *KeyEscrow) *KeyRecoveryQuery {
+	query := (&KeyRecoveryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(keyescrow.Table, keyescrow.FieldID, id),
+			sqlgraph.To(keyrecovery.Table, keyrecovery.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, keyescrow.RecoveriesTable, keyescrow.RecoveriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *KeyEscrowClient) Hooks() []Hook {
+	hooks := c.hooks.KeyEscrow
+	return append(hooks[:len(hooks):len(hooks)], keyescrow.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *KeyEscrowClient) Interceptors() []Interceptor {
+	return c.inters.KeyEscrow
+}
+
+func (c *KeyEscrowClient) mutate(ctx context.Context, m *KeyEscrowMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&KeyEscrowCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&KeyEscrowUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&KeyEscrowUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&KeyEscrowDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown KeyEscrow mutation op: %q", m.Op())
+	}
+}
+
+// KeyRecoveryClient is a client for the KeyRecovery schema.
+type KeyRecoveryClient struct {
+	config
+}
+
+// NewKeyRecoveryClient returns a client for the KeyRecovery from the given config.
+func NewKeyRecoveryClient(c config) *KeyRecoveryClient {
+	return &KeyRecoveryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `keyrecovery.Hooks(f(g(h())))`.
+func (c *KeyRecoveryClient) Use(hooks ...Hook) {
+	c.hooks.KeyRecovery = append(c.hooks.KeyRecovery, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `keyrecovery.Intercept(f(g(h())))`.
+func (c *KeyRecoveryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.KeyRecovery = append(c.inters.KeyRecovery, interceptors...)
+}
+
+// Create returns a builder for creating a KeyRecovery entity.
+func (c *KeyRecoveryClient) Create() *KeyRecoveryCreate {
+	mutation := newKeyRecoveryMutation(c.config, OpCreate)
+	return &KeyRecoveryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of KeyRecovery entities.
+func (c *KeyRecoveryClient) CreateBulk(builders ...*KeyRecoveryCreate) *KeyRecoveryCreateBulk {
+	return &KeyRecoveryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *KeyRecoveryClient) MapCreateBulk(slice any, setFunc func(*KeyRecoveryCreate, int)) *KeyRecoveryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &KeyRecoveryCreateBulk{err: fmt.Errorf("calling to KeyRecoveryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*KeyRecoveryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &KeyRecoveryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for KeyRecovery.
+func (c *KeyRecoveryClient) Update() *KeyRecoveryUpdate {
+	mutation := newKeyRecoveryMutation(c.config, OpUpdate)
+	return &KeyRecoveryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *KeyRecoveryClient) UpdateOne(_m *KeyRecovery) *KeyRecoveryUpdateOne {
+	mutation := newKeyRecoveryMutation(c.config, OpUpdateOne, withKeyRecovery(_m))
+	return &KeyRecoveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *KeyRecoveryClient) UpdateOneID(id int) *KeyRecoveryUpdateOne {
+	mutation := newKeyRecoveryMutation(c.config, OpUpdateOne, withKeyRecoveryID(id))
+	return &KeyRecoveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for KeyRecovery.
+func (c *KeyRecoveryClient) Delete() *KeyRecoveryDelete {
+	mutation := newKeyRecoveryMutation(c.config, OpDelete)
+	return &KeyRecoveryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *KeyRecoveryClient) DeleteOne(_m *KeyRecovery) *KeyRecoveryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *KeyRecoveryClient) DeleteOneID(id int) *KeyRecoveryDeleteOne {
+	builder := c.Delete().Where(keyrecovery.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &KeyRecoveryDeleteOne{builder}
+}
+
+// Query returns a query builder for KeyRecovery.
+func (c *KeyRecoveryClient) Query() *KeyRecoveryQuery {
+	return &KeyRecoveryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeKeyRecovery},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a KeyRecovery entity by its id.
+func (c *KeyRecoveryClient) Get(ctx context.Context, id int) (*KeyRecovery, error) {
+	return c.Query().Where(keyrecovery.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *KeyRecoveryClient) GetX(ctx context.Context, id int) *KeyRecovery {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryEscrow queries the escrow edge of a KeyRecovery.
+func (c *KeyRecoveryClient) QueryEscrow(_m *KeyRecovery) *KeyEscrowQuery {
+	query := (&KeyEscrowClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(keyrecovery.Table, keyrecovery.FieldID, id),
+			sqlgraph.To(keyescrow.Table, keyescrow.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, keyrecovery.EscrowTable, keyrecovery.EscrowColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *KeyRecoveryClient) Hooks() []Hook {
+	return c.hooks.KeyRecovery
+}
+
+// Interceptors returns the client interceptors.
+func (c *KeyRecoveryClient) Interceptors() []Interceptor {
+	return c.inters.KeyRecovery
+}
+
+func (c *KeyRecoveryClient) mutate(ctx context.Context, m *KeyRecoveryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&KeyRecoveryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&KeyRecoveryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&KeyRecoveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&KeyRecoveryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown KeyRecovery mutation op: %q", m.Op())
+	}
+}
+
+// NotificationPreferenceClient is a client for the NotificationPreference schema.
+type NotificationPreferenceClient struct {
+	config
+}
+
+// NewNotificationPreferenceClient returns a client for the NotificationPreference from the given config.
+func NewNotificationPreferenceClient(c config) *NotificationPreferenceClient {
+	return &NotificationPreferenceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `notificationpreference.Hooks(f(g(h())))`.
+func (c *NotificationPreferenceClient) Use(hooks ...Hook) {
+	c.hooks.NotificationPreference = append(c.hooks.NotificationPreference, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `notificationpreference.Intercept(f(g(h())))`.
+func (c *NotificationPreferenceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.NotificationPreference = append(c.inters.NotificationPreference, interceptors...)
+}
+
+// Create returns a builder for creating a NotificationPreference entity.
+func (c *NotificationPreferenceClient) Create() *NotificationPreferenceCreate {
+	mutation := newNotificationPreferenceMutation(c.config, OpCreate)
+	return &NotificationPreferenceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of NotificationPreference entities.
+func (c *NotificationPreferenceClient) CreateBulk(builders ...*NotificationPreferenceCreate) *NotificationPreferenceCreateBulk {
+	return &NotificationPreferenceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NotificationPreferenceClient) MapCreateBulk(slice any, setFunc func(*NotificationPreferenceCreate, int)) *NotificationPreferenceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NotificationPreferenceCreateBulk{err: fmt.Errorf("calling to NotificationPreferenceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NotificationPreferenceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NotificationPreferenceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for NotificationPreference.
+func (c *NotificationPreferenceClient) Update() *NotificationPreferenceUpdate {
+	mutation := newNotificationPreferenceMutation(c.config, OpUpdate)
+	return &NotificationPreferenceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NotificationPreferenceClient) UpdateOne(_m *NotificationPreference) *NotificationPreferenceUpdateOne {
+	mutation := newNotificationPreferenceMutation(c.config, OpUpdateOne, withNotificationPreference(_m))
+	return &NotificationPreferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NotificationPreferenceClient) UpdateOneID(id int) *NotificationPreferenceUpdateOne {
+	mutation := newNotificationPreferenceMutation(c.config, OpUpdateOne, withNotificationPreferenceID(id))
+	return &NotificationPreferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for NotificationPreference.
+func (c *NotificationPreferenceClient) Delete() *NotificationPreferenceDelete {
+	mutation := newNotificationPreferenceMutation(c.config, OpDelete)
+	return &NotificationPreferenceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NotificationPreferenceClient) DeleteOne(_m *NotificationPreference) *NotificationPreferenceDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NotificationPreferenceClient) DeleteOneID(id int) *NotificationPreferenceDeleteOne {
+	builder := c.Delete().Where(notificationpreference.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NotificationPreferenceDeleteOne{builder}
+}
+
+// Query returns a query builder for NotificationPreference.
+func (c *NotificationPreferenceClient) Query() *NotificationPreferenceQuery {
+	return &NotificationPreferenceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNotificationPreference},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a NotificationPreference entity by its id.
+func (c *NotificationPreferenceClient) Get(ctx context.Context, id int) (*NotificationPreference, error) {
+	return c.Query().Where(notificationpreference.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NotificationPreferenceClient) GetX(ctx context.Context, id int) *NotificationPreference {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *NotificationPreferenceClient) Hooks() []Hook {
+	return c.hooks.NotificationPreference
+}
+
+// Interceptors returns the client interceptors.
+func (c *NotificationPreferenceClient) Interceptors() []Interceptor {
+	return c.inters.NotificationPreference
+}
+
+func (c *NotificationPreferenceClient) mutate(ctx context.Context, m *NotificationPreferenceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NotificationPreferenceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NotificationPreferenceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NotificationPreferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NotificationPreferenceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown NotificationPreference mutation op: %q", m.Op())
+	}
+}
+
+// SentNotificationClient is a client for the SentNotification schema.
+type SentNotificationClient struct {
+	config
+}
+
+// NewSentNotificationClient returns a client for the SentNotification from the given config.
+func NewSentNotificationClient(c config) *SentNotificationClient {
+	return &SentNotificationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `sentnotification.Hooks(f(g(h())))`.
+func (c *SentNotificationClient) Use(hooks ...Hook) {
+	c.hooks.SentNotification = append(c.hooks.SentNotification, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `sentnotification.Intercept(f(g(h())))`.
+func (c *SentNotificationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SentNotification = append(c.inters.SentNotification, interceptors...)
+}
+
+// Create returns a builder for creating a SentNotification entity.
+func (c *SentNotificationClient) Create() *SentNotificationCreate {
+	mutation := newSentNotificationMutation(c.config, OpCreate)
+	return &SentNotificationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SentNotification entities.
+func (c *SentNotificationClient) CreateBulk(builders ...*SentNotificationCreate) *SentNotificationCreateBulk {
+	return &SentNotificationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SentNotificationClient) MapCreateBulk(slice any, setFunc func(*SentNotificationCreate, int)) *SentNotificationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SentNotificationCreateBulk{err: fmt.Errorf("calling to SentNotificationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SentNotificationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SentNotificationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SentNotification.
+func (c *SentNotificationClient) Update() *SentNotificationUpdate {
+	mutation := newSentNotificationMutation(c.config, OpUpdate)
+	return &SentNotificationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SentNotificationClient) UpdateOne(_m *SentNotification) *SentNotificationUpdateOne {
+	mutation := newSentNotificationMutation(c.config, OpUpdateOne, withSentNotification(_m))
+	return &SentNotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SentNotificationClient) UpdateOneID(id int) *SentNotificationUpdateOne {
+	mutation := newSentNotificationMutation(c.config, OpUpdateOne, withSentNotificationID(id))
+	return &SentNotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SentNotification.
+func (c *SentNotificationClient) Delete() *SentNotificationDelete {
+	mutation := newSentNotificationMutation(c.config, OpDelete)
+	return &SentNotificationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SentNotificationClient) DeleteOne(_m *SentNotification) *SentNotificationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SentNotificationClient) DeleteOneID(id int) *SentNotificationDeleteOne {
+	builder := c.Delete().Where(sentnotification.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SentNotificationDeleteOne{builder}
+}
+
+// Query returns a query builder for SentNotification.
+func (c *SentNotificationClient) Query() *SentNotificationQuery {
+	return &SentNotificationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSentNotification},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SentNotification entity by its id.
+func (c *SentNotificationClient) Get(ctx context.Context, id int) (*SentNotification, error) {
+	return c.Query().Where(sentnotification.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SentNotificationClient) GetX(ctx context.Context, id int) *SentNotification {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SentNotificationClient) Hooks() []Hook {
+	return c.hooks.SentNotification
+}
+
+// Interceptors returns the client interceptors.
+func (c *SentNotificationClient) Interceptors() []Interceptor {
+	return c.inters.SentNotification
+}
+
+func (c *SentNotificationClient) mutate(ctx context.Context, m *SentNotificationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SentNotificationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SentNotificationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SentNotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SentNotificationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SentNotification mutation op: %q", m.Op())
+	}
+}
+
+// SmimeCertificateClient is a client for the SmimeCertificate schema.
+type SmimeCertificateClient struct {
+	config
+}
+
+// NewSmimeCertificateClient returns a client for the SmimeCertificate from the given config.
+func NewSmimeCertificateClient(c config) *SmimeCertificateClient {
+	return &SmimeCertificateClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `smimecertificate.Hooks(f(g(h())))`.
+func (c *SmimeCertificateClient) Use(hooks ...Hook) {
+	c.hooks.SmimeCertificate = append(c.hooks.SmimeCertificate, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `smimecertificate.Intercept(f(g(h())))`.
+func (c *SmimeCertificateClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SmimeCertificate = append(c.inters.SmimeCertificate, interceptors...)
+}
+
+// Create returns a builder for creating a SmimeCertificate entity.
+func (c *SmimeCertificateClient) Create() *SmimeCertificateCreate {
+	mutation := newSmimeCertificateMutation(c.config, OpCreate)
+	return &SmimeCertificateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SmimeCertificate entities.
+func (c *SmimeCertificateClient) CreateBulk(builders ...*SmimeCertificateCreate) *SmimeCertificateCreateBulk {
+	return &SmimeCertificateCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SmimeCertificateClient) MapCreateBulk(slice any, setFunc func(*SmimeCertificateCreate, int)) *SmimeCertificateCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SmimeCertificateCreateBulk{err: fmt.Errorf("calling to SmimeCertificateClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SmimeCertificateCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SmimeCertificateCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SmimeCertificate.
+func (c *SmimeCertificateClient) Update() *SmimeCertificateUpdate {
+	mutation := newSmimeCertificateMutation(c.config, OpUpdate)
+	return &SmimeCertificateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SmimeCertificateClient) UpdateOne(_m *SmimeCertificate) *SmimeCertificateUpdateOne {
+	mutation := newSmimeCertificateMutation(c.config, OpUpdateOne, withSmimeCertificate(_m))
+	return &SmimeCertificateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SmimeCertificateClient) UpdateOneID(id int) *SmimeCertificateUpdateOne {
+	mutation := newSmimeCertificateMutation(c.config, OpUpdateOne, withSmimeCertificateID(id))
+	return &SmimeCertificateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SmimeCertificate.
+func (c *SmimeCertificateClient) Delete() *SmimeCertificateDelete {
+	mutation := newSmimeCertificateMutation(c.config, OpDelete)
+	return &SmimeCertificateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SmimeCertificateClient) DeleteOne(_m *SmimeCertificate) *SmimeCertificateDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SmimeCertificateClient) DeleteOneID(id int) *SmimeCertificateDeleteOne {
+	builder := c.Delete().Where(smimecertificate.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SmimeCertificateDeleteOne{builder}
+}
+
+// Query returns a query builder for SmimeCertificate.
+func (c *SmimeCertificateClient) Query() *SmimeCertificateQuery {
+	return &SmimeCertificateQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSmimeCertificate},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SmimeCertificate entity by its id.
+func (c *SmimeCertificateClient) Get(ctx context.Context, id int) (*SmimeCertificate, error) {
+	return c.Query().Where(smimecertificate.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SmimeCertificateClient) GetX(ctx context.Context, id int) *SmimeCertificate {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryKeyEscrow queries the keyEscrow edge of a SmimeCertificate.
+func (c *SmimeCertificateClient) QueryKeyEscrow(_m *SmimeCertificate) *KeyEscrowQuery {
+	query := (&KeyEscrowClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(smimecertificate.Table, smimecertificate.FieldID, id),
+			sqlgraph.To(keyescrow.Table, keyescrow.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, smimecertificate.KeyEscrowTable, smimecertificate.KeyEscrowColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SmimeCertificateClient) Hooks() []Hook {
+	hooks := c.hooks.SmimeCertificate
+	return append(hooks[:len(hooks):len(hooks)], smimecertificate.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *SmimeCertificateClient) Interceptors() []Interceptor {
+	return c.inters.SmimeCertificate
+}
+
+func (c *SmimeCertificateClient) mutate(ctx context.Context, m *SmimeCertificateMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SmimeCertificateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SmimeCertificateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SmimeCertificateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SmimeCertificateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SmimeCertificate mutation op: %q", m.Op())
+	}
+}
+
+// TermsAcceptanceClient is a client for the TermsAcceptance schema.
+type TermsAcceptanceClient struct {
+	config
+}
+
+// NewTermsAcceptanceClient returns a client for the TermsAcceptance from the given config.
+func NewTermsAcceptanceClient(c config) *TermsAcceptanceClient {
+	return &TermsAcceptanceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `termsacceptance.Hooks(f(g(h())))`.
+func (c *TermsAcceptanceClient) Use(hooks ...Hook) {
+	c.hooks.TermsAcceptance = append(c.hooks.TermsAcceptance, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `termsacceptance.Intercept(f(g(h())))`.
+func (c *TermsAcceptanceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TermsAcceptance = append(c.inters.TermsAcceptance, interceptors...)
+}
+
+// Create returns a builder for creating a TermsAcceptance entity.
+func (c *TermsAcceptanceClient) Create() *TermsAcceptanceCreate {
+	mutation := newTermsAcceptanceMutation(c.config, OpCreate)
+	return &TermsAcceptanceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TermsAcceptance entities.
+func (c *TermsAcceptanceClient) CreateBulk(builders ...*TermsAcceptanceCreate) *TermsAcceptanceCreateBulk {
+	return &TermsAcceptanceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TermsAcceptanceClient) MapCreateBulk(slice any, setFunc func(*TermsAcceptanceCreate, int)) *TermsAcceptanceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TermsAcceptanceCreateBulk{err: fmt.Errorf("calling to TermsAcceptanceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TermsAcceptanceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TermsAcceptanceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TermsAcceptance.
+func (c *TermsAcceptanceClient) Update() *TermsAcceptanceUpdate {
+	mutation := newTermsAcceptanceMutation(c.config, OpUpdate)
+	return &TermsAcceptanceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TermsAcceptanceClient) UpdateOne(_m *TermsAcceptance) *TermsAcceptanceUpdateOne {
+	mutation := newTermsAcceptanceMutation(c.config, OpUpdateOne, withTermsAcceptance(_m))
+	return &TermsAcceptanceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TermsAcceptanceClient) UpdateOneID(id int) *TermsAcceptanceUpdateOne {
+	mutation := newTermsAcceptanceMutation(c.config, OpUpdateOne, withTermsAcceptanceID(id))
+	return &TermsAcceptanceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TermsAcceptance.
+func (c *TermsAcceptanceClient) Delete() *TermsAcceptanceDelete {
+	mutation := newTermsAcceptanceMutation(c.config, OpDelete)
+	return &TermsAcceptanceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TermsAcceptanceClient) DeleteOne(_m *TermsAcceptance) *TermsAcceptanceDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TermsAcceptanceClient) DeleteOneID(id int) *TermsAcceptanceDeleteOne {
+	builder := c.Delete().Where(termsacceptance.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TermsAcceptanceDeleteOne{builder}
+}
+
+// Query returns a query builder for TermsAcceptance.
+func (c *TermsAcceptanceClient) Query() *TermsAcceptanceQuery {
+	return &TermsAcceptanceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTermsAcceptance},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TermsAcceptance entity by its id.
+func (c *TermsAcceptanceClient) Get(ctx context.Context, id int) (*TermsAcceptance, error) {
+	return c.Query().Where(termsacceptance.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TermsAcceptanceClient) GetX(ctx context.Context, id int) *TermsAcceptance {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TermsAcceptanceClient) Hooks() []Hook {
+	hooks := c.hooks.TermsAcceptance
+	return append(hooks[:len(hooks):len(hooks)], termsacceptance.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *TermsAcceptanceClient) Interceptors() []Interceptor {
+	return c.inters.TermsAcceptance
+}
+
+func (c *TermsAcceptanceClient) mutate(ctx context.Context, m *TermsAcceptanceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TermsAcceptanceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TermsAcceptanceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TermsAcceptanceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TermsAcceptanceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TermsAcceptance mutation op: %q", m.Op())
 	}
 }
 
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Certificate, Domain, SmimeCertificate []ent.Hook
+		AcmeOrder, Certificate, Domain, EmailVerification, EscrowAudit, KeyEscrow,
+		KeyRecovery, NotificationPreference, SentNotification, SmimeCertificate,
+		TermsAcceptance []ent.Hook
 	}
 	inters struct {
-		Certificate, Domain, SmimeCertificate []ent.Interceptor
+		AcmeOrder, Certificate, Domain, EmailVerification, EscrowAudit, KeyEscrow,
+		KeyRecovery, NotificationPreference, SentNotification, SmimeCertificate,
+		TermsAcceptance []ent.Interceptor
 	}
 )
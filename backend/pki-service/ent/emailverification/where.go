@@ -0,0 +1,590 @@
+// Code generated by ent, DO NOT EDIT.
+
+package emailverification
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/hm-edu/pki-service/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// User applies equality check predicate on the "user" field. It's identical to UserEQ.
+func User(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldEQ(FieldUser, v))
+}
+
+// Email applies equality check predicate on the "email" field. It's identical to EmailEQ.
+func Email(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldEQ(FieldEmail, v))
+}
+
+// CodeHash applies equality check predicate on the "codeHash" field. It's identical to CodeHashEQ.
+func CodeHash(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldEQ(FieldCodeHash, v))
+}
+
+// CodeExpires applies equality check predicate on the "codeExpires" field. It's identical to CodeExpiresEQ.
+func CodeExpires(v time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldEQ(FieldCodeExpires, v))
+}
+
+// Attempts applies equality check predicate on the "attempts" field. It's identical to AttemptsEQ.
+func Attempts(v int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldEQ(FieldAttempts, v))
+}
+
+// VerifiedAt applies equality check predicate on the "verifiedAt" field. It's identical to VerifiedAtEQ.
+func VerifiedAt(v time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldEQ(FieldVerifiedAt, v))
+}
+
+// ValidUntil applies equality check predicate on the "validUntil" field. It's identical to ValidUntilEQ.
+func ValidUntil(v time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldEQ(FieldValidUntil, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// UserEQ applies the EQ predicate on the "user" field.
+func UserEQ(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldEQ(FieldUser, v))
+}
+
+// UserNEQ applies the NEQ predicate on the "user" field.
+func UserNEQ(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldNEQ(FieldUser, v))
+}
+
+// UserIn applies the In predicate on the "user" field.
+func UserIn(vs ...string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldIn(FieldUser, vs...))
+}
+
+// UserNotIn applies the NotIn predicate on the "user" field.
+func UserNotIn(vs ...string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldNotIn(FieldUser, vs...))
+}
+
+// UserGT applies the GT predicate on the "user" field.
+func UserGT(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldGT(FieldUser, v))
+}
+
+// UserGTE applies the GTE predicate on the "user" field.
+func UserGTE(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldGTE(FieldUser, v))
+}
+
+// UserLT applies the LT predicate on the "user" field.
+func UserLT(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldLT(FieldUser, v))
+}
+
+// UserLTE applies the LTE predicate on the "user" field.
+func UserLTE(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldLTE(FieldUser, v))
+}
+
+// UserContains applies the Contains predicate on the "user" field.
+func UserContains(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldContains(FieldUser, v))
+}
+
+// UserHasPrefix applies the HasPrefix predicate on the "user" field.
+func UserHasPrefix(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldHasPrefix(FieldUser, v))
+}
+
+// UserHasSuffix applies the HasSuffix predicate on the "user" field.
+func UserHasSuffix(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldHasSuffix(FieldUser, v))
+}
+
+// UserEqualFold applies the EqualFold predicate on the "user" field.
+func UserEqualFold(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldEqualFold(FieldUser, v))
+}
+
+// UserContainsFold applies the ContainsFold predicate on the "user" field.
+func UserContainsFold(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldContainsFold(FieldUser, v))
+}
+
+// EmailEQ applies the EQ predicate on the "email" field.
+func EmailEQ(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldEQ(FieldEmail, v))
+}
+
+// EmailNEQ applies the NEQ predicate on the "email" field.
+func EmailNEQ(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldNEQ(FieldEmail, v))
+}
+
+// EmailIn applies the In predicate on the "email" field.
+func EmailIn(vs ...string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldIn(FieldEmail, vs...))
+}
+
+// EmailNotIn applies the NotIn predicate on the "email" field.
+func EmailNotIn(vs ...string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldNotIn(FieldEmail, vs...))
+}
+
+// EmailGT applies the GT predicate on the "email" field.
+func EmailGT(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldGT(FieldEmail, v))
+}
+
+// EmailGTE applies the GTE predicate on the "email" field.
+func EmailGTE(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldGTE(FieldEmail, v))
+}
+
+// EmailLT applies the LT predicate on the "email" field.
+func EmailLT(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldLT(FieldEmail, v))
+}
+
+// EmailLTE applies the LTE predicate on the "email" field.
+func EmailLTE(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldLTE(FieldEmail, v))
+}
+
+// EmailContains applies the Contains predicate on the "email" field.
+func EmailContains(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldContains(FieldEmail, v))
+}
+
+// EmailHasPrefix applies the HasPrefix predicate on the "email" field.
+func EmailHasPrefix(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldHasPrefix(FieldEmail, v))
+}
+
+// EmailHasSuffix applies the HasSuffix predicate on the "email" field.
+func EmailHasSuffix(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldHasSuffix(FieldEmail, v))
+}
+
+// EmailEqualFold applies the EqualFold predicate on the "email" field.
+func EmailEqualFold(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldEqualFold(FieldEmail, v))
+}
+
+// EmailContainsFold applies the ContainsFold predicate on the "email" field.
+func EmailContainsFold(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldContainsFold(FieldEmail, v))
+}
+
+// CodeHashEQ applies the EQ predicate on the "codeHash" field.
+func CodeHashEQ(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldEQ(FieldCodeHash, v))
+}
+
+// CodeHashNEQ applies the NEQ predicate on the "codeHash" field.
+func CodeHashNEQ(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldNEQ(FieldCodeHash, v))
+}
+
+// CodeHashIn applies the In predicate on the "codeHash" field.
+func CodeHashIn(vs ...string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldIn(FieldCodeHash, vs...))
+}
+
+// CodeHashNotIn applies the NotIn predicate on the "codeHash" field.
+func CodeHashNotIn(vs ...string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldNotIn(FieldCodeHash, vs...))
+}
+
+// CodeHashGT applies the GT predicate on the "codeHash" field.
+func CodeHashGT(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldGT(FieldCodeHash, v))
+}
+
+// CodeHashGTE applies the GTE predicate on the "codeHash" field.
+func CodeHashGTE(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldGTE(FieldCodeHash, v))
+}
+
+// CodeHashLT applies the LT predicate on the "codeHash" field.
+func CodeHashLT(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldLT(FieldCodeHash, v))
+}
+
+// CodeHashLTE applies the LTE predicate on the "codeHash" field.
+func CodeHashLTE(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldLTE(FieldCodeHash, v))
+}
+
+// CodeHashContains applies the Contains predicate on the "codeHash" field.
+func CodeHashContains(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldContains(FieldCodeHash, v))
+}
+
+// CodeHashHasPrefix applies the HasPrefix predicate on the "codeHash" field.
+func CodeHashHasPrefix(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldHasPrefix(FieldCodeHash, v))
+}
+
+// CodeHashHasSuffix applies the HasSuffix predicate on the "codeHash" field.
+func CodeHashHasSuffix(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldHasSuffix(FieldCodeHash, v))
+}
+
+// CodeHashIsNil applies the IsNil predicate on the "codeHash" field.
+func CodeHashIsNil() predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldIsNull(FieldCodeHash))
+}
+
+// CodeHashNotNil applies the NotNil predicate on the "codeHash" field.
+func CodeHashNotNil() predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldNotNull(FieldCodeHash))
+}
+
+// CodeHashEqualFold applies the EqualFold predicate on the "codeHash" field.
+func CodeHashEqualFold(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldEqualFold(FieldCodeHash, v))
+}
+
+// CodeHashContainsFold applies the ContainsFold predicate on the "codeHash" field.
+func CodeHashContainsFold(v string) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldContainsFold(FieldCodeHash, v))
+}
+
+// CodeExpiresEQ applies the EQ predicate on the "codeExpires" field.
+func CodeExpiresEQ(v time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldEQ(FieldCodeExpires, v))
+}
+
+// CodeExpiresNEQ applies the NEQ predicate on the "codeExpires" field.
+func CodeExpiresNEQ(v time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldNEQ(FieldCodeExpires, v))
+}
+
+// CodeExpiresIn applies the In predicate on the "codeExpires" field.
+func CodeExpiresIn(vs ...time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldIn(FieldCodeExpires, vs...))
+}
+
+// CodeExpiresNotIn applies the NotIn predicate on the "codeExpires" field.
+func CodeExpiresNotIn(vs ...time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldNotIn(FieldCodeExpires, vs...))
+}
+
+// CodeExpiresGT applies the GT predicate on the "codeExpires" field.
+func CodeExpiresGT(v time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldGT(FieldCodeExpires, v))
+}
+
+// CodeExpiresGTE applies the GTE predicate on the "codeExpires" field.
+func CodeExpiresGTE(v time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldGTE(FieldCodeExpires, v))
+}
+
+// CodeExpiresLT applies the LT predicate on the "codeExpires" field.
+func CodeExpiresLT(v time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldLT(FieldCodeExpires, v))
+}
+
+// CodeExpiresLTE applies the LTE predicate on the "codeExpires" field.
+func CodeExpiresLTE(v time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldLTE(FieldCodeExpires, v))
+}
+
+// CodeExpiresIsNil applies the IsNil predicate on the "codeExpires" field.
+func CodeExpiresIsNil() predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldIsNull(FieldCodeExpires))
+}
+
+// CodeExpiresNotNil applies the NotNil predicate on the "codeExpires" field.
+func CodeExpiresNotNil() predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldNotNull(FieldCodeExpires))
+}
+
+// AttemptsEQ applies the EQ predicate on the "attempts" field.
+func AttemptsEQ(v int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldEQ(FieldAttempts, v))
+}
+
+// AttemptsNEQ applies the NEQ predicate on the "attempts" field.
+func AttemptsNEQ(v int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldNEQ(FieldAttempts, v))
+}
+
+// AttemptsIn applies the In predicate on the "attempts" field.
+func AttemptsIn(vs ...int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldIn(FieldAttempts, vs...))
+}
+
+// AttemptsNotIn applies the NotIn predicate on the "attempts" field.
+func AttemptsNotIn(vs ...int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldNotIn(FieldAttempts, vs...))
+}
+
+// AttemptsGT applies the GT predicate on the "attempts" field.
+func AttemptsGT(v int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldGT(FieldAttempts, v))
+}
+
+// AttemptsGTE applies the GTE predicate on the "attempts" field.
+func AttemptsGTE(v int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldGTE(FieldAttempts, v))
+}
+
+// AttemptsLT applies the LT predicate on the "attempts" field.
+func AttemptsLT(v int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldLT(FieldAttempts, v))
+}
+
+// AttemptsLTE applies the LTE predicate on the "attempts" field.
+func AttemptsLTE(v int) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldLTE(FieldAttempts, v))
+}
+
+// VerifiedAtEQ applies the EQ predicate on the "verifiedAt" field.
+func VerifiedAtEQ(v time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldEQ(FieldVerifiedAt, v))
+}
+
+// VerifiedAtNEQ applies the NEQ predicate on the "verifiedAt" field.
+func VerifiedAtNEQ(v time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldNEQ(FieldVerifiedAt, v))
+}
+
+// VerifiedAtIn applies the In predicate on the "verifiedAt" field.
+func VerifiedAtIn(vs ...time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldIn(FieldVerifiedAt, vs...))
+}
+
+// VerifiedAtNotIn applies the NotIn predicate on the "verifiedAt" field.
+func VerifiedAtNotIn(vs ...time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldNotIn(FieldVerifiedAt, vs...))
+}
+
+// VerifiedAtGT applies the GT predicate on the "verifiedAt" field.
+func VerifiedAtGT(v time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldGT(FieldVerifiedAt, v))
+}
+
+// VerifiedAtGTE applies the GTE predicate on the "verifiedAt" field.
+func VerifiedAtGTE(v time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldGTE(FieldVerifiedAt, v))
+}
+
+// VerifiedAtLT applies the LT predicate on the "verifiedAt" field.
+func VerifiedAtLT(v time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldLT(FieldVerifiedAt, v))
+}
+
+// VerifiedAtLTE applies the LTE predicate on the "verifiedAt" field.
+func VerifiedAtLTE(v time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldLTE(FieldVerifiedAt, v))
+}
+
+// VerifiedAtIsNil applies the IsNil predicate on the "verifiedAt" field.
+func VerifiedAtIsNil() predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldIsNull(FieldVerifiedAt))
+}
+
+// VerifiedAtNotNil applies the NotNil predicate on the "verifiedAt" field.
+func VerifiedAtNotNil() predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldNotNull(FieldVerifiedAt))
+}
+
+// ValidUntilEQ applies the EQ predicate on the "validUntil" field.
+func ValidUntilEQ(v time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldEQ(FieldValidUntil, v))
+}
+
+// ValidUntilNEQ applies the NEQ predicate on the "validUntil" field.
+func ValidUntilNEQ(v time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldNEQ(FieldValidUntil, v))
+}
+
+// ValidUntilIn applies the In predicate on the "validUntil" field.
+func ValidUntilIn(vs ...time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldIn(FieldValidUntil, vs...))
+}
+
+// ValidUntilNotIn applies the NotIn predicate on the "validUntil" field.
+func ValidUntilNotIn(vs ...time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldNotIn(FieldValidUntil, vs...))
+}
+
+// ValidUntilGT applies the GT predicate on the "validUntil" field.
+func ValidUntilGT(v time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldGT(FieldValidUntil, v))
+}
+
+// ValidUntilGTE applies the GTE predicate on the "validUntil" field.
+func ValidUntilGTE(v time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldGTE(FieldValidUntil, v))
+}
+
+// ValidUntilLT applies the LT predicate on the "validUntil" field.
+func ValidUntilLT(v time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldLT(FieldValidUntil, v))
+}
+
+// ValidUntilLTE applies the LTE predicate on the "validUntil" field.
+func ValidUntilLTE(v time.Time) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldLTE(FieldValidUntil, v))
+}
+
+// ValidUntilIsNil applies the IsNil predicate on the "validUntil" field.
+func ValidUntilIsNil() predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldIsNull(FieldValidUntil))
+}
+
+// ValidUntilNotNil applies the NotNil predicate on the "validUntil" field.
+func ValidUntilNotNil() predicate.EmailVerification {
+	return predicate.EmailVerification(sql.FieldNotNull(FieldValidUntil))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.EmailVerification) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.EmailVerification) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.EmailVerification) predicate.EmailVerification {
+	return predicate.EmailVerification(sql.NotPredicates(p))
+}
@@ -0,0 +1,1023 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hm-edu/pki-service/ent/emailverification"
+)
+
+// EmailVerificationCreate is the builder for creating a EmailVerification entity.
+type EmailVerificationCreate struct {
+	config
+	mutation *EmailVerificationMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *EmailVerificationCreate) SetCreateTime(v time.Time) *EmailVerificationCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *EmailVerificationCreate) SetNillableCreateTime(v *time.Time) *EmailVerificationCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *EmailVerificationCreate) SetUpdateTime(v time.Time) *EmailVerificationCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *EmailVerificationCreate) SetNillableUpdateTime(v *time.Time) *EmailVerificationCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetUser sets the "user" field.
+func (_c *EmailVerificationCreate) SetUser(v string) *EmailVerificationCreate {
+	_c.mutation.SetUser(v)
+	return _c
+}
+
+// SetEmail sets the "email" field.
+func (_c *EmailVerificationCreate) SetEmail(v string) *EmailVerificationCreate {
+	_c.mutation.SetEmail(v)
+	return _c
+}
+
+// SetCodeHash sets the "codeHash" field.
+func (_c *EmailVerificationCreate) SetCodeHash(v string) *EmailVerificationCreate {
+	_c.mutation.SetCodeHash(v)
+	return _c
+}
+
+// SetNillableCodeHash sets the "codeHash" field if the given value is not nil.
+func (_c *EmailVerificationCreate) SetNillableCodeHash(v *string) *EmailVerificationCreate {
+	if v != nil {
+		_c.SetCodeHash(*v)
+	}
+	return _c
+}
+
+// SetCodeExpires sets the "codeExpires" field.
+func (_c *EmailVerificationCreate) SetCodeExpires(v time.Time) *EmailVerificationCreate {
+	_c.mutation.SetCodeExpires(v)
+	return _c
+}
+
+// SetNillableCodeExpires sets the "codeExpires" field if the given value is not nil.
+func (_c *EmailVerificationCreate) SetNillableCodeExpires(v *time.Time) *EmailVerificationCreate {
+	if v != nil {
+		_c.SetCodeExpires(*v)
+	}
+	return _c
+}
+
+// SetAttempts sets the "attempts" field.
+func (_c *EmailVerificationCreate) SetAttempts(v int) *EmailVerificationCreate {
+	_c.mutation.SetAttempts(v)
+	return _c
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_c *EmailVerificationCreate) SetNillableAttempts(v *int) *EmailVerificationCreate {
+	if v != nil {
+		_c.SetAttempts(*v)
+	}
+	return _c
+}
+
+// SetVerifiedAt sets the "verifiedAt" field.
+func (_c *EmailVerificationCreate) SetVerifiedAt(v time.Time) *EmailVerificationCreate {
+	_c.mutation.SetVerifiedAt(v)
+	return _c
+}
+
+// SetNillableVerifiedAt sets the "verifiedAt" field if the given value is not nil.
+func (_c *EmailVerificationCreate) SetNillableVerifiedAt(v *time.Time) *EmailVerificationCreate {
+	if v != nil {
+		_c.SetVerifiedAt(*v)
+	}
+	return _c
+}
+
+// SetValidUntil sets the "validUntil" field.
+func (_c *EmailVerificationCreate) SetValidUntil(v time.Time) *EmailVerificationCreate {
+	_c.mutation.SetValidUntil(v)
+	return _c
+}
+
+// SetNillableValidUntil sets the "validUntil" field if the given value is not nil.
+func (_c *EmailVerificationCreate) SetNillableValidUntil(v *time.Time) *EmailVerificationCreate {
+	if v != nil {
+		_c.SetValidUntil(*v)
+	}
+	return _c
+}
+
+// Mutation returns the EmailVerificationMutation object of the builder.
+func (_c *EmailVerificationCreate) Mutation() *EmailVerificationMutation {
+	return _c.mutation
+}
+
+// Save creates the EmailVerification in the database.
+func (_c *EmailVerificationCreate) Save(ctx context.Context) (*EmailVerification, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *EmailVerificationCreate) SaveX(ctx context.Context) *EmailVerification {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EmailVerificationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EmailVerificationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *EmailVerificationCreate) defaults() {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		v := emailverification.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		v := emailverification.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		v := emailverification.DefaultAttempts
+		_c.mutation.SetAttempts(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *EmailVerificationCreate) check() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "EmailVerification.create_time"`)}
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "EmailVerification.update_time"`)}
+	}
+	if _, ok := _c.mutation.User(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required field "EmailVerification.user"`)}
+	}
+	if v, ok := _c.mutation.User(); ok {
+		if err := emailverification.UserValidator(v); err != nil {
+			return &ValidationError{Name: "user", err: fmt.Errorf(`ent: validator failed for field "EmailVerification.user": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Email(); !ok {
+		return &ValidationError{Name: "email", err: errors.New(`ent: missing required field "EmailVerification.email"`)}
+	}
+	if v, ok := _c.mutation.Email(); ok {
+		if err := emailverification.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "EmailVerification.email": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "EmailVerification.attempts"`)}
+	}
+	return nil
+}
+
+func (_c *EmailVerificationCreate) sqlSave(ctx context.Context) (*EmailVerification, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *EmailVerificationCreate) createSpec() (*EmailVerification, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EmailVerification{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(emailverification.Table, sqlgraph.NewFieldSpec(emailverification.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(emailverification.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(emailverification.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.User(); ok {
+		_spec.SetField(emailverification.FieldUser, field.TypeString, value)
+		_node.User = value
+	}
+	if value, ok := _c.mutation.Email(); ok {
+		_spec.SetField(emailverification.FieldEmail, field.TypeString, value)
+		_node.Email = value
+	}
+	if value, ok := _c.mutation.CodeHash(); ok {
+		_spec.SetField(emailverification.FieldCodeHash, field.TypeString, value)
+		_node.CodeHash = value
+	}
+	if value, ok := _c.mutation.CodeExpires(); ok {
+		_spec.SetField(emailverification.FieldCodeExpires, field.TypeTime, value)
+		_node.CodeExpires = &value
+	}
+	if value, ok := _c.mutation.Attempts(); ok {
+		_spec.SetField(emailverification.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := _c.mutation.VerifiedAt(); ok {
+		_spec.SetField(emailverification.FieldVerifiedAt, field.TypeTime, value)
+		_node.VerifiedAt = &value
+	}
+	if value, ok := _c.mutation.ValidUntil(); ok {
+		_spec.SetField(emailverification.FieldValidUntil, field.TypeTime, value)
+		_node.ValidUntil = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EmailVerification.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EmailVerificationUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *EmailVerificationCreate) OnConflict(opts ...sql.ConflictOption) *EmailVerificationUpsertOne {
+	_c.conflict = opts
+	return &EmailVerificationUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EmailVerification.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *EmailVerificationCreate) OnConflictColumns(columns ...string) *EmailVerificationUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &EmailVerificationUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// EmailVerificationUpsertOne is the builder for "upsert"-ing
+	//  one EmailVerification node.
+	EmailVerificationUpsertOne struct {
+		create *EmailVerificationCreate
+	}
+
+	// EmailVerificationUpsert is the "OnConflict" setter.
+	EmailVerificationUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *EmailVerificationUpsert) SetUpdateTime(v time.Time) *EmailVerificationUpsert {
+	u.Set(emailverification.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *EmailVerificationUpsert) UpdateUpdateTime() *EmailVerificationUpsert {
+	u.SetExcluded(emailverification.FieldUpdateTime)
+	return u
+}
+
+// SetUser sets the "user" field.
+func (u *EmailVerificationUpsert) SetUser(v string) *EmailVerificationUpsert {
+	u.Set(emailverification.FieldUser, v)
+	return u
+}
+
+// UpdateUser sets the "user" field to the value that was provided on create.
+func (u *EmailVerificationUpsert) UpdateUser() *EmailVerificationUpsert {
+	u.SetExcluded(emailverification.FieldUser)
+	return u
+}
+
+// SetEmail sets the "email" field.
+func (u *EmailVerificationUpsert) SetEmail(v string) *EmailVerificationUpsert {
+	u.Set(emailverification.FieldEmail, v)
+	return u
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *EmailVerificationUpsert) UpdateEmail() *EmailVerificationUpsert {
+	u.SetExcluded(emailverification.FieldEmail)
+	return u
+}
+
+// SetCodeHash sets the "codeHash" field.
+func (u *EmailVerificationUpsert) SetCodeHash(v string) *EmailVerificationUpsert {
+	u.Set(emailverification.FieldCodeHash, v)
+	return u
+}
+
+// UpdateCodeHash sets the "codeHash" field to the value that was provided on create.
+func (u *EmailVerificationUpsert) UpdateCodeHash() *EmailVerificationUpsert {
+	u.SetExcluded(emailverification.FieldCodeHash)
+	return u
+}
+
+// ClearCodeHash clears the value of the "codeHash" field.
+func (u *EmailVerificationUpsert) ClearCodeHash() *EmailVerificationUpsert {
+	u.SetNull(emailverification.FieldCodeHash)
+	return u
+}
+
+// SetCodeExpires sets the "codeExpires" field.
+func (u *EmailVerificationUpsert) SetCodeExpires(v time.Time) *EmailVerificationUpsert {
+	u.Set(emailverification.FieldCodeExpires, v)
+	return u
+}
+
+// UpdateCodeExpires sets the "codeExpires" field to the value that was provided on create.
+func (u *EmailVerificationUpsert) UpdateCodeExpires() *EmailVerificationUpsert {
+	u.SetExcluded(emailverification.FieldCodeExpires)
+	return u
+}
+
+// ClearCodeExpires clears the value of the "codeExpires" field.
+func (u *EmailVerificationUpsert) ClearCodeExpires() *EmailVerificationUpsert {
+	u.SetNull(emailverification.FieldCodeExpires)
+	return u
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *EmailVerificationUpsert) SetAttempts(v int) *EmailVerificationUpsert {
+	u.Set(emailverification.FieldAttempts, v)
+	return u
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *EmailVerificationUpsert) UpdateAttempts() *EmailVerificationUpsert {
+	u.SetExcluded(emailverification.FieldAttempts)
+	return u
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *EmailVerificationUpsert) AddAttempts(v int) *EmailVerificationUpsert {
+	u.Add(emailverification.FieldAttempts, v)
+	return u
+}
+
+// SetVerifiedAt sets the "verifiedAt" field.
+func (u *EmailVerificationUpsert) SetVerifiedAt(v time.Time) *EmailVerificationUpsert {
+	u.Set(emailverification.FieldVerifiedAt, v)
+	return u
+}
+
+// UpdateVerifiedAt sets the "verifiedAt" field to the value that was provided on create.
+func (u *EmailVerificationUpsert) UpdateVerifiedAt() *EmailVerificationUpsert {
+	u.SetExcluded(emailverification.FieldVerifiedAt)
+	return u
+}
+
+// ClearVerifiedAt clears the value of the "verifiedAt" field.
+func (u *EmailVerificationUpsert) ClearVerifiedAt() *EmailVerificationUpsert {
+	u.SetNull(emailverification.FieldVerifiedAt)
+	return u
+}
+
+// SetValidUntil sets the "validUntil" field.
+func (u *EmailVerificationUpsert) SetValidUntil(v time.Time) *EmailVerificationUpsert {
+	u.Set(emailverification.FieldValidUntil, v)
+	return u
+}
+
+// UpdateValidUntil sets the "validUntil" field to the value that was provided on create.
+func (u *EmailVerificationUpsert) UpdateValidUntil() *EmailVerificationUpsert {
+	u.SetExcluded(emailverification.FieldValidUntil)
+	return u
+}
+
+// ClearValidUntil clears the value of the "validUntil" field.
+func (u *EmailVerificationUpsert) ClearValidUntil() *EmailVerificationUpsert {
+	u.SetNull(emailverification.FieldValidUntil)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.EmailVerification.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *EmailVerificationUpsertOne) UpdateNewValues() *EmailVerificationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(emailverification.FieldCreateTime)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EmailVerification.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *EmailVerificationUpsertOne) Ignore() *EmailVerificationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EmailVerificationUpsertOne) DoNothing() *EmailVerificationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EmailVerificationCreate.OnConflict
+// documentation for more info.
+func (u *EmailVerificationUpsertOne) Update(set func(*EmailVerificationUpsert)) *EmailVerificationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EmailVerificationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *EmailVerificationUpsertOne) SetUpdateTime(v time.Time) *EmailVerificationUpsertOne {
+	return u.Update(func(s *EmailVerificationUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *EmailVerificationUpsertOne) UpdateUpdateTime() *EmailVerificationUpsertOne {
+	return u.Update(func(s *EmailVerificationUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetUser sets the "user" field.
+func (u *EmailVerificationUpsertOne) SetUser(v string) *EmailVerificationUpsertOne {
+	return u.Update(func(s *EmailVerificationUpsert) {
+		s.SetUser(v)
+	})
+}
+
+// UpdateUser sets the "user" field to the value that was provided on create.
+func (u *EmailVerificationUpsertOne) UpdateUser() *EmailVerificationUpsertOne {
+	return u.Update(func(s *EmailVerificationUpsert) {
+		s.UpdateUser()
+	})
+}
+
+// SetEmail sets the "email" field.
+func (u *EmailVerificationUpsertOne) SetEmail(v string) *EmailVerificationUpsertOne {
+	return u.Update(func(s *EmailVerificationUpsert) {
+		s.SetEmail(v)
+	})
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *EmailVerificationUpsertOne) UpdateEmail() *EmailVerificationUpsertOne {
+	return u.Update(func(s *EmailVerificationUpsert) {
+		s.UpdateEmail()
+	})
+}
+
+// SetCodeHash sets the "codeHash" field.
+func (u *EmailVerificationUpsertOne) SetCodeHash(v string) *EmailVerificationUpsertOne {
+	return u.Update(func(s *EmailVerificationUpsert) {
+		s.SetCodeHash(v)
+	})
+}
+
+// UpdateCodeHash sets the "codeHash" field to the value that was provided on create.
+func (u *EmailVerificationUpsertOne) UpdateCodeHash() *EmailVerificationUpsertOne {
+	return u.Update(func(s *EmailVerificationUpsert) {
+		s.UpdateCodeHash()
+	})
+}
+
+// ClearCodeHash clears the value of the "codeHash" field.
+func (u *EmailVerificationUpsertOne) ClearCodeHash() *EmailVerificationUpsertOne {
+	return u.Update(func(s *EmailVerificationUpsert) {
+		s.ClearCodeHash()
+	})
+}
+
+// SetCodeExpires sets the "codeExpires" field.
+func (u *EmailVerificationUpsertOne) SetCodeExpires(v time.Time) *EmailVerificationUpsertOne {
+	return u.Update(func(s *EmailVerificationUpsert) {
+		s.SetCodeExpires(v)
+	})
+}
+
+// UpdateCodeExpires sets the "codeExpires" field to the value that was provided on create.
+func (u *EmailVerificationUpsertOne) UpdateCodeExpires() *EmailVerificationUpsertOne {
+	return u.Update(func(s *EmailVerificationUpsert) {
+		s.UpdateCodeExpires()
+	})
+}
+
+// ClearCodeExpires clears the value of the "codeExpires" field.
+func (u *EmailVerificationUpsertOne) ClearCodeExpires() *EmailVerificationUpsertOne {
+	return u.Update(func(s *EmailVerificationUpsert) {
+		s.ClearCodeExpires()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *EmailVerificationUpsertOne) SetAttempts(v int) *EmailVerificationUpsertOne {
+	return u.Update(func(s *EmailVerificationUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *EmailVerificationUpsertOne) AddAttempts(v int) *EmailVerificationUpsertOne {
+	return u.Update(func(s *EmailVerificationUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *EmailVerificationUpsertOne) UpdateAttempts() *EmailVerificationUpsertOne {
+	return u.Update(func(s *EmailVerificationUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetVerifiedAt sets the "verifiedAt" field.
+func (u *EmailVerificationUpsertOne) SetVerifiedAt(v time.Time) *EmailVerificationUpsertOne {
+	return u.Update(func(s *EmailVerificationUpsert) {
+		s.SetVerifiedAt(v)
+	})
+}
+
+// UpdateVerifiedAt sets the "verifiedAt" field to the value that was provided on create.
+func (u *EmailVerificationUpsertOne) UpdateVerifiedAt() *EmailVerificationUpsertOne {
+	return u.Update(func(s *EmailVerificationUpsert) {
+		s.UpdateVerifiedAt()
+	})
+}
+
+// ClearVerifiedAt clears the value of the "verifiedAt" field.
+func (u *EmailVerificationUpsertOne) ClearVerifiedAt() *EmailVerificationUpsertOne {
+	return u.Update(func(s *EmailVerificationUpsert) {
+		s.ClearVerifiedAt()
+	})
+}
+
+// SetValidUntil sets the "validUntil" field.
+func (u *EmailVerificationUpsertOne) SetValidUntil(v time.Time) *EmailVerificationUpsertOne {
+	return u.Update(func(s *EmailVerificationUpsert) {
+		s.SetValidUntil(v)
+	})
+}
+
+// UpdateValidUntil sets the "validUntil" field to the value that was provided on create.
+func (u *EmailVerificationUpsertOne) UpdateValidUntil() *EmailVerificationUpsertOne {
+	return u.Update(func(s *EmailVerificationUpsert) {
+		s.UpdateValidUntil()
+	})
+}
+
+// ClearValidUntil clears the value of the "validUntil" field.
+func (u *EmailVerificationUpsertOne) ClearValidUntil() *EmailVerificationUpsertOne {
+	return u.Update(func(s *EmailVerificationUpsert) {
+		s.ClearValidUntil()
+	})
+}
+
+// Exec executes the query.
+func (u *EmailVerificationUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EmailVerificationCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EmailVerificationUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *EmailVerificationUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *EmailVerificationUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// EmailVerificationCreateBulk is the builder for creating many EmailVerification entities in bulk.
+type EmailVerificationCreateBulk struct {
+	config
+	err      error
+	builders []*EmailVerificationCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the EmailVerification entities in the database.
+func (_c *EmailVerificationCreateBulk) Save(ctx context.Context) ([]*EmailVerification, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*EmailVerification, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EmailVerificationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *EmailVerificationCreateBulk) SaveX(ctx context.Context) []*EmailVerification {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EmailVerificationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EmailVerificationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EmailVerification.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EmailVerificationUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *EmailVerificationCreateBulk) OnConflict(opts ...sql.ConflictOption) *EmailVerificationUpsertBulk {
+	_c.conflict = opts
+	return &EmailVerificationUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EmailVerification.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *EmailVerificationCreateBulk) OnConflictColumns(columns ...string) *EmailVerificationUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &EmailVerificationUpsertBulk{
+		create: _c,
+	}
+}
+
+// EmailVerificationUpsertBulk is the builder for "upsert"-ing
+// a bulk of EmailVerification nodes.
+type EmailVerificationUpsertBulk struct {
+	create *EmailVerificationCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.EmailVerification.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *EmailVerificationUpsertBulk) UpdateNewValues() *EmailVerificationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(emailverification.FieldCreateTime)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EmailVerification.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *EmailVerificationUpsertBulk) Ignore() *EmailVerificationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EmailVerificationUpsertBulk) DoNothing() *EmailVerificationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EmailVerificationCreateBulk.OnConflict
+// documentation for more info.
+func (u *EmailVerificationUpsertBulk) Update(set func(*EmailVerificationUpsert)) *EmailVerificationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EmailVerificationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *EmailVerificationUpsertBulk) SetUpdateTime(v time.Time) *EmailVerificationUpsertBulk {
+	return u.Update(func(s *EmailVerificationUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *EmailVerificationUpsertBulk) UpdateUpdateTime() *EmailVerificationUpsertBulk {
+	return u.Update(func(s *EmailVerificationUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetUser sets the "user" field.
+func (u *EmailVerificationUpsertBulk) SetUser(v string) *EmailVerificationUpsertBulk {
+	return u.Update(func(s *EmailVerificationUpsert) {
+		s.SetUser(v)
+	})
+}
+
+// UpdateUser sets the "user" field to the value that was provided on create.
+func (u *EmailVerificationUpsertBulk) UpdateUser() *EmailVerificationUpsertBulk {
+	return u.Update(func(s *EmailVerificationUpsert) {
+		s.UpdateUser()
+	})
+}
+
+// SetEmail sets the "email" field.
+func (u *EmailVerificationUpsertBulk) SetEmail(v string) *EmailVerificationUpsertBulk {
+	return u.Update(func(s *EmailVerificationUpsert) {
+		s.SetEmail(v)
+	})
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *EmailVerificationUpsertBulk) UpdateEmail() *EmailVerificationUpsertBulk {
+	return u.Update(func(s *EmailVerificationUpsert) {
+		s.UpdateEmail()
+	})
+}
+
+// SetCodeHash sets the "codeHash" field.
+func (u *EmailVerificationUpsertBulk) SetCodeHash(v string) *EmailVerificationUpsertBulk {
+	return u.Update(func(s *EmailVerificationUpsert) {
+		s.SetCodeHash(v)
+	})
+}
+
+// UpdateCodeHash sets the "codeHash" field to the value that was provided on create.
+func (u *EmailVerificationUpsertBulk) UpdateCodeHash() *EmailVerificationUpsertBulk {
+	return u.Update(func(s *EmailVerificationUpsert) {
+		s.UpdateCodeHash()
+	})
+}
+
+// ClearCodeHash clears the value of the "codeHash" field.
+func (u *EmailVerificationUpsertBulk) ClearCodeHash() *EmailVerificationUpsertBulk {
+	return u.Update(func(s *EmailVerificationUpsert) {
+		s.ClearCodeHash()
+	})
+}
+
+// SetCodeExpires sets the "codeExpires" field.
+func (u *EmailVerificationUpsertBulk) SetCodeExpires(v time.Time) *EmailVerificationUpsertBulk {
+	return u.Update(func(s *EmailVerificationUpsert) {
+		s.SetCodeExpires(v)
+	})
+}
+
+// UpdateCodeExpires sets the "codeExpires" field to the value that was provided on create.
+func (u *EmailVerificationUpsertBulk) UpdateCodeExpires() *EmailVerificationUpsertBulk {
+	return u.Update(func(s *EmailVerificationUpsert) {
+		s.UpdateCodeExpires()
+	})
+}
+
+// ClearCodeExpires clears the value of the "codeExpires" field.
+func (u *EmailVerificationUpsertBulk) ClearCodeExpires() *EmailVerificationUpsertBulk {
+	return u.Update(func(s *EmailVerificationUpsert) {
+		s.ClearCodeExpires()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *EmailVerificationUpsertBulk) SetAttempts(v int) *EmailVerificationUpsertBulk {
+	return u.Update(func(s *EmailVerificationUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *EmailVerificationUpsertBulk) AddAttempts(v int) *EmailVerificationUpsertBulk {
+	return u.Update(func(s *EmailVerificationUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *EmailVerificationUpsertBulk) UpdateAttempts() *EmailVerificationUpsertBulk {
+	return u.Update(func(s *EmailVerificationUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetVerifiedAt sets the "verifiedAt" field.
+func (u *EmailVerificationUpsertBulk) SetVerifiedAt(v time.Time) *EmailVerificationUpsertBulk {
+	return u.Update(func(s *EmailVerificationUpsert) {
+		s.SetVerifiedAt(v)
+	})
+}
+
+// UpdateVerifiedAt sets the "verifiedAt" field to the value that was provided on create.
+func (u *EmailVerificationUpsertBulk) UpdateVerifiedAt() *EmailVerificationUpsertBulk {
+	return u.Update(func(s *EmailVerificationUpsert) {
+		s.UpdateVerifiedAt()
+	})
+}
+
+// ClearVerifiedAt clears the value of the "verifiedAt" field.
+func (u *EmailVerificationUpsertBulk) ClearVerifiedAt() *EmailVerificationUpsertBulk {
+	return u.Update(func(s *EmailVerificationUpsert) {
+		s.ClearVerifiedAt()
+	})
+}
+
+// SetValidUntil sets the "validUntil" field.
+func (u *EmailVerificationUpsertBulk) SetValidUntil(v time.Time) *EmailVerificationUpsertBulk {
+	return u.Update(func(s *EmailVerificationUpsert) {
+		s.SetValidUntil(v)
+	})
+}
+
+// UpdateValidUntil sets the "validUntil" field to the value that was provided on create.
+func (u *EmailVerificationUpsertBulk) UpdateValidUntil() *EmailVerificationUpsertBulk {
+	return u.Update(func(s *EmailVerificationUpsert) {
+		s.UpdateValidUntil()
+	})
+}
+
+// ClearValidUntil clears the value of the "validUntil" field.
+func (u *EmailVerificationUpsertBulk) ClearValidUntil() *EmailVerificationUpsertBulk {
+	return u.Update(func(s *EmailVerificationUpsert) {
+		s.ClearValidUntil()
+	})
+}
+
+// Exec executes the query.
+func (u *EmailVerificationUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the EmailVerificationCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EmailVerificationCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EmailVerificationUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
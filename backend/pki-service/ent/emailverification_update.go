@@ -0,0 +1,578 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hm-edu/pki-service/ent/emailverification"
+	"github.com/hm-edu/pki-service/ent/predicate"
+)
+
+// EmailVerificationUpdate is the builder for updating EmailVerification entities.
+type EmailVerificationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EmailVerificationMutation
+}
+
+// Where appends a list predicates to the EmailVerificationUpdate builder.
+func (_u *EmailVerificationUpdate) Where(ps ...predicate.EmailVerification) *EmailVerificationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *EmailVerificationUpdate) SetUpdateTime(v time.Time) *EmailVerificationUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetUser sets the "user" field.
+func (_u *EmailVerificationUpdate) SetUser(v string) *EmailVerificationUpdate {
+	_u.mutation.SetUser(v)
+	return _u
+}
+
+// SetNillableUser sets the "user" field if the given value is not nil.
+func (_u *EmailVerificationUpdate) SetNillableUser(v *string) *EmailVerificationUpdate {
+	if v != nil {
+		_u.SetUser(*v)
+	}
+	return _u
+}
+
+// SetEmail sets the "email" field.
+func (_u *EmailVerificationUpdate) SetEmail(v string) *EmailVerificationUpdate {
+	_u.mutation.SetEmail(v)
+	return _u
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *EmailVerificationUpdate) SetNillableEmail(v *string) *EmailVerificationUpdate {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
+	return _u
+}
+
+// SetCodeHash sets the "codeHash" field.
+func (_u *EmailVerificationUpdate) SetCodeHash(v string) *EmailVerificationUpdate {
+	_u.mutation.SetCodeHash(v)
+	return _u
+}
+
+// SetNillableCodeHash sets the "codeHash" field if the given value is not nil.
+func (_u *EmailVerificationUpdate) SetNillableCodeHash(v *string) *EmailVerificationUpdate {
+	if v != nil {
+		_u.SetCodeHash(*v)
+	}
+	return _u
+}
+
+// ClearCodeHash clears the value of the "codeHash" field.
+func (_u *EmailVerificationUpdate) ClearCodeHash() *EmailVerificationUpdate {
+	_u.mutation.ClearCodeHash()
+	return _u
+}
+
+// SetCodeExpires sets the "codeExpires" field.
+func (_u *EmailVerificationUpdate) SetCodeExpires(v time.Time) *EmailVerificationUpdate {
+	_u.mutation.SetCodeExpires(v)
+	return _u
+}
+
+// SetNillableCodeExpires sets the "codeExpires" field if the given value is not nil.
+func (_u *EmailVerificationUpdate) SetNillableCodeExpires(v *time.Time) *EmailVerificationUpdate {
+	if v != nil {
+		_u.SetCodeExpires(*v)
+	}
+	return _u
+}
+
+// ClearCodeExpires clears the value of the "codeExpires" field.
+func (_u *EmailVerificationUpdate) ClearCodeExpires() *EmailVerificationUpdate {
+	_u.mutation.ClearCodeExpires()
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *EmailVerificationUpdate) SetAttempts(v int) *EmailVerificationUpdate {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *EmailVerificationUpdate) SetNillableAttempts(v *int) *EmailVerificationUpdate {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *EmailVerificationUpdate) AddAttempts(v int) *EmailVerificationUpdate {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetVerifiedAt sets the "verifiedAt" field.
+func (_u *EmailVerificationUpdate) SetVerifiedAt(v time.Time) *EmailVerificationUpdate {
+	_u.mutation.SetVerifiedAt(v)
+	return _u
+}
+
+// SetNillableVerifiedAt sets the "verifiedAt" field if the given value is not nil.
+func (_u *EmailVerificationUpdate) SetNillableVerifiedAt(v *time.Time) *EmailVerificationUpdate {
+	if v != nil {
+		_u.SetVerifiedAt(*v)
+	}
+	return _u
+}
+
+// ClearVerifiedAt clears the value of the "verifiedAt" field.
+func (_u *EmailVerificationUpdate) ClearVerifiedAt() *EmailVerificationUpdate {
+	_u.mutation.ClearVerifiedAt()
+	return _u
+}
+
+// SetValidUntil sets the "validUntil" field.
+func (_u *EmailVerificationUpdate) SetValidUntil(v time.Time) *EmailVerificationUpdate {
+	_u.mutation.SetValidUntil(v)
+	return _u
+}
+
+// SetNillableValidUntil sets the "validUntil" field if the given value is not nil.
+func (_u *EmailVerificationUpdate) SetNillableValidUntil(v *time.Time) *EmailVerificationUpdate {
+	if v != nil {
+		_u.SetValidUntil(*v)
+	}
+	return _u
+}
+
+// ClearValidUntil clears the value of the "validUntil" field.
+func (_u *EmailVerificationUpdate) ClearValidUntil() *EmailVerificationUpdate {
+	_u.mutation.ClearValidUntil()
+	return _u
+}
+
+// Mutation returns the EmailVerificationMutation object of the builder.
+func (_u *EmailVerificationUpdate) Mutation() *EmailVerificationMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *EmailVerificationUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EmailVerificationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *EmailVerificationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EmailVerificationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *EmailVerificationUpdate) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := emailverification.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EmailVerificationUpdate) check() error {
+	if v, ok := _u.mutation.User(); ok {
+		if err := emailverification.UserValidator(v); err != nil {
+			return &ValidationError{Name: "user", err: fmt.Errorf(`ent: validator failed for field "EmailVerification.user": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Email(); ok {
+		if err := emailverification.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "EmailVerification.email": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *EmailVerificationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(emailverification.Table, emailverification.Columns, sqlgraph.NewFieldSpec(emailverification.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(emailverification.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.User(); ok {
+		_spec.SetField(emailverification.FieldUser, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(emailverification.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CodeHash(); ok {
+		_spec.SetField(emailverification.FieldCodeHash, field.TypeString, value)
+	}
+	if _u.mutation.CodeHashCleared() {
+		_spec.ClearField(emailverification.FieldCodeHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.CodeExpires(); ok {
+		_spec.SetField(emailverification.FieldCodeExpires, field.TypeTime, value)
+	}
+	if _u.mutation.CodeExpiresCleared() {
+		_spec.ClearField(emailverification.FieldCodeExpires, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(emailverification.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(emailverification.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.VerifiedAt(); ok {
+		_spec.SetField(emailverification.FieldVerifiedAt, field.TypeTime, value)
+	}
+	if _u.mutation.VerifiedAtCleared() {
+		_spec.ClearField(emailverification.FieldVerifiedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ValidUntil(); ok {
+		_spec.SetField(emailverification.FieldValidUntil, field.TypeTime, value)
+	}
+	if _u.mutation.ValidUntilCleared() {
+		_spec.ClearField(emailverification.FieldValidUntil, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{emailverification.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// EmailVerificationUpdateOne is the builder for updating a single EmailVerification entity.
+type EmailVerificationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EmailVerificationMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *EmailVerificationUpdateOne) SetUpdateTime(v time.Time) *EmailVerificationUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetUser sets the "user" field.
+func (_u *EmailVerificationUpdateOne) SetUser(v string) *EmailVerificationUpdateOne {
+	_u.mutation.SetUser(v)
+	return _u
+}
+
+// SetNillableUser sets the "user" field if the given value is not nil.
+func (_u *EmailVerificationUpdateOne) SetNillableUser(v *string) *EmailVerificationUpdateOne {
+	if v != nil {
+		_u.SetUser(*v)
+	}
+	return _u
+}
+
+// SetEmail sets the "email" field.
+func (_u *EmailVerificationUpdateOne) SetEmail(v string) *EmailVerificationUpdateOne {
+	_u.mutation.SetEmail(v)
+	return _u
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *EmailVerificationUpdateOne) SetNillableEmail(v *string) *EmailVerificationUpdateOne {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
+	return _u
+}
+
+// SetCodeHash sets the "codeHash" field.
+func (_u *EmailVerificationUpdateOne) SetCodeHash(v string) *EmailVerificationUpdateOne {
+	_u.mutation.SetCodeHash(v)
+	return _u
+}
+
+// SetNillableCodeHash sets the "codeHash" field if the given value is not nil.
+func (_u *EmailVerificationUpdateOne) SetNillableCodeHash(v *string) *EmailVerificationUpdateOne {
+	if v != nil {
+		_u.SetCodeHash(*v)
+	}
+	return _u
+}
+
+// ClearCodeHash clears the value of the "codeHash" field.
+func (_u *EmailVerificationUpdateOne) ClearCodeHash() *EmailVerificationUpdateOne {
+	_u.mutation.ClearCodeHash()
+	return _u
+}
+
+// SetCodeExpires sets the "codeExpires" field.
+func (_u *EmailVerificationUpdateOne) SetCodeExpires(v time.Time) *EmailVerificationUpdateOne {
+	_u.mutation.SetCodeExpires(v)
+	return _u
+}
+
+// SetNillableCodeExpires sets the "codeExpires" field if the given value is not nil.
+func (_u *EmailVerificationUpdateOne) SetNillableCodeExpires(v *time.Time) *EmailVerificationUpdateOne {
+	if v != nil {
+		_u.SetCodeExpires(*v)
+	}
+	return _u
+}
+
+// ClearCodeExpires clears the value of the "codeExpires" field.
+func (_u *EmailVerificationUpdateOne) ClearCodeExpires() *EmailVerificationUpdateOne {
+	_u.mutation.ClearCodeExpires()
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *EmailVerificationUpdateOne) SetAttempts(v int) *EmailVerificationUpdateOne {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *EmailVerificationUpdateOne) SetNillableAttempts(v *int) *EmailVerificationUpdateOne {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *EmailVerificationUpdateOne) AddAttempts(v int) *EmailVerificationUpdateOne {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetVerifiedAt sets the "verifiedAt" field.
+func (_u *EmailVerificationUpdateOne) SetVerifiedAt(v time.Time) *EmailVerificationUpdateOne {
+	_u.mutation.SetVerifiedAt(v)
+	return _u
+}
+
+// SetNillableVerifiedAt sets the "verifiedAt" field if the given value is not nil.
+func (_u *EmailVerificationUpdateOne) SetNillableVerifiedAt(v *time.Time) *EmailVerificationUpdateOne {
+	if v != nil {
+		_u.SetVerifiedAt(*v)
+	}
+	return _u
+}
+
+// ClearVerifiedAt clears the value of the "verifiedAt" field.
+func (_u *EmailVerificationUpdateOne) ClearVerifiedAt() *EmailVerificationUpdateOne {
+	_u.mutation.ClearVerifiedAt()
+	return _u
+}
+
+// SetValidUntil sets the "validUntil" field.
+func (_u *EmailVerificationUpdateOne) SetValidUntil(v time.Time) *EmailVerificationUpdateOne {
+	_u.mutation.SetValidUntil(v)
+	return _u
+}
+
+// SetNillableValidUntil sets the "validUntil" field if the given value is not nil.
+func (_u *EmailVerificationUpdateOne) SetNillableValidUntil(v *time.Time) *EmailVerificationUpdateOne {
+	if v != nil {
+		_u.SetValidUntil(*v)
+	}
+	return _u
+}
+
+// ClearValidUntil clears the value of the "validUntil" field.
+func (_u *EmailVerificationUpdateOne) ClearValidUntil() *EmailVerificationUpdateOne {
+	_u.mutation.ClearValidUntil()
+	return _u
+}
+
+// Mutation returns the EmailVerificationMutation object of the builder.
+func (_u *EmailVerificationUpdateOne) Mutation() *EmailVerificationMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the EmailVerificationUpdate builder.
+func (_u *EmailVerificationUpdateOne) Where(ps ...predicate.EmailVerification) *EmailVerificationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *EmailVerificationUpdateOne) Select(field string, fields ...string) *EmailVerificationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated EmailVerification entity.
+func (_u *EmailVerificationUpdateOne) Save(ctx context.Context) (*EmailVerification, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EmailVerificationUpdateOne) SaveX(ctx context.Context) *EmailVerification {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *EmailVerificationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EmailVerificationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *EmailVerificationUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := emailverification.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EmailVerificationUpdateOne) check() error {
+	if v, ok := _u.mutation.User(); ok {
+		if err := emailverification.UserValidator(v); err != nil {
+			return &ValidationError{Name: "user", err: fmt.Errorf(`ent: validator failed for field "EmailVerification.user": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Email(); ok {
+		if err := emailverification.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "EmailVerification.email": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *EmailVerificationUpdateOne) sqlSave(ctx context.Context) (_node *EmailVerification, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(emailverification.Table, emailverification.Columns, sqlgraph.NewFieldSpec(emailverification.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EmailVerification.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, emailverification.FieldID)
+		for _, f := range fields {
+			if !emailverification.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != emailverification.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(emailverification.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.User(); ok {
+		_spec.SetField(emailverification.FieldUser, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(emailverification.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CodeHash(); ok {
+		_spec.SetField(emailverification.FieldCodeHash, field.TypeString, value)
+	}
+	if _u.mutation.CodeHashCleared() {
+		_spec.ClearField(emailverification.FieldCodeHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.CodeExpires(); ok {
+		_spec.SetField(emailverification.FieldCodeExpires, field.TypeTime, value)
+	}
+	if _u.mutation.CodeExpiresCleared() {
+		_spec.ClearField(emailverification.FieldCodeExpires, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(emailverification.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(emailverification.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.VerifiedAt(); ok {
+		_spec.SetField(emailverification.FieldVerifiedAt, field.TypeTime, value)
+	}
+	if _u.mutation.VerifiedAtCleared() {
+		_spec.ClearField(emailverification.FieldVerifiedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ValidUntil(); ok {
+		_spec.SetField(emailverification.FieldValidUntil, field.TypeTime, value)
+	}
+	if _u.mutation.ValidUntilCleared() {
+		_spec.ClearField(emailverification.FieldValidUntil, field.TypeTime)
+	}
+	_node = &EmailVerification{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{emailverification.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
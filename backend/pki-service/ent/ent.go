@@ -12,9 +12,17 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/hm-edu/pki-service/ent/acmeorder"
 	"github.com/hm-edu/pki-service/ent/certificate"
 	"github.com/hm-edu/pki-service/ent/domain"
+	"github.com/hm-edu/pki-service/ent/emailverification"
+	"github.com/hm-edu/pki-service/ent/escrowaudit"
+	"github.com/hm-edu/pki-service/ent/keyescrow"
+	"github.com/hm-edu/pki-service/ent/keyrecovery"
+	"github.com/hm-edu/pki-service/ent/notificationpreference"
+	"github.com/hm-edu/pki-service/ent/sentnotification"
 	"github.com/hm-edu/pki-service/ent/smimecertificate"
+	"github.com/hm-edu/pki-service/ent/termsacceptance"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -75,9 +83,17 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			certificate.Table:      certificate.ValidColumn,
-			domain.Table:           domain.ValidColumn,
-			smimecertificate.Table: smimecertificate.ValidColumn,
+			acmeorder.Table:              acmeorder.ValidColumn,
+			certificate.Table:            certificate.ValidColumn,
+			domain.Table:                 domain.ValidColumn,
+			emailverification.Table:      emailverification.ValidColumn,
+			escrowaudit.Table:            escrowaudit.ValidColumn,
+			keyescrow.Table:              keyescrow.ValidColumn,
+			keyrecovery.Table:            keyrecovery.ValidColumn,
+			notificationpreference.Table: notificationpreference.ValidColumn,
+			sentnotification.Table:       sentnotification.ValidColumn,
+			smimecertificate.Table:       smimecertificate.ValidColumn,
+			termsacceptance.Table:        termsacceptance.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
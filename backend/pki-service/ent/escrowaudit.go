@@ -0,0 +1,172 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/hm-edu/pki-service/ent/escrowaudit"
+)
+
+// EscrowAudit is the model entity for the EscrowAudit schema.
+type EscrowAudit struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// Action holds the value of the "action" field.
+	Action string `json:"action,omitempty"`
+	// Actor holds the value of the "actor" field.
+	Actor string `json:"actor,omitempty"`
+	// Serial holds the value of the "serial" field.
+	Serial string `json:"serial,omitempty"`
+	// RecoveryId holds the value of the "recoveryId" field.
+	RecoveryId int `json:"recoveryId,omitempty"`
+	// Details holds the value of the "details" field.
+	Details      string `json:"details,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EscrowAudit) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case escrowaudit.FieldID, escrowaudit.FieldRecoveryId:
+			values[i] = new(sql.NullInt64)
+		case escrowaudit.FieldAction, escrowaudit.FieldActor, escrowaudit.FieldSerial, escrowaudit.FieldDetails:
+			values[i] = new(sql.NullString)
+		case escrowaudit.FieldCreateTime, escrowaudit.FieldUpdateTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EscrowAudit fields.
+func (_m *EscrowAudit) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case escrowaudit.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case escrowaudit.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = value.Time
+			}
+		case escrowaudit.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = value.Time
+			}
+		case escrowaudit.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				_m.Action = value.String
+			}
+		case escrowaudit.FieldActor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field actor", values[i])
+			} else if value.Valid {
+				_m.Actor = value.String
+			}
+		case escrowaudit.FieldSerial:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field serial", values[i])
+			} else if value.Valid {
+				_m.Serial = value.String
+			}
+		case escrowaudit.FieldRecoveryId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field recoveryId", values[i])
+			} else if value.Valid {
+				_m.RecoveryId = int(value.Int64)
+			}
+		case escrowaudit.FieldDetails:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field details", values[i])
+			} else if value.Valid {
+				_m.Details = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EscrowAudit.
+// This includes values selected through modifiers, order, etc.
+func (_m *EscrowAudit) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this EscrowAudit.
+// Note that you need to call EscrowAudit.Unwrap() before calling this method if this EscrowAudit
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *EscrowAudit) Update() *EscrowAuditUpdateOne {
+	return NewEscrowAuditClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the EscrowAudit entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *EscrowAudit) Unwrap() *EscrowAudit {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: EscrowAudit is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *EscrowAudit) String() string {
+	var builder strings.Builder
+	builder.WriteString("EscrowAudit(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(_m.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(_m.Action)
+	builder.WriteString(", ")
+	builder.WriteString("actor=")
+	builder.WriteString(_m.Actor)
+	builder.WriteString(", ")
+	builder.WriteString("serial=")
+	builder.WriteString(_m.Serial)
+	builder.WriteString(", ")
+	builder.WriteString("recoveryId=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RecoveryId))
+	builder.WriteString(", ")
+	builder.WriteString("details=")
+	builder.WriteString(_m.Details)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EscrowAudits is a parsable slice of EscrowAudit.
+type EscrowAudits []*EscrowAudit
@@ -0,0 +1,515 @@
+// Code generated by ent, DO NOT EDIT.
+
+package escrowaudit
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/hm-edu/pki-service/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// Action applies equality check predicate on the "action" field. It's identical to ActionEQ.
+func Action(v string) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldEQ(FieldAction, v))
+}
+
+// Actor applies equality check predicate on the "actor" field. It's identical to ActorEQ.
+func Actor(v string) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldEQ(FieldActor, v))
+}
+
+// Serial applies equality check predicate on the "serial" field. It's identical to SerialEQ.
+func Serial(v string) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldEQ(FieldSerial, v))
+}
+
+// RecoveryId applies equality check predicate on the "recoveryId" field. It's identical to RecoveryIdEQ.
+func RecoveryId(v int) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldEQ(FieldRecoveryId, v))
+}
+
+// Details applies equality check predicate on the "details" field. It's identical to DetailsEQ.
+func Details(v string) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldEQ(FieldDetails, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v string) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldEQ(FieldAction, v))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v string) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldNEQ(FieldAction, v))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...string) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldIn(FieldAction, vs...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...string) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldNotIn(FieldAction, vs...))
+}
+
+// ActionGT applies the GT predicate on the "action" field.
+func ActionGT(v string) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldGT(FieldAction, v))
+}
+
+// ActionGTE applies the GTE predicate on the "action" field.
+func ActionGTE(v string) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldGTE(FieldAction, v))
+}
+
+// ActionLT applies the LT predicate on the "action" field.
+func ActionLT(v string) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldLT(FieldAction, v))
+}
+
+// ActionLTE applies the LTE predicate on the "action" field.
+func ActionLTE(v string) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldLTE(FieldAction, v))
+}
+
+// ActionContains applies the Contains predicate on the "action" field.
+func ActionContains(v string) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldContains(FieldAction, v))
+}
+
+// ActionHasPrefix applies the HasPrefix predicate on the "action" field.
+func ActionHasPrefix(v string) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldHasPrefix(FieldAction, v))
+}
+
+// ActionHasSuffix applies the HasSuffix predicate on the "action" field.
+func ActionHasSuffix(v string) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldHasSuffix(FieldAction, v))
+}
+
+// ActionEqualFold applies the EqualFold predicate on the "action" field.
+func ActionEqualFold(v string) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldEqualFold(FieldAction, v))
+}
+
+// ActionContainsFold applies the ContainsFold predicate on the "action" field.
+func ActionContainsFold(v string) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldContainsFold(FieldAction, v))
+}
+
+// ActorEQ applies the EQ predicate on the "actor" field.
+func ActorEQ(v string) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldEQ(FieldActor, v))
+}
+
+// ActorNEQ applies the NEQ predicate on the "actor" field.
+func ActorNEQ(v string) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldNEQ(FieldActor, v))
+}
+
+// ActorIn applies the In predicate on the "actor" field.
+func ActorIn(vs ...string) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldIn(FieldActor, vs...))
+}
+
+// ActorNotIn applies the NotIn predicate on the "actor" field.
+func ActorNotIn(vs ...string) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldNotIn(FieldActor, vs...))
+}
+
+// ActorGT applies the GT predicate on the "actor" field.
+func ActorGT(v string) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldGT(FieldActor, v))
+}
+
+// ActorGTE applies the GTE predicate on the "actor" field.
+func ActorGTE(v string) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldGTE(FieldActor, v))
+}
+
+// ActorLT applies the LT predicate on the "actor" field.
+func ActorLT(v string) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldLT(FieldActor, v))
+}
+
+// ActorLTE applies the LTE predicate on the "actor" field.
+func ActorLTE(v string) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldLTE(FieldActor, v))
+}
+
+// ActorContains applies the Contains predicate on the "actor" field.
+func ActorContains(v string) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldContains(FieldActor, v))
+}
+
+// ActorHasPrefix applies the HasPrefix predicate on the "actor" field.
+func ActorHasPrefix(v string) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldHasPrefix(FieldActor, v))
+}
+
+// ActorHasSuffix applies the HasSuffix predicate on the "actor" field.
+func ActorHasSuffix(v string) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldHasSuffix(FieldActor, v))
+}
+
+// ActorEqualFold applies the EqualFold predicate on the "actor" field.
+func ActorEqualFold(v string) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldEqualFold(FieldActor, v))
+}
+
+// ActorContainsFold applies the ContainsFold predicate on the "actor" field.
+func ActorContainsFold(v string) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldContainsFold(FieldActor, v))
+}
+
+// SerialEQ applies the EQ predicate on the "serial" field.
+func SerialEQ(v string) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldEQ(FieldSerial, v))
+}
+
+// SerialNEQ applies the NEQ predicate on the "serial" field.
+func SerialNEQ(v string) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldNEQ(FieldSerial, v))
+}
+
+// SerialIn applies the In predicate on the "serial" field.
+func SerialIn(vs ...string) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldIn(FieldSerial, vs...))
+}
+
+// SerialNotIn applies the NotIn predicate on the "serial" field.
+func SerialNotIn(vs ...string) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldNotIn(FieldSerial, vs...))
+}
+
+// SerialGT applies the GT predicate on the "serial" field.
+func SerialGT(v string) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldGT(FieldSerial, v))
+}
+
+// SerialGTE applies the GTE predicate on the "serial" field.
+func SerialGTE(v string) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldGTE(FieldSerial, v))
+}
+
+// SerialLT applies the LT predicate on the "serial" field.
+func SerialLT(v string) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldLT(FieldSerial, v))
+}
+
+// SerialLTE applies the LTE predicate on the "serial" field.
+func SerialLTE(v string) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldLTE(FieldSerial, v))
+}
+
+// SerialContains applies the Contains predicate on the "serial" field.
+func SerialContains(v string) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldContains(FieldSerial, v))
+}
+
+// SerialHasPrefix applies the HasPrefix predicate on the "serial" field.
+func SerialHasPrefix(v string) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldHasPrefix(FieldSerial, v))
+}
+
+// SerialHasSuffix applies the HasSuffix predicate on the "serial" field.
+func SerialHasSuffix(v string) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldHasSuffix(FieldSerial, v))
+}
+
+// SerialIsNil applies the IsNil predicate on the "serial" field.
+func SerialIsNil() predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldIsNull(FieldSerial))
+}
+
+// SerialNotNil applies the NotNil predicate on the "serial" field.
+func SerialNotNil() predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldNotNull(FieldSerial))
+}
+
+// SerialEqualFold applies the EqualFold predicate on the "serial" field.
+func SerialEqualFold(v string) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldEqualFold(FieldSerial, v))
+}
+
+// SerialContainsFold applies the ContainsFold predicate on the "serial" field.
+func SerialContainsFold(v string) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldContainsFold(FieldSerial, v))
+}
+
+// RecoveryIdEQ applies the EQ predicate on the "recoveryId" field.
+func RecoveryIdEQ(v int) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldEQ(FieldRecoveryId, v))
+}
+
+// RecoveryIdNEQ applies the NEQ predicate on the "recoveryId" field.
+func RecoveryIdNEQ(v int) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldNEQ(FieldRecoveryId, v))
+}
+
+// RecoveryIdIn applies the In predicate on the "recoveryId" field.
+func RecoveryIdIn(vs ...int) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldIn(FieldRecoveryId, vs...))
+}
+
+// RecoveryIdNotIn applies the NotIn predicate on the "recoveryId" field.
+func RecoveryIdNotIn(vs ...int) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldNotIn(FieldRecoveryId, vs...))
+}
+
+// RecoveryIdGT applies the GT predicate on the "recoveryId" field.
+func RecoveryIdGT(v int) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldGT(FieldRecoveryId, v))
+}
+
+// RecoveryIdGTE applies the GTE predicate on the "recoveryId" field.
+func RecoveryIdGTE(v int) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldGTE(FieldRecoveryId, v))
+}
+
+// RecoveryIdLT applies the LT predicate on the "recoveryId" field.
+func RecoveryIdLT(v int) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldLT(FieldRecoveryId, v))
+}
+
+// RecoveryIdLTE applies the LTE predicate on the "recoveryId" field.
+func RecoveryIdLTE(v int) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldLTE(FieldRecoveryId, v))
+}
+
+// RecoveryIdIsNil applies the IsNil predicate on the "recoveryId" field.
+func RecoveryIdIsNil() predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldIsNull(FieldRecoveryId))
+}
+
+// RecoveryIdNotNil applies the NotNil predicate on the "recoveryId" field.
+func RecoveryIdNotNil() predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldNotNull(FieldRecoveryId))
+}
+
+// DetailsEQ applies the EQ predicate on the "details" field.
+func DetailsEQ(v string) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldEQ(FieldDetails, v))
+}
+
+// DetailsNEQ applies the NEQ predicate on the "details" field.
+func DetailsNEQ(v string) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldNEQ(FieldDetails, v))
+}
+
+// DetailsIn applies the In predicate on the "details" field.
+func DetailsIn(vs ...string) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldIn(FieldDetails, vs...))
+}
+
+// DetailsNotIn applies the NotIn predicate on the "details" field.
+func DetailsNotIn(vs ...string) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldNotIn(FieldDetails, vs...))
+}
+
+// DetailsGT applies the GT predicate on the "details" field.
+func DetailsGT(v string) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldGT(FieldDetails, v))
+}
+
+// DetailsGTE applies the GTE predicate on the "details" field.
+func DetailsGTE(v string) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldGTE(FieldDetails, v))
+}
+
+// DetailsLT applies the LT predicate on the "details" field.
+func DetailsLT(v string) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldLT(FieldDetails, v))
+}
+
+// DetailsLTE applies the LTE predicate on the "details" field.
+func DetailsLTE(v string) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldLTE(FieldDetails, v))
+}
+
+// DetailsContains applies the Contains predicate on the "details" field.
+func DetailsContains(v string) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldContains(FieldDetails, v))
+}
+
+// DetailsHasPrefix applies the HasPrefix predicate on the "details" field.
+func DetailsHasPrefix(v string) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldHasPrefix(FieldDetails, v))
+}
+
+// DetailsHasSuffix applies the HasSuffix predicate on the "details" field.
+func DetailsHasSuffix(v string) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldHasSuffix(FieldDetails, v))
+}
+
+// DetailsIsNil applies the IsNil predicate on the "details" field.
+func DetailsIsNil() predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldIsNull(FieldDetails))
+}
+
+// DetailsNotNil applies the NotNil predicate on the "details" field.
+func DetailsNotNil() predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldNotNull(FieldDetails))
+}
+
+// DetailsEqualFold applies the EqualFold predicate on the "details" field.
+func DetailsEqualFold(v string) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldEqualFold(FieldDetails, v))
+}
+
+// DetailsContainsFold applies the ContainsFold predicate on the "details" field.
+func DetailsContainsFold(v string) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.FieldContainsFold(FieldDetails, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.EscrowAudit) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.EscrowAudit) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.EscrowAudit) predicate.EscrowAudit {
+	return predicate.EscrowAudit(sql.NotPredicates(p))
+}
@@ -0,0 +1,889 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hm-edu/pki-service/ent/escrowaudit"
+)
+
+// EscrowAuditCreate is the builder for creating a EscrowAudit entity.
+type EscrowAuditCreate struct {
+	config
+	mutation *EscrowAuditMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *EscrowAuditCreate) SetCreateTime(v time.Time) *EscrowAuditCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *EscrowAuditCreate) SetNillableCreateTime(v *time.Time) *EscrowAuditCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *EscrowAuditCreate) SetUpdateTime(v time.Time) *EscrowAuditCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *EscrowAuditCreate) SetNillableUpdateTime(v *time.Time) *EscrowAuditCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetAction sets the "action" field.
+func (_c *EscrowAuditCreate) SetAction(v string) *EscrowAuditCreate {
+	_c.mutation.SetAction(v)
+	return _c
+}
+
+// SetActor sets the "actor" field.
+func (_c *EscrowAuditCreate) SetActor(v string) *EscrowAuditCreate {
+	_c.mutation.SetActor(v)
+	return _c
+}
+
+// SetSerial sets the "serial" field.
+func (_c *EscrowAuditCreate) SetSerial(v string) *EscrowAuditCreate {
+	_c.mutation.SetSerial(v)
+	return _c
+}
+
+// SetNillableSerial sets the "serial" field if the given value is not nil.
+func (_c *EscrowAuditCreate) SetNillableSerial(v *string) *EscrowAuditCreate {
+	if v != nil {
+		_c.SetSerial(*v)
+	}
+	return _c
+}
+
+// SetRecoveryId sets the "recoveryId" field.
+func (_c *EscrowAuditCreate) SetRecoveryId(v int) *EscrowAuditCreate {
+	_c.mutation.SetRecoveryId(v)
+	return _c
+}
+
+// SetNillableRecoveryId sets the "recoveryId" field if the given value is not nil.
+func (_c *EscrowAuditCreate) SetNillableRecoveryId(v *int) *EscrowAuditCreate {
+	if v != nil {
+		_c.SetRecoveryId(*v)
+	}
+	return _c
+}
+
+// SetDetails sets the "details" field.
+func (_c *EscrowAuditCreate) SetDetails(v string) *EscrowAuditCreate {
+	_c.mutation.SetDetails(v)
+	return _c
+}
+
+// SetNillableDetails sets the "details" field if the given value is not nil.
+func (_c *EscrowAuditCreate) SetNillableDetails(v *string) *EscrowAuditCreate {
+	if v != nil {
+		_c.SetDetails(*v)
+	}
+	return _c
+}
+
+// Mutation returns the EscrowAuditMutation object of the builder.
+func (_c *EscrowAuditCreate) Mutation() *EscrowAuditMutation {
+	return _c.mutation
+}
+
+// Save creates the EscrowAudit in the database.
+func (_c *EscrowAuditCreate) Save(ctx context.Context) (*EscrowAudit, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *EscrowAuditCreate) SaveX(ctx context.Context) *EscrowAudit {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EscrowAuditCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EscrowAuditCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *EscrowAuditCreate) defaults() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		if escrowaudit.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized escrowaudit.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
+		v := escrowaudit.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		if escrowaudit.DefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized escrowaudit.DefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := escrowaudit.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *EscrowAuditCreate) check() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "EscrowAudit.create_time"`)}
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "EscrowAudit.update_time"`)}
+	}
+	if _, ok := _c.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "EscrowAudit.action"`)}
+	}
+	if v, ok := _c.mutation.Action(); ok {
+		if err := escrowaudit.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "EscrowAudit.action": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Actor(); !ok {
+		return &ValidationError{Name: "actor", err: errors.New(`ent: missing required field "EscrowAudit.actor"`)}
+	}
+	if v, ok := _c.mutation.Actor(); ok {
+		if err := escrowaudit.ActorValidator(v); err != nil {
+			return &ValidationError{Name: "actor", err: fmt.Errorf(`ent: validator failed for field "EscrowAudit.actor": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *EscrowAuditCreate) sqlSave(ctx context.Context) (*EscrowAudit, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *EscrowAuditCreate) createSpec() (*EscrowAudit, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EscrowAudit{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(escrowaudit.Table, sqlgraph.NewFieldSpec(escrowaudit.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(escrowaudit.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(escrowaudit.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.Action(); ok {
+		_spec.SetField(escrowaudit.FieldAction, field.TypeString, value)
+		_node.Action = value
+	}
+	if value, ok := _c.mutation.Actor(); ok {
+		_spec.SetField(escrowaudit.FieldActor, field.TypeString, value)
+		_node.Actor = value
+	}
+	if value, ok := _c.mutation.Serial(); ok {
+		_spec.SetField(escrowaudit.FieldSerial, field.TypeString, value)
+		_node.Serial = value
+	}
+	if value, ok := _c.mutation.RecoveryId(); ok {
+		_spec.SetField(escrowaudit.FieldRecoveryId, field.TypeInt, value)
+		_node.RecoveryId = value
+	}
+	if value, ok := _c.mutation.Details(); ok {
+		_spec.SetField(escrowaudit.FieldDetails, field.TypeString, value)
+		_node.Details = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EscrowAudit.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EscrowAuditUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *EscrowAuditCreate) OnConflict(opts ...sql.ConflictOption) *EscrowAuditUpsertOne {
+	_c.conflict = opts
+	return &EscrowAuditUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EscrowAudit.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *EscrowAuditCreate) OnConflictColumns(columns ...string) *EscrowAuditUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &EscrowAuditUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// EscrowAuditUpsertOne is the builder for "upsert"-ing
+	//  one EscrowAudit node.
+	EscrowAuditUpsertOne struct {
+		create *EscrowAuditCreate
+	}
+
+	// EscrowAuditUpsert is the "OnConflict" setter.
+	EscrowAuditUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *EscrowAuditUpsert) SetUpdateTime(v time.Time) *EscrowAuditUpsert {
+	u.Set(escrowaudit.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *EscrowAuditUpsert) UpdateUpdateTime() *EscrowAuditUpsert {
+	u.SetExcluded(escrowaudit.FieldUpdateTime)
+	return u
+}
+
+// SetAction sets the "action" field.
+func (u *EscrowAuditUpsert) SetAction(v string) *EscrowAuditUpsert {
+	u.Set(escrowaudit.FieldAction, v)
+	return u
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *EscrowAuditUpsert) UpdateAction() *EscrowAuditUpsert {
+	u.SetExcluded(escrowaudit.FieldAction)
+	return u
+}
+
+// SetActor sets the "actor" field.
+func (u *EscrowAuditUpsert) SetActor(v string) *EscrowAuditUpsert {
+	u.Set(escrowaudit.FieldActor, v)
+	return u
+}
+
+// UpdateActor sets the "actor" field to the value that was provided on create.
+func (u *EscrowAuditUpsert) UpdateActor() *EscrowAuditUpsert {
+	u.SetExcluded(escrowaudit.FieldActor)
+	return u
+}
+
+// SetSerial sets the "serial" field.
+func (u *EscrowAuditUpsert) SetSerial(v string) *EscrowAuditUpsert {
+	u.Set(escrowaudit.FieldSerial, v)
+	return u
+}
+
+// UpdateSerial sets the "serial" field to the value that was provided on create.
+func (u *EscrowAuditUpsert) UpdateSerial() *EscrowAuditUpsert {
+	u.SetExcluded(escrowaudit.FieldSerial)
+	return u
+}
+
+// ClearSerial clears the value of the "serial" field.
+func (u *EscrowAuditUpsert) ClearSerial() *EscrowAuditUpsert {
+	u.SetNull(escrowaudit.FieldSerial)
+	return u
+}
+
+// SetRecoveryId sets the "recoveryId" field.
+func (u *EscrowAuditUpsert) SetRecoveryId(v int) *EscrowAuditUpsert {
+	u.Set(escrowaudit.FieldRecoveryId, v)
+	return u
+}
+
+// UpdateRecoveryId sets the "recoveryId" field to the value that was provided on create.
+func (u *EscrowAuditUpsert) UpdateRecoveryId() *EscrowAuditUpsert {
+	u.SetExcluded(escrowaudit.FieldRecoveryId)
+	return u
+}
+
+// AddRecoveryId adds v to the "recoveryId" field.
+func (u *EscrowAuditUpsert) AddRecoveryId(v int) *EscrowAuditUpsert {
+	u.Add(escrowaudit.FieldRecoveryId, v)
+	return u
+}
+
+// ClearRecoveryId clears the value of the "recoveryId" field.
+func (u *EscrowAuditUpsert) ClearRecoveryId() *EscrowAuditUpsert {
+	u.SetNull(escrowaudit.FieldRecoveryId)
+	return u
+}
+
+// SetDetails sets the "details" field.
+func (u *EscrowAuditUpsert) SetDetails(v string) *EscrowAuditUpsert {
+	u.Set(escrowaudit.FieldDetails, v)
+	return u
+}
+
+// UpdateDetails sets the "details" field to the value that was provided on create.
+func (u *EscrowAuditUpsert) UpdateDetails() *EscrowAuditUpsert {
+	u.SetExcluded(escrowaudit.FieldDetails)
+	return u
+}
+
+// ClearDetails clears the value of the "details" field.
+func (u *EscrowAuditUpsert) ClearDetails() *EscrowAuditUpsert {
+	u.SetNull(escrowaudit.FieldDetails)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.EscrowAudit.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *EscrowAuditUpsertOne) UpdateNewValues() *EscrowAuditUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(escrowaudit.FieldCreateTime)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EscrowAudit.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *EscrowAuditUpsertOne) Ignore() *EscrowAuditUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EscrowAuditUpsertOne) DoNothing() *EscrowAuditUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EscrowAuditCreate.OnConflict
+// documentation for more info.
+func (u *EscrowAuditUpsertOne) Update(set func(*EscrowAuditUpsert)) *EscrowAuditUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EscrowAuditUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *EscrowAuditUpsertOne) SetUpdateTime(v time.Time) *EscrowAuditUpsertOne {
+	return u.Update(func(s *EscrowAuditUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *EscrowAuditUpsertOne) UpdateUpdateTime() *EscrowAuditUpsertOne {
+	return u.Update(func(s *EscrowAuditUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetAction sets the "action" field.
+func (u *EscrowAuditUpsertOne) SetAction(v string) *EscrowAuditUpsertOne {
+	return u.Update(func(s *EscrowAuditUpsert) {
+		s.SetAction(v)
+	})
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *EscrowAuditUpsertOne) UpdateAction() *EscrowAuditUpsertOne {
+	return u.Update(func(s *EscrowAuditUpsert) {
+		s.UpdateAction()
+	})
+}
+
+// SetActor sets the "actor" field.
+func (u *EscrowAuditUpsertOne) SetActor(v string) *EscrowAuditUpsertOne {
+	return u.Update(func(s *EscrowAuditUpsert) {
+		s.SetActor(v)
+	})
+}
+
+// UpdateActor sets the "actor" field to the value that was provided on create.
+func (u *EscrowAuditUpsertOne) UpdateActor() *EscrowAuditUpsertOne {
+	return u.Update(func(s *EscrowAuditUpsert) {
+		s.UpdateActor()
+	})
+}
+
+// SetSerial sets the "serial" field.
+func (u *EscrowAuditUpsertOne) SetSerial(v string) *EscrowAuditUpsertOne {
+	return u.Update(func(s *EscrowAuditUpsert) {
+		s.SetSerial(v)
+	})
+}
+
+// UpdateSerial sets the "serial" field to the value that was provided on create.
+func (u *EscrowAuditUpsertOne) UpdateSerial() *EscrowAuditUpsertOne {
+	return u.Update(func(s *EscrowAuditUpsert) {
+		s.UpdateSerial()
+	})
+}
+
+// ClearSerial clears the value of the "serial" field.
+func (u *EscrowAuditUpsertOne) ClearSerial() *EscrowAuditUpsertOne {
+	return u.Update(func(s *EscrowAuditUpsert) {
+		s.ClearSerial()
+	})
+}
+
+// SetRecoveryId sets the "recoveryId" field.
+func (u *EscrowAuditUpsertOne) SetRecoveryId(v int) *EscrowAuditUpsertOne {
+	return u.Update(func(s *EscrowAuditUpsert) {
+		s.SetRecoveryId(v)
+	})
+}
+
+// AddRecoveryId adds v to the "recoveryId" field.
+func (u *EscrowAuditUpsertOne) AddRecoveryId(v int) *EscrowAuditUpsertOne {
+	return u.Update(func(s *EscrowAuditUpsert) {
+		s.AddRecoveryId(v)
+	})
+}
+
+// UpdateRecoveryId sets the "recoveryId" field to the value that was provided on create.
+func (u *EscrowAuditUpsertOne) UpdateRecoveryId() *EscrowAuditUpsertOne {
+	return u.Update(func(s *EscrowAuditUpsert) {
+		s.UpdateRecoveryId()
+	})
+}
+
+// ClearRecoveryId clears the value of the "recoveryId" field.
+func (u *EscrowAuditUpsertOne) ClearRecoveryId() *EscrowAuditUpsertOne {
+	return u.Update(func(s *EscrowAuditUpsert) {
+		s.ClearRecoveryId()
+	})
+}
+
+// SetDetails sets the "details" field.
+func (u *EscrowAuditUpsertOne) SetDetails(v string) *EscrowAuditUpsertOne {
+	return u.Update(func(s *EscrowAuditUpsert) {
+		s.SetDetails(v)
+	})
+}
+
+// UpdateDetails sets the "details" field to the value that was provided on create.
+func (u *EscrowAuditUpsertOne) UpdateDetails() *EscrowAuditUpsertOne {
+	return u.Update(func(s *EscrowAuditUpsert) {
+		s.UpdateDetails()
+	})
+}
+
+// ClearDetails clears the value of the "details" field.
+func (u *EscrowAuditUpsertOne) ClearDetails() *EscrowAuditUpsertOne {
+	return u.Update(func(s *EscrowAuditUpsert) {
+		s.ClearDetails()
+	})
+}
+
+// Exec executes the query.
+func (u *EscrowAuditUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EscrowAuditCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EscrowAuditUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *EscrowAuditUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *EscrowAuditUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// EscrowAuditCreateBulk is the builder for creating many EscrowAudit entities in bulk.
+type EscrowAuditCreateBulk struct {
+	config
+	err      error
+	builders []*EscrowAuditCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the EscrowAudit entities in the database.
+func (_c *EscrowAuditCreateBulk) Save(ctx context.Context) ([]*EscrowAudit, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*EscrowAudit, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EscrowAuditMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *EscrowAuditCreateBulk) SaveX(ctx context.Context) []*EscrowAudit {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EscrowAuditCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EscrowAuditCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EscrowAudit.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EscrowAuditUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *EscrowAuditCreateBulk) OnConflict(opts ...sql.ConflictOption) *EscrowAuditUpsertBulk {
+	_c.conflict = opts
+	return &EscrowAuditUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EscrowAudit.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *EscrowAuditCreateBulk) OnConflictColumns(columns ...string) *EscrowAuditUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &EscrowAuditUpsertBulk{
+		create: _c,
+	}
+}
+
+// EscrowAuditUpsertBulk is the builder for "upsert"-ing
+// a bulk of EscrowAudit nodes.
+type EscrowAuditUpsertBulk struct {
+	create *EscrowAuditCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.EscrowAudit.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *EscrowAuditUpsertBulk) UpdateNewValues() *EscrowAuditUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(escrowaudit.FieldCreateTime)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EscrowAudit.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *EscrowAuditUpsertBulk) Ignore() *EscrowAuditUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EscrowAuditUpsertBulk) DoNothing() *EscrowAuditUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EscrowAuditCreateBulk.OnConflict
+// documentation for more info.
+func (u *EscrowAuditUpsertBulk) Update(set func(*EscrowAuditUpsert)) *EscrowAuditUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EscrowAuditUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *EscrowAuditUpsertBulk) SetUpdateTime(v time.Time) *EscrowAuditUpsertBulk {
+	return u.Update(func(s *EscrowAuditUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *EscrowAuditUpsertBulk) UpdateUpdateTime() *EscrowAuditUpsertBulk {
+	return u.Update(func(s *EscrowAuditUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetAction sets the "action" field.
+func (u *EscrowAuditUpsertBulk) SetAction(v string) *EscrowAuditUpsertBulk {
+	return u.Update(func(s *EscrowAuditUpsert) {
+		s.SetAction(v)
+	})
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *EscrowAuditUpsertBulk) UpdateAction() *EscrowAuditUpsertBulk {
+	return u.Update(func(s *EscrowAuditUpsert) {
+		s.UpdateAction()
+	})
+}
+
+// SetActor sets the "actor" field.
+func (u *EscrowAuditUpsertBulk) SetActor(v string) *EscrowAuditUpsertBulk {
+	return u.Update(func(s *EscrowAuditUpsert) {
+		s.SetActor(v)
+	})
+}
+
+// UpdateActor sets the "actor" field to the value that was provided on create.
+func (u *EscrowAuditUpsertBulk) UpdateActor() *EscrowAuditUpsertBulk {
+	return u.Update(func(s *EscrowAuditUpsert) {
+		s.UpdateActor()
+	})
+}
+
+// SetSerial sets the "serial" field.
+func (u *EscrowAuditUpsertBulk) SetSerial(v string) *EscrowAuditUpsertBulk {
+	return u.Update(func(s *EscrowAuditUpsert) {
+		s.SetSerial(v)
+	})
+}
+
+// UpdateSerial sets the "serial" field to the value that was provided on create.
+func (u *EscrowAuditUpsertBulk) UpdateSerial() *EscrowAuditUpsertBulk {
+	return u.Update(func(s *EscrowAuditUpsert) {
+		s.UpdateSerial()
+	})
+}
+
+// ClearSerial clears the value of the "serial" field.
+func (u *EscrowAuditUpsertBulk) ClearSerial() *EscrowAuditUpsertBulk {
+	return u.Update(func(s *EscrowAuditUpsert) {
+		s.ClearSerial()
+	})
+}
+
+// SetRecoveryId sets the "recoveryId" field.
+func (u *EscrowAuditUpsertBulk) SetRecoveryId(v int) *EscrowAuditUpsertBulk {
+	return u.Update(func(s *EscrowAuditUpsert) {
+		s.SetRecoveryId(v)
+	})
+}
+
+// AddRecoveryId adds v to the "recoveryId" field.
+func (u *EscrowAuditUpsertBulk) AddRecoveryId(v int) *EscrowAuditUpsertBulk {
+	return u.Update(func(s *EscrowAuditUpsert) {
+		s.AddRecoveryId(v)
+	})
+}
+
+// UpdateRecoveryId sets the "recoveryId" field to the value that was provided on create.
+func (u *EscrowAuditUpsertBulk) UpdateRecoveryId() *EscrowAuditUpsertBulk {
+	return u.Update(func(s *EscrowAuditUpsert) {
+		s.UpdateRecoveryId()
+	})
+}
+
+// ClearRecoveryId clears the value of the "recoveryId" field.
+func (u *EscrowAuditUpsertBulk) ClearRecoveryId() *EscrowAuditUpsertBulk {
+	return u.Update(func(s *EscrowAuditUpsert) {
+		s.ClearRecoveryId()
+	})
+}
+
+// SetDetails sets the "details" field.
+func (u *EscrowAuditUpsertBulk) SetDetails(v string) *EscrowAuditUpsertBulk {
+	return u.Update(func(s *EscrowAuditUpsert) {
+		s.SetDetails(v)
+	})
+}
+
+// UpdateDetails sets the "details" field to the value that was provided on create.
+func (u *EscrowAuditUpsertBulk) UpdateDetails() *EscrowAuditUpsertBulk {
+	return u.Update(func(s *EscrowAuditUpsert) {
+		s.UpdateDetails()
+	})
+}
+
+// ClearDetails clears the value of the "details" field.
+func (u *EscrowAuditUpsertBulk) ClearDetails() *EscrowAuditUpsertBulk {
+	return u.Update(func(s *EscrowAuditUpsert) {
+		s.ClearDetails()
+	})
+}
+
+// Exec executes the query.
+func (u *EscrowAuditUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the EscrowAuditCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EscrowAuditCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EscrowAuditUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hm-edu/pki-service/ent/escrowaudit"
+	"github.com/hm-edu/pki-service/ent/predicate"
+)
+
+// EscrowAuditDelete is the builder for deleting a EscrowAudit entity.
+type EscrowAuditDelete struct {
+	config
+	hooks    []Hook
+	mutation *EscrowAuditMutation
+}
+
+// Where appends a list predicates to the EscrowAuditDelete builder.
+func (_d *EscrowAuditDelete) Where(ps ...predicate.EscrowAudit) *EscrowAuditDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *EscrowAuditDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *EscrowAuditDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *EscrowAuditDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(escrowaudit.Table, sqlgraph.NewFieldSpec(escrowaudit.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// EscrowAuditDeleteOne is the builder for deleting a single EscrowAudit entity.
+type EscrowAuditDeleteOne struct {
+	_d *EscrowAuditDelete
+}
+
+// Where appends a list predicates to the EscrowAuditDelete builder.
+func (_d *EscrowAuditDeleteOne) Where(ps ...predicate.EscrowAudit) *EscrowAuditDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *EscrowAuditDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{escrowaudit.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *EscrowAuditDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,504 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hm-edu/pki-service/ent/escrowaudit"
+	"github.com/hm-edu/pki-service/ent/predicate"
+)
+
+// EscrowAuditUpdate is the builder for updating EscrowAudit entities.
+type EscrowAuditUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EscrowAuditMutation
+}
+
+// Where appends a list predicates to the EscrowAuditUpdate builder.
+func (_u *EscrowAuditUpdate) Where(ps ...predicate.EscrowAudit) *EscrowAuditUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *EscrowAuditUpdate) SetUpdateTime(v time.Time) *EscrowAuditUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *EscrowAuditUpdate) SetAction(v string) *EscrowAuditUpdate {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *EscrowAuditUpdate) SetNillableAction(v *string) *EscrowAuditUpdate {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetActor sets the "actor" field.
+func (_u *EscrowAuditUpdate) SetActor(v string) *EscrowAuditUpdate {
+	_u.mutation.SetActor(v)
+	return _u
+}
+
+// SetNillableActor sets the "actor" field if the given value is not nil.
+func (_u *EscrowAuditUpdate) SetNillableActor(v *string) *EscrowAuditUpdate {
+	if v != nil {
+		_u.SetActor(*v)
+	}
+	return _u
+}
+
+// SetSerial sets the "serial" field.
+func (_u *EscrowAuditUpdate) SetSerial(v string) *EscrowAuditUpdate {
+	_u.mutation.SetSerial(v)
+	return _u
+}
+
+// SetNillableSerial sets the "serial" field if the given value is not nil.
+func (_u *EscrowAuditUpdate) SetNillableSerial(v *string) *EscrowAuditUpdate {
+	if v != nil {
+		_u.SetSerial(*v)
+	}
+	return _u
+}
+
+// ClearSerial clears the value of the "serial" field.
+func (_u *EscrowAuditUpdate) ClearSerial() *EscrowAuditUpdate {
+	_u.mutation.ClearSerial()
+	return _u
+}
+
+// SetRecoveryId sets the "recoveryId" field.
+func (_u *EscrowAuditUpdate) SetRecoveryId(v int) *EscrowAuditUpdate {
+	_u.mutation.ResetRecoveryId()
+	_u.mutation.SetRecoveryId(v)
+	return _u
+}
+
+// SetNillableRecoveryId sets the "recoveryId" field if the given value is not nil.
+func (_u *EscrowAuditUpdate) SetNillableRecoveryId(v *int) *EscrowAuditUpdate {
+	if v != nil {
+		_u.SetRecoveryId(*v)
+	}
+	return _u
+}
+
+// AddRecoveryId adds value to the "recoveryId" field.
+func (_u *EscrowAuditUpdate) AddRecoveryId(v int) *EscrowAuditUpdate {
+	_u.mutation.AddRecoveryId(v)
+	return _u
+}
+
+// ClearRecoveryId clears the value of the "recoveryId" field.
+func (_u *EscrowAuditUpdate) ClearRecoveryId() *EscrowAuditUpdate {
+	_u.mutation.ClearRecoveryId()
+	return _u
+}
+
+// SetDetails sets the "details" field.
+func (_u *EscrowAuditUpdate) SetDetails(v string) *EscrowAuditUpdate {
+	_u.mutation.SetDetails(v)
+	return _u
+}
+
+// SetNillableDetails sets the "details" field if the given value is not nil.
+func (_u *EscrowAuditUpdate) SetNillableDetails(v *string) *EscrowAuditUpdate {
+	if v != nil {
+		_u.SetDetails(*v)
+	}
+	return _u
+}
+
+// ClearDetails clears the value of the "details" field.
+func (_u *EscrowAuditUpdate) ClearDetails() *EscrowAuditUpdate {
+	_u.mutation.ClearDetails()
+	return _u
+}
+
+// Mutation returns the EscrowAuditMutation object of the builder.
+func (_u *EscrowAuditUpdate) Mutation() *EscrowAuditMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *EscrowAuditUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EscrowAuditUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *EscrowAuditUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EscrowAuditUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *EscrowAuditUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if escrowaudit.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized escrowaudit.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := escrowaudit.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EscrowAuditUpdate) check() error {
+	if v, ok := _u.mutation.Action(); ok {
+		if err := escrowaudit.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "EscrowAudit.action": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Actor(); ok {
+		if err := escrowaudit.ActorValidator(v); err != nil {
+			return &ValidationError{Name: "actor", err: fmt.Errorf(`ent: validator failed for field "EscrowAudit.actor": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *EscrowAuditUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(escrowaudit.Table, escrowaudit.Columns, sqlgraph.NewFieldSpec(escrowaudit.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(escrowaudit.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(escrowaudit.FieldAction, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Actor(); ok {
+		_spec.SetField(escrowaudit.FieldActor, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Serial(); ok {
+		_spec.SetField(escrowaudit.FieldSerial, field.TypeString, value)
+	}
+	if _u.mutation.SerialCleared() {
+		_spec.ClearField(escrowaudit.FieldSerial, field.TypeString)
+	}
+	if value, ok := _u.mutation.RecoveryId(); ok {
+		_spec.SetField(escrowaudit.FieldRecoveryId, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRecoveryId(); ok {
+		_spec.AddField(escrowaudit.FieldRecoveryId, field.TypeInt, value)
+	}
+	if _u.mutation.RecoveryIdCleared() {
+		_spec.ClearField(escrowaudit.FieldRecoveryId, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Details(); ok {
+		_spec.SetField(escrowaudit.FieldDetails, field.TypeString, value)
+	}
+	if _u.mutation.DetailsCleared() {
+		_spec.ClearField(escrowaudit.FieldDetails, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{escrowaudit.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// EscrowAuditUpdateOne is the builder for updating a single EscrowAudit entity.
+type EscrowAuditUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EscrowAuditMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *EscrowAuditUpdateOne) SetUpdateTime(v time.Time) *EscrowAuditUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *EscrowAuditUpdateOne) SetAction(v string) *EscrowAuditUpdateOne {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *EscrowAuditUpdateOne) SetNillableAction(v *string) *EscrowAuditUpdateOne {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetActor sets the "actor" field.
+func (_u *EscrowAuditUpdateOne) SetActor(v string) *EscrowAuditUpdateOne {
+	_u.mutation.SetActor(v)
+	return _u
+}
+
+// SetNillableActor sets the "actor" field if the given value is not nil.
+func (_u *EscrowAuditUpdateOne) SetNillableActor(v *string) *EscrowAuditUpdateOne {
+	if v != nil {
+		_u.SetActor(*v)
+	}
+	return _u
+}
+
+// SetSerial sets the "serial" field.
+func (_u *EscrowAuditUpdateOne) SetSerial(v string) *EscrowAuditUpdateOne {
+	_u.mutation.SetSerial(v)
+	return _u
+}
+
+// SetNillableSerial sets the "serial" field if the given value is not nil.
+func (_u *EscrowAuditUpdateOne) SetNillableSerial(v *string) *EscrowAuditUpdateOne {
+	if v != nil {
+		_u.SetSerial(*v)
+	}
+	return _u
+}
+
+// ClearSerial clears the value of the "serial" field.
+func (_u *EscrowAuditUpdateOne) ClearSerial() *EscrowAuditUpdateOne {
+	_u.mutation.ClearSerial()
+	return _u
+}
+
+// SetRecoveryId sets the "recoveryId" field.
+func (_u *EscrowAuditUpdateOne) SetRecoveryId(v int) *EscrowAuditUpdateOne {
+	_u.mutation.ResetRecoveryId()
+	_u.mutation.SetRecoveryId(v)
+	return _u
+}
+
+// SetNillableRecoveryId sets the "recoveryId" field if the given value is not nil.
+func (_u *EscrowAuditUpdateOne) SetNillableRecoveryId(v *int) *EscrowAuditUpdateOne {
+	if v != nil {
+		_u.SetRecoveryId(*v)
+	}
+	return _u
+}
+
+// AddRecoveryId adds value to the "recoveryId" field.
+func (_u *EscrowAuditUpdateOne) AddRecoveryId(v int) *EscrowAuditUpdateOne {
+	_u.mutation.AddRecoveryId(v)
+	return _u
+}
+
+// ClearRecoveryId clears the value of the "recoveryId" field.
+func (_u *EscrowAuditUpdateOne) ClearRecoveryId() *EscrowAuditUpdateOne {
+	_u.mutation.ClearRecoveryId()
+	return _u
+}
+
+// SetDetails sets the "details" field.
+func (_u *EscrowAuditUpdateOne) SetDetails(v string) *EscrowAuditUpdateOne {
+	_u.mutation.SetDetails(v)
+	return _u
+}
+
+// SetNillableDetails sets the "details" field if the given value is not nil.
+func (_u *EscrowAuditUpdateOne) SetNillableDetails(v *string) *EscrowAuditUpdateOne {
+	if v != nil {
+		_u.SetDetails(*v)
+	}
+	return _u
+}
+
+// ClearDetails clears the value of the "details" field.
+func (_u *EscrowAuditUpdateOne) ClearDetails() *EscrowAuditUpdateOne {
+	_u.mutation.ClearDetails()
+	return _u
+}
+
+// Mutation returns the EscrowAuditMutation object of the builder.
+func (_u *EscrowAuditUpdateOne) Mutation() *EscrowAuditMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the EscrowAuditUpdate builder.
+func (_u *EscrowAuditUpdateOne) Where(ps ...predicate.EscrowAudit) *EscrowAuditUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *EscrowAuditUpdateOne) Select(field string, fields ...string) *EscrowAuditUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated EscrowAudit entity.
+func (_u *EscrowAuditUpdateOne) Save(ctx context.Context) (*EscrowAudit, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EscrowAuditUpdateOne) SaveX(ctx context.Context) *EscrowAudit {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *EscrowAuditUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EscrowAuditUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *EscrowAuditUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if escrowaudit.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized escrowaudit.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := escrowaudit.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EscrowAuditUpdateOne) check() error {
+	if v, ok := _u.mutation.Action(); ok {
+		if err := escrowaudit.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "EscrowAudit.action": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Actor(); ok {
+		if err := escrowaudit.ActorValidator(v); err != nil {
+			return &ValidationError{Name: "actor", err: fmt.Errorf(`ent: validator failed for field "EscrowAudit.actor": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *EscrowAuditUpdateOne) sqlSave(ctx context.Context) (_node *EscrowAudit, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(escrowaudit.Table, escrowaudit.Columns, sqlgraph.NewFieldSpec(escrowaudit.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EscrowAudit.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, escrowaudit.FieldID)
+		for _, f := range fields {
+			if !escrowaudit.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != escrowaudit.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(escrowaudit.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(escrowaudit.FieldAction, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Actor(); ok {
+		_spec.SetField(escrowaudit.FieldActor, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Serial(); ok {
+		_spec.SetField(escrowaudit.FieldSerial, field.TypeString, value)
+	}
+	if _u.mutation.SerialCleared() {
+		_spec.ClearField(escrowaudit.FieldSerial, field.TypeString)
+	}
+	if value, ok := _u.mutation.RecoveryId(); ok {
+		_spec.SetField(escrowaudit.FieldRecoveryId, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRecoveryId(); ok {
+		_spec.AddField(escrowaudit.FieldRecoveryId, field.TypeInt, value)
+	}
+	if _u.mutation.RecoveryIdCleared() {
+		_spec.ClearField(escrowaudit.FieldRecoveryId, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Details(); ok {
+		_spec.SetField(escrowaudit.FieldDetails, field.TypeString, value)
+	}
+	if _u.mutation.DetailsCleared() {
+		_spec.ClearField(escrowaudit.FieldDetails, field.TypeString)
+	}
+	_node = &EscrowAudit{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{escrowaudit.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -9,6 +9,18 @@ import (
 	"github.com/hm-edu/pki-service/ent"
 )
 
+// The AcmeOrderFunc type is an adapter to allow the use of ordinary
+// function as AcmeOrder mutator.
+type AcmeOrderFunc func(context.Context, *ent.AcmeOrderMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AcmeOrderFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AcmeOrderMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AcmeOrderMutation", m)
+}
+
 // The CertificateFunc type is an adapter to allow the use of ordinary
 // function as Certificate mutator.
 type CertificateFunc func(context.Context, *ent.CertificateMutation) (ent.Value, error)
@@ -33,6 +45,78 @@ func (f DomainFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, erro
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DomainMutation", m)
 }
 
+// The EmailVerificationFunc type is an adapter to allow the use of ordinary
+// function as EmailVerification mutator.
+type EmailVerificationFunc func(context.Context, *ent.EmailVerificationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EmailVerificationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.EmailVerificationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EmailVerificationMutation", m)
+}
+
+// The EscrowAuditFunc type is an adapter to allow the use of ordinary
+// function as EscrowAudit mutator.
+type EscrowAuditFunc func(context.Context, *ent.EscrowAuditMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EscrowAuditFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.EscrowAuditMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EscrowAuditMutation", m)
+}
+
+// The KeyEscrowFunc type is an adapter to allow the use of ordinary
+// function as KeyEscrow mutator.
+type KeyEscrowFunc func(context.Context, *ent.KeyEscrowMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f KeyEscrowFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.KeyEscrowMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.KeyEscrowMutation", m)
+}
+
+// The KeyRecoveryFunc type is an adapter to allow the use of ordinary
+// function as KeyRecovery mutator.
+type KeyRecoveryFunc func(context.Context, *ent.KeyRecoveryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f KeyRecoveryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.KeyRecoveryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.KeyRecoveryMutation", m)
+}
+
+// The NotificationPreferenceFunc type is an adapter to allow the use of ordinary
+// function as NotificationPreference mutator.
+type NotificationPreferenceFunc func(context.Context, *ent.NotificationPreferenceMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f NotificationPreferenceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.NotificationPreferenceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.NotificationPreferenceMutation", m)
+}
+
+// The SentNotificationFunc type is an adapter to allow the use of ordinary
+// function as SentNotification mutator.
+type SentNotificationFunc func(context.Context, *ent.SentNotificationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SentNotificationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SentNotificationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SentNotificationMutation", m)
+}
+
 // The SmimeCertificateFunc type is an adapter to allow the use of ordinary
 // function as SmimeCertificate mutator.
 type SmimeCertificateFunc func(context.Context, *ent.SmimeCertificateMutation) (ent.Value, error)
@@ -45,6 +129,18 @@ func (f SmimeCertificateFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.V
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SmimeCertificateMutation", m)
 }
 
+// The TermsAcceptanceFunc type is an adapter to allow the use of ordinary
+// function as TermsAcceptance mutator.
+type TermsAcceptanceFunc func(context.Context, *ent.TermsAcceptanceMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TermsAcceptanceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TermsAcceptanceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TermsAcceptanceMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
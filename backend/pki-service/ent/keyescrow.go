@@ -0,0 +1,218 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/hm-edu/pki-service/ent/keyescrow"
+	"github.com/hm-edu/pki-service/ent/smimecertificate"
+)
+
+// KeyEscrow is the model entity for the KeyEscrow schema.
+type KeyEscrow struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// Mode holds the value of the "mode" field.
+	Mode keyescrow.Mode `json:"mode,omitempty"`
+	// Ciphertext holds the value of the "ciphertext" field.
+	Ciphertext []byte `json:"-"`
+	// WrappedKey holds the value of the "wrappedKey" field.
+	WrappedKey []byte `json:"-"`
+	// Shares holds the value of the "shares" field.
+	Shares map[string][]uint8 `json:"-"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the KeyEscrowQuery when eager-loading is set.
+	Edges                        KeyEscrowEdges `json:"edges"`
+	smime_certificate_key_escrow *int
+	selectValues                 sql.SelectValues
+}
+
+// KeyEscrowEdges holds the relations/edges for other nodes in the graph.
+type KeyEscrowEdges struct {
+	// Certificate holds the value of the certificate edge.
+	Certificate *SmimeCertificate `json:"certificate,omitempty"`
+	// Recoveries holds the value of the recoveries edge.
+	Recoveries []*KeyRecovery `json:"recoveries,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// CertificateOrErr returns the Certificate value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e KeyEscrowEdges) CertificateOrErr() (*SmimeCertificate, error) {
+	if e.Certificate != nil {
+		return e.Certificate, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: smimecertificate.Label}
+	}
+	return nil, &NotLoadedError{edge: "certificate"}
+}
+
+// RecoveriesOrErr returns the Recoveries value or an error if the edge
+// was not loaded in eager-loading.
+func (e KeyEscrowEdges) RecoveriesOrErr() ([]*KeyRecovery, error) {
+	if e.loadedTypes[1] {
+		return e.Recoveries, nil
+	}
+	return nil, &NotLoadedError{edge: "recoveries"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*KeyEscrow) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case keyescrow.FieldCiphertext, keyescrow.FieldWrappedKey, keyescrow.FieldShares:
+			values[i] = new([]byte)
+		case keyescrow.FieldID:
+			values[i] = new(sql.NullInt64)
+		case keyescrow.FieldMode:
+			values[i] = new(sql.NullString)
+		case keyescrow.FieldCreateTime, keyescrow.FieldUpdateTime:
+			values[i] = new(sql.NullTime)
+		case keyescrow.ForeignKeys[0]: // smime_certificate_key_escrow
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the KeyEscrow fields.
+func (_m *KeyEscrow) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case keyescrow.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case keyescrow.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = value.Time
+			}
+		case keyescrow.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = value.Time
+			}
+		case keyescrow.FieldMode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mode", values[i])
+			} else if value.Valid {
+				_m.Mode = keyescrow.Mode(value.String)
+			}
+		case keyescrow.FieldCiphertext:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field ciphertext", values[i])
+			} else if value != nil {
+				_m.Ciphertext = *value
+			}
+		case keyescrow.FieldWrappedKey:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field wrappedKey", values[i])
+			} else if value != nil {
+				_m.WrappedKey = *value
+			}
+		case keyescrow.FieldShares:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field shares", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Shares); err != nil {
+					return fmt.Errorf("unmarshal field shares: %w", err)
+				}
+			}
+		case keyescrow.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field smime_certificate_key_escrow", value)
+			} else if value.Valid {
+				_m.smime_certificate_key_escrow = new(int)
+				*_m.smime_certificate_key_escrow = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the KeyEscrow.
+// This includes values selected through modifiers, order, etc.
+func (_m *KeyEscrow) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryCertificate queries the "certificate" edge of the KeyEscrow entity.
+func (_m *KeyEscrow) QueryCertificate() *SmimeCertificateQuery {
+	return NewKeyEscrowClient(_m.config).QueryCertificate(_m)
+}
+
+// QueryRecoveries queries the "recoveries" edge of the KeyEscrow entity.
+func (_m *KeyEscrow) QueryRecoveries() *KeyRecoveryQuery {
+	return NewKeyEscrowClient(_m.config).QueryRecoveries(_m)
+}
+
+// Update returns a builder for updating this KeyEscrow.
+// Note that you need to call KeyEscrow.Unwrap() before calling this method if this KeyEscrow
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *KeyEscrow) Update() *KeyEscrowUpdateOne {
+	return NewKeyEscrowClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the KeyEscrow entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *KeyEscrow) Unwrap() *KeyEscrow {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: KeyEscrow is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *KeyEscrow) String() string {
+	var builder strings.Builder
+	builder.WriteString("KeyEscrow(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(_m.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("mode=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Mode))
+	builder.WriteString(", ")
+	builder.WriteString("ciphertext=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("wrappedKey=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("shares=<sensitive>")
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// KeyEscrows is a parsable slice of KeyEscrow.
+type KeyEscrows []*KeyEscrow
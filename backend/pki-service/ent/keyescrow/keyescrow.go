@@ -0,0 +1,179 @@
+// Code generated by ent, DO NOT EDIT.
+
+package keyescrow
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the keyescrow type in the database.
+	Label = "key_escrow"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldMode holds the string denoting the mode field in the database.
+	FieldMode = "mode"
+	// FieldCiphertext holds the string denoting the ciphertext field in the database.
+	FieldCiphertext = "ciphertext"
+	// FieldWrappedKey holds the string denoting the wrappedkey field in the database.
+	FieldWrappedKey = "wrapped_key"
+	// FieldShares holds the string denoting the shares field in the database.
+	FieldShares = "shares"
+	// EdgeCertificate holds the string denoting the certificate edge name in mutations.
+	EdgeCertificate = "certificate"
+	// EdgeRecoveries holds the string denoting the recoveries edge name in mutations.
+	EdgeRecoveries = "recoveries"
+	// Table holds the table name of the keyescrow in the database.
+	Table = "key_escrows"
+	// CertificateTable is the table that holds the certificate relation/edge.
+	CertificateTable = "key_escrows"
+	// CertificateInverseTable is the table name for the SmimeCertificate entity.
+	// It exists in this package in order to avoid circular dependency with the "smimecertificate" package.
+	CertificateInverseTable = "smime_certificates"
+	// CertificateColumn is the table column denoting the certificate relation/edge.
+	CertificateColumn = "smime_certificate_key_escrow"
+	// RecoveriesTable is the table that holds the recoveries relation/edge.
+	RecoveriesTable = "key_recoveries"
+	// RecoveriesInverseTable is the table name for the KeyRecovery entity.
+	// It exists in this package in order to avoid circular dependency with the "keyrecovery" package.
+	RecoveriesInverseTable = "key_recoveries"
+	// RecoveriesColumn is the table column denoting the recoveries relation/edge.
+	RecoveriesColumn = "key_escrow_recoveries"
+)
+
+// Columns holds all SQL columns for keyescrow fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldMode,
+	FieldCiphertext,
+	FieldWrappedKey,
+	FieldShares,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "key_escrows"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"smime_certificate_key_escrow",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/hm-edu/pki-service/ent/runtime"
+var (
+	Hooks [1]ent.Hook
+	// DefaultCreateTime holds the default value on creation for the "create_time" field.
+	DefaultCreateTime func() time.Time
+	// DefaultUpdateTime holds the default value on creation for the "update_time" field.
+	DefaultUpdateTime func() time.Time
+	// UpdateDefaultUpdateTime holds the default value on update for the "update_time" field.
+	UpdateDefaultUpdateTime func() time.Time
+)
+
+// Mode defines the type for the "mode" enum field.
+type Mode string
+
+// Mode values.
+const (
+	ModeWrap  Mode = "wrap"
+	ModeSplit Mode = "split"
+)
+
+func (m Mode) String() string {
+	return string(m)
+}
+
+// ModeValidator is a validator for the "mode" field enum values. It is called by the builders before save.
+func ModeValidator(m Mode) error {
+	switch m {
+	case ModeWrap, ModeSplit:
+		return nil
+	default:
+		return fmt.Errorf("keyescrow: invalid enum value for mode field: %q", m)
+	}
+}
+
+// OrderOption defines the ordering options for the KeyEscrow queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByMode orders the results by the mode field.
+func ByMode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMode, opts...).ToFunc()
+}
+
+// ByCertificateField orders the results by certificate field.
+func ByCertificateField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCertificateStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByRecoveriesCount orders the results by recoveries count.
+func ByRecoveriesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRecoveriesStep(), opts...)
+	}
+}
+
+// ByRecoveries orders the results by recoveries terms.
+func ByRecoveries(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRecoveriesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newCertificateStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CertificateInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, true, CertificateTable, CertificateColumn),
+	)
+}
+func newRecoveriesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RecoveriesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RecoveriesTable, RecoveriesColumn),
+	)
+}
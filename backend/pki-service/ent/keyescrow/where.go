@@ -0,0 +1,337 @@
+// Code generated by ent, DO NOT EDIT.
+
+package keyescrow
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/hm-edu/pki-service/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.KeyEscrow {
+	return predicate.KeyEscrow(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.KeyEscrow {
+	return predicate.KeyEscrow(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.KeyEscrow {
+	return predicate.KeyEscrow(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.KeyEscrow {
+	return predicate.KeyEscrow(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.KeyEscrow {
+	return predicate.KeyEscrow(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.KeyEscrow {
+	return predicate.KeyEscrow(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.KeyEscrow {
+	return predicate.KeyEscrow(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.KeyEscrow {
+	return predicate.KeyEscrow(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.KeyEscrow {
+	return predicate.KeyEscrow(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.KeyEscrow {
+	return predicate.KeyEscrow(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.KeyEscrow {
+	return predicate.KeyEscrow(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// Ciphertext applies equality check predicate on the "ciphertext" field. It's identical to CiphertextEQ.
+func Ciphertext(v []byte) predicate.KeyEscrow {
+	return predicate.KeyEscrow(sql.FieldEQ(FieldCiphertext, v))
+}
+
+// WrappedKey applies equality check predicate on the "wrappedKey" field. It's identical to WrappedKeyEQ.
+func WrappedKey(v []byte) predicate.KeyEscrow {
+	return predicate.KeyEscrow(sql.FieldEQ(FieldWrappedKey, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.KeyEscrow {
+	return predicate.KeyEscrow(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.KeyEscrow {
+	return predicate.KeyEscrow(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.KeyEscrow {
+	return predicate.KeyEscrow(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.KeyEscrow {
+	return predicate.KeyEscrow(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.KeyEscrow {
+	return predicate.KeyEscrow(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.KeyEscrow {
+	return predicate.KeyEscrow(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.KeyEscrow {
+	return predicate.KeyEscrow(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.KeyEscrow {
+	return predicate.KeyEscrow(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.KeyEscrow {
+	return predicate.KeyEscrow(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.KeyEscrow {
+	return predicate.KeyEscrow(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.KeyEscrow {
+	return predicate.KeyEscrow(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.KeyEscrow {
+	return predicate.KeyEscrow(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.KeyEscrow {
+	return predicate.KeyEscrow(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.KeyEscrow {
+	return predicate.KeyEscrow(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.KeyEscrow {
+	return predicate.KeyEscrow(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.KeyEscrow {
+	return predicate.KeyEscrow(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// ModeEQ applies the EQ predicate on the "mode" field.
+func ModeEQ(v Mode) predicate.KeyEscrow {
+	return predicate.KeyEscrow(sql.FieldEQ(FieldMode, v))
+}
+
+// ModeNEQ applies the NEQ predicate on the "mode" field.
+func ModeNEQ(v Mode) predicate.KeyEscrow {
+	return predicate.KeyEscrow(sql.FieldNEQ(FieldMode, v))
+}
+
+// ModeIn applies the In predicate on the "mode" field.
+func ModeIn(vs ...Mode) predicate.KeyEscrow {
+	return predicate.KeyEscrow(sql.FieldIn(FieldMode, vs...))
+}
+
+// ModeNotIn applies the NotIn predicate on the "mode" field.
+func ModeNotIn(vs ...Mode) predicate.KeyEscrow {
+	return predicate.KeyEscrow(sql.FieldNotIn(FieldMode, vs...))
+}
+
+// CiphertextEQ applies the EQ predicate on the "ciphertext" field.
+func CiphertextEQ(v []byte) predicate.KeyEscrow {
+	return predicate.KeyEscrow(sql.FieldEQ(FieldCiphertext, v))
+}
+
+// CiphertextNEQ applies the NEQ predicate on the "ciphertext" field.
+func CiphertextNEQ(v []byte) predicate.KeyEscrow {
+	return predicate.KeyEscrow(sql.FieldNEQ(FieldCiphertext, v))
+}
+
+// CiphertextIn applies the In predicate on the "ciphertext" field.
+func CiphertextIn(vs ...[]byte) predicate.KeyEscrow {
+	return predicate.KeyEscrow(sql.FieldIn(FieldCiphertext, vs...))
+}
+
+// CiphertextNotIn applies the NotIn predicate on the "ciphertext" field.
+func CiphertextNotIn(vs ...[]byte) predicate.KeyEscrow {
+	return predicate.KeyEscrow(sql.FieldNotIn(FieldCiphertext, vs...))
+}
+
+// CiphertextGT applies the GT predicate on the "ciphertext" field.
+func CiphertextGT(v []byte) predicate.KeyEscrow {
+	return predicate.KeyEscrow(sql.FieldGT(FieldCiphertext, v))
+}
+
+// CiphertextGTE applies the GTE predicate on the "ciphertext" field.
+func CiphertextGTE(v []byte) predicate.KeyEscrow {
+	return predicate.KeyEscrow(sql.FieldGTE(FieldCiphertext, v))
+}
+
+// CiphertextLT applies the LT predicate on the "ciphertext" field.
+func CiphertextLT(v []byte) predicate.KeyEscrow {
+	return predicate.KeyEscrow(sql.FieldLT(FieldCiphertext, v))
+}
+
+// CiphertextLTE applies the LTE predicate on the "ciphertext" field.
+func CiphertextLTE(v []byte) predicate.KeyEscrow {
+	return predicate.KeyEscrow(sql.FieldLTE(FieldCiphertext, v))
+}
+
+// WrappedKeyEQ applies the EQ predicate on the "wrappedKey" field.
+func WrappedKeyEQ(v []byte) predicate.KeyEscrow {
+	return predicate.KeyEscrow(sql.FieldEQ(FieldWrappedKey, v))
+}
+
+// WrappedKeyNEQ applies the NEQ predicate on the "wrappedKey" field.
+func WrappedKeyNEQ(v []byte) predicate.KeyEscrow {
+	return predicate.KeyEscrow(sql.FieldNEQ(FieldWrappedKey, v))
+}
+
+// WrappedKeyIn applies the In predicate on the "wrappedKey" field.
+func WrappedKeyIn(vs ...[]byte) predicate.KeyEscrow {
+	return predicate.KeyEscrow(sql.FieldIn(FieldWrappedKey, vs...))
+}
+
+// WrappedKeyNotIn applies the NotIn predicate on the "wrappedKey" field.
+func WrappedKeyNotIn(vs ...[]byte) predicate.KeyEscrow {
+	return predicate.KeyEscrow(sql.FieldNotIn(FieldWrappedKey, vs...))
+}
+
+// WrappedKeyGT applies the GT predicate on the "wrappedKey" field.
+func WrappedKeyGT(v []byte) predicate.KeyEscrow {
+	return predicate.KeyEscrow(sql.FieldGT(FieldWrappedKey, v))
+}
+
+// WrappedKeyGTE applies the GTE predicate on the "wrappedKey" field.
+func WrappedKeyGTE(v []byte) predicate.KeyEscrow {
+	return predicate.KeyEscrow(sql.FieldGTE(FieldWrappedKey, v))
+}
+
+// WrappedKeyLT applies the LT predicate on the "wrappedKey" field.
+func WrappedKeyLT(v []byte) predicate.KeyEscrow {
+	return predicate.KeyEscrow(sql.FieldLT(FieldWrappedKey, v))
+}
+
+// WrappedKeyLTE applies the LTE predicate on the "wrappedKey" field.
+func WrappedKeyLTE(v []byte) predicate.KeyEscrow {
+	return predicate.KeyEscrow(sql.FieldLTE(FieldWrappedKey, v))
+}
+
+// WrappedKeyIsNil applies the IsNil predicate on the "wrappedKey" field.
+func WrappedKeyIsNil() predicate.KeyEscrow {
+	return predicate.KeyEscrow(sql.FieldIsNull(FieldWrappedKey))
+}
+
+// WrappedKeyNotNil applies the NotNil predicate on the "wrappedKey" field.
+func WrappedKeyNotNil() predicate.KeyEscrow {
+	return predicate.KeyEscrow(sql.FieldNotNull(FieldWrappedKey))
+}
+
+// SharesIsNil applies the IsNil predicate on the "shares" field.
+func SharesIsNil() predicate.KeyEscrow {
+	return predicate.KeyEscrow(sql.FieldIsNull(FieldShares))
+}
+
+// SharesNotNil applies the NotNil predicate on the "shares" field.
+func SharesNotNil() predicate.KeyEscrow {
+	return predicate.KeyEscrow(sql.FieldNotNull(FieldShares))
+}
+
+// HasCertificate applies the HasEdge predicate on the "certificate" edge.
+func HasCertificate() predicate.KeyEscrow {
+	return predicate.KeyEscrow(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, CertificateTable, CertificateColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCertificateWith applies the HasEdge predicate on the "certificate" edge with a given conditions (other predicates).
+func HasCertificateWith(preds ...predicate.SmimeCertificate) predicate.KeyEscrow {
+	return predicate.KeyEscrow(func(s *sql.Selector) {
+		step := newCertificateStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRecoveries applies the HasEdge predicate on the "recoveries" edge.
+func HasRecoveries() predicate.KeyEscrow {
+	return predicate.KeyEscrow(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RecoveriesTable, RecoveriesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRecoveriesWith applies the HasEdge predicate on the "recoveries" edge with a given conditions (other predicates).
+func HasRecoveriesWith(preds ...predicate.KeyRecovery) predicate.KeyEscrow {
+	return predicate.KeyEscrow(func(s *sql.Selector) {
+		step := newRecoveriesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.KeyEscrow) predicate.KeyEscrow {
+	return predicate.KeyEscrow(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.KeyEscrow) predicate.KeyEscrow {
+	return predicate.KeyEscrow(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.KeyEscrow) predicate.KeyEscrow {
+	return predicate.KeyEscrow(sql.NotPredicates(p))
+}
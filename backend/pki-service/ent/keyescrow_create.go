@@ -0,0 +1,834 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hm-edu/pki-service/ent/keyescrow"
+	"github.com/hm-edu/pki-service/ent/keyrecovery"
+	"github.com/hm-edu/pki-service/ent/smimecertificate"
+)
+
+// KeyEscrowCreate is the builder for creating a KeyEscrow entity.
+type KeyEscrowCreate struct {
+	config
+	mutation *KeyEscrowMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *KeyEscrowCreate) SetCreateTime(v time.Time) *KeyEscrowCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *KeyEscrowCreate) SetNillableCreateTime(v *time.Time) *KeyEscrowCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *KeyEscrowCreate) SetUpdateTime(v time.Time) *KeyEscrowCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *KeyEscrowCreate) SetNillableUpdateTime(v *time.Time) *KeyEscrowCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetMode sets the "mode" field.
+func (_c *KeyEscrowCreate) SetMode(v keyescrow.Mode) *KeyEscrowCreate {
+	_c.mutation.SetMode(v)
+	return _c
+}
+
+// SetCiphertext sets the "ciphertext" field.
+func (_c *KeyEscrowCreate) SetCiphertext(v []byte) *KeyEscrowCreate {
+	_c.mutation.SetCiphertext(v)
+	return _c
+}
+
+// SetWrappedKey sets the "wrappedKey" field.
+func (_c *KeyEscrowCreate) SetWrappedKey(v []byte) *KeyEscrowCreate {
+	_c.mutation.SetWrappedKey(v)
+	return _c
+}
+
+// SetShares sets the "shares" field.
+func (_c *KeyEscrowCreate) SetShares(v map[string][]uint8) *KeyEscrowCreate {
+	_c.mutation.SetShares(v)
+	return _c
+}
+
+// SetCertificateID sets the "certificate" edge to the SmimeCertificate entity by ID.
+func (_c *KeyEscrowCreate) SetCertificateID(id int) *KeyEscrowCreate {
+	_c.mutation.SetCertificateID(id)
+	return _c
+}
+
+// SetCertificate sets the "certificate" edge to the SmimeCertificate entity.
+func (_c *KeyEscrowCreate) SetCertificate(v *SmimeCertificate) *KeyEscrowCreate {
+	return _c.SetCertificateID(v.ID)
+}
+
+// AddRecoveryIDs adds the "recoveries" edge to the KeyRecovery entity by IDs.
+func (_c *KeyEscrowCreate) AddRecoveryIDs(ids ...int) *KeyEscrowCreate {
+	_c.mutation.AddRecoveryIDs(ids...)
+	return _c
+}
+
+// AddRecoveries adds the "recoveries" edges to the KeyRecovery entity.
+func (_c *KeyEscrowCreate) AddRecoveries(v ...*KeyRecovery) *KeyEscrowCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRecoveryIDs(ids...)
+}
+
+// Mutation returns the KeyEscrowMutation object of the builder.
+func (_c *KeyEscrowCreate) Mutation() *KeyEscrowMutation {
+	return _c.mutation
+}
+
+// Save creates the KeyEscrow in the database.
+func (_c *KeyEscrowCreate) Save(ctx context.Context) (*KeyEscrow, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *KeyEscrowCreate) SaveX(ctx context.Context) *KeyEscrow {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *KeyEscrowCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *KeyEscrowCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *KeyEscrowCreate) defaults() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		if keyescrow.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized keyescrow.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
+		v := keyescrow.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		if keyescrow.DefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized keyescrow.DefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := keyescrow.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *KeyEscrowCreate) check() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "KeyEscrow.create_time"`)}
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "KeyEscrow.update_time"`)}
+	}
+	if _, ok := _c.mutation.Mode(); !ok {
+		return &ValidationError{Name: "mode", err: errors.New(`ent: missing required field "KeyEscrow.mode"`)}
+	}
+	if v, ok := _c.mutation.Mode(); ok {
+		if err := keyescrow.ModeValidator(v); err != nil {
+			return &ValidationError{Name: "mode", err: fmt.Errorf(`ent: validator failed for field "KeyEscrow.mode": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Ciphertext(); !ok {
+		return &ValidationError{Name: "ciphertext", err: errors.New(`ent: missing required field "KeyEscrow.ciphertext"`)}
+	}
+	if len(_c.mutation.CertificateIDs()) == 0 {
+		return &ValidationError{Name: "certificate", err: errors.New(`ent: missing required edge "KeyEscrow.certificate"`)}
+	}
+	return nil
+}
+
+func (_c *KeyEscrowCreate) sqlSave(ctx context.Context) (*KeyEscrow, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *KeyEscrowCreate) createSpec() (*KeyEscrow, *sqlgraph.CreateSpec) {
+	var (
+		_node = &KeyEscrow{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(keyescrow.Table, sqlgraph.NewFieldSpec(keyescrow.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(keyescrow.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(keyescrow.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.Mode(); ok {
+		_spec.SetField(keyescrow.FieldMode, field.TypeEnum, value)
+		_node.Mode = value
+	}
+	if value, ok := _c.mutation.Ciphertext(); ok {
+		_spec.SetField(keyescrow.FieldCiphertext, field.TypeBytes, value)
+		_node.Ciphertext = value
+	}
+	if value, ok := _c.mutation.WrappedKey(); ok {
+		_spec.SetField(keyescrow.FieldWrappedKey, field.TypeBytes, value)
+		_node.WrappedKey = value
+	}
+	if value, ok := _c.mutation.Shares(); ok {
+		_spec.SetField(keyescrow.FieldShares, field.TypeJSON, value)
+		_node.Shares = value
+	}
+	if nodes := _c.mutation.CertificateIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   keyescrow.CertificateTable,
+			Columns: []string{keyescrow.CertificateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(smimecertificate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.smime_certificate_key_escrow = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RecoveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   keyescrow.RecoveriesTable,
+			Columns: []string{keyescrow.RecoveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(keyrecovery.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.KeyEscrow.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.KeyEscrowUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *KeyEscrowCreate) OnConflict(opts ...sql.ConflictOption) *KeyEscrowUpsertOne {
+	_c.conflict = opts
+	return &KeyEscrowUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.KeyEscrow.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *KeyEscrowCreate) OnConflictColumns(columns ...string) *KeyEscrowUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &KeyEscrowUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// KeyEscrowUpsertOne is the builder for "upsert"-ing
+	//  one KeyEscrow node.
+	KeyEscrowUpsertOne struct {
+		create *KeyEscrowCreate
+	}
+
+	// KeyEscrowUpsert is the "OnConflict" setter.
+	KeyEscrowUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *KeyEscrowUpsert) SetUpdateTime(v time.Time) *KeyEscrowUpsert {
+	u.Set(keyescrow.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *KeyEscrowUpsert) UpdateUpdateTime() *KeyEscrowUpsert {
+	u.SetExcluded(keyescrow.FieldUpdateTime)
+	return u
+}
+
+// SetMode sets the "mode" field.
+func (u *KeyEscrowUpsert) SetMode(v keyescrow.Mode) *KeyEscrowUpsert {
+	u.Set(keyescrow.FieldMode, v)
+	return u
+}
+
+// UpdateMode sets the "mode" field to the value that was provided on create.
+func (u *KeyEscrowUpsert) UpdateMode() *KeyEscrowUpsert {
+	u.SetExcluded(keyescrow.FieldMode)
+	return u
+}
+
+// SetCiphertext sets the "ciphertext" field.
+func (u *KeyEscrowUpsert) SetCiphertext(v []byte) *KeyEscrowUpsert {
+	u.Set(keyescrow.FieldCiphertext, v)
+	return u
+}
+
+// UpdateCiphertext sets the "ciphertext" field to the value that was provided on create.
+func (u *KeyEscrowUpsert) UpdateCiphertext() *KeyEscrowUpsert {
+	u.SetExcluded(keyescrow.FieldCiphertext)
+	return u
+}
+
+// SetWrappedKey sets the "wrappedKey" field.
+func (u *KeyEscrowUpsert) SetWrappedKey(v []byte) *KeyEscrowUpsert {
+	u.Set(keyescrow.FieldWrappedKey, v)
+	return u
+}
+
+// UpdateWrappedKey sets the "wrappedKey" field to the value that was provided on create.
+func (u *KeyEscrowUpsert) UpdateWrappedKey() *KeyEscrowUpsert {
+	u.SetExcluded(keyescrow.FieldWrappedKey)
+	return u
+}
+
+// ClearWrappedKey clears the value of the "wrappedKey" field.
+func (u *KeyEscrowUpsert) ClearWrappedKey() *KeyEscrowUpsert {
+	u.SetNull(keyescrow.FieldWrappedKey)
+	return u
+}
+
+// SetShares sets the "shares" field.
+func (u *KeyEscrowUpsert) SetShares(v map[string][]uint8) *KeyEscrowUpsert {
+	u.Set(keyescrow.FieldShares, v)
+	return u
+}
+
+// UpdateShares sets the "shares" field to the value that was provided on create.
+func (u *KeyEscrowUpsert) UpdateShares() *KeyEscrowUpsert {
+	u.SetExcluded(keyescrow.FieldShares)
+	return u
+}
+
+// ClearShares clears the value of the "shares" field.
+func (u *KeyEscrowUpsert) ClearShares() *KeyEscrowUpsert {
+	u.SetNull(keyescrow.FieldShares)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.KeyEscrow.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *KeyEscrowUpsertOne) UpdateNewValues() *KeyEscrowUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(keyescrow.FieldCreateTime)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.KeyEscrow.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *KeyEscrowUpsertOne) Ignore() *KeyEscrowUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *KeyEscrowUpsertOne) DoNothing() *KeyEscrowUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the KeyEscrowCreate.OnConflict
+// documentation for more info.
+func (u *KeyEscrowUpsertOne) Update(set func(*KeyEscrowUpsert)) *KeyEscrowUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&KeyEscrowUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *KeyEscrowUpsertOne) SetUpdateTime(v time.Time) *KeyEscrowUpsertOne {
+	return u.Update(func(s *KeyEscrowUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *KeyEscrowUpsertOne) UpdateUpdateTime() *KeyEscrowUpsertOne {
+	return u.Update(func(s *KeyEscrowUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetMode sets the "mode" field.
+func (u *KeyEscrowUpsertOne) SetMode(v keyescrow.Mode) *KeyEscrowUpsertOne {
+	return u.Update(func(s *KeyEscrowUpsert) {
+		s.SetMode(v)
+	})
+}
+
+// UpdateMode sets the "mode" field to the value that was provided on create.
+func (u *KeyEscrowUpsertOne) UpdateMode() *KeyEscrowUpsertOne {
+	return u.Update(func(s *KeyEscrowUpsert) {
+		s.UpdateMode()
+	})
+}
+
+// SetCiphertext sets the "ciphertext" field.
+func (u *KeyEscrowUpsertOne) SetCiphertext(v []byte) *KeyEscrowUpsertOne {
+	return u.Update(func(s *KeyEscrowUpsert) {
+		s.SetCiphertext(v)
+	})
+}
+
+// UpdateCiphertext sets the "ciphertext" field to the value that was provided on create.
+func (u *KeyEscrowUpsertOne) UpdateCiphertext() *KeyEscrowUpsertOne {
+	return u.Update(func(s *KeyEscrowUpsert) {
+		s.UpdateCiphertext()
+	})
+}
+
+// SetWrappedKey sets the "wrappedKey" field.
+func (u *KeyEscrowUpsertOne) SetWrappedKey(v []byte) *KeyEscrowUpsertOne {
+	return u.Update(func(s *KeyEscrowUpsert) {
+		s.SetWrappedKey(v)
+	})
+}
+
+// UpdateWrappedKey sets the "wrappedKey" field to the value that was provided on create.
+func (u *KeyEscrowUpsertOne) UpdateWrappedKey() *KeyEscrowUpsertOne {
+	return u.Update(func(s *KeyEscrowUpsert) {
+		s.UpdateWrappedKey()
+	})
+}
+
+// ClearWrappedKey clears the value of the "wrappedKey" field.
+func (u *KeyEscrowUpsertOne) ClearWrappedKey() *KeyEscrowUpsertOne {
+	return u.Update(func(s *KeyEscrowUpsert) {
+		s.ClearWrappedKey()
+	})
+}
+
+// SetShares sets the "shares" field.
+func (u *KeyEscrowUpsertOne) SetShares(v map[string][]uint8) *KeyEscrowUpsertOne {
+	return u.Update(func(s *KeyEscrowUpsert) {
+		s.SetShares(v)
+	})
+}
+
+// UpdateShares sets the "shares" field to the value that was provided on create.
+func (u *KeyEscrowUpsertOne) UpdateShares() *KeyEscrowUpsertOne {
+	return u.Update(func(s *KeyEscrowUpsert) {
+		s.UpdateShares()
+	})
+}
+
+// ClearShares clears the value of the "shares" field.
+func (u *KeyEscrowUpsertOne) ClearShares() *KeyEscrowUpsertOne {
+	return u.Update(func(s *KeyEscrowUpsert) {
+		s.ClearShares()
+	})
+}
+
+// Exec executes the query.
+func (u *KeyEscrowUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for KeyEscrowCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *KeyEscrowUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *KeyEscrowUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *KeyEscrowUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// KeyEscrowCreateBulk is the builder for creating many KeyEscrow entities in bulk.
+type KeyEscrowCreateBulk struct {
+	config
+	err      error
+	builders []*KeyEscrowCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the KeyEscrow entities in the database.
+func (_c *KeyEscrowCreateBulk) Save(ctx context.Context) ([]*KeyEscrow, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*KeyEscrow, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*KeyEscrowMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *KeyEscrowCreateBulk) SaveX(ctx context.Context) []*KeyEscrow {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *KeyEscrowCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *KeyEscrowCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.KeyEscrow.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.KeyEscrowUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *KeyEscrowCreateBulk) OnConflict(opts ...sql.ConflictOption) *KeyEscrowUpsertBulk {
+	_c.conflict = opts
+	return &KeyEscrowUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.KeyEscrow.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *KeyEscrowCreateBulk) OnConflictColumns(columns ...string) *KeyEscrowUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &KeyEscrowUpsertBulk{
+		create: _c,
+	}
+}
+
+// KeyEscrowUpsertBulk is the builder for "upsert"-ing
+// a bulk of KeyEscrow nodes.
+type KeyEscrowUpsertBulk struct {
+	create *KeyEscrowCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.KeyEscrow.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *KeyEscrowUpsertBulk) UpdateNewValues() *KeyEscrowUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(keyescrow.FieldCreateTime)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.KeyEscrow.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *KeyEscrowUpsertBulk) Ignore() *KeyEscrowUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *KeyEscrowUpsertBulk) DoNothing() *KeyEscrowUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the KeyEscrowCreateBulk.OnConflict
+// documentation for more info.
+func (u *KeyEscrowUpsertBulk) Update(set func(*KeyEscrowUpsert)) *KeyEscrowUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&KeyEscrowUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *KeyEscrowUpsertBulk) SetUpdateTime(v time.Time) *KeyEscrowUpsertBulk {
+	return u.Update(func(s *KeyEscrowUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *KeyEscrowUpsertBulk) UpdateUpdateTime() *KeyEscrowUpsertBulk {
+	return u.Update(func(s *KeyEscrowUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetMode sets the "mode" field.
+func (u *KeyEscrowUpsertBulk) SetMode(v keyescrow.Mode) *KeyEscrowUpsertBulk {
+	return u.Update(func(s *KeyEscrowUpsert) {
+		s.SetMode(v)
+	})
+}
+
+// UpdateMode sets the "mode" field to the value that was provided on create.
+func (u *KeyEscrowUpsertBulk) UpdateMode() *KeyEscrowUpsertBulk {
+	return u.Update(func(s *KeyEscrowUpsert) {
+		s.UpdateMode()
+	})
+}
+
+// SetCiphertext sets the "ciphertext" field.
+func (u *KeyEscrowUpsertBulk) SetCiphertext(v []byte) *KeyEscrowUpsertBulk {
+	return u.Update(func(s *KeyEscrowUpsert) {
+		s.SetCiphertext(v)
+	})
+}
+
+// UpdateCiphertext sets the "ciphertext" field to the value that was provided on create.
+func (u *KeyEscrowUpsertBulk) UpdateCiphertext() *KeyEscrowUpsertBulk {
+	return u.Update(func(s *KeyEscrowUpsert) {
+		s.UpdateCiphertext()
+	})
+}
+
+// SetWrappedKey sets the "wrappedKey" field.
+func (u *KeyEscrowUpsertBulk) SetWrappedKey(v []byte) *KeyEscrowUpsertBulk {
+	return u.Update(func(s *KeyEscrowUpsert) {
+		s.SetWrappedKey(v)
+	})
+}
+
+// UpdateWrappedKey sets the "wrappedKey" field to the value that was provided on create.
+func (u *KeyEscrowUpsertBulk) UpdateWrappedKey() *KeyEscrowUpsertBulk {
+	return u.Update(func(s *KeyEscrowUpsert) {
+		s.UpdateWrappedKey()
+	})
+}
+
+// ClearWrappedKey clears the value of the "wrappedKey" field.
+func (u *KeyEscrowUpsertBulk) ClearWrappedKey() *KeyEscrowUpsertBulk {
+	return u.Update(func(s *KeyEscrowUpsert) {
+		s.ClearWrappedKey()
+	})
+}
+
+// SetShares sets the "shares" field.
+func (u *KeyEscrowUpsertBulk) SetShares(v map[string][]uint8) *KeyEscrowUpsertBulk {
+	return u.Update(func(s *KeyEscrowUpsert) {
+		s.SetShares(v)
+	})
+}
+
+// UpdateShares sets the "shares" field to the value that was provided on create.
+func (u *KeyEscrowUpsertBulk) UpdateShares() *KeyEscrowUpsertBulk {
+	return u.Update(func(s *KeyEscrowUpsert) {
+		s.UpdateShares()
+	})
+}
+
+// ClearShares clears the value of the "shares" field.
+func (u *KeyEscrowUpsertBulk) ClearShares() *KeyEscrowUpsertBulk {
+	return u.Update(func(s *KeyEscrowUpsert) {
+		s.ClearShares()
+	})
+}
+
+// Exec executes the query.
+func (u *KeyEscrowUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the KeyEscrowCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for KeyEscrowCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *KeyEscrowUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
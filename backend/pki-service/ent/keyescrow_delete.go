@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hm-edu/pki-service/ent/keyescrow"
+	"github.com/hm-edu/pki-service/ent/predicate"
+)
+
+// KeyEscrowDelete is the builder for deleting a KeyEscrow entity.
+type KeyEscrowDelete struct {
+	config
+	hooks    []Hook
+	mutation *KeyEscrowMutation
+}
+
+// Where appends a list predicates to the KeyEscrowDelete builder.
+func (_d *KeyEscrowDelete) Where(ps ...predicate.KeyEscrow) *KeyEscrowDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *KeyEscrowDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *KeyEscrowDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *KeyEscrowDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(keyescrow.Table, sqlgraph.NewFieldSpec(keyescrow.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// KeyEscrowDeleteOne is the builder for deleting a single KeyEscrow entity.
+type KeyEscrowDeleteOne struct {
+	_d *KeyEscrowDelete
+}
+
+// Where appends a list predicates to the KeyEscrowDelete builder.
+func (_d *KeyEscrowDeleteOne) Where(ps ...predicate.KeyEscrow) *KeyEscrowDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *KeyEscrowDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{keyescrow.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *KeyEscrowDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,690 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hm-edu/pki-service/ent/keyescrow"
+	"github.com/hm-edu/pki-service/ent/keyrecovery"
+	"github.com/hm-edu/pki-service/ent/predicate"
+	"github.com/hm-edu/pki-service/ent/smimecertificate"
+)
+
+// KeyEscrowQuery is the builder for querying KeyEscrow entities.
+type KeyEscrowQuery struct {
+	config
+	ctx             *QueryContext
+	order           []keyescrow.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.KeyEscrow
+	withCertificate *SmimeCertificateQuery
+	withRecoveries  *KeyRecoveryQuery
+	withFKs         bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the KeyEscrowQuery builder.
+func (_q *KeyEscrowQuery) Where(ps ...predicate.KeyEscrow) *KeyEscrowQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *KeyEscrowQuery) Limit(limit int) *KeyEscrowQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *KeyEscrowQuery) Offset(offset int) *KeyEscrowQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *KeyEscrowQuery) Unique(unique bool) *KeyEscrowQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *KeyEscrowQuery) Order(o ...keyescrow.OrderOption) *KeyEscrowQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// QueryCertificate chains the current query on the "certificate" edge.
+func (_q *KeyEscrowQuery) QueryCertificate() *SmimeCertificateQuery {
+	query := (&SmimeCertificateClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(keyescrow.Table, keyescrow.FieldID, selector),
+			sqlgraph.To(smimecertificate.Table, smimecertificate.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, keyescrow.CertificateTable, keyescrow.CertificateColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRecoveries chains the current query on the "recoveries" edge.
+func (_q *KeyEscrowQuery) QueryRecoveries() *KeyRecoveryQuery {
+	query := (&KeyRecoveryClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(keyescrow.Table, keyescrow.FieldID, selector),
+			sqlgraph.To(keyrecovery.Table, keyrecovery.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, keyescrow.RecoveriesTable, keyescrow.RecoveriesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first KeyEscrow entity from the query.
+// Returns a *NotFoundError when no KeyEscrow was found.
+func (_q *KeyEscrowQuery) First(ctx context.Context) (*KeyEscrow, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{keyescrow.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *KeyEscrowQuery) FirstX(ctx context.Context) *KeyEscrow {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first KeyEscrow ID from the query.
+// Returns a *NotFoundError when no KeyEscrow ID was found.
+func (_q *KeyEscrowQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{keyescrow.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *KeyEscrowQuery) FirstIDX(ctx context.Context) int {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single KeyEscrow entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one KeyEscrow entity is found.
+// Returns a *NotFoundError when no KeyEscrow entities are found.
+func (_q *KeyEscrowQuery) Only(ctx context.Context) (*KeyEscrow, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{keyescrow.Label}
+	default:
+		return nil, &NotSingularError{keyescrow.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *KeyEscrowQuery) OnlyX(ctx context.Context) *KeyEscrow {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only KeyEscrow ID in the query.
+// Returns a *NotSingularError when more than one KeyEscrow ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *KeyEscrowQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{keyescrow.Label}
+	default:
+		err = &NotSingularError{keyescrow.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *KeyEscrowQuery) OnlyIDX(ctx context.Context) int {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of KeyEscrows.
+func (_q *KeyEscrowQuery) All(ctx context.Context) ([]*KeyEscrow, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*KeyEscrow, *KeyEscrowQuery]()
+	return withInterceptors[[]*KeyEscrow](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *KeyEscrowQuery) AllX(ctx context.Context) []*KeyEscrow {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of KeyEscrow IDs.
+func (_q *KeyEscrowQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(keyescrow.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *KeyEscrowQuery) IDsX(ctx context.Context) []int {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *KeyEscrowQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*KeyEscrowQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *KeyEscrowQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *KeyEscrowQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *KeyEscrowQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the KeyEscrowQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *KeyEscrowQuery) Clone() *KeyEscrowQuery {
+	if _q == nil {
+		return nil
+	}
+	return &KeyEscrowQuery{
+		config:          _q.config,
+		ctx:             _q.ctx.Clone(),
+		order:           append([]keyescrow.OrderOption{}, _q.order...),
+		inters:          append([]Interceptor{}, _q.inters...),
+		predicates:      append([]predicate.KeyEscrow{}, _q.predicates...),
+		withCertificate: _q.withCertificate.Clone(),
+		withRecoveries:  _q.withRecoveries.Clone(),
+		// clone intermediate query.
+		sql:  _q.sql.Clone(),
+		path: _q.path,
+	}
+}
+
+// WithCertificate tells the query-builder to eager-load the nodes that are connected to
+// the "certificate" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *KeyEscrowQuery) WithCertificate(opts ...func(*SmimeCertificateQuery)) *KeyEscrowQuery {
+	query := (&SmimeCertificateClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withCertificate = query
+	return _q
+}
+
+// WithRecoveries tells the query-builder to eager-load the nodes that are connected to
+// the "recoveries" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *KeyEscrowQuery) WithRecoveries(opts ...func(*KeyRecoveryQuery)) *KeyEscrowQuery {
+	query := (&KeyRecoveryClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRecoveries = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.KeyEscrow.Query().
+//		GroupBy(keyescrow.FieldCreateTime).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *KeyEscrowQuery) GroupBy(field string, fields ...string) *KeyEscrowGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &KeyEscrowGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = keyescrow.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//	}
+//
+//	client.KeyEscrow.Query().
+//		Select(keyescrow.FieldCreateTime).
+//		Scan(ctx, &v)
+func (_q *KeyEscrowQuery) Select(fields ...string) *KeyEscrowSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &KeyEscrowSelect{KeyEscrowQuery: _q}
+	sbuild.label = keyescrow.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a KeyEscrowSelect configured with the given aggregations.
+func (_q *KeyEscrowQuery) Aggregate(fns ...AggregateFunc) *KeyEscrowSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *KeyEscrowQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !keyescrow.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *KeyEscrowQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*KeyEscrow, error) {
+	var (
+		nodes       = []*KeyEscrow{}
+		withFKs     = _q.withFKs
+		_spec       = _q.querySpec()
+		loadedTypes = [2]bool{
+			_q.withCertificate != nil,
+			_q.withRecoveries != nil,
+		}
+	)
+	if _q.withCertificate != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, keyescrow.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*KeyEscrow).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &KeyEscrow{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withCertificate; query != nil {
+		if err := _q.loadCertificate(ctx, query, nodes, nil,
+			func(n *KeyEscrow, e *SmimeCertificate) { n.Edges.Certificate = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withRecoveries; query != nil {
+		if err := _q.loadRecoveries(ctx, query, nodes,
+			func(n *KeyEscrow) { n.Edges.Recoveries = []*KeyRecovery{} },
+			func(n *KeyEscrow, e *KeyRecovery) { n.Edges.Recoveries = append(n.Edges.Recoveries, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *KeyEscrowQuery) loadCertificate(ctx context.Context, query *SmimeCertificateQuery, nodes []*KeyEscrow, init func(*KeyEscrow), assign func(*KeyEscrow, *SmimeCertificate)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*KeyEscrow)
+	for i := range nodes {
+		if nodes[i].smime_certificate_key_escrow == nil {
+			continue
+		}
+		fk := *nodes[i].smime_certificate_key_escrow
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(smimecertificate.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "smime_certificate_key_escrow" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *KeyEscrowQuery) loadRecoveries(ctx context.Context, query *KeyRecoveryQuery, nodes []*KeyEscrow, init func(*KeyEscrow), assign func(*KeyEscrow, *KeyRecovery)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*KeyEscrow)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.KeyRecovery(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(keyescrow.RecoveriesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.key_escrow_recoveries
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "key_escrow_recoveries" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "key_escrow_recoveries" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (_q *KeyEscrowQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *KeyEscrowQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(keyescrow.Table, keyescrow.Columns, sqlgraph.NewFieldSpec(keyescrow.FieldID, field.TypeInt))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, keyescrow.FieldID)
+		for i := range fields {
+			if fields[i] != keyescrow.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *KeyEscrowQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(keyescrow.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = keyescrow.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// KeyEscrowGroupBy is the group-by builder for KeyEscrow entities.
+type KeyEscrowGroupBy struct {
+	selector
+	build *KeyEscrowQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *KeyEscrowGroupBy) Aggregate(fns ...AggregateFunc) *KeyEscrowGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *KeyEscrowGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*KeyEscrowQuery, *KeyEscrowGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *KeyEscrowGroupBy) sqlScan(ctx context.Context, root *KeyEscrowQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// KeyEscrowSelect is the builder for selecting fields of KeyEscrow entities.
+type KeyEscrowSelect struct {
+	*KeyEscrowQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *KeyEscrowSelect) Aggregate(fns ...AggregateFunc) *KeyEscrowSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *KeyEscrowSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*KeyEscrowQuery, *KeyEscrowSelect](ctx, _s.KeyEscrowQuery, _s, _s.inters, v)
+}
+
+func (_s *KeyEscrowSelect) sqlScan(ctx context.Context, root *KeyEscrowQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,636 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hm-edu/pki-service/ent/keyescrow"
+	"github.com/hm-edu/pki-service/ent/keyrecovery"
+	"github.com/hm-edu/pki-service/ent/predicate"
+	"github.com/hm-edu/pki-service/ent/smimecertificate"
+)
+
+// KeyEscrowUpdate is the builder for updating KeyEscrow entities.
+type KeyEscrowUpdate struct {
+	config
+	hooks    []Hook
+	mutation *KeyEscrowMutation
+}
+
+// Where appends a list predicates to the KeyEscrowUpdate builder.
+func (_u *KeyEscrowUpdate) Where(ps ...predicate.KeyEscrow) *KeyEscrowUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *KeyEscrowUpdate) SetUpdateTime(v time.Time) *KeyEscrowUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetMode sets the "mode" field.
+func (_u *KeyEscrowUpdate) SetMode(v keyescrow.Mode) *KeyEscrowUpdate {
+	_u.mutation.SetMode(v)
+	return _u
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (_u *KeyEscrowUpdate) SetNillableMode(v *keyescrow.Mode) *KeyEscrowUpdate {
+	if v != nil {
+		_u.SetMode(*v)
+	}
+	return _u
+}
+
+// SetCiphertext sets the "ciphertext" field.
+func (_u *KeyEscrowUpdate) SetCiphertext(v []byte) *KeyEscrowUpdate {
+	_u.mutation.SetCiphertext(v)
+	return _u
+}
+
+// SetWrappedKey sets the "wrappedKey" field.
+func (_u *KeyEscrowUpdate) SetWrappedKey(v []byte) *KeyEscrowUpdate {
+	_u.mutation.SetWrappedKey(v)
+	return _u
+}
+
+// ClearWrappedKey clears the value of the "wrappedKey" field.
+func (_u *KeyEscrowUpdate) ClearWrappedKey() *KeyEscrowUpdate {
+	_u.mutation.ClearWrappedKey()
+	return _u
+}
+
+// SetShares sets the "shares" field.
+func (_u *KeyEscrowUpdate) SetShares(v map[string][]uint8) *KeyEscrowUpdate {
+	_u.mutation.SetShares(v)
+	return _u
+}
+
+// ClearShares clears the value of the "shares" field.
+func (_u *KeyEscrowUpdate) ClearShares() *KeyEscrowUpdate {
+	_u.mutation.ClearShares()
+	return _u
+}
+
+// SetCertificateID sets the "certificate" edge to the SmimeCertificate entity by ID.
+func (_u *KeyEscrowUpdate) SetCertificateID(id int) *KeyEscrowUpdate {
+	_u.mutation.SetCertificateID(id)
+	return _u
+}
+
+// SetCertificate sets the "certificate" edge to the SmimeCertificate entity.
+func (_u *KeyEscrowUpdate) SetCertificate(v *SmimeCertificate) *KeyEscrowUpdate {
+	return _u.SetCertificateID(v.ID)
+}
+
+// AddRecoveryIDs adds the "recoveries" edge to the KeyRecovery entity by IDs.
+func (_u *KeyEscrowUpdate) AddRecoveryIDs(ids ...int) *KeyEscrowUpdate {
+	_u.mutation.AddRecoveryIDs(ids...)
+	return _u
+}
+
+// AddRecoveries adds the "recoveries" edges to the KeyRecovery entity.
+func (_u *KeyEscrowUpdate) AddRecoveries(v ...*KeyRecovery) *KeyEscrowUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRecoveryIDs(ids...)
+}
+
+// Mutation returns the KeyEscrowMutation object of the builder.
+func (_u *KeyEscrowUpdate) Mutation() *KeyEscrowMutation {
+	return _u.mutation
+}
+
+// ClearCertificate clears the "certificate" edge to the SmimeCertificate entity.
+func (_u *KeyEscrowUpdate) ClearCertificate() *KeyEscrowUpdate {
+	_u.mutation.ClearCertificate()
+	return _u
+}
+
+// ClearRecoveries clears all "recoveries" edges to the KeyRecovery entity.
+func (_u *KeyEscrowUpdate) ClearRecoveries() *KeyEscrowUpdate {
+	_u.mutation.ClearRecoveries()
+	return _u
+}
+
+// RemoveRecoveryIDs removes the "recoveries" edge to KeyRecovery entities by IDs.
+func (_u *KeyEscrowUpdate) RemoveRecoveryIDs(ids ...int) *KeyEscrowUpdate {
+	_u.mutation.RemoveRecoveryIDs(ids...)
+	return _u
+}
+
+// RemoveRecoveries removes "recoveries" edges to KeyRecovery entities.
+func (_u *KeyEscrowUpdate) RemoveRecoveries(v ...*KeyRecovery) *KeyEscrowUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRecoveryIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *KeyEscrowUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *KeyEscrowUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *KeyEscrowUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *KeyEscrowUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *KeyEscrowUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if keyescrow.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized keyescrow.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := keyescrow.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *KeyEscrowUpdate) check() error {
+	if v, ok := _u.mutation.Mode(); ok {
+		if err := keyescrow.ModeValidator(v); err != nil {
+			return &ValidationError{Name: "mode", err: fmt.Errorf(`ent: validator failed for field "KeyEscrow.mode": %w`, err)}
+		}
+	}
+	if _u.mutation.CertificateCleared() && len(_u.mutation.CertificateIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "KeyEscrow.certificate"`)
+	}
+	return nil
+}
+
+func (_u *KeyEscrowUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(keyescrow.Table, keyescrow.Columns, sqlgraph.NewFieldSpec(keyescrow.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(keyescrow.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Mode(); ok {
+		_spec.SetField(keyescrow.FieldMode, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Ciphertext(); ok {
+		_spec.SetField(keyescrow.FieldCiphertext, field.TypeBytes, value)
+	}
+	if value, ok := _u.mutation.WrappedKey(); ok {
+		_spec.SetField(keyescrow.FieldWrappedKey, field.TypeBytes, value)
+	}
+	if _u.mutation.WrappedKeyCleared() {
+		_spec.ClearField(keyescrow.FieldWrappedKey, field.TypeBytes)
+	}
+	if value, ok := _u.mutation.Shares(); ok {
+		_spec.SetField(keyescrow.FieldShares, field.TypeJSON, value)
+	}
+	if _u.mutation.SharesCleared() {
+		_spec.ClearField(keyescrow.FieldShares, field.TypeJSON)
+	}
+	if _u.mutation.CertificateCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   keyescrow.CertificateTable,
+			Columns: []string{keyescrow.CertificateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(smimecertificate.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CertificateIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   keyescrow.CertificateTable,
+			Columns: []string{keyescrow.CertificateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(smimecertificate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RecoveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   keyescrow.RecoveriesTable,
+			Columns: []string{keyescrow.RecoveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(keyrecovery.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRecoveriesIDs(); len(nodes) > 0 && !_u.mutation.RecoveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   keyescrow.RecoveriesTable,
+			Columns: []string{keyescrow.RecoveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(keyrecovery.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RecoveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   keyescrow.RecoveriesTable,
+			Columns: []string{keyescrow.RecoveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(keyrecovery.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{keyescrow.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// KeyEscrowUpdateOne is the builder for updating a single KeyEscrow entity.
+type KeyEscrowUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *KeyEscrowMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *KeyEscrowUpdateOne) SetUpdateTime(v time.Time) *KeyEscrowUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetMode sets the "mode" field.
+func (_u *KeyEscrowUpdateOne) SetMode(v keyescrow.Mode) *KeyEscrowUpdateOne {
+	_u.mutation.SetMode(v)
+	return _u
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (_u *KeyEscrowUpdateOne) SetNillableMode(v *keyescrow.Mode) *KeyEscrowUpdateOne {
+	if v != nil {
+		_u.SetMode(*v)
+	}
+	return _u
+}
+
+// SetCiphertext sets the "ciphertext" field.
+func (_u *KeyEscrowUpdateOne) SetCiphertext(v []byte) *KeyEscrowUpdateOne {
+	_u.mutation.SetCiphertext(v)
+	return _u
+}
+
+// SetWrappedKey sets the "wrappedKey" field.
+func (_u *KeyEscrowUpdateOne) SetWrappedKey(v []byte) *KeyEscrowUpdateOne {
+	_u.mutation.SetWrappedKey(v)
+	return _u
+}
+
+// ClearWrappedKey clears the value of the "wrappedKey" field.
+func (_u *KeyEscrowUpdateOne) ClearWrappedKey() *KeyEscrowUpdateOne {
+	_u.mutation.ClearWrappedKey()
+	return _u
+}
+
+// SetShares sets the "shares" field.
+func (_u *KeyEscrowUpdateOne) SetShares(v map[string][]uint8) *KeyEscrowUpdateOne {
+	_u.mutation.SetShares(v)
+	return _u
+}
+
+// ClearShares clears the value of the "shares" field.
+func (_u *KeyEscrowUpdateOne) ClearShares() *KeyEscrowUpdateOne {
+	_u.mutation.ClearShares()
+	return _u
+}
+
+// SetCertificateID sets the "certificate" edge to the SmimeCertificate entity by ID.
+func (_u *KeyEscrowUpdateOne) SetCertificateID(id int) *KeyEscrowUpdateOne {
+	_u.mutation.SetCertificateID(id)
+	return _u
+}
+
+// SetCertificate sets the "certificate" edge to the SmimeCertificate entity.
+func (_u *KeyEscrowUpdateOne) SetCertificate(v *SmimeCertificate) *KeyEscrowUpdateOne {
+	return _u.SetCertificateID(v.ID)
+}
+
+// AddRecoveryIDs adds the "recoveries" edge to the KeyRecovery entity by IDs.
+func (_u *KeyEscrowUpdateOne) AddRecoveryIDs(ids ...int) *KeyEscrowUpdateOne {
+	_u.mutation.AddRecoveryIDs(ids...)
+	return _u
+}
+
+// AddRecoveries adds the "recoveries" edges to the KeyRecovery entity.
+func (_u *KeyEscrowUpdateOne) AddRecoveries(v ...*KeyRecovery) *KeyEscrowUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRecoveryIDs(ids...)
+}
+
+// Mutation returns the KeyEscrowMutation object of the builder.
+func (_u *KeyEscrowUpdateOne) Mutation() *KeyEscrowMutation {
+	return _u.mutation
+}
+
+// ClearCertificate clears the "certificate" edge to the SmimeCertificate entity.
+func (_u *KeyEscrowUpdateOne) ClearCertificate() *KeyEscrowUpdateOne {
+	_u.mutation.ClearCertificate()
+	return _u
+}
+
+// ClearRecoveries clears all "recoveries" edges to the KeyRecovery entity.
+func (_u *KeyEscrowUpdateOne) ClearRecoveries() *KeyEscrowUpdateOne {
+	_u.mutation.ClearRecoveries()
+	return _u
+}
+
+// RemoveRecoveryIDs removes the "recoveries" edge to KeyRecovery entities by IDs.
+func (_u *KeyEscrowUpdateOne) RemoveRecoveryIDs(ids ...int) *KeyEscrowUpdateOne {
+	_u.mutation.RemoveRecoveryIDs(ids...)
+	return _u
+}
+
+// RemoveRecoveries removes "recoveries" edges to KeyRecovery entities.
+func (_u *KeyEscrowUpdateOne) RemoveRecoveries(v ...*KeyRecovery) *KeyEscrowUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRecoveryIDs(ids...)
+}
+
+// Where appends a list predicates to the KeyEscrowUpdate builder.
+func (_u *KeyEscrowUpdateOne) Where(ps ...predicate.KeyEscrow) *KeyEscrowUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *KeyEscrowUpdateOne) Select(field string, fields ...string) *KeyEscrowUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated KeyEscrow entity.
+func (_u *KeyEscrowUpdateOne) Save(ctx context.Context) (*KeyEscrow, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *KeyEscrowUpdateOne) SaveX(ctx context.Context) *KeyEscrow {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *KeyEscrowUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *KeyEscrowUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *KeyEscrowUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if keyescrow.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized keyescrow.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := keyescrow.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *KeyEscrowUpdateOne) check() error {
+	if v, ok := _u.mutation.Mode(); ok {
+		if err := keyescrow.ModeValidator(v); err != nil {
+			return &ValidationError{Name: "mode", err: fmt.Errorf(`ent: validator failed for field "KeyEscrow.mode": %w`, err)}
+		}
+	}
+	if _u.mutation.CertificateCleared() && len(_u.mutation.CertificateIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "KeyEscrow.certificate"`)
+	}
+	return nil
+}
+
+func (_u *KeyEscrowUpdateOne) sqlSave(ctx context.Context) (_node *KeyEscrow, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(keyescrow.Table, keyescrow.Columns, sqlgraph.NewFieldSpec(keyescrow.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "KeyEscrow.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, keyescrow.FieldID)
+		for _, f := range fields {
+			if !keyescrow.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != keyescrow.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(keyescrow.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Mode(); ok {
+		_spec.SetField(keyescrow.FieldMode, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Ciphertext(); ok {
+		_spec.SetField(keyescrow.FieldCiphertext, field.TypeBytes, value)
+	}
+	if value, ok := _u.mutation.WrappedKey(); ok {
+		_spec.SetField(keyescrow.FieldWrappedKey, field.TypeBytes, value)
+	}
+	if _u.mutation.WrappedKeyCleared() {
+		_spec.ClearField(keyescrow.FieldWrappedKey, field.TypeBytes)
+	}
+	if value, ok := _u.mutation.Shares(); ok {
+		_spec.SetField(keyescrow.FieldShares, field.TypeJSON, value)
+	}
+	if _u.mutation.SharesCleared() {
+		_spec.ClearField(keyescrow.FieldShares, field.TypeJSON)
+	}
+	if _u.mutation.CertificateCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   keyescrow.CertificateTable,
+			Columns: []string{keyescrow.CertificateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(smimecertificate.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CertificateIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   keyescrow.CertificateTable,
+			Columns: []string{keyescrow.CertificateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(smimecertificate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RecoveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   keyescrow.RecoveriesTable,
+			Columns: []string{keyescrow.RecoveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(keyrecovery.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRecoveriesIDs(); len(nodes) > 0 && !_u.mutation.RecoveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   keyescrow.RecoveriesTable,
+			Columns: []string{keyescrow.RecoveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(keyrecovery.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RecoveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   keyescrow.RecoveriesTable,
+			Columns: []string{keyescrow.RecoveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(keyrecovery.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &KeyEscrow{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{keyescrow.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,233 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/hm-edu/pki-service/ent/keyescrow"
+	"github.com/hm-edu/pki-service/ent/keyrecovery"
+)
+
+// KeyRecovery is the model entity for the KeyRecovery schema.
+type KeyRecovery struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// RequestedBy holds the value of the "requestedBy" field.
+	RequestedBy string `json:"requestedBy,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason,omitempty"`
+	// Status holds the value of the "status" field.
+	Status keyrecovery.Status `json:"status,omitempty"`
+	// Approvals holds the value of the "approvals" field.
+	Approvals []string `json:"approvals,omitempty"`
+	// DecidedBy holds the value of the "decidedBy" field.
+	DecidedBy *string `json:"decidedBy,omitempty"`
+	// ReleasedAt holds the value of the "releasedAt" field.
+	ReleasedAt *time.Time `json:"releasedAt,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the KeyRecoveryQuery when eager-loading is set.
+	Edges                 KeyRecoveryEdges `json:"edges"`
+	key_escrow_recoveries *int
+	selectValues          sql.SelectValues
+}
+
+// KeyRecoveryEdges holds the relations/edges for other nodes in the graph.
+type KeyRecoveryEdges struct {
+	// Escrow holds the value of the escrow edge.
+	Escrow *KeyEscrow `json:"escrow,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// EscrowOrErr returns the Escrow value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e KeyRecoveryEdges) EscrowOrErr() (*KeyEscrow, error) {
+	if e.Escrow != nil {
+		return e.Escrow, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: keyescrow.Label}
+	}
+	return nil, &NotLoadedError{edge: "escrow"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*KeyRecovery) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case keyrecovery.FieldApprovals:
+			values[i] = new([]byte)
+		case keyrecovery.FieldID:
+			values[i] = new(sql.NullInt64)
+		case keyrecovery.FieldRequestedBy, keyrecovery.FieldReason, keyrecovery.FieldStatus, keyrecovery.FieldDecidedBy:
+			values[i] = new(sql.NullString)
+		case keyrecovery.FieldCreateTime, keyrecovery.FieldUpdateTime, keyrecovery.FieldReleasedAt:
+			values[i] = new(sql.NullTime)
+		case keyrecovery.ForeignKeys[0]: // key_escrow_recoveries
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the KeyRecovery fields.
+func (_m *KeyRecovery) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case keyrecovery.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case keyrecovery.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = value.Time
+			}
+		case keyrecovery.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = value.Time
+			}
+		case keyrecovery.FieldRequestedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field requestedBy", values[i])
+			} else if value.Valid {
+				_m.RequestedBy = value.String
+			}
+		case keyrecovery.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = value.String
+			}
+		case keyrecovery.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = keyrecovery.Status(value.String)
+			}
+		case keyrecovery.FieldApprovals:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field approvals", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Approvals); err != nil {
+					return fmt.Errorf("unmarshal field approvals: %w", err)
+				}
+			}
+		case keyrecovery.FieldDecidedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field decidedBy", values[i])
+			} else if value.Valid {
+				_m.DecidedBy = new(string)
+				*_m.DecidedBy = value.String
+			}
+		case keyrecovery.FieldReleasedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field releasedAt", values[i])
+			} else if value.Valid {
+				_m.ReleasedAt = new(time.Time)
+				*_m.ReleasedAt = value.Time
+			}
+		case keyrecovery.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field key_escrow_recoveries", value)
+			} else if value.Valid {
+				_m.key_escrow_recoveries = new(int)
+				*_m.key_escrow_recoveries = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the KeyRecovery.
+// This includes values selected through modifiers, order, etc.
+func (_m *KeyRecovery) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryEscrow queries the "escrow" edge of the KeyRecovery entity.
+func (_m *KeyRecovery) QueryEscrow() *KeyEscrowQuery {
+	return NewKeyRecoveryClient(_m.config).QueryEscrow(_m)
+}
+
+// Update returns a builder for updating this KeyRecovery.
+// Note that you need to call KeyRecovery.Unwrap() before calling this method if this KeyRecovery
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *KeyRecovery) Update() *KeyRecoveryUpdateOne {
+	return NewKeyRecoveryClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the KeyRecovery entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *KeyRecovery) Unwrap() *KeyRecovery {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: KeyRecovery is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *KeyRecovery) String() string {
+	var builder strings.Builder
+	builder.WriteString("KeyRecovery(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(_m.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("requestedBy=")
+	builder.WriteString(_m.RequestedBy)
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(_m.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("approvals=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Approvals))
+	builder.WriteString(", ")
+	if v := _m.DecidedBy; v != nil {
+		builder.WriteString("decidedBy=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.ReleasedAt; v != nil {
+		builder.WriteString("releasedAt=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// KeyRecoveries is a parsable slice of KeyRecovery.
+type KeyRecoveries []*KeyRecovery
@@ -0,0 +1,175 @@
+// Code generated by ent, DO NOT EDIT.
+
+package keyrecovery
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the keyrecovery type in the database.
+	Label = "key_recovery"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldRequestedBy holds the string denoting the requestedby field in the database.
+	FieldRequestedBy = "requested_by"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldApprovals holds the string denoting the approvals field in the database.
+	FieldApprovals = "approvals"
+	// FieldDecidedBy holds the string denoting the decidedby field in the database.
+	FieldDecidedBy = "decided_by"
+	// FieldReleasedAt holds the string denoting the releasedat field in the database.
+	FieldReleasedAt = "released_at"
+	// EdgeEscrow holds the string denoting the escrow edge name in mutations.
+	EdgeEscrow = "escrow"
+	// Table holds the table name of the keyrecovery in the database.
+	Table = "key_recoveries"
+	// EscrowTable is the table that holds the escrow relation/edge.
+	EscrowTable = "key_recoveries"
+	// EscrowInverseTable is the table name for the KeyEscrow entity.
+	// It exists in this package in order to avoid circular dependency with the "keyescrow" package.
+	EscrowInverseTable = "key_escrows"
+	// EscrowColumn is the table column denoting the escrow relation/edge.
+	EscrowColumn = "key_escrow_recoveries"
+)
+
+// Columns holds all SQL columns for keyrecovery fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldRequestedBy,
+	FieldReason,
+	FieldStatus,
+	FieldApprovals,
+	FieldDecidedBy,
+	FieldReleasedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "key_recoveries"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"key_escrow_recoveries",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreateTime holds the default value on creation for the "create_time" field.
+	DefaultCreateTime func() time.Time
+	// DefaultUpdateTime holds the default value on creation for the "update_time" field.
+	DefaultUpdateTime func() time.Time
+	// UpdateDefaultUpdateTime holds the default value on update for the "update_time" field.
+	UpdateDefaultUpdateTime func() time.Time
+	// RequestedByValidator is a validator for the "requestedBy" field. It is called by the builders before save.
+	RequestedByValidator func(string) error
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusPending is the default value of the Status enum.
+const DefaultStatus = StatusPending
+
+// Status values.
+const (
+	StatusPending  Status = "Pending"
+	StatusApproved Status = "Approved"
+	StatusReleased Status = "Released"
+	StatusRejected Status = "Rejected"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPending, StatusApproved, StatusReleased, StatusRejected:
+		return nil
+	default:
+		return fmt.Errorf("keyrecovery: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the KeyRecovery queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByRequestedBy orders the results by the requestedBy field.
+func ByRequestedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequestedBy, opts...).ToFunc()
+}
+
+// ByReason orders the results by the reason field.
+func ByReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReason, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByDecidedBy orders the results by the decidedBy field.
+func ByDecidedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDecidedBy, opts...).ToFunc()
+}
+
+// ByReleasedAt orders the results by the releasedAt field.
+func ByReleasedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReleasedAt, opts...).ToFunc()
+}
+
+// ByEscrowField orders the results by escrow field.
+func ByEscrowField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEscrowStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newEscrowStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EscrowInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, EscrowTable, EscrowColumn),
+	)
+}
@@ -0,0 +1,499 @@
+// Code generated by ent, DO NOT EDIT.
+
+package keyrecovery
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/hm-edu/pki-service/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// RequestedBy applies equality check predicate on the "requestedBy" field. It's identical to RequestedByEQ.
+func RequestedBy(v string) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldEQ(FieldRequestedBy, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldEQ(FieldReason, v))
+}
+
+// DecidedBy applies equality check predicate on the "decidedBy" field. It's identical to DecidedByEQ.
+func DecidedBy(v string) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldEQ(FieldDecidedBy, v))
+}
+
+// ReleasedAt applies equality check predicate on the "releasedAt" field. It's identical to ReleasedAtEQ.
+func ReleasedAt(v time.Time) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldEQ(FieldReleasedAt, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// RequestedByEQ applies the EQ predicate on the "requestedBy" field.
+func RequestedByEQ(v string) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldEQ(FieldRequestedBy, v))
+}
+
+// RequestedByNEQ applies the NEQ predicate on the "requestedBy" field.
+func RequestedByNEQ(v string) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldNEQ(FieldRequestedBy, v))
+}
+
+// RequestedByIn applies the In predicate on the "requestedBy" field.
+func RequestedByIn(vs ...string) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldIn(FieldRequestedBy, vs...))
+}
+
+// RequestedByNotIn applies the NotIn predicate on the "requestedBy" field.
+func RequestedByNotIn(vs ...string) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldNotIn(FieldRequestedBy, vs...))
+}
+
+// RequestedByGT applies the GT predicate on the "requestedBy" field.
+func RequestedByGT(v string) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldGT(FieldRequestedBy, v))
+}
+
+// RequestedByGTE applies the GTE predicate on the "requestedBy" field.
+func RequestedByGTE(v string) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldGTE(FieldRequestedBy, v))
+}
+
+// RequestedByLT applies the LT predicate on the "requestedBy" field.
+func RequestedByLT(v string) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldLT(FieldRequestedBy, v))
+}
+
+// RequestedByLTE applies the LTE predicate on the "requestedBy" field.
+func RequestedByLTE(v string) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldLTE(FieldRequestedBy, v))
+}
+
+// RequestedByContains applies the Contains predicate on the "requestedBy" field.
+func RequestedByContains(v string) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldContains(FieldRequestedBy, v))
+}
+
+// RequestedByHasPrefix applies the HasPrefix predicate on the "requestedBy" field.
+func RequestedByHasPrefix(v string) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldHasPrefix(FieldRequestedBy, v))
+}
+
+// RequestedByHasSuffix applies the HasSuffix predicate on the "requestedBy" field.
+func RequestedByHasSuffix(v string) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldHasSuffix(FieldRequestedBy, v))
+}
+
+// RequestedByEqualFold applies the EqualFold predicate on the "requestedBy" field.
+func RequestedByEqualFold(v string) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldEqualFold(FieldRequestedBy, v))
+}
+
+// RequestedByContainsFold applies the ContainsFold predicate on the "requestedBy" field.
+func RequestedByContainsFold(v string) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldContainsFold(FieldRequestedBy, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonIsNil applies the IsNil predicate on the "reason" field.
+func ReasonIsNil() predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldIsNull(FieldReason))
+}
+
+// ReasonNotNil applies the NotNil predicate on the "reason" field.
+func ReasonNotNil() predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldNotNull(FieldReason))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldContainsFold(FieldReason, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ApprovalsIsNil applies the IsNil predicate on the "approvals" field.
+func ApprovalsIsNil() predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldIsNull(FieldApprovals))
+}
+
+// ApprovalsNotNil applies the NotNil predicate on the "approvals" field.
+func ApprovalsNotNil() predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldNotNull(FieldApprovals))
+}
+
+// DecidedByEQ applies the EQ predicate on the "decidedBy" field.
+func DecidedByEQ(v string) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldEQ(FieldDecidedBy, v))
+}
+
+// DecidedByNEQ applies the NEQ predicate on the "decidedBy" field.
+func DecidedByNEQ(v string) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldNEQ(FieldDecidedBy, v))
+}
+
+// DecidedByIn applies the In predicate on the "decidedBy" field.
+func DecidedByIn(vs ...string) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldIn(FieldDecidedBy, vs...))
+}
+
+// DecidedByNotIn applies the NotIn predicate on the "decidedBy" field.
+func DecidedByNotIn(vs ...string) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldNotIn(FieldDecidedBy, vs...))
+}
+
+// DecidedByGT applies the GT predicate on the "decidedBy" field.
+func DecidedByGT(v string) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldGT(FieldDecidedBy, v))
+}
+
+// DecidedByGTE applies the GTE predicate on the "decidedBy" field.
+func DecidedByGTE(v string) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldGTE(FieldDecidedBy, v))
+}
+
+// DecidedByLT applies the LT predicate on the "decidedBy" field.
+func DecidedByLT(v string) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldLT(FieldDecidedBy, v))
+}
+
+// DecidedByLTE applies the LTE predicate on the "decidedBy" field.
+func DecidedByLTE(v string) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldLTE(FieldDecidedBy, v))
+}
+
+// DecidedByContains applies the Contains predicate on the "decidedBy" field.
+func DecidedByContains(v string) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldContains(FieldDecidedBy, v))
+}
+
+// DecidedByHasPrefix applies the HasPrefix predicate on the "decidedBy" field.
+func DecidedByHasPrefix(v string) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldHasPrefix(FieldDecidedBy, v))
+}
+
+// DecidedByHasSuffix applies the HasSuffix predicate on the "decidedBy" field.
+func DecidedByHasSuffix(v string) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldHasSuffix(FieldDecidedBy, v))
+}
+
+// DecidedByIsNil applies the IsNil predicate on the "decidedBy" field.
+func DecidedByIsNil() predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldIsNull(FieldDecidedBy))
+}
+
+// DecidedByNotNil applies the NotNil predicate on the "decidedBy" field.
+func DecidedByNotNil() predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldNotNull(FieldDecidedBy))
+}
+
+// DecidedByEqualFold applies the EqualFold predicate on the "decidedBy" field.
+func DecidedByEqualFold(v string) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldEqualFold(FieldDecidedBy, v))
+}
+
+// DecidedByContainsFold applies the ContainsFold predicate on the "decidedBy" field.
+func DecidedByContainsFold(v string) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldContainsFold(FieldDecidedBy, v))
+}
+
+// ReleasedAtEQ applies the EQ predicate on the "releasedAt" field.
+func ReleasedAtEQ(v time.Time) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldEQ(FieldReleasedAt, v))
+}
+
+// ReleasedAtNEQ applies the NEQ predicate on the "releasedAt" field.
+func ReleasedAtNEQ(v time.Time) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldNEQ(FieldReleasedAt, v))
+}
+
+// ReleasedAtIn applies the In predicate on the "releasedAt" field.
+func ReleasedAtIn(vs ...time.Time) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldIn(FieldReleasedAt, vs...))
+}
+
+// ReleasedAtNotIn applies the NotIn predicate on the "releasedAt" field.
+func ReleasedAtNotIn(vs ...time.Time) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldNotIn(FieldReleasedAt, vs...))
+}
+
+// ReleasedAtGT applies the GT predicate on the "releasedAt" field.
+func ReleasedAtGT(v time.Time) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldGT(FieldReleasedAt, v))
+}
+
+// ReleasedAtGTE applies the GTE predicate on the "releasedAt" field.
+func ReleasedAtGTE(v time.Time) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldGTE(FieldReleasedAt, v))
+}
+
+// ReleasedAtLT applies the LT predicate on the "releasedAt" field.
+func ReleasedAtLT(v time.Time) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldLT(FieldReleasedAt, v))
+}
+
+// ReleasedAtLTE applies the LTE predicate on the "releasedAt" field.
+func ReleasedAtLTE(v time.Time) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldLTE(FieldReleasedAt, v))
+}
+
+// ReleasedAtIsNil applies the IsNil predicate on the "releasedAt" field.
+func ReleasedAtIsNil() predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldIsNull(FieldReleasedAt))
+}
+
+// ReleasedAtNotNil applies the NotNil predicate on the "releasedAt" field.
+func ReleasedAtNotNil() predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.FieldNotNull(FieldReleasedAt))
+}
+
+// HasEscrow applies the HasEdge predicate on the "escrow" edge.
+func HasEscrow() predicate.KeyRecovery {
+	return predicate.KeyRecovery(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, EscrowTable, EscrowColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEscrowWith applies the HasEdge predicate on the "escrow" edge with a given conditions (other predicates).
+func HasEscrowWith(preds ...predicate.KeyEscrow) predicate.KeyRecovery {
+	return predicate.KeyRecovery(func(s *sql.Selector) {
+		step := newEscrowStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.KeyRecovery) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.KeyRecovery) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.KeyRecovery) predicate.KeyRecovery {
+	return predicate.KeyRecovery(sql.NotPredicates(p))
+}
@@ -0,0 +1,974 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hm-edu/pki-service/ent/keyescrow"
+	"github.com/hm-edu/pki-service/ent/keyrecovery"
+)
+
+// KeyRecoveryCreate is the builder for creating a KeyRecovery entity.
+type KeyRecoveryCreate struct {
+	config
+	mutation *KeyRecoveryMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *KeyRecoveryCreate) SetCreateTime(v time.Time) *KeyRecoveryCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *KeyRecoveryCreate) SetNillableCreateTime(v *time.Time) *KeyRecoveryCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *KeyRecoveryCreate) SetUpdateTime(v time.Time) *KeyRecoveryCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *KeyRecoveryCreate) SetNillableUpdateTime(v *time.Time) *KeyRecoveryCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetRequestedBy sets the "requestedBy" field.
+func (_c *KeyRecoveryCreate) SetRequestedBy(v string) *KeyRecoveryCreate {
+	_c.mutation.SetRequestedBy(v)
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *KeyRecoveryCreate) SetReason(v string) *KeyRecoveryCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_c *KeyRecoveryCreate) SetNillableReason(v *string) *KeyRecoveryCreate {
+	if v != nil {
+		_c.SetReason(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *KeyRecoveryCreate) SetStatus(v keyrecovery.Status) *KeyRecoveryCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *KeyRecoveryCreate) SetNillableStatus(v *keyrecovery.Status) *KeyRecoveryCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetApprovals sets the "approvals" field.
+func (_c *KeyRecoveryCreate) SetApprovals(v []string) *KeyRecoveryCreate {
+	_c.mutation.SetApprovals(v)
+	return _c
+}
+
+// SetDecidedBy sets the "decidedBy" field.
+func (_c *KeyRecoveryCreate) SetDecidedBy(v string) *KeyRecoveryCreate {
+	_c.mutation.SetDecidedBy(v)
+	return _c
+}
+
+// SetNillableDecidedBy sets the "decidedBy" field if the given value is not nil.
+func (_c *KeyRecoveryCreate) SetNillableDecidedBy(v *string) *KeyRecoveryCreate {
+	if v != nil {
+		_c.SetDecidedBy(*v)
+	}
+	return _c
+}
+
+// SetReleasedAt sets the "releasedAt" field.
+func (_c *KeyRecoveryCreate) SetReleasedAt(v time.Time) *KeyRecoveryCreate {
+	_c.mutation.SetReleasedAt(v)
+	return _c
+}
+
+// SetNillableReleasedAt sets the "releasedAt" field if the given value is not nil.
+func (_c *KeyRecoveryCreate) SetNillableReleasedAt(v *time.Time) *KeyRecoveryCreate {
+	if v != nil {
+		_c.SetReleasedAt(*v)
+	}
+	return _c
+}
+
+// SetEscrowID sets the "escrow" edge to the KeyEscrow entity by ID.
+func (_c *KeyRecoveryCreate) SetEscrowID(id int) *KeyRecoveryCreate {
+	_c.mutation.SetEscrowID(id)
+	return _c
+}
+
+// SetEscrow sets the "escrow" edge to the KeyEscrow entity.
+func (_c *KeyRecoveryCreate) SetEscrow(v *KeyEscrow) *KeyRecoveryCreate {
+	return _c.SetEscrowID(v.ID)
+}
+
+// Mutation returns the KeyRecoveryMutation object of the builder.
+func (_c *KeyRecoveryCreate) Mutation() *KeyRecoveryMutation {
+	return _c.mutation
+}
+
+// Save creates the KeyRecovery in the database.
+func (_c *KeyRecoveryCreate) Save(ctx context.Context) (*KeyRecovery, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *KeyRecoveryCreate) SaveX(ctx context.Context) *KeyRecovery {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *KeyRecoveryCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *KeyRecoveryCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *KeyRecoveryCreate) defaults() {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		v := keyrecovery.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		v := keyrecovery.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := keyrecovery.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *KeyRecoveryCreate) check() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "KeyRecovery.create_time"`)}
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "KeyRecovery.update_time"`)}
+	}
+	if _, ok := _c.mutation.RequestedBy(); !ok {
+		return &ValidationError{Name: "requestedBy", err: errors.New(`ent: missing required field "KeyRecovery.requestedBy"`)}
+	}
+	if v, ok := _c.mutation.RequestedBy(); ok {
+		if err := keyrecovery.RequestedByValidator(v); err != nil {
+			return &ValidationError{Name: "requestedBy", err: fmt.Errorf(`ent: validator failed for field "KeyRecovery.requestedBy": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "KeyRecovery.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := keyrecovery.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "KeyRecovery.status": %w`, err)}
+		}
+	}
+	if len(_c.mutation.EscrowIDs()) == 0 {
+		return &ValidationError{Name: "escrow", err: errors.New(`ent: missing required edge "KeyRecovery.escrow"`)}
+	}
+	return nil
+}
+
+func (_c *KeyRecoveryCreate) sqlSave(ctx context.Context) (*KeyRecovery, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *KeyRecoveryCreate) createSpec() (*KeyRecovery, *sqlgraph.CreateSpec) {
+	var (
+		_node = &KeyRecovery{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(keyrecovery.Table, sqlgraph.NewFieldSpec(keyrecovery.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(keyrecovery.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(keyrecovery.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.RequestedBy(); ok {
+		_spec.SetField(keyrecovery.FieldRequestedBy, field.TypeString, value)
+		_node.RequestedBy = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(keyrecovery.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(keyrecovery.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Approvals(); ok {
+		_spec.SetField(keyrecovery.FieldApprovals, field.TypeJSON, value)
+		_node.Approvals = value
+	}
+	if value, ok := _c.mutation.DecidedBy(); ok {
+		_spec.SetField(keyrecovery.FieldDecidedBy, field.TypeString, value)
+		_node.DecidedBy = &value
+	}
+	if value, ok := _c.mutation.ReleasedAt(); ok {
+		_spec.SetField(keyrecovery.FieldReleasedAt, field.TypeTime, value)
+		_node.ReleasedAt = &value
+	}
+	if nodes := _c.mutation.EscrowIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   keyrecovery.EscrowTable,
+			Columns: []string{keyrecovery.EscrowColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(keyescrow.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.key_escrow_recoveries = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.KeyRecovery.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.KeyRecoveryUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *KeyRecoveryCreate) OnConflict(opts ...sql.ConflictOption) *KeyRecoveryUpsertOne {
+	_c.conflict = opts
+	return &KeyRecoveryUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.KeyRecovery.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *KeyRecoveryCreate) OnConflictColumns(columns ...string) *KeyRecoveryUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &KeyRecoveryUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// KeyRecoveryUpsertOne is the builder for "upsert"-ing
+	//  one KeyRecovery node.
+	KeyRecoveryUpsertOne struct {
+		create *KeyRecoveryCreate
+	}
+
+	// KeyRecoveryUpsert is the "OnConflict" setter.
+	KeyRecoveryUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *KeyRecoveryUpsert) SetUpdateTime(v time.Time) *KeyRecoveryUpsert {
+	u.Set(keyrecovery.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *KeyRecoveryUpsert) UpdateUpdateTime() *KeyRecoveryUpsert {
+	u.SetExcluded(keyrecovery.FieldUpdateTime)
+	return u
+}
+
+// SetRequestedBy sets the "requestedBy" field.
+func (u *KeyRecoveryUpsert) SetRequestedBy(v string) *KeyRecoveryUpsert {
+	u.Set(keyrecovery.FieldRequestedBy, v)
+	return u
+}
+
+// UpdateRequestedBy sets the "requestedBy" field to the value that was provided on create.
+func (u *KeyRecoveryUpsert) UpdateRequestedBy() *KeyRecoveryUpsert {
+	u.SetExcluded(keyrecovery.FieldRequestedBy)
+	return u
+}
+
+// SetReason sets the "reason" field.
+func (u *KeyRecoveryUpsert) SetReason(v string) *KeyRecoveryUpsert {
+	u.Set(keyrecovery.FieldReason, v)
+	return u
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *KeyRecoveryUpsert) UpdateReason() *KeyRecoveryUpsert {
+	u.SetExcluded(keyrecovery.FieldReason)
+	return u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *KeyRecoveryUpsert) ClearReason() *KeyRecoveryUpsert {
+	u.SetNull(keyrecovery.FieldReason)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *KeyRecoveryUpsert) SetStatus(v keyrecovery.Status) *KeyRecoveryUpsert {
+	u.Set(keyrecovery.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *KeyRecoveryUpsert) UpdateStatus() *KeyRecoveryUpsert {
+	u.SetExcluded(keyrecovery.FieldStatus)
+	return u
+}
+
+// SetApprovals sets the "approvals" field.
+func (u *KeyRecoveryUpsert) SetApprovals(v []string) *KeyRecoveryUpsert {
+	u.Set(keyrecovery.FieldApprovals, v)
+	return u
+}
+
+// UpdateApprovals sets the "approvals" field to the value that was provided on create.
+func (u *KeyRecoveryUpsert) UpdateApprovals() *KeyRecoveryUpsert {
+	u.SetExcluded(keyrecovery.FieldApprovals)
+	return u
+}
+
+// ClearApprovals clears the value of the "approvals" field.
+func (u *KeyRecoveryUpsert) ClearApprovals() *KeyRecoveryUpsert {
+	u.SetNull(keyrecovery.FieldApprovals)
+	return u
+}
+
+// SetDecidedBy sets the "decidedBy" field.
+func (u *KeyRecoveryUpsert) SetDecidedBy(v string) *KeyRecoveryUpsert {
+	u.Set(keyrecovery.FieldDecidedBy, v)
+	return u
+}
+
+// UpdateDecidedBy sets the "decidedBy" field to the value that was provided on create.
+func (u *KeyRecoveryUpsert) UpdateDecidedBy() *KeyRecoveryUpsert {
+	u.SetExcluded(keyrecovery.FieldDecidedBy)
+	return u
+}
+
+// ClearDecidedBy clears the value of the "decidedBy" field.
+func (u *KeyRecoveryUpsert) ClearDecidedBy() *KeyRecoveryUpsert {
+	u.SetNull(keyrecovery.FieldDecidedBy)
+	return u
+}
+
+// SetReleasedAt sets the "releasedAt" field.
+func (u *KeyRecoveryUpsert) SetReleasedAt(v time.Time) *KeyRecoveryUpsert {
+	u.Set(keyrecovery.FieldReleasedAt, v)
+	return u
+}
+
+// UpdateReleasedAt sets the "releasedAt" field to the value that was provided on create.
+func (u *KeyRecoveryUpsert) UpdateReleasedAt() *KeyRecoveryUpsert {
+	u.SetExcluded(keyrecovery.FieldReleasedAt)
+	return u
+}
+
+// ClearReleasedAt clears the value of the "releasedAt" field.
+func (u *KeyRecoveryUpsert) ClearReleasedAt() *KeyRecoveryUpsert {
+	u.SetNull(keyrecovery.FieldReleasedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.KeyRecovery.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *KeyRecoveryUpsertOne) UpdateNewValues() *KeyRecoveryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(keyrecovery.FieldCreateTime)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.KeyRecovery.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *KeyRecoveryUpsertOne) Ignore() *KeyRecoveryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *KeyRecoveryUpsertOne) DoNothing() *KeyRecoveryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the KeyRecoveryCreate.OnConflict
+// documentation for more info.
+func (u *KeyRecoveryUpsertOne) Update(set func(*KeyRecoveryUpsert)) *KeyRecoveryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&KeyRecoveryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *KeyRecoveryUpsertOne) SetUpdateTime(v time.Time) *KeyRecoveryUpsertOne {
+	return u.Update(func(s *KeyRecoveryUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *KeyRecoveryUpsertOne) UpdateUpdateTime() *KeyRecoveryUpsertOne {
+	return u.Update(func(s *KeyRecoveryUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetRequestedBy sets the "requestedBy" field.
+func (u *KeyRecoveryUpsertOne) SetRequestedBy(v string) *KeyRecoveryUpsertOne {
+	return u.Update(func(s *KeyRecoveryUpsert) {
+		s.SetRequestedBy(v)
+	})
+}
+
+// UpdateRequestedBy sets the "requestedBy" field to the value that was provided on create.
+func (u *KeyRecoveryUpsertOne) UpdateRequestedBy() *KeyRecoveryUpsertOne {
+	return u.Update(func(s *KeyRecoveryUpsert) {
+		s.UpdateRequestedBy()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *KeyRecoveryUpsertOne) SetReason(v string) *KeyRecoveryUpsertOne {
+	return u.Update(func(s *KeyRecoveryUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *KeyRecoveryUpsertOne) UpdateReason() *KeyRecoveryUpsertOne {
+	return u.Update(func(s *KeyRecoveryUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *KeyRecoveryUpsertOne) ClearReason() *KeyRecoveryUpsertOne {
+	return u.Update(func(s *KeyRecoveryUpsert) {
+		s.ClearReason()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *KeyRecoveryUpsertOne) SetStatus(v keyrecovery.Status) *KeyRecoveryUpsertOne {
+	return u.Update(func(s *KeyRecoveryUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *KeyRecoveryUpsertOne) UpdateStatus() *KeyRecoveryUpsertOne {
+	return u.Update(func(s *KeyRecoveryUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetApprovals sets the "approvals" field.
+func (u *KeyRecoveryUpsertOne) SetApprovals(v []string) *KeyRecoveryUpsertOne {
+	return u.Update(func(s *KeyRecoveryUpsert) {
+		s.SetApprovals(v)
+	})
+}
+
+// UpdateApprovals sets the "approvals" field to the value that was provided on create.
+func (u *KeyRecoveryUpsertOne) UpdateApprovals() *KeyRecoveryUpsertOne {
+	return u.Update(func(s *KeyRecoveryUpsert) {
+		s.UpdateApprovals()
+	})
+}
+
+// ClearApprovals clears the value of the "approvals" field.
+func (u *KeyRecoveryUpsertOne) ClearApprovals() *KeyRecoveryUpsertOne {
+	return u.Update(func(s *KeyRecoveryUpsert) {
+		s.ClearApprovals()
+	})
+}
+
+// SetDecidedBy sets the "decidedBy" field.
+func (u *KeyRecoveryUpsertOne) SetDecidedBy(v string) *KeyRecoveryUpsertOne {
+	return u.Update(func(s *KeyRecoveryUpsert) {
+		s.SetDecidedBy(v)
+	})
+}
+
+// UpdateDecidedBy sets the "decidedBy" field to the value that was provided on create.
+func (u *KeyRecoveryUpsertOne) UpdateDecidedBy() *KeyRecoveryUpsertOne {
+	return u.Update(func(s *KeyRecoveryUpsert) {
+		s.UpdateDecidedBy()
+	})
+}
+
+// ClearDecidedBy clears the value of the "decidedBy" field.
+func (u *KeyRecoveryUpsertOne) ClearDecidedBy() *KeyRecoveryUpsertOne {
+	return u.Update(func(s *KeyRecoveryUpsert) {
+		s.ClearDecidedBy()
+	})
+}
+
+// SetReleasedAt sets the "releasedAt" field.
+func (u *KeyRecoveryUpsertOne) SetReleasedAt(v time.Time) *KeyRecoveryUpsertOne {
+	return u.Update(func(s *KeyRecoveryUpsert) {
+		s.SetReleasedAt(v)
+	})
+}
+
+// UpdateReleasedAt sets the "releasedAt" field to the value that was provided on create.
+func (u *KeyRecoveryUpsertOne) UpdateReleasedAt() *KeyRecoveryUpsertOne {
+	return u.Update(func(s *KeyRecoveryUpsert) {
+		s.UpdateReleasedAt()
+	})
+}
+
+// ClearReleasedAt clears the value of the "releasedAt" field.
+func (u *KeyRecoveryUpsertOne) ClearReleasedAt() *KeyRecoveryUpsertOne {
+	return u.Update(func(s *KeyRecoveryUpsert) {
+		s.ClearReleasedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *KeyRecoveryUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for KeyRecoveryCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *KeyRecoveryUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *KeyRecoveryUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *KeyRecoveryUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// KeyRecoveryCreateBulk is the builder for creating many KeyRecovery entities in bulk.
+type KeyRecoveryCreateBulk struct {
+	config
+	err      error
+	builders []*KeyRecoveryCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the KeyRecovery entities in the database.
+func (_c *KeyRecoveryCreateBulk) Save(ctx context.Context) ([]*KeyRecovery, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*KeyRecovery, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*KeyRecoveryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *KeyRecoveryCreateBulk) SaveX(ctx context.Context) []*KeyRecovery {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *KeyRecoveryCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *KeyRecoveryCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.KeyRecovery.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.KeyRecoveryUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *KeyRecoveryCreateBulk) OnConflict(opts ...sql.ConflictOption) *KeyRecoveryUpsertBulk {
+	_c.conflict = opts
+	return &KeyRecoveryUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.KeyRecovery.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *KeyRecoveryCreateBulk) OnConflictColumns(columns ...string) *KeyRecoveryUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &KeyRecoveryUpsertBulk{
+		create: _c,
+	}
+}
+
+// KeyRecoveryUpsertBulk is the builder for "upsert"-ing
+// a bulk of KeyRecovery nodes.
+type KeyRecoveryUpsertBulk struct {
+	create *KeyRecoveryCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.KeyRecovery.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *KeyRecoveryUpsertBulk) UpdateNewValues() *KeyRecoveryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(keyrecovery.FieldCreateTime)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.KeyRecovery.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *KeyRecoveryUpsertBulk) Ignore() *KeyRecoveryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *KeyRecoveryUpsertBulk) DoNothing() *KeyRecoveryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the KeyRecoveryCreateBulk.OnConflict
+// documentation for more info.
+func (u *KeyRecoveryUpsertBulk) Update(set func(*KeyRecoveryUpsert)) *KeyRecoveryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&KeyRecoveryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *KeyRecoveryUpsertBulk) SetUpdateTime(v time.Time) *KeyRecoveryUpsertBulk {
+	return u.Update(func(s *KeyRecoveryUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *KeyRecoveryUpsertBulk) UpdateUpdateTime() *KeyRecoveryUpsertBulk {
+	return u.Update(func(s *KeyRecoveryUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetRequestedBy sets the "requestedBy" field.
+func (u *KeyRecoveryUpsertBulk) SetRequestedBy(v string) *KeyRecoveryUpsertBulk {
+	return u.Update(func(s *KeyRecoveryUpsert) {
+		s.SetRequestedBy(v)
+	})
+}
+
+// UpdateRequestedBy sets the "requestedBy" field to the value that was provided on create.
+func (u *KeyRecoveryUpsertBulk) UpdateRequestedBy() *KeyRecoveryUpsertBulk {
+	return u.Update(func(s *KeyRecoveryUpsert) {
+		s.UpdateRequestedBy()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *KeyRecoveryUpsertBulk) SetReason(v string) *KeyRecoveryUpsertBulk {
+	return u.Update(func(s *KeyRecoveryUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *KeyRecoveryUpsertBulk) UpdateReason() *KeyRecoveryUpsertBulk {
+	return u.Update(func(s *KeyRecoveryUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *KeyRecoveryUpsertBulk) ClearReason() *KeyRecoveryUpsertBulk {
+	return u.Update(func(s *KeyRecoveryUpsert) {
+		s.ClearReason()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *KeyRecoveryUpsertBulk) SetStatus(v keyrecovery.Status) *KeyRecoveryUpsertBulk {
+	return u.Update(func(s *KeyRecoveryUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *KeyRecoveryUpsertBulk) UpdateStatus() *KeyRecoveryUpsertBulk {
+	return u.Update(func(s *KeyRecoveryUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetApprovals sets the "approvals" field.
+func (u *KeyRecoveryUpsertBulk) SetApprovals(v []string) *KeyRecoveryUpsertBulk {
+	return u.Update(func(s *KeyRecoveryUpsert) {
+		s.SetApprovals(v)
+	})
+}
+
+// UpdateApprovals sets the "approvals" field to the value that was provided on create.
+func (u *KeyRecoveryUpsertBulk) UpdateApprovals() *KeyRecoveryUpsertBulk {
+	return u.Update(func(s *KeyRecoveryUpsert) {
+		s.UpdateApprovals()
+	})
+}
+
+// ClearApprovals clears the value of the "approvals" field.
+func (u *KeyRecoveryUpsertBulk) ClearApprovals() *KeyRecoveryUpsertBulk {
+	return u.Update(func(s *KeyRecoveryUpsert) {
+		s.ClearApprovals()
+	})
+}
+
+// SetDecidedBy sets the "decidedBy" field.
+func (u *KeyRecoveryUpsertBulk) SetDecidedBy(v string) *KeyRecoveryUpsertBulk {
+	return u.Update(func(s *KeyRecoveryUpsert) {
+		s.SetDecidedBy(v)
+	})
+}
+
+// UpdateDecidedBy sets the "decidedBy" field to the value that was provided on create.
+func (u *KeyRecoveryUpsertBulk) UpdateDecidedBy() *KeyRecoveryUpsertBulk {
+	return u.Update(func(s *KeyRecoveryUpsert) {
+		s.UpdateDecidedBy()
+	})
+}
+
+// ClearDecidedBy clears the value of the "decidedBy" field.
+func (u *KeyRecoveryUpsertBulk) ClearDecidedBy() *KeyRecoveryUpsertBulk {
+	return u.Update(func(s *KeyRecoveryUpsert) {
+		s.ClearDecidedBy()
+	})
+}
+
+// SetReleasedAt sets the "releasedAt" field.
+func (u *KeyRecoveryUpsertBulk) SetReleasedAt(v time.Time) *KeyRecoveryUpsertBulk {
+	return u.Update(func(s *KeyRecoveryUpsert) {
+		s.SetReleasedAt(v)
+	})
+}
+
+// UpdateReleasedAt sets the "releasedAt" field to the value that was provided on create.
+func (u *KeyRecoveryUpsertBulk) UpdateReleasedAt() *KeyRecoveryUpsertBulk {
+	return u.Update(func(s *KeyRecoveryUpsert) {
+		s.UpdateReleasedAt()
+	})
+}
+
+// ClearReleasedAt clears the value of the "releasedAt" field.
+func (u *KeyRecoveryUpsertBulk) ClearReleasedAt() *KeyRecoveryUpsertBulk {
+	return u.Update(func(s *KeyRecoveryUpsert) {
+		s.ClearReleasedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *KeyRecoveryUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the KeyRecoveryCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for KeyRecoveryCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *KeyRecoveryUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,630 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/hm-edu/pki-service/ent/keyescrow"
+	"github.com/hm-edu/pki-service/ent/keyrecovery"
+	"github.com/hm-edu/pki-service/ent/predicate"
+)
+
+// KeyRecoveryUpdate is the builder for updating KeyRecovery entities.
+type KeyRecoveryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *KeyRecoveryMutation
+}
+
+// Where appends a list predicates to the KeyRecoveryUpdate builder.
+func (_u *KeyRecoveryUpdate) Where(ps ...predicate.KeyRecovery) *KeyRecoveryUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *KeyRecoveryUpdate) SetUpdateTime(v time.Time) *KeyRecoveryUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetRequestedBy sets the "requestedBy" field.
+func (_u *KeyRecoveryUpdate) SetRequestedBy(v string) *KeyRecoveryUpdate {
+	_u.mutation.SetRequestedBy(v)
+	return _u
+}
+
+// SetNillableRequestedBy sets the "requestedBy" field if the given value is not nil.
+func (_u *KeyRecoveryUpdate) SetNillableRequestedBy(v *string) *KeyRecoveryUpdate {
+	if v != nil {
+		_u.SetRequestedBy(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *KeyRecoveryUpdate) SetReason(v string) *KeyRecoveryUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *KeyRecoveryUpdate) SetNillableReason(v *string) *KeyRecoveryUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *KeyRecoveryUpdate) ClearReason() *KeyRecoveryUpdate {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *KeyRecoveryUpdate) SetStatus(v keyrecovery.Status) *KeyRecoveryUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *KeyRecoveryUpdate) SetNillableStatus(v *keyrecovery.Status) *KeyRecoveryUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetApprovals sets the "approvals" field.
+func (_u *KeyRecoveryUpdate) SetApprovals(v []string) *KeyRecoveryUpdate {
+	_u.mutation.SetApprovals(v)
+	return _u
+}
+
+// AppendApprovals appends value to the "approvals" field.
+func (_u *KeyRecoveryUpdate) AppendApprovals(v []string) *KeyRecoveryUpdate {
+	_u.mutation.AppendApprovals(v)
+	return _u
+}
+
+// ClearApprovals clears the value of the "approvals" field.
+func (_u *KeyRecoveryUpdate) ClearApprovals() *KeyRecoveryUpdate {
+	_u.mutation.ClearApprovals()
+	return _u
+}
+
+// SetDecidedBy sets the "decidedBy" field.
+func (_u *KeyRecoveryUpdate) SetDecidedBy(v string) *KeyRecoveryUpdate {
+	_u.mutation.SetDecidedBy(v)
+	return _u
+}
+
+// SetNillableDecidedBy sets the "decidedBy" field if the given value is not nil.
+func (_u *KeyRecoveryUpdate) SetNillableDecidedBy(v *string) *KeyRecoveryUpdate {
+	if v != nil {
+		_u.SetDecidedBy(*v)
+	}
+	return _u
+}
+
+// ClearDecidedBy clears the value of the "decidedBy" field.
+func (_u *KeyRecoveryUpdate) ClearDecidedBy() *KeyRecoveryUpdate {
+	_u.mutation.ClearDecidedBy()
+	return _u
+}
+
+// SetReleasedAt sets the "releasedAt" field.
+func (_u *KeyRecoveryUpdate) SetReleasedAt(v time.Time) *KeyRecoveryUpdate {
+	_u.mutation.SetReleasedAt(v)
+	return _u
+}
+
+// SetNillableReleasedAt sets the "releasedAt" field if the given value is not nil.
+func (_u *KeyRecoveryUpdate) SetNillableReleasedAt(v *time.Time) *KeyRecoveryUpdate {
+	if v != nil {
+		_u.SetReleasedAt(*v)
+	}
+	return _u
+}
+
+// ClearReleasedAt clears the value of the "releasedAt" field.
+func (_u *KeyRecoveryUpdate) ClearReleasedAt() *KeyRecoveryUpdate {
+	_u.mutation.ClearReleasedAt()
+	return _u
+}
+
+// SetEscrowID sets the "escrow" edge to the KeyEscrow entity by ID.
+func (_u *KeyRecoveryUpdate) SetEscrowID(id int) *KeyRecoveryUpdate {
+	_u.mutation.SetEscrowID(id)
+	return _u
+}
+
+// SetEscrow sets the "escrow" edge to the KeyEscrow entity.
+func (_u *KeyRecoveryUpdate) SetEscrow(v *KeyEscrow) *KeyRecoveryUpdate {
+	return _u.SetEscrowID(v.ID)
+}
+
+// Mutation returns the KeyRecoveryMutation object of the builder.
+func (_u *KeyRecoveryUpdate) Mutation() *KeyRecoveryMutation {
+	return _u.mutation
+}
+
+// ClearEscrow clears the "escrow" edge to the KeyEscrow entity.
+func (_u *KeyRecoveryUpdate) ClearEscrow() *KeyRecoveryUpdate {
+	_u.mutation.ClearEscrow()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *KeyRecoveryUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *KeyRecoveryUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *KeyRecoveryUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *KeyRecoveryUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *KeyRecoveryUpdate) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := keyrecovery.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *KeyRecoveryUpdate) check() error {
+	if v, ok := _u.mutation.RequestedBy(); ok {
+		if err := keyrecovery.RequestedByValidator(v); err != nil {
+			return &ValidationError{Name: "requestedBy", err: fmt.Errorf(`ent: validator failed for field "KeyRecovery.requestedBy": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := keyrecovery.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "KeyRecovery.status": %w`, err)}
+		}
+	}
+	if _u.mutation.EscrowCleared() && len(_u.mutation.EscrowIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "KeyRecovery.escrow"`)
+	}
+	return nil
+}
+
+func (_u *KeyRecoveryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(keyrecovery.Table, keyrecovery.Columns, sqlgraph.NewFieldSpec(keyrecovery.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(keyrecovery.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.RequestedBy(); ok {
+		_spec.SetField(keyrecovery.FieldRequestedBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(keyrecovery.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(keyrecovery.FieldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(keyrecovery.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Approvals(); ok {
+		_spec.SetField(keyrecovery.FieldApprovals, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedApprovals(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, keyrecovery.FieldApprovals, value)
+		})
+	}
+	if _u.mutation.ApprovalsCleared() {
+		_spec.ClearField(keyrecovery.FieldApprovals, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.DecidedBy(); ok {
+		_spec.SetField(keyrecovery.FieldDecidedBy, field.TypeString, value)
+	}
+	if _u.mutation.DecidedByCleared() {
+		_spec.ClearField(keyrecovery.FieldDecidedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReleasedAt(); ok {
+		_spec.SetField(keyrecovery.FieldReleasedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReleasedAtCleared() {
+		_spec.ClearField(keyrecovery.FieldReleasedAt, field.TypeTime)
+	}
+	if _u.mutation.EscrowCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   keyrecovery.EscrowTable,
+			Columns: []string{keyrecovery.EscrowColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(keyescrow.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EscrowIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   keyrecovery.EscrowTable,
+			Columns: []string{keyrecovery.EscrowColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(keyescrow.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{keyrecovery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// KeyRecoveryUpdateOne is the builder for updating a single KeyRecovery entity.
+type KeyRecoveryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *KeyRecoveryMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *KeyRecoveryUpdateOne) SetUpdateTime(v time.Time) *KeyRecoveryUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetRequestedBy sets the "requestedBy" field.
+func (_u *KeyRecoveryUpdateOne) SetRequestedBy(v string) *KeyRecoveryUpdateOne {
+	_u.mutation.SetRequestedBy(v)
+	return _u
+}
+
+// SetNillableRequestedBy sets the "requestedBy" field if the given value is not nil.
+func (_u *KeyRecoveryUpdateOne) SetNillableRequestedBy(v *string) *KeyRecoveryUpdateOne {
+	if v != nil {
+		_u.SetRequestedBy(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *KeyRecoveryUpdateOne) SetReason(v string) *KeyRecoveryUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *KeyRecoveryUpdateOne) SetNillableReason(v *string) *KeyRecoveryUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *KeyRecoveryUpdateOne) ClearReason() *KeyRecoveryUpdateOne {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *KeyRecoveryUpdateOne) SetStatus(v keyrecovery.Status) *KeyRecoveryUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *KeyRecoveryUpdateOne) SetNillableStatus(v *keyrecovery.Status) *KeyRecoveryUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetApprovals sets the "approvals" field.
+func (_u *KeyRecoveryUpdateOne) SetApprovals(v []string) *KeyRecoveryUpdateOne {
+	_u.mutation.SetApprovals(v)
+	return _u
+}
+
+// AppendApprovals appends value to the "approvals" field.
+func (_u *KeyRecoveryUpdateOne) AppendApprovals(v []string) *KeyRecoveryUpdateOne {
+	_u.mutation.AppendApprovals(v)
+	return _u
+}
+
+// ClearApprovals clears the value of the "approvals" field.
+func (_u *KeyRecoveryUpdateOne) ClearApprovals() *KeyRecoveryUpdateOne {
+	_u.mutation.ClearApprovals()
+	return _u
+}
+
+// SetDecidedBy sets the "decidedBy" field.
+func (_u *KeyRecoveryUpdateOne) SetDecidedBy(v string) *KeyRecoveryUpdateOne {
+	_u.mutation.SetDecidedBy(v)
+	return _u
+}
+
+// SetNillableDecidedBy sets the "decidedBy" field if the given value is not nil.
+func (_u *KeyRecoveryUpdateOne) SetNillableDecidedBy(v *string) *KeyRecoveryUpdateOne {
+	if v != nil {
+		_u.SetDecidedBy(*v)
+	}
+	return _u
+}
+
+// ClearDecidedBy clears the value of the "decidedBy" field.
+func (_u *KeyRecoveryUpdateOne) ClearDecidedBy() *KeyRecoveryUpdateOne {
+	_u.mutation.ClearDecidedBy()
+	return _u
+}
+
+// SetReleasedAt sets the "releasedAt" field.
+func (_u *KeyRecoveryUpdateOne) SetReleasedAt(v time.Time) *KeyRecoveryUpdateOne {
+	_u.mutation.SetReleasedAt(v)
+	return _u
+}
+
+// SetNillableReleasedAt sets the "releasedAt" field if the given value is not nil.
+func (_u *KeyRecoveryUpdateOne) SetNillableReleasedAt(v *time.Time) *KeyRecoveryUpdateOne {
+	if v != nil {
+		_u.SetReleasedAt(*v)
+	}
+	return _u
+}
+
+// ClearReleasedAt clears the value of the "releasedAt" field.
+func (_u *KeyRecoveryUpdateOne) ClearReleasedAt() *KeyRecoveryUpdateOne {
+	_u.mutation.ClearReleasedAt()
+	return _u
+}
+
+// SetEscrowID sets the "escrow" edge to the KeyEscrow entity by ID.
+func (_u *KeyRecoveryUpdateOne) SetEscrowID(id int) *KeyRecoveryUpdateOne {
+	_u.mutation.SetEscrowID(id)
+	return _u
+}
+
+// SetEscrow sets the "escrow" edge to the KeyEscrow entity.
+func (_u *KeyRecoveryUpdateOne) SetEscrow(v *KeyEscrow) *KeyRecoveryUpdateOne {
+	return _u.SetEscrowID(v.ID)
+}
+
+// Mutation returns the KeyRecoveryMutation object of the builder.
+func (_u *KeyRecoveryUpdateOne) Mutation() *KeyRecoveryMutation {
+	return _u.mutation
+}
+
+// ClearEscrow clears the "escrow" edge to the KeyEscrow entity.
+func (_u *KeyRecoveryUpdateOne) ClearEscrow() *KeyRecoveryUpdateOne {
+	_u.mutation.ClearEscrow()
+	return _u
+}
+
+// Where appends a list predicates to the KeyRecoveryUpdate builder.
+func (_u *KeyRecoveryUpdateOne) Where(ps ...predicate.KeyRecovery) *KeyRecoveryUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *KeyRecoveryUpdateOne) Select(field string, fields ...string) *KeyRecoveryUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated KeyRecovery entity.
+func (_u *KeyRecoveryUpdateOne) Save(ctx context.Context) (*KeyRecovery, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *KeyRecoveryUpdateOne) SaveX(ctx context.Context) *KeyRecovery {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *KeyRecoveryUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *KeyRecoveryUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *KeyRecoveryUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := keyrecovery.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *KeyRecoveryUpdateOne) check() error {
+	if v, ok := _u.mutation.RequestedBy(); ok {
+		if err := keyrecovery.RequestedByValidator(v); err != nil {
+			return &ValidationError{Name: "requestedBy", err: fmt.Errorf(`ent: validator failed for field "KeyRecovery.requestedBy": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := keyrecovery.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "KeyRecovery.status": %w`, err)}
+		}
+	}
+	if _u.mutation.EscrowCleared() && len(_u.mutation.EscrowIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "KeyRecovery.escrow"`)
+	}
+	return nil
+}
+
+func (_u *KeyRecoveryUpdateOne) sqlSave(ctx context.Context) (_node *KeyRecovery, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(keyrecovery.Table, keyrecovery.Columns, sqlgraph.NewFieldSpec(keyrecovery.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "KeyRecovery.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, keyrecovery.FieldID)
+		for _, f := range fields {
+			if !keyrecovery.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != keyrecovery.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(keyrecovery.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.RequestedBy(); ok {
+		_spec.SetField(keyrecovery.FieldRequestedBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(keyrecovery.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(keyrecovery.FieldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(keyrecovery.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Approvals(); ok {
+		_spec.SetField(keyrecovery.FieldApprovals, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedApprovals(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, keyrecovery.FieldApprovals, value)
+		})
+	}
+	if _u.mutation.ApprovalsCleared() {
+		_spec.ClearField(keyrecovery.FieldApprovals, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.DecidedBy(); ok {
+		_spec.SetField(keyrecovery.FieldDecidedBy, field.TypeString, value)
+	}
+	if _u.mutation.DecidedByCleared() {
+		_spec.ClearField(keyrecovery.FieldDecidedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReleasedAt(); ok {
+		_spec.SetField(keyrecovery.FieldReleasedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReleasedAtCleared() {
+		_spec.ClearField(keyrecovery.FieldReleasedAt, field.TypeTime)
+	}
+	if _u.mutation.EscrowCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   keyrecovery.EscrowTable,
+			Columns: []string{keyrecovery.EscrowColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(keyescrow.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EscrowIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   keyrecovery.EscrowTable,
+			Columns: []string{keyrecovery.EscrowColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(keyescrow.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &KeyRecovery{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{keyrecovery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -8,6 +8,39 @@ import (
 )
 
 var (
+	// AcmeOrdersColumns holds the columns for the "acme_orders" table.
+	AcmeOrdersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "identifiers", Type: field.TypeString, Size: 2147483647},
+		{Name: "registered_domains", Type: field.TypeJSON, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"Pending", "Valid", "Invalid", "RateLimited"}, Default: "Pending"},
+		{Name: "retry_after", Type: field.TypeTime, Nullable: true},
+		{Name: "error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "certificate_acme_orders", Type: field.TypeInt, Nullable: true},
+	}
+	// AcmeOrdersTable holds the schema information for the "acme_orders" table.
+	AcmeOrdersTable = &schema.Table{
+		Name:       "acme_orders",
+		Columns:    AcmeOrdersColumns,
+		PrimaryKey: []*schema.Column{AcmeOrdersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "acme_orders_certificates_acmeOrders",
+				Columns:    []*schema.Column{AcmeOrdersColumns[8]},
+				RefColumns: []*schema.Column{CertificatesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "acmeorder_status_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{AcmeOrdersColumns[5], AcmeOrdersColumns[1]},
+			},
+		},
+	}
 	// CertificatesColumns holds the columns for the "certificates" table.
 	CertificatesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -25,6 +58,10 @@ var (
 		{Name: "status", Type: field.TypeEnum, Enums: []string{"Invalid", "Requested", "Approved", "Declined", "Applied", "Issued", "Revoked", "Expired", "Replaced", "Rejected", "Unmanaged", "SAApproved", "Init"}, Default: "Invalid"},
 		{Name: "ca", Type: field.TypeString, Nullable: true},
 		{Name: "certificate", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "ca_reason", Type: field.TypeString, Nullable: true},
+		{Name: "csr", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "organization", Type: field.TypeString, Nullable: true},
+		{Name: "cert_type", Type: field.TypeString, Nullable: true},
 	}
 	// CertificatesTable holds the schema information for the "certificates" table.
 	CertificatesTable = &schema.Table{
@@ -43,6 +80,148 @@ var (
 		Columns:    DomainsColumns,
 		PrimaryKey: []*schema.Column{DomainsColumns[0]},
 	}
+	// EmailVerificationsColumns holds the columns for the "email_verifications" table.
+	EmailVerificationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "user", Type: field.TypeString},
+		{Name: "email", Type: field.TypeString},
+		{Name: "code_hash", Type: field.TypeString, Nullable: true},
+		{Name: "code_expires", Type: field.TypeTime, Nullable: true},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "verified_at", Type: field.TypeTime, Nullable: true},
+		{Name: "valid_until", Type: field.TypeTime, Nullable: true},
+	}
+	// EmailVerificationsTable holds the schema information for the "email_verifications" table.
+	EmailVerificationsTable = &schema.Table{
+		Name:       "email_verifications",
+		Columns:    EmailVerificationsColumns,
+		PrimaryKey: []*schema.Column{EmailVerificationsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "emailverification_user_email",
+				Unique:  true,
+				Columns: []*schema.Column{EmailVerificationsColumns[3], EmailVerificationsColumns[4]},
+			},
+		},
+	}
+	// EscrowAuditsColumns holds the columns for the "escrow_audits" table.
+	EscrowAuditsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "action", Type: field.TypeString},
+		{Name: "actor", Type: field.TypeString},
+		{Name: "serial", Type: field.TypeString, Nullable: true},
+		{Name: "recovery_id", Type: field.TypeInt, Nullable: true},
+		{Name: "details", Type: field.TypeString, Nullable: true, Size: 2147483647},
+	}
+	// EscrowAuditsTable holds the schema information for the "escrow_audits" table.
+	EscrowAuditsTable = &schema.Table{
+		Name:       "escrow_audits",
+		Columns:    EscrowAuditsColumns,
+		PrimaryKey: []*schema.Column{EscrowAuditsColumns[0]},
+	}
+	// KeyEscrowsColumns holds the columns for the "key_escrows" table.
+	KeyEscrowsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "mode", Type: field.TypeEnum, Enums: []string{"wrap", "split"}},
+		{Name: "ciphertext", Type: field.TypeBytes},
+		{Name: "wrapped_key", Type: field.TypeBytes, Nullable: true},
+		{Name: "shares", Type: field.TypeJSON, Nullable: true},
+		{Name: "smime_certificate_key_escrow", Type: field.TypeInt, Unique: true},
+	}
+	// KeyEscrowsTable holds the schema information for the "key_escrows" table.
+	KeyEscrowsTable = &schema.Table{
+		Name:       "key_escrows",
+		Columns:    KeyEscrowsColumns,
+		PrimaryKey: []*schema.Column{KeyEscrowsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "key_escrows_smime_certificates_keyEscrow",
+				Columns:    []*schema.Column{KeyEscrowsColumns[7]},
+				RefColumns: []*schema.Column{SmimeCertificatesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// KeyRecoveriesColumns holds the columns for the "key_recoveries" table.
+	KeyRecoveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "requested_by", Type: field.TypeString},
+		{Name: "reason", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"Pending", "Approved", "Released", "Rejected"}, Default: "Pending"},
+		{Name: "approvals", Type: field.TypeJSON, Nullable: true},
+		{Name: "decided_by", Type: field.TypeString, Nullable: true},
+		{Name: "released_at", Type: field.TypeTime, Nullable: true},
+		{Name: "key_escrow_recoveries", Type: field.TypeInt},
+	}
+	// KeyRecoveriesTable holds the schema information for the "key_recoveries" table.
+	KeyRecoveriesTable = &schema.Table{
+		Name:       "key_recoveries",
+		Columns:    KeyRecoveriesColumns,
+		PrimaryKey: []*schema.Column{KeyRecoveriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "key_recoveries_key_escrows_recoveries",
+				Columns:    []*schema.Column{KeyRecoveriesColumns[9]},
+				RefColumns: []*schema.Column{KeyEscrowsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// NotificationPreferencesColumns holds the columns for the "notification_preferences" table.
+	NotificationPreferencesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "user", Type: field.TypeString},
+		{Name: "event", Type: field.TypeString},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "channels", Type: field.TypeJSON, Nullable: true},
+	}
+	// NotificationPreferencesTable holds the schema information for the "notification_preferences" table.
+	NotificationPreferencesTable = &schema.Table{
+		Name:       "notification_preferences",
+		Columns:    NotificationPreferencesColumns,
+		PrimaryKey: []*schema.Column{NotificationPreferencesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "notificationpreference_user_event",
+				Unique:  true,
+				Columns: []*schema.Column{NotificationPreferencesColumns[3], NotificationPreferencesColumns[4]},
+			},
+		},
+	}
+	// SentNotificationsColumns holds the columns for the "sent_notifications" table.
+	SentNotificationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"ssl", "smime"}},
+		{Name: "certificate_id", Type: field.TypeInt},
+		{Name: "threshold", Type: field.TypeInt},
+		{Name: "recipient", Type: field.TypeString},
+		{Name: "channel", Type: field.TypeString, Default: ""},
+	}
+	// SentNotificationsTable holds the schema information for the "sent_notifications" table.
+	SentNotificationsTable = &schema.Table{
+		Name:       "sent_notifications",
+		Columns:    SentNotificationsColumns,
+		PrimaryKey: []*schema.Column{SentNotificationsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "sentnotification_kind_certificate_id_threshold_recipient_channel",
+				Unique:  true,
+				Columns: []*schema.Column{SentNotificationsColumns[3], SentNotificationsColumns[4], SentNotificationsColumns[5], SentNotificationsColumns[6], SentNotificationsColumns[7]},
+			},
+		},
+	}
 	// SmimeCertificatesColumns holds the columns for the "smime_certificates" table.
 	SmimeCertificatesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -56,6 +235,9 @@ var (
 		{Name: "created", Type: field.TypeTime, Nullable: true},
 		{Name: "status", Type: field.TypeEnum, Enums: []string{"Invalid", "Requested", "Approved", "Declined", "Applied", "Issued", "Revoked", "Expired", "Replaced", "Rejected", "Unmanaged", "SAApproved", "Init"}, Default: "Invalid"},
 		{Name: "ca", Type: field.TypeString, Nullable: true},
+		{Name: "organization", Type: field.TypeString, Nullable: true},
+		{Name: "certificate", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "owner", Type: field.TypeString, Nullable: true},
 	}
 	// SmimeCertificatesTable holds the schema information for the "smime_certificates" table.
 	SmimeCertificatesTable = &schema.Table{
@@ -63,6 +245,28 @@ var (
 		Columns:    SmimeCertificatesColumns,
 		PrimaryKey: []*schema.Column{SmimeCertificatesColumns[0]},
 	}
+	// TermsAcceptancesColumns holds the columns for the "terms_acceptances" table.
+	TermsAcceptancesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "user", Type: field.TypeString},
+		{Name: "version", Type: field.TypeString},
+		{Name: "source", Type: field.TypeString, Nullable: true},
+	}
+	// TermsAcceptancesTable holds the schema information for the "terms_acceptances" table.
+	TermsAcceptancesTable = &schema.Table{
+		Name:       "terms_acceptances",
+		Columns:    TermsAcceptancesColumns,
+		PrimaryKey: []*schema.Column{TermsAcceptancesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "termsacceptance_user_version",
+				Unique:  true,
+				Columns: []*schema.Column{TermsAcceptancesColumns[3], TermsAcceptancesColumns[4]},
+			},
+		},
+	}
 	// CertificateDomainsColumns holds the columns for the "certificate_domains" table.
 	CertificateDomainsColumns = []*schema.Column{
 		{Name: "certificate_id", Type: field.TypeInt},
@@ -90,14 +294,25 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AcmeOrdersTable,
 		CertificatesTable,
 		DomainsTable,
+		EmailVerificationsTable,
+		EscrowAuditsTable,
+		KeyEscrowsTable,
+		KeyRecoveriesTable,
+		NotificationPreferencesTable,
+		SentNotificationsTable,
 		SmimeCertificatesTable,
+		TermsAcceptancesTable,
 		CertificateDomainsTable,
 	}
 )
 
 func init() {
+	AcmeOrdersTable.ForeignKeys[0].RefTable = CertificatesTable
+	KeyEscrowsTable.ForeignKeys[0].RefTable = SmimeCertificatesTable
+	KeyRecoveriesTable.ForeignKeys[0].RefTable = KeyEscrowsTable
 	CertificateDomainsTable.ForeignKeys[0].RefTable = CertificatesTable
 	CertificateDomainsTable.ForeignKeys[1].RefTable = DomainsTable
 }
@@ -11,10 +11,18 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/hm-edu/pki-service/ent/acmeorder"
 	"github.com/hm-edu/pki-service/ent/certificate"
 	"github.com/hm-edu/pki-service/ent/domain"
+	"github.com/hm-edu/pki-service/ent/emailverification"
+	"github.com/hm-edu/pki-service/ent/escrowaudit"
+	"github.com/hm-edu/pki-service/ent/keyescrow"
+	"github.com/hm-edu/pki-service/ent/keyrecovery"
+	"github.com/hm-edu/pki-service/ent/notificationpreference"
 	"github.com/hm-edu/pki-service/ent/predicate"
+	"github.com/hm-edu/pki-service/ent/sentnotification"
 	"github.com/hm-edu/pki-service/ent/smimecertificate"
+	"github.com/hm-edu/pki-service/ent/termsacceptance"
 )
 
 const (
@@ -26,52 +34,52 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeCertificate      = "Certificate"
-	TypeDomain           = "Domain"
-	TypeSmimeCertificate = "SmimeCertificate"
+	TypeAcmeOrder              = "AcmeOrder"
+	TypeCertificate            = "Certificate"
+	TypeDomain                 = "Domain"
+	TypeEmailVerification      = "EmailVerification"
+	TypeEscrowAudit            = "EscrowAudit"
+	TypeKeyEscrow              = "KeyEscrow"
+	TypeKeyRecovery            = "KeyRecovery"
+	TypeNotificationPreference = "NotificationPreference"
+	TypeSentNotification       = "SentNotification"
+	TypeSmimeCertificate       = "SmimeCertificate"
+	TypeTermsAcceptance        = "TermsAcceptance"
 )
 
-// CertificateMutation represents an operation that mutates the Certificate nodes in the graph.
-type CertificateMutation struct {
+// AcmeOrderMutation represents an operation that mutates the AcmeOrder nodes in the graph.
+type AcmeOrderMutation struct {
 	config
-	op             Op
-	typ            string
-	id             *int
-	create_time    *time.Time
-	update_time    *time.Time
-	sslId          *int
-	addsslId       *int
-	transactionId  *string
-	serial         *string
-	commonName     *string
-	notBefore      *time.Time
-	notAfter       *time.Time
-	issuedBy       *string
-	source         *string
-	created        *time.Time
-	status         *certificate.Status
-	ca             *string
-	certificate    *string
-	clearedFields  map[string]struct{}
-	domains        map[int]struct{}
-	removeddomains map[int]struct{}
-	cleareddomains bool
-	done           bool
-	oldValue       func(context.Context) (*Certificate, error)
-	predicates     []predicate.Certificate
-}
-
-var _ ent.Mutation = (*CertificateMutation)(nil)
-
-// certificateOption allows management of the mutation configuration using functional options.
-type certificateOption func(*CertificateMutation)
-
-// newCertificateMutation creates new mutation for the Certificate entity.
-func newCertificateMutation(c config, op Op, opts ...certificateOption) *CertificateMutation {
-	m := &CertificateMutation{
+	op                      Op
+	typ                     string
+	id                      *int
+	create_time             *time.Time
+	update_time             *time.Time
+	identifiers             *string
+	registeredDomains       *[]string
+	appendregisteredDomains []string
+	status                  *acmeorder.Status
+	retryAfter              *time.Time
+	error                   *string
+	clearedFields           map[string]struct{}
+	certificate             *int
+	clearedcertificate      bool
+	done                    bool
+	oldValue                func(context.Context) (*AcmeOrder, error)
+	predicates              []predicate.AcmeOrder
+}
+
+var _ ent.Mutation = (*AcmeOrderMutation)(nil)
+
+// acmeorderOption allows management of the mutation configuration using functional options.
+type acmeorderOption func(*AcmeOrderMutation)
+
+// newAcmeOrderMutation creates new mutation for the AcmeOrder entity.
+func newAcmeOrderMutation(c config, op Op, opts ...acmeorderOption) *AcmeOrderMutation {
+	m := &AcmeOrderMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeCertificate,
+		typ:           TypeAcmeOrder,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -80,20 +88,20 @@ func newCertificateMutation(c config, op Op, opts ...certificateOption) *Certifi
 	return m
 }
 
-// withCertificateID sets the ID field of the mutation.
-func withCertificateID(id int) certificateOption {
-	return func(m *CertificateMutation) {
+// withAcmeOrderID sets the ID field of the mutation.
+func withAcmeOrderID(id int) acmeorderOption {
+	return func(m *AcmeOrderMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Certificate
+			value *AcmeOrder
 		)
-		m.oldValue = func(ctx context.Context) (*Certificate, error) {
+		m.oldValue = func(ctx context.Context) (*AcmeOrder, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Certificate.Get(ctx, id)
+					value, err = m.Client().AcmeOrder.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -102,10 +110,10 @@ func withCertificateID(id int) certificateOption {
 	}
 }
 
-// withCertificate sets the old Certificate of the mutation.
-func withCertificate(node *Certificate) certificateOption {
-	return func(m *CertificateMutation) {
-		m.oldValue = func(context.Context) (*Certificate, error) {
+// withAcmeOrder sets the old AcmeOrder of the mutation.
+func withAcmeOrder(node *AcmeOrder) acmeorderOption {
+	return func(m *AcmeOrderMutation) {
+		m.oldValue = func(context.Context) (*AcmeOrder, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -114,7 +122,7 @@ func withCertificate(node *Certificate) certificateOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m CertificateMutation) Client() *Client {
+func (m AcmeOrderMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -122,7 +130,7 @@ func (m CertificateMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m CertificateMutation) Tx() (*Tx, error) {
+func (m AcmeOrderMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -133,7 +141,7 @@ func (m CertificateMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *CertificateMutation) ID() (id int, exists bool) {
+func (m *AcmeOrderMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -144,7 +152,7 @@ func (m *CertificateMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *CertificateMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *AcmeOrderMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -153,19 +161,19 @@ func (m *CertificateMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Certificate.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AcmeOrder.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreateTime sets the "create_time" field.
-func (m *CertificateMutation) SetCreateTime(t time.Time) {
+func (m *AcmeOrderMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
 // CreateTime returns the value of the "create_time" field in the mutation.
-func (m *CertificateMutation) CreateTime() (r time.Time, exists bool) {
+func (m *AcmeOrderMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.create_time
 	if v == nil {
 		return
@@ -173,10 +181,10 @@ func (m *CertificateMutation) CreateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the Certificate entity.
-// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the AcmeOrder entity.
+// If the AcmeOrder object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CertificateMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *AcmeOrderMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
@@ -191,17 +199,17 @@ func (m *CertificateMutation) OldCreateTime(ctx context.Context) (v time.Time, e
 }
 
 // ResetCreateTime resets all changes to the "create_time" field.
-func (m *CertificateMutation) ResetCreateTime() {
+func (m *AcmeOrderMutation) ResetCreateTime() {
 	m.create_time = nil
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (m *CertificateMutation) SetUpdateTime(t time.Time) {
+func (m *AcmeOrderMutation) SetUpdateTime(t time.Time) {
 	m.update_time = &t
 }
 
 // UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *CertificateMutation) UpdateTime() (r time.Time, exists bool) {
+func (m *AcmeOrderMutation) UpdateTime() (r time.Time, exists bool) {
 	v := m.update_time
 	if v == nil {
 		return
@@ -209,10 +217,10 @@ func (m *CertificateMutation) UpdateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the Certificate entity.
-// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the AcmeOrder entity.
+// If the AcmeOrder object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CertificateMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+func (m *AcmeOrderMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
@@ -227,1374 +235,8132 @@ func (m *CertificateMutation) OldUpdateTime(ctx context.Context) (v time.Time, e
 }
 
 // ResetUpdateTime resets all changes to the "update_time" field.
-func (m *CertificateMutation) ResetUpdateTime() {
+func (m *AcmeOrderMutation) ResetUpdateTime() {
 	m.update_time = nil
 }
 
-// SetSslId sets the "sslId" field.
-func (m *CertificateMutation) SetSslId(i int) {
-	m.sslId = &i
-	m.addsslId = nil
+// SetIdentifiers sets the "identifiers" field.
+func (m *AcmeOrderMutation) SetIdentifiers(s string) {
+	m.identifiers = &s
 }
 
-// SslId returns the value of the "sslId" field in the mutation.
-func (m *CertificateMutation) SslId() (r int, exists bool) {
-	v := m.sslId
+// Identifiers returns the value of the "identifiers" field in the mutation.
+func (m *AcmeOrderMutation) Identifiers() (r string, exists bool) {
+	v := m.identifiers
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSslId returns the old "sslId" field's value of the Certificate entity.
-// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// OldIdentifiers returns the old "identifiers" field's value of the AcmeOrder entity.
+// If the AcmeOrder object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CertificateMutation) OldSslId(ctx context.Context) (v int, err error) {
+func (m *AcmeOrderMutation) OldIdentifiers(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSslId is only allowed on UpdateOne operations")
+		return v, errors.New("OldIdentifiers is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSslId requires an ID field in the mutation")
+		return v, errors.New("OldIdentifiers requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSslId: %w", err)
-	}
-	return oldValue.SslId, nil
-}
-
-// AddSslId adds i to the "sslId" field.
-func (m *CertificateMutation) AddSslId(i int) {
-	if m.addsslId != nil {
-		*m.addsslId += i
-	} else {
-		m.addsslId = &i
-	}
-}
-
-// AddedSslId returns the value that was added to the "sslId" field in this mutation.
-func (m *CertificateMutation) AddedSslId() (r int, exists bool) {
-	v := m.addsslId
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldIdentifiers: %w", err)
 	}
-	return *v, true
-}
-
-// ClearSslId clears the value of the "sslId" field.
-func (m *CertificateMutation) ClearSslId() {
-	m.sslId = nil
-	m.addsslId = nil
-	m.clearedFields[certificate.FieldSslId] = struct{}{}
-}
-
-// SslIdCleared returns if the "sslId" field was cleared in this mutation.
-func (m *CertificateMutation) SslIdCleared() bool {
-	_, ok := m.clearedFields[certificate.FieldSslId]
-	return ok
+	return oldValue.Identifiers, nil
 }
 
-// ResetSslId resets all changes to the "sslId" field.
-func (m *CertificateMutation) ResetSslId() {
-	m.sslId = nil
-	m.addsslId = nil
-	delete(m.clearedFields, certificate.FieldSslId)
+// ResetIdentifiers resets all changes to the "identifiers" field.
+func (m *AcmeOrderMutation) ResetIdentifiers() {
+	m.identifiers = nil
 }
 
-// SetTransactionId sets the "transactionId" field.
-func (m *CertificateMutation) SetTransactionId(s string) {
-	m.transactionId = &s
+// SetRegisteredDomains sets the "registeredDomains" field.
+func (m *AcmeOrderMutation) SetRegisteredDomains(s []string) {
+	m.registeredDomains = &s
+	m.appendregisteredDomains = nil
 }
 
-// TransactionId returns the value of the "transactionId" field in the mutation.
-func (m *CertificateMutation) TransactionId() (r string, exists bool) {
-	v := m.transactionId
+// RegisteredDomains returns the value of the "registeredDomains" field in the mutation.
+func (m *AcmeOrderMutation) RegisteredDomains() (r []string, exists bool) {
+	v := m.registeredDomains
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTransactionId returns the old "transactionId" field's value of the Certificate entity.
-// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// OldRegisteredDomains returns the old "registeredDomains" field's value of the AcmeOrder entity.
+// If the AcmeOrder object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CertificateMutation) OldTransactionId(ctx context.Context) (v string, err error) {
+func (m *AcmeOrderMutation) OldRegisteredDomains(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTransactionId is only allowed on UpdateOne operations")
+		return v, errors.New("OldRegisteredDomains is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTransactionId requires an ID field in the mutation")
+		return v, errors.New("OldRegisteredDomains requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTransactionId: %w", err)
+		return v, fmt.Errorf("querying old value for OldRegisteredDomains: %w", err)
 	}
-	return oldValue.TransactionId, nil
-}
-
-// ClearTransactionId clears the value of the "transactionId" field.
-func (m *CertificateMutation) ClearTransactionId() {
-	m.transactionId = nil
-	m.clearedFields[certificate.FieldTransactionId] = struct{}{}
-}
-
-// TransactionIdCleared returns if the "transactionId" field was cleared in this mutation.
-func (m *CertificateMutation) TransactionIdCleared() bool {
-	_, ok := m.clearedFields[certificate.FieldTransactionId]
-	return ok
-}
-
-// ResetTransactionId resets all changes to the "transactionId" field.
-func (m *CertificateMutation) ResetTransactionId() {
-	m.transactionId = nil
-	delete(m.clearedFields, certificate.FieldTransactionId)
+	return oldValue.RegisteredDomains, nil
 }
 
-// SetSerial sets the "serial" field.
-func (m *CertificateMutation) SetSerial(s string) {
-	m.serial = &s
-}
-
-// Serial returns the value of the "serial" field in the mutation.
-func (m *CertificateMutation) Serial() (r string, exists bool) {
-	v := m.serial
-	if v == nil {
-		return
-	}
-	return *v, true
+// AppendRegisteredDomains adds s to the "registeredDomains" field.
+func (m *AcmeOrderMutation) AppendRegisteredDomains(s []string) {
+	m.appendregisteredDomains = append(m.appendregisteredDomains, s...)
 }
 
-// OldSerial returns the old "serial" field's value of the Certificate entity.
-// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CertificateMutation) OldSerial(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSerial is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSerial requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSerial: %w", err)
+// AppendedRegisteredDomains returns the list of values that were appended to the "registeredDomains" field in this mutation.
+func (m *AcmeOrderMutation) AppendedRegisteredDomains() ([]string, bool) {
+	if len(m.appendregisteredDomains) == 0 {
+		return nil, false
 	}
-	return oldValue.Serial, nil
+	return m.appendregisteredDomains, true
 }
 
-// ClearSerial clears the value of the "serial" field.
-func (m *CertificateMutation) ClearSerial() {
-	m.serial = nil
-	m.clearedFields[certificate.FieldSerial] = struct{}{}
+// ClearRegisteredDomains clears the value of the "registeredDomains" field.
+func (m *AcmeOrderMutation) ClearRegisteredDomains() {
+	m.registeredDomains = nil
+	m.appendregisteredDomains = nil
+	m.clearedFields[acmeorder.FieldRegisteredDomains] = struct{}{}
 }
 
-// SerialCleared returns if the "serial" field was cleared in this mutation.
-func (m *CertificateMutation) SerialCleared() bool {
-	_, ok := m.clearedFields[certificate.FieldSerial]
+// RegisteredDomainsCleared returns if the "registeredDomains" field was cleared in this mutation.
+func (m *AcmeOrderMutation) RegisteredDomainsCleared() bool {
+	_, ok := m.clearedFields[acmeorder.FieldRegisteredDomains]
 	return ok
 }
 
-// ResetSerial resets all changes to the "serial" field.
-func (m *CertificateMutation) ResetSerial() {
-	m.serial = nil
-	delete(m.clearedFields, certificate.FieldSerial)
+// ResetRegisteredDomains resets all changes to the "registeredDomains" field.
+func (m *AcmeOrderMutation) ResetRegisteredDomains() {
+	m.registeredDomains = nil
+	m.appendregisteredDomains = nil
+	delete(m.clearedFields, acmeorder.FieldRegisteredDomains)
 }
 
-// SetCommonName sets the "commonName" field.
-func (m *CertificateMutation) SetCommonName(s string) {
-	m.commonName = &s
+// SetStatus sets the "status" field.
+func (m *AcmeOrderMutation) SetStatus(a acmeorder.Status) {
+	m.status = &a
 }
 
-// CommonName returns the value of the "commonName" field in the mutation.
-func (m *CertificateMutation) CommonName() (r string, exists bool) {
-	v := m.commonName
+// Status returns the value of the "status" field in the mutation.
+func (m *AcmeOrderMutation) Status() (r acmeorder.Status, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCommonName returns the old "commonName" field's value of the Certificate entity.
-// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the AcmeOrder entity.
+// If the AcmeOrder object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CertificateMutation) OldCommonName(ctx context.Context) (v string, err error) {
+func (m *AcmeOrderMutation) OldStatus(ctx context.Context) (v acmeorder.Status, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCommonName is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCommonName requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCommonName: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.CommonName, nil
+	return oldValue.Status, nil
 }
 
-// ResetCommonName resets all changes to the "commonName" field.
-func (m *CertificateMutation) ResetCommonName() {
-	m.commonName = nil
+// ResetStatus resets all changes to the "status" field.
+func (m *AcmeOrderMutation) ResetStatus() {
+	m.status = nil
 }
 
-// SetNotBefore sets the "notBefore" field.
-func (m *CertificateMutation) SetNotBefore(t time.Time) {
-	m.notBefore = &t
+// SetRetryAfter sets the "retryAfter" field.
+func (m *AcmeOrderMutation) SetRetryAfter(t time.Time) {
+	m.retryAfter = &t
 }
 
-// NotBefore returns the value of the "notBefore" field in the mutation.
-func (m *CertificateMutation) NotBefore() (r time.Time, exists bool) {
-	v := m.notBefore
+// RetryAfter returns the value of the "retryAfter" field in the mutation.
+func (m *AcmeOrderMutation) RetryAfter() (r time.Time, exists bool) {
+	v := m.retryAfter
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNotBefore returns the old "notBefore" field's value of the Certificate entity.
-// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// OldRetryAfter returns the old "retryAfter" field's value of the AcmeOrder entity.
+// If the AcmeOrder object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CertificateMutation) OldNotBefore(ctx context.Context) (v *time.Time, err error) {
+func (m *AcmeOrderMutation) OldRetryAfter(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNotBefore is only allowed on UpdateOne operations")
+		return v, errors.New("OldRetryAfter is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNotBefore requires an ID field in the mutation")
+		return v, errors.New("OldRetryAfter requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNotBefore: %w", err)
+		return v, fmt.Errorf("querying old value for OldRetryAfter: %w", err)
 	}
-	return oldValue.NotBefore, nil
+	return oldValue.RetryAfter, nil
 }
 
-// ClearNotBefore clears the value of the "notBefore" field.
-func (m *CertificateMutation) ClearNotBefore() {
-	m.notBefore = nil
-	m.clearedFields[certificate.FieldNotBefore] = struct{}{}
+// ClearRetryAfter clears the value of the "retryAfter" field.
+func (m *AcmeOrderMutation) ClearRetryAfter() {
+	m.retryAfter = nil
+	m.clearedFields[acmeorder.FieldRetryAfter] = struct{}{}
 }
 
-// NotBeforeCleared returns if the "notBefore" field was cleared in this mutation.
-func (m *CertificateMutation) NotBeforeCleared() bool {
-	_, ok := m.clearedFields[certificate.FieldNotBefore]
+// RetryAfterCleared returns if the "retryAfter" field was cleared in this mutation.
+func (m *AcmeOrderMutation) RetryAfterCleared() bool {
+	_, ok := m.clearedFields[acmeorder.FieldRetryAfter]
 	return ok
 }
 
-// ResetNotBefore resets all changes to the "notBefore" field.
-func (m *CertificateMutation) ResetNotBefore() {
-	m.notBefore = nil
-	delete(m.clearedFields, certificate.FieldNotBefore)
+// ResetRetryAfter resets all changes to the "retryAfter" field.
+func (m *AcmeOrderMutation) ResetRetryAfter() {
+	m.retryAfter = nil
+	delete(m.clearedFields, acmeorder.FieldRetryAfter)
 }
 
-// SetNotAfter sets the "notAfter" field.
-func (m *CertificateMutation) SetNotAfter(t time.Time) {
-	m.notAfter = &t
+// SetError sets the "error" field.
+func (m *AcmeOrderMutation) SetError(s string) {
+	m.error = &s
 }
 
-// NotAfter returns the value of the "notAfter" field in the mutation.
-func (m *CertificateMutation) NotAfter() (r time.Time, exists bool) {
-	v := m.notAfter
+// Error returns the value of the "error" field in the mutation.
+func (m *AcmeOrderMutation) Error() (r string, exists bool) {
+	v := m.error
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNotAfter returns the old "notAfter" field's value of the Certificate entity.
-// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// OldError returns the old "error" field's value of the AcmeOrder entity.
+// If the AcmeOrder object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CertificateMutation) OldNotAfter(ctx context.Context) (v time.Time, err error) {
+func (m *AcmeOrderMutation) OldError(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNotAfter is only allowed on UpdateOne operations")
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNotAfter requires an ID field in the mutation")
+		return v, errors.New("OldError requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNotAfter: %w", err)
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
 	}
-	return oldValue.NotAfter, nil
+	return oldValue.Error, nil
 }
 
-// ClearNotAfter clears the value of the "notAfter" field.
-func (m *CertificateMutation) ClearNotAfter() {
-	m.notAfter = nil
-	m.clearedFields[certificate.FieldNotAfter] = struct{}{}
+// ClearError clears the value of the "error" field.
+func (m *AcmeOrderMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[acmeorder.FieldError] = struct{}{}
 }
 
-// NotAfterCleared returns if the "notAfter" field was cleared in this mutation.
-func (m *CertificateMutation) NotAfterCleared() bool {
-	_, ok := m.clearedFields[certificate.FieldNotAfter]
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *AcmeOrderMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[acmeorder.FieldError]
 	return ok
 }
 
-// ResetNotAfter resets all changes to the "notAfter" field.
-func (m *CertificateMutation) ResetNotAfter() {
-	m.notAfter = nil
-	delete(m.clearedFields, certificate.FieldNotAfter)
+// ResetError resets all changes to the "error" field.
+func (m *AcmeOrderMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, acmeorder.FieldError)
 }
 
-// SetIssuedBy sets the "issuedBy" field.
-func (m *CertificateMutation) SetIssuedBy(s string) {
-	m.issuedBy = &s
+// SetCertificateID sets the "certificate" edge to the Certificate entity by id.
+func (m *AcmeOrderMutation) SetCertificateID(id int) {
+	m.certificate = &id
 }
 
-// IssuedBy returns the value of the "issuedBy" field in the mutation.
-func (m *CertificateMutation) IssuedBy() (r string, exists bool) {
-	v := m.issuedBy
-	if v == nil {
-		return
+// ClearCertificate clears the "certificate" edge to the Certificate entity.
+func (m *AcmeOrderMutation) ClearCertificate() {
+	m.clearedcertificate = true
+}
+
+// CertificateCleared reports if the "certificate" edge to the Certificate entity was cleared.
+func (m *AcmeOrderMutation) CertificateCleared() bool {
+	return m.clearedcertificate
+}
+
+// CertificateID returns the "certificate" edge ID in the mutation.
+func (m *AcmeOrderMutation) CertificateID() (id int, exists bool) {
+	if m.certificate != nil {
+		return *m.certificate, true
 	}
-	return *v, true
+	return
 }
 
-// OldIssuedBy returns the old "issuedBy" field's value of the Certificate entity.
-// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CertificateMutation) OldIssuedBy(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIssuedBy is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIssuedBy requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIssuedBy: %w", err)
+// CertificateIDs returns the "certificate" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CertificateID instead. It exists only for internal usage by the builders.
+func (m *AcmeOrderMutation) CertificateIDs() (ids []int) {
+	if id := m.certificate; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.IssuedBy, nil
+	return
 }
 
-// ClearIssuedBy clears the value of the "issuedBy" field.
-func (m *CertificateMutation) ClearIssuedBy() {
-	m.issuedBy = nil
-	m.clearedFields[certificate.FieldIssuedBy] = struct{}{}
+// ResetCertificate resets all changes to the "certificate" edge.
+func (m *AcmeOrderMutation) ResetCertificate() {
+	m.certificate = nil
+	m.clearedcertificate = false
 }
 
-// IssuedByCleared returns if the "issuedBy" field was cleared in this mutation.
-func (m *CertificateMutation) IssuedByCleared() bool {
-	_, ok := m.clearedFields[certificate.FieldIssuedBy]
-	return ok
+// Where appends a list predicates to the AcmeOrderMutation builder.
+func (m *AcmeOrderMutation) Where(ps ...predicate.AcmeOrder) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// ResetIssuedBy resets all changes to the "issuedBy" field.
-func (m *CertificateMutation) ResetIssuedBy() {
-	m.issuedBy = nil
-	delete(m.clearedFields, certificate.FieldIssuedBy)
+// WhereP appends storage-level predicates to the AcmeOrderMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AcmeOrderMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AcmeOrder, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
 }
 
-// SetSource sets the "source" field.
-func (m *CertificateMutation) SetSource(s string) {
-	m.source = &s
+// Op returns the operation name.
+func (m *AcmeOrderMutation) Op() Op {
+	return m.op
 }
 
-// Source returns the value of the "source" field in the mutation.
-func (m *CertificateMutation) Source() (r string, exists bool) {
-	v := m.source
-	if v == nil {
-		return
-	}
-	return *v, true
+// SetOp allows setting the mutation operation.
+func (m *AcmeOrderMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// OldSource returns the old "source" field's value of the Certificate entity.
-// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CertificateMutation) OldSource(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+// Type returns the node type of this mutation (AcmeOrder).
+func (m *AcmeOrderMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AcmeOrderMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.create_time != nil {
+		fields = append(fields, acmeorder.FieldCreateTime)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSource requires an ID field in the mutation")
+	if m.update_time != nil {
+		fields = append(fields, acmeorder.FieldUpdateTime)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+	if m.identifiers != nil {
+		fields = append(fields, acmeorder.FieldIdentifiers)
 	}
-	return oldValue.Source, nil
+	if m.registeredDomains != nil {
+		fields = append(fields, acmeorder.FieldRegisteredDomains)
+	}
+	if m.status != nil {
+		fields = append(fields, acmeorder.FieldStatus)
+	}
+	if m.retryAfter != nil {
+		fields = append(fields, acmeorder.FieldRetryAfter)
+	}
+	if m.error != nil {
+		fields = append(fields, acmeorder.FieldError)
+	}
+	return fields
 }
 
-// ClearSource clears the value of the "source" field.
-func (m *CertificateMutation) ClearSource() {
-	m.source = nil
-	m.clearedFields[certificate.FieldSource] = struct{}{}
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AcmeOrderMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case acmeorder.FieldCreateTime:
+		return m.CreateTime()
+	case acmeorder.FieldUpdateTime:
+		return m.UpdateTime()
+	case acmeorder.FieldIdentifiers:
+		return m.Identifiers()
+	case acmeorder.FieldRegisteredDomains:
+		return m.RegisteredDomains()
+	case acmeorder.FieldStatus:
+		return m.Status()
+	case acmeorder.FieldRetryAfter:
+		return m.RetryAfter()
+	case acmeorder.FieldError:
+		return m.Error()
+	}
+	return nil, false
 }
 
-// SourceCleared returns if the "source" field was cleared in this mutation.
-func (m *CertificateMutation) SourceCleared() bool {
-	_, ok := m.clearedFields[certificate.FieldSource]
-	return ok
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AcmeOrderMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case acmeorder.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case acmeorder.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case acmeorder.FieldIdentifiers:
+		return m.OldIdentifiers(ctx)
+	case acmeorder.FieldRegisteredDomains:
+		return m.OldRegisteredDomains(ctx)
+	case acmeorder.FieldStatus:
+		return m.OldStatus(ctx)
+	case acmeorder.FieldRetryAfter:
+		return m.OldRetryAfter(ctx)
+	case acmeorder.FieldError:
+		return m.OldError(ctx)
+	}
+	return nil, fmt.Errorf("unknown AcmeOrder field %s", name)
 }
 
-// ResetSource resets all changes to the "source" field.
-func (m *CertificateMutation) ResetSource() {
-	m.source = nil
-	delete(m.clearedFields, certificate.FieldSource)
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AcmeOrderMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case acmeorder.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case acmeorder.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case acmeorder.FieldIdentifiers:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIdentifiers(v)
+		return nil
+	case acmeorder.FieldRegisteredDomains:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRegisteredDomains(v)
+		return nil
+	case acmeorder.FieldStatus:
+		v, ok := value.(acmeorder.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case acmeorder.FieldRetryAfter:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRetryAfter(v)
+		return nil
+	case acmeorder.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AcmeOrder field %s", name)
 }
 
-// SetCreated sets the "created" field.
-func (m *CertificateMutation) SetCreated(t time.Time) {
-	m.created = &t
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AcmeOrderMutation) AddedFields() []string {
+	return nil
 }
 
-// Created returns the value of the "created" field in the mutation.
-func (m *CertificateMutation) Created() (r time.Time, exists bool) {
-	v := m.created
-	if v == nil {
-		return
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AcmeOrderMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AcmeOrderMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	return *v, true
+	return fmt.Errorf("unknown AcmeOrder numeric field %s", name)
 }
 
-// OldCreated returns the old "created" field's value of the Certificate entity.
-// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CertificateMutation) OldCreated(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreated is only allowed on UpdateOne operations")
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AcmeOrderMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(acmeorder.FieldRegisteredDomains) {
+		fields = append(fields, acmeorder.FieldRegisteredDomains)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreated requires an ID field in the mutation")
+	if m.FieldCleared(acmeorder.FieldRetryAfter) {
+		fields = append(fields, acmeorder.FieldRetryAfter)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreated: %w", err)
+	if m.FieldCleared(acmeorder.FieldError) {
+		fields = append(fields, acmeorder.FieldError)
 	}
-	return oldValue.Created, nil
-}
-
-// ClearCreated clears the value of the "created" field.
-func (m *CertificateMutation) ClearCreated() {
-	m.created = nil
-	m.clearedFields[certificate.FieldCreated] = struct{}{}
+	return fields
 }
 
-// CreatedCleared returns if the "created" field was cleared in this mutation.
-func (m *CertificateMutation) CreatedCleared() bool {
-	_, ok := m.clearedFields[certificate.FieldCreated]
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AcmeOrderMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
 	return ok
 }
 
-// ResetCreated resets all changes to the "created" field.
-func (m *CertificateMutation) ResetCreated() {
-	m.created = nil
-	delete(m.clearedFields, certificate.FieldCreated)
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AcmeOrderMutation) ClearField(name string) error {
+	switch name {
+	case acmeorder.FieldRegisteredDomains:
+		m.ClearRegisteredDomains()
+		return nil
+	case acmeorder.FieldRetryAfter:
+		m.ClearRetryAfter()
+		return nil
+	case acmeorder.FieldError:
+		m.ClearError()
+		return nil
+	}
+	return fmt.Errorf("unknown AcmeOrder nullable field %s", name)
 }
 
-// SetStatus sets the "status" field.
-func (m *CertificateMutation) SetStatus(c certificate.Status) {
-	m.status = &c
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AcmeOrderMutation) ResetField(name string) error {
+	switch name {
+	case acmeorder.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case acmeorder.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case acmeorder.FieldIdentifiers:
+		m.ResetIdentifiers()
+		return nil
+	case acmeorder.FieldRegisteredDomains:
+		m.ResetRegisteredDomains()
+		return nil
+	case acmeorder.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case acmeorder.FieldRetryAfter:
+		m.ResetRetryAfter()
+		return nil
+	case acmeorder.FieldError:
+		m.ResetError()
+		return nil
+	}
+	return fmt.Errorf("unknown AcmeOrder field %s", name)
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *CertificateMutation) Status() (r certificate.Status, exists bool) {
-	v := m.status
-	if v == nil {
-		return
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AcmeOrderMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.certificate != nil {
+		edges = append(edges, acmeorder.EdgeCertificate)
 	}
-	return *v, true
+	return edges
 }
 
-// OldStatus returns the old "status" field's value of the Certificate entity.
-// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CertificateMutation) OldStatus(ctx context.Context) (v certificate.Status, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AcmeOrderMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case acmeorder.EdgeCertificate:
+		if id := m.certificate; id != nil {
+			return []ent.Value{*id}
+		}
 	}
-	return oldValue.Status, nil
+	return nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *CertificateMutation) ResetStatus() {
-	m.status = nil
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AcmeOrderMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
 }
 
-// SetCa sets the "ca" field.
-func (m *CertificateMutation) SetCa(s string) {
-	m.ca = &s
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AcmeOrderMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// Ca returns the value of the "ca" field in the mutation.
-func (m *CertificateMutation) Ca() (r string, exists bool) {
-	v := m.ca
-	if v == nil {
-		return
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AcmeOrderMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedcertificate {
+		edges = append(edges, acmeorder.EdgeCertificate)
 	}
-	return *v, true
+	return edges
 }
 
-// OldCa returns the old "ca" field's value of the Certificate entity.
-// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CertificateMutation) OldCa(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCa is only allowed on UpdateOne operations")
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AcmeOrderMutation) EdgeCleared(name string) bool {
+	switch name {
+	case acmeorder.EdgeCertificate:
+		return m.clearedcertificate
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCa requires an ID field in the mutation")
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AcmeOrderMutation) ClearEdge(name string) error {
+	switch name {
+	case acmeorder.EdgeCertificate:
+		m.ClearCertificate()
+		return nil
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCa: %w", err)
+	return fmt.Errorf("unknown AcmeOrder unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AcmeOrderMutation) ResetEdge(name string) error {
+	switch name {
+	case acmeorder.EdgeCertificate:
+		m.ResetCertificate()
+		return nil
 	}
-	return oldValue.Ca, nil
+	return fmt.Errorf("unknown AcmeOrder edge %s", name)
 }
 
-// ClearCa clears the value of the "ca" field.
-func (m *CertificateMutation) ClearCa() {
-	m.ca = nil
-	m.clearedFields[certificate.FieldCa] = struct{}{}
+// CertificateMutation represents an operation that mutates the Certificate nodes in the graph.
+type CertificateMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	create_time       *time.Time
+	update_time       *time.Time
+	sslId             *int
+	addsslId          *int
+	transactionId     *string
+	serial            *string
+	commonName        *string
+	notBefore         *time.Time
+	notAfter          *time.Time
+	issuedBy          *string
+	source            *string
+	created           *time.Time
+	status            *certificate.Status
+	ca                *string
+	certificate       *string
+	caReason          *string
+	csr               *string
+	organization      *string
+	certType          *string
+	clearedFields     map[string]struct{}
+	domains           map[int]struct{}
+	removeddomains    map[int]struct{}
+	cleareddomains    bool
+	acmeOrders        map[int]struct{}
+	removedacmeOrders map[int]struct{}
+	clearedacmeOrders bool
+	done              bool
+	oldValue          func(context.Context) (*Certificate, error)
+	predicates        []predicate.Certificate
 }
 
-// CaCleared returns if the "ca" field was cleared in this mutation.
-func (m *CertificateMutation) CaCleared() bool {
-	_, ok := m.clearedFields[certificate.FieldCa]
-	return ok
+var _ ent.Mutation = (*CertificateMutation)(nil)
+
+// certificateOption allows management of the mutation configuration using functional options.
+type certificateOption func(*CertificateMutation)
+
+// newCertificateMutation creates new mutation for the Certificate entity.
+func newCertificateMutation(c config, op Op, opts ...certificateOption) *CertificateMutation {
+	m := &CertificateMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCertificate,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// ResetCa resets all changes to the "ca" field.
-func (m *CertificateMutation) ResetCa() {
-	m.ca = nil
-	delete(m.clearedFields, certificate.FieldCa)
+// withCertificateID sets the ID field of the mutation.
+func withCertificateID(id int) certificateOption {
+	return func(m *CertificateMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Certificate
+		)
+		m.oldValue = func(ctx context.Context) (*Certificate, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Certificate.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// SetCertificate sets the "certificate" field.
-func (m *CertificateMutation) SetCertificate(s string) {
-	m.certificate = &s
+// withCertificate sets the old Certificate of the mutation.
+func withCertificate(node *Certificate) certificateOption {
+	return func(m *CertificateMutation) {
+		m.oldValue = func(context.Context) (*Certificate, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// Certificate returns the value of the "certificate" field in the mutation.
-func (m *CertificateMutation) Certificate() (r string, exists bool) {
-	v := m.certificate
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CertificateMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CertificateMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CertificateMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CertificateMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Certificate.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *CertificateMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *CertificateMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCertificate returns the old "certificate" field's value of the Certificate entity.
+// OldCreateTime returns the old "create_time" field's value of the Certificate entity.
 // If the Certificate object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CertificateMutation) OldCertificate(ctx context.Context) (v *string, err error) {
+func (m *CertificateMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCertificate is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCertificate requires an ID field in the mutation")
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCertificate: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
 	}
-	return oldValue.Certificate, nil
+	return oldValue.CreateTime, nil
 }
 
-// ClearCertificate clears the value of the "certificate" field.
-func (m *CertificateMutation) ClearCertificate() {
-	m.certificate = nil
-	m.clearedFields[certificate.FieldCertificate] = struct{}{}
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *CertificateMutation) ResetCreateTime() {
+	m.create_time = nil
 }
 
-// CertificateCleared returns if the "certificate" field was cleared in this mutation.
-func (m *CertificateMutation) CertificateCleared() bool {
-	_, ok := m.clearedFields[certificate.FieldCertificate]
-	return ok
+// SetUpdateTime sets the "update_time" field.
+func (m *CertificateMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
 }
 
-// ResetCertificate resets all changes to the "certificate" field.
-func (m *CertificateMutation) ResetCertificate() {
-	m.certificate = nil
-	delete(m.clearedFields, certificate.FieldCertificate)
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *CertificateMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddDomainIDs adds the "domains" edge to the Domain entity by ids.
-func (m *CertificateMutation) AddDomainIDs(ids ...int) {
-	if m.domains == nil {
-		m.domains = make(map[int]struct{})
+// OldUpdateTime returns the old "update_time" field's value of the Certificate entity.
+// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertificateMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.domains[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
 }
 
-// ClearDomains clears the "domains" edge to the Domain entity.
-func (m *CertificateMutation) ClearDomains() {
-	m.cleareddomains = true
-}
-
-// DomainsCleared reports if the "domains" edge to the Domain entity was cleared.
-func (m *CertificateMutation) DomainsCleared() bool {
-	return m.cleareddomains
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *CertificateMutation) ResetUpdateTime() {
+	m.update_time = nil
 }
 
-// RemoveDomainIDs removes the "domains" edge to the Domain entity by IDs.
-func (m *CertificateMutation) RemoveDomainIDs(ids ...int) {
-	if m.removeddomains == nil {
-		m.removeddomains = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.domains, ids[i])
-		m.removeddomains[ids[i]] = struct{}{}
-	}
+// SetSslId sets the "sslId" field.
+func (m *CertificateMutation) SetSslId(i int) {
+	m.sslId = &i
+	m.addsslId = nil
 }
 
-// RemovedDomains returns the removed IDs of the "domains" edge to the Domain entity.
-func (m *CertificateMutation) RemovedDomainsIDs() (ids []int) {
-	for id := range m.removeddomains {
-		ids = append(ids, id)
+// SslId returns the value of the "sslId" field in the mutation.
+func (m *CertificateMutation) SslId() (r int, exists bool) {
+	v := m.sslId
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// DomainsIDs returns the "domains" edge IDs in the mutation.
-func (m *CertificateMutation) DomainsIDs() (ids []int) {
-	for id := range m.domains {
-		ids = append(ids, id)
+// OldSslId returns the old "sslId" field's value of the Certificate entity.
+// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertificateMutation) OldSslId(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSslId is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSslId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSslId: %w", err)
+	}
+	return oldValue.SslId, nil
 }
 
-// ResetDomains resets all changes to the "domains" edge.
-func (m *CertificateMutation) ResetDomains() {
-	m.domains = nil
-	m.cleareddomains = false
-	m.removeddomains = nil
+// AddSslId adds i to the "sslId" field.
+func (m *CertificateMutation) AddSslId(i int) {
+	if m.addsslId != nil {
+		*m.addsslId += i
+	} else {
+		m.addsslId = &i
+	}
 }
 
-// Where appends a list predicates to the CertificateMutation builder.
-func (m *CertificateMutation) Where(ps ...predicate.Certificate) {
-	m.predicates = append(m.predicates, ps...)
+// AddedSslId returns the value that was added to the "sslId" field in this mutation.
+func (m *CertificateMutation) AddedSslId() (r int, exists bool) {
+	v := m.addsslId
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// WhereP appends storage-level predicates to the CertificateMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *CertificateMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Certificate, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
+// ClearSslId clears the value of the "sslId" field.
+func (m *CertificateMutation) ClearSslId() {
+	m.sslId = nil
+	m.addsslId = nil
+	m.clearedFields[certificate.FieldSslId] = struct{}{}
 }
 
-// Op returns the operation name.
-func (m *CertificateMutation) Op() Op {
-	return m.op
+// SslIdCleared returns if the "sslId" field was cleared in this mutation.
+func (m *CertificateMutation) SslIdCleared() bool {
+	_, ok := m.clearedFields[certificate.FieldSslId]
+	return ok
 }
 
-// SetOp allows setting the mutation operation.
-func (m *CertificateMutation) SetOp(op Op) {
-	m.op = op
+// ResetSslId resets all changes to the "sslId" field.
+func (m *CertificateMutation) ResetSslId() {
+	m.sslId = nil
+	m.addsslId = nil
+	delete(m.clearedFields, certificate.FieldSslId)
 }
 
-// Type returns the node type of this mutation (Certificate).
-func (m *CertificateMutation) Type() string {
-	return m.typ
+// SetTransactionId sets the "transactionId" field.
+func (m *CertificateMutation) SetTransactionId(s string) {
+	m.transactionId = &s
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *CertificateMutation) Fields() []string {
-	fields := make([]string, 0, 14)
-	if m.create_time != nil {
-		fields = append(fields, certificate.FieldCreateTime)
+// TransactionId returns the value of the "transactionId" field in the mutation.
+func (m *CertificateMutation) TransactionId() (r string, exists bool) {
+	v := m.transactionId
+	if v == nil {
+		return
 	}
-	if m.update_time != nil {
-		fields = append(fields, certificate.FieldUpdateTime)
+	return *v, true
+}
+
+// OldTransactionId returns the old "transactionId" field's value of the Certificate entity.
+// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertificateMutation) OldTransactionId(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTransactionId is only allowed on UpdateOne operations")
 	}
-	if m.sslId != nil {
-		fields = append(fields, certificate.FieldSslId)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTransactionId requires an ID field in the mutation")
 	}
-	if m.transactionId != nil {
-		fields = append(fields, certificate.FieldTransactionId)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTransactionId: %w", err)
 	}
-	if m.serial != nil {
-		fields = append(fields, certificate.FieldSerial)
+	return oldValue.TransactionId, nil
+}
+
+// ClearTransactionId clears the value of the "transactionId" field.
+func (m *CertificateMutation) ClearTransactionId() {
+	m.transactionId = nil
+	m.clearedFields[certificate.FieldTransactionId] = struct{}{}
+}
+
+// TransactionIdCleared returns if the "transactionId" field was cleared in this mutation.
+func (m *CertificateMutation) TransactionIdCleared() bool {
+	_, ok := m.clearedFields[certificate.FieldTransactionId]
+	return ok
+}
+
+// ResetTransactionId resets all changes to the "transactionId" field.
+func (m *CertificateMutation) ResetTransactionId() {
+	m.transactionId = nil
+	delete(m.clearedFields, certificate.FieldTransactionId)
+}
+
+// SetSerial sets the "serial" field.
+func (m *CertificateMutation) SetSerial(s string) {
+	m.serial = &s
+}
+
+// Serial returns the value of the "serial" field in the mutation.
+func (m *CertificateMutation) Serial() (r string, exists bool) {
+	v := m.serial
+	if v == nil {
+		return
 	}
-	if m.commonName != nil {
-		fields = append(fields, certificate.FieldCommonName)
+	return *v, true
+}
+
+// OldSerial returns the old "serial" field's value of the Certificate entity.
+// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertificateMutation) OldSerial(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSerial is only allowed on UpdateOne operations")
 	}
-	if m.notBefore != nil {
-		fields = append(fields, certificate.FieldNotBefore)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSerial requires an ID field in the mutation")
 	}
-	if m.notAfter != nil {
-		fields = append(fields, certificate.FieldNotAfter)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSerial: %w", err)
 	}
-	if m.issuedBy != nil {
-		fields = append(fields, certificate.FieldIssuedBy)
+	return oldValue.Serial, nil
+}
+
+// ClearSerial clears the value of the "serial" field.
+func (m *CertificateMutation) ClearSerial() {
+	m.serial = nil
+	m.clearedFields[certificate.FieldSerial] = struct{}{}
+}
+
+// SerialCleared returns if the "serial" field was cleared in this mutation.
+func (m *CertificateMutation) SerialCleared() bool {
+	_, ok := m.clearedFields[certificate.FieldSerial]
+	return ok
+}
+
+// ResetSerial resets all changes to the "serial" field.
+func (m *CertificateMutation) ResetSerial() {
+	m.serial = nil
+	delete(m.clearedFields, certificate.FieldSerial)
+}
+
+// SetCommonName sets the "commonName" field.
+func (m *CertificateMutation) SetCommonName(s string) {
+	m.commonName = &s
+}
+
+// CommonName returns the value of the "commonName" field in the mutation.
+func (m *CertificateMutation) CommonName() (r string, exists bool) {
+	v := m.commonName
+	if v == nil {
+		return
 	}
-	if m.source != nil {
-		fields = append(fields, certificate.FieldSource)
+	return *v, true
+}
+
+// OldCommonName returns the old "commonName" field's value of the Certificate entity.
+// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertificateMutation) OldCommonName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCommonName is only allowed on UpdateOne operations")
 	}
-	if m.created != nil {
-		fields = append(fields, certificate.FieldCreated)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCommonName requires an ID field in the mutation")
 	}
-	if m.status != nil {
-		fields = append(fields, certificate.FieldStatus)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCommonName: %w", err)
 	}
-	if m.ca != nil {
-		fields = append(fields, certificate.FieldCa)
+	return oldValue.CommonName, nil
+}
+
+// ResetCommonName resets all changes to the "commonName" field.
+func (m *CertificateMutation) ResetCommonName() {
+	m.commonName = nil
+}
+
+// SetNotBefore sets the "notBefore" field.
+func (m *CertificateMutation) SetNotBefore(t time.Time) {
+	m.notBefore = &t
+}
+
+// NotBefore returns the value of the "notBefore" field in the mutation.
+func (m *CertificateMutation) NotBefore() (r time.Time, exists bool) {
+	v := m.notBefore
+	if v == nil {
+		return
 	}
-	if m.certificate != nil {
-		fields = append(fields, certificate.FieldCertificate)
+	return *v, true
+}
+
+// OldNotBefore returns the old "notBefore" field's value of the Certificate entity.
+// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertificateMutation) OldNotBefore(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNotBefore is only allowed on UpdateOne operations")
 	}
-	return fields
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNotBefore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNotBefore: %w", err)
+	}
+	return oldValue.NotBefore, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *CertificateMutation) Field(name string) (ent.Value, bool) {
-	switch name {
+// ClearNotBefore clears the value of the "notBefore" field.
+func (m *CertificateMutation) ClearNotBefore() {
+	m.notBefore = nil
+	m.clearedFields[certificate.FieldNotBefore] = struct{}{}
+}
+
+// NotBeforeCleared returns if the "notBefore" field was cleared in this mutation.
+func (m *CertificateMutation) NotBeforeCleared() bool {
+	_, ok := m.clearedFields[certificate.FieldNotBefore]
+	return ok
+}
+
+// ResetNotBefore resets all changes to the "notBefore" field.
+func (m *CertificateMutation) ResetNotBefore() {
+	m.notBefore = nil
+	delete(m.clearedFields, certificate.FieldNotBefore)
+}
+
+// SetNotAfter sets the "notAfter" field.
+func (m *CertificateMutation) SetNotAfter(t time.Time) {
+	m.notAfter = &t
+}
+
+// NotAfter returns the value of the "notAfter" field in the mutation.
+func (m *CertificateMutation) NotAfter() (r time.Time, exists bool) {
+	v := m.notAfter
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNotAfter returns the old "notAfter" field's value of the Certificate entity.
+// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertificateMutation) OldNotAfter(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNotAfter is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNotAfter requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNotAfter: %w", err)
+	}
+	return oldValue.NotAfter, nil
+}
+
+// ClearNotAfter clears the value of the "notAfter" field.
+func (m *CertificateMutation) ClearNotAfter() {
+	m.notAfter = nil
+	m.clearedFields[certificate.FieldNotAfter] = struct{}{}
+}
+
+// NotAfterCleared returns if the "notAfter" field was cleared in this mutation.
+func (m *CertificateMutation) NotAfterCleared() bool {
+	_, ok := m.clearedFields[certificate.FieldNotAfter]
+	return ok
+}
+
+// ResetNotAfter resets all changes to the "notAfter" field.
+func (m *CertificateMutation) ResetNotAfter() {
+	m.notAfter = nil
+	delete(m.clearedFields, certificate.FieldNotAfter)
+}
+
+// SetIssuedBy sets the "issuedBy" field.
+func (m *CertificateMutation) SetIssuedBy(s string) {
+	m.issuedBy = &s
+}
+
+// IssuedBy returns the value of the "issuedBy" field in the mutation.
+func (m *CertificateMutation) IssuedBy() (r string, exists bool) {
+	v := m.issuedBy
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIssuedBy returns the old "issuedBy" field's value of the Certificate entity.
+// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertificateMutation) OldIssuedBy(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIssuedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIssuedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIssuedBy: %w", err)
+	}
+	return oldValue.IssuedBy, nil
+}
+
+// ClearIssuedBy clears the value of the "issuedBy" field.
+func (m *CertificateMutation) ClearIssuedBy() {
+	m.issuedBy = nil
+	m.clearedFields[certificate.FieldIssuedBy] = struct{}{}
+}
+
+// IssuedByCleared returns if the "issuedBy" field was cleared in this mutation.
+func (m *CertificateMutation) IssuedByCleared() bool {
+	_, ok := m.clearedFields[certificate.FieldIssuedBy]
+	return ok
+}
+
+// ResetIssuedBy resets all changes to the "issuedBy" field.
+func (m *CertificateMutation) ResetIssuedBy() {
+	m.issuedBy = nil
+	delete(m.clearedFields, certificate.FieldIssuedBy)
+}
+
+// SetSource sets the "source" field.
+func (m *CertificateMutation) SetSource(s string) {
+	m.source = &s
+}
+
+// Source returns the value of the "source" field in the mutation.
+func (m *CertificateMutation) Source() (r string, exists bool) {
+	v := m.source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSource returns the old "source" field's value of the Certificate entity.
+// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertificateMutation) OldSource(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+	}
+	return oldValue.Source, nil
+}
+
+// ClearSource clears the value of the "source" field.
+func (m *CertificateMutation) ClearSource() {
+	m.source = nil
+	m.clearedFields[certificate.FieldSource] = struct{}{}
+}
+
+// SourceCleared returns if the "source" field was cleared in this mutation.
+func (m *CertificateMutation) SourceCleared() bool {
+	_, ok := m.clearedFields[certificate.FieldSource]
+	return ok
+}
+
+// ResetSource resets all changes to the "source" field.
+func (m *CertificateMutation) ResetSource() {
+	m.source = nil
+	delete(m.clearedFields, certificate.FieldSource)
+}
+
+// SetCreated sets the "created" field.
+func (m *CertificateMutation) SetCreated(t time.Time) {
+	m.created = &t
+}
+
+// Created returns the value of the "created" field in the mutation.
+func (m *CertificateMutation) Created() (r time.Time, exists bool) {
+	v := m.created
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreated returns the old "created" field's value of the Certificate entity.
+// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertificateMutation) OldCreated(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreated is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreated requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreated: %w", err)
+	}
+	return oldValue.Created, nil
+}
+
+// ClearCreated clears the value of the "created" field.
+func (m *CertificateMutation) ClearCreated() {
+	m.created = nil
+	m.clearedFields[certificate.FieldCreated] = struct{}{}
+}
+
+// CreatedCleared returns if the "created" field was cleared in this mutation.
+func (m *CertificateMutation) CreatedCleared() bool {
+	_, ok := m.clearedFields[certificate.FieldCreated]
+	return ok
+}
+
+// ResetCreated resets all changes to the "created" field.
+func (m *CertificateMutation) ResetCreated() {
+	m.created = nil
+	delete(m.clearedFields, certificate.FieldCreated)
+}
+
+// SetStatus sets the "status" field.
+func (m *CertificateMutation) SetStatus(c certificate.Status) {
+	m.status = &c
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *CertificateMutation) Status() (r certificate.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Certificate entity.
+// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertificateMutation) OldStatus(ctx context.Context) (v certificate.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *CertificateMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetCa sets the "ca" field.
+func (m *CertificateMutation) SetCa(s string) {
+	m.ca = &s
+}
+
+// Ca returns the value of the "ca" field in the mutation.
+func (m *CertificateMutation) Ca() (r string, exists bool) {
+	v := m.ca
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCa returns the old "ca" field's value of the Certificate entity.
+// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertificateMutation) OldCa(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCa is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCa requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCa: %w", err)
+	}
+	return oldValue.Ca, nil
+}
+
+// ClearCa clears the value of the "ca" field.
+func (m *CertificateMutation) ClearCa() {
+	m.ca = nil
+	m.clearedFields[certificate.FieldCa] = struct{}{}
+}
+
+// CaCleared returns if the "ca" field was cleared in this mutation.
+func (m *CertificateMutation) CaCleared() bool {
+	_, ok := m.clearedFields[certificate.FieldCa]
+	return ok
+}
+
+// ResetCa resets all changes to the "ca" field.
+func (m *CertificateMutation) ResetCa() {
+	m.ca = nil
+	delete(m.clearedFields, certificate.FieldCa)
+}
+
+// SetCertificate sets the "certificate" field.
+func (m *CertificateMutation) SetCertificate(s string) {
+	m.certificate = &s
+}
+
+// Certificate returns the value of the "certificate" field in the mutation.
+func (m *CertificateMutation) Certificate() (r string, exists bool) {
+	v := m.certificate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCertificate returns the old "certificate" field's value of the Certificate entity.
+// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertificateMutation) OldCertificate(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCertificate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCertificate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCertificate: %w", err)
+	}
+	return oldValue.Certificate, nil
+}
+
+// ClearCertificate clears the value of the "certificate" field.
+func (m *CertificateMutation) ClearCertificate() {
+	m.certificate = nil
+	m.clearedFields[certificate.FieldCertificate] = struct{}{}
+}
+
+// CertificateCleared returns if the "certificate" field was cleared in this mutation.
+func (m *CertificateMutation) CertificateCleared() bool {
+	_, ok := m.clearedFields[certificate.FieldCertificate]
+	return ok
+}
+
+// ResetCertificate resets all changes to the "certificate" field.
+func (m *CertificateMutation) ResetCertificate() {
+	m.certificate = nil
+	delete(m.clearedFields, certificate.FieldCertificate)
+}
+
+// SetCaReason sets the "caReason" field.
+func (m *CertificateMutation) SetCaReason(s string) {
+	m.caReason = &s
+}
+
+// CaReason returns the value of the "caReason" field in the mutation.
+func (m *CertificateMutation) CaReason() (r string, exists bool) {
+	v := m.caReason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCaReason returns the old "caReason" field's value of the Certificate entity.
+// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertificateMutation) OldCaReason(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCaReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCaReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCaReason: %w", err)
+	}
+	return oldValue.CaReason, nil
+}
+
+// ClearCaReason clears the value of the "caReason" field.
+func (m *CertificateMutation) ClearCaReason() {
+	m.caReason = nil
+	m.clearedFields[certificate.FieldCaReason] = struct{}{}
+}
+
+// CaReasonCleared returns if the "caReason" field was cleared in this mutation.
+func (m *CertificateMutation) CaReasonCleared() bool {
+	_, ok := m.clearedFields[certificate.FieldCaReason]
+	return ok
+}
+
+// ResetCaReason resets all changes to the "caReason" field.
+func (m *CertificateMutation) ResetCaReason() {
+	m.caReason = nil
+	delete(m.clearedFields, certificate.FieldCaReason)
+}
+
+// SetCsr sets the "csr" field.
+func (m *CertificateMutation) SetCsr(s string) {
+	m.csr = &s
+}
+
+// Csr returns the value of the "csr" field in the mutation.
+func (m *CertificateMutation) Csr() (r string, exists bool) {
+	v := m.csr
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCsr returns the old "csr" field's value of the Certificate entity.
+// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertificateMutation) OldCsr(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCsr is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCsr requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCsr: %w", err)
+	}
+	return oldValue.Csr, nil
+}
+
+// ClearCsr clears the value of the "csr" field.
+func (m *CertificateMutation) ClearCsr() {
+	m.csr = nil
+	m.clearedFields[certificate.FieldCsr] = struct{}{}
+}
+
+// CsrCleared returns if the "csr" field was cleared in this mutation.
+func (m *CertificateMutation) CsrCleared() bool {
+	_, ok := m.clearedFields[certificate.FieldCsr]
+	return ok
+}
+
+// ResetCsr resets all changes to the "csr" field.
+func (m *CertificateMutation) ResetCsr() {
+	m.csr = nil
+	delete(m.clearedFields, certificate.FieldCsr)
+}
+
+// SetOrganization sets the "organization" field.
+func (m *CertificateMutation) SetOrganization(s string) {
+	m.organization = &s
+}
+
+// Organization returns the value of the "organization" field in the mutation.
+func (m *CertificateMutation) Organization() (r string, exists bool) {
+	v := m.organization
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrganization returns the old "organization" field's value of the Certificate entity.
+// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertificateMutation) OldOrganization(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrganization is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrganization requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrganization: %w", err)
+	}
+	return oldValue.Organization, nil
+}
+
+// ClearOrganization clears the value of the "organization" field.
+func (m *CertificateMutation) ClearOrganization() {
+	m.organization = nil
+	m.clearedFields[certificate.FieldOrganization] = struct{}{}
+}
+
+// OrganizationCleared returns if the "organization" field was cleared in this mutation.
+func (m *CertificateMutation) OrganizationCleared() bool {
+	_, ok := m.clearedFields[certificate.FieldOrganization]
+	return ok
+}
+
+// ResetOrganization resets all changes to the "organization" field.
+func (m *CertificateMutation) ResetOrganization() {
+	m.organization = nil
+	delete(m.clearedFields, certificate.FieldOrganization)
+}
+
+// SetCertType sets the "certType" field.
+func (m *CertificateMutation) SetCertType(s string) {
+	m.certType = &s
+}
+
+// CertType returns the value of the "certType" field in the mutation.
+func (m *CertificateMutation) CertType() (r string, exists bool) {
+	v := m.certType
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCertType returns the old "certType" field's value of the Certificate entity.
+// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertificateMutation) OldCertType(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCertType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCertType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCertType: %w", err)
+	}
+	return oldValue.CertType, nil
+}
+
+// ClearCertType clears the value of the "certType" field.
+func (m *CertificateMutation) ClearCertType() {
+	m.certType = nil
+	m.clearedFields[certificate.FieldCertType] = struct{}{}
+}
+
+// CertTypeCleared returns if the "certType" field was cleared in this mutation.
+func (m *CertificateMutation) CertTypeCleared() bool {
+	_, ok := m.clearedFields[certificate.FieldCertType]
+	return ok
+}
+
+// ResetCertType resets all changes to the "certType" field.
+func (m *CertificateMutation) ResetCertType() {
+	m.certType = nil
+	delete(m.clearedFields, certificate.FieldCertType)
+}
+
+// AddDomainIDs adds the "domains" edge to the Domain entity by ids.
+func (m *CertificateMutation) AddDomainIDs(ids ...int) {
+	if m.domains == nil {
+		m.domains = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.domains[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDomains clears the "domains" edge to the Domain entity.
+func (m *CertificateMutation) ClearDomains() {
+	m.cleareddomains = true
+}
+
+// DomainsCleared reports if the "domains" edge to the Domain entity was cleared.
+func (m *CertificateMutation) DomainsCleared() bool {
+	return m.cleareddomains
+}
+
+// RemoveDomainIDs removes the "domains" edge to the Domain entity by IDs.
+func (m *CertificateMutation) RemoveDomainIDs(ids ...int) {
+	if m.removeddomains == nil {
+		m.removeddomains = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.domains, ids[i])
+		m.removeddomains[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDomains returns the removed IDs of the "domains" edge to the Domain entity.
+func (m *CertificateMutation) RemovedDomainsIDs() (ids []int) {
+	for id := range m.removeddomains {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DomainsIDs returns the "domains" edge IDs in the mutation.
+func (m *CertificateMutation) DomainsIDs() (ids []int) {
+	for id := range m.domains {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDomains resets all changes to the "domains" edge.
+func (m *CertificateMutation) ResetDomains() {
+	m.domains = nil
+	m.cleareddomains = false
+	m.removeddomains = nil
+}
+
+// AddAcmeOrderIDs adds the "acmeOrders" edge to the AcmeOrder entity by ids.
+func (m *CertificateMutation) AddAcmeOrderIDs(ids ...int) {
+	if m.acmeOrders == nil {
+		m.acmeOrders = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.acmeOrders[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAcmeOrders clears the "acmeOrders" edge to the AcmeOrder entity.
+func (m *CertificateMutation) ClearAcmeOrders() {
+	m.clearedacmeOrders = true
+}
+
+// AcmeOrdersCleared reports if the "acmeOrders" edge to the AcmeOrder entity was cleared.
+func (m *CertificateMutation) AcmeOrdersCleared() bool {
+	return m.clearedacmeOrders
+}
+
+// RemoveAcmeOrderIDs removes the "acmeOrders" edge to the AcmeOrder entity by IDs.
+func (m *CertificateMutation) RemoveAcmeOrderIDs(ids ...int) {
+	if m.removedacmeOrders == nil {
+		m.removedacmeOrders = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.acmeOrders, ids[i])
+		m.removedacmeOrders[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAcmeOrders returns the removed IDs of the "acmeOrders" edge to the AcmeOrder entity.
+func (m *CertificateMutation) RemovedAcmeOrdersIDs() (ids []int) {
+	for id := range m.removedacmeOrders {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AcmeOrdersIDs returns the "acmeOrders" edge IDs in the mutation.
+func (m *CertificateMutation) AcmeOrdersIDs() (ids []int) {
+	for id := range m.acmeOrders {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAcmeOrders resets all changes to the "acmeOrders" edge.
+func (m *CertificateMutation) ResetAcmeOrders() {
+	m.acmeOrders = nil
+	m.clearedacmeOrders = false
+	m.removedacmeOrders = nil
+}
+
+// Where appends a list predicates to the CertificateMutation builder.
+func (m *CertificateMutation) Where(ps ...predicate.Certificate) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CertificateMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CertificateMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Certificate, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CertificateMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CertificateMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Certificate).
+func (m *CertificateMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CertificateMutation) Fields() []string {
+	fields := make([]string, 0, 18)
+	if m.create_time != nil {
+		fields = append(fields, certificate.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, certificate.FieldUpdateTime)
+	}
+	if m.sslId != nil {
+		fields = append(fields, certificate.FieldSslId)
+	}
+	if m.transactionId != nil {
+		fields = append(fields, certificate.FieldTransactionId)
+	}
+	if m.serial != nil {
+		fields = append(fields, certificate.FieldSerial)
+	}
+	if m.commonName != nil {
+		fields = append(fields, certificate.FieldCommonName)
+	}
+	if m.notBefore != nil {
+		fields = append(fields, certificate.FieldNotBefore)
+	}
+	if m.notAfter != nil {
+		fields = append(fields, certificate.FieldNotAfter)
+	}
+	if m.issuedBy != nil {
+		fields = append(fields, certificate.FieldIssuedBy)
+	}
+	if m.source != nil {
+		fields = append(fields, certificate.FieldSource)
+	}
+	if m.created != nil {
+		fields = append(fields, certificate.FieldCreated)
+	}
+	if m.status != nil {
+		fields = append(fields, certificate.FieldStatus)
+	}
+	if m.ca != nil {
+		fields = append(fields, certificate.FieldCa)
+	}
+	if m.certificate != nil {
+		fields = append(fields, certificate.FieldCertificate)
+	}
+	if m.caReason != nil {
+		fields = append(fields, certificate.FieldCaReason)
+	}
+	if m.csr != nil {
+		fields = append(fields, certificate.FieldCsr)
+	}
+	if m.organization != nil {
+		fields = append(fields, certificate.FieldOrganization)
+	}
+	if m.certType != nil {
+		fields = append(fields, certificate.FieldCertType)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CertificateMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case certificate.FieldCreateTime:
+		return m.CreateTime()
+	case certificate.FieldUpdateTime:
+		return m.UpdateTime()
+	case certificate.FieldSslId:
+		return m.SslId()
+	case certificate.FieldTransactionId:
+		return m.TransactionId()
+	case certificate.FieldSerial:
+		return m.Serial()
+	case certificate.FieldCommonName:
+		return m.CommonName()
+	case certificate.FieldNotBefore:
+		return m.NotBefore()
+	case certificate.FieldNotAfter:
+		return m.NotAfter()
+	case certificate.FieldIssuedBy:
+		return m.IssuedBy()
+	case certificate.FieldSource:
+		return m.Source()
+	case certificate.FieldCreated:
+		return m.Created()
+	case certificate.FieldStatus:
+		return m.Status()
+	case certificate.FieldCa:
+		return m.Ca()
+	case certificate.FieldCertificate:
+		return m.Certificate()
+	case certificate.FieldCaReason:
+		return m.CaReason()
+	case certificate.FieldCsr:
+		return m.Csr()
+	case certificate.FieldOrganization:
+		return m.Organization()
+	case certificate.FieldCertType:
+		return m.CertType()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CertificateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case certificate.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case certificate.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case certificate.FieldSslId:
+		return m.OldSslId(ctx)
+	case certificate.FieldTransactionId:
+		return m.OldTransactionId(ctx)
+	case certificate.FieldSerial:
+		return m.OldSerial(ctx)
+	case certificate.FieldCommonName:
+		return m.OldCommonName(ctx)
+	case certificate.FieldNotBefore:
+		return m.OldNotBefore(ctx)
+	case certificate.FieldNotAfter:
+		return m.OldNotAfter(ctx)
+	case certificate.FieldIssuedBy:
+		return m.OldIssuedBy(ctx)
+	case certificate.FieldSource:
+		return m.OldSource(ctx)
+	case certificate.FieldCreated:
+		return m.OldCreated(ctx)
+	case certificate.FieldStatus:
+		return m.OldStatus(ctx)
+	case certificate.FieldCa:
+		return m.OldCa(ctx)
+	case certificate.FieldCertificate:
+		return m.OldCertificate(ctx)
+	case certificate.FieldCaReason:
+		return m.OldCaReason(ctx)
+	case certificate.FieldCsr:
+		return m.OldCsr(ctx)
+	case certificate.FieldOrganization:
+		return m.OldOrganization(ctx)
+	case certificate.FieldCertType:
+		return m.OldCertType(ctx)
+	}
+	return nil, fmt.Errorf("unknown Certificate field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CertificateMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case certificate.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case certificate.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case certificate.FieldSslId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSslId(v)
+		return nil
+	case certificate.FieldTransactionId:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTransactionId(v)
+		return nil
+	case certificate.FieldSerial:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSerial(v)
+		return nil
+	case certificate.FieldCommonName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCommonName(v)
+		return nil
+	case certificate.FieldNotBefore:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNotBefore(v)
+		return nil
+	case certificate.FieldNotAfter:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNotAfter(v)
+		return nil
+	case certificate.FieldIssuedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIssuedBy(v)
+		return nil
+	case certificate.FieldSource:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSource(v)
+		return nil
+	case certificate.FieldCreated:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreated(v)
+		return nil
+	case certificate.FieldStatus:
+		v, ok := value.(certificate.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case certificate.FieldCa:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCa(v)
+		return nil
+	case certificate.FieldCertificate:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCertificate(v)
+		return nil
+	case certificate.FieldCaReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCaReason(v)
+		return nil
+	case certificate.FieldCsr:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCsr(v)
+		return nil
+	case certificate.FieldOrganization:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrganization(v)
+		return nil
+	case certificate.FieldCertType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCertType(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Certificate field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CertificateMutation) AddedFields() []string {
+	var fields []string
+	if m.addsslId != nil {
+		fields = append(fields, certificate.FieldSslId)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CertificateMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case certificate.FieldSslId:
+		return m.AddedSslId()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CertificateMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case certificate.FieldSslId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSslId(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Certificate numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CertificateMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(certificate.FieldSslId) {
+		fields = append(fields, certificate.FieldSslId)
+	}
+	if m.FieldCleared(certificate.FieldTransactionId) {
+		fields = append(fields, certificate.FieldTransactionId)
+	}
+	if m.FieldCleared(certificate.FieldSerial) {
+		fields = append(fields, certificate.FieldSerial)
+	}
+	if m.FieldCleared(certificate.FieldNotBefore) {
+		fields = append(fields, certificate.FieldNotBefore)
+	}
+	if m.FieldCleared(certificate.FieldNotAfter) {
+		fields = append(fields, certificate.FieldNotAfter)
+	}
+	if m.FieldCleared(certificate.FieldIssuedBy) {
+		fields = append(fields, certificate.FieldIssuedBy)
+	}
+	if m.FieldCleared(certificate.FieldSource) {
+		fields = append(fields, certificate.FieldSource)
+	}
+	if m.FieldCleared(certificate.FieldCreated) {
+		fields = append(fields, certificate.FieldCreated)
+	}
+	if m.FieldCleared(certificate.FieldCa) {
+		fields = append(fields, certificate.FieldCa)
+	}
+	if m.FieldCleared(certificate.FieldCertificate) {
+		fields = append(fields, certificate.FieldCertificate)
+	}
+	if m.FieldCleared(certificate.FieldCaReason) {
+		fields = append(fields, certificate.FieldCaReason)
+	}
+	if m.FieldCleared(certificate.FieldCsr) {
+		fields = append(fields, certificate.FieldCsr)
+	}
+	if m.FieldCleared(certificate.FieldOrganization) {
+		fields = append(fields, certificate.FieldOrganization)
+	}
+	if m.FieldCleared(certificate.FieldCertType) {
+		fields = append(fields, certificate.FieldCertType)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CertificateMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CertificateMutation) ClearField(name string) error {
+	switch name {
+	case certificate.FieldSslId:
+		m.ClearSslId()
+		return nil
+	case certificate.FieldTransactionId:
+		m.ClearTransactionId()
+		return nil
+	case certificate.FieldSerial:
+		m.ClearSerial()
+		return nil
+	case certificate.FieldNotBefore:
+		m.ClearNotBefore()
+		return nil
+	case certificate.FieldNotAfter:
+		m.ClearNotAfter()
+		return nil
+	case certificate.FieldIssuedBy:
+		m.ClearIssuedBy()
+		return nil
+	case certificate.FieldSource:
+		m.ClearSource()
+		return nil
+	case certificate.FieldCreated:
+		m.ClearCreated()
+		return nil
+	case certificate.FieldCa:
+		m.ClearCa()
+		return nil
+	case certificate.FieldCertificate:
+		m.ClearCertificate()
+		return nil
+	case certificate.FieldCaReason:
+		m.ClearCaReason()
+		return nil
+	case certificate.FieldCsr:
+		m.ClearCsr()
+		return nil
+	case certificate.FieldOrganization:
+		m.ClearOrganization()
+		return nil
+	case certificate.FieldCertType:
+		m.ClearCertType()
+		return nil
+	}
+	return fmt.Errorf("unknown Certificate nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CertificateMutation) ResetField(name string) error {
+	switch name {
 	case certificate.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case certificate.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case certificate.FieldSslId:
+		m.ResetSslId()
+		return nil
+	case certificate.FieldTransactionId:
+		m.ResetTransactionId()
+		return nil
+	case certificate.FieldSerial:
+		m.ResetSerial()
+		return nil
+	case certificate.FieldCommonName:
+		m.ResetCommonName()
+		return nil
+	case certificate.FieldNotBefore:
+		m.ResetNotBefore()
+		return nil
+	case certificate.FieldNotAfter:
+		m.ResetNotAfter()
+		return nil
+	case certificate.FieldIssuedBy:
+		m.ResetIssuedBy()
+		return nil
+	case certificate.FieldSource:
+		m.ResetSource()
+		return nil
+	case certificate.FieldCreated:
+		m.ResetCreated()
+		return nil
+	case certificate.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case certificate.FieldCa:
+		m.ResetCa()
+		return nil
+	case certificate.FieldCertificate:
+		m.ResetCertificate()
+		return nil
+	case certificate.FieldCaReason:
+		m.ResetCaReason()
+		return nil
+	case certificate.FieldCsr:
+		m.ResetCsr()
+		return nil
+	case certificate.FieldOrganization:
+		m.ResetOrganization()
+		return nil
+	case certificate.FieldCertType:
+		m.ResetCertType()
+		return nil
+	}
+	return fmt.Errorf("unknown Certificate field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CertificateMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.domains != nil {
+		edges = append(edges, certificate.EdgeDomains)
+	}
+	if m.acmeOrders != nil {
+		edges = append(edges, certificate.EdgeAcmeOrders)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CertificateMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case certificate.EdgeDomains:
+		ids := make([]ent.Value, 0, len(m.domains))
+		for id := range m.domains {
+			ids = append(ids, id)
+		}
+		return ids
+	case certificate.EdgeAcmeOrders:
+		ids := make([]ent.Value, 0, len(m.acmeOrders))
+		for id := range m.acmeOrders {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CertificateMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removeddomains != nil {
+		edges = append(edges, certificate.EdgeDomains)
+	}
+	if m.removedacmeOrders != nil {
+		edges = append(edges, certificate.EdgeAcmeOrders)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CertificateMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case certificate.EdgeDomains:
+		ids := make([]ent.Value, 0, len(m.removeddomains))
+		for id := range m.removeddomains {
+			ids = append(ids, id)
+		}
+		return ids
+	case certificate.EdgeAcmeOrders:
+		ids := make([]ent.Value, 0, len(m.removedacmeOrders))
+		for id := range m.removedacmeOrders {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CertificateMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareddomains {
+		edges = append(edges, certificate.EdgeDomains)
+	}
+	if m.clearedacmeOrders {
+		edges = append(edges, certificate.EdgeAcmeOrders)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CertificateMutation) EdgeCleared(name string) bool {
+	switch name {
+	case certificate.EdgeDomains:
+		return m.cleareddomains
+	case certificate.EdgeAcmeOrders:
+		return m.clearedacmeOrders
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CertificateMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Certificate unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CertificateMutation) ResetEdge(name string) error {
+	switch name {
+	case certificate.EdgeDomains:
+		m.ResetDomains()
+		return nil
+	case certificate.EdgeAcmeOrders:
+		m.ResetAcmeOrders()
+		return nil
+	}
+	return fmt.Errorf("unknown Certificate edge %s", name)
+}
+
+// DomainMutation represents an operation that mutates the Domain nodes in the graph.
+type DomainMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *int
+	fqdn                *string
+	clearedFields       map[string]struct{}
+	certificates        map[int]struct{}
+	removedcertificates map[int]struct{}
+	clearedcertificates bool
+	done                bool
+	oldValue            func(context.Context) (*Domain, error)
+	predicates          []predicate.Domain
+}
+
+var _ ent.Mutation = (*DomainMutation)(nil)
+
+// domainOption allows management of the mutation configuration using functional options.
+type domainOption func(*DomainMutation)
+
+// newDomainMutation creates new mutation for the Domain entity.
+func newDomainMutation(c config, op Op, opts ...domainOption) *DomainMutation {
+	m := &DomainMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDomain,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDomainID sets the ID field of the mutation.
+func withDomainID(id int) domainOption {
+	return func(m *DomainMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Domain
+		)
+		m.oldValue = func(ctx context.Context) (*Domain, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Domain.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDomain sets the old Domain of the mutation.
+func withDomain(node *Domain) domainOption {
+	return func(m *DomainMutation) {
+		m.oldValue = func(context.Context) (*Domain, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DomainMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DomainMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DomainMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DomainMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Domain.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetFqdn sets the "fqdn" field.
+func (m *DomainMutation) SetFqdn(s string) {
+	m.fqdn = &s
+}
+
+// Fqdn returns the value of the "fqdn" field in the mutation.
+func (m *DomainMutation) Fqdn() (r string, exists bool) {
+	v := m.fqdn
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFqdn returns the old "fqdn" field's value of the Domain entity.
+// If the Domain object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DomainMutation) OldFqdn(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFqdn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFqdn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFqdn: %w", err)
+	}
+	return oldValue.Fqdn, nil
+}
+
+// ResetFqdn resets all changes to the "fqdn" field.
+func (m *DomainMutation) ResetFqdn() {
+	m.fqdn = nil
+}
+
+// AddCertificateIDs adds the "certificates" edge to the Certificate entity by ids.
+func (m *DomainMutation) AddCertificateIDs(ids ...int) {
+	if m.certificates == nil {
+		m.certificates = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.certificates[ids[i]] = struct{}{}
+	}
+}
+
+// ClearCertificates clears the "certificates" edge to the Certificate entity.
+func (m *DomainMutation) ClearCertificates() {
+	m.clearedcertificates = true
+}
+
+// CertificatesCleared reports if the "certificates" edge to the Certificate entity was cleared.
+func (m *DomainMutation) CertificatesCleared() bool {
+	return m.clearedcertificates
+}
+
+// RemoveCertificateIDs removes the "certificates" edge to the Certificate entity by IDs.
+func (m *DomainMutation) RemoveCertificateIDs(ids ...int) {
+	if m.removedcertificates == nil {
+		m.removedcertificates = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.certificates, ids[i])
+		m.removedcertificates[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedCertificates returns the removed IDs of the "certificates" edge to the Certificate entity.
+func (m *DomainMutation) RemovedCertificatesIDs() (ids []int) {
+	for id := range m.removedcertificates {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CertificatesIDs returns the "certificates" edge IDs in the mutation.
+func (m *DomainMutation) CertificatesIDs() (ids []int) {
+	for id := range m.certificates {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCertificates resets all changes to the "certificates" edge.
+func (m *DomainMutation) ResetCertificates() {
+	m.certificates = nil
+	m.clearedcertificates = false
+	m.removedcertificates = nil
+}
+
+// Where appends a list predicates to the DomainMutation builder.
+func (m *DomainMutation) Where(ps ...predicate.Domain) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DomainMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DomainMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Domain, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DomainMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DomainMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Domain).
+func (m *DomainMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DomainMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.fqdn != nil {
+		fields = append(fields, domain.FieldFqdn)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DomainMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case domain.FieldFqdn:
+		return m.Fqdn()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DomainMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case domain.FieldFqdn:
+		return m.OldFqdn(ctx)
+	}
+	return nil, fmt.Errorf("unknown Domain field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DomainMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case domain.FieldFqdn:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFqdn(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Domain field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DomainMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DomainMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DomainMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Domain numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DomainMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DomainMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DomainMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Domain nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DomainMutation) ResetField(name string) error {
+	switch name {
+	case domain.FieldFqdn:
+		m.ResetFqdn()
+		return nil
+	}
+	return fmt.Errorf("unknown Domain field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DomainMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.certificates != nil {
+		edges = append(edges, domain.EdgeCertificates)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DomainMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case domain.EdgeCertificates:
+		ids := make([]ent.Value, 0, len(m.certificates))
+		for id := range m.certificates {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DomainMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedcertificates != nil {
+		edges = append(edges, domain.EdgeCertificates)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DomainMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case domain.EdgeCertificates:
+		ids := make([]ent.Value, 0, len(m.removedcertificates))
+		for id := range m.removedcertificates {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DomainMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedcertificates {
+		edges = append(edges, domain.EdgeCertificates)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DomainMutation) EdgeCleared(name string) bool {
+	switch name {
+	case domain.EdgeCertificates:
+		return m.clearedcertificates
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DomainMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Domain unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DomainMutation) ResetEdge(name string) error {
+	switch name {
+	case domain.EdgeCertificates:
+		m.ResetCertificates()
+		return nil
+	}
+	return fmt.Errorf("unknown Domain edge %s", name)
+}
+
+// EmailVerificationMutation represents an operation that mutates the EmailVerification nodes in the graph.
+type EmailVerificationMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	create_time   *time.Time
+	update_time   *time.Time
+	user          *string
+	email         *string
+	codeHash      *string
+	codeExpires   *time.Time
+	attempts      *int
+	addattempts   *int
+	verifiedAt    *time.Time
+	validUntil    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*EmailVerification, error)
+	predicates    []predicate.EmailVerification
+}
+
+var _ ent.Mutation = (*EmailVerificationMutation)(nil)
+
+// emailverificationOption allows management of the mutation configuration using functional options.
+type emailverificationOption func(*EmailVerificationMutation)
+
+// newEmailVerificationMutation creates new mutation for the EmailVerification entity.
+func newEmailVerificationMutation(c config, op Op, opts ...emailverificationOption) *EmailVerificationMutation {
+	m := &EmailVerificationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEmailVerification,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withEmailVerificationID sets the ID field of the mutation.
+func withEmailVerificationID(id int) emailverificationOption {
+	return func(m *EmailVerificationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *EmailVerification
+		)
+		m.oldValue = func(ctx context.Context) (*EmailVerification, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().EmailVerification.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withEmailVerification sets the old EmailVerification of the mutation.
+func withEmailVerification(node *EmailVerification) emailverificationOption {
+	return func(m *EmailVerificationMutation) {
+		m.oldValue = func(context.Context) (*EmailVerification, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EmailVerificationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EmailVerificationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EmailVerificationMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EmailVerificationMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().EmailVerification.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *EmailVerificationMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *EmailVerificationMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the EmailVerification entity.
+// If the EmailVerification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailVerificationMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *EmailVerificationMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *EmailVerificationMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *EmailVerificationMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the EmailVerification entity.
+// If the EmailVerification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailVerificationMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *EmailVerificationMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetUser sets the "user" field.
+func (m *EmailVerificationMutation) SetUser(s string) {
+	m.user = &s
+}
+
+// User returns the value of the "user" field in the mutation.
+func (m *EmailVerificationMutation) User() (r string, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUser returns the old "user" field's value of the EmailVerification entity.
+// If the EmailVerification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailVerificationMutation) OldUser(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUser is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUser requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUser: %w", err)
+	}
+	return oldValue.User, nil
+}
+
+// ResetUser resets all changes to the "user" field.
+func (m *EmailVerificationMutation) ResetUser() {
+	m.user = nil
+}
+
+// SetEmail sets the "email" field.
+func (m *EmailVerificationMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *EmailVerificationMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the EmailVerification entity.
+// If the EmailVerification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailVerificationMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *EmailVerificationMutation) ResetEmail() {
+	m.email = nil
+}
+
+// SetCodeHash sets the "codeHash" field.
+func (m *EmailVerificationMutation) SetCodeHash(s string) {
+	m.codeHash = &s
+}
+
+// CodeHash returns the value of the "codeHash" field in the mutation.
+func (m *EmailVerificationMutation) CodeHash() (r string, exists bool) {
+	v := m.codeHash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCodeHash returns the old "codeHash" field's value of the EmailVerification entity.
+// If the EmailVerification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailVerificationMutation) OldCodeHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCodeHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCodeHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCodeHash: %w", err)
+	}
+	return oldValue.CodeHash, nil
+}
+
+// ClearCodeHash clears the value of the "codeHash" field.
+func (m *EmailVerificationMutation) ClearCodeHash() {
+	m.codeHash = nil
+	m.clearedFields[emailverification.FieldCodeHash] = struct{}{}
+}
+
+// CodeHashCleared returns if the "codeHash" field was cleared in this mutation.
+func (m *EmailVerificationMutation) CodeHashCleared() bool {
+	_, ok := m.clearedFields[emailverification.FieldCodeHash]
+	return ok
+}
+
+// ResetCodeHash resets all changes to the "codeHash" field.
+func (m *EmailVerificationMutation) ResetCodeHash() {
+	m.codeHash = nil
+	delete(m.clearedFields, emailverification.FieldCodeHash)
+}
+
+// SetCodeExpires sets the "codeExpires" field.
+func (m *EmailVerificationMutation) SetCodeExpires(t time.Time) {
+	m.codeExpires = &t
+}
+
+// CodeExpires returns the value of the "codeExpires" field in the mutation.
+func (m *EmailVerificationMutation) CodeExpires() (r time.Time, exists bool) {
+	v := m.codeExpires
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCodeExpires returns the old "codeExpires" field's value of the EmailVerification entity.
+// If the EmailVerification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailVerificationMutation) OldCodeExpires(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCodeExpires is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCodeExpires requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCodeExpires: %w", err)
+	}
+	return oldValue.CodeExpires, nil
+}
+
+// ClearCodeExpires clears the value of the "codeExpires" field.
+func (m *EmailVerificationMutation) ClearCodeExpires() {
+	m.codeExpires = nil
+	m.clearedFields[emailverification.FieldCodeExpires] = struct{}{}
+}
+
+// CodeExpiresCleared returns if the "codeExpires" field was cleared in this mutation.
+func (m *EmailVerificationMutation) CodeExpiresCleared() bool {
+	_, ok := m.clearedFields[emailverification.FieldCodeExpires]
+	return ok
+}
+
+// ResetCodeExpires resets all changes to the "codeExpires" field.
+func (m *EmailVerificationMutation) ResetCodeExpires() {
+	m.codeExpires = nil
+	delete(m.clearedFields, emailverification.FieldCodeExpires)
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *EmailVerificationMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *EmailVerificationMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the EmailVerification entity.
+// If the EmailVerification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailVerificationMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (m *EmailVerificationMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *EmailVerificationMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *EmailVerificationMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetVerifiedAt sets the "verifiedAt" field.
+func (m *EmailVerificationMutation) SetVerifiedAt(t time.Time) {
+	m.verifiedAt = &t
+}
+
+// VerifiedAt returns the value of the "verifiedAt" field in the mutation.
+func (m *EmailVerificationMutation) VerifiedAt() (r time.Time, exists bool) {
+	v := m.verifiedAt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVerifiedAt returns the old "verifiedAt" field's value of the EmailVerification entity.
+// If the EmailVerification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailVerificationMutation) OldVerifiedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVerifiedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVerifiedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVerifiedAt: %w", err)
+	}
+	return oldValue.VerifiedAt, nil
+}
+
+// ClearVerifiedAt clears the value of the "verifiedAt" field.
+func (m *EmailVerificationMutation) ClearVerifiedAt() {
+	m.verifiedAt = nil
+	m.clearedFields[emailverification.FieldVerifiedAt] = struct{}{}
+}
+
+// VerifiedAtCleared returns if the "verifiedAt" field was cleared in this mutation.
+func (m *EmailVerificationMutation) VerifiedAtCleared() bool {
+	_, ok := m.clearedFields[emailverification.FieldVerifiedAt]
+	return ok
+}
+
+// ResetVerifiedAt resets all changes to the "verifiedAt" field.
+func (m *EmailVerificationMutation) ResetVerifiedAt() {
+	m.verifiedAt = nil
+	delete(m.clearedFields, emailverification.FieldVerifiedAt)
+}
+
+// SetValidUntil sets the "validUntil" field.
+func (m *EmailVerificationMutation) SetValidUntil(t time.Time) {
+	m.validUntil = &t
+}
+
+// ValidUntil returns the value of the "validUntil" field in the mutation.
+func (m *EmailVerificationMutation) ValidUntil() (r time.Time, exists bool) {
+	v := m.validUntil
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValidUntil returns the old "validUntil" field's value of the EmailVerification entity.
+// If the EmailVerification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailVerificationMutation) OldValidUntil(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValidUntil is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValidUntil requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValidUntil: %w", err)
+	}
+	return oldValue.ValidUntil, nil
+}
+
+// ClearValidUntil clears the value of the "validUntil" field.
+func (m *EmailVerificationMutation) ClearValidUntil() {
+	m.validUntil = nil
+	m.clearedFields[emailverification.FieldValidUntil] = struct{}{}
+}
+
+// ValidUntilCleared returns if the "validUntil" field was cleared in this mutation.
+func (m *EmailVerificationMutation) ValidUntilCleared() bool {
+	_, ok := m.clearedFields[emailverification.FieldValidUntil]
+	return ok
+}
+
+// ResetValidUntil resets all changes to the "validUntil" field.
+func (m *EmailVerificationMutation) ResetValidUntil() {
+	m.validUntil = nil
+	delete(m.clearedFields, emailverification.FieldValidUntil)
+}
+
+// Where appends a list predicates to the EmailVerificationMutation builder.
+func (m *EmailVerificationMutation) Where(ps ...predicate.EmailVerification) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EmailVerificationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EmailVerificationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.EmailVerification, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EmailVerificationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EmailVerificationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (EmailVerification).
+func (m *EmailVerificationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EmailVerificationMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.create_time != nil {
+		fields = append(fields, emailverification.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, emailverification.FieldUpdateTime)
+	}
+	if m.user != nil {
+		fields = append(fields, emailverification.FieldUser)
+	}
+	if m.email != nil {
+		fields = append(fields, emailverification.FieldEmail)
+	}
+	if m.codeHash != nil {
+		fields = append(fields, emailverification.FieldCodeHash)
+	}
+	if m.codeExpires != nil {
+		fields = append(fields, emailverification.FieldCodeExpires)
+	}
+	if m.attempts != nil {
+		fields = append(fields, emailverification.FieldAttempts)
+	}
+	if m.verifiedAt != nil {
+		fields = append(fields, emailverification.FieldVerifiedAt)
+	}
+	if m.validUntil != nil {
+		fields = append(fields, emailverification.FieldValidUntil)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EmailVerificationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case emailverification.FieldCreateTime:
+		return m.CreateTime()
+	case emailverification.FieldUpdateTime:
+		return m.UpdateTime()
+	case emailverification.FieldUser:
+		return m.User()
+	case emailverification.FieldEmail:
+		return m.Email()
+	case emailverification.FieldCodeHash:
+		return m.CodeHash()
+	case emailverification.FieldCodeExpires:
+		return m.CodeExpires()
+	case emailverification.FieldAttempts:
+		return m.Attempts()
+	case emailverification.FieldVerifiedAt:
+		return m.VerifiedAt()
+	case emailverification.FieldValidUntil:
+		return m.ValidUntil()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EmailVerificationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case emailverification.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case emailverification.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case emailverification.FieldUser:
+		return m.OldUser(ctx)
+	case emailverification.FieldEmail:
+		return m.OldEmail(ctx)
+	case emailverification.FieldCodeHash:
+		return m.OldCodeHash(ctx)
+	case emailverification.FieldCodeExpires:
+		return m.OldCodeExpires(ctx)
+	case emailverification.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case emailverification.FieldVerifiedAt:
+		return m.OldVerifiedAt(ctx)
+	case emailverification.FieldValidUntil:
+		return m.OldValidUntil(ctx)
+	}
+	return nil, fmt.Errorf("unknown EmailVerification field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EmailVerificationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case emailverification.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case emailverification.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case emailverification.FieldUser:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUser(v)
+		return nil
+	case emailverification.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
+	case emailverification.FieldCodeHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCodeHash(v)
+		return nil
+	case emailverification.FieldCodeExpires:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCodeExpires(v)
+		return nil
+	case emailverification.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case emailverification.FieldVerifiedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVerifiedAt(v)
+		return nil
+	case emailverification.FieldValidUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValidUntil(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EmailVerification field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EmailVerificationMutation) AddedFields() []string {
+	var fields []string
+	if m.addattempts != nil {
+		fields = append(fields, emailverification.FieldAttempts)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EmailVerificationMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case emailverification.FieldAttempts:
+		return m.AddedAttempts()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EmailVerificationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case emailverification.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EmailVerification numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EmailVerificationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(emailverification.FieldCodeHash) {
+		fields = append(fields, emailverification.FieldCodeHash)
+	}
+	if m.FieldCleared(emailverification.FieldCodeExpires) {
+		fields = append(fields, emailverification.FieldCodeExpires)
+	}
+	if m.FieldCleared(emailverification.FieldVerifiedAt) {
+		fields = append(fields, emailverification.FieldVerifiedAt)
+	}
+	if m.FieldCleared(emailverification.FieldValidUntil) {
+		fields = append(fields, emailverification.FieldValidUntil)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EmailVerificationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EmailVerificationMutation) ClearField(name string) error {
+	switch name {
+	case emailverification.FieldCodeHash:
+		m.ClearCodeHash()
+		return nil
+	case emailverification.FieldCodeExpires:
+		m.ClearCodeExpires()
+		return nil
+	case emailverification.FieldVerifiedAt:
+		m.ClearVerifiedAt()
+		return nil
+	case emailverification.FieldValidUntil:
+		m.ClearValidUntil()
+		return nil
+	}
+	return fmt.Errorf("unknown EmailVerification nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EmailVerificationMutation) ResetField(name string) error {
+	switch name {
+	case emailverification.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case emailverification.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case emailverification.FieldUser:
+		m.ResetUser()
+		return nil
+	case emailverification.FieldEmail:
+		m.ResetEmail()
+		return nil
+	case emailverification.FieldCodeHash:
+		m.ResetCodeHash()
+		return nil
+	case emailverification.FieldCodeExpires:
+		m.ResetCodeExpires()
+		return nil
+	case emailverification.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case emailverification.FieldVerifiedAt:
+		m.ResetVerifiedAt()
+		return nil
+	case emailverification.FieldValidUntil:
+		m.ResetValidUntil()
+		return nil
+	}
+	return fmt.Errorf("unknown EmailVerification field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EmailVerificationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EmailVerificationMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EmailVerificationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EmailVerificationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EmailVerificationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EmailVerificationMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EmailVerificationMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown EmailVerification unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EmailVerificationMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown EmailVerification edge %s", name)
+}
+
+// EscrowAuditMutation represents an operation that mutates the EscrowAudit nodes in the graph.
+type EscrowAuditMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	create_time   *time.Time
+	update_time   *time.Time
+	action        *string
+	actor         *string
+	serial        *string
+	recoveryId    *int
+	addrecoveryId *int
+	details       *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*EscrowAudit, error)
+	predicates    []predicate.EscrowAudit
+}
+
+var _ ent.Mutation = (*EscrowAuditMutation)(nil)
+
+// escrowauditOption allows management of the mutation configuration using functional options.
+type escrowauditOption func(*EscrowAuditMutation)
+
+// newEscrowAuditMutation creates new mutation for the EscrowAudit entity.
+func newEscrowAuditMutation(c config, op Op, opts ...escrowauditOption) *EscrowAuditMutation {
+	m := &EscrowAuditMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEscrowAudit,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withEscrowAuditID sets the ID field of the mutation.
+func withEscrowAuditID(id int) escrowauditOption {
+	return func(m *EscrowAuditMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *EscrowAudit
+		)
+		m.oldValue = func(ctx context.Context) (*EscrowAudit, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().EscrowAudit.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withEscrowAudit sets the old EscrowAudit of the mutation.
+func withEscrowAudit(node *EscrowAudit) escrowauditOption {
+	return func(m *EscrowAuditMutation) {
+		m.oldValue = func(context.Context) (*EscrowAudit, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EscrowAuditMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EscrowAuditMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EscrowAuditMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EscrowAuditMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().EscrowAudit.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *EscrowAuditMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *EscrowAuditMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the EscrowAudit entity.
+// If the EscrowAudit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EscrowAuditMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *EscrowAuditMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *EscrowAuditMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *EscrowAuditMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the EscrowAudit entity.
+// If the EscrowAudit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EscrowAuditMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *EscrowAuditMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetAction sets the "action" field.
+func (m *EscrowAuditMutation) SetAction(s string) {
+	m.action = &s
+}
+
+// Action returns the value of the "action" field in the mutation.
+func (m *EscrowAuditMutation) Action() (r string, exists bool) {
+	v := m.action
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAction returns the old "action" field's value of the EscrowAudit entity.
+// If the EscrowAudit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EscrowAuditMutation) OldAction(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+	}
+	return oldValue.Action, nil
+}
+
+// ResetAction resets all changes to the "action" field.
+func (m *EscrowAuditMutation) ResetAction() {
+	m.action = nil
+}
+
+// SetActor sets the "actor" field.
+func (m *EscrowAuditMutation) SetActor(s string) {
+	m.actor = &s
+}
+
+// Actor returns the value of the "actor" field in the mutation.
+func (m *EscrowAuditMutation) Actor() (r string, exists bool) {
+	v := m.actor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActor returns the old "actor" field's value of the EscrowAudit entity.
+// If the EscrowAudit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EscrowAuditMutation) OldActor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActor: %w", err)
+	}
+	return oldValue.Actor, nil
+}
+
+// ResetActor resets all changes to the "actor" field.
+func (m *EscrowAuditMutation) ResetActor() {
+	m.actor = nil
+}
+
+// SetSerial sets the "serial" field.
+func (m *EscrowAuditMutation) SetSerial(s string) {
+	m.serial = &s
+}
+
+// Serial returns the value of the "serial" field in the mutation.
+func (m *EscrowAuditMutation) Serial() (r string, exists bool) {
+	v := m.serial
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSerial returns the old "serial" field's value of the EscrowAudit entity.
+// If the EscrowAudit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EscrowAuditMutation) OldSerial(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSerial is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSerial requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSerial: %w", err)
+	}
+	return oldValue.Serial, nil
+}
+
+// ClearSerial clears the value of the "serial" field.
+func (m *EscrowAuditMutation) ClearSerial() {
+	m.serial = nil
+	m.clearedFields[escrowaudit.FieldSerial] = struct{}{}
+}
+
+// SerialCleared returns if the "serial" field was cleared in this mutation.
+func (m *EscrowAuditMutation) SerialCleared() bool {
+	_, ok := m.clearedFields[escrowaudit.FieldSerial]
+	return ok
+}
+
+// ResetSerial resets all changes to the "serial" field.
+func (m *EscrowAuditMutation) ResetSerial() {
+	m.serial = nil
+	delete(m.clearedFields, escrowaudit.FieldSerial)
+}
+
+// SetRecoveryId sets the "recoveryId" field.
+func (m *EscrowAuditMutation) SetRecoveryId(i int) {
+	m.recoveryId = &i
+	m.addrecoveryId = nil
+}
+
+// RecoveryId returns the value of the "recoveryId" field in the mutation.
+func (m *EscrowAuditMutation) RecoveryId() (r int, exists bool) {
+	v := m.recoveryId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRecoveryId returns the old "recoveryId" field's value of the EscrowAudit entity.
+// If the EscrowAudit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EscrowAuditMutation) OldRecoveryId(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRecoveryId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRecoveryId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRecoveryId: %w", err)
+	}
+	return oldValue.RecoveryId, nil
+}
+
+// AddRecoveryId adds i to the "recoveryId" field.
+func (m *EscrowAuditMutation) AddRecoveryId(i int) {
+	if m.addrecoveryId != nil {
+		*m.addrecoveryId += i
+	} else {
+		m.addrecoveryId = &i
+	}
+}
+
+// AddedRecoveryId returns the value that was added to the "recoveryId" field in this mutation.
+func (m *EscrowAuditMutation) AddedRecoveryId() (r int, exists bool) {
+	v := m.addrecoveryId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearRecoveryId clears the value of the "recoveryId" field.
+func (m *EscrowAuditMutation) ClearRecoveryId() {
+	m.recoveryId = nil
+	m.addrecoveryId = nil
+	m.clearedFields[escrowaudit.FieldRecoveryId] = struct{}{}
+}
+
+// RecoveryIdCleared returns if the "recoveryId" field was cleared in this mutation.
+func (m *EscrowAuditMutation) RecoveryIdCleared() bool {
+	_, ok := m.clearedFields[escrowaudit.FieldRecoveryId]
+	return ok
+}
+
+// ResetRecoveryId resets all changes to the "recoveryId" field.
+func (m *EscrowAuditMutation) ResetRecoveryId() {
+	m.recoveryId = nil
+	m.addrecoveryId = nil
+	delete(m.clearedFields, escrowaudit.FieldRecoveryId)
+}
+
+// SetDetails sets the "details" field.
+func (m *EscrowAuditMutation) SetDetails(s string) {
+	m.details = &s
+}
+
+// Details returns the value of the "details" field in the mutation.
+func (m *EscrowAuditMutation) Details() (r string, exists bool) {
+	v := m.details
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDetails returns the old "details" field's value of the EscrowAudit entity.
+// If the EscrowAudit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EscrowAuditMutation) OldDetails(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDetails is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDetails requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDetails: %w", err)
+	}
+	return oldValue.Details, nil
+}
+
+// ClearDetails clears the value of the "details" field.
+func (m *EscrowAuditMutation) ClearDetails() {
+	m.details = nil
+	m.clearedFields[escrowaudit.FieldDetails] = struct{}{}
+}
+
+// DetailsCleared returns if the "details" field was cleared in this mutation.
+func (m *EscrowAuditMutation) DetailsCleared() bool {
+	_, ok := m.clearedFields[escrowaudit.FieldDetails]
+	return ok
+}
+
+// ResetDetails resets all changes to the "details" field.
+func (m *EscrowAuditMutation) ResetDetails() {
+	m.details = nil
+	delete(m.clearedFields, escrowaudit.FieldDetails)
+}
+
+// Where appends a list predicates to the EscrowAuditMutation builder.
+func (m *EscrowAuditMutation) Where(ps ...predicate.EscrowAudit) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EscrowAuditMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EscrowAuditMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.EscrowAudit, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EscrowAuditMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EscrowAuditMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (EscrowAudit).
+func (m *EscrowAuditMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EscrowAuditMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.create_time != nil {
+		fields = append(fields, escrowaudit.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, escrowaudit.FieldUpdateTime)
+	}
+	if m.action != nil {
+		fields = append(fields, escrowaudit.FieldAction)
+	}
+	if m.actor != nil {
+		fields = append(fields, escrowaudit.FieldActor)
+	}
+	if m.serial != nil {
+		fields = append(fields, escrowaudit.FieldSerial)
+	}
+	if m.recoveryId != nil {
+		fields = append(fields, escrowaudit.FieldRecoveryId)
+	}
+	if m.details != nil {
+		fields = append(fields, escrowaudit.FieldDetails)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EscrowAuditMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case escrowaudit.FieldCreateTime:
+		return m.CreateTime()
+	case escrowaudit.FieldUpdateTime:
+		return m.UpdateTime()
+	case escrowaudit.FieldAction:
+		return m.Action()
+	case escrowaudit.FieldActor:
+		return m.Actor()
+	case escrowaudit.FieldSerial:
+		return m.Serial()
+	case escrowaudit.FieldRecoveryId:
+		return m.RecoveryId()
+	case escrowaudit.FieldDetails:
+		return m.Details()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EscrowAuditMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case escrowaudit.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case escrowaudit.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case escrowaudit.FieldAction:
+		return m.OldAction(ctx)
+	case escrowaudit.FieldActor:
+		return m.OldActor(ctx)
+	case escrowaudit.FieldSerial:
+		return m.OldSerial(ctx)
+	case escrowaudit.FieldRecoveryId:
+		return m.OldRecoveryId(ctx)
+	case escrowaudit.FieldDetails:
+		return m.OldDetails(ctx)
+	}
+	return nil, fmt.Errorf("unknown EscrowAudit field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EscrowAuditMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case escrowaudit.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case escrowaudit.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case escrowaudit.FieldAction:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	case escrowaudit.FieldActor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActor(v)
+		return nil
+	case escrowaudit.FieldSerial:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSerial(v)
+		return nil
+	case escrowaudit.FieldRecoveryId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRecoveryId(v)
+		return nil
+	case escrowaudit.FieldDetails:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDetails(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EscrowAudit field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EscrowAuditMutation) AddedFields() []string {
+	var fields []string
+	if m.addrecoveryId != nil {
+		fields = append(fields, escrowaudit.FieldRecoveryId)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EscrowAuditMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case escrowaudit.FieldRecoveryId:
+		return m.AddedRecoveryId()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EscrowAuditMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case escrowaudit.FieldRecoveryId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRecoveryId(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EscrowAudit numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EscrowAuditMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(escrowaudit.FieldSerial) {
+		fields = append(fields, escrowaudit.FieldSerial)
+	}
+	if m.FieldCleared(escrowaudit.FieldRecoveryId) {
+		fields = append(fields, escrowaudit.FieldRecoveryId)
+	}
+	if m.FieldCleared(escrowaudit.FieldDetails) {
+		fields = append(fields, escrowaudit.FieldDetails)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EscrowAuditMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EscrowAuditMutation) ClearField(name string) error {
+	switch name {
+	case escrowaudit.FieldSerial:
+		m.ClearSerial()
+		return nil
+	case escrowaudit.FieldRecoveryId:
+		m.ClearRecoveryId()
+		return nil
+	case escrowaudit.FieldDetails:
+		m.ClearDetails()
+		return nil
+	}
+	return fmt.Errorf("unknown EscrowAudit nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EscrowAuditMutation) ResetField(name string) error {
+	switch name {
+	case escrowaudit.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case escrowaudit.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case escrowaudit.FieldAction:
+		m.ResetAction()
+		return nil
+	case escrowaudit.FieldActor:
+		m.ResetActor()
+		return nil
+	case escrowaudit.FieldSerial:
+		m.ResetSerial()
+		return nil
+	case escrowaudit.FieldRecoveryId:
+		m.ResetRecoveryId()
+		return nil
+	case escrowaudit.FieldDetails:
+		m.ResetDetails()
+		return nil
+	}
+	return fmt.Errorf("unknown EscrowAudit field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EscrowAuditMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EscrowAuditMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EscrowAuditMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EscrowAuditMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EscrowAuditMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EscrowAuditMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EscrowAuditMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown EscrowAudit unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EscrowAuditMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown EscrowAudit edge %s", name)
+}
+
+// KeyEscrowMutation represents an operation that mutates the KeyEscrow nodes in the graph.
+type KeyEscrowMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	create_time        *time.Time
+	update_time        *time.Time
+	mode               *keyescrow.Mode
+	ciphertext         *[]byte
+	wrappedKey         *[]byte
+	shares             *map[string][]uint8
+	clearedFields      map[string]struct{}
+	certificate        *int
+	clearedcertificate bool
+	recoveries         map[int]struct{}
+	removedrecoveries  map[int]struct{}
+	clearedrecoveries  bool
+	done               bool
+	oldValue           func(context.Context) (*KeyEscrow, error)
+	predicates         []predicate.KeyEscrow
+}
+
+var _ ent.Mutation = (*KeyEscrowMutation)(nil)
+
+// keyescrowOption allows management of the mutation configuration using functional options.
+type keyescrowOption func(*KeyEscrowMutation)
+
+// newKeyEscrowMutation creates new mutation for the KeyEscrow entity.
+func newKeyEscrowMutation(c config, op Op, opts ...keyescrowOption) *KeyEscrowMutation {
+	m := &KeyEscrowMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeKeyEscrow,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withKeyEscrowID sets the ID field of the mutation.
+func withKeyEscrowID(id int) keyescrowOption {
+	return func(m *KeyEscrowMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *KeyEscrow
+		)
+		m.oldValue = func(ctx context.Context) (*KeyEscrow, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().KeyEscrow.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withKeyEscrow sets the old KeyEscrow of the mutation.
+func withKeyEscrow(node *KeyEscrow) keyescrowOption {
+	return func(m *KeyEscrowMutation) {
+		m.oldValue = func(context.Context) (*KeyEscrow, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m KeyEscrowMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m KeyEscrowMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *KeyEscrowMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *KeyEscrowMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().KeyEscrow.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *KeyEscrowMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *KeyEscrowMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the KeyEscrow entity.
+// If the KeyEscrow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeyEscrowMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *KeyEscrowMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *KeyEscrowMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *KeyEscrowMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the KeyEscrow entity.
+// If the KeyEscrow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeyEscrowMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *KeyEscrowMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetMode sets the "mode" field.
+func (m *KeyEscrowMutation) SetMode(k keyescrow.Mode) {
+	m.mode = &k
+}
+
+// Mode returns the value of the "mode" field in the mutation.
+func (m *KeyEscrowMutation) Mode() (r keyescrow.Mode, exists bool) {
+	v := m.mode
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMode returns the old "mode" field's value of the KeyEscrow entity.
+// If the KeyEscrow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeyEscrowMutation) OldMode(ctx context.Context) (v keyescrow.Mode, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMode: %w", err)
+	}
+	return oldValue.Mode, nil
+}
+
+// ResetMode resets all changes to the "mode" field.
+func (m *KeyEscrowMutation) ResetMode() {
+	m.mode = nil
+}
+
+// SetCiphertext sets the "ciphertext" field.
+func (m *KeyEscrowMutation) SetCiphertext(b []byte) {
+	m.ciphertext = &b
+}
+
+// Ciphertext returns the value of the "ciphertext" field in the mutation.
+func (m *KeyEscrowMutation) Ciphertext() (r []byte, exists bool) {
+	v := m.ciphertext
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCiphertext returns the old "ciphertext" field's value of the KeyEscrow entity.
+// If the KeyEscrow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeyEscrowMutation) OldCiphertext(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCiphertext is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCiphertext requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCiphertext: %w", err)
+	}
+	return oldValue.Ciphertext, nil
+}
+
+// ResetCiphertext resets all changes to the "ciphertext" field.
+func (m *KeyEscrowMutation) ResetCiphertext() {
+	m.ciphertext = nil
+}
+
+// SetWrappedKey sets the "wrappedKey" field.
+func (m *KeyEscrowMutation) SetWrappedKey(b []byte) {
+	m.wrappedKey = &b
+}
+
+// WrappedKey returns the value of the "wrappedKey" field in the mutation.
+func (m *KeyEscrowMutation) WrappedKey() (r []byte, exists bool) {
+	v := m.wrappedKey
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWrappedKey returns the old "wrappedKey" field's value of the KeyEscrow entity.
+// If the KeyEscrow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeyEscrowMutation) OldWrappedKey(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWrappedKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWrappedKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWrappedKey: %w", err)
+	}
+	return oldValue.WrappedKey, nil
+}
+
+// ClearWrappedKey clears the value of the "wrappedKey" field.
+func (m *KeyEscrowMutation) ClearWrappedKey() {
+	m.wrappedKey = nil
+	m.clearedFields[keyescrow.FieldWrappedKey] = struct{}{}
+}
+
+// WrappedKeyCleared returns if the "wrappedKey" field was cleared in this mutation.
+func (m *KeyEscrowMutation) WrappedKeyCleared() bool {
+	_, ok := m.clearedFields[keyescrow.FieldWrappedKey]
+	return ok
+}
+
+// ResetWrappedKey resets all changes to the "wrappedKey" field.
+func (m *KeyEscrowMutation) ResetWrappedKey() {
+	m.wrappedKey = nil
+	delete(m.clearedFields, keyescrow.FieldWrappedKey)
+}
+
+// SetShares sets the "shares" field.
+func (m *KeyEscrowMutation) SetShares(value map[string][]uint8) {
+	m.shares = &value
+}
+
+// Shares returns the value of the "shares" field in the mutation.
+func (m *KeyEscrowMutation) Shares() (r map[string][]uint8, exists bool) {
+	v := m.shares
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldShares returns the old "shares" field's value of the KeyEscrow entity.
+// If the KeyEscrow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeyEscrowMutation) OldShares(ctx context.Context) (v map[string][]uint8, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldShares is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldShares requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldShares: %w", err)
+	}
+	return oldValue.Shares, nil
+}
+
+// ClearShares clears the value of the "shares" field.
+func (m *KeyEscrowMutation) ClearShares() {
+	m.shares = nil
+	m.clearedFields[keyescrow.FieldShares] = struct{}{}
+}
+
+// SharesCleared returns if the "shares" field was cleared in this mutation.
+func (m *KeyEscrowMutation) SharesCleared() bool {
+	_, ok := m.clearedFields[keyescrow.FieldShares]
+	return ok
+}
+
+// ResetShares resets all changes to the "shares" field.
+func (m *KeyEscrowMutation) ResetShares() {
+	m.shares = nil
+	delete(m.clearedFields, keyescrow.FieldShares)
+}
+
+// SetCertificateID sets the "certificate" edge to the SmimeCertificate entity by id.
+func (m *KeyEscrowMutation) SetCertificateID(id int) {
+	m.certificate = &id
+}
+
+// ClearCertificate clears the "certificate" edge to the SmimeCertificate entity.
+func (m *KeyEscrowMutation) ClearCertificate() {
+	m.clearedcertificate = true
+}
+
+// CertificateCleared reports if the "certificate" edge to the SmimeCertificate entity was cleared.
+func (m *KeyEscrowMutation) CertificateCleared() bool {
+	return m.clearedcertificate
+}
+
+// CertificateID returns the "certificate" edge ID in the mutation.
+func (m *KeyEscrowMutation) CertificateID() (id int, exists bool) {
+	if m.certificate != nil {
+		return *m.certificate, true
+	}
+	return
+}
+
+// CertificateIDs returns the "certificate" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CertificateID instead. It exists only for internal usage by the builders.
+func (m *KeyEscrowMutation) CertificateIDs() (ids []int) {
+	if id := m.certificate; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCertificate resets all changes to the "certificate" edge.
+func (m *KeyEscrowMutation) ResetCertificate() {
+	m.certificate = nil
+	m.clearedcertificate = false
+}
+
+// AddRecoveryIDs adds the "recoveries" edge to the KeyRecovery entity by ids.
+func (m *KeyEscrowMutation) AddRecoveryIDs(ids ...int) {
+	if m.recoveries == nil {
+		m.recoveries = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.recoveries[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRecoveries clears the "recoveries" edge to the KeyRecovery entity.
+func (m *KeyEscrowMutation) ClearRecoveries() {
+	m.clearedrecoveries = true
+}
+
+// RecoveriesCleared reports if the "recoveries" edge to the KeyRecovery entity was cleared.
+func (m *KeyEscrowMutation) RecoveriesCleared() bool {
+	return m.clearedrecoveries
+}
+
+// RemoveRecoveryIDs removes the "recoveries" edge to the KeyRecovery entity by IDs.
+func (m *KeyEscrowMutation) RemoveRecoveryIDs(ids ...int) {
+	if m.removedrecoveries == nil {
+		m.removedrecoveries = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.recoveries, ids[i])
+		m.removedrecoveries[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRecoveries returns the removed IDs of the "recoveries" edge to the KeyRecovery entity.
+func (m *KeyEscrowMutation) RemovedRecoveriesIDs() (ids []int) {
+	for id := range m.removedrecoveries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RecoveriesIDs returns the "recoveries" edge IDs in the mutation.
+func (m *KeyEscrowMutation) RecoveriesIDs() (ids []int) {
+	for id := range m.recoveries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRecoveries resets all changes to the "recoveries" edge.
+func (m *KeyEscrowMutation) ResetRecoveries() {
+	m.recoveries = nil
+	m.clearedrecoveries = false
+	m.removedrecoveries = nil
+}
+
+// Where appends a list predicates to the KeyEscrowMutation builder.
+func (m *KeyEscrowMutation) Where(ps ...predicate.KeyEscrow) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the KeyEscrowMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *KeyEscrowMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.KeyEscrow, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *KeyEscrowMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *KeyEscrowMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (KeyEscrow).
+func (m *KeyEscrowMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *KeyEscrowMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.create_time != nil {
+		fields = append(fields, keyescrow.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, keyescrow.FieldUpdateTime)
+	}
+	if m.mode != nil {
+		fields = append(fields, keyescrow.FieldMode)
+	}
+	if m.ciphertext != nil {
+		fields = append(fields, keyescrow.FieldCiphertext)
+	}
+	if m.wrappedKey != nil {
+		fields = append(fields, keyescrow.FieldWrappedKey)
+	}
+	if m.shares != nil {
+		fields = append(fields, keyescrow.FieldShares)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *KeyEscrowMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case keyescrow.FieldCreateTime:
+		return m.CreateTime()
+	case keyescrow.FieldUpdateTime:
+		return m.UpdateTime()
+	case keyescrow.FieldMode:
+		return m.Mode()
+	case keyescrow.FieldCiphertext:
+		return m.Ciphertext()
+	case keyescrow.FieldWrappedKey:
+		return m.WrappedKey()
+	case keyescrow.FieldShares:
+		return m.Shares()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *KeyEscrowMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case keyescrow.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case keyescrow.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case keyescrow.FieldMode:
+		return m.OldMode(ctx)
+	case keyescrow.FieldCiphertext:
+		return m.OldCiphertext(ctx)
+	case keyescrow.FieldWrappedKey:
+		return m.OldWrappedKey(ctx)
+	case keyescrow.FieldShares:
+		return m.OldShares(ctx)
+	}
+	return nil, fmt.Errorf("unknown KeyEscrow field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *KeyEscrowMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case keyescrow.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case keyescrow.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case keyescrow.FieldMode:
+		v, ok := value.(keyescrow.Mode)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMode(v)
+		return nil
+	case keyescrow.FieldCiphertext:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCiphertext(v)
+		return nil
+	case keyescrow.FieldWrappedKey:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWrappedKey(v)
+		return nil
+	case keyescrow.FieldShares:
+		v, ok := value.(map[string][]uint8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetShares(v)
+		return nil
+	}
+	return fmt.Errorf("unknown KeyEscrow field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *KeyEscrowMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *KeyEscrowMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *KeyEscrowMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown KeyEscrow numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *KeyEscrowMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(keyescrow.FieldWrappedKey) {
+		fields = append(fields, keyescrow.FieldWrappedKey)
+	}
+	if m.FieldCleared(keyescrow.FieldShares) {
+		fields = append(fields, keyescrow.FieldShares)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *KeyEscrowMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *KeyEscrowMutation) ClearField(name string) error {
+	switch name {
+	case keyescrow.FieldWrappedKey:
+		m.ClearWrappedKey()
+		return nil
+	case keyescrow.FieldShares:
+		m.ClearShares()
+		return nil
+	}
+	return fmt.Errorf("unknown KeyEscrow nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *KeyEscrowMutation) ResetField(name string) error {
+	switch name {
+	case keyescrow.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case keyescrow.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case keyescrow.FieldMode:
+		m.ResetMode()
+		return nil
+	case keyescrow.FieldCiphertext:
+		m.ResetCiphertext()
+		return nil
+	case keyescrow.FieldWrappedKey:
+		m.ResetWrappedKey()
+		return nil
+	case keyescrow.FieldShares:
+		m.ResetShares()
+		return nil
+	}
+	return fmt.Errorf("unknown KeyEscrow field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *KeyEscrowMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.certificate != nil {
+		edges = append(edges, keyescrow.EdgeCertificate)
+	}
+	if m.recoveries != nil {
+		edges = append(edges, keyescrow.EdgeRecoveries)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *KeyEscrowMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case keyescrow.EdgeCertificate:
+		if id := m.certificate; id != nil {
+			return []ent.Value{*id}
+		}
+	case keyescrow.EdgeRecoveries:
+		ids := make([]ent.Value, 0, len(m.recoveries))
+		for id := range m.recoveries {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *KeyEscrowMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedrecoveries != nil {
+		edges = append(edges, keyescrow.EdgeRecoveries)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *KeyEscrowMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case keyescrow.EdgeRecoveries:
+		ids := make([]ent.Value, 0, len(m.removedrecoveries))
+		for id := range m.removedrecoveries {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *KeyEscrowMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedcertificate {
+		edges = append(edges, keyescrow.EdgeCertificate)
+	}
+	if m.clearedrecoveries {
+		edges = append(edges, keyescrow.EdgeRecoveries)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *KeyEscrowMutation) EdgeCleared(name string) bool {
+	switch name {
+	case keyescrow.EdgeCertificate:
+		return m.clearedcertificate
+	case keyescrow.EdgeRecoveries:
+		return m.clearedrecoveries
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *KeyEscrowMutation) ClearEdge(name string) error {
+	switch name {
+	case keyescrow.EdgeCertificate:
+		m.ClearCertificate()
+		return nil
+	}
+	return fmt.Errorf("unknown KeyEscrow unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *KeyEscrowMutation) ResetEdge(name string) error {
+	switch name {
+	case keyescrow.EdgeCertificate:
+		m.ResetCertificate()
+		return nil
+	case keyescrow.EdgeRecoveries:
+		m.ResetRecoveries()
+		return nil
+	}
+	return fmt.Errorf("unknown KeyEscrow edge %s", name)
+}
+
+// KeyRecoveryMutation represents an operation that mutates the KeyRecovery nodes in the graph.
+type KeyRecoveryMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	create_time     *time.Time
+	update_time     *time.Time
+	requestedBy     *string
+	reason          *string
+	status          *keyrecovery.Status
+	approvals       *[]string
+	appendapprovals []string
+	decidedBy       *string
+	releasedAt      *time.Time
+	clearedFields   map[string]struct{}
+	escrow          *int
+	clearedescrow   bool
+	done            bool
+	oldValue        func(context.Context) (*KeyRecovery, error)
+	predicates      []predicate.KeyRecovery
+}
+
+var _ ent.Mutation = (*KeyRecoveryMutation)(nil)
+
+// keyrecoveryOption allows management of the mutation configuration using functional options.
+type keyrecoveryOption func(*KeyRecoveryMutation)
+
+// newKeyRecoveryMutation creates new mutation for the KeyRecovery entity.
+func newKeyRecoveryMutation(c config, op Op, opts ...keyrecoveryOption) *KeyRecoveryMutation {
+	m := &KeyRecoveryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeKeyRecovery,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withKeyRecoveryID sets the ID field of the mutation.
+func withKeyRecoveryID(id int) keyrecoveryOption {
+	return func(m *KeyRecoveryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *KeyRecovery
+		)
+		m.oldValue = func(ctx context.Context) (*KeyRecovery, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().KeyRecovery.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withKeyRecovery sets the old KeyRecovery of the mutation.
+func withKeyRecovery(node *KeyRecovery) keyrecoveryOption {
+	return func(m *KeyRecoveryMutation) {
+		m.oldValue = func(context.Context) (*KeyRecovery, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m KeyRecoveryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m KeyRecoveryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *KeyRecoveryMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *KeyRecoveryMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().KeyRecovery.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *KeyRecoveryMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *KeyRecoveryMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the KeyRecovery entity.
+// If the KeyRecovery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeyRecoveryMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *KeyRecoveryMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *KeyRecoveryMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *KeyRecoveryMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the KeyRecovery entity.
+// If the KeyRecovery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeyRecoveryMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *KeyRecoveryMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetRequestedBy sets the "requestedBy" field.
+func (m *KeyRecoveryMutation) SetRequestedBy(s string) {
+	m.requestedBy = &s
+}
+
+// RequestedBy returns the value of the "requestedBy" field in the mutation.
+func (m *KeyRecoveryMutation) RequestedBy() (r string, exists bool) {
+	v := m.requestedBy
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequestedBy returns the old "requestedBy" field's value of the KeyRecovery entity.
+// If the KeyRecovery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeyRecoveryMutation) OldRequestedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequestedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequestedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequestedBy: %w", err)
+	}
+	return oldValue.RequestedBy, nil
+}
+
+// ResetRequestedBy resets all changes to the "requestedBy" field.
+func (m *KeyRecoveryMutation) ResetRequestedBy() {
+	m.requestedBy = nil
+}
+
+// SetReason sets the "reason" field.
+func (m *KeyRecoveryMutation) SetReason(s string) {
+	m.reason = &s
+}
+
+// Reason returns the value of the "reason" field in the mutation.
+func (m *KeyRecoveryMutation) Reason() (r string, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReason returns the old "reason" field's value of the KeyRecovery entity.
+// If the KeyRecovery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeyRecoveryMutation) OldReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
+}
+
+// ClearReason clears the value of the "reason" field.
+func (m *KeyRecoveryMutation) ClearReason() {
+	m.reason = nil
+	m.clearedFields[keyrecovery.FieldReason] = struct{}{}
+}
+
+// ReasonCleared returns if the "reason" field was cleared in this mutation.
+func (m *KeyRecoveryMutation) ReasonCleared() bool {
+	_, ok := m.clearedFields[keyrecovery.FieldReason]
+	return ok
+}
+
+// ResetReason resets all changes to the "reason" field.
+func (m *KeyRecoveryMutation) ResetReason() {
+	m.reason = nil
+	delete(m.clearedFields, keyrecovery.FieldReason)
+}
+
+// SetStatus sets the "status" field.
+func (m *KeyRecoveryMutation) SetStatus(k keyrecovery.Status) {
+	m.status = &k
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *KeyRecoveryMutation) Status() (r keyrecovery.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the KeyRecovery entity.
+// If the KeyRecovery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeyRecoveryMutation) OldStatus(ctx context.Context) (v keyrecovery.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *KeyRecoveryMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetApprovals sets the "approvals" field.
+func (m *KeyRecoveryMutation) SetApprovals(s []string) {
+	m.approvals = &s
+	m.appendapprovals = nil
+}
+
+// Approvals returns the value of the "approvals" field in the mutation.
+func (m *KeyRecoveryMutation) Approvals() (r []string, exists bool) {
+	v := m.approvals
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldApprovals returns the old "approvals" field's value of the KeyRecovery entity.
+// If the KeyRecovery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeyRecoveryMutation) OldApprovals(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldApprovals is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldApprovals requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldApprovals: %w", err)
+	}
+	return oldValue.Approvals, nil
+}
+
+// AppendApprovals adds s to the "approvals" field.
+func (m *KeyRecoveryMutation) AppendApprovals(s []string) {
+	m.appendapprovals = append(m.appendapprovals, s...)
+}
+
+// AppendedApprovals returns the list of values that were appended to the "approvals" field in this mutation.
+func (m *KeyRecoveryMutation) AppendedApprovals() ([]string, bool) {
+	if len(m.appendapprovals) == 0 {
+		return nil, false
+	}
+	return m.appendapprovals, true
+}
+
+// ClearApprovals clears the value of the "approvals" field.
+func (m *KeyRecoveryMutation) ClearApprovals() {
+	m.approvals = nil
+	m.appendapprovals = nil
+	m.clearedFields[keyrecovery.FieldApprovals] = struct{}{}
+}
+
+// ApprovalsCleared returns if the "approvals" field was cleared in this mutation.
+func (m *KeyRecoveryMutation) ApprovalsCleared() bool {
+	_, ok := m.clearedFields[keyrecovery.FieldApprovals]
+	return ok
+}
+
+// ResetApprovals resets all changes to the "approvals" field.
+func (m *KeyRecoveryMutation) ResetApprovals() {
+	m.approvals = nil
+	m.appendapprovals = nil
+	delete(m.clearedFields, keyrecovery.FieldApprovals)
+}
+
+// SetDecidedBy sets the "decidedBy" field.
+func (m *KeyRecoveryMutation) SetDecidedBy(s string) {
+	m.decidedBy = &s
+}
+
+// DecidedBy returns the value of the "decidedBy" field in the mutation.
+func (m *KeyRecoveryMutation) DecidedBy() (r string, exists bool) {
+	v := m.decidedBy
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDecidedBy returns the old "decidedBy" field's value of the KeyRecovery entity.
+// If the KeyRecovery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeyRecoveryMutation) OldDecidedBy(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDecidedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDecidedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDecidedBy: %w", err)
+	}
+	return oldValue.DecidedBy, nil
+}
+
+// ClearDecidedBy clears the value of the "decidedBy" field.
+func (m *KeyRecoveryMutation) ClearDecidedBy() {
+	m.decidedBy = nil
+	m.clearedFields[keyrecovery.FieldDecidedBy] = struct{}{}
+}
+
+// DecidedByCleared returns if the "decidedBy" field was cleared in this mutation.
+func (m *KeyRecoveryMutation) DecidedByCleared() bool {
+	_, ok := m.clearedFields[keyrecovery.FieldDecidedBy]
+	return ok
+}
+
+// ResetDecidedBy resets all changes to the "decidedBy" field.
+func (m *KeyRecoveryMutation) ResetDecidedBy() {
+	m.decidedBy = nil
+	delete(m.clearedFields, keyrecovery.FieldDecidedBy)
+}
+
+// SetReleasedAt sets the "releasedAt" field.
+func (m *KeyRecoveryMutation) SetReleasedAt(t time.Time) {
+	m.releasedAt = &t
+}
+
+// ReleasedAt returns the value of the "releasedAt" field in the mutation.
+func (m *KeyRecoveryMutation) ReleasedAt() (r time.Time, exists bool) {
+	v := m.releasedAt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReleasedAt returns the old "releasedAt" field's value of the KeyRecovery entity.
+// If the KeyRecovery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeyRecoveryMutation) OldReleasedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReleasedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReleasedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReleasedAt: %w", err)
+	}
+	return oldValue.ReleasedAt, nil
+}
+
+// ClearReleasedAt clears the value of the "releasedAt" field.
+func (m *KeyRecoveryMutation) ClearReleasedAt() {
+	m.releasedAt = nil
+	m.clearedFields[keyrecovery.FieldReleasedAt] = struct{}{}
+}
+
+// ReleasedAtCleared returns if the "releasedAt" field was cleared in this mutation.
+func (m *KeyRecoveryMutation) ReleasedAtCleared() bool {
+	_, ok := m.clearedFields[keyrecovery.FieldReleasedAt]
+	return ok
+}
+
+// ResetReleasedAt resets all changes to the "releasedAt" field.
+func (m *KeyRecoveryMutation) ResetReleasedAt() {
+	m.releasedAt = nil
+	delete(m.clearedFields, keyrecovery.FieldReleasedAt)
+}
+
+// SetEscrowID sets the "escrow" edge to the KeyEscrow entity by id.
+func (m *KeyRecoveryMutation) SetEscrowID(id int) {
+	m.escrow = &id
+}
+
+// ClearEscrow clears the "escrow" edge to the KeyEscrow entity.
+func (m *KeyRecoveryMutation) ClearEscrow() {
+	m.clearedescrow = true
+}
+
+// EscrowCleared reports if the "escrow" edge to the KeyEscrow entity was cleared.
+func (m *KeyRecoveryMutation) EscrowCleared() bool {
+	return m.clearedescrow
+}
+
+// EscrowID returns the "escrow" edge ID in the mutation.
+func (m *KeyRecoveryMutation) EscrowID() (id int, exists bool) {
+	if m.escrow != nil {
+		return *m.escrow, true
+	}
+	return
+}
+
+// EscrowIDs returns the "escrow" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// EscrowID instead. It exists only for internal usage by the builders.
+func (m *KeyRecoveryMutation) EscrowIDs() (ids []int) {
+	if id := m.escrow; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetEscrow resets all changes to the "escrow" edge.
+func (m *KeyRecoveryMutation) ResetEscrow() {
+	m.escrow = nil
+	m.clearedescrow = false
+}
+
+// Where appends a list predicates to the KeyRecoveryMutation builder.
+func (m *KeyRecoveryMutation) Where(ps ...predicate.KeyRecovery) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the KeyRecoveryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *KeyRecoveryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.KeyRecovery, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *KeyRecoveryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *KeyRecoveryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (KeyRecovery).
+func (m *KeyRecoveryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *KeyRecoveryMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.create_time != nil {
+		fields = append(fields, keyrecovery.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, keyrecovery.FieldUpdateTime)
+	}
+	if m.requestedBy != nil {
+		fields = append(fields, keyrecovery.FieldRequestedBy)
+	}
+	if m.reason != nil {
+		fields = append(fields, keyrecovery.FieldReason)
+	}
+	if m.status != nil {
+		fields = append(fields, keyrecovery.FieldStatus)
+	}
+	if m.approvals != nil {
+		fields = append(fields, keyrecovery.FieldApprovals)
+	}
+	if m.decidedBy != nil {
+		fields = append(fields, keyrecovery.FieldDecidedBy)
+	}
+	if m.releasedAt != nil {
+		fields = append(fields, keyrecovery.FieldReleasedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *KeyRecoveryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case keyrecovery.FieldCreateTime:
+		return m.CreateTime()
+	case keyrecovery.FieldUpdateTime:
+		return m.UpdateTime()
+	case keyrecovery.FieldRequestedBy:
+		return m.RequestedBy()
+	case keyrecovery.FieldReason:
+		return m.Reason()
+	case keyrecovery.FieldStatus:
+		return m.Status()
+	case keyrecovery.FieldApprovals:
+		return m.Approvals()
+	case keyrecovery.FieldDecidedBy:
+		return m.DecidedBy()
+	case keyrecovery.FieldReleasedAt:
+		return m.ReleasedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *KeyRecoveryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case keyrecovery.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case keyrecovery.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case keyrecovery.FieldRequestedBy:
+		return m.OldRequestedBy(ctx)
+	case keyrecovery.FieldReason:
+		return m.OldReason(ctx)
+	case keyrecovery.FieldStatus:
+		return m.OldStatus(ctx)
+	case keyrecovery.FieldApprovals:
+		return m.OldApprovals(ctx)
+	case keyrecovery.FieldDecidedBy:
+		return m.OldDecidedBy(ctx)
+	case keyrecovery.FieldReleasedAt:
+		return m.OldReleasedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown KeyRecovery field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *KeyRecoveryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case keyrecovery.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case keyrecovery.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case keyrecovery.FieldRequestedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequestedBy(v)
+		return nil
+	case keyrecovery.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	case keyrecovery.FieldStatus:
+		v, ok := value.(keyrecovery.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case keyrecovery.FieldApprovals:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetApprovals(v)
+		return nil
+	case keyrecovery.FieldDecidedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDecidedBy(v)
+		return nil
+	case keyrecovery.FieldReleasedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReleasedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown KeyRecovery field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *KeyRecoveryMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *KeyRecoveryMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *KeyRecoveryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown KeyRecovery numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *KeyRecoveryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(keyrecovery.FieldReason) {
+		fields = append(fields, keyrecovery.FieldReason)
+	}
+	if m.FieldCleared(keyrecovery.FieldApprovals) {
+		fields = append(fields, keyrecovery.FieldApprovals)
+	}
+	if m.FieldCleared(keyrecovery.FieldDecidedBy) {
+		fields = append(fields, keyrecovery.FieldDecidedBy)
+	}
+	if m.FieldCleared(keyrecovery.FieldReleasedAt) {
+		fields = append(fields, keyrecovery.FieldReleasedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *KeyRecoveryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *KeyRecoveryMutation) ClearField(name string) error {
+	switch name {
+	case keyrecovery.FieldReason:
+		m.ClearReason()
+		return nil
+	case keyrecovery.FieldApprovals:
+		m.ClearApprovals()
+		return nil
+	case keyrecovery.FieldDecidedBy:
+		m.ClearDecidedBy()
+		return nil
+	case keyrecovery.FieldReleasedAt:
+		m.ClearReleasedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown KeyRecovery nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *KeyRecoveryMutation) ResetField(name string) error {
+	switch name {
+	case keyrecovery.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case keyrecovery.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case keyrecovery.FieldRequestedBy:
+		m.ResetRequestedBy()
+		return nil
+	case keyrecovery.FieldReason:
+		m.ResetReason()
+		return nil
+	case keyrecovery.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case keyrecovery.FieldApprovals:
+		m.ResetApprovals()
+		return nil
+	case keyrecovery.FieldDecidedBy:
+		m.ResetDecidedBy()
+		return nil
+	case keyrecovery.FieldReleasedAt:
+		m.ResetReleasedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown KeyRecovery field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *KeyRecoveryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.escrow != nil {
+		edges = append(edges, keyrecovery.EdgeEscrow)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *KeyRecoveryMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case keyrecovery.EdgeEscrow:
+		if id := m.escrow; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *KeyRecoveryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *KeyRecoveryMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *KeyRecoveryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedescrow {
+		edges = append(edges, keyrecovery.EdgeEscrow)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *KeyRecoveryMutation) EdgeCleared(name string) bool {
+	switch name {
+	case keyrecovery.EdgeEscrow:
+		return m.clearedescrow
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *KeyRecoveryMutation) ClearEdge(name string) error {
+	switch name {
+	case keyrecovery.EdgeEscrow:
+		m.ClearEscrow()
+		return nil
+	}
+	return fmt.Errorf("unknown KeyRecovery unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *KeyRecoveryMutation) ResetEdge(name string) error {
+	switch name {
+	case keyrecovery.EdgeEscrow:
+		m.ResetEscrow()
+		return nil
+	}
+	return fmt.Errorf("unknown KeyRecovery edge %s", name)
+}
+
+// NotificationPreferenceMutation represents an operation that mutates the NotificationPreference nodes in the graph.
+type NotificationPreferenceMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	create_time    *time.Time
+	update_time    *time.Time
+	user           *string
+	event          *string
+	enabled        *bool
+	channels       *[]string
+	appendchannels []string
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*NotificationPreference, error)
+	predicates     []predicate.NotificationPreference
+}
+
+var _ ent.Mutation = (*NotificationPreferenceMutation)(nil)
+
+// notificationpreferenceOption allows management of the mutation configuration using functional options.
+type notificationpreferenceOption func(*NotificationPreferenceMutation)
+
+// newNotificationPreferenceMutation creates new mutation for the NotificationPreference entity.
+func newNotificationPreferenceMutation(c config, op Op, opts ...notificationpreferenceOption) *NotificationPreferenceMutation {
+	m := &NotificationPreferenceMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNotificationPreference,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withNotificationPreferenceID sets the ID field of the mutation.
+func withNotificationPreferenceID(id int) notificationpreferenceOption {
+	return func(m *NotificationPreferenceMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *NotificationPreference
+		)
+		m.oldValue = func(ctx context.Context) (*NotificationPreference, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().NotificationPreference.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withNotificationPreference sets the old NotificationPreference of the mutation.
+func withNotificationPreference(node *NotificationPreference) notificationpreferenceOption {
+	return func(m *NotificationPreferenceMutation) {
+		m.oldValue = func(context.Context) (*NotificationPreference, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NotificationPreferenceMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NotificationPreferenceMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *NotificationPreferenceMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *NotificationPreferenceMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().NotificationPreference.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *NotificationPreferenceMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *NotificationPreferenceMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the NotificationPreference entity.
+// If the NotificationPreference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationPreferenceMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *NotificationPreferenceMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *NotificationPreferenceMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *NotificationPreferenceMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the NotificationPreference entity.
+// If the NotificationPreference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationPreferenceMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *NotificationPreferenceMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetUser sets the "user" field.
+func (m *NotificationPreferenceMutation) SetUser(s string) {
+	m.user = &s
+}
+
+// User returns the value of the "user" field in the mutation.
+func (m *NotificationPreferenceMutation) User() (r string, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUser returns the old "user" field's value of the NotificationPreference entity.
+// If the NotificationPreference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationPreferenceMutation) OldUser(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUser is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUser requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUser: %w", err)
+	}
+	return oldValue.User, nil
+}
+
+// ResetUser resets all changes to the "user" field.
+func (m *NotificationPreferenceMutation) ResetUser() {
+	m.user = nil
+}
+
+// SetEvent sets the "event" field.
+func (m *NotificationPreferenceMutation) SetEvent(s string) {
+	m.event = &s
+}
+
+// Event returns the value of the "event" field in the mutation.
+func (m *NotificationPreferenceMutation) Event() (r string, exists bool) {
+	v := m.event
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEvent returns the old "event" field's value of the NotificationPreference entity.
+// If the NotificationPreference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationPreferenceMutation) OldEvent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEvent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEvent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEvent: %w", err)
+	}
+	return oldValue.Event, nil
+}
+
+// ResetEvent resets all changes to the "event" field.
+func (m *NotificationPreferenceMutation) ResetEvent() {
+	m.event = nil
+}
+
+// SetEnabled sets the "enabled" field.
+func (m *NotificationPreferenceMutation) SetEnabled(b bool) {
+	m.enabled = &b
+}
+
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *NotificationPreferenceMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnabled returns the old "enabled" field's value of the NotificationPreference entity.
+// If the NotificationPreference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationPreferenceMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+	}
+	return oldValue.Enabled, nil
+}
+
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *NotificationPreferenceMutation) ResetEnabled() {
+	m.enabled = nil
+}
+
+// SetChannels sets the "channels" field.
+func (m *NotificationPreferenceMutation) SetChannels(s []string) {
+	m.channels = &s
+	m.appendchannels = nil
+}
+
+// Channels returns the value of the "channels" field in the mutation.
+func (m *NotificationPreferenceMutation) Channels() (r []string, exists bool) {
+	v := m.channels
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChannels returns the old "channels" field's value of the NotificationPreference entity.
+// If the NotificationPreference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationPreferenceMutation) OldChannels(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChannels is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChannels requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChannels: %w", err)
+	}
+	return oldValue.Channels, nil
+}
+
+// AppendChannels adds s to the "channels" field.
+func (m *NotificationPreferenceMutation) AppendChannels(s []string) {
+	m.appendchannels = append(m.appendchannels, s...)
+}
+
+// AppendedChannels returns the list of values that were appended to the "channels" field in this mutation.
+func (m *NotificationPreferenceMutation) AppendedChannels() ([]string, bool) {
+	if len(m.appendchannels) == 0 {
+		return nil, false
+	}
+	return m.appendchannels, true
+}
+
+// ClearChannels clears the value of the "channels" field.
+func (m *NotificationPreferenceMutation) ClearChannels() {
+	m.channels = nil
+	m.appendchannels = nil
+	m.clearedFields[notificationpreference.FieldChannels] = struct{}{}
+}
+
+// ChannelsCleared returns if the "channels" field was cleared in this mutation.
+func (m *NotificationPreferenceMutation) ChannelsCleared() bool {
+	_, ok := m.clearedFields[notificationpreference.FieldChannels]
+	return ok
+}
+
+// ResetChannels resets all changes to the "channels" field.
+func (m *NotificationPreferenceMutation) ResetChannels() {
+	m.channels = nil
+	m.appendchannels = nil
+	delete(m.clearedFields, notificationpreference.FieldChannels)
+}
+
+// Where appends a list predicates to the NotificationPreferenceMutation builder.
+func (m *NotificationPreferenceMutation) Where(ps ...predicate.NotificationPreference) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the NotificationPreferenceMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *NotificationPreferenceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.NotificationPreference, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *NotificationPreferenceMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *NotificationPreferenceMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (NotificationPreference).
+func (m *NotificationPreferenceMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *NotificationPreferenceMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.create_time != nil {
+		fields = append(fields, notificationpreference.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, notificationpreference.FieldUpdateTime)
+	}
+	if m.user != nil {
+		fields = append(fields, notificationpreference.FieldUser)
+	}
+	if m.event != nil {
+		fields = append(fields, notificationpreference.FieldEvent)
+	}
+	if m.enabled != nil {
+		fields = append(fields, notificationpreference.FieldEnabled)
+	}
+	if m.channels != nil {
+		fields = append(fields, notificationpreference.FieldChannels)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *NotificationPreferenceMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case notificationpreference.FieldCreateTime:
+		return m.CreateTime()
+	case notificationpreference.FieldUpdateTime:
+		return m.UpdateTime()
+	case notificationpreference.FieldUser:
+		return m.User()
+	case notificationpreference.FieldEvent:
+		return m.Event()
+	case notificationpreference.FieldEnabled:
+		return m.Enabled()
+	case notificationpreference.FieldChannels:
+		return m.Channels()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *NotificationPreferenceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case notificationpreference.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case notificationpreference.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case notificationpreference.FieldUser:
+		return m.OldUser(ctx)
+	case notificationpreference.FieldEvent:
+		return m.OldEvent(ctx)
+	case notificationpreference.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case notificationpreference.FieldChannels:
+		return m.OldChannels(ctx)
+	}
+	return nil, fmt.Errorf("unknown NotificationPreference field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NotificationPreferenceMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case notificationpreference.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case notificationpreference.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case notificationpreference.FieldUser:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUser(v)
+		return nil
+	case notificationpreference.FieldEvent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEvent(v)
+		return nil
+	case notificationpreference.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
+	case notificationpreference.FieldChannels:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChannels(v)
+		return nil
+	}
+	return fmt.Errorf("unknown NotificationPreference field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *NotificationPreferenceMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *NotificationPreferenceMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NotificationPreferenceMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown NotificationPreference numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *NotificationPreferenceMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(notificationpreference.FieldChannels) {
+		fields = append(fields, notificationpreference.FieldChannels)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *NotificationPreferenceMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *NotificationPreferenceMutation) ClearField(name string) error {
+	switch name {
+	case notificationpreference.FieldChannels:
+		m.ClearChannels()
+		return nil
+	}
+	return fmt.Errorf("unknown NotificationPreference nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *NotificationPreferenceMutation) ResetField(name string) error {
+	switch name {
+	case notificationpreference.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case notificationpreference.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case notificationpreference.FieldUser:
+		m.ResetUser()
+		return nil
+	case notificationpreference.FieldEvent:
+		m.ResetEvent()
+		return nil
+	case notificationpreference.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	case notificationpreference.FieldChannels:
+		m.ResetChannels()
+		return nil
+	}
+	return fmt.Errorf("unknown NotificationPreference field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *NotificationPreferenceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *NotificationPreferenceMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *NotificationPreferenceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *NotificationPreferenceMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *NotificationPreferenceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *NotificationPreferenceMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *NotificationPreferenceMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown NotificationPreference unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *NotificationPreferenceMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown NotificationPreference edge %s", name)
+}
+
+// SentNotificationMutation represents an operation that mutates the SentNotification nodes in the graph.
+type SentNotificationMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *int
+	create_time      *time.Time
+	update_time      *time.Time
+	kind             *sentnotification.Kind
+	certificateId    *int
+	addcertificateId *int
+	threshold        *int
+	addthreshold     *int
+	recipient        *string
+	channel          *string
+	clearedFields    map[string]struct{}
+	done             bool
+	oldValue         func(context.Context) (*SentNotification, error)
+	predicates       []predicate.SentNotification
+}
+
+var _ ent.Mutation = (*SentNotificationMutation)(nil)
+
+// sentnotificationOption allows management of the mutation configuration using functional options.
+type sentnotificationOption func(*SentNotificationMutation)
+
+// newSentNotificationMutation creates new mutation for the SentNotification entity.
+func newSentNotificationMutation(c config, op Op, opts ...sentnotificationOption) *SentNotificationMutation {
+	m := &SentNotificationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSentNotification,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSentNotificationID sets the ID field of the mutation.
+func withSentNotificationID(id int) sentnotificationOption {
+	return func(m *SentNotificationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SentNotification
+		)
+		m.oldValue = func(ctx context.Context) (*SentNotification, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SentNotification.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSentNotification sets the old SentNotification of the mutation.
+func withSentNotification(node *SentNotification) sentnotificationOption {
+	return func(m *SentNotificationMutation) {
+		m.oldValue = func(context.Context) (*SentNotification, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SentNotificationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SentNotificationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SentNotificationMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SentNotificationMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SentNotification.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *SentNotificationMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *SentNotificationMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the SentNotification entity.
+// If the SentNotification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SentNotificationMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *SentNotificationMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *SentNotificationMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *SentNotificationMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the SentNotification entity.
+// If the SentNotification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SentNotificationMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *SentNotificationMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetKind sets the "kind" field.
+func (m *SentNotificationMutation) SetKind(s sentnotification.Kind) {
+	m.kind = &s
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *SentNotificationMutation) Kind() (r sentnotification.Kind, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the SentNotification entity.
+// If the SentNotification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SentNotificationMutation) OldKind(ctx context.Context) (v sentnotification.Kind, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *SentNotificationMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetCertificateId sets the "certificateId" field.
+func (m *SentNotificationMutation) SetCertificateId(i int) {
+	m.certificateId = &i
+	m.addcertificateId = nil
+}
+
+// CertificateId returns the value of the "certificateId" field in the mutation.
+func (m *SentNotificationMutation) CertificateId() (r int, exists bool) {
+	v := m.certificateId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCertificateId returns the old "certificateId" field's value of the SentNotification entity.
+// If the SentNotification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SentNotificationMutation) OldCertificateId(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCertificateId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCertificateId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCertificateId: %w", err)
+	}
+	return oldValue.CertificateId, nil
+}
+
+// AddCertificateId adds i to the "certificateId" field.
+func (m *SentNotificationMutation) AddCertificateId(i int) {
+	if m.addcertificateId != nil {
+		*m.addcertificateId += i
+	} else {
+		m.addcertificateId = &i
+	}
+}
+
+// AddedCertificateId returns the value that was added to the "certificateId" field in this mutation.
+func (m *SentNotificationMutation) AddedCertificateId() (r int, exists bool) {
+	v := m.addcertificateId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCertificateId resets all changes to the "certificateId" field.
+func (m *SentNotificationMutation) ResetCertificateId() {
+	m.certificateId = nil
+	m.addcertificateId = nil
+}
+
+// SetThreshold sets the "threshold" field.
+func (m *SentNotificationMutation) SetThreshold(i int) {
+	m.threshold = &i
+	m.addthreshold = nil
+}
+
+// Threshold returns the value of the "threshold" field in the mutation.
+func (m *SentNotificationMutation) Threshold() (r int, exists bool) {
+	v := m.threshold
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldThreshold returns the old "threshold" field's value of the SentNotification entity.
+// If the SentNotification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SentNotificationMutation) OldThreshold(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldThreshold is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldThreshold requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldThreshold: %w", err)
+	}
+	return oldValue.Threshold, nil
+}
+
+// AddThreshold adds i to the "threshold" field.
+func (m *SentNotificationMutation) AddThreshold(i int) {
+	if m.addthreshold != nil {
+		*m.addthreshold += i
+	} else {
+		m.addthreshold = &i
+	}
+}
+
+// AddedThreshold returns the value that was added to the "threshold" field in this mutation.
+func (m *SentNotificationMutation) AddedThreshold() (r int, exists bool) {
+	v := m.addthreshold
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetThreshold resets all changes to the "threshold" field.
+func (m *SentNotificationMutation) ResetThreshold() {
+	m.threshold = nil
+	m.addthreshold = nil
+}
+
+// SetRecipient sets the "recipient" field.
+func (m *SentNotificationMutation) SetRecipient(s string) {
+	m.recipient = &s
+}
+
+// Recipient returns the value of the "recipient" field in the mutation.
+func (m *SentNotificationMutation) Recipient() (r string, exists bool) {
+	v := m.recipient
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRecipient returns the old "recipient" field's value of the SentNotification entity.
+// If the SentNotification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SentNotificationMutation) OldRecipient(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRecipient is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRecipient requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRecipient: %w", err)
+	}
+	return oldValue.Recipient, nil
+}
+
+// ResetRecipient resets all changes to the "recipient" field.
+func (m *SentNotificationMutation) ResetRecipient() {
+	m.recipient = nil
+}
+
+// SetChannel sets the "channel" field.
+func (m *SentNotificationMutation) SetChannel(s string) {
+	m.channel = &s
+}
+
+// Channel returns the value of the "channel" field in the mutation.
+func (m *SentNotificationMutation) Channel() (r string, exists bool) {
+	v := m.channel
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChannel returns the old "channel" field's value of the SentNotification entity.
+// If the SentNotification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SentNotificationMutation) OldChannel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChannel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChannel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChannel: %w", err)
+	}
+	return oldValue.Channel, nil
+}
+
+// ResetChannel resets all changes to the "channel" field.
+func (m *SentNotificationMutation) ResetChannel() {
+	m.channel = nil
+}
+
+// Where appends a list predicates to the SentNotificationMutation builder.
+func (m *SentNotificationMutation) Where(ps ...predicate.SentNotification) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SentNotificationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SentNotificationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SentNotification, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SentNotificationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SentNotificationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SentNotification).
+func (m *SentNotificationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SentNotificationMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.create_time != nil {
+		fields = append(fields, sentnotification.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, sentnotification.FieldUpdateTime)
+	}
+	if m.kind != nil {
+		fields = append(fields, sentnotification.FieldKind)
+	}
+	if m.certificateId != nil {
+		fields = append(fields, sentnotification.FieldCertificateId)
+	}
+	if m.threshold != nil {
+		fields = append(fields, sentnotification.FieldThreshold)
+	}
+	if m.recipient != nil {
+		fields = append(fields, sentnotification.FieldRecipient)
+	}
+	if m.channel != nil {
+		fields = append(fields, sentnotification.FieldChannel)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SentNotificationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case sentnotification.FieldCreateTime:
 		return m.CreateTime()
-	case certificate.FieldUpdateTime:
+	case sentnotification.FieldUpdateTime:
 		return m.UpdateTime()
-	case certificate.FieldSslId:
-		return m.SslId()
-	case certificate.FieldTransactionId:
-		return m.TransactionId()
-	case certificate.FieldSerial:
-		return m.Serial()
-	case certificate.FieldCommonName:
-		return m.CommonName()
-	case certificate.FieldNotBefore:
-		return m.NotBefore()
-	case certificate.FieldNotAfter:
-		return m.NotAfter()
-	case certificate.FieldIssuedBy:
-		return m.IssuedBy()
-	case certificate.FieldSource:
-		return m.Source()
-	case certificate.FieldCreated:
-		return m.Created()
-	case certificate.FieldStatus:
-		return m.Status()
-	case certificate.FieldCa:
-		return m.Ca()
-	case certificate.FieldCertificate:
-		return m.Certificate()
+	case sentnotification.FieldKind:
+		return m.Kind()
+	case sentnotification.FieldCertificateId:
+		return m.CertificateId()
+	case sentnotification.FieldThreshold:
+		return m.Threshold()
+	case sentnotification.FieldRecipient:
+		return m.Recipient()
+	case sentnotification.FieldChannel:
+		return m.Channel()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SentNotificationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case sentnotification.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case sentnotification.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case sentnotification.FieldKind:
+		return m.OldKind(ctx)
+	case sentnotification.FieldCertificateId:
+		return m.OldCertificateId(ctx)
+	case sentnotification.FieldThreshold:
+		return m.OldThreshold(ctx)
+	case sentnotification.FieldRecipient:
+		return m.OldRecipient(ctx)
+	case sentnotification.FieldChannel:
+		return m.OldChannel(ctx)
+	}
+	return nil, fmt.Errorf("unknown SentNotification field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SentNotificationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case sentnotification.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case sentnotification.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case sentnotification.FieldKind:
+		v, ok := value.(sentnotification.Kind)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case sentnotification.FieldCertificateId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCertificateId(v)
+		return nil
+	case sentnotification.FieldThreshold:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetThreshold(v)
+		return nil
+	case sentnotification.FieldRecipient:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRecipient(v)
+		return nil
+	case sentnotification.FieldChannel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChannel(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SentNotification field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SentNotificationMutation) AddedFields() []string {
+	var fields []string
+	if m.addcertificateId != nil {
+		fields = append(fields, sentnotification.FieldCertificateId)
+	}
+	if m.addthreshold != nil {
+		fields = append(fields, sentnotification.FieldThreshold)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SentNotificationMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case sentnotification.FieldCertificateId:
+		return m.AddedCertificateId()
+	case sentnotification.FieldThreshold:
+		return m.AddedThreshold()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SentNotificationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case sentnotification.FieldCertificateId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCertificateId(v)
+		return nil
+	case sentnotification.FieldThreshold:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddThreshold(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SentNotification numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SentNotificationMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SentNotificationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SentNotificationMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown SentNotification nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SentNotificationMutation) ResetField(name string) error {
+	switch name {
+	case sentnotification.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case sentnotification.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case sentnotification.FieldKind:
+		m.ResetKind()
+		return nil
+	case sentnotification.FieldCertificateId:
+		m.ResetCertificateId()
+		return nil
+	case sentnotification.FieldThreshold:
+		m.ResetThreshold()
+		return nil
+	case sentnotification.FieldRecipient:
+		m.ResetRecipient()
+		return nil
+	case sentnotification.FieldChannel:
+		m.ResetChannel()
+		return nil
+	}
+	return fmt.Errorf("unknown SentNotification field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SentNotificationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SentNotificationMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SentNotificationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SentNotificationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SentNotificationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SentNotificationMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SentNotificationMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SentNotification unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SentNotificationMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SentNotification edge %s", name)
+}
+
+// SmimeCertificateMutation represents an operation that mutates the SmimeCertificate nodes in the graph.
+type SmimeCertificateMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *int
+	create_time      *time.Time
+	update_time      *time.Time
+	transactionId    *string
+	email            *string
+	serial           *string
+	notBefore        *time.Time
+	notAfter         *time.Time
+	created          *time.Time
+	status           *smimecertificate.Status
+	ca               *string
+	organization     *string
+	certificate      *string
+	owner            *string
+	clearedFields    map[string]struct{}
+	keyEscrow        *int
+	clearedkeyEscrow bool
+	done             bool
+	oldValue         func(context.Context) (*SmimeCertificate, error)
+	predicates       []predicate.SmimeCertificate
+}
+
+var _ ent.Mutation = (*SmimeCertificateMutation)(nil)
+
+// smimecertificateOption allows management of the mutation configuration using functional options.
+type smimecertificateOption func(*SmimeCertificateMutation)
+
+// newSmimeCertificateMutation creates new mutation for the SmimeCertificate entity.
+func newSmimeCertificateMutation(c config, op Op, opts ...smimecertificateOption) *SmimeCertificateMutation {
+	m := &SmimeCertificateMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSmimeCertificate,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSmimeCertificateID sets the ID field of the mutation.
+func withSmimeCertificateID(id int) smimecertificateOption {
+	return func(m *SmimeCertificateMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SmimeCertificate
+		)
+		m.oldValue = func(ctx context.Context) (*SmimeCertificate, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SmimeCertificate.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSmimeCertificate sets the old SmimeCertificate of the mutation.
+func withSmimeCertificate(node *SmimeCertificate) smimecertificateOption {
+	return func(m *SmimeCertificateMutation) {
+		m.oldValue = func(context.Context) (*SmimeCertificate, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SmimeCertificateMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SmimeCertificateMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SmimeCertificateMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SmimeCertificateMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SmimeCertificate.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *SmimeCertificateMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *SmimeCertificateMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
 	}
-	return nil, false
+	return *v, true
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *CertificateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case certificate.FieldCreateTime:
-		return m.OldCreateTime(ctx)
-	case certificate.FieldUpdateTime:
-		return m.OldUpdateTime(ctx)
-	case certificate.FieldSslId:
-		return m.OldSslId(ctx)
-	case certificate.FieldTransactionId:
-		return m.OldTransactionId(ctx)
-	case certificate.FieldSerial:
-		return m.OldSerial(ctx)
-	case certificate.FieldCommonName:
-		return m.OldCommonName(ctx)
-	case certificate.FieldNotBefore:
-		return m.OldNotBefore(ctx)
-	case certificate.FieldNotAfter:
-		return m.OldNotAfter(ctx)
-	case certificate.FieldIssuedBy:
-		return m.OldIssuedBy(ctx)
-	case certificate.FieldSource:
-		return m.OldSource(ctx)
-	case certificate.FieldCreated:
-		return m.OldCreated(ctx)
-	case certificate.FieldStatus:
-		return m.OldStatus(ctx)
-	case certificate.FieldCa:
-		return m.OldCa(ctx)
-	case certificate.FieldCertificate:
-		return m.OldCertificate(ctx)
+// OldCreateTime returns the old "create_time" field's value of the SmimeCertificate entity.
+// If the SmimeCertificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SmimeCertificateMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
-	return nil, fmt.Errorf("unknown Certificate field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *CertificateMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case certificate.FieldCreateTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreateTime(v)
-		return nil
-	case certificate.FieldUpdateTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdateTime(v)
-		return nil
-	case certificate.FieldSslId:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSslId(v)
-		return nil
-	case certificate.FieldTransactionId:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTransactionId(v)
-		return nil
-	case certificate.FieldSerial:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSerial(v)
-		return nil
-	case certificate.FieldCommonName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCommonName(v)
-		return nil
-	case certificate.FieldNotBefore:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetNotBefore(v)
-		return nil
-	case certificate.FieldNotAfter:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetNotAfter(v)
-		return nil
-	case certificate.FieldIssuedBy:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIssuedBy(v)
-		return nil
-	case certificate.FieldSource:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSource(v)
-		return nil
-	case certificate.FieldCreated:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreated(v)
-		return nil
-	case certificate.FieldStatus:
-		v, ok := value.(certificate.Status)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
-		return nil
-	case certificate.FieldCa:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCa(v)
-		return nil
-	case certificate.FieldCertificate:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCertificate(v)
-		return nil
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *SmimeCertificateMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *SmimeCertificateMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *SmimeCertificateMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Certificate field %s", name)
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the SmimeCertificate entity.
+// If the SmimeCertificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SmimeCertificateMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *SmimeCertificateMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetTransactionId sets the "transactionId" field.
+func (m *SmimeCertificateMutation) SetTransactionId(s string) {
+	m.transactionId = &s
+}
+
+// TransactionId returns the value of the "transactionId" field in the mutation.
+func (m *SmimeCertificateMutation) TransactionId() (r string, exists bool) {
+	v := m.transactionId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTransactionId returns the old "transactionId" field's value of the SmimeCertificate entity.
+// If the SmimeCertificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SmimeCertificateMutation) OldTransactionId(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTransactionId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTransactionId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTransactionId: %w", err)
+	}
+	return oldValue.TransactionId, nil
+}
+
+// ClearTransactionId clears the value of the "transactionId" field.
+func (m *SmimeCertificateMutation) ClearTransactionId() {
+	m.transactionId = nil
+	m.clearedFields[smimecertificate.FieldTransactionId] = struct{}{}
+}
+
+// TransactionIdCleared returns if the "transactionId" field was cleared in this mutation.
+func (m *SmimeCertificateMutation) TransactionIdCleared() bool {
+	_, ok := m.clearedFields[smimecertificate.FieldTransactionId]
+	return ok
+}
+
+// ResetTransactionId resets all changes to the "transactionId" field.
+func (m *SmimeCertificateMutation) ResetTransactionId() {
+	m.transactionId = nil
+	delete(m.clearedFields, smimecertificate.FieldTransactionId)
+}
+
+// SetEmail sets the "email" field.
+func (m *SmimeCertificateMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *SmimeCertificateMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *CertificateMutation) AddedFields() []string {
-	var fields []string
-	if m.addsslId != nil {
-		fields = append(fields, certificate.FieldSslId)
+// OldEmail returns the old "email" field's value of the SmimeCertificate entity.
+// If the SmimeCertificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SmimeCertificateMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
 	}
-	return fields
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *CertificateMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case certificate.FieldSslId:
-		return m.AddedSslId()
-	}
-	return nil, false
+// ResetEmail resets all changes to the "email" field.
+func (m *SmimeCertificateMutation) ResetEmail() {
+	m.email = nil
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *CertificateMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case certificate.FieldSslId:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddSslId(v)
-		return nil
-	}
-	return fmt.Errorf("unknown Certificate numeric field %s", name)
+// SetSerial sets the "serial" field.
+func (m *SmimeCertificateMutation) SetSerial(s string) {
+	m.serial = &s
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *CertificateMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(certificate.FieldSslId) {
-		fields = append(fields, certificate.FieldSslId)
-	}
-	if m.FieldCleared(certificate.FieldTransactionId) {
-		fields = append(fields, certificate.FieldTransactionId)
-	}
-	if m.FieldCleared(certificate.FieldSerial) {
-		fields = append(fields, certificate.FieldSerial)
+// Serial returns the value of the "serial" field in the mutation.
+func (m *SmimeCertificateMutation) Serial() (r string, exists bool) {
+	v := m.serial
+	if v == nil {
+		return
 	}
-	if m.FieldCleared(certificate.FieldNotBefore) {
-		fields = append(fields, certificate.FieldNotBefore)
+	return *v, true
+}
+
+// OldSerial returns the old "serial" field's value of the SmimeCertificate entity.
+// If the SmimeCertificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SmimeCertificateMutation) OldSerial(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSerial is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(certificate.FieldNotAfter) {
-		fields = append(fields, certificate.FieldNotAfter)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSerial requires an ID field in the mutation")
 	}
-	if m.FieldCleared(certificate.FieldIssuedBy) {
-		fields = append(fields, certificate.FieldIssuedBy)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSerial: %w", err)
 	}
-	if m.FieldCleared(certificate.FieldSource) {
-		fields = append(fields, certificate.FieldSource)
+	return oldValue.Serial, nil
+}
+
+// ResetSerial resets all changes to the "serial" field.
+func (m *SmimeCertificateMutation) ResetSerial() {
+	m.serial = nil
+}
+
+// SetNotBefore sets the "notBefore" field.
+func (m *SmimeCertificateMutation) SetNotBefore(t time.Time) {
+	m.notBefore = &t
+}
+
+// NotBefore returns the value of the "notBefore" field in the mutation.
+func (m *SmimeCertificateMutation) NotBefore() (r time.Time, exists bool) {
+	v := m.notBefore
+	if v == nil {
+		return
 	}
-	if m.FieldCleared(certificate.FieldCreated) {
-		fields = append(fields, certificate.FieldCreated)
+	return *v, true
+}
+
+// OldNotBefore returns the old "notBefore" field's value of the SmimeCertificate entity.
+// If the SmimeCertificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SmimeCertificateMutation) OldNotBefore(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNotBefore is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(certificate.FieldCa) {
-		fields = append(fields, certificate.FieldCa)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNotBefore requires an ID field in the mutation")
 	}
-	if m.FieldCleared(certificate.FieldCertificate) {
-		fields = append(fields, certificate.FieldCertificate)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNotBefore: %w", err)
 	}
-	return fields
+	return oldValue.NotBefore, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *CertificateMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
+// ClearNotBefore clears the value of the "notBefore" field.
+func (m *SmimeCertificateMutation) ClearNotBefore() {
+	m.notBefore = nil
+	m.clearedFields[smimecertificate.FieldNotBefore] = struct{}{}
+}
+
+// NotBeforeCleared returns if the "notBefore" field was cleared in this mutation.
+func (m *SmimeCertificateMutation) NotBeforeCleared() bool {
+	_, ok := m.clearedFields[smimecertificate.FieldNotBefore]
 	return ok
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *CertificateMutation) ClearField(name string) error {
-	switch name {
-	case certificate.FieldSslId:
-		m.ClearSslId()
-		return nil
-	case certificate.FieldTransactionId:
-		m.ClearTransactionId()
-		return nil
-	case certificate.FieldSerial:
-		m.ClearSerial()
-		return nil
-	case certificate.FieldNotBefore:
-		m.ClearNotBefore()
-		return nil
-	case certificate.FieldNotAfter:
-		m.ClearNotAfter()
-		return nil
-	case certificate.FieldIssuedBy:
-		m.ClearIssuedBy()
-		return nil
-	case certificate.FieldSource:
-		m.ClearSource()
-		return nil
-	case certificate.FieldCreated:
-		m.ClearCreated()
-		return nil
-	case certificate.FieldCa:
-		m.ClearCa()
-		return nil
-	case certificate.FieldCertificate:
-		m.ClearCertificate()
-		return nil
+// ResetNotBefore resets all changes to the "notBefore" field.
+func (m *SmimeCertificateMutation) ResetNotBefore() {
+	m.notBefore = nil
+	delete(m.clearedFields, smimecertificate.FieldNotBefore)
+}
+
+// SetNotAfter sets the "notAfter" field.
+func (m *SmimeCertificateMutation) SetNotAfter(t time.Time) {
+	m.notAfter = &t
+}
+
+// NotAfter returns the value of the "notAfter" field in the mutation.
+func (m *SmimeCertificateMutation) NotAfter() (r time.Time, exists bool) {
+	v := m.notAfter
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Certificate nullable field %s", name)
+	return *v, true
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *CertificateMutation) ResetField(name string) error {
-	switch name {
-	case certificate.FieldCreateTime:
-		m.ResetCreateTime()
-		return nil
-	case certificate.FieldUpdateTime:
-		m.ResetUpdateTime()
-		return nil
-	case certificate.FieldSslId:
-		m.ResetSslId()
-		return nil
-	case certificate.FieldTransactionId:
-		m.ResetTransactionId()
-		return nil
-	case certificate.FieldSerial:
-		m.ResetSerial()
-		return nil
-	case certificate.FieldCommonName:
-		m.ResetCommonName()
-		return nil
-	case certificate.FieldNotBefore:
-		m.ResetNotBefore()
-		return nil
-	case certificate.FieldNotAfter:
-		m.ResetNotAfter()
-		return nil
-	case certificate.FieldIssuedBy:
-		m.ResetIssuedBy()
-		return nil
-	case certificate.FieldSource:
-		m.ResetSource()
-		return nil
-	case certificate.FieldCreated:
-		m.ResetCreated()
-		return nil
-	case certificate.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case certificate.FieldCa:
-		m.ResetCa()
-		return nil
-	case certificate.FieldCertificate:
-		m.ResetCertificate()
-		return nil
+// OldNotAfter returns the old "notAfter" field's value of the SmimeCertificate entity.
+// If the SmimeCertificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SmimeCertificateMutation) OldNotAfter(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNotAfter is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown Certificate field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNotAfter requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNotAfter: %w", err)
+	}
+	return oldValue.NotAfter, nil
+}
+
+// ClearNotAfter clears the value of the "notAfter" field.
+func (m *SmimeCertificateMutation) ClearNotAfter() {
+	m.notAfter = nil
+	m.clearedFields[smimecertificate.FieldNotAfter] = struct{}{}
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *CertificateMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.domains != nil {
-		edges = append(edges, certificate.EdgeDomains)
-	}
-	return edges
+// NotAfterCleared returns if the "notAfter" field was cleared in this mutation.
+func (m *SmimeCertificateMutation) NotAfterCleared() bool {
+	_, ok := m.clearedFields[smimecertificate.FieldNotAfter]
+	return ok
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *CertificateMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case certificate.EdgeDomains:
-		ids := make([]ent.Value, 0, len(m.domains))
-		for id := range m.domains {
-			ids = append(ids, id)
-		}
-		return ids
-	}
-	return nil
+// ResetNotAfter resets all changes to the "notAfter" field.
+func (m *SmimeCertificateMutation) ResetNotAfter() {
+	m.notAfter = nil
+	delete(m.clearedFields, smimecertificate.FieldNotAfter)
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *CertificateMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removeddomains != nil {
-		edges = append(edges, certificate.EdgeDomains)
-	}
-	return edges
+// SetCreated sets the "created" field.
+func (m *SmimeCertificateMutation) SetCreated(t time.Time) {
+	m.created = &t
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *CertificateMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case certificate.EdgeDomains:
-		ids := make([]ent.Value, 0, len(m.removeddomains))
-		for id := range m.removeddomains {
-			ids = append(ids, id)
-		}
-		return ids
+// Created returns the value of the "created" field in the mutation.
+func (m *SmimeCertificateMutation) Created() (r time.Time, exists bool) {
+	v := m.created
+	if v == nil {
+		return
 	}
-	return nil
+	return *v, true
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *CertificateMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.cleareddomains {
-		edges = append(edges, certificate.EdgeDomains)
+// OldCreated returns the old "created" field's value of the SmimeCertificate entity.
+// If the SmimeCertificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SmimeCertificateMutation) OldCreated(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreated is only allowed on UpdateOne operations")
 	}
-	return edges
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreated requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreated: %w", err)
+	}
+	return oldValue.Created, nil
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *CertificateMutation) EdgeCleared(name string) bool {
-	switch name {
-	case certificate.EdgeDomains:
-		return m.cleareddomains
-	}
-	return false
+// ClearCreated clears the value of the "created" field.
+func (m *SmimeCertificateMutation) ClearCreated() {
+	m.created = nil
+	m.clearedFields[smimecertificate.FieldCreated] = struct{}{}
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *CertificateMutation) ClearEdge(name string) error {
-	switch name {
+// CreatedCleared returns if the "created" field was cleared in this mutation.
+func (m *SmimeCertificateMutation) CreatedCleared() bool {
+	_, ok := m.clearedFields[smimecertificate.FieldCreated]
+	return ok
+}
+
+// ResetCreated resets all changes to the "created" field.
+func (m *SmimeCertificateMutation) ResetCreated() {
+	m.created = nil
+	delete(m.clearedFields, smimecertificate.FieldCreated)
+}
+
+// SetStatus sets the "status" field.
+func (m *SmimeCertificateMutation) SetStatus(s smimecertificate.Status) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *SmimeCertificateMutation) Status() (r smimecertificate.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Certificate unique edge %s", name)
+	return *v, true
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *CertificateMutation) ResetEdge(name string) error {
-	switch name {
-	case certificate.EdgeDomains:
-		m.ResetDomains()
-		return nil
+// OldStatus returns the old "status" field's value of the SmimeCertificate entity.
+// If the SmimeCertificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SmimeCertificateMutation) OldStatus(ctx context.Context) (v smimecertificate.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown Certificate edge %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
 }
 
-// DomainMutation represents an operation that mutates the Domain nodes in the graph.
-type DomainMutation struct {
-	config
-	op                  Op
-	typ                 string
-	id                  *int
-	fqdn                *string
-	clearedFields       map[string]struct{}
-	certificates        map[int]struct{}
-	removedcertificates map[int]struct{}
-	clearedcertificates bool
-	done                bool
-	oldValue            func(context.Context) (*Domain, error)
-	predicates          []predicate.Domain
+// ResetStatus resets all changes to the "status" field.
+func (m *SmimeCertificateMutation) ResetStatus() {
+	m.status = nil
 }
 
-var _ ent.Mutation = (*DomainMutation)(nil)
+// SetCa sets the "ca" field.
+func (m *SmimeCertificateMutation) SetCa(s string) {
+	m.ca = &s
+}
 
-// domainOption allows management of the mutation configuration using functional options.
-type domainOption func(*DomainMutation)
+// Ca returns the value of the "ca" field in the mutation.
+func (m *SmimeCertificateMutation) Ca() (r string, exists bool) {
+	v := m.ca
+	if v == nil {
+		return
+	}
+	return *v, true
+}
 
-// newDomainMutation creates new mutation for the Domain entity.
-func newDomainMutation(c config, op Op, opts ...domainOption) *DomainMutation {
-	m := &DomainMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeDomain,
-		clearedFields: make(map[string]struct{}),
+// OldCa returns the old "ca" field's value of the SmimeCertificate entity.
+// If the SmimeCertificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SmimeCertificateMutation) OldCa(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCa is only allowed on UpdateOne operations")
 	}
-	for _, opt := range opts {
-		opt(m)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCa requires an ID field in the mutation")
 	}
-	return m
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCa: %w", err)
+	}
+	return oldValue.Ca, nil
 }
 
-// withDomainID sets the ID field of the mutation.
-func withDomainID(id int) domainOption {
-	return func(m *DomainMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *Domain
-		)
-		m.oldValue = func(ctx context.Context) (*Domain, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().Domain.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// ClearCa clears the value of the "ca" field.
+func (m *SmimeCertificateMutation) ClearCa() {
+	m.ca = nil
+	m.clearedFields[smimecertificate.FieldCa] = struct{}{}
+}
+
+// CaCleared returns if the "ca" field was cleared in this mutation.
+func (m *SmimeCertificateMutation) CaCleared() bool {
+	_, ok := m.clearedFields[smimecertificate.FieldCa]
+	return ok
+}
+
+// ResetCa resets all changes to the "ca" field.
+func (m *SmimeCertificateMutation) ResetCa() {
+	m.ca = nil
+	delete(m.clearedFields, smimecertificate.FieldCa)
+}
+
+// SetOrganization sets the "organization" field.
+func (m *SmimeCertificateMutation) SetOrganization(s string) {
+	m.organization = &s
+}
+
+// Organization returns the value of the "organization" field in the mutation.
+func (m *SmimeCertificateMutation) Organization() (r string, exists bool) {
+	v := m.organization
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// withDomain sets the old Domain of the mutation.
-func withDomain(node *Domain) domainOption {
-	return func(m *DomainMutation) {
-		m.oldValue = func(context.Context) (*Domain, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// OldOrganization returns the old "organization" field's value of the SmimeCertificate entity.
+// If the SmimeCertificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SmimeCertificateMutation) OldOrganization(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrganization is only allowed on UpdateOne operations")
 	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrganization requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrganization: %w", err)
+	}
+	return oldValue.Organization, nil
+}
+
+// ClearOrganization clears the value of the "organization" field.
+func (m *SmimeCertificateMutation) ClearOrganization() {
+	m.organization = nil
+	m.clearedFields[smimecertificate.FieldOrganization] = struct{}{}
+}
+
+// OrganizationCleared returns if the "organization" field was cleared in this mutation.
+func (m *SmimeCertificateMutation) OrganizationCleared() bool {
+	_, ok := m.clearedFields[smimecertificate.FieldOrganization]
+	return ok
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DomainMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ResetOrganization resets all changes to the "organization" field.
+func (m *SmimeCertificateMutation) ResetOrganization() {
+	m.organization = nil
+	delete(m.clearedFields, smimecertificate.FieldOrganization)
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m DomainMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// SetCertificate sets the "certificate" field.
+func (m *SmimeCertificateMutation) SetCertificate(s string) {
+	m.certificate = &s
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *DomainMutation) ID() (id int, exists bool) {
-	if m.id == nil {
+// Certificate returns the value of the "certificate" field in the mutation.
+func (m *SmimeCertificateMutation) Certificate() (r string, exists bool) {
+	v := m.certificate
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *DomainMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Domain.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// OldCertificate returns the old "certificate" field's value of the SmimeCertificate entity.
+// If the SmimeCertificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SmimeCertificateMutation) OldCertificate(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCertificate is only allowed on UpdateOne operations")
 	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCertificate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCertificate: %w", err)
+	}
+	return oldValue.Certificate, nil
 }
 
-// SetFqdn sets the "fqdn" field.
-func (m *DomainMutation) SetFqdn(s string) {
-	m.fqdn = &s
+// ClearCertificate clears the value of the "certificate" field.
+func (m *SmimeCertificateMutation) ClearCertificate() {
+	m.certificate = nil
+	m.clearedFields[smimecertificate.FieldCertificate] = struct{}{}
 }
 
-// Fqdn returns the value of the "fqdn" field in the mutation.
-func (m *DomainMutation) Fqdn() (r string, exists bool) {
-	v := m.fqdn
+// CertificateCleared returns if the "certificate" field was cleared in this mutation.
+func (m *SmimeCertificateMutation) CertificateCleared() bool {
+	_, ok := m.clearedFields[smimecertificate.FieldCertificate]
+	return ok
+}
+
+// ResetCertificate resets all changes to the "certificate" field.
+func (m *SmimeCertificateMutation) ResetCertificate() {
+	m.certificate = nil
+	delete(m.clearedFields, smimecertificate.FieldCertificate)
+}
+
+// SetOwner sets the "owner" field.
+func (m *SmimeCertificateMutation) SetOwner(s string) {
+	m.owner = &s
+}
+
+// Owner returns the value of the "owner" field in the mutation.
+func (m *SmimeCertificateMutation) Owner() (r string, exists bool) {
+	v := m.owner
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFqdn returns the old "fqdn" field's value of the Domain entity.
-// If the Domain object wasn't provided to the builder, the object is fetched from the database.
+// OldOwner returns the old "owner" field's value of the SmimeCertificate entity.
+// If the SmimeCertificate object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DomainMutation) OldFqdn(ctx context.Context) (v string, err error) {
+func (m *SmimeCertificateMutation) OldOwner(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFqdn is only allowed on UpdateOne operations")
+		return v, errors.New("OldOwner is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFqdn requires an ID field in the mutation")
+		return v, errors.New("OldOwner requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFqdn: %w", err)
+		return v, fmt.Errorf("querying old value for OldOwner: %w", err)
 	}
-	return oldValue.Fqdn, nil
+	return oldValue.Owner, nil
 }
 
-// ResetFqdn resets all changes to the "fqdn" field.
-func (m *DomainMutation) ResetFqdn() {
-	m.fqdn = nil
+// ClearOwner clears the value of the "owner" field.
+func (m *SmimeCertificateMutation) ClearOwner() {
+	m.owner = nil
+	m.clearedFields[smimecertificate.FieldOwner] = struct{}{}
 }
 
-// AddCertificateIDs adds the "certificates" edge to the Certificate entity by ids.
-func (m *DomainMutation) AddCertificateIDs(ids ...int) {
-	if m.certificates == nil {
-		m.certificates = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.certificates[ids[i]] = struct{}{}
-	}
+// OwnerCleared returns if the "owner" field was cleared in this mutation.
+func (m *SmimeCertificateMutation) OwnerCleared() bool {
+	_, ok := m.clearedFields[smimecertificate.FieldOwner]
+	return ok
 }
 
-// ClearCertificates clears the "certificates" edge to the Certificate entity.
-func (m *DomainMutation) ClearCertificates() {
-	m.clearedcertificates = true
+// ResetOwner resets all changes to the "owner" field.
+func (m *SmimeCertificateMutation) ResetOwner() {
+	m.owner = nil
+	delete(m.clearedFields, smimecertificate.FieldOwner)
 }
 
-// CertificatesCleared reports if the "certificates" edge to the Certificate entity was cleared.
-func (m *DomainMutation) CertificatesCleared() bool {
-	return m.clearedcertificates
+// SetKeyEscrowID sets the "keyEscrow" edge to the KeyEscrow entity by id.
+func (m *SmimeCertificateMutation) SetKeyEscrowID(id int) {
+	m.keyEscrow = &id
 }
 
-// RemoveCertificateIDs removes the "certificates" edge to the Certificate entity by IDs.
-func (m *DomainMutation) RemoveCertificateIDs(ids ...int) {
-	if m.removedcertificates == nil {
-		m.removedcertificates = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.certificates, ids[i])
-		m.removedcertificates[ids[i]] = struct{}{}
-	}
+// ClearKeyEscrow clears the "keyEscrow" edge to the KeyEscrow entity.
+func (m *SmimeCertificateMutation) ClearKeyEscrow() {
+	m.clearedkeyEscrow = true
 }
 
-// RemovedCertificates returns the removed IDs of the "certificates" edge to the Certificate entity.
-func (m *DomainMutation) RemovedCertificatesIDs() (ids []int) {
-	for id := range m.removedcertificates {
-		ids = append(ids, id)
+// KeyEscrowCleared reports if the "keyEscrow" edge to the KeyEscrow entity was cleared.
+func (m *SmimeCertificateMutation) KeyEscrowCleared() bool {
+	return m.clearedkeyEscrow
+}
+
+// KeyEscrowID returns the "keyEscrow" edge ID in the mutation.
+func (m *SmimeCertificateMutation) KeyEscrowID() (id int, exists bool) {
+	if m.keyEscrow != nil {
+		return *m.keyEscrow, true
 	}
 	return
 }
 
-// CertificatesIDs returns the "certificates" edge IDs in the mutation.
-func (m *DomainMutation) CertificatesIDs() (ids []int) {
-	for id := range m.certificates {
-		ids = append(ids, id)
+// KeyEscrowIDs returns the "keyEscrow" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// KeyEscrowID instead. It exists only for internal usage by the builders.
+func (m *SmimeCertificateMutation) KeyEscrowIDs() (ids []int) {
+	if id := m.keyEscrow; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetCertificates resets all changes to the "certificates" edge.
-func (m *DomainMutation) ResetCertificates() {
-	m.certificates = nil
-	m.clearedcertificates = false
-	m.removedcertificates = nil
+// ResetKeyEscrow resets all changes to the "keyEscrow" edge.
+func (m *SmimeCertificateMutation) ResetKeyEscrow() {
+	m.keyEscrow = nil
+	m.clearedkeyEscrow = false
 }
 
-// Where appends a list predicates to the DomainMutation builder.
-func (m *DomainMutation) Where(ps ...predicate.Domain) {
+// Where appends a list predicates to the SmimeCertificateMutation builder.
+func (m *SmimeCertificateMutation) Where(ps ...predicate.SmimeCertificate) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the DomainMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SmimeCertificateMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DomainMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Domain, len(ps))
+func (m *SmimeCertificateMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SmimeCertificate, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1602,759 +8368,767 @@ func (m *DomainMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *DomainMutation) Op() Op {
+func (m *SmimeCertificateMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *DomainMutation) SetOp(op Op) {
+func (m *SmimeCertificateMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Domain).
-func (m *DomainMutation) Type() string {
+// Type returns the node type of this mutation (SmimeCertificate).
+func (m *SmimeCertificateMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *DomainMutation) Fields() []string {
-	fields := make([]string, 0, 1)
-	if m.fqdn != nil {
-		fields = append(fields, domain.FieldFqdn)
+func (m *SmimeCertificateMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.create_time != nil {
+		fields = append(fields, smimecertificate.FieldCreateTime)
 	}
-	return fields
-}
-
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *DomainMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case domain.FieldFqdn:
-		return m.Fqdn()
+	if m.update_time != nil {
+		fields = append(fields, smimecertificate.FieldUpdateTime)
 	}
-	return nil, false
-}
-
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *DomainMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case domain.FieldFqdn:
-		return m.OldFqdn(ctx)
+	if m.transactionId != nil {
+		fields = append(fields, smimecertificate.FieldTransactionId)
 	}
-	return nil, fmt.Errorf("unknown Domain field %s", name)
-}
-
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *DomainMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case domain.FieldFqdn:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFqdn(v)
-		return nil
+	if m.email != nil {
+		fields = append(fields, smimecertificate.FieldEmail)
 	}
-	return fmt.Errorf("unknown Domain field %s", name)
-}
-
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *DomainMutation) AddedFields() []string {
-	return nil
-}
-
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *DomainMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
-}
-
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *DomainMutation) AddField(name string, value ent.Value) error {
-	switch name {
+	if m.serial != nil {
+		fields = append(fields, smimecertificate.FieldSerial)
 	}
-	return fmt.Errorf("unknown Domain numeric field %s", name)
-}
-
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *DomainMutation) ClearedFields() []string {
-	return nil
-}
-
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *DomainMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
-}
-
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *DomainMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Domain nullable field %s", name)
-}
-
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *DomainMutation) ResetField(name string) error {
-	switch name {
-	case domain.FieldFqdn:
-		m.ResetFqdn()
-		return nil
+	if m.notBefore != nil {
+		fields = append(fields, smimecertificate.FieldNotBefore)
 	}
-	return fmt.Errorf("unknown Domain field %s", name)
-}
-
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DomainMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.certificates != nil {
-		edges = append(edges, domain.EdgeCertificates)
+	if m.notAfter != nil {
+		fields = append(fields, smimecertificate.FieldNotAfter)
+	}
+	if m.created != nil {
+		fields = append(fields, smimecertificate.FieldCreated)
 	}
-	return edges
-}
-
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *DomainMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case domain.EdgeCertificates:
-		ids := make([]ent.Value, 0, len(m.certificates))
-		for id := range m.certificates {
-			ids = append(ids, id)
-		}
-		return ids
+	if m.status != nil {
+		fields = append(fields, smimecertificate.FieldStatus)
 	}
-	return nil
-}
-
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DomainMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedcertificates != nil {
-		edges = append(edges, domain.EdgeCertificates)
+	if m.ca != nil {
+		fields = append(fields, smimecertificate.FieldCa)
 	}
-	return edges
-}
-
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *DomainMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case domain.EdgeCertificates:
-		ids := make([]ent.Value, 0, len(m.removedcertificates))
-		for id := range m.removedcertificates {
-			ids = append(ids, id)
-		}
-		return ids
+	if m.organization != nil {
+		fields = append(fields, smimecertificate.FieldOrganization)
 	}
-	return nil
-}
-
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DomainMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedcertificates {
-		edges = append(edges, domain.EdgeCertificates)
+	if m.certificate != nil {
+		fields = append(fields, smimecertificate.FieldCertificate)
 	}
-	return edges
+	if m.owner != nil {
+		fields = append(fields, smimecertificate.FieldOwner)
+	}
+	return fields
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *DomainMutation) EdgeCleared(name string) bool {
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SmimeCertificateMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case domain.EdgeCertificates:
-		return m.clearedcertificates
+	case smimecertificate.FieldCreateTime:
+		return m.CreateTime()
+	case smimecertificate.FieldUpdateTime:
+		return m.UpdateTime()
+	case smimecertificate.FieldTransactionId:
+		return m.TransactionId()
+	case smimecertificate.FieldEmail:
+		return m.Email()
+	case smimecertificate.FieldSerial:
+		return m.Serial()
+	case smimecertificate.FieldNotBefore:
+		return m.NotBefore()
+	case smimecertificate.FieldNotAfter:
+		return m.NotAfter()
+	case smimecertificate.FieldCreated:
+		return m.Created()
+	case smimecertificate.FieldStatus:
+		return m.Status()
+	case smimecertificate.FieldCa:
+		return m.Ca()
+	case smimecertificate.FieldOrganization:
+		return m.Organization()
+	case smimecertificate.FieldCertificate:
+		return m.Certificate()
+	case smimecertificate.FieldOwner:
+		return m.Owner()
 	}
-	return false
+	return nil, false
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *DomainMutation) ClearEdge(name string) error {
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SmimeCertificateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
+	case smimecertificate.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case smimecertificate.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case smimecertificate.FieldTransactionId:
+		return m.OldTransactionId(ctx)
+	case smimecertificate.FieldEmail:
+		return m.OldEmail(ctx)
+	case smimecertificate.FieldSerial:
+		return m.OldSerial(ctx)
+	case smimecertificate.FieldNotBefore:
+		return m.OldNotBefore(ctx)
+	case smimecertificate.FieldNotAfter:
+		return m.OldNotAfter(ctx)
+	case smimecertificate.FieldCreated:
+		return m.OldCreated(ctx)
+	case smimecertificate.FieldStatus:
+		return m.OldStatus(ctx)
+	case smimecertificate.FieldCa:
+		return m.OldCa(ctx)
+	case smimecertificate.FieldOrganization:
+		return m.OldOrganization(ctx)
+	case smimecertificate.FieldCertificate:
+		return m.OldCertificate(ctx)
+	case smimecertificate.FieldOwner:
+		return m.OldOwner(ctx)
 	}
-	return fmt.Errorf("unknown Domain unique edge %s", name)
+	return nil, fmt.Errorf("unknown SmimeCertificate field %s", name)
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *DomainMutation) ResetEdge(name string) error {
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SmimeCertificateMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case domain.EdgeCertificates:
-		m.ResetCertificates()
+	case smimecertificate.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
 		return nil
-	}
-	return fmt.Errorf("unknown Domain edge %s", name)
-}
-
-// SmimeCertificateMutation represents an operation that mutates the SmimeCertificate nodes in the graph.
-type SmimeCertificateMutation struct {
-	config
-	op            Op
-	typ           string
-	id            *int
-	create_time   *time.Time
-	update_time   *time.Time
-	transactionId *string
-	email         *string
-	serial        *string
-	notBefore     *time.Time
-	notAfter      *time.Time
-	created       *time.Time
-	status        *smimecertificate.Status
-	ca            *string
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*SmimeCertificate, error)
-	predicates    []predicate.SmimeCertificate
-}
-
-var _ ent.Mutation = (*SmimeCertificateMutation)(nil)
-
-// smimecertificateOption allows management of the mutation configuration using functional options.
-type smimecertificateOption func(*SmimeCertificateMutation)
-
-// newSmimeCertificateMutation creates new mutation for the SmimeCertificate entity.
-func newSmimeCertificateMutation(c config, op Op, opts ...smimecertificateOption) *SmimeCertificateMutation {
-	m := &SmimeCertificateMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeSmimeCertificate,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
-	}
-	return m
-}
-
-// withSmimeCertificateID sets the ID field of the mutation.
-func withSmimeCertificateID(id int) smimecertificateOption {
-	return func(m *SmimeCertificateMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *SmimeCertificate
-		)
-		m.oldValue = func(ctx context.Context) (*SmimeCertificate, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().SmimeCertificate.Get(ctx, id)
-				}
-			})
-			return value, err
+	case smimecertificate.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case smimecertificate.FieldTransactionId:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTransactionId(v)
+		return nil
+	case smimecertificate.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
+	case smimecertificate.FieldSerial:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSerial(v)
+		return nil
+	case smimecertificate.FieldNotBefore:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNotBefore(v)
+		return nil
+	case smimecertificate.FieldNotAfter:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNotAfter(v)
+		return nil
+	case smimecertificate.FieldCreated:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreated(v)
+		return nil
+	case smimecertificate.FieldStatus:
+		v, ok := value.(smimecertificate.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case smimecertificate.FieldCa:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCa(v)
+		return nil
+	case smimecertificate.FieldOrganization:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrganization(v)
+		return nil
+	case smimecertificate.FieldCertificate:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCertificate(v)
+		return nil
+	case smimecertificate.FieldOwner:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.id = &id
+		m.SetOwner(v)
+		return nil
 	}
+	return fmt.Errorf("unknown SmimeCertificate field %s", name)
 }
 
-// withSmimeCertificate sets the old SmimeCertificate of the mutation.
-func withSmimeCertificate(node *SmimeCertificate) smimecertificateOption {
-	return func(m *SmimeCertificateMutation) {
-		m.oldValue = func(context.Context) (*SmimeCertificate, error) {
-			return node, nil
-		}
-		m.id = &node.ID
-	}
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SmimeCertificateMutation) AddedFields() []string {
+	return nil
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SmimeCertificateMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SmimeCertificateMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m SmimeCertificateMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SmimeCertificateMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+	return fmt.Errorf("unknown SmimeCertificate numeric field %s", name)
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *SmimeCertificateMutation) ID() (id int, exists bool) {
-	if m.id == nil {
-		return
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SmimeCertificateMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(smimecertificate.FieldTransactionId) {
+		fields = append(fields, smimecertificate.FieldTransactionId)
 	}
-	return *m.id, true
-}
-
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *SmimeCertificateMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().SmimeCertificate.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	if m.FieldCleared(smimecertificate.FieldNotBefore) {
+		fields = append(fields, smimecertificate.FieldNotBefore)
 	}
-}
-
-// SetCreateTime sets the "create_time" field.
-func (m *SmimeCertificateMutation) SetCreateTime(t time.Time) {
-	m.create_time = &t
-}
-
-// CreateTime returns the value of the "create_time" field in the mutation.
-func (m *SmimeCertificateMutation) CreateTime() (r time.Time, exists bool) {
-	v := m.create_time
-	if v == nil {
-		return
+	if m.FieldCleared(smimecertificate.FieldNotAfter) {
+		fields = append(fields, smimecertificate.FieldNotAfter)
 	}
-	return *v, true
-}
-
-// OldCreateTime returns the old "create_time" field's value of the SmimeCertificate entity.
-// If the SmimeCertificate object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SmimeCertificateMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	if m.FieldCleared(smimecertificate.FieldCreated) {
+		fields = append(fields, smimecertificate.FieldCreated)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	if m.FieldCleared(smimecertificate.FieldCa) {
+		fields = append(fields, smimecertificate.FieldCa)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	if m.FieldCleared(smimecertificate.FieldOrganization) {
+		fields = append(fields, smimecertificate.FieldOrganization)
 	}
-	return oldValue.CreateTime, nil
+	if m.FieldCleared(smimecertificate.FieldCertificate) {
+		fields = append(fields, smimecertificate.FieldCertificate)
+	}
+	if m.FieldCleared(smimecertificate.FieldOwner) {
+		fields = append(fields, smimecertificate.FieldOwner)
+	}
+	return fields
 }
 
-// ResetCreateTime resets all changes to the "create_time" field.
-func (m *SmimeCertificateMutation) ResetCreateTime() {
-	m.create_time = nil
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SmimeCertificateMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// SetUpdateTime sets the "update_time" field.
-func (m *SmimeCertificateMutation) SetUpdateTime(t time.Time) {
-	m.update_time = &t
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SmimeCertificateMutation) ClearField(name string) error {
+	switch name {
+	case smimecertificate.FieldTransactionId:
+		m.ClearTransactionId()
+		return nil
+	case smimecertificate.FieldNotBefore:
+		m.ClearNotBefore()
+		return nil
+	case smimecertificate.FieldNotAfter:
+		m.ClearNotAfter()
+		return nil
+	case smimecertificate.FieldCreated:
+		m.ClearCreated()
+		return nil
+	case smimecertificate.FieldCa:
+		m.ClearCa()
+		return nil
+	case smimecertificate.FieldOrganization:
+		m.ClearOrganization()
+		return nil
+	case smimecertificate.FieldCertificate:
+		m.ClearCertificate()
+		return nil
+	case smimecertificate.FieldOwner:
+		m.ClearOwner()
+		return nil
+	}
+	return fmt.Errorf("unknown SmimeCertificate nullable field %s", name)
 }
 
-// UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *SmimeCertificateMutation) UpdateTime() (r time.Time, exists bool) {
-	v := m.update_time
-	if v == nil {
-		return
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SmimeCertificateMutation) ResetField(name string) error {
+	switch name {
+	case smimecertificate.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case smimecertificate.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case smimecertificate.FieldTransactionId:
+		m.ResetTransactionId()
+		return nil
+	case smimecertificate.FieldEmail:
+		m.ResetEmail()
+		return nil
+	case smimecertificate.FieldSerial:
+		m.ResetSerial()
+		return nil
+	case smimecertificate.FieldNotBefore:
+		m.ResetNotBefore()
+		return nil
+	case smimecertificate.FieldNotAfter:
+		m.ResetNotAfter()
+		return nil
+	case smimecertificate.FieldCreated:
+		m.ResetCreated()
+		return nil
+	case smimecertificate.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case smimecertificate.FieldCa:
+		m.ResetCa()
+		return nil
+	case smimecertificate.FieldOrganization:
+		m.ResetOrganization()
+		return nil
+	case smimecertificate.FieldCertificate:
+		m.ResetCertificate()
+		return nil
+	case smimecertificate.FieldOwner:
+		m.ResetOwner()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown SmimeCertificate field %s", name)
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the SmimeCertificate entity.
-// If the SmimeCertificate object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SmimeCertificateMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SmimeCertificateMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.keyEscrow != nil {
+		edges = append(edges, smimecertificate.EdgeKeyEscrow)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SmimeCertificateMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case smimecertificate.EdgeKeyEscrow:
+		if id := m.keyEscrow; id != nil {
+			return []ent.Value{*id}
+		}
 	}
-	return oldValue.UpdateTime, nil
+	return nil
 }
 
-// ResetUpdateTime resets all changes to the "update_time" field.
-func (m *SmimeCertificateMutation) ResetUpdateTime() {
-	m.update_time = nil
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SmimeCertificateMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
 }
 
-// SetTransactionId sets the "transactionId" field.
-func (m *SmimeCertificateMutation) SetTransactionId(s string) {
-	m.transactionId = &s
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SmimeCertificateMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// TransactionId returns the value of the "transactionId" field in the mutation.
-func (m *SmimeCertificateMutation) TransactionId() (r string, exists bool) {
-	v := m.transactionId
-	if v == nil {
-		return
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SmimeCertificateMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedkeyEscrow {
+		edges = append(edges, smimecertificate.EdgeKeyEscrow)
 	}
-	return *v, true
+	return edges
 }
 
-// OldTransactionId returns the old "transactionId" field's value of the SmimeCertificate entity.
-// If the SmimeCertificate object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SmimeCertificateMutation) OldTransactionId(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTransactionId is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTransactionId requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTransactionId: %w", err)
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SmimeCertificateMutation) EdgeCleared(name string) bool {
+	switch name {
+	case smimecertificate.EdgeKeyEscrow:
+		return m.clearedkeyEscrow
 	}
-	return oldValue.TransactionId, nil
+	return false
 }
 
-// ClearTransactionId clears the value of the "transactionId" field.
-func (m *SmimeCertificateMutation) ClearTransactionId() {
-	m.transactionId = nil
-	m.clearedFields[smimecertificate.FieldTransactionId] = struct{}{}
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SmimeCertificateMutation) ClearEdge(name string) error {
+	switch name {
+	case smimecertificate.EdgeKeyEscrow:
+		m.ClearKeyEscrow()
+		return nil
+	}
+	return fmt.Errorf("unknown SmimeCertificate unique edge %s", name)
 }
 
-// TransactionIdCleared returns if the "transactionId" field was cleared in this mutation.
-func (m *SmimeCertificateMutation) TransactionIdCleared() bool {
-	_, ok := m.clearedFields[smimecertificate.FieldTransactionId]
-	return ok
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SmimeCertificateMutation) ResetEdge(name string) error {
+	switch name {
+	case smimecertificate.EdgeKeyEscrow:
+		m.ResetKeyEscrow()
+		return nil
+	}
+	return fmt.Errorf("unknown SmimeCertificate edge %s", name)
 }
 
-// ResetTransactionId resets all changes to the "transactionId" field.
-func (m *SmimeCertificateMutation) ResetTransactionId() {
-	m.transactionId = nil
-	delete(m.clearedFields, smimecertificate.FieldTransactionId)
+// TermsAcceptanceMutation represents an operation that mutates the TermsAcceptance nodes in the graph.
+type TermsAcceptanceMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	create_time   *time.Time
+	update_time   *time.Time
+	user          *string
+	version       *string
+	source        *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*TermsAcceptance, error)
+	predicates    []predicate.TermsAcceptance
 }
 
-// SetEmail sets the "email" field.
-func (m *SmimeCertificateMutation) SetEmail(s string) {
-	m.email = &s
-}
+var _ ent.Mutation = (*TermsAcceptanceMutation)(nil)
 
-// Email returns the value of the "email" field in the mutation.
-func (m *SmimeCertificateMutation) Email() (r string, exists bool) {
-	v := m.email
-	if v == nil {
-		return
-	}
-	return *v, true
-}
+// termsacceptanceOption allows management of the mutation configuration using functional options.
+type termsacceptanceOption func(*TermsAcceptanceMutation)
 
-// OldEmail returns the old "email" field's value of the SmimeCertificate entity.
-// If the SmimeCertificate object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SmimeCertificateMutation) OldEmail(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+// newTermsAcceptanceMutation creates new mutation for the TermsAcceptance entity.
+func newTermsAcceptanceMutation(c config, op Op, opts ...termsacceptanceOption) *TermsAcceptanceMutation {
+	m := &TermsAcceptanceMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTermsAcceptance,
+		clearedFields: make(map[string]struct{}),
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmail requires an ID field in the mutation")
+	for _, opt := range opts {
+		opt(m)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	return m
+}
+
+// withTermsAcceptanceID sets the ID field of the mutation.
+func withTermsAcceptanceID(id int) termsacceptanceOption {
+	return func(m *TermsAcceptanceMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TermsAcceptance
+		)
+		m.oldValue = func(ctx context.Context) (*TermsAcceptance, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TermsAcceptance.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return oldValue.Email, nil
 }
 
-// ResetEmail resets all changes to the "email" field.
-func (m *SmimeCertificateMutation) ResetEmail() {
-	m.email = nil
+// withTermsAcceptance sets the old TermsAcceptance of the mutation.
+func withTermsAcceptance(node *TermsAcceptance) termsacceptanceOption {
+	return func(m *TermsAcceptanceMutation) {
+		m.oldValue = func(context.Context) (*TermsAcceptance, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// SetSerial sets the "serial" field.
-func (m *SmimeCertificateMutation) SetSerial(s string) {
-	m.serial = &s
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TermsAcceptanceMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// Serial returns the value of the "serial" field in the mutation.
-func (m *SmimeCertificateMutation) Serial() (r string, exists bool) {
-	v := m.serial
-	if v == nil {
-		return
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TermsAcceptanceMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	return *v, true
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// OldSerial returns the old "serial" field's value of the SmimeCertificate entity.
-// If the SmimeCertificate object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SmimeCertificateMutation) OldSerial(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSerial is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSerial requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSerial: %w", err)
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TermsAcceptanceMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
 	}
-	return oldValue.Serial, nil
+	return *m.id, true
 }
 
-// ResetSerial resets all changes to the "serial" field.
-func (m *SmimeCertificateMutation) ResetSerial() {
-	m.serial = nil
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TermsAcceptanceMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TermsAcceptance.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetNotBefore sets the "notBefore" field.
-func (m *SmimeCertificateMutation) SetNotBefore(t time.Time) {
-	m.notBefore = &t
+// SetCreateTime sets the "create_time" field.
+func (m *TermsAcceptanceMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
 }
 
-// NotBefore returns the value of the "notBefore" field in the mutation.
-func (m *SmimeCertificateMutation) NotBefore() (r time.Time, exists bool) {
-	v := m.notBefore
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *TermsAcceptanceMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNotBefore returns the old "notBefore" field's value of the SmimeCertificate entity.
-// If the SmimeCertificate object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the TermsAcceptance entity.
+// If the TermsAcceptance object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SmimeCertificateMutation) OldNotBefore(ctx context.Context) (v *time.Time, err error) {
+func (m *TermsAcceptanceMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNotBefore is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNotBefore requires an ID field in the mutation")
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNotBefore: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
 	}
-	return oldValue.NotBefore, nil
-}
-
-// ClearNotBefore clears the value of the "notBefore" field.
-func (m *SmimeCertificateMutation) ClearNotBefore() {
-	m.notBefore = nil
-	m.clearedFields[smimecertificate.FieldNotBefore] = struct{}{}
-}
-
-// NotBeforeCleared returns if the "notBefore" field was cleared in this mutation.
-func (m *SmimeCertificateMutation) NotBeforeCleared() bool {
-	_, ok := m.clearedFields[smimecertificate.FieldNotBefore]
-	return ok
+	return oldValue.CreateTime, nil
 }
 
-// ResetNotBefore resets all changes to the "notBefore" field.
-func (m *SmimeCertificateMutation) ResetNotBefore() {
-	m.notBefore = nil
-	delete(m.clearedFields, smimecertificate.FieldNotBefore)
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *TermsAcceptanceMutation) ResetCreateTime() {
+	m.create_time = nil
 }
 
-// SetNotAfter sets the "notAfter" field.
-func (m *SmimeCertificateMutation) SetNotAfter(t time.Time) {
-	m.notAfter = &t
+// SetUpdateTime sets the "update_time" field.
+func (m *TermsAcceptanceMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
 }
 
-// NotAfter returns the value of the "notAfter" field in the mutation.
-func (m *SmimeCertificateMutation) NotAfter() (r time.Time, exists bool) {
-	v := m.notAfter
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *TermsAcceptanceMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNotAfter returns the old "notAfter" field's value of the SmimeCertificate entity.
-// If the SmimeCertificate object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the TermsAcceptance entity.
+// If the TermsAcceptance object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SmimeCertificateMutation) OldNotAfter(ctx context.Context) (v time.Time, err error) {
+func (m *TermsAcceptanceMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNotAfter is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNotAfter requires an ID field in the mutation")
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNotAfter: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
 	}
-	return oldValue.NotAfter, nil
-}
-
-// ClearNotAfter clears the value of the "notAfter" field.
-func (m *SmimeCertificateMutation) ClearNotAfter() {
-	m.notAfter = nil
-	m.clearedFields[smimecertificate.FieldNotAfter] = struct{}{}
-}
-
-// NotAfterCleared returns if the "notAfter" field was cleared in this mutation.
-func (m *SmimeCertificateMutation) NotAfterCleared() bool {
-	_, ok := m.clearedFields[smimecertificate.FieldNotAfter]
-	return ok
+	return oldValue.UpdateTime, nil
 }
 
-// ResetNotAfter resets all changes to the "notAfter" field.
-func (m *SmimeCertificateMutation) ResetNotAfter() {
-	m.notAfter = nil
-	delete(m.clearedFields, smimecertificate.FieldNotAfter)
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *TermsAcceptanceMutation) ResetUpdateTime() {
+	m.update_time = nil
 }
 
-// SetCreated sets the "created" field.
-func (m *SmimeCertificateMutation) SetCreated(t time.Time) {
-	m.created = &t
+// SetUser sets the "user" field.
+func (m *TermsAcceptanceMutation) SetUser(s string) {
+	m.user = &s
 }
 
-// Created returns the value of the "created" field in the mutation.
-func (m *SmimeCertificateMutation) Created() (r time.Time, exists bool) {
-	v := m.created
+// User returns the value of the "user" field in the mutation.
+func (m *TermsAcceptanceMutation) User() (r string, exists bool) {
+	v := m.user
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreated returns the old "created" field's value of the SmimeCertificate entity.
-// If the SmimeCertificate object wasn't provided to the builder, the object is fetched from the database.
+// OldUser returns the old "user" field's value of the TermsAcceptance entity.
+// If the TermsAcceptance object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SmimeCertificateMutation) OldCreated(ctx context.Context) (v *time.Time, err error) {
+func (m *TermsAcceptanceMutation) OldUser(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreated is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreated requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreated: %w", err)
+		return v, errors.New("OldUser is only allowed on UpdateOne operations")
 	}
-	return oldValue.Created, nil
-}
-
-// ClearCreated clears the value of the "created" field.
-func (m *SmimeCertificateMutation) ClearCreated() {
-	m.created = nil
-	m.clearedFields[smimecertificate.FieldCreated] = struct{}{}
-}
-
-// CreatedCleared returns if the "created" field was cleared in this mutation.
-func (m *SmimeCertificateMutation) CreatedCleared() bool {
-	_, ok := m.clearedFields[smimecertificate.FieldCreated]
-	return ok
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUser requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUser: %w", err)
+	}
+	return oldValue.User, nil
 }
 
-// ResetCreated resets all changes to the "created" field.
-func (m *SmimeCertificateMutation) ResetCreated() {
-	m.created = nil
-	delete(m.clearedFields, smimecertificate.FieldCreated)
+// ResetUser resets all changes to the "user" field.
+func (m *TermsAcceptanceMutation) ResetUser() {
+	m.user = nil
 }
 
-// SetStatus sets the "status" field.
-func (m *SmimeCertificateMutation) SetStatus(s smimecertificate.Status) {
-	m.status = &s
+// SetVersion sets the "version" field.
+func (m *TermsAcceptanceMutation) SetVersion(s string) {
+	m.version = &s
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *SmimeCertificateMutation) Status() (r smimecertificate.Status, exists bool) {
-	v := m.status
+// Version returns the value of the "version" field in the mutation.
+func (m *TermsAcceptanceMutation) Version() (r string, exists bool) {
+	v := m.version
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the SmimeCertificate entity.
-// If the SmimeCertificate object wasn't provided to the builder, the object is fetched from the database.
+// OldVersion returns the old "version" field's value of the TermsAcceptance entity.
+// If the TermsAcceptance object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SmimeCertificateMutation) OldStatus(ctx context.Context) (v smimecertificate.Status, err error) {
+func (m *TermsAcceptanceMutation) OldVersion(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldVersion requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.Version, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *SmimeCertificateMutation) ResetStatus() {
-	m.status = nil
+// ResetVersion resets all changes to the "version" field.
+func (m *TermsAcceptanceMutation) ResetVersion() {
+	m.version = nil
 }
 
-// SetCa sets the "ca" field.
-func (m *SmimeCertificateMutation) SetCa(s string) {
-	m.ca = &s
+// SetSource sets the "source" field.
+func (m *TermsAcceptanceMutation) SetSource(s string) {
+	m.source = &s
 }
 
-// Ca returns the value of the "ca" field in the mutation.
-func (m *SmimeCertificateMutation) Ca() (r string, exists bool) {
-	v := m.ca
+// Source returns the value of the "source" field in the mutation.
+func (m *TermsAcceptanceMutation) Source() (r string, exists bool) {
+	v := m.source
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCa returns the old "ca" field's value of the SmimeCertificate entity.
-// If the SmimeCertificate object wasn't provided to the builder, the object is fetched from the database.
+// OldSource returns the old "source" field's value of the TermsAcceptance entity.
+// If the TermsAcceptance object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SmimeCertificateMutation) OldCa(ctx context.Context) (v *string, err error) {
+func (m *TermsAcceptanceMutation) OldSource(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCa is only allowed on UpdateOne operations")
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCa requires an ID field in the mutation")
+		return v, errors.New("OldSource requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCa: %w", err)
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
 	}
-	return oldValue.Ca, nil
+	return oldValue.Source, nil
 }
 
-// ClearCa clears the value of the "ca" field.
-func (m *SmimeCertificateMutation) ClearCa() {
-	m.ca = nil
-	m.clearedFields[smimecertificate.FieldCa] = struct{}{}
+// ClearSource clears the value of the "source" field.
+func (m *TermsAcceptanceMutation) ClearSource() {
+	m.source = nil
+	m.clearedFields[termsacceptance.FieldSource] = struct{}{}
 }
 
-// CaCleared returns if the "ca" field was cleared in this mutation.
-func (m *SmimeCertificateMutation) CaCleared() bool {
-	_, ok := m.clearedFields[smimecertificate.FieldCa]
+// SourceCleared returns if the "source" field was cleared in this mutation.
+func (m *TermsAcceptanceMutation) SourceCleared() bool {
+	_, ok := m.clearedFields[termsacceptance.FieldSource]
 	return ok
 }
 
-// ResetCa resets all changes to the "ca" field.
-func (m *SmimeCertificateMutation) ResetCa() {
-	m.ca = nil
-	delete(m.clearedFields, smimecertificate.FieldCa)
+// ResetSource resets all changes to the "source" field.
+func (m *TermsAcceptanceMutation) ResetSource() {
+	m.source = nil
+	delete(m.clearedFields, termsacceptance.FieldSource)
 }
 
-// Where appends a list predicates to the SmimeCertificateMutation builder.
-func (m *SmimeCertificateMutation) Where(ps ...predicate.SmimeCertificate) {
+// Where appends a list predicates to the TermsAcceptanceMutation builder.
+func (m *TermsAcceptanceMutation) Where(ps ...predicate.TermsAcceptance) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the SmimeCertificateMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TermsAcceptanceMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SmimeCertificateMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.SmimeCertificate, len(ps))
+func (m *TermsAcceptanceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TermsAcceptance, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2362,54 +9136,39 @@ func (m *SmimeCertificateMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *SmimeCertificateMutation) Op() Op {
+func (m *TermsAcceptanceMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *SmimeCertificateMutation) SetOp(op Op) {
+func (m *TermsAcceptanceMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (SmimeCertificate).
-func (m *SmimeCertificateMutation) Type() string {
+// Type returns the node type of this mutation (TermsAcceptance).
+func (m *TermsAcceptanceMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SmimeCertificateMutation) Fields() []string {
-	fields := make([]string, 0, 10)
+func (m *TermsAcceptanceMutation) Fields() []string {
+	fields := make([]string, 0, 5)
 	if m.create_time != nil {
-		fields = append(fields, smimecertificate.FieldCreateTime)
+		fields = append(fields, termsacceptance.FieldCreateTime)
 	}
 	if m.update_time != nil {
-		fields = append(fields, smimecertificate.FieldUpdateTime)
-	}
-	if m.transactionId != nil {
-		fields = append(fields, smimecertificate.FieldTransactionId)
-	}
-	if m.email != nil {
-		fields = append(fields, smimecertificate.FieldEmail)
+		fields = append(fields, termsacceptance.FieldUpdateTime)
 	}
-	if m.serial != nil {
-		fields = append(fields, smimecertificate.FieldSerial)
-	}
-	if m.notBefore != nil {
-		fields = append(fields, smimecertificate.FieldNotBefore)
-	}
-	if m.notAfter != nil {
-		fields = append(fields, smimecertificate.FieldNotAfter)
+	if m.user != nil {
+		fields = append(fields, termsacceptance.FieldUser)
 	}
-	if m.created != nil {
-		fields = append(fields, smimecertificate.FieldCreated)
-	}
-	if m.status != nil {
-		fields = append(fields, smimecertificate.FieldStatus)
+	if m.version != nil {
+		fields = append(fields, termsacceptance.FieldVersion)
 	}
-	if m.ca != nil {
-		fields = append(fields, smimecertificate.FieldCa)
+	if m.source != nil {
+		fields = append(fields, termsacceptance.FieldSource)
 	}
 	return fields
 }
@@ -2417,28 +9176,18 @@ func (m *SmimeCertificateMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SmimeCertificateMutation) Field(name string) (ent.Value, bool) {
+func (m *TermsAcceptanceMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case smimecertificate.FieldCreateTime:
+	case termsacceptance.FieldCreateTime:
 		return m.CreateTime()
-	case smimecertificate.FieldUpdateTime:
+	case termsacceptance.FieldUpdateTime:
 		return m.UpdateTime()
-	case smimecertificate.FieldTransactionId:
-		return m.TransactionId()
-	case smimecertificate.FieldEmail:
-		return m.Email()
-	case smimecertificate.FieldSerial:
-		return m.Serial()
-	case smimecertificate.FieldNotBefore:
-		return m.NotBefore()
-	case smimecertificate.FieldNotAfter:
-		return m.NotAfter()
-	case smimecertificate.FieldCreated:
-		return m.Created()
-	case smimecertificate.FieldStatus:
-		return m.Status()
-	case smimecertificate.FieldCa:
-		return m.Ca()
+	case termsacceptance.FieldUser:
+		return m.User()
+	case termsacceptance.FieldVersion:
+		return m.Version()
+	case termsacceptance.FieldSource:
+		return m.Source()
 	}
 	return nil, false
 }
@@ -2446,267 +9195,183 @@ func (m *SmimeCertificateMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SmimeCertificateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TermsAcceptanceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case smimecertificate.FieldCreateTime:
+	case termsacceptance.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case smimecertificate.FieldUpdateTime:
+	case termsacceptance.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case smimecertificate.FieldTransactionId:
-		return m.OldTransactionId(ctx)
-	case smimecertificate.FieldEmail:
-		return m.OldEmail(ctx)
-	case smimecertificate.FieldSerial:
-		return m.OldSerial(ctx)
-	case smimecertificate.FieldNotBefore:
-		return m.OldNotBefore(ctx)
-	case smimecertificate.FieldNotAfter:
-		return m.OldNotAfter(ctx)
-	case smimecertificate.FieldCreated:
-		return m.OldCreated(ctx)
-	case smimecertificate.FieldStatus:
-		return m.OldStatus(ctx)
-	case smimecertificate.FieldCa:
-		return m.OldCa(ctx)
+	case termsacceptance.FieldUser:
+		return m.OldUser(ctx)
+	case termsacceptance.FieldVersion:
+		return m.OldVersion(ctx)
+	case termsacceptance.FieldSource:
+		return m.OldSource(ctx)
 	}
-	return nil, fmt.Errorf("unknown SmimeCertificate field %s", name)
+	return nil, fmt.Errorf("unknown TermsAcceptance field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SmimeCertificateMutation) SetField(name string, value ent.Value) error {
+func (m *TermsAcceptanceMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case smimecertificate.FieldCreateTime:
+	case termsacceptance.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateTime(v)
 		return nil
-	case smimecertificate.FieldUpdateTime:
+	case termsacceptance.FieldUpdateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdateTime(v)
 		return nil
-	case smimecertificate.FieldTransactionId:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTransactionId(v)
-		return nil
-	case smimecertificate.FieldEmail:
+	case termsacceptance.FieldUser:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEmail(v)
+		m.SetUser(v)
 		return nil
-	case smimecertificate.FieldSerial:
+	case termsacceptance.FieldVersion:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSerial(v)
-		return nil
-	case smimecertificate.FieldNotBefore:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetNotBefore(v)
-		return nil
-	case smimecertificate.FieldNotAfter:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetNotAfter(v)
-		return nil
-	case smimecertificate.FieldCreated:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreated(v)
-		return nil
-	case smimecertificate.FieldStatus:
-		v, ok := value.(smimecertificate.Status)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
+		m.SetVersion(v)
 		return nil
-	case smimecertificate.FieldCa:
+	case termsacceptance.FieldSource:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCa(v)
+		m.SetSource(v)
 		return nil
 	}
-	return fmt.Errorf("unknown SmimeCertificate field %s", name)
+	return fmt.Errorf("unknown TermsAcceptance field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SmimeCertificateMutation) AddedFields() []string {
+func (m *TermsAcceptanceMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SmimeCertificateMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TermsAcceptanceMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SmimeCertificateMutation) AddField(name string, value ent.Value) error {
+func (m *TermsAcceptanceMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown SmimeCertificate numeric field %s", name)
+	return fmt.Errorf("unknown TermsAcceptance numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SmimeCertificateMutation) ClearedFields() []string {
+func (m *TermsAcceptanceMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(smimecertificate.FieldTransactionId) {
-		fields = append(fields, smimecertificate.FieldTransactionId)
-	}
-	if m.FieldCleared(smimecertificate.FieldNotBefore) {
-		fields = append(fields, smimecertificate.FieldNotBefore)
-	}
-	if m.FieldCleared(smimecertificate.FieldNotAfter) {
-		fields = append(fields, smimecertificate.FieldNotAfter)
-	}
-	if m.FieldCleared(smimecertificate.FieldCreated) {
-		fields = append(fields, smimecertificate.FieldCreated)
-	}
-	if m.FieldCleared(smimecertificate.FieldCa) {
-		fields = append(fields, smimecertificate.FieldCa)
+	if m.FieldCleared(termsacceptance.FieldSource) {
+		fields = append(fields, termsacceptance.FieldSource)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SmimeCertificateMutation) FieldCleared(name string) bool {
+func (m *TermsAcceptanceMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SmimeCertificateMutation) ClearField(name string) error {
+func (m *TermsAcceptanceMutation) ClearField(name string) error {
 	switch name {
-	case smimecertificate.FieldTransactionId:
-		m.ClearTransactionId()
-		return nil
-	case smimecertificate.FieldNotBefore:
-		m.ClearNotBefore()
-		return nil
-	case smimecertificate.FieldNotAfter:
-		m.ClearNotAfter()
-		return nil
-	case smimecertificate.FieldCreated:
-		m.ClearCreated()
-		return nil
-	case smimecertificate.FieldCa:
-		m.ClearCa()
+	case termsacceptance.FieldSource:
+		m.ClearSource()
 		return nil
 	}
-	return fmt.Errorf("unknown SmimeCertificate nullable field %s", name)
+	return fmt.Errorf("unknown TermsAcceptance nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SmimeCertificateMutation) ResetField(name string) error {
+func (m *TermsAcceptanceMutation) ResetField(name string) error {
 	switch name {
-	case smimecertificate.FieldCreateTime:
+	case termsacceptance.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
-	case smimecertificate.FieldUpdateTime:
+	case termsacceptance.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case smimecertificate.FieldTransactionId:
-		m.ResetTransactionId()
-		return nil
-	case smimecertificate.FieldEmail:
-		m.ResetEmail()
-		return nil
-	case smimecertificate.FieldSerial:
-		m.ResetSerial()
-		return nil
-	case smimecertificate.FieldNotBefore:
-		m.ResetNotBefore()
-		return nil
-	case smimecertificate.FieldNotAfter:
-		m.ResetNotAfter()
-		return nil
-	case smimecertificate.FieldCreated:
-		m.ResetCreated()
+	case termsacceptance.FieldUser:
+		m.ResetUser()
 		return nil
-	case smimecertificate.FieldStatus:
-		m.ResetStatus()
+	case termsacceptance.FieldVersion:
+		m.ResetVersion()
 		return nil
-	case smimecertificate.FieldCa:
-		m.ResetCa()
+	case termsacceptance.FieldSource:
+		m.ResetSource()
 		return nil
 	}
-	return fmt.Errorf("unknown SmimeCertificate field %s", name)
+	return fmt.Errorf("unknown TermsAcceptance field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SmimeCertificateMutation) AddedEdges() []string {
+func (m *TermsAcceptanceMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SmimeCertificateMutation) AddedIDs(name string) []ent.Value {
+func (m *TermsAcceptanceMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SmimeCertificateMutation) RemovedEdges() []string {
+func (m *TermsAcceptanceMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SmimeCertificateMutation) RemovedIDs(name string) []ent.Value {
+func (m *TermsAcceptanceMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SmimeCertificateMutation) ClearedEdges() []string {
+func (m *TermsAcceptanceMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SmimeCertificateMutation) EdgeCleared(name string) bool {
+func (m *TermsAcceptanceMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SmimeCertificateMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown SmimeCertificate unique edge %s", name)
+func (m *TermsAcceptanceMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown TermsAcceptance unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SmimeCertificateMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown SmimeCertificate edge %s", name)
+func (m *TermsAcceptanceMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown TermsAcceptance edge %s", name)
 }
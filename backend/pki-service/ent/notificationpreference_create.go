@@ -0,0 +1,761 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hm-edu/pki-service/ent/notificationpreference"
+)
+
+// NotificationPreferenceCreate is the builder for creating a NotificationPreference entity.
+type NotificationPreferenceCreate struct {
+	config
+	mutation *NotificationPreferenceMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *NotificationPreferenceCreate) SetCreateTime(v time.Time) *NotificationPreferenceCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *NotificationPreferenceCreate) SetNillableCreateTime(v *time.Time) *NotificationPreferenceCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *NotificationPreferenceCreate) SetUpdateTime(v time.Time) *NotificationPreferenceCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *NotificationPreferenceCreate) SetNillableUpdateTime(v *time.Time) *NotificationPreferenceCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetUser sets the "user" field.
+func (_c *NotificationPreferenceCreate) SetUser(v string) *NotificationPreferenceCreate {
+	_c.mutation.SetUser(v)
+	return _c
+}
+
+// SetEvent sets the "event" field.
+func (_c *NotificationPreferenceCreate) SetEvent(v string) *NotificationPreferenceCreate {
+	_c.mutation.SetEvent(v)
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *NotificationPreferenceCreate) SetEnabled(v bool) *NotificationPreferenceCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *NotificationPreferenceCreate) SetNillableEnabled(v *bool) *NotificationPreferenceCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetChannels sets the "channels" field.
+func (_c *NotificationPreferenceCreate) SetChannels(v []string) *NotificationPreferenceCreate {
+	_c.mutation.SetChannels(v)
+	return _c
+}
+
+// Mutation returns the NotificationPreferenceMutation object of the builder.
+func (_c *NotificationPreferenceCreate) Mutation() *NotificationPreferenceMutation {
+	return _c.mutation
+}
+
+// Save creates the NotificationPreference in the database.
+func (_c *NotificationPreferenceCreate) Save(ctx context.Context) (*NotificationPreference, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *NotificationPreferenceCreate) SaveX(ctx context.Context) *NotificationPreference {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *NotificationPreferenceCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *NotificationPreferenceCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *NotificationPreferenceCreate) defaults() {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		v := notificationpreference.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		v := notificationpreference.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := notificationpreference.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *NotificationPreferenceCreate) check() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "NotificationPreference.create_time"`)}
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "NotificationPreference.update_time"`)}
+	}
+	if _, ok := _c.mutation.User(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required field "NotificationPreference.user"`)}
+	}
+	if v, ok := _c.mutation.User(); ok {
+		if err := notificationpreference.UserValidator(v); err != nil {
+			return &ValidationError{Name: "user", err: fmt.Errorf(`ent: validator failed for field "NotificationPreference.user": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Event(); !ok {
+		return &ValidationError{Name: "event", err: errors.New(`ent: missing required field "NotificationPreference.event"`)}
+	}
+	if v, ok := _c.mutation.Event(); ok {
+		if err := notificationpreference.EventValidator(v); err != nil {
+			return &ValidationError{Name: "event", err: fmt.Errorf(`ent: validator failed for field "NotificationPreference.event": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "NotificationPreference.enabled"`)}
+	}
+	return nil
+}
+
+func (_c *NotificationPreferenceCreate) sqlSave(ctx context.Context) (*NotificationPreference, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *NotificationPreferenceCreate) createSpec() (*NotificationPreference, *sqlgraph.CreateSpec) {
+	var (
+		_node = &NotificationPreference{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(notificationpreference.Table, sqlgraph.NewFieldSpec(notificationpreference.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(notificationpreference.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(notificationpreference.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.User(); ok {
+		_spec.SetField(notificationpreference.FieldUser, field.TypeString, value)
+		_node.User = value
+	}
+	if value, ok := _c.mutation.Event(); ok {
+		_spec.SetField(notificationpreference.FieldEvent, field.TypeString, value)
+		_node.Event = value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(notificationpreference.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := _c.mutation.Channels(); ok {
+		_spec.SetField(notificationpreference.FieldChannels, field.TypeJSON, value)
+		_node.Channels = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.NotificationPreference.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.NotificationPreferenceUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *NotificationPreferenceCreate) OnConflict(opts ...sql.ConflictOption) *NotificationPreferenceUpsertOne {
+	_c.conflict = opts
+	return &NotificationPreferenceUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.NotificationPreference.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *NotificationPreferenceCreate) OnConflictColumns(columns ...string) *NotificationPreferenceUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &NotificationPreferenceUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// NotificationPreferenceUpsertOne is the builder for "upsert"-ing
+	//  one NotificationPreference node.
+	NotificationPreferenceUpsertOne struct {
+		create *NotificationPreferenceCreate
+	}
+
+	// NotificationPreferenceUpsert is the "OnConflict" setter.
+	NotificationPreferenceUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *NotificationPreferenceUpsert) SetUpdateTime(v time.Time) *NotificationPreferenceUpsert {
+	u.Set(notificationpreference.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsert) UpdateUpdateTime() *NotificationPreferenceUpsert {
+	u.SetExcluded(notificationpreference.FieldUpdateTime)
+	return u
+}
+
+// SetUser sets the "user" field.
+func (u *NotificationPreferenceUpsert) SetUser(v string) *NotificationPreferenceUpsert {
+	u.Set(notificationpreference.FieldUser, v)
+	return u
+}
+
+// UpdateUser sets the "user" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsert) UpdateUser() *NotificationPreferenceUpsert {
+	u.SetExcluded(notificationpreference.FieldUser)
+	return u
+}
+
+// SetEvent sets the "event" field.
+func (u *NotificationPreferenceUpsert) SetEvent(v string) *NotificationPreferenceUpsert {
+	u.Set(notificationpreference.FieldEvent, v)
+	return u
+}
+
+// UpdateEvent sets the "event" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsert) UpdateEvent() *NotificationPreferenceUpsert {
+	u.SetExcluded(notificationpreference.FieldEvent)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *NotificationPreferenceUpsert) SetEnabled(v bool) *NotificationPreferenceUpsert {
+	u.Set(notificationpreference.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsert) UpdateEnabled() *NotificationPreferenceUpsert {
+	u.SetExcluded(notificationpreference.FieldEnabled)
+	return u
+}
+
+// SetChannels sets the "channels" field.
+func (u *NotificationPreferenceUpsert) SetChannels(v []string) *NotificationPreferenceUpsert {
+	u.Set(notificationpreference.FieldChannels, v)
+	return u
+}
+
+// UpdateChannels sets the "channels" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsert) UpdateChannels() *NotificationPreferenceUpsert {
+	u.SetExcluded(notificationpreference.FieldChannels)
+	return u
+}
+
+// ClearChannels clears the value of the "channels" field.
+func (u *NotificationPreferenceUpsert) ClearChannels() *NotificationPreferenceUpsert {
+	u.SetNull(notificationpreference.FieldChannels)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.NotificationPreference.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *NotificationPreferenceUpsertOne) UpdateNewValues() *NotificationPreferenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(notificationpreference.FieldCreateTime)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.NotificationPreference.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *NotificationPreferenceUpsertOne) Ignore() *NotificationPreferenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NotificationPreferenceUpsertOne) DoNothing() *NotificationPreferenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NotificationPreferenceCreate.OnConflict
+// documentation for more info.
+func (u *NotificationPreferenceUpsertOne) Update(set func(*NotificationPreferenceUpsert)) *NotificationPreferenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NotificationPreferenceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *NotificationPreferenceUpsertOne) SetUpdateTime(v time.Time) *NotificationPreferenceUpsertOne {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsertOne) UpdateUpdateTime() *NotificationPreferenceUpsertOne {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetUser sets the "user" field.
+func (u *NotificationPreferenceUpsertOne) SetUser(v string) *NotificationPreferenceUpsertOne {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.SetUser(v)
+	})
+}
+
+// UpdateUser sets the "user" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsertOne) UpdateUser() *NotificationPreferenceUpsertOne {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.UpdateUser()
+	})
+}
+
+// SetEvent sets the "event" field.
+func (u *NotificationPreferenceUpsertOne) SetEvent(v string) *NotificationPreferenceUpsertOne {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.SetEvent(v)
+	})
+}
+
+// UpdateEvent sets the "event" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsertOne) UpdateEvent() *NotificationPreferenceUpsertOne {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.UpdateEvent()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *NotificationPreferenceUpsertOne) SetEnabled(v bool) *NotificationPreferenceUpsertOne {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsertOne) UpdateEnabled() *NotificationPreferenceUpsertOne {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetChannels sets the "channels" field.
+func (u *NotificationPreferenceUpsertOne) SetChannels(v []string) *NotificationPreferenceUpsertOne {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.SetChannels(v)
+	})
+}
+
+// UpdateChannels sets the "channels" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsertOne) UpdateChannels() *NotificationPreferenceUpsertOne {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.UpdateChannels()
+	})
+}
+
+// ClearChannels clears the value of the "channels" field.
+func (u *NotificationPreferenceUpsertOne) ClearChannels() *NotificationPreferenceUpsertOne {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.ClearChannels()
+	})
+}
+
+// Exec executes the query.
+func (u *NotificationPreferenceUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for NotificationPreferenceCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NotificationPreferenceUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *NotificationPreferenceUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *NotificationPreferenceUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// NotificationPreferenceCreateBulk is the builder for creating many NotificationPreference entities in bulk.
+type NotificationPreferenceCreateBulk struct {
+	config
+	err      error
+	builders []*NotificationPreferenceCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the NotificationPreference entities in the database.
+func (_c *NotificationPreferenceCreateBulk) Save(ctx context.Context) ([]*NotificationPreference, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*NotificationPreference, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*NotificationPreferenceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *NotificationPreferenceCreateBulk) SaveX(ctx context.Context) []*NotificationPreference {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *NotificationPreferenceCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *NotificationPreferenceCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.NotificationPreference.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.NotificationPreferenceUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *NotificationPreferenceCreateBulk) OnConflict(opts ...sql.ConflictOption) *NotificationPreferenceUpsertBulk {
+	_c.conflict = opts
+	return &NotificationPreferenceUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.NotificationPreference.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *NotificationPreferenceCreateBulk) OnConflictColumns(columns ...string) *NotificationPreferenceUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &NotificationPreferenceUpsertBulk{
+		create: _c,
+	}
+}
+
+// NotificationPreferenceUpsertBulk is the builder for "upsert"-ing
+// a bulk of NotificationPreference nodes.
+type NotificationPreferenceUpsertBulk struct {
+	create *NotificationPreferenceCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.NotificationPreference.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *NotificationPreferenceUpsertBulk) UpdateNewValues() *NotificationPreferenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(notificationpreference.FieldCreateTime)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.NotificationPreference.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *NotificationPreferenceUpsertBulk) Ignore() *NotificationPreferenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NotificationPreferenceUpsertBulk) DoNothing() *NotificationPreferenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NotificationPreferenceCreateBulk.OnConflict
+// documentation for more info.
+func (u *NotificationPreferenceUpsertBulk) Update(set func(*NotificationPreferenceUpsert)) *NotificationPreferenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NotificationPreferenceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *NotificationPreferenceUpsertBulk) SetUpdateTime(v time.Time) *NotificationPreferenceUpsertBulk {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsertBulk) UpdateUpdateTime() *NotificationPreferenceUpsertBulk {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetUser sets the "user" field.
+func (u *NotificationPreferenceUpsertBulk) SetUser(v string) *NotificationPreferenceUpsertBulk {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.SetUser(v)
+	})
+}
+
+// UpdateUser sets the "user" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsertBulk) UpdateUser() *NotificationPreferenceUpsertBulk {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.UpdateUser()
+	})
+}
+
+// SetEvent sets the "event" field.
+func (u *NotificationPreferenceUpsertBulk) SetEvent(v string) *NotificationPreferenceUpsertBulk {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.SetEvent(v)
+	})
+}
+
+// UpdateEvent sets the "event" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsertBulk) UpdateEvent() *NotificationPreferenceUpsertBulk {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.UpdateEvent()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *NotificationPreferenceUpsertBulk) SetEnabled(v bool) *NotificationPreferenceUpsertBulk {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsertBulk) UpdateEnabled() *NotificationPreferenceUpsertBulk {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetChannels sets the "channels" field.
+func (u *NotificationPreferenceUpsertBulk) SetChannels(v []string) *NotificationPreferenceUpsertBulk {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.SetChannels(v)
+	})
+}
+
+// UpdateChannels sets the "channels" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsertBulk) UpdateChannels() *NotificationPreferenceUpsertBulk {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.UpdateChannels()
+	})
+}
+
+// ClearChannels clears the value of the "channels" field.
+func (u *NotificationPreferenceUpsertBulk) ClearChannels() *NotificationPreferenceUpsertBulk {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.ClearChannels()
+	})
+}
+
+// Exec executes the query.
+func (u *NotificationPreferenceUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the NotificationPreferenceCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for NotificationPreferenceCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NotificationPreferenceUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,409 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/hm-edu/pki-service/ent/notificationpreference"
+	"github.com/hm-edu/pki-service/ent/predicate"
+)
+
+// NotificationPreferenceUpdate is the builder for updating NotificationPreference entities.
+type NotificationPreferenceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *NotificationPreferenceMutation
+}
+
+// Where appends a list predicates to the NotificationPreferenceUpdate builder.
+func (_u *NotificationPreferenceUpdate) Where(ps ...predicate.NotificationPreference) *NotificationPreferenceUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *NotificationPreferenceUpdate) SetUpdateTime(v time.Time) *NotificationPreferenceUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetUser sets the "user" field.
+func (_u *NotificationPreferenceUpdate) SetUser(v string) *NotificationPreferenceUpdate {
+	_u.mutation.SetUser(v)
+	return _u
+}
+
+// SetNillableUser sets the "user" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdate) SetNillableUser(v *string) *NotificationPreferenceUpdate {
+	if v != nil {
+		_u.SetUser(*v)
+	}
+	return _u
+}
+
+// SetEvent sets the "event" field.
+func (_u *NotificationPreferenceUpdate) SetEvent(v string) *NotificationPreferenceUpdate {
+	_u.mutation.SetEvent(v)
+	return _u
+}
+
+// SetNillableEvent sets the "event" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdate) SetNillableEvent(v *string) *NotificationPreferenceUpdate {
+	if v != nil {
+		_u.SetEvent(*v)
+	}
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *NotificationPreferenceUpdate) SetEnabled(v bool) *NotificationPreferenceUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdate) SetNillableEnabled(v *bool) *NotificationPreferenceUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetChannels sets the "channels" field.
+func (_u *NotificationPreferenceUpdate) SetChannels(v []string) *NotificationPreferenceUpdate {
+	_u.mutation.SetChannels(v)
+	return _u
+}
+
+// AppendChannels appends value to the "channels" field.
+func (_u *NotificationPreferenceUpdate) AppendChannels(v []string) *NotificationPreferenceUpdate {
+	_u.mutation.AppendChannels(v)
+	return _u
+}
+
+// ClearChannels clears the value of the "channels" field.
+func (_u *NotificationPreferenceUpdate) ClearChannels() *NotificationPreferenceUpdate {
+	_u.mutation.ClearChannels()
+	return _u
+}
+
+// Mutation returns the NotificationPreferenceMutation object of the builder.
+func (_u *NotificationPreferenceUpdate) Mutation() *NotificationPreferenceMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *NotificationPreferenceUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *NotificationPreferenceUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *NotificationPreferenceUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *NotificationPreferenceUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *NotificationPreferenceUpdate) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := notificationpreference.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *NotificationPreferenceUpdate) check() error {
+	if v, ok := _u.mutation.User(); ok {
+		if err := notificationpreference.UserValidator(v); err != nil {
+			return &ValidationError{Name: "user", err: fmt.Errorf(`ent: validator failed for field "NotificationPreference.user": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Event(); ok {
+		if err := notificationpreference.EventValidator(v); err != nil {
+			return &ValidationError{Name: "event", err: fmt.Errorf(`ent: validator failed for field "NotificationPreference.event": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *NotificationPreferenceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notificationpreference.Table, notificationpreference.Columns, sqlgraph.NewFieldSpec(notificationpreference.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(notificationpreference.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.User(); ok {
+		_spec.SetField(notificationpreference.FieldUser, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Event(); ok {
+		_spec.SetField(notificationpreference.FieldEvent, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(notificationpreference.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Channels(); ok {
+		_spec.SetField(notificationpreference.FieldChannels, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedChannels(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, notificationpreference.FieldChannels, value)
+		})
+	}
+	if _u.mutation.ChannelsCleared() {
+		_spec.ClearField(notificationpreference.FieldChannels, field.TypeJSON)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notificationpreference.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// NotificationPreferenceUpdateOne is the builder for updating a single NotificationPreference entity.
+type NotificationPreferenceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *NotificationPreferenceMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *NotificationPreferenceUpdateOne) SetUpdateTime(v time.Time) *NotificationPreferenceUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetUser sets the "user" field.
+func (_u *NotificationPreferenceUpdateOne) SetUser(v string) *NotificationPreferenceUpdateOne {
+	_u.mutation.SetUser(v)
+	return _u
+}
+
+// SetNillableUser sets the "user" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdateOne) SetNillableUser(v *string) *NotificationPreferenceUpdateOne {
+	if v != nil {
+		_u.SetUser(*v)
+	}
+	return _u
+}
+
+// SetEvent sets the "event" field.
+func (_u *NotificationPreferenceUpdateOne) SetEvent(v string) *NotificationPreferenceUpdateOne {
+	_u.mutation.SetEvent(v)
+	return _u
+}
+
+// SetNillableEvent sets the "event" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdateOne) SetNillableEvent(v *string) *NotificationPreferenceUpdateOne {
+	if v != nil {
+		_u.SetEvent(*v)
+	}
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *NotificationPreferenceUpdateOne) SetEnabled(v bool) *NotificationPreferenceUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdateOne) SetNillableEnabled(v *bool) *NotificationPreferenceUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetChannels sets the "channels" field.
+func (_u *NotificationPreferenceUpdateOne) SetChannels(v []string) *NotificationPreferenceUpdateOne {
+	_u.mutation.SetChannels(v)
+	return _u
+}
+
+// AppendChannels appends value to the "channels" field.
+func (_u *NotificationPreferenceUpdateOne) AppendChannels(v []string) *NotificationPreferenceUpdateOne {
+	_u.mutation.AppendChannels(v)
+	return _u
+}
+
+// ClearChannels clears the value of the "channels" field.
+func (_u *NotificationPreferenceUpdateOne) ClearChannels() *NotificationPreferenceUpdateOne {
+	_u.mutation.ClearChannels()
+	return _u
+}
+
+// Mutation returns the NotificationPreferenceMutation object of the builder.
+func (_u *NotificationPreferenceUpdateOne) Mutation() *NotificationPreferenceMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the NotificationPreferenceUpdate builder.
+func (_u *NotificationPreferenceUpdateOne) Where(ps ...predicate.NotificationPreference) *NotificationPreferenceUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *NotificationPreferenceUpdateOne) Select(field string, fields ...string) *NotificationPreferenceUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated NotificationPreference entity.
+func (_u *NotificationPreferenceUpdateOne) Save(ctx context.Context) (*NotificationPreference, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *NotificationPreferenceUpdateOne) SaveX(ctx context.Context) *NotificationPreference {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *NotificationPreferenceUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *NotificationPreferenceUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *NotificationPreferenceUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := notificationpreference.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *NotificationPreferenceUpdateOne) check() error {
+	if v, ok := _u.mutation.User(); ok {
+		if err := notificationpreference.UserValidator(v); err != nil {
+			return &ValidationError{Name: "user", err: fmt.Errorf(`ent: validator failed for field "NotificationPreference.user": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Event(); ok {
+		if err := notificationpreference.EventValidator(v); err != nil {
+			return &ValidationError{Name: "event", err: fmt.Errorf(`ent: validator failed for field "NotificationPreference.event": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *NotificationPreferenceUpdateOne) sqlSave(ctx context.Context) (_node *NotificationPreference, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notificationpreference.Table, notificationpreference.Columns, sqlgraph.NewFieldSpec(notificationpreference.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "NotificationPreference.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, notificationpreference.FieldID)
+		for _, f := range fields {
+			if !notificationpreference.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != notificationpreference.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(notificationpreference.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.User(); ok {
+		_spec.SetField(notificationpreference.FieldUser, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Event(); ok {
+		_spec.SetField(notificationpreference.FieldEvent, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(notificationpreference.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Channels(); ok {
+		_spec.SetField(notificationpreference.FieldChannels, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedChannels(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, notificationpreference.FieldChannels, value)
+		})
+	}
+	if _u.mutation.ChannelsCleared() {
+		_spec.ClearField(notificationpreference.FieldChannels, field.TypeJSON)
+	}
+	_node = &NotificationPreference{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notificationpreference.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -6,11 +6,35 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// AcmeOrder is the predicate function for acmeorder builders.
+type AcmeOrder func(*sql.Selector)
+
 // Certificate is the predicate function for certificate builders.
 type Certificate func(*sql.Selector)
 
 // Domain is the predicate function for domain builders.
 type Domain func(*sql.Selector)
 
+// EmailVerification is the predicate function for emailverification builders.
+type EmailVerification func(*sql.Selector)
+
+// EscrowAudit is the predicate function for escrowaudit builders.
+type EscrowAudit func(*sql.Selector)
+
+// KeyEscrow is the predicate function for keyescrow builders.
+type KeyEscrow func(*sql.Selector)
+
+// KeyRecovery is the predicate function for keyrecovery builders.
+type KeyRecovery func(*sql.Selector)
+
+// NotificationPreference is the predicate function for notificationpreference builders.
+type NotificationPreference func(*sql.Selector)
+
+// SentNotification is the predicate function for sentnotification builders.
+type SentNotification func(*sql.Selector)
+
 // SmimeCertificate is the predicate function for smimecertificate builders.
 type SmimeCertificate func(*sql.Selector)
+
+// TermsAcceptance is the predicate function for termsacceptance builders.
+type TermsAcceptance func(*sql.Selector)
@@ -5,16 +5,43 @@ package runtime
 import (
 	"time"
 
+	"github.com/hm-edu/pki-service/ent/acmeorder"
 	"github.com/hm-edu/pki-service/ent/certificate"
 	"github.com/hm-edu/pki-service/ent/domain"
+	"github.com/hm-edu/pki-service/ent/emailverification"
+	"github.com/hm-edu/pki-service/ent/escrowaudit"
+	"github.com/hm-edu/pki-service/ent/keyescrow"
+	"github.com/hm-edu/pki-service/ent/keyrecovery"
+	"github.com/hm-edu/pki-service/ent/notificationpreference"
 	"github.com/hm-edu/pki-service/ent/schema"
+	"github.com/hm-edu/pki-service/ent/sentnotification"
 	"github.com/hm-edu/pki-service/ent/smimecertificate"
+	"github.com/hm-edu/pki-service/ent/termsacceptance"
 )
 
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	acmeorderMixin := schema.AcmeOrder{}.Mixin()
+	acmeorderMixinFields0 := acmeorderMixin[0].Fields()
+	_ = acmeorderMixinFields0
+	acmeorderFields := schema.AcmeOrder{}.Fields()
+	_ = acmeorderFields
+	// acmeorderDescCreateTime is the schema descriptor for create_time field.
+	acmeorderDescCreateTime := acmeorderMixinFields0[0].Descriptor()
+	// acmeorder.DefaultCreateTime holds the default value on creation for the create_time field.
+	acmeorder.DefaultCreateTime = acmeorderDescCreateTime.Default.(func() time.Time)
+	// acmeorderDescUpdateTime is the schema descriptor for update_time field.
+	acmeorderDescUpdateTime := acmeorderMixinFields0[1].Descriptor()
+	// acmeorder.DefaultUpdateTime holds the default value on creation for the update_time field.
+	acmeorder.DefaultUpdateTime = acmeorderDescUpdateTime.Default.(func() time.Time)
+	// acmeorder.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	acmeorder.UpdateDefaultUpdateTime = acmeorderDescUpdateTime.UpdateDefault.(func() time.Time)
+	// acmeorderDescIdentifiers is the schema descriptor for identifiers field.
+	acmeorderDescIdentifiers := acmeorderFields[0].Descriptor()
+	// acmeorder.IdentifiersValidator is a validator for the "identifiers" field. It is called by the builders before save.
+	acmeorder.IdentifiersValidator = acmeorderDescIdentifiers.Validators[0].(func(string) error)
 	certificateMixin := schema.Certificate{}.Mixin()
 	certificateHooks := schema.Certificate{}.Hooks()
 	certificate.Hooks[0] = certificateHooks[0]
@@ -42,6 +69,144 @@ func init() {
 	domainDescFqdn := domainFields[0].Descriptor()
 	// domain.FqdnValidator is a validator for the "fqdn" field. It is called by the builders before save.
 	domain.FqdnValidator = domainDescFqdn.Validators[0].(func(string) error)
+	emailverificationMixin := schema.EmailVerification{}.Mixin()
+	emailverificationMixinFields0 := emailverificationMixin[0].Fields()
+	_ = emailverificationMixinFields0
+	emailverificationFields := schema.EmailVerification{}.Fields()
+	_ = emailverificationFields
+	// emailverificationDescCreateTime is the schema descriptor for create_time field.
+	emailverificationDescCreateTime := emailverificationMixinFields0[0].Descriptor()
+	// emailverification.DefaultCreateTime holds the default value on creation for the create_time field.
+	emailverification.DefaultCreateTime = emailverificationDescCreateTime.Default.(func() time.Time)
+	// emailverificationDescUpdateTime is the schema descriptor for update_time field.
+	emailverificationDescUpdateTime := emailverificationMixinFields0[1].Descriptor()
+	// emailverification.DefaultUpdateTime holds the default value on creation for the update_time field.
+	emailverification.DefaultUpdateTime = emailverificationDescUpdateTime.Default.(func() time.Time)
+	// emailverification.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	emailverification.UpdateDefaultUpdateTime = emailverificationDescUpdateTime.UpdateDefault.(func() time.Time)
+	// emailverificationDescUser is the schema descriptor for user field.
+	emailverificationDescUser := emailverificationFields[0].Descriptor()
+	// emailverification.UserValidator is a validator for the "user" field. It is called by the builders before save.
+	emailverification.UserValidator = emailverificationDescUser.Validators[0].(func(string) error)
+	// emailverificationDescEmail is the schema descriptor for email field.
+	emailverificationDescEmail := emailverificationFields[1].Descriptor()
+	// emailverification.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	emailverification.EmailValidator = emailverificationDescEmail.Validators[0].(func(string) error)
+	// emailverificationDescAttempts is the schema descriptor for attempts field.
+	emailverificationDescAttempts := emailverificationFields[4].Descriptor()
+	// emailverification.DefaultAttempts holds the default value on creation for the attempts field.
+	emailverification.DefaultAttempts = emailverificationDescAttempts.Default.(int)
+	escrowauditMixin := schema.EscrowAudit{}.Mixin()
+	escrowauditHooks := schema.EscrowAudit{}.Hooks()
+	escrowaudit.Hooks[0] = escrowauditHooks[0]
+	escrowauditMixinFields0 := escrowauditMixin[0].Fields()
+	_ = escrowauditMixinFields0
+	escrowauditFields := schema.EscrowAudit{}.Fields()
+	_ = escrowauditFields
+	// escrowauditDescCreateTime is the schema descriptor for create_time field.
+	escrowauditDescCreateTime := escrowauditMixinFields0[0].Descriptor()
+	// escrowaudit.DefaultCreateTime holds the default value on creation for the create_time field.
+	escrowaudit.DefaultCreateTime = escrowauditDescCreateTime.Default.(func() time.Time)
+	// escrowauditDescUpdateTime is the schema descriptor for update_time field.
+	escrowauditDescUpdateTime := escrowauditMixinFields0[1].Descriptor()
+	// escrowaudit.DefaultUpdateTime holds the default value on creation for the update_time field.
+	escrowaudit.DefaultUpdateTime = escrowauditDescUpdateTime.Default.(func() time.Time)
+	// escrowaudit.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	escrowaudit.UpdateDefaultUpdateTime = escrowauditDescUpdateTime.UpdateDefault.(func() time.Time)
+	// escrowauditDescAction is the schema descriptor for action field.
+	escrowauditDescAction := escrowauditFields[0].Descriptor()
+	// escrowaudit.ActionValidator is a validator for the "action" field. It is called by the builders before save.
+	escrowaudit.ActionValidator = escrowauditDescAction.Validators[0].(func(string) error)
+	// escrowauditDescActor is the schema descriptor for actor field.
+	escrowauditDescActor := escrowauditFields[1].Descriptor()
+	// escrowaudit.ActorValidator is a validator for the "actor" field. It is called by the builders before save.
+	escrowaudit.ActorValidator = escrowauditDescActor.Validators[0].(func(string) error)
+	keyescrowMixin := schema.KeyEscrow{}.Mixin()
+	keyescrowHooks := schema.KeyEscrow{}.Hooks()
+	keyescrow.Hooks[0] = keyescrowHooks[0]
+	keyescrowMixinFields0 := keyescrowMixin[0].Fields()
+	_ = keyescrowMixinFields0
+	keyescrowFields := schema.KeyEscrow{}.Fields()
+	_ = keyescrowFields
+	// keyescrowDescCreateTime is the schema descriptor for create_time field.
+	keyescrowDescCreateTime := keyescrowMixinFields0[0].Descriptor()
+	// keyescrow.DefaultCreateTime holds the default value on creation for the create_time field.
+	keyescrow.DefaultCreateTime = keyescrowDescCreateTime.Default.(func() time.Time)
+	// keyescrowDescUpdateTime is the schema descriptor for update_time field.
+	keyescrowDescUpdateTime := keyescrowMixinFields0[1].Descriptor()
+	// keyescrow.DefaultUpdateTime holds the default value on creation for the update_time field.
+	keyescrow.DefaultUpdateTime = keyescrowDescUpdateTime.Default.(func() time.Time)
+	// keyescrow.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	keyescrow.UpdateDefaultUpdateTime = keyescrowDescUpdateTime.UpdateDefault.(func() time.Time)
+	keyrecoveryMixin := schema.KeyRecovery{}.Mixin()
+	keyrecoveryMixinFields0 := keyrecoveryMixin[0].Fields()
+	_ = keyrecoveryMixinFields0
+	keyrecoveryFields := schema.KeyRecovery{}.Fields()
+	_ = keyrecoveryFields
+	// keyrecoveryDescCreateTime is the schema descriptor for create_time field.
+	keyrecoveryDescCreateTime := keyrecoveryMixinFields0[0].Descriptor()
+	// keyrecovery.DefaultCreateTime holds the default value on creation for the create_time field.
+	keyrecovery.DefaultCreateTime = keyrecoveryDescCreateTime.Default.(func() time.Time)
+	// keyrecoveryDescUpdateTime is the schema descriptor for update_time field.
+	keyrecoveryDescUpdateTime := keyrecoveryMixinFields0[1].Descriptor()
+	// keyrecovery.DefaultUpdateTime holds the default value on creation for the update_time field.
+	keyrecovery.DefaultUpdateTime = keyrecoveryDescUpdateTime.Default.(func() time.Time)
+	// keyrecovery.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	keyrecovery.UpdateDefaultUpdateTime = keyrecoveryDescUpdateTime.UpdateDefault.(func() time.Time)
+	// keyrecoveryDescRequestedBy is the schema descriptor for requestedBy field.
+	keyrecoveryDescRequestedBy := keyrecoveryFields[0].Descriptor()
+	// keyrecovery.RequestedByValidator is a validator for the "requestedBy" field. It is called by the builders before save.
+	keyrecovery.RequestedByValidator = keyrecoveryDescRequestedBy.Validators[0].(func(string) error)
+	notificationpreferenceMixin := schema.NotificationPreference{}.Mixin()
+	notificationpreferenceMixinFields0 := notificationpreferenceMixin[0].Fields()
+	_ = notificationpreferenceMixinFields0
+	notificationpreferenceFields := schema.NotificationPreference{}.Fields()
+	_ = notificationpreferenceFields
+	// notificationpreferenceDescCreateTime is the schema descriptor for create_time field.
+	notificationpreferenceDescCreateTime := notificationpreferenceMixinFields0[0].Descriptor()
+	// notificationpreference.DefaultCreateTime holds the default value on creation for the create_time field.
+	notificationpreference.DefaultCreateTime = notificationpreferenceDescCreateTime.Default.(func() time.Time)
+	// notificationpreferenceDescUpdateTime is the schema descriptor for update_time field.
+	notificationpreferenceDescUpdateTime := notificationpreferenceMixinFields0[1].Descriptor()
+	// notificationpreference.DefaultUpdateTime holds the default value on creation for the update_time field.
+	notificationpreference.DefaultUpdateTime = notificationpreferenceDescUpdateTime.Default.(func() time.Time)
+	// notificationpreference.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	notificationpreference.UpdateDefaultUpdateTime = notificationpreferenceDescUpdateTime.UpdateDefault.(func() time.Time)
+	// notificationpreferenceDescUser is the schema descriptor for user field.
+	notificationpreferenceDescUser := notificationpreferenceFields[0].Descriptor()
+	// notificationpreference.UserValidator is a validator for the "user" field. It is called by the builders before save.
+	notificationpreference.UserValidator = notificationpreferenceDescUser.Validators[0].(func(string) error)
+	// notificationpreferenceDescEvent is the schema descriptor for event field.
+	notificationpreferenceDescEvent := notificationpreferenceFields[1].Descriptor()
+	// notificationpreference.EventValidator is a validator for the "event" field. It is called by the builders before save.
+	notificationpreference.EventValidator = notificationpreferenceDescEvent.Validators[0].(func(string) error)
+	// notificationpreferenceDescEnabled is the schema descriptor for enabled field.
+	notificationpreferenceDescEnabled := notificationpreferenceFields[2].Descriptor()
+	// notificationpreference.DefaultEnabled holds the default value on creation for the enabled field.
+	notificationpreference.DefaultEnabled = notificationpreferenceDescEnabled.Default.(bool)
+	sentnotificationMixin := schema.SentNotification{}.Mixin()
+	sentnotificationMixinFields0 := sentnotificationMixin[0].Fields()
+	_ = sentnotificationMixinFields0
+	sentnotificationFields := schema.SentNotification{}.Fields()
+	_ = sentnotificationFields
+	// sentnotificationDescCreateTime is the schema descriptor for create_time field.
+	sentnotificationDescCreateTime := sentnotificationMixinFields0[0].Descriptor()
+	// sentnotification.DefaultCreateTime holds the default value on creation for the create_time field.
+	sentnotification.DefaultCreateTime = sentnotificationDescCreateTime.Default.(func() time.Time)
+	// sentnotificationDescUpdateTime is the schema descriptor for update_time field.
+	sentnotificationDescUpdateTime := sentnotificationMixinFields0[1].Descriptor()
+	// sentnotification.DefaultUpdateTime holds the default value on creation for the update_time field.
+	sentnotification.DefaultUpdateTime = sentnotificationDescUpdateTime.Default.(func() time.Time)
+	// sentnotification.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	sentnotification.UpdateDefaultUpdateTime = sentnotificationDescUpdateTime.UpdateDefault.(func() time.Time)
+	// sentnotificationDescRecipient is the schema descriptor for recipient field.
+	sentnotificationDescRecipient := sentnotificationFields[3].Descriptor()
+	// sentnotification.RecipientValidator is a validator for the "recipient" field. It is called by the builders before save.
+	sentnotification.RecipientValidator = sentnotificationDescRecipient.Validators[0].(func(string) error)
+	// sentnotificationDescChannel is the schema descriptor for channel field.
+	sentnotificationDescChannel := sentnotificationFields[4].Descriptor()
+	// sentnotification.DefaultChannel holds the default value on creation for the channel field.
+	sentnotification.DefaultChannel = sentnotificationDescChannel.Default.(string)
 	smimecertificateMixin := schema.SmimeCertificate{}.Mixin()
 	smimecertificateHooks := schema.SmimeCertificate{}.Hooks()
 	smimecertificate.Hooks[0] = smimecertificateHooks[0]
@@ -67,6 +232,31 @@ func init() {
 	smimecertificateDescSerial := smimecertificateFields[2].Descriptor()
 	// smimecertificate.SerialValidator is a validator for the "serial" field. It is called by the builders before save.
 	smimecertificate.SerialValidator = smimecertificateDescSerial.Validators[0].(func(string) error)
+	termsacceptanceMixin := schema.TermsAcceptance{}.Mixin()
+	termsacceptanceHooks := schema.TermsAcceptance{}.Hooks()
+	termsacceptance.Hooks[0] = termsacceptanceHooks[0]
+	termsacceptanceMixinFields0 := termsacceptanceMixin[0].Fields()
+	_ = termsacceptanceMixinFields0
+	termsacceptanceFields := schema.TermsAcceptance{}.Fields()
+	_ = termsacceptanceFields
+	// termsacceptanceDescCreateTime is the schema descriptor for create_time field.
+	termsacceptanceDescCreateTime := termsacceptanceMixinFields0[0].Descriptor()
+	// termsacceptance.DefaultCreateTime holds the default value on creation for the create_time field.
+	termsacceptance.DefaultCreateTime = termsacceptanceDescCreateTime.Default.(func() time.Time)
+	// termsacceptanceDescUpdateTime is the schema descriptor for update_time field.
+	termsacceptanceDescUpdateTime := termsacceptanceMixinFields0[1].Descriptor()
+	// termsacceptance.DefaultUpdateTime holds the default value on creation for the update_time field.
+	termsacceptance.DefaultUpdateTime = termsacceptanceDescUpdateTime.Default.(func() time.Time)
+	// termsacceptance.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	termsacceptance.UpdateDefaultUpdateTime = termsacceptanceDescUpdateTime.UpdateDefault.(func() time.Time)
+	// termsacceptanceDescUser is the schema descriptor for user field.
+	termsacceptanceDescUser := termsacceptanceFields[0].Descriptor()
+	// termsacceptance.UserValidator is a validator for the "user" field. It is called by the builders before save.
+	termsacceptance.UserValidator = termsacceptanceDescUser.Validators[0].(func(string) error)
+	// termsacceptanceDescVersion is the schema descriptor for version field.
+	termsacceptanceDescVersion := termsacceptanceFields[1].Descriptor()
+	// termsacceptance.VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	termsacceptance.VersionValidator = termsacceptanceDescVersion.Validators[0].(func(string) error)
 }
 
 const (
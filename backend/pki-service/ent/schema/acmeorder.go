@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// AcmeOrder holds the schema definition for the AcmeOrder entity. Every
+// order placed at the ACME CA is recorded so the rate limits of the CA (e.g.
+// Let's Encrypt) can be predicted before a new order is placed.
+type AcmeOrder struct {
+	ent.Schema
+}
+
+// Fields of the AcmeOrder.
+func (AcmeOrder) Fields() []ent.Field {
+	return []ent.Field{
+		// The normalized, sorted and comma separated set of identifiers.
+		field.Text("identifiers").NotEmpty(),
+		// The registered domains (eTLD+1) covered by the order.
+		field.Strings("registeredDomains").Optional(),
+		field.Enum("status").Values("Pending", "Valid", "Invalid", "RateLimited").Default("Pending"),
+		field.Time("retryAfter").Nillable().Optional(),
+		field.Text("error").Nillable().Optional(),
+	}
+}
+
+// Edges of the AcmeOrder.
+func (AcmeOrder) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("certificate", Certificate.Type).
+			Ref("acmeOrders").
+			Unique(),
+	}
+}
+
+// Indexes of the AcmeOrder.
+func (AcmeOrder) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "create_time"),
+	}
+}
+
+// Mixin adds default time fields to this model.
+func (AcmeOrder) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
@@ -31,6 +31,15 @@ func (Certificate) Fields() []ent.Field {
 		// The issued certificate (PEM). Required for ACME CAs since
 		// revocation requires the certificate itself.
 		field.Text("certificate").Nillable().Optional(),
+		// The reason why the certificate was not issued by the preferred CA
+		// (e.g. an ACME rate limit causing a fallback to HARICA).
+		field.String("caReason").Nillable().Optional(),
+		// The CSR of a request that was queued for a later ACME order.
+		field.Text("csr").Nillable().Optional(),
+		// The HARICA organization ID the certificate was requested for.
+		field.String("organization").Nillable().Optional(),
+		// The HARICA certificate type (e.g. DV or OV).
+		field.String("certType").Nillable().Optional(),
 	}
 }
 
@@ -41,6 +50,7 @@ func (Certificate) Edges() []ent.Edge {
 			Annotations(entsql.Annotation{
 				OnDelete: entsql.Restrict,
 			}),
+		edge.To("acmeOrders", AcmeOrder.Type),
 	}
 }
 
@@ -74,6 +84,21 @@ func (SmimeCertificate) Fields() []ent.Field {
 		field.Time("created").Nillable().Optional(),
 		field.Enum("status").Values("Invalid", "Requested", "Approved", "Declined", "Applied", "Issued", "Revoked", "Expired", "Replaced", "Rejected", "Unmanaged", "SAApproved", "Init").Default("Invalid"),
 		field.String("ca").Nillable().Optional(),
+		// The HARICA organization ID the certificate was requested for.
+		field.String("organization").Nillable().Optional(),
+		// The issued certificate (PEM, leaf only). Required to release
+		// escrowed keys as PKCS#12.
+		field.Text("certificate").Nillable().Optional(),
+		// The primary mail address of the user that requested the
+		// certificate (differs from email for additional addresses).
+		field.String("owner").Nillable().Optional(),
+	}
+}
+
+// Edges of the SmimeCertificate.
+func (SmimeCertificate) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("keyEscrow", KeyEscrow.Type).Unique(),
 	}
 }
 
@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// EmailVerification holds the schema definition for the EmailVerification
+// entity. It records that a user proved control over an additional email
+// address (i.e. not the primary one) before S/MIME certificates may be
+// requested for it.
+type EmailVerification struct {
+	ent.Schema
+}
+
+// Fields of the EmailVerification.
+func (EmailVerification) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("user").NotEmpty(),
+		field.String("email").NotEmpty(),
+		// The SHA-256 hash of the pending one-time code. It is removed as
+		// soon as the code is used.
+		field.String("codeHash").Optional().Sensitive(),
+		field.Time("codeExpires").Nillable().Optional(),
+		// The number of failed attempts for the pending code.
+		field.Int("attempts").Default(0),
+		field.Time("verifiedAt").Nillable().Optional(),
+		field.Time("validUntil").Nillable().Optional(),
+	}
+}
+
+// Indexes of the EmailVerification.
+func (EmailVerification) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user", "email").Unique(),
+	}
+}
+
+// Mixin adds default time fields to this model.
+func (EmailVerification) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
@@ -0,0 +1,124 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+	"github.com/hm-edu/pki-service/ent/hook"
+)
+
+// KeyEscrow holds the schema definition for the KeyEscrow entity. It stores
+// the escrowed (encrypted) key of a S/MIME certificate generated by the
+// service. The key can only be decrypted using the recovery private key or
+// the shares of several recovery officers.
+type KeyEscrow struct {
+	ent.Schema
+}
+
+// Fields of the KeyEscrow.
+func (KeyEscrow) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("mode").Values("wrap", "split"),
+		// The PKCS#8 encoded key encrypted with the data key.
+		field.Bytes("ciphertext").Sensitive(),
+		// The data key encrypted with the recovery public key (wrap mode).
+		field.Bytes("wrappedKey").Optional().Sensitive(),
+		// The shares of the data key encrypted with the public keys of the
+		// officers (split mode).
+		field.JSON("shares", map[string][]byte{}).Optional().Sensitive(),
+	}
+}
+
+// Edges of the KeyEscrow.
+func (KeyEscrow) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("certificate", SmimeCertificate.Type).
+			Ref("keyEscrow").
+			Unique().
+			Required(),
+		edge.To("recoveries", KeyRecovery.Type),
+	}
+}
+
+// Mixin adds default time fields to this model.
+func (KeyEscrow) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
+
+// Hooks of the key escrow.
+func (KeyEscrow) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hook.Reject(ent.OpDelete | ent.OpDeleteOne),
+	}
+}
+
+// KeyRecovery holds the schema definition for the KeyRecovery entity. A
+// recovery is requested by the owner of the key and must be approved by
+// several recovery officers (dual control) before the key is released.
+type KeyRecovery struct {
+	ent.Schema
+}
+
+// Fields of the KeyRecovery.
+func (KeyRecovery) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("requestedBy").NotEmpty(),
+		field.Text("reason").Optional(),
+		field.Enum("status").Values("Pending", "Approved", "Released", "Rejected").Default("Pending"),
+		// The officers that approved the recovery.
+		field.Strings("approvals").Optional(),
+		field.String("decidedBy").Nillable().Optional(),
+		field.Time("releasedAt").Nillable().Optional(),
+	}
+}
+
+// Edges of the KeyRecovery.
+func (KeyRecovery) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("escrow", KeyEscrow.Type).
+			Ref("recoveries").
+			Unique().
+			Required(),
+	}
+}
+
+// Mixin adds default time fields to this model.
+func (KeyRecovery) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
+
+// EscrowAudit holds the schema definition for the EscrowAudit entity. Every
+// action on escrowed keys is recorded.
+type EscrowAudit struct {
+	ent.Schema
+}
+
+// Fields of the EscrowAudit.
+func (EscrowAudit) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("action").NotEmpty(),
+		field.String("actor").NotEmpty(),
+		field.String("serial").Optional(),
+		field.Int("recoveryId").Optional(),
+		field.Text("details").Optional(),
+	}
+}
+
+// Mixin adds default time fields to this model.
+func (EscrowAudit) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
+
+// Hooks of the audit log.
+func (EscrowAudit) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hook.Reject(ent.OpDelete | ent.OpDeleteOne | ent.OpUpdate | ent.OpUpdateOne),
+	}
+}
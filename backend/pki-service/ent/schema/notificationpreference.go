@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// NotificationPreference holds the schema definition for the
+// NotificationPreference entity. It records whether a user receives the
+// notifications of an event and on which channels. Users without preference
+// for an event receive it on all channels.
+type NotificationPreference struct {
+	ent.Schema
+}
+
+// Fields of the NotificationPreference.
+func (NotificationPreference) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("user").NotEmpty(),
+		field.String("event").NotEmpty(),
+		field.Bool("enabled").Default(true),
+		// The names of the channels the event is delivered through. All
+		// channels handling the event are used if empty.
+		field.Strings("channels").Optional(),
+	}
+}
+
+// Indexes of the NotificationPreference.
+func (NotificationPreference) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user", "event").Unique(),
+	}
+}
+
+// Mixin adds default time fields to this model.
+func (NotificationPreference) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
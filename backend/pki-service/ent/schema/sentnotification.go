@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// SentNotification holds the schema definition for the SentNotification
+// entity. It records that the reminder for a threshold (days before the
+// expiry) of a certificate was sent to a recipient through a channel, so
+// missed thresholds can be sent later on and no reminder is sent twice.
+type SentNotification struct {
+	ent.Schema
+}
+
+// Fields of the SentNotification.
+func (SentNotification) Fields() []ent.Field {
+	return []ent.Field{
+		// The kind of the certificate: SSL or S/MIME.
+		field.Enum("kind").Values("ssl", "smime"),
+		// The ID of the Certificate or SmimeCertificate.
+		field.Int("certificateId"),
+		field.Int("threshold"),
+		field.String("recipient").NotEmpty(),
+		// The name of the notification channel. Records without channel
+		// were created before the delivery was tracked per channel and
+		// count for all channels.
+		field.String("channel").Default(""),
+	}
+}
+
+// Indexes of the SentNotification.
+func (SentNotification) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("kind", "certificateId", "threshold", "recipient", "channel").Unique(),
+	}
+}
+
+// Mixin adds default time fields to this model.
+func (SentNotification) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
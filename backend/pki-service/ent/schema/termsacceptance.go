@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/hm-edu/pki-service/ent/hook"
+)
+
+// TermsAcceptance holds the schema definition for the TermsAcceptance
+// entity. It records that a user accepted a version of the subscriber
+// agreement / terms of use. The creation time is the time of the acceptance.
+type TermsAcceptance struct {
+	ent.Schema
+}
+
+// Fields of the TermsAcceptance.
+func (TermsAcceptance) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("user").NotEmpty(),
+		field.String("version").NotEmpty(),
+		// The client the terms were accepted with (e.g. web or API).
+		field.String("source").Optional(),
+	}
+}
+
+// Indexes of the TermsAcceptance.
+func (TermsAcceptance) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user", "version").Unique(),
+	}
+}
+
+// Mixin adds default time fields to this model.
+func (TermsAcceptance) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
+
+// Hooks of the terms acceptance. Acceptances are kept as evidence.
+func (TermsAcceptance) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hook.Reject(ent.OpDelete | ent.OpDeleteOne | ent.OpUpdate | ent.OpUpdateOne),
+	}
+}
@@ -0,0 +1,172 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/hm-edu/pki-service/ent/sentnotification"
+)
+
+// SentNotification is the model entity for the SentNotification schema.
+type SentNotification struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind sentnotification.Kind `json:"kind,omitempty"`
+	// CertificateId holds the value of the "certificateId" field.
+	CertificateId int `json:"certificateId,omitempty"`
+	// Threshold holds the value of the "threshold" field.
+	Threshold int `json:"threshold,omitempty"`
+	// Recipient holds the value of the "recipient" field.
+	Recipient string `json:"recipient,omitempty"`
+	// Channel holds the value of the "channel" field.
+	Channel      string `json:"channel,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SentNotification) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case sentnotification.FieldID, sentnotification.FieldCertificateId, sentnotification.FieldThreshold:
+			values[i] = new(sql.NullInt64)
+		case sentnotification.FieldKind, sentnotification.FieldRecipient, sentnotification.FieldChannel:
+			values[i] = new(sql.NullString)
+		case sentnotification.FieldCreateTime, sentnotification.FieldUpdateTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SentNotification fields.
+func (_m *SentNotification) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case sentnotification.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case sentnotification.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = value.Time
+			}
+		case sentnotification.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = value.Time
+			}
+		case sentnotification.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = sentnotification.Kind(value.String)
+			}
+		case sentnotification.FieldCertificateId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field certificateId", values[i])
+			} else if value.Valid {
+				_m.CertificateId = int(value.Int64)
+			}
+		case sentnotification.FieldThreshold:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field threshold", values[i])
+			} else if value.Valid {
+				_m.Threshold = int(value.Int64)
+			}
+		case sentnotification.FieldRecipient:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field recipient", values[i])
+			} else if value.Valid {
+				_m.Recipient = value.String
+			}
+		case sentnotification.FieldChannel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field channel", values[i])
+			} else if value.Valid {
+				_m.Channel = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SentNotification.
+// This includes values selected through modifiers, order, etc.
+func (_m *SentNotification) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this SentNotification.
+// Note that you need to call SentNotification.Unwrap() before calling this method if this SentNotification
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *SentNotification) Update() *SentNotificationUpdateOne {
+	return NewSentNotificationClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the SentNotification entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *SentNotification) Unwrap() *SentNotification {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SentNotification is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *SentNotification) String() string {
+	var builder strings.Builder
+	builder.WriteString("SentNotification(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(_m.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("certificateId=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CertificateId))
+	builder.WriteString(", ")
+	builder.WriteString("threshold=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Threshold))
+	builder.WriteString(", ")
+	builder.WriteString("recipient=")
+	builder.WriteString(_m.Recipient)
+	builder.WriteString(", ")
+	builder.WriteString("channel=")
+	builder.WriteString(_m.Channel)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SentNotifications is a parsable slice of SentNotification.
+type SentNotifications []*SentNotification
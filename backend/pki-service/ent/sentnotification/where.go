@@ -0,0 +1,410 @@
+// Code generated by ent, DO NOT EDIT.
+
+package sentnotification
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/hm-edu/pki-service/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// CertificateId applies equality check predicate on the "certificateId" field. It's identical to CertificateIdEQ.
+func CertificateId(v int) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldEQ(FieldCertificateId, v))
+}
+
+// Threshold applies equality check predicate on the "threshold" field. It's identical to ThresholdEQ.
+func Threshold(v int) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldEQ(FieldThreshold, v))
+}
+
+// Recipient applies equality check predicate on the "recipient" field. It's identical to RecipientEQ.
+func Recipient(v string) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldEQ(FieldRecipient, v))
+}
+
+// Channel applies equality check predicate on the "channel" field. It's identical to ChannelEQ.
+func Channel(v string) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldEQ(FieldChannel, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v Kind) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v Kind) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...Kind) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...Kind) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// CertificateIdEQ applies the EQ predicate on the "certificateId" field.
+func CertificateIdEQ(v int) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldEQ(FieldCertificateId, v))
+}
+
+// CertificateIdNEQ applies the NEQ predicate on the "certificateId" field.
+func CertificateIdNEQ(v int) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldNEQ(FieldCertificateId, v))
+}
+
+// CertificateIdIn applies the In predicate on the "certificateId" field.
+func CertificateIdIn(vs ...int) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldIn(FieldCertificateId, vs...))
+}
+
+// CertificateIdNotIn applies the NotIn predicate on the "certificateId" field.
+func CertificateIdNotIn(vs ...int) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldNotIn(FieldCertificateId, vs...))
+}
+
+// CertificateIdGT applies the GT predicate on the "certificateId" field.
+func CertificateIdGT(v int) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldGT(FieldCertificateId, v))
+}
+
+// CertificateIdGTE applies the GTE predicate on the "certificateId" field.
+func CertificateIdGTE(v int) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldGTE(FieldCertificateId, v))
+}
+
+// CertificateIdLT applies the LT predicate on the "certificateId" field.
+func CertificateIdLT(v int) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldLT(FieldCertificateId, v))
+}
+
+// CertificateIdLTE applies the LTE predicate on the "certificateId" field.
+func CertificateIdLTE(v int) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldLTE(FieldCertificateId, v))
+}
+
+// ThresholdEQ applies the EQ predicate on the "threshold" field.
+func ThresholdEQ(v int) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldEQ(FieldThreshold, v))
+}
+
+// ThresholdNEQ applies the NEQ predicate on the "threshold" field.
+func ThresholdNEQ(v int) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldNEQ(FieldThreshold, v))
+}
+
+// ThresholdIn applies the In predicate on the "threshold" field.
+func ThresholdIn(vs ...int) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldIn(FieldThreshold, vs...))
+}
+
+// ThresholdNotIn applies the NotIn predicate on the "threshold" field.
+func ThresholdNotIn(vs ...int) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldNotIn(FieldThreshold, vs...))
+}
+
+// ThresholdGT applies the GT predicate on the "threshold" field.
+func ThresholdGT(v int) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldGT(FieldThreshold, v))
+}
+
+// ThresholdGTE applies the GTE predicate on the "threshold" field.
+func ThresholdGTE(v int) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldGTE(FieldThreshold, v))
+}
+
+// ThresholdLT applies the LT predicate on the "threshold" field.
+func ThresholdLT(v int) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldLT(FieldThreshold, v))
+}
+
+// ThresholdLTE applies the LTE predicate on the "threshold" field.
+func ThresholdLTE(v int) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldLTE(FieldThreshold, v))
+}
+
+// RecipientEQ applies the EQ predicate on the "recipient" field.
+func RecipientEQ(v string) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldEQ(FieldRecipient, v))
+}
+
+// RecipientNEQ applies the NEQ predicate on the "recipient" field.
+func RecipientNEQ(v string) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldNEQ(FieldRecipient, v))
+}
+
+// RecipientIn applies the In predicate on the "recipient" field.
+func RecipientIn(vs ...string) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldIn(FieldRecipient, vs...))
+}
+
+// RecipientNotIn applies the NotIn predicate on the "recipient" field.
+func RecipientNotIn(vs ...string) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldNotIn(FieldRecipient, vs...))
+}
+
+// RecipientGT applies the GT predicate on the "recipient" field.
+func RecipientGT(v string) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldGT(FieldRecipient, v))
+}
+
+// RecipientGTE applies the GTE predicate on the "recipient" field.
+func RecipientGTE(v string) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldGTE(FieldRecipient, v))
+}
+
+// RecipientLT applies the LT predicate on the "recipient" field.
+func RecipientLT(v string) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldLT(FieldRecipient, v))
+}
+
+// RecipientLTE applies the LTE predicate on the "recipient" field.
+func RecipientLTE(v string) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldLTE(FieldRecipient, v))
+}
+
+// RecipientContains applies the Contains predicate on the "recipient" field.
+func RecipientContains(v string) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldContains(FieldRecipient, v))
+}
+
+// RecipientHasPrefix applies the HasPrefix predicate on the "recipient" field.
+func RecipientHasPrefix(v string) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldHasPrefix(FieldRecipient, v))
+}
+
+// RecipientHasSuffix applies the HasSuffix predicate on the "recipient" field.
+func RecipientHasSuffix(v string) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldHasSuffix(FieldRecipient, v))
+}
+
+// RecipientEqualFold applies the EqualFold predicate on the "recipient" field.
+func RecipientEqualFold(v string) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldEqualFold(FieldRecipient, v))
+}
+
+// RecipientContainsFold applies the ContainsFold predicate on the "recipient" field.
+func RecipientContainsFold(v string) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldContainsFold(FieldRecipient, v))
+}
+
+// ChannelEQ applies the EQ predicate on the "channel" field.
+func ChannelEQ(v string) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldEQ(FieldChannel, v))
+}
+
+// ChannelNEQ applies the NEQ predicate on the "channel" field.
+func ChannelNEQ(v string) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldNEQ(FieldChannel, v))
+}
+
+// ChannelIn applies the In predicate on the "channel" field.
+func ChannelIn(vs ...string) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldIn(FieldChannel, vs...))
+}
+
+// ChannelNotIn applies the NotIn predicate on the "channel" field.
+func ChannelNotIn(vs ...string) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldNotIn(FieldChannel, vs...))
+}
+
+// ChannelGT applies the GT predicate on the "channel" field.
+func ChannelGT(v string) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldGT(FieldChannel, v))
+}
+
+// ChannelGTE applies the GTE predicate on the "channel" field.
+func ChannelGTE(v string) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldGTE(FieldChannel, v))
+}
+
+// ChannelLT applies the LT predicate on the "channel" field.
+func ChannelLT(v string) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldLT(FieldChannel, v))
+}
+
+// ChannelLTE applies the LTE predicate on the "channel" field.
+func ChannelLTE(v string) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldLTE(FieldChannel, v))
+}
+
+// ChannelContains applies the Contains predicate on the "channel" field.
+func ChannelContains(v string) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldContains(FieldChannel, v))
+}
+
+// ChannelHasPrefix applies the HasPrefix predicate on the "channel" field.
+func ChannelHasPrefix(v string) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldHasPrefix(FieldChannel, v))
+}
+
+// ChannelHasSuffix applies the HasSuffix predicate on the "channel" field.
+func ChannelHasSuffix(v string) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldHasSuffix(FieldChannel, v))
+}
+
+// ChannelEqualFold applies the EqualFold predicate on the "channel" field.
+func ChannelEqualFold(v string) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldEqualFold(FieldChannel, v))
+}
+
+// ChannelContainsFold applies the ContainsFold predicate on the "channel" field.
+func ChannelContainsFold(v string) predicate.SentNotification {
+	return predicate.SentNotification(sql.FieldContainsFold(FieldChannel, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SentNotification) predicate.SentNotification {
+	return predicate.SentNotification(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SentNotification) predicate.SentNotification {
+	return predicate.SentNotification(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SentNotification) predicate.SentNotification {
+	return predicate.SentNotification(sql.NotPredicates(p))
+}
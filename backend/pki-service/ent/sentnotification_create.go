@@ -0,0 +1,837 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hm-edu/pki-service/ent/sentnotification"
+)
+
+// SentNotificationCreate is the builder for creating a SentNotification entity.
+type SentNotificationCreate struct {
+	config
+	mutation *SentNotificationMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *SentNotificationCreate) SetCreateTime(v time.Time) *SentNotificationCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *SentNotificationCreate) SetNillableCreateTime(v *time.Time) *SentNotificationCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *SentNotificationCreate) SetUpdateTime(v time.Time) *SentNotificationCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *SentNotificationCreate) SetNillableUpdateTime(v *time.Time) *SentNotificationCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *SentNotificationCreate) SetKind(v sentnotification.Kind) *SentNotificationCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetCertificateId sets the "certificateId" field.
+func (_c *SentNotificationCreate) SetCertificateId(v int) *SentNotificationCreate {
+	_c.mutation.SetCertificateId(v)
+	return _c
+}
+
+// SetThreshold sets the "threshold" field.
+func (_c *SentNotificationCreate) SetThreshold(v int) *SentNotificationCreate {
+	_c.mutation.SetThreshold(v)
+	return _c
+}
+
+// SetRecipient sets the "recipient" field.
+func (_c *SentNotificationCreate) SetRecipient(v string) *SentNotificationCreate {
+	_c.mutation.SetRecipient(v)
+	return _c
+}
+
+// SetChannel sets the "channel" field.
+func (_c *SentNotificationCreate) SetChannel(v string) *SentNotificationCreate {
+	_c.mutation.SetChannel(v)
+	return _c
+}
+
+// SetNillableChannel sets the "channel" field if the given value is not nil.
+func (_c *SentNotificationCreate) SetNillableChannel(v *string) *SentNotificationCreate {
+	if v != nil {
+		_c.SetChannel(*v)
+	}
+	return _c
+}
+
+// Mutation returns the SentNotificationMutation object of the builder.
+func (_c *SentNotificationCreate) Mutation() *SentNotificationMutation {
+	return _c.mutation
+}
+
+// Save creates the SentNotification in the database.
+func (_c *SentNotificationCreate) Save(ctx context.Context) (*SentNotification, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SentNotificationCreate) SaveX(ctx context.Context) *SentNotification {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SentNotificationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SentNotificationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SentNotificationCreate) defaults() {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		v := sentnotification.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		v := sentnotification.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+	if _, ok := _c.mutation.Channel(); !ok {
+		v := sentnotification.DefaultChannel
+		_c.mutation.SetChannel(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SentNotificationCreate) check() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "SentNotification.create_time"`)}
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "SentNotification.update_time"`)}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "SentNotification.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := sentnotification.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "SentNotification.kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CertificateId(); !ok {
+		return &ValidationError{Name: "certificateId", err: errors.New(`ent: missing required field "SentNotification.certificateId"`)}
+	}
+	if _, ok := _c.mutation.Threshold(); !ok {
+		return &ValidationError{Name: "threshold", err: errors.New(`ent: missing required field "SentNotification.threshold"`)}
+	}
+	if _, ok := _c.mutation.Recipient(); !ok {
+		return &ValidationError{Name: "recipient", err: errors.New(`ent: missing required field "SentNotification.recipient"`)}
+	}
+	if v, ok := _c.mutation.Recipient(); ok {
+		if err := sentnotification.RecipientValidator(v); err != nil {
+			return &ValidationError{Name: "recipient", err: fmt.Errorf(`ent: validator failed for field "SentNotification.recipient": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Channel(); !ok {
+		return &ValidationError{Name: "channel", err: errors.New(`ent: missing required field "SentNotification.channel"`)}
+	}
+	return nil
+}
+
+func (_c *SentNotificationCreate) sqlSave(ctx context.Context) (*SentNotification, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SentNotificationCreate) createSpec() (*SentNotification, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SentNotification{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(sentnotification.Table, sqlgraph.NewFieldSpec(sentnotification.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(sentnotification.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(sentnotification.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(sentnotification.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.CertificateId(); ok {
+		_spec.SetField(sentnotification.FieldCertificateId, field.TypeInt, value)
+		_node.CertificateId = value
+	}
+	if value, ok := _c.mutation.Threshold(); ok {
+		_spec.SetField(sentnotification.FieldThreshold, field.TypeInt, value)
+		_node.Threshold = value
+	}
+	if value, ok := _c.mutation.Recipient(); ok {
+		_spec.SetField(sentnotification.FieldRecipient, field.TypeString, value)
+		_node.Recipient = value
+	}
+	if value, ok := _c.mutation.Channel(); ok {
+		_spec.SetField(sentnotification.FieldChannel, field.TypeString, value)
+		_node.Channel = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SentNotification.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SentNotificationUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *SentNotificationCreate) OnConflict(opts ...sql.ConflictOption) *SentNotificationUpsertOne {
+	_c.conflict = opts
+	return &SentNotificationUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SentNotification.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *SentNotificationCreate) OnConflictColumns(columns ...string) *SentNotificationUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &SentNotificationUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// SentNotificationUpsertOne is the builder for "upsert"-ing
+	//  one SentNotification node.
+	SentNotificationUpsertOne struct {
+		create *SentNotificationCreate
+	}
+
+	// SentNotificationUpsert is the "OnConflict" setter.
+	SentNotificationUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *SentNotificationUpsert) SetUpdateTime(v time.Time) *SentNotificationUpsert {
+	u.Set(sentnotification.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *SentNotificationUpsert) UpdateUpdateTime() *SentNotificationUpsert {
+	u.SetExcluded(sentnotification.FieldUpdateTime)
+	return u
+}
+
+// SetKind sets the "kind" field.
+func (u *SentNotificationUpsert) SetKind(v sentnotification.Kind) *SentNotificationUpsert {
+	u.Set(sentnotification.FieldKind, v)
+	return u
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *SentNotificationUpsert) UpdateKind() *SentNotificationUpsert {
+	u.SetExcluded(sentnotification.FieldKind)
+	return u
+}
+
+// SetCertificateId sets the "certificateId" field.
+func (u *SentNotificationUpsert) SetCertificateId(v int) *SentNotificationUpsert {
+	u.Set(sentnotification.FieldCertificateId, v)
+	return u
+}
+
+// UpdateCertificateId sets the "certificateId" field to the value that was provided on create.
+func (u *SentNotificationUpsert) UpdateCertificateId() *SentNotificationUpsert {
+	u.SetExcluded(sentnotification.FieldCertificateId)
+	return u
+}
+
+// AddCertificateId adds v to the "certificateId" field.
+func (u *SentNotificationUpsert) AddCertificateId(v int) *SentNotificationUpsert {
+	u.Add(sentnotification.FieldCertificateId, v)
+	return u
+}
+
+// SetThreshold sets the "threshold" field.
+func (u *SentNotificationUpsert) SetThreshold(v int) *SentNotificationUpsert {
+	u.Set(sentnotification.FieldThreshold, v)
+	return u
+}
+
+// UpdateThreshold sets the "threshold" field to the value that was provided on create.
+func (u *SentNotificationUpsert) UpdateThreshold() *SentNotificationUpsert {
+	u.SetExcluded(sentnotification.FieldThreshold)
+	return u
+}
+
+// AddThreshold adds v to the "threshold" field.
+func (u *SentNotificationUpsert) AddThreshold(v int) *SentNotificationUpsert {
+	u.Add(sentnotification.FieldThreshold, v)
+	return u
+}
+
+// SetRecipient sets the "recipient" field.
+func (u *SentNotificationUpsert) SetRecipient(v string) *SentNotificationUpsert {
+	u.Set(sentnotification.FieldRecipient, v)
+	return u
+}
+
+// UpdateRecipient sets the "recipient" field to the value that was provided on create.
+func (u *SentNotificationUpsert) UpdateRecipient() *SentNotificationUpsert {
+	u.SetExcluded(sentnotification.FieldRecipient)
+	return u
+}
+
+// SetChannel sets the "channel" field.
+func (u *SentNotificationUpsert) SetChannel(v string) *SentNotificationUpsert {
+	u.Set(sentnotification.FieldChannel, v)
+	return u
+}
+
+// UpdateChannel sets the "channel" field to the value that was provided on create.
+func (u *SentNotificationUpsert) UpdateChannel() *SentNotificationUpsert {
+	u.SetExcluded(sentnotification.FieldChannel)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.SentNotification.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *SentNotificationUpsertOne) UpdateNewValues() *SentNotificationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(sentnotification.FieldCreateTime)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SentNotification.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *SentNotificationUpsertOne) Ignore() *SentNotificationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SentNotificationUpsertOne) DoNothing() *SentNotificationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SentNotificationCreate.OnConflict
+// documentation for more info.
+func (u *SentNotificationUpsertOne) Update(set func(*SentNotificationUpsert)) *SentNotificationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SentNotificationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *SentNotificationUpsertOne) SetUpdateTime(v time.Time) *SentNotificationUpsertOne {
+	return u.Update(func(s *SentNotificationUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *SentNotificationUpsertOne) UpdateUpdateTime() *SentNotificationUpsertOne {
+	return u.Update(func(s *SentNotificationUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *SentNotificationUpsertOne) SetKind(v sentnotification.Kind) *SentNotificationUpsertOne {
+	return u.Update(func(s *SentNotificationUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *SentNotificationUpsertOne) UpdateKind() *SentNotificationUpsertOne {
+	return u.Update(func(s *SentNotificationUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetCertificateId sets the "certificateId" field.
+func (u *SentNotificationUpsertOne) SetCertificateId(v int) *SentNotificationUpsertOne {
+	return u.Update(func(s *SentNotificationUpsert) {
+		s.SetCertificateId(v)
+	})
+}
+
+// AddCertificateId adds v to the "certificateId" field.
+func (u *SentNotificationUpsertOne) AddCertificateId(v int) *SentNotificationUpsertOne {
+	return u.Update(func(s *SentNotificationUpsert) {
+		s.AddCertificateId(v)
+	})
+}
+
+// UpdateCertificateId sets the "certificateId" field to the value that was provided on create.
+func (u *SentNotificationUpsertOne) UpdateCertificateId() *SentNotificationUpsertOne {
+	return u.Update(func(s *SentNotificationUpsert) {
+		s.UpdateCertificateId()
+	})
+}
+
+// SetThreshold sets the "threshold" field.
+func (u *SentNotificationUpsertOne) SetThreshold(v int) *SentNotificationUpsertOne {
+	return u.Update(func(s *SentNotificationUpsert) {
+		s.SetThreshold(v)
+	})
+}
+
+// AddThreshold adds v to the "threshold" field.
+func (u *SentNotificationUpsertOne) AddThreshold(v int) *SentNotificationUpsertOne {
+	return u.Update(func(s *SentNotificationUpsert) {
+		s.AddThreshold(v)
+	})
+}
+
+// UpdateThreshold sets the "threshold" field to the value that was provided on create.
+func (u *SentNotificationUpsertOne) UpdateThreshold() *SentNotificationUpsertOne {
+	return u.Update(func(s *SentNotificationUpsert) {
+		s.UpdateThreshold()
+	})
+}
+
+// SetRecipient sets the "recipient" field.
+func (u *SentNotificationUpsertOne) SetRecipient(v string) *SentNotificationUpsertOne {
+	return u.Update(func(s *SentNotificationUpsert) {
+		s.SetRecipient(v)
+	})
+}
+
+// UpdateRecipient sets the "recipient" field to the value that was provided on create.
+func (u *SentNotificationUpsertOne) UpdateRecipient() *SentNotificationUpsertOne {
+	return u.Update(func(s *SentNotificationUpsert) {
+		s.UpdateRecipient()
+	})
+}
+
+// SetChannel sets the "channel" field.
+func (u *SentNotificationUpsertOne) SetChannel(v string) *SentNotificationUpsertOne {
+	return u.Update(func(s *SentNotificationUpsert) {
+		s.SetChannel(v)
+	})
+}
+
+// UpdateChannel sets the "channel" field to the value that was provided on create.
+func (u *SentNotificationUpsertOne) UpdateChannel() *SentNotificationUpsertOne {
+	return u.Update(func(s *SentNotificationUpsert) {
+		s.UpdateChannel()
+	})
+}
+
+// Exec executes the query.
+func (u *SentNotificationUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SentNotificationCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SentNotificationUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *SentNotificationUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *SentNotificationUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SentNotificationCreateBulk is the builder for creating many SentNotification entities in bulk.
+type SentNotificationCreateBulk struct {
+	config
+	err      error
+	builders []*SentNotificationCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the SentNotification entities in the database.
+func (_c *SentNotificationCreateBulk) Save(ctx context.Context) ([]*SentNotification, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*SentNotification, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SentNotificationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SentNotificationCreateBulk) SaveX(ctx context.Context) []*SentNotification {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SentNotificationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SentNotificationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SentNotification.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SentNotificationUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *SentNotificationCreateBulk) OnConflict(opts ...sql.ConflictOption) *SentNotificationUpsertBulk {
+	_c.conflict = opts
+	return &SentNotificationUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SentNotification.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *SentNotificationCreateBulk) OnConflictColumns(columns ...string) *SentNotificationUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &SentNotificationUpsertBulk{
+		create: _c,
+	}
+}
+
+// SentNotificationUpsertBulk is the builder for "upsert"-ing
+// a bulk of SentNotification nodes.
+type SentNotificationUpsertBulk struct {
+	create *SentNotificationCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.SentNotification.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *SentNotificationUpsertBulk) UpdateNewValues() *SentNotificationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(sentnotification.FieldCreateTime)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SentNotification.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *SentNotificationUpsertBulk) Ignore() *SentNotificationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SentNotificationUpsertBulk) DoNothing() *SentNotificationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SentNotificationCreateBulk.OnConflict
+// documentation for more info.
+func (u *SentNotificationUpsertBulk) Update(set func(*SentNotificationUpsert)) *SentNotificationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SentNotificationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *SentNotificationUpsertBulk) SetUpdateTime(v time.Time) *SentNotificationUpsertBulk {
+	return u.Update(func(s *SentNotificationUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *SentNotificationUpsertBulk) UpdateUpdateTime() *SentNotificationUpsertBulk {
+	return u.Update(func(s *SentNotificationUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *SentNotificationUpsertBulk) SetKind(v sentnotification.Kind) *SentNotificationUpsertBulk {
+	return u.Update(func(s *SentNotificationUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *SentNotificationUpsertBulk) UpdateKind() *SentNotificationUpsertBulk {
+	return u.Update(func(s *SentNotificationUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetCertificateId sets the "certificateId" field.
+func (u *SentNotificationUpsertBulk) SetCertificateId(v int) *SentNotificationUpsertBulk {
+	return u.Update(func(s *SentNotificationUpsert) {
+		s.SetCertificateId(v)
+	})
+}
+
+// AddCertificateId adds v to the "certificateId" field.
+func (u *SentNotificationUpsertBulk) AddCertificateId(v int) *SentNotificationUpsertBulk {
+	return u.Update(func(s *SentNotificationUpsert) {
+		s.AddCertificateId(v)
+	})
+}
+
+// UpdateCertificateId sets the "certificateId" field to the value that was provided on create.
+func (u *SentNotificationUpsertBulk) UpdateCertificateId() *SentNotificationUpsertBulk {
+	return u.Update(func(s *SentNotificationUpsert) {
+		s.UpdateCertificateId()
+	})
+}
+
+// SetThreshold sets the "threshold" field.
+func (u *SentNotificationUpsertBulk) SetThreshold(v int) *SentNotificationUpsertBulk {
+	return u.Update(func(s *SentNotificationUpsert) {
+		s.SetThreshold(v)
+	})
+}
+
+// AddThreshold adds v to the "threshold" field.
+func (u *SentNotificationUpsertBulk) AddThreshold(v int) *SentNotificationUpsertBulk {
+	return u.Update(func(s *SentNotificationUpsert) {
+		s.AddThreshold(v)
+	})
+}
+
+// UpdateThreshold sets the "threshold" field to the value that was provided on create.
+func (u *SentNotificationUpsertBulk) UpdateThreshold() *SentNotificationUpsertBulk {
+	return u.Update(func(s *SentNotificationUpsert) {
+		s.UpdateThreshold()
+	})
+}
+
+// SetRecipient sets the "recipient" field.
+func (u *SentNotificationUpsertBulk) SetRecipient(v string) *SentNotificationUpsertBulk {
+	return u.Update(func(s *SentNotificationUpsert) {
+		s.SetRecipient(v)
+	})
+}
+
+// UpdateRecipient sets the "recipient" field to the value that was provided on create.
+func (u *SentNotificationUpsertBulk) UpdateRecipient() *SentNotificationUpsertBulk {
+	return u.Update(func(s *SentNotificationUpsert) {
+		s.UpdateRecipient()
+	})
+}
+
+// SetChannel sets the "channel" field.
+func (u *SentNotificationUpsertBulk) SetChannel(v string) *SentNotificationUpsertBulk {
+	return u.Update(func(s *SentNotificationUpsert) {
+		s.SetChannel(v)
+	})
+}
+
+// UpdateChannel sets the "channel" field to the value that was provided on create.
+func (u *SentNotificationUpsertBulk) UpdateChannel() *SentNotificationUpsertBulk {
+	return u.Update(func(s *SentNotificationUpsert) {
+		s.UpdateChannel()
+	})
+}
+
+// Exec executes the query.
+func (u *SentNotificationUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the SentNotificationCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SentNotificationCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SentNotificationUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
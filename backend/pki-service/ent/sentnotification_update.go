@@ -0,0 +1,458 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hm-edu/pki-service/ent/predicate"
+	"github.com/hm-edu/pki-service/ent/sentnotification"
+)
+
+// SentNotificationUpdate is the builder for updating SentNotification entities.
+type SentNotificationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SentNotificationMutation
+}
+
+// Where appends a list predicates to the SentNotificationUpdate builder.
+func (_u *SentNotificationUpdate) Where(ps ...predicate.SentNotification) *SentNotificationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *SentNotificationUpdate) SetUpdateTime(v time.Time) *SentNotificationUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *SentNotificationUpdate) SetKind(v sentnotification.Kind) *SentNotificationUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *SentNotificationUpdate) SetNillableKind(v *sentnotification.Kind) *SentNotificationUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetCertificateId sets the "certificateId" field.
+func (_u *SentNotificationUpdate) SetCertificateId(v int) *SentNotificationUpdate {
+	_u.mutation.ResetCertificateId()
+	_u.mutation.SetCertificateId(v)
+	return _u
+}
+
+// SetNillableCertificateId sets the "certificateId" field if the given value is not nil.
+func (_u *SentNotificationUpdate) SetNillableCertificateId(v *int) *SentNotificationUpdate {
+	if v != nil {
+		_u.SetCertificateId(*v)
+	}
+	return _u
+}
+
+// AddCertificateId adds value to the "certificateId" field.
+func (_u *SentNotificationUpdate) AddCertificateId(v int) *SentNotificationUpdate {
+	_u.mutation.AddCertificateId(v)
+	return _u
+}
+
+// SetThreshold sets the "threshold" field.
+func (_u *SentNotificationUpdate) SetThreshold(v int) *SentNotificationUpdate {
+	_u.mutation.ResetThreshold()
+	_u.mutation.SetThreshold(v)
+	return _u
+}
+
+// SetNillableThreshold sets the "threshold" field if the given value is not nil.
+func (_u *SentNotificationUpdate) SetNillableThreshold(v *int) *SentNotificationUpdate {
+	if v != nil {
+		_u.SetThreshold(*v)
+	}
+	return _u
+}
+
+// AddThreshold adds value to the "threshold" field.
+func (_u *SentNotificationUpdate) AddThreshold(v int) *SentNotificationUpdate {
+	_u.mutation.AddThreshold(v)
+	return _u
+}
+
+// SetRecipient sets the "recipient" field.
+func (_u *SentNotificationUpdate) SetRecipient(v string) *SentNotificationUpdate {
+	_u.mutation.SetRecipient(v)
+	return _u
+}
+
+// SetNillableRecipient sets the "recipient" field if the given value is not nil.
+func (_u *SentNotificationUpdate) SetNillableRecipient(v *string) *SentNotificationUpdate {
+	if v != nil {
+		_u.SetRecipient(*v)
+	}
+	return _u
+}
+
+// SetChannel sets the "channel" field.
+func (_u *SentNotificationUpdate) SetChannel(v string) *SentNotificationUpdate {
+	_u.mutation.SetChannel(v)
+	return _u
+}
+
+// SetNillableChannel sets the "channel" field if the given value is not nil.
+func (_u *SentNotificationUpdate) SetNillableChannel(v *string) *SentNotificationUpdate {
+	if v != nil {
+		_u.SetChannel(*v)
+	}
+	return _u
+}
+
+// Mutation returns the SentNotificationMutation object of the builder.
+func (_u *SentNotificationUpdate) Mutation() *SentNotificationMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SentNotificationUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SentNotificationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SentNotificationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SentNotificationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SentNotificationUpdate) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := sentnotification.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SentNotificationUpdate) check() error {
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := sentnotification.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "SentNotification.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Recipient(); ok {
+		if err := sentnotification.RecipientValidator(v); err != nil {
+			return &ValidationError{Name: "recipient", err: fmt.Errorf(`ent: validator failed for field "SentNotification.recipient": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *SentNotificationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sentnotification.Table, sentnotification.Columns, sqlgraph.NewFieldSpec(sentnotification.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(sentnotification.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(sentnotification.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.CertificateId(); ok {
+		_spec.SetField(sentnotification.FieldCertificateId, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCertificateId(); ok {
+		_spec.AddField(sentnotification.FieldCertificateId, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Threshold(); ok {
+		_spec.SetField(sentnotification.FieldThreshold, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedThreshold(); ok {
+		_spec.AddField(sentnotification.FieldThreshold, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Recipient(); ok {
+		_spec.SetField(sentnotification.FieldRecipient, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Channel(); ok {
+		_spec.SetField(sentnotification.FieldChannel, field.TypeString, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sentnotification.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SentNotificationUpdateOne is the builder for updating a single SentNotification entity.
+type SentNotificationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SentNotificationMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *SentNotificationUpdateOne) SetUpdateTime(v time.Time) *SentNotificationUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *SentNotificationUpdateOne) SetKind(v sentnotification.Kind) *SentNotificationUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *SentNotificationUpdateOne) SetNillableKind(v *sentnotification.Kind) *SentNotificationUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetCertificateId sets the "certificateId" field.
+func (_u *SentNotificationUpdateOne) SetCertificateId(v int) *SentNotificationUpdateOne {
+	_u.mutation.ResetCertificateId()
+	_u.mutation.SetCertificateId(v)
+	return _u
+}
+
+// SetNillableCertificateId sets the "certificateId" field if the given value is not nil.
+func (_u *SentNotificationUpdateOne) SetNillableCertificateId(v *int) *SentNotificationUpdateOne {
+	if v != nil {
+		_u.SetCertificateId(*v)
+	}
+	return _u
+}
+
+// AddCertificateId adds value to the "certificateId" field.
+func (_u *SentNotificationUpdateOne) AddCertificateId(v int) *SentNotificationUpdateOne {
+	_u.mutation.AddCertificateId(v)
+	return _u
+}
+
+// SetThreshold sets the "threshold" field.
+func (_u *SentNotificationUpdateOne) SetThreshold(v int) *SentNotificationUpdateOne {
+	_u.mutation.ResetThreshold()
+	_u.mutation.SetThreshold(v)
+	return _u
+}
+
+// SetNillableThreshold sets the "threshold" field if the given value is not nil.
+func (_u *SentNotificationUpdateOne) SetNillableThreshold(v *int) *SentNotificationUpdateOne {
+	if v != nil {
+		_u.SetThreshold(*v)
+	}
+	return _u
+}
+
+// AddThreshold adds value to the "threshold" field.
+func (_u *SentNotificationUpdateOne) AddThreshold(v int) *SentNotificationUpdateOne {
+	_u.mutation.AddThreshold(v)
+	return _u
+}
+
+// SetRecipient sets the "recipient" field.
+func (_u *SentNotificationUpdateOne) SetRecipient(v string) *SentNotificationUpdateOne {
+	_u.mutation.SetRecipient(v)
+	return _u
+}
+
+// SetNillableRecipient sets the "recipient" field if the given value is not nil.
+func (_u *SentNotificationUpdateOne) SetNillableRecipient(v *string) *SentNotificationUpdateOne {
+	if v != nil {
+		_u.SetRecipient(*v)
+	}
+	return _u
+}
+
+// SetChannel sets the "channel" field.
+func (_u *SentNotificationUpdateOne) SetChannel(v string) *SentNotificationUpdateOne {
+	_u.mutation.SetChannel(v)
+	return _u
+}
+
+// SetNillableChannel sets the "channel" field if the given value is not nil.
+func (_u *SentNotificationUpdateOne) SetNillableChannel(v *string) *SentNotificationUpdateOne {
+	if v != nil {
+		_u.SetChannel(*v)
+	}
+	return _u
+}
+
+// Mutation returns the SentNotificationMutation object of the builder.
+func (_u *SentNotificationUpdateOne) Mutation() *SentNotificationMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the SentNotificationUpdate builder.
+func (_u *SentNotificationUpdateOne) Where(ps ...predicate.SentNotification) *SentNotificationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SentNotificationUpdateOne) Select(field string, fields ...string) *SentNotificationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated SentNotification entity.
+func (_u *SentNotificationUpdateOne) Save(ctx context.Context) (*SentNotification, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SentNotificationUpdateOne) SaveX(ctx context.Context) *SentNotification {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SentNotificationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SentNotificationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SentNotificationUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := sentnotification.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SentNotificationUpdateOne) check() error {
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := sentnotification.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "SentNotification.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Recipient(); ok {
+		if err := sentnotification.RecipientValidator(v); err != nil {
+			return &ValidationError{Name: "recipient", err: fmt.Errorf(`ent: validator failed for field "SentNotification.recipient": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *SentNotificationUpdateOne) sqlSave(ctx context.Context) (_node *SentNotification, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sentnotification.Table, sentnotification.Columns, sqlgraph.NewFieldSpec(sentnotification.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SentNotification.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sentnotification.FieldID)
+		for _, f := range fields {
+			if !sentnotification.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != sentnotification.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(sentnotification.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(sentnotification.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.CertificateId(); ok {
+		_spec.SetField(sentnotification.FieldCertificateId, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCertificateId(); ok {
+		_spec.AddField(sentnotification.FieldCertificateId, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Threshold(); ok {
+		_spec.SetField(sentnotification.FieldThreshold, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedThreshold(); ok {
+		_spec.AddField(sentnotification.FieldThreshold, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Recipient(); ok {
+		_spec.SetField(sentnotification.FieldRecipient, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Channel(); ok {
+		_spec.SetField(sentnotification.FieldChannel, field.TypeString, value)
+	}
+	_node = &SentNotification{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sentnotification.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
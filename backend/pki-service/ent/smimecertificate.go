@@ -9,6 +9,7 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/hm-edu/pki-service/ent/keyescrow"
 	"github.com/hm-edu/pki-service/ent/smimecertificate"
 )
 
@@ -36,10 +37,39 @@ type SmimeCertificate struct {
 	// Status holds the value of the "status" field.
 	Status smimecertificate.Status `json:"status,omitempty"`
 	// Ca holds the value of the "ca" field.
-	Ca           *string `json:"ca,omitempty"`
+	Ca *string `json:"ca,omitempty"`
+	// Organization holds the value of the "organization" field.
+	Organization *string `json:"organization,omitempty"`
+	// Certificate holds the value of the "certificate" field.
+	Certificate *string `json:"certificate,omitempty"`
+	// Owner holds the value of the "owner" field.
+	Owner *string `json:"owner,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SmimeCertificateQuery when eager-loading is set.
+	Edges        SmimeCertificateEdges `json:"edges"`
 	selectValues sql.SelectValues
 }
 
+// SmimeCertificateEdges holds the relations/edges for other nodes in the graph.
+type SmimeCertificateEdges struct {
+	// KeyEscrow holds the value of the keyEscrow edge.
+	KeyEscrow *KeyEscrow `json:"keyEscrow,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// KeyEscrowOrErr returns the KeyEscrow value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SmimeCertificateEdges) KeyEscrowOrErr() (*KeyEscrow, error) {
+	if e.KeyEscrow != nil {
+		return e.KeyEscrow, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: keyescrow.Label}
+	}
+	return nil, &NotLoadedError{edge: "keyEscrow"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*SmimeCertificate) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -47,7 +77,7 @@ func (*SmimeCertificate) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case smimecertificate.FieldID:
 			values[i] = new(sql.NullInt64)
-		case smimecertificate.FieldTransactionId, smimecertificate.FieldEmail, smimecertificate.FieldSerial, smimecertificate.FieldStatus, smimecertificate.FieldCa:
+		case smimecertificate.FieldTransactionId, smimecertificate.FieldEmail, smimecertificate.FieldSerial, smimecertificate.FieldStatus, smimecertificate.FieldCa, smimecertificate.FieldOrganization, smimecertificate.FieldCertificate, smimecertificate.FieldOwner:
 			values[i] = new(sql.NullString)
 		case smimecertificate.FieldCreateTime, smimecertificate.FieldUpdateTime, smimecertificate.FieldNotBefore, smimecertificate.FieldNotAfter, smimecertificate.FieldCreated:
 			values[i] = new(sql.NullTime)
@@ -135,6 +165,27 @@ func (_m *SmimeCertificate) assignValues(columns []string, values []any) error {
 				_m.Ca = new(string)
 				*_m.Ca = value.String
 			}
+		case smimecertificate.FieldOrganization:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field organization", values[i])
+			} else if value.Valid {
+				_m.Organization = new(string)
+				*_m.Organization = value.String
+			}
+		case smimecertificate.FieldCertificate:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field certificate", values[i])
+			} else if value.Valid {
+				_m.Certificate = new(string)
+				*_m.Certificate = value.String
+			}
+		case smimecertificate.FieldOwner:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field owner", values[i])
+			} else if value.Valid {
+				_m.Owner = new(string)
+				*_m.Owner = value.String
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -148,6 +199,11 @@ func (_m *SmimeCertificate) Value(name string) (ent.Value, error) {
 	return _m.selectValues.Get(name)
 }
 
+// QueryKeyEscrow queries the "keyEscrow" edge of the SmimeCertificate entity.
+func (_m *SmimeCertificate) QueryKeyEscrow() *KeyEscrowQuery {
+	return NewSmimeCertificateClient(_m.config).QueryKeyEscrow(_m)
+}
+
 // Update returns a builder for updating this SmimeCertificate.
 // Note that you need to call SmimeCertificate.Unwrap() before calling this method if this SmimeCertificate
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -206,6 +262,21 @@ func (_m *SmimeCertificate) String() string {
 		builder.WriteString("ca=")
 		builder.WriteString(*v)
 	}
+	builder.WriteString(", ")
+	if v := _m.Organization; v != nil {
+		builder.WriteString("organization=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.Certificate; v != nil {
+		builder.WriteString("certificate=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.Owner; v != nil {
+		builder.WriteString("owner=")
+		builder.WriteString(*v)
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -8,6 +8,7 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 )
 
 const (
@@ -35,8 +36,23 @@ const (
 	FieldStatus = "status"
 	// FieldCa holds the string denoting the ca field in the database.
 	FieldCa = "ca"
+	// FieldOrganization holds the string denoting the organization field in the database.
+	FieldOrganization = "organization"
+	// FieldCertificate holds the string denoting the certificate field in the database.
+	FieldCertificate = "certificate"
+	// FieldOwner holds the string denoting the owner field in the database.
+	FieldOwner = "owner"
+	// EdgeKeyEscrow holds the string denoting the keyescrow edge name in mutations.
+	EdgeKeyEscrow = "keyEscrow"
 	// Table holds the table name of the smimecertificate in the database.
 	Table = "smime_certificates"
+	// KeyEscrowTable is the table that holds the keyEscrow relation/edge.
+	KeyEscrowTable = "key_escrows"
+	// KeyEscrowInverseTable is the table name for the KeyEscrow entity.
+	// It exists in this package in order to avoid circular dependency with the "keyescrow" package.
+	KeyEscrowInverseTable = "key_escrows"
+	// KeyEscrowColumn is the table column denoting the keyEscrow relation/edge.
+	KeyEscrowColumn = "smime_certificate_key_escrow"
 )
 
 // Columns holds all SQL columns for smimecertificate fields.
@@ -52,6 +68,9 @@ var Columns = []string{
 	FieldCreated,
 	FieldStatus,
 	FieldCa,
+	FieldOrganization,
+	FieldCertificate,
+	FieldOwner,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -177,3 +196,32 @@ func ByStatus(opts ...sql.OrderTermOption) OrderOption {
 func ByCa(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCa, opts...).ToFunc()
 }
+
+// ByOrganization orders the results by the organization field.
+func ByOrganization(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrganization, opts...).ToFunc()
+}
+
+// ByCertificate orders the results by the certificate field.
+func ByCertificate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCertificate, opts...).ToFunc()
+}
+
+// ByOwner orders the results by the owner field.
+func ByOwner(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOwner, opts...).ToFunc()
+}
+
+// ByKeyEscrowField orders the results by keyEscrow field.
+func ByKeyEscrowField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newKeyEscrowStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newKeyEscrowStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(KeyEscrowInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, KeyEscrowTable, KeyEscrowColumn),
+	)
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/hm-edu/pki-service/ent/predicate"
 )
 
@@ -99,6 +100,21 @@ func Ca(v string) predicate.SmimeCertificate {
 	return predicate.SmimeCertificate(sql.FieldEQ(FieldCa, v))
 }
 
+// Organization applies equality check predicate on the "organization" field. It's identical to OrganizationEQ.
+func Organization(v string) predicate.SmimeCertificate {
+	return predicate.SmimeCertificate(sql.FieldEQ(FieldOrganization, v))
+}
+
+// Certificate applies equality check predicate on the "certificate" field. It's identical to CertificateEQ.
+func Certificate(v string) predicate.SmimeCertificate {
+	return predicate.SmimeCertificate(sql.FieldEQ(FieldCertificate, v))
+}
+
+// Owner applies equality check predicate on the "owner" field. It's identical to OwnerEQ.
+func Owner(v string) predicate.SmimeCertificate {
+	return predicate.SmimeCertificate(sql.FieldEQ(FieldOwner, v))
+}
+
 // CreateTimeEQ applies the EQ predicate on the "create_time" field.
 func CreateTimeEQ(v time.Time) predicate.SmimeCertificate {
 	return predicate.SmimeCertificate(sql.FieldEQ(FieldCreateTime, v))
@@ -629,6 +645,254 @@ func CaContainsFold(v string) predicate.SmimeCertificate {
 	return predicate.SmimeCertificate(sql.FieldContainsFold(FieldCa, v))
 }
 
+// OrganizationEQ applies the EQ predicate on the "organization" field.
+func OrganizationEQ(v string) predicate.SmimeCertificate {
+	return predicate.SmimeCertificate(sql.FieldEQ(FieldOrganization, v))
+}
+
+// OrganizationNEQ applies the NEQ predicate on the "organization" field.
+func OrganizationNEQ(v string) predicate.SmimeCertificate {
+	return predicate.SmimeCertificate(sql.FieldNEQ(FieldOrganization, v))
+}
+
+// OrganizationIn applies the In predicate on the "organization" field.
+func OrganizationIn(vs ...string) predicate.SmimeCertificate {
+	return predicate.SmimeCertificate(sql.FieldIn(FieldOrganization, vs...))
+}
+
+// OrganizationNotIn applies the NotIn predicate on the "organization" field.
+func OrganizationNotIn(vs ...string) predicate.SmimeCertificate {
+	return predicate.SmimeCertificate(sql.FieldNotIn(FieldOrganization, vs...))
+}
+
+// OrganizationGT applies the GT predicate on the "organization" field.
+func OrganizationGT(v string) predicate.SmimeCertificate {
+	return predicate.SmimeCertificate(sql.FieldGT(FieldOrganization, v))
+}
+
+// OrganizationGTE applies the GTE predicate on the "organization" field.
+func OrganizationGTE(v string) predicate.SmimeCertificate {
+	return predicate.SmimeCertificate(sql.FieldGTE(FieldOrganization, v))
+}
+
+// OrganizationLT applies the LT predicate on the "organization" field.
+func OrganizationLT(v string) predicate.SmimeCertificate {
+	return predicate.SmimeCertificate(sql.FieldLT(FieldOrganization, v))
+}
+
+// OrganizationLTE applies the LTE predicate on the "organization" field.
+func OrganizationLTE(v string) predicate.SmimeCertificate {
+	return predicate.SmimeCertificate(sql.FieldLTE(FieldOrganization, v))
+}
+
+// OrganizationContains applies the Contains predicate on the "organization" field.
+func OrganizationContains(v string) predicate.SmimeCertificate {
+	return predicate.SmimeCertificate(sql.FieldContains(FieldOrganization, v))
+}
+
+// OrganizationHasPrefix applies the HasPrefix predicate on the "organization" field.
+func OrganizationHasPrefix(v string) predicate.SmimeCertificate {
+	return predicate.SmimeCertificate(sql.FieldHasPrefix(FieldOrganization, v))
+}
+
+// OrganizationHasSuffix applies the HasSuffix predicate on the "organization" field.
+func OrganizationHasSuffix(v string) predicate.SmimeCertificate {
+	return predicate.SmimeCertificate(sql.FieldHasSuffix(FieldOrganization, v))
+}
+
+// OrganizationIsNil applies the IsNil predicate on the "organization" field.
+func OrganizationIsNil() predicate.SmimeCertificate {
+	return predicate.SmimeCertificate(sql.FieldIsNull(FieldOrganization))
+}
+
+// OrganizationNotNil applies the NotNil predicate on the "organization" field.
+func OrganizationNotNil() predicate.SmimeCertificate {
+	return predicate.SmimeCertificate(sql.FieldNotNull(FieldOrganization))
+}
+
+// OrganizationEqualFold applies the EqualFold predicate on the "organization" field.
+func OrganizationEqualFold(v string) predicate.SmimeCertificate {
+	return predicate.SmimeCertificate(sql.FieldEqualFold(FieldOrganization, v))
+}
+
+// OrganizationContainsFold applies the ContainsFold predicate on the "organization" field.
+func OrganizationContainsFold(v string) predicate.SmimeCertificate {
+	return predicate.SmimeCertificate(sql.FieldContainsFold(FieldOrganization, v))
+}
+
+// CertificateEQ applies the EQ predicate on the "certificate" field.
+func CertificateEQ(v string) predicate.SmimeCertificate {
+	return predicate.SmimeCertificate(sql.FieldEQ(FieldCertificate, v))
+}
+
+// CertificateNEQ applies the NEQ predicate on the "certificate" field.
+func CertificateNEQ(v string) predicate.SmimeCertificate {
+	return predicate.SmimeCertificate(sql.FieldNEQ(FieldCertificate, v))
+}
+
+// CertificateIn applies the In predicate on the "certificate" field.
+func CertificateIn(vs ...string) predicate.SmimeCertificate {
+	return predicate.SmimeCertificate(sql.FieldIn(FieldCertificate, vs...))
+}
+
+// CertificateNotIn applies the NotIn predicate on the "certificate" field.
+func CertificateNotIn(vs ...string) predicate.SmimeCertificate {
+	return predicate.SmimeCertificate(sql.FieldNotIn(FieldCertificate, vs...))
+}
+
+// CertificateGT applies the GT predicate on the "certificate" field.
+func CertificateGT(v string) predicate.SmimeCertificate {
+	return predicate.SmimeCertificate(sql.FieldGT(FieldCertificate, v))
+}
+
+// CertificateGTE applies the GTE predicate on the "certificate" field.
+func CertificateGTE(v string) predicate.SmimeCertificate {
+	return predicate.SmimeCertificate(sql.FieldGTE(FieldCertificate, v))
+}
+
+// CertificateLT applies the LT predicate on the "certificate" field.
+func CertificateLT(v string) predicate.SmimeCertificate {
+	return predicate.SmimeCertificate(sql.FieldLT(FieldCertificate, v))
+}
+
+// CertificateLTE applies the LTE predicate on the "certificate" field.
+func CertificateLTE(v string) predicate.SmimeCertificate {
+	return predicate.SmimeCertificate(sql.FieldLTE(FieldCertificate, v))
+}
+
+// CertificateContains applies the Contains predicate on the "certificate" field.
+func CertificateContains(v string) predicate.SmimeCertificate {
+	return predicate.SmimeCertificate(sql.FieldContains(FieldCertificate, v))
+}
+
+// CertificateHasPrefix applies the HasPrefix predicate on the "certificate" field.
+func CertificateHasPrefix(v string) predicate.SmimeCertificate {
+	return predicate.SmimeCertificate(sql.FieldHasPrefix(FieldCertificate, v))
+}
+
+// CertificateHasSuffix applies the HasSuffix predicate on the "certificate" field.
+func CertificateHasSuffix(v string) predicate.SmimeCertificate {
+	return predicate.SmimeCertificate(sql.FieldHasSuffix(FieldCertificate, v))
+}
+
+// CertificateIsNil applies the IsNil predicate on the "certificate" field.
+func CertificateIsNil() predicate.SmimeCertificate {
+	return predicate.SmimeCertificate(sql.FieldIsNull(FieldCertificate))
+}
+
+// CertificateNotNil applies the NotNil predicate on the "certificate" field.
+func CertificateNotNil() predicate.SmimeCertificate {
+	return predicate.SmimeCertificate(sql.FieldNotNull(FieldCertificate))
+}
+
+// CertificateEqualFold applies the EqualFold predicate on the "certificate" field.
+func CertificateEqualFold(v string) predicate.SmimeCertificate {
+	return predicate.SmimeCertificate(sql.FieldEqualFold(FieldCertificate, v))
+}
+
+// CertificateContainsFold applies the ContainsFold predicate on the "certificate" field.
+func CertificateContainsFold(v string) predicate.SmimeCertificate {
+	return predicate.SmimeCertificate(sql.FieldContainsFold(FieldCertificate, v))
+}
+
+// OwnerEQ applies the EQ predicate on the "owner" field.
+func OwnerEQ(v string) predicate.SmimeCertificate {
+	return predicate.SmimeCertificate(sql.FieldEQ(FieldOwner, v))
+}
+
+// OwnerNEQ applies the NEQ predicate on the "owner" field.
+func OwnerNEQ(v string) predicate.SmimeCertificate {
+	return predicate.SmimeCertificate(sql.FieldNEQ(FieldOwner, v))
+}
+
+// OwnerIn applies the In predicate on the "owner" field.
+func OwnerIn(vs ...string) predicate.SmimeCertificate {
+	return predicate.SmimeCertificate(sql.FieldIn(FieldOwner, vs...))
+}
+
+// OwnerNotIn applies the NotIn predicate on the "owner" field.
+func OwnerNotIn(vs ...string) predicate.SmimeCertificate {
+	return predicate.SmimeCertificate(sql.FieldNotIn(FieldOwner, vs...))
+}
+
+// OwnerGT applies the GT predicate on the "owner" field.
+func OwnerGT(v string) predicate.SmimeCertificate {
+	return predicate.SmimeCertificate(sql.FieldGT(FieldOwner, v))
+}
+
+// OwnerGTE applies the GTE predicate on the "owner" field.
+func OwnerGTE(v string) predicate.SmimeCertificate {
+	return predicate.SmimeCertificate(sql.FieldGTE(FieldOwner, v))
+}
+
+// OwnerLT applies the LT predicate on the "owner" field.
+func OwnerLT(v string) predicate.SmimeCertificate {
+	return predicate.SmimeCertificate(sql.FieldLT(FieldOwner, v))
+}
+
+// OwnerLTE applies the LTE predicate on the "owner" field.
+func OwnerLTE(v string) predicate.SmimeCertificate {
+	return predicate.SmimeCertificate(sql.FieldLTE(FieldOwner, v))
+}
+
+// OwnerContains applies the Contains predicate on the "owner" field.
+func OwnerContains(v string) predicate.SmimeCertificate {
+	return predicate.SmimeCertificate(sql.FieldContains(FieldOwner, v))
+}
+
+// OwnerHasPrefix applies the HasPrefix predicate on the "owner" field.
+func OwnerHasPrefix(v string) predicate.SmimeCertificate {
+	return predicate.SmimeCertificate(sql.FieldHasPrefix(FieldOwner, v))
+}
+
+// OwnerHasSuffix applies the HasSuffix predicate on the "owner" field.
+func OwnerHasSuffix(v string) predicate.SmimeCertificate {
+	return predicate.SmimeCertificate(sql.FieldHasSuffix(FieldOwner, v))
+}
+
+// OwnerIsNil applies the IsNil predicate on the "owner" field.
+func OwnerIsNil() predicate.SmimeCertificate {
+	return predicate.SmimeCertificate(sql.FieldIsNull(FieldOwner))
+}
+
+// OwnerNotNil applies the NotNil predicate on the "owner" field.
+func OwnerNotNil() predicate.SmimeCertificate {
+	return predicate.SmimeCertificate(sql.FieldNotNull(FieldOwner))
+}
+
+// OwnerEqualFold applies the EqualFold predicate on the "owner" field.
+func OwnerEqualFold(v string) predicate.SmimeCertificate {
+	return predicate.SmimeCertificate(sql.FieldEqualFold(FieldOwner, v))
+}
+
+// OwnerContainsFold applies the ContainsFold predicate on the "owner" field.
+func OwnerContainsFold(v string) predicate.SmimeCertificate {
+	return predicate.SmimeCertificate(sql.FieldContainsFold(FieldOwner, v))
+}
+
+// HasKeyEscrow applies the HasEdge predicate on the "keyEscrow" edge.
+func HasKeyEscrow() predicate.SmimeCertificate {
+	return predicate.SmimeCertificate(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, KeyEscrowTable, KeyEscrowColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasKeyEscrowWith applies the HasEdge predicate on the "keyEscrow" edge with a given conditions (other predicates).
+func HasKeyEscrowWith(preds ...predicate.KeyEscrow) predicate.SmimeCertificate {
+	return predicate.SmimeCertificate(func(s *sql.Selector) {
+		step := newKeyEscrowStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.SmimeCertificate) predicate.SmimeCertificate {
 	return predicate.SmimeCertificate(sql.AndPredicates(predicates...))
@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/hm-edu/pki-service/ent/keyescrow"
 	"github.com/hm-edu/pki-service/ent/smimecertificate"
 )
 
@@ -146,6 +147,67 @@ func (_c *SmimeCertificateCreate) SetNillableCa(v *string) *SmimeCertificateCrea
 	return _c
 }
 
+// SetOrganization sets the "organization" field.
+func (_c *SmimeCertificateCreate) SetOrganization(v string) *SmimeCertificateCreate {
+	_c.mutation.SetOrganization(v)
+	return _c
+}
+
+// SetNillableOrganization sets the "organization" field if the given value is not nil.
+func (_c *SmimeCertificateCreate) SetNillableOrganization(v *string) *SmimeCertificateCreate {
+	if v != nil {
+		_c.SetOrganization(*v)
+	}
+	return _c
+}
+
+// SetCertificate sets the "certificate" field.
+func (_c *SmimeCertificateCreate) SetCertificate(v string) *SmimeCertificateCreate {
+	_c.mutation.SetCertificate(v)
+	return _c
+}
+
+// SetNillableCertificate sets the "certificate" field if the given value is not nil.
+func (_c *SmimeCertificateCreate) SetNillableCertificate(v *string) *SmimeCertificateCreate {
+	if v != nil {
+		_c.SetCertificate(*v)
+	}
+	return _c
+}
+
+// SetOwner sets the "owner" field.
+func (_c *SmimeCertificateCreate) SetOwner(v string) *SmimeCertificateCreate {
+	_c.mutation.SetOwner(v)
+	return _c
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (_c *SmimeCertificateCreate) SetNillableOwner(v *string) *SmimeCertificateCreate {
+	if v != nil {
+		_c.SetOwner(*v)
+	}
+	return _c
+}
+
+// SetKeyEscrowID sets the "keyEscrow" edge to the KeyEscrow entity by ID.
+func (_c *SmimeCertificateCreate) SetKeyEscrowID(id int) *SmimeCertificateCreate {
+	_c.mutation.SetKeyEscrowID(id)
+	return _c
+}
+
+// SetNillableKeyEscrowID sets the "keyEscrow" edge to the KeyEscrow entity by ID if the given value is not nil.
+func (_c *SmimeCertificateCreate) SetNillableKeyEscrowID(id *int) *SmimeCertificateCreate {
+	if id != nil {
+		_c = _c.SetKeyEscrowID(*id)
+	}
+	return _c
+}
+
+// SetKeyEscrow sets the "keyEscrow" edge to the KeyEscrow entity.
+func (_c *SmimeCertificateCreate) SetKeyEscrow(v *KeyEscrow) *SmimeCertificateCreate {
+	return _c.SetKeyEscrowID(v.ID)
+}
+
 // Mutation returns the SmimeCertificateMutation object of the builder.
 func (_c *SmimeCertificateCreate) Mutation() *SmimeCertificateMutation {
 	return _c.mutation
@@ -303,6 +365,34 @@ func (_c *SmimeCertificateCreate) createSpec() (*SmimeCertificate, *sqlgraph.Cre
 		_spec.SetField(smimecertificate.FieldCa, field.TypeString, value)
 		_node.Ca = &value
 	}
+	if value, ok := _c.mutation.Organization(); ok {
+		_spec.SetField(smimecertificate.FieldOrganization, field.TypeString, value)
+		_node.Organization = &value
+	}
+	if value, ok := _c.mutation.Certificate(); ok {
+		_spec.SetField(smimecertificate.FieldCertificate, field.TypeString, value)
+		_node.Certificate = &value
+	}
+	if value, ok := _c.mutation.Owner(); ok {
+		_spec.SetField(smimecertificate.FieldOwner, field.TypeString, value)
+		_node.Owner = &value
+	}
+	if nodes := _c.mutation.KeyEscrowIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   smimecertificate.KeyEscrowTable,
+			Columns: []string{smimecertificate.KeyEscrowColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(keyescrow.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -493,6 +583,60 @@ func (u *SmimeCertificateUpsert) ClearCa() *SmimeCertificateUpsert {
 	return u
 }
 
+// SetOrganization sets the "organization" field.
+func (u *SmimeCertificateUpsert) SetOrganization(v string) *SmimeCertificateUpsert {
+	u.Set(smimecertificate.FieldOrganization, v)
+	return u
+}
+
+// UpdateOrganization sets the "organization" field to the value that was provided on create.
+func (u *SmimeCertificateUpsert) UpdateOrganization() *SmimeCertificateUpsert {
+	u.SetExcluded(smimecertificate.FieldOrganization)
+	return u
+}
+
+// ClearOrganization clears the value of the "organization" field.
+func (u *SmimeCertificateUpsert) ClearOrganization() *SmimeCertificateUpsert {
+	u.SetNull(smimecertificate.FieldOrganization)
+	return u
+}
+
+// SetCertificate sets the "certificate" field.
+func (u *SmimeCertificateUpsert) SetCertificate(v string) *SmimeCertificateUpsert {
+	u.Set(smimecertificate.FieldCertificate, v)
+	return u
+}
+
+// UpdateCertificate sets the "certificate" field to the value that was provided on create.
+func (u *SmimeCertificateUpsert) UpdateCertificate() *SmimeCertificateUpsert {
+	u.SetExcluded(smimecertificate.FieldCertificate)
+	return u
+}
+
+// ClearCertificate clears the value of the "certificate" field.
+func (u *SmimeCertificateUpsert) ClearCertificate() *SmimeCertificateUpsert {
+	u.SetNull(smimecertificate.FieldCertificate)
+	return u
+}
+
+// SetOwner sets the "owner" field.
+func (u *SmimeCertificateUpsert) SetOwner(v string) *SmimeCertificateUpsert {
+	u.Set(smimecertificate.FieldOwner, v)
+	return u
+}
+
+// UpdateOwner sets the "owner" field to the value that was provided on create.
+func (u *SmimeCertificateUpsert) UpdateOwner() *SmimeCertificateUpsert {
+	u.SetExcluded(smimecertificate.FieldOwner)
+	return u
+}
+
+// ClearOwner clears the value of the "owner" field.
+func (u *SmimeCertificateUpsert) ClearOwner() *SmimeCertificateUpsert {
+	u.SetNull(smimecertificate.FieldOwner)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -699,6 +843,69 @@ func (u *SmimeCertificateUpsertOne) ClearCa() *SmimeCertificateUpsertOne {
 	})
 }
 
+// SetOrganization sets the "organization" field.
+func (u *SmimeCertificateUpsertOne) SetOrganization(v string) *SmimeCertificateUpsertOne {
+	return u.Update(func(s *SmimeCertificateUpsert) {
+		s.SetOrganization(v)
+	})
+}
+
+// UpdateOrganization sets the "organization" field to the value that was provided on create.
+func (u *SmimeCertificateUpsertOne) UpdateOrganization() *SmimeCertificateUpsertOne {
+	return u.Update(func(s *SmimeCertificateUpsert) {
+		s.UpdateOrganization()
+	})
+}
+
+// ClearOrganization clears the value of the "organization" field.
+func (u *SmimeCertificateUpsertOne) ClearOrganization() *SmimeCertificateUpsertOne {
+	return u.Update(func(s *SmimeCertificateUpsert) {
+		s.ClearOrganization()
+	})
+}
+
+// SetCertificate sets the "certificate" field.
+func (u *SmimeCertificateUpsertOne) SetCertificate(v string) *SmimeCertificateUpsertOne {
+	return u.Update(func(s *SmimeCertificateUpsert) {
+		s.SetCertificate(v)
+	})
+}
+
+// UpdateCertificate sets the "certificate" field to the value that was provided on create.
+func (u *SmimeCertificateUpsertOne) UpdateCertificate() *SmimeCertificateUpsertOne {
+	return u.Update(func(s *SmimeCertificateUpsert) {
+		s.UpdateCertificate()
+	})
+}
+
+// ClearCertificate clears the value of the "certificate" field.
+func (u *SmimeCertificateUpsertOne) ClearCertificate() *SmimeCertificateUpsertOne {
+	return u.Update(func(s *SmimeCertificateUpsert) {
+		s.ClearCertificate()
+	})
+}
+
+// SetOwner sets the "owner" field.
+func (u *SmimeCertificateUpsertOne) SetOwner(v string) *SmimeCertificateUpsertOne {
+	return u.Update(func(s *SmimeCertificateUpsert) {
+		s.SetOwner(v)
+	})
+}
+
+// UpdateOwner sets the "owner" field to the value that was provided on create.
+func (u *SmimeCertificateUpsertOne) UpdateOwner() *SmimeCertificateUpsertOne {
+	return u.Update(func(s *SmimeCertificateUpsert) {
+		s.UpdateOwner()
+	})
+}
+
+// ClearOwner clears the value of the "owner" field.
+func (u *SmimeCertificateUpsertOne) ClearOwner() *SmimeCertificateUpsertOne {
+	return u.Update(func(s *SmimeCertificateUpsert) {
+		s.ClearOwner()
+	})
+}
+
 // Exec executes the query.
 func (u *SmimeCertificateUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1071,6 +1278,69 @@ func (u *SmimeCertificateUpsertBulk) ClearCa() *SmimeCertificateUpsertBulk {
 	})
 }
 
+// SetOrganization sets the "organization" field.
+func (u *SmimeCertificateUpsertBulk) SetOrganization(v string) *SmimeCertificateUpsertBulk {
+	return u.Update(func(s *SmimeCertificateUpsert) {
+		s.SetOrganization(v)
+	})
+}
+
+// UpdateOrganization sets the "organization" field to the value that was provided on create.
+func (u *SmimeCertificateUpsertBulk) UpdateOrganization() *SmimeCertificateUpsertBulk {
+	return u.Update(func(s *SmimeCertificateUpsert) {
+		s.UpdateOrganization()
+	})
+}
+
+// ClearOrganization clears the value of the "organization" field.
+func (u *SmimeCertificateUpsertBulk) ClearOrganization() *SmimeCertificateUpsertBulk {
+	return u.Update(func(s *SmimeCertificateUpsert) {
+		s.ClearOrganization()
+	})
+}
+
+// SetCertificate sets the "certificate" field.
+func (u *SmimeCertificateUpsertBulk) SetCertificate(v string) *SmimeCertificateUpsertBulk {
+	return u.Update(func(s *SmimeCertificateUpsert) {
+		s.SetCertificate(v)
+	})
+}
+
+// UpdateCertificate sets the "certificate" field to the value that was provided on create.
+func (u *SmimeCertificateUpsertBulk) UpdateCertificate() *SmimeCertificateUpsertBulk {
+	return u.Update(func(s *SmimeCertificateUpsert) {
+		s.UpdateCertificate()
+	})
+}
+
+// ClearCertificate clears the value of the "certificate" field.
+func (u *SmimeCertificateUpsertBulk) ClearCertificate() *SmimeCertificateUpsertBulk {
+	return u.Update(func(s *SmimeCertificateUpsert) {
+		s.ClearCertificate()
+	})
+}
+
+// SetOwner sets the "owner" field.
+func (u *SmimeCertificateUpsertBulk) SetOwner(v string) *SmimeCertificateUpsertBulk {
+	return u.Update(func(s *SmimeCertificateUpsert) {
+		s.SetOwner(v)
+	})
+}
+
+// UpdateOwner sets the "owner" field to the value that was provided on create.
+func (u *SmimeCertificateUpsertBulk) UpdateOwner() *SmimeCertificateUpsertBulk {
+	return u.Update(func(s *SmimeCertificateUpsert) {
+		s.UpdateOwner()
+	})
+}
+
+// ClearOwner clears the value of the "owner" field.
+func (u *SmimeCertificateUpsertBulk) ClearOwner() *SmimeCertificateUpsertBulk {
+	return u.Update(func(s *SmimeCertificateUpsert) {
+		s.ClearOwner()
+	})
+}
+
 // Exec executes the query.
 func (u *SmimeCertificateUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
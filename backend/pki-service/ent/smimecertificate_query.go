@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -11,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/hm-edu/pki-service/ent/keyescrow"
 	"github.com/hm-edu/pki-service/ent/predicate"
 	"github.com/hm-edu/pki-service/ent/smimecertificate"
 )
@@ -18,10 +20,11 @@ import (
 // SmimeCertificateQuery is the builder for querying SmimeCertificate entities.
 type SmimeCertificateQuery struct {
 	config
-	ctx        *QueryContext
-	order      []smimecertificate.OrderOption
-	inters     []Interceptor
-	predicates []predicate.SmimeCertificate
+	ctx           *QueryContext
+	order         []smimecertificate.OrderOption
+	inters        []Interceptor
+	predicates    []predicate.SmimeCertificate
+	withKeyEscrow *KeyEscrowQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -58,6 +61,28 @@ func (_q *SmimeCertificateQuery) Order(o ...smimecertificate.OrderOption) *Smime
 	return _q
 }
 
+// QueryKeyEscrow chains the current query on the "keyEscrow" edge.
+func (_q *SmimeCertificateQuery) QueryKeyEscrow() *KeyEscrowQuery {
+	query := (&KeyEscrowClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(smimecertificate.Table, smimecertificate.FieldID, selector),
+			sqlgraph.To(keyescrow.Table, keyescrow.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, smimecertificate.KeyEscrowTable, smimecertificate.KeyEscrowColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first SmimeCertificate entity from the query.
 // Returns a *NotFoundError when no SmimeCertificate was found.
 func (_q *SmimeCertificateQuery) First(ctx context.Context) (*SmimeCertificate, error) {
@@ -245,17 +270,29 @@ func (_q *SmimeCertificateQuery) Clone() *SmimeCertificateQuery {
 		return nil
 	}
 	return &SmimeCertificateQuery{
-		config:     _q.config,
-		ctx:        _q.ctx.Clone(),
-		order:      append([]smimecertificate.OrderOption{}, _q.order...),
-		inters:     append([]Interceptor{}, _q.inters...),
-		predicates: append([]predicate.SmimeCertificate{}, _q.predicates...),
+		config:        _q.config,
+		ctx:           _q.ctx.Clone(),
+		order:         append([]smimecertificate.OrderOption{}, _q.order...),
+		inters:        append([]Interceptor{}, _q.inters...),
+		predicates:    append([]predicate.SmimeCertificate{}, _q.predicates...),
+		withKeyEscrow: _q.withKeyEscrow.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
 	}
 }
 
+// WithKeyEscrow tells the query-builder to eager-load the nodes that are connected to
+// the "keyEscrow" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *SmimeCertificateQuery) WithKeyEscrow(opts ...func(*KeyEscrowQuery)) *SmimeCertificateQuery {
+	query := (&KeyEscrowClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withKeyEscrow = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -332,8 +369,11 @@ func (_q *SmimeCertificateQuery) prepareQuery(ctx context.Context) error {
 
 func (_q *SmimeCertificateQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SmimeCertificate, error) {
 	var (
-		nodes = []*SmimeCertificate{}
-		_spec = _q.querySpec()
+		nodes       = []*SmimeCertificate{}
+		_spec       = _q.querySpec()
+		loadedTypes = [1]bool{
+			_q.withKeyEscrow != nil,
+		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*SmimeCertificate).scanValues(nil, columns)
@@ -341,6 +381,7 @@ func (_q *SmimeCertificateQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 	_spec.Assign = func(columns []string, values []any) error {
 		node := &SmimeCertificate{config: _q.config}
 		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
 	for i := range hooks {
@@ -352,9 +393,44 @@ func (_q *SmimeCertificateQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+	if query := _q.withKeyEscrow; query != nil {
+		if err := _q.loadKeyEscrow(ctx, query, nodes, nil,
+			func(n *SmimeCertificate, e *KeyEscrow) { n.Edges.KeyEscrow = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
+func (_q *SmimeCertificateQuery) loadKeyEscrow(ctx context.Context, query *KeyEscrowQuery, nodes []*SmimeCertificate, init func(*SmimeCertificate), assign func(*SmimeCertificate, *KeyEscrow)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*SmimeCertificate)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	query.withFKs = true
+	query.Where(predicate.KeyEscrow(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(smimecertificate.KeyEscrowColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.smime_certificate_key_escrow
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "smime_certificate_key_escrow" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "smime_certificate_key_escrow" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
 func (_q *SmimeCertificateQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
 	_spec.Node.Columns = _q.ctx.Fields
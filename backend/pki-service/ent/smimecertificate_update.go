@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/hm-edu/pki-service/ent/keyescrow"
 	"github.com/hm-edu/pki-service/ent/predicate"
 	"github.com/hm-edu/pki-service/ent/smimecertificate"
 )
@@ -176,11 +177,96 @@ func (_u *SmimeCertificateUpdate) ClearCa() *SmimeCertificateUpdate {
 	return _u
 }
 
+// SetOrganization sets the "organization" field.
+func (_u *SmimeCertificateUpdate) SetOrganization(v string) *SmimeCertificateUpdate {
+	_u.mutation.SetOrganization(v)
+	return _u
+}
+
+// SetNillableOrganization sets the "organization" field if the given value is not nil.
+func (_u *SmimeCertificateUpdate) SetNillableOrganization(v *string) *SmimeCertificateUpdate {
+	if v != nil {
+		_u.SetOrganization(*v)
+	}
+	return _u
+}
+
+// ClearOrganization clears the value of the "organization" field.
+func (_u *SmimeCertificateUpdate) ClearOrganization() *SmimeCertificateUpdate {
+	_u.mutation.ClearOrganization()
+	return _u
+}
+
+// SetCertificate sets the "certificate" field.
+func (_u *SmimeCertificateUpdate) SetCertificate(v string) *SmimeCertificateUpdate {
+	_u.mutation.SetCertificate(v)
+	return _u
+}
+
+// SetNillableCertificate sets the "certificate" field if the given value is not nil.
+func (_u *SmimeCertificateUpdate) SetNillableCertificate(v *string) *SmimeCertificateUpdate {
+	if v != nil {
+		_u.SetCertificate(*v)
+	}
+	return _u
+}
+
+// ClearCertificate clears the value of the "certificate" field.
+func (_u *SmimeCertificateUpdate) ClearCertificate() *SmimeCertificateUpdate {
+	_u.mutation.ClearCertificate()
+	return _u
+}
+
+// SetOwner sets the "owner" field.
+func (_u *SmimeCertificateUpdate) SetOwner(v string) *SmimeCertificateUpdate {
+	_u.mutation.SetOwner(v)
+	return _u
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (_u *SmimeCertificateUpdate) SetNillableOwner(v *string) *SmimeCertificateUpdate {
+	if v != nil {
+		_u.SetOwner(*v)
+	}
+	return _u
+}
+
+// ClearOwner clears the value of the "owner" field.
+func (_u *SmimeCertificateUpdate) ClearOwner() *SmimeCertificateUpdate {
+	_u.mutation.ClearOwner()
+	return _u
+}
+
+// SetKeyEscrowID sets the "keyEscrow" edge to the KeyEscrow entity by ID.
+func (_u *SmimeCertificateUpdate) SetKeyEscrowID(id int) *SmimeCertificateUpdate {
+	_u.mutation.SetKeyEscrowID(id)
+	return _u
+}
+
+// SetNillableKeyEscrowID sets the "keyEscrow" edge to the KeyEscrow entity by ID if the given value is not nil.
+func (_u *SmimeCertificateUpdate) SetNillableKeyEscrowID(id *int) *SmimeCertificateUpdate {
+	if id != nil {
+		_u = _u.SetKeyEscrowID(*id)
+	}
+	return _u
+}
+
+// SetKeyEscrow sets the "keyEscrow" edge to the KeyEscrow entity.
+func (_u *SmimeCertificateUpdate) SetKeyEscrow(v *KeyEscrow) *SmimeCertificateUpdate {
+	return _u.SetKeyEscrowID(v.ID)
+}
+
 // Mutation returns the SmimeCertificateMutation object of the builder.
 func (_u *SmimeCertificateUpdate) Mutation() *SmimeCertificateMutation {
 	return _u.mutation
 }
 
+// ClearKeyEscrow clears the "keyEscrow" edge to the KeyEscrow entity.
+func (_u *SmimeCertificateUpdate) ClearKeyEscrow() *SmimeCertificateUpdate {
+	_u.mutation.ClearKeyEscrow()
+	return _u
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *SmimeCertificateUpdate) Save(ctx context.Context) (int, error) {
 	if err := _u.defaults(); err != nil {
@@ -297,6 +383,53 @@ func (_u *SmimeCertificateUpdate) sqlSave(ctx context.Context) (_node int, err e
 	if _u.mutation.CaCleared() {
 		_spec.ClearField(smimecertificate.FieldCa, field.TypeString)
 	}
+	if value, ok := _u.mutation.Organization(); ok {
+		_spec.SetField(smimecertificate.FieldOrganization, field.TypeString, value)
+	}
+	if _u.mutation.OrganizationCleared() {
+		_spec.ClearField(smimecertificate.FieldOrganization, field.TypeString)
+	}
+	if value, ok := _u.mutation.Certificate(); ok {
+		_spec.SetField(smimecertificate.FieldCertificate, field.TypeString, value)
+	}
+	if _u.mutation.CertificateCleared() {
+		_spec.ClearField(smimecertificate.FieldCertificate, field.TypeString)
+	}
+	if value, ok := _u.mutation.Owner(); ok {
+		_spec.SetField(smimecertificate.FieldOwner, field.TypeString, value)
+	}
+	if _u.mutation.OwnerCleared() {
+		_spec.ClearField(smimecertificate.FieldOwner, field.TypeString)
+	}
+	if _u.mutation.KeyEscrowCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   smimecertificate.KeyEscrowTable,
+			Columns: []string{smimecertificate.KeyEscrowColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(keyescrow.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.KeyEscrowIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   smimecertificate.KeyEscrowTable,
+			Columns: []string{smimecertificate.KeyEscrowColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(keyescrow.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{smimecertificate.Label}
@@ -465,11 +598,96 @@ func (_u *SmimeCertificateUpdateOne) ClearCa() *SmimeCertificateUpdateOne {
 	return _u
 }
 
+// SetOrganization sets the "organization" field.
+func (_u *SmimeCertificateUpdateOne) SetOrganization(v string) *SmimeCertificateUpdateOne {
+	_u.mutation.SetOrganization(v)
+	return _u
+}
+
+// SetNillableOrganization sets the "organization" field if the given value is not nil.
+func (_u *SmimeCertificateUpdateOne) SetNillableOrganization(v *string) *SmimeCertificateUpdateOne {
+	if v != nil {
+		_u.SetOrganization(*v)
+	}
+	return _u
+}
+
+// ClearOrganization clears the value of the "organization" field.
+func (_u *SmimeCertificateUpdateOne) ClearOrganization() *SmimeCertificateUpdateOne {
+	_u.mutation.ClearOrganization()
+	return _u
+}
+
+// SetCertificate sets the "certificate" field.
+func (_u *SmimeCertificateUpdateOne) SetCertificate(v string) *SmimeCertificateUpdateOne {
+	_u.mutation.SetCertificate(v)
+	return _u
+}
+
+// SetNillableCertificate sets the "certificate" field if the given value is not nil.
+func (_u *SmimeCertificateUpdateOne) SetNillableCertificate(v *string) *SmimeCertificateUpdateOne {
+	if v != nil {
+		_u.SetCertificate(*v)
+	}
+	return _u
+}
+
+// ClearCertificate clears the value of the "certificate" field.
+func (_u *SmimeCertificateUpdateOne) ClearCertificate() *SmimeCertificateUpdateOne {
+	_u.mutation.ClearCertificate()
+	return _u
+}
+
+// SetOwner sets the "owner" field.
+func (_u *SmimeCertificateUpdateOne) SetOwner(v string) *SmimeCertificateUpdateOne {
+	_u.mutation.SetOwner(v)
+	return _u
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (_u *SmimeCertificateUpdateOne) SetNillableOwner(v *string) *SmimeCertificateUpdateOne {
+	if v != nil {
+		_u.SetOwner(*v)
+	}
+	return _u
+}
+
+// ClearOwner clears the value of the "owner" field.
+func (_u *SmimeCertificateUpdateOne) ClearOwner() *SmimeCertificateUpdateOne {
+	_u.mutation.ClearOwner()
+	return _u
+}
+
+// SetKeyEscrowID sets the "keyEscrow" edge to the KeyEscrow entity by ID.
+func (_u *SmimeCertificateUpdateOne) SetKeyEscrowID(id int) *SmimeCertificateUpdateOne {
+	_u.mutation.SetKeyEscrowID(id)
+	return _u
+}
+
+// SetNillableKeyEscrowID sets the "keyEscrow" edge to the KeyEscrow entity by ID if the given value is not nil.
+func (_u *SmimeCertificateUpdateOne) SetNillableKeyEscrowID(id *int) *SmimeCertificateUpdateOne {
+	if id != nil {
+		_u = _u.SetKeyEscrowID(*id)
+	}
+	return _u
+}
+
+// SetKeyEscrow sets the "keyEscrow" edge to the KeyEscrow entity.
+func (_u *SmimeCertificateUpdateOne) SetKeyEscrow(v *KeyEscrow) *SmimeCertificateUpdateOne {
+	return _u.SetKeyEscrowID(v.ID)
+}
+
 // Mutation returns the SmimeCertificateMutation object of the builder.
 func (_u *SmimeCertificateUpdateOne) Mutation() *SmimeCertificateMutation {
 	return _u.mutation
 }
 
+// ClearKeyEscrow clears the "keyEscrow" edge to the KeyEscrow entity.
+func (_u *SmimeCertificateUpdateOne) ClearKeyEscrow() *SmimeCertificateUpdateOne {
+	_u.mutation.ClearKeyEscrow()
+	return _u
+}
+
 // Where appends a list predicates to the SmimeCertificateUpdate builder.
 func (_u *SmimeCertificateUpdateOne) Where(ps ...predicate.SmimeCertificate) *SmimeCertificateUpdateOne {
 	_u.mutation.Where(ps...)
@@ -616,6 +834,53 @@ func (_u *SmimeCertificateUpdateOne) sqlSave(ctx context.Context) (_node *SmimeC
 	if _u.mutation.CaCleared() {
 		_spec.ClearField(smimecertificate.FieldCa, field.TypeString)
 	}
+	if value, ok := _u.mutation.Organization(); ok {
+		_spec.SetField(smimecertificate.FieldOrganization, field.TypeString, value)
+	}
+	if _u.mutation.OrganizationCleared() {
+		_spec.ClearField(smimecertificate.FieldOrganization, field.TypeString)
+	}
+	if value, ok := _u.mutation.Certificate(); ok {
+		_spec.SetField(smimecertificate.FieldCertificate, field.TypeString, value)
+	}
+	if _u.mutation.CertificateCleared() {
+		_spec.ClearField(smimecertificate.FieldCertificate, field.TypeString)
+	}
+	if value, ok := _u.mutation.Owner(); ok {
+		_spec.SetField(smimecertificate.FieldOwner, field.TypeString, value)
+	}
+	if _u.mutation.OwnerCleared() {
+		_spec.ClearField(smimecertificate.FieldOwner, field.TypeString)
+	}
+	if _u.mutation.KeyEscrowCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   smimecertificate.KeyEscrowTable,
+			Columns: []string{smimecertificate.KeyEscrowColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(keyescrow.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.KeyEscrowIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   smimecertificate.KeyEscrowTable,
+			Columns: []string{smimecertificate.KeyEscrowColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(keyescrow.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &SmimeCertificate{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,713 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hm-edu/pki-service/ent/termsacceptance"
+)
+
+// TermsAcceptanceCreate is the builder for creating a TermsAcceptance entity.
+type TermsAcceptanceCreate struct {
+	config
+	mutation *TermsAcceptanceMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *TermsAcceptanceCreate) SetCreateTime(v time.Time) *TermsAcceptanceCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *TermsAcceptanceCreate) SetNillableCreateTime(v *time.Time) *TermsAcceptanceCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *TermsAcceptanceCreate) SetUpdateTime(v time.Time) *TermsAcceptanceCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *TermsAcceptanceCreate) SetNillableUpdateTime(v *time.Time) *TermsAcceptanceCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetUser sets the "user" field.
+func (_c *TermsAcceptanceCreate) SetUser(v string) *TermsAcceptanceCreate {
+	_c.mutation.SetUser(v)
+	return _c
+}
+
+// SetVersion sets the "version" field.
+func (_c *TermsAcceptanceCreate) SetVersion(v string) *TermsAcceptanceCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetSource sets the "source" field.
+func (_c *TermsAcceptanceCreate) SetSource(v string) *TermsAcceptanceCreate {
+	_c.mutation.SetSource(v)
+	return _c
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_c *TermsAcceptanceCreate) SetNillableSource(v *string) *TermsAcceptanceCreate {
+	if v != nil {
+		_c.SetSource(*v)
+	}
+	return _c
+}
+
+// Mutation returns the TermsAcceptanceMutation object of the builder.
+func (_c *TermsAcceptanceCreate) Mutation() *TermsAcceptanceMutation {
+	return _c.mutation
+}
+
+// Save creates the TermsAcceptance in the database.
+func (_c *TermsAcceptanceCreate) Save(ctx context.Context) (*TermsAcceptance, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TermsAcceptanceCreate) SaveX(ctx context.Context) *TermsAcceptance {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TermsAcceptanceCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TermsAcceptanceCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TermsAcceptanceCreate) defaults() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		if termsacceptance.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized termsacceptance.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
+		v := termsacceptance.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		if termsacceptance.DefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized termsacceptance.DefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := termsacceptance.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TermsAcceptanceCreate) check() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "TermsAcceptance.create_time"`)}
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "TermsAcceptance.update_time"`)}
+	}
+	if _, ok := _c.mutation.User(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required field "TermsAcceptance.user"`)}
+	}
+	if v, ok := _c.mutation.User(); ok {
+		if err := termsacceptance.UserValidator(v); err != nil {
+			return &ValidationError{Name: "user", err: fmt.Errorf(`ent: validator failed for field "TermsAcceptance.user": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "TermsAcceptance.version"`)}
+	}
+	if v, ok := _c.mutation.Version(); ok {
+		if err := termsacceptance.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "TermsAcceptance.version": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *TermsAcceptanceCreate) sqlSave(ctx context.Context) (*TermsAcceptance, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TermsAcceptanceCreate) createSpec() (*TermsAcceptance, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TermsAcceptance{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(termsacceptance.Table, sqlgraph.NewFieldSpec(termsacceptance.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(termsacceptance.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(termsacceptance.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.User(); ok {
+		_spec.SetField(termsacceptance.FieldUser, field.TypeString, value)
+		_node.User = value
+	}
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(termsacceptance.FieldVersion, field.TypeString, value)
+		_node.Version = value
+	}
+	if value, ok := _c.mutation.Source(); ok {
+		_spec.SetField(termsacceptance.FieldSource, field.TypeString, value)
+		_node.Source = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TermsAcceptance.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TermsAcceptanceUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TermsAcceptanceCreate) OnConflict(opts ...sql.ConflictOption) *TermsAcceptanceUpsertOne {
+	_c.conflict = opts
+	return &TermsAcceptanceUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TermsAcceptance.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TermsAcceptanceCreate) OnConflictColumns(columns ...string) *TermsAcceptanceUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TermsAcceptanceUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// TermsAcceptanceUpsertOne is the builder for "upsert"-ing
+	//  one TermsAcceptance node.
+	TermsAcceptanceUpsertOne struct {
+		create *TermsAcceptanceCreate
+	}
+
+	// TermsAcceptanceUpsert is the "OnConflict" setter.
+	TermsAcceptanceUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *TermsAcceptanceUpsert) SetUpdateTime(v time.Time) *TermsAcceptanceUpsert {
+	u.Set(termsacceptance.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *TermsAcceptanceUpsert) UpdateUpdateTime() *TermsAcceptanceUpsert {
+	u.SetExcluded(termsacceptance.FieldUpdateTime)
+	return u
+}
+
+// SetUser sets the "user" field.
+func (u *TermsAcceptanceUpsert) SetUser(v string) *TermsAcceptanceUpsert {
+	u.Set(termsacceptance.FieldUser, v)
+	return u
+}
+
+// UpdateUser sets the "user" field to the value that was provided on create.
+func (u *TermsAcceptanceUpsert) UpdateUser() *TermsAcceptanceUpsert {
+	u.SetExcluded(termsacceptance.FieldUser)
+	return u
+}
+
+// SetVersion sets the "version" field.
+func (u *TermsAcceptanceUpsert) SetVersion(v string) *TermsAcceptanceUpsert {
+	u.Set(termsacceptance.FieldVersion, v)
+	return u
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *TermsAcceptanceUpsert) UpdateVersion() *TermsAcceptanceUpsert {
+	u.SetExcluded(termsacceptance.FieldVersion)
+	return u
+}
+
+// SetSource sets the "source" field.
+func (u *TermsAcceptanceUpsert) SetSource(v string) *TermsAcceptanceUpsert {
+	u.Set(termsacceptance.FieldSource, v)
+	return u
+}
+
+// UpdateSource sets the "source" field to the value that was provided on create.
+func (u *TermsAcceptanceUpsert) UpdateSource() *TermsAcceptanceUpsert {
+	u.SetExcluded(termsacceptance.FieldSource)
+	return u
+}
+
+// ClearSource clears the value of the "source" field.
+func (u *TermsAcceptanceUpsert) ClearSource() *TermsAcceptanceUpsert {
+	u.SetNull(termsacceptance.FieldSource)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.TermsAcceptance.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TermsAcceptanceUpsertOne) UpdateNewValues() *TermsAcceptanceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(termsacceptance.FieldCreateTime)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TermsAcceptance.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TermsAcceptanceUpsertOne) Ignore() *TermsAcceptanceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TermsAcceptanceUpsertOne) DoNothing() *TermsAcceptanceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TermsAcceptanceCreate.OnConflict
+// documentation for more info.
+func (u *TermsAcceptanceUpsertOne) Update(set func(*TermsAcceptanceUpsert)) *TermsAcceptanceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TermsAcceptanceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *TermsAcceptanceUpsertOne) SetUpdateTime(v time.Time) *TermsAcceptanceUpsertOne {
+	return u.Update(func(s *TermsAcceptanceUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *TermsAcceptanceUpsertOne) UpdateUpdateTime() *TermsAcceptanceUpsertOne {
+	return u.Update(func(s *TermsAcceptanceUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetUser sets the "user" field.
+func (u *TermsAcceptanceUpsertOne) SetUser(v string) *TermsAcceptanceUpsertOne {
+	return u.Update(func(s *TermsAcceptanceUpsert) {
+		s.SetUser(v)
+	})
+}
+
+// UpdateUser sets the "user" field to the value that was provided on create.
+func (u *TermsAcceptanceUpsertOne) UpdateUser() *TermsAcceptanceUpsertOne {
+	return u.Update(func(s *TermsAcceptanceUpsert) {
+		s.UpdateUser()
+	})
+}
+
+// SetVersion sets the "version" field.
+func (u *TermsAcceptanceUpsertOne) SetVersion(v string) *TermsAcceptanceUpsertOne {
+	return u.Update(func(s *TermsAcceptanceUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *TermsAcceptanceUpsertOne) UpdateVersion() *TermsAcceptanceUpsertOne {
+	return u.Update(func(s *TermsAcceptanceUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// SetSource sets the "source" field.
+func (u *TermsAcceptanceUpsertOne) SetSource(v string) *TermsAcceptanceUpsertOne {
+	return u.Update(func(s *TermsAcceptanceUpsert) {
+		s.SetSource(v)
+	})
+}
+
+// UpdateSource sets the "source" field to the value that was provided on create.
+func (u *TermsAcceptanceUpsertOne) UpdateSource() *TermsAcceptanceUpsertOne {
+	return u.Update(func(s *TermsAcceptanceUpsert) {
+		s.UpdateSource()
+	})
+}
+
+// ClearSource clears the value of the "source" field.
+func (u *TermsAcceptanceUpsertOne) ClearSource() *TermsAcceptanceUpsertOne {
+	return u.Update(func(s *TermsAcceptanceUpsert) {
+		s.ClearSource()
+	})
+}
+
+// Exec executes the query.
+func (u *TermsAcceptanceUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TermsAcceptanceCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TermsAcceptanceUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TermsAcceptanceUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TermsAcceptanceUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TermsAcceptanceCreateBulk is the builder for creating many TermsAcceptance entities in bulk.
+type TermsAcceptanceCreateBulk struct {
+	config
+	err      error
+	builders []*TermsAcceptanceCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TermsAcceptance entities in the database.
+func (_c *TermsAcceptanceCreateBulk) Save(ctx context.Context) ([]*TermsAcceptance, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TermsAcceptance, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TermsAcceptanceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TermsAcceptanceCreateBulk) SaveX(ctx context.Context) []*TermsAcceptance {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TermsAcceptanceCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TermsAcceptanceCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TermsAcceptance.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TermsAcceptanceUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TermsAcceptanceCreateBulk) OnConflict(opts ...sql.ConflictOption) *TermsAcceptanceUpsertBulk {
+	_c.conflict = opts
+	return &TermsAcceptanceUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TermsAcceptance.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TermsAcceptanceCreateBulk) OnConflictColumns(columns ...string) *TermsAcceptanceUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TermsAcceptanceUpsertBulk{
+		create: _c,
+	}
+}
+
+// TermsAcceptanceUpsertBulk is the builder for "upsert"-ing
+// a bulk of TermsAcceptance nodes.
+type TermsAcceptanceUpsertBulk struct {
+	create *TermsAcceptanceCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TermsAcceptance.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TermsAcceptanceUpsertBulk) UpdateNewValues() *TermsAcceptanceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(termsacceptance.FieldCreateTime)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TermsAcceptance.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TermsAcceptanceUpsertBulk) Ignore() *TermsAcceptanceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TermsAcceptanceUpsertBulk) DoNothing() *TermsAcceptanceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TermsAcceptanceCreateBulk.OnConflict
+// documentation for more info.
+func (u *TermsAcceptanceUpsertBulk) Update(set func(*TermsAcceptanceUpsert)) *TermsAcceptanceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TermsAcceptanceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *TermsAcceptanceUpsertBulk) SetUpdateTime(v time.Time) *TermsAcceptanceUpsertBulk {
+	return u.Update(func(s *TermsAcceptanceUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *TermsAcceptanceUpsertBulk) UpdateUpdateTime() *TermsAcceptanceUpsertBulk {
+	return u.Update(func(s *TermsAcceptanceUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetUser sets the "user" field.
+func (u *TermsAcceptanceUpsertBulk) SetUser(v string) *TermsAcceptanceUpsertBulk {
+	return u.Update(func(s *TermsAcceptanceUpsert) {
+		s.SetUser(v)
+	})
+}
+
+// UpdateUser sets the "user" field to the value that was provided on create.
+func (u *TermsAcceptanceUpsertBulk) UpdateUser() *TermsAcceptanceUpsertBulk {
+	return u.Update(func(s *TermsAcceptanceUpsert) {
+		s.UpdateUser()
+	})
+}
+
+// SetVersion sets the "version" field.
+func (u *TermsAcceptanceUpsertBulk) SetVersion(v string) *TermsAcceptanceUpsertBulk {
+	return u.Update(func(s *TermsAcceptanceUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *TermsAcceptanceUpsertBulk) UpdateVersion() *TermsAcceptanceUpsertBulk {
+	return u.Update(func(s *TermsAcceptanceUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// SetSource sets the "source" field.
+func (u *TermsAcceptanceUpsertBulk) SetSource(v string) *TermsAcceptanceUpsertBulk {
+	return u.Update(func(s *TermsAcceptanceUpsert) {
+		s.SetSource(v)
+	})
+}
+
+// UpdateSource sets the "source" field to the value that was provided on create.
+func (u *TermsAcceptanceUpsertBulk) UpdateSource() *TermsAcceptanceUpsertBulk {
+	return u.Update(func(s *TermsAcceptanceUpsert) {
+		s.UpdateSource()
+	})
+}
+
+// ClearSource clears the value of the "source" field.
+func (u *TermsAcceptanceUpsertBulk) ClearSource() *TermsAcceptanceUpsertBulk {
+	return u.Update(func(s *TermsAcceptanceUpsert) {
+		s.ClearSource()
+	})
+}
+
+// Exec executes the query.
+func (u *TermsAcceptanceUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TermsAcceptanceCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TermsAcceptanceCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TermsAcceptanceUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hm-edu/pki-service/ent/predicate"
+	"github.com/hm-edu/pki-service/ent/termsacceptance"
+)
+
+// TermsAcceptanceDelete is the builder for deleting a TermsAcceptance entity.
+type TermsAcceptanceDelete struct {
+	config
+	hooks    []Hook
+	mutation *TermsAcceptanceMutation
+}
+
+// Where appends a list predicates to the TermsAcceptanceDelete builder.
+func (_d *TermsAcceptanceDelete) Where(ps ...predicate.TermsAcceptance) *TermsAcceptanceDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *TermsAcceptanceDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *TermsAcceptanceDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *TermsAcceptanceDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(termsacceptance.Table, sqlgraph.NewFieldSpec(termsacceptance.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// TermsAcceptanceDeleteOne is the builder for deleting a single TermsAcceptance entity.
+type TermsAcceptanceDeleteOne struct {
+	_d *TermsAcceptanceDelete
+}
+
+// Where appends a list predicates to the TermsAcceptanceDelete builder.
+func (_d *TermsAcceptanceDeleteOne) Where(ps ...predicate.TermsAcceptance) *TermsAcceptanceDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *TermsAcceptanceDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{termsacceptance.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *TermsAcceptanceDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,380 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hm-edu/pki-service/ent/predicate"
+	"github.com/hm-edu/pki-service/ent/termsacceptance"
+)
+
+// TermsAcceptanceUpdate is the builder for updating TermsAcceptance entities.
+type TermsAcceptanceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TermsAcceptanceMutation
+}
+
+// Where appends a list predicates to the TermsAcceptanceUpdate builder.
+func (_u *TermsAcceptanceUpdate) Where(ps ...predicate.TermsAcceptance) *TermsAcceptanceUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *TermsAcceptanceUpdate) SetUpdateTime(v time.Time) *TermsAcceptanceUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetUser sets the "user" field.
+func (_u *TermsAcceptanceUpdate) SetUser(v string) *TermsAcceptanceUpdate {
+	_u.mutation.SetUser(v)
+	return _u
+}
+
+// SetNillableUser sets the "user" field if the given value is not nil.
+func (_u *TermsAcceptanceUpdate) SetNillableUser(v *string) *TermsAcceptanceUpdate {
+	if v != nil {
+		_u.SetUser(*v)
+	}
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *TermsAcceptanceUpdate) SetVersion(v string) *TermsAcceptanceUpdate {
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *TermsAcceptanceUpdate) SetNillableVersion(v *string) *TermsAcceptanceUpdate {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *TermsAcceptanceUpdate) SetSource(v string) *TermsAcceptanceUpdate {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *TermsAcceptanceUpdate) SetNillableSource(v *string) *TermsAcceptanceUpdate {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// ClearSource clears the value of the "source" field.
+func (_u *TermsAcceptanceUpdate) ClearSource() *TermsAcceptanceUpdate {
+	_u.mutation.ClearSource()
+	return _u
+}
+
+// Mutation returns the TermsAcceptanceMutation object of the builder.
+func (_u *TermsAcceptanceUpdate) Mutation() *TermsAcceptanceMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TermsAcceptanceUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TermsAcceptanceUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TermsAcceptanceUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TermsAcceptanceUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TermsAcceptanceUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if termsacceptance.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized termsacceptance.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := termsacceptance.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TermsAcceptanceUpdate) check() error {
+	if v, ok := _u.mutation.User(); ok {
+		if err := termsacceptance.UserValidator(v); err != nil {
+			return &ValidationError{Name: "user", err: fmt.Errorf(`ent: validator failed for field "TermsAcceptance.user": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Version(); ok {
+		if err := termsacceptance.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "TermsAcceptance.version": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *TermsAcceptanceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(termsacceptance.Table, termsacceptance.Columns, sqlgraph.NewFieldSpec(termsacceptance.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(termsacceptance.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.User(); ok {
+		_spec.SetField(termsacceptance.FieldUser, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(termsacceptance.FieldVersion, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(termsacceptance.FieldSource, field.TypeString, value)
+	}
+	if _u.mutation.SourceCleared() {
+		_spec.ClearField(termsacceptance.FieldSource, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{termsacceptance.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TermsAcceptanceUpdateOne is the builder for updating a single TermsAcceptance entity.
+type TermsAcceptanceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TermsAcceptanceMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *TermsAcceptanceUpdateOne) SetUpdateTime(v time.Time) *TermsAcceptanceUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetUser sets the "user" field.
+func (_u *TermsAcceptanceUpdateOne) SetUser(v string) *TermsAcceptanceUpdateOne {
+	_u.mutation.SetUser(v)
+	return _u
+}
+
+// SetNillableUser sets the "user" field if the given value is not nil.
+func (_u *TermsAcceptanceUpdateOne) SetNillableUser(v *string) *TermsAcceptanceUpdateOne {
+	if v != nil {
+		_u.SetUser(*v)
+	}
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *TermsAcceptanceUpdateOne) SetVersion(v string) *TermsAcceptanceUpdateOne {
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *TermsAcceptanceUpdateOne) SetNillableVersion(v *string) *TermsAcceptanceUpdateOne {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *TermsAcceptanceUpdateOne) SetSource(v string) *TermsAcceptanceUpdateOne {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *TermsAcceptanceUpdateOne) SetNillableSource(v *string) *TermsAcceptanceUpdateOne {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// ClearSource clears the value of the "source" field.
+func (_u *TermsAcceptanceUpdateOne) ClearSource() *TermsAcceptanceUpdateOne {
+	_u.mutation.ClearSource()
+	return _u
+}
+
+// Mutation returns the TermsAcceptanceMutation object of the builder.
+func (_u *TermsAcceptanceUpdateOne) Mutation() *TermsAcceptanceMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the TermsAcceptanceUpdate builder.
+func (_u *TermsAcceptanceUpdateOne) Where(ps ...predicate.TermsAcceptance) *TermsAcceptanceUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TermsAcceptanceUpdateOne) Select(field string, fields ...string) *TermsAcceptanceUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TermsAcceptance entity.
+func (_u *TermsAcceptanceUpdateOne) Save(ctx context.Context) (*TermsAcceptance, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TermsAcceptanceUpdateOne) SaveX(ctx context.Context) *TermsAcceptance {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TermsAcceptanceUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TermsAcceptanceUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TermsAcceptanceUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if termsacceptance.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized termsacceptance.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := termsacceptance.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TermsAcceptanceUpdateOne) check() error {
+	if v, ok := _u.mutation.User(); ok {
+		if err := termsacceptance.UserValidator(v); err != nil {
+			return &ValidationError{Name: "user", err: fmt.Errorf(`ent: validator failed for field "TermsAcceptance.user": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Version(); ok {
+		if err := termsacceptance.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "TermsAcceptance.version": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *TermsAcceptanceUpdateOne) sqlSave(ctx context.Context) (_node *TermsAcceptance, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(termsacceptance.Table, termsacceptance.Columns, sqlgraph.NewFieldSpec(termsacceptance.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TermsAcceptance.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, termsacceptance.FieldID)
+		for _, f := range fields {
+			if !termsacceptance.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != termsacceptance.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(termsacceptance.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.User(); ok {
+		_spec.SetField(termsacceptance.FieldUser, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(termsacceptance.FieldVersion, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(termsacceptance.FieldSource, field.TypeString, value)
+	}
+	if _u.mutation.SourceCleared() {
+		_spec.ClearField(termsacceptance.FieldSource, field.TypeString)
+	}
+	_node = &TermsAcceptance{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{termsacceptance.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
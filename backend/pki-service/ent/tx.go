@@ -12,12 +12,28 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// AcmeOrder is the client for interacting with the AcmeOrder builders.
+	AcmeOrder *AcmeOrderClient
 	// Certificate is the client for interacting with the Certificate builders.
 	Certificate *CertificateClient
 	// Domain is the client for interacting with the Domain builders.
 	Domain *DomainClient
+	// EmailVerification is the client for interacting with the EmailVerification builders.
+	EmailVerification *EmailVerificationClient
+	// EscrowAudit is the client for interacting with the EscrowAudit builders.
+	EscrowAudit *EscrowAuditClient
+	// KeyEscrow is the client for interacting with the KeyEscrow builders.
+	KeyEscrow *KeyEscrowClient
+	// KeyRecovery is the client for interacting with the KeyRecovery builders.
+	KeyRecovery *KeyRecoveryClient
+	// NotificationPreference is the client for interacting with the NotificationPreference builders.
+	NotificationPreference *NotificationPreferenceClient
+	// SentNotification is the client for interacting with the SentNotification builders.
+	SentNotification *SentNotificationClient
 	// SmimeCertificate is the client for interacting with the SmimeCertificate builders.
 	SmimeCertificate *SmimeCertificateClient
+	// TermsAcceptance is the client for interacting with the TermsAcceptance builders.
+	TermsAcceptance *TermsAcceptanceClient
 
 	// lazily loaded.
 	client     *Client
@@ -149,9 +165,17 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.AcmeOrder = NewAcmeOrderClient(tx.config)
 	tx.Certificate = NewCertificateClient(tx.config)
 	tx.Domain = NewDomainClient(tx.config)
+	tx.EmailVerification = NewEmailVerificationClient(tx.config)
+	tx.EscrowAudit = NewEscrowAuditClient(tx.config)
+	tx.KeyEscrow = NewKeyEscrowClient(tx.config)
+	tx.KeyRecovery = NewKeyRecoveryClient(tx.config)
+	tx.NotificationPreference = NewNotificationPreferenceClient(tx.config)
+	tx.SentNotification = NewSentNotificationClient(tx.config)
 	tx.SmimeCertificate = NewSmimeCertificateClient(tx.config)
+	tx.TermsAcceptance = NewTermsAcceptanceClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -161,7 +185,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Certificate.QueryXXX(), the query will be executed
+// applies a query, for example: AcmeOrder.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -11,15 +11,18 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"net"
 	"os"
 
 	legoacme "github.com/go-acme/lego/v5/acme"
 	"github.com/go-acme/lego/v5/certcrypto"
 	"github.com/go-acme/lego/v5/certificate"
 	"github.com/go-acme/lego/v5/challenge/dns01"
+	"github.com/go-acme/lego/v5/challenge/http01"
 	"github.com/go-acme/lego/v5/lego"
 	legolog "github.com/go-acme/lego/v5/log"
 	"github.com/go-acme/lego/v5/registration"
+	"github.com/hm-edu/portal-common/helper"
 	"go.uber.org/zap"
 )
 
@@ -34,11 +37,19 @@ func (a *account) GetEmail() string                           { return a.email }
 func (a *account) GetRegistration() *legoacme.ExtendedAccount { return a.registration }
 func (a *account) GetPrivateKey() crypto.Signer               { return a.key }
 
+// ipProfile is the ACME profile requested for certificates containing IP
+// addresses. Let's Encrypt only issues short-lived certificates for them.
+const ipProfile = "shortlived"
+
 // Client wraps a lego ACME client that validates domains using DNS-01
-// challenges published via RFC2136/TSIG. The ACME session (account key and
+// challenges published via RFC2136/TSIG and (optionally) a second lego
+// client that validates IP addresses using HTTP-01 challenges. lego prefers
+// HTTP-01 over DNS-01 if both are offered, so the HTTP-01 provider must not
+// be registered for domain orders. The ACME session (account key and
 // registration) is created once and reused for all requests.
 type Client struct {
 	lego   *lego.Client
+	ip     *lego.Client
 	dns    *DNSConfig
 	logger *zap.Logger
 }
@@ -73,7 +84,6 @@ func NewClient(ctx context.Context, email, directory, keyPath string, dnsCfg *DN
 	if err := client.Challenge.SetDNS01Provider(NewDNSProvider(dnsCfg, logger), dns01.DisableAuthoritativeNssPropagationRequirement()); err != nil {
 		return nil, fmt.Errorf("setting DNS-01 provider: %w", err)
 	}
-
 	reg, err := client.Registration.ResolveAccountByKey(ctx)
 	if err != nil {
 		if !created {
@@ -87,28 +97,74 @@ func NewClient(ctx context.Context, email, directory, keyPath string, dnsCfg *DN
 	acc.registration = reg
 	logger.Info("ACME account ready", zap.String("email", email), zap.String("directory", directory))
 
-	return &Client{lego: client, dns: dnsCfg, logger: logger}, nil
+	c := &Client{lego: client, dns: dnsCfg, logger: logger}
+	if v := dnsCfg.IPValidation; v != nil {
+		// The client is created after the registration so it uses the
+		// resolved account.
+		c.ip, err = lego.NewClient(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("creating ACME client for IP addresses: %w", err)
+		}
+		host, port, _ := net.SplitHostPort(v.Listen)
+		if err := c.ip.Challenge.SetHTTP01Provider(http01.NewProviderServer(host, port)); err != nil {
+			return nil, fmt.Errorf("setting HTTP-01 provider: %w", err)
+		}
+	}
+	return c, nil
 }
 
 // Covers reports whether all given domains can be validated with the
-// configured DNS zones.
+// configured DNS zones and IP networks.
 func (c *Client) Covers(domains []string) bool {
 	return c.dns.Covers(domains)
 }
 
 // ObtainForCSR requests a certificate for the given CSR. The returned bytes
-// contain the full PEM encoded chain (leaf first).
+// contain the full PEM encoded chain (leaf first). Certificates for IP
+// addresses are requested by the HTTP-01 client using the short-lived
+// profile. If the order is rejected because of a rate limit, a
+// *RateLimitError is returned.
 func (c *Client) ObtainForCSR(ctx context.Context, csr *x509.CertificateRequest) ([]byte, error) {
-	res, err := c.lego.Certificate.ObtainForCSR(ctx, certificate.ObtainForCSRRequest{
+	request := certificate.ObtainForCSRRequest{
 		CSR:    csr,
 		Bundle: true,
-	})
+	}
+	client := c.lego
+	if containsIP(csr) {
+		if c.ip == nil {
+			return nil, errors.New("IP addresses are not enabled for ACME")
+		}
+		if len(csr.DNSNames) > 0 {
+			return nil, errors.New("IP addresses and domain names cannot be combined in one ACME order")
+		}
+		client = c.ip
+		request.Profile = ipProfile
+	}
+	res, err := client.Certificate.ObtainForCSR(ctx, request)
 	if err != nil {
+		var limited *legoacme.RateLimitedError
+		if errors.As(err, &limited) {
+			retry := limited.RetryAfter
+			if retry <= 0 {
+				retry = defaultRetryAfter
+			}
+			detail := ""
+			if limited.ProblemDetails != nil {
+				detail = limited.Detail
+			}
+			return nil, &RateLimitError{Detail: detail, RetryAfter: retry, err: err}
+		}
 		return nil, err
 	}
 	return res.Certificate, nil
 }
 
+// containsIP reports whether the CSR requests a certificate for an IP
+// address.
+func containsIP(csr *x509.CertificateRequest) bool {
+	return len(csr.IPAddresses) > 0 || helper.IsIP(csr.Subject.CommonName)
+}
+
 // Revoke revokes the given PEM encoded certificate. Revoking an already
 // revoked certificate is not treated as an error.
 func (c *Client) Revoke(ctx context.Context, certPEM []byte) error {
@@ -1,14 +1,19 @@
 // Package acme provides certificate issuance using an ACME CA (e.g.
 // Let's Encrypt). Domain validation is performed using DNS-01 challenges
 // that are published via RFC2136 dynamic updates signed with per-zone
-// TSIG keys.
+// TSIG keys. IP addresses are validated using HTTP-01 challenges served by
+// a built-in challenge server.
 package acme
 
 import (
 	"fmt"
+	"net"
+	"net/netip"
 	"os"
 	"strings"
 
+	"github.com/hm-edu/portal-common/helper"
+
 	"github.com/miekg/dns"
 	"gopkg.in/yaml.v3"
 )
@@ -43,6 +48,23 @@ type Zone struct {
 // challenges.
 type DNSConfig struct {
 	Zones []Zone `yaml:"zones"`
+	// IPValidation enables the issuance of certificates for IP addresses.
+	IPValidation *IPValidation `yaml:"ip_validation"`
+}
+
+// IPValidation describes how IP addresses are validated. IP addresses cannot
+// be validated using DNS-01, so a HTTP-01 challenge server is started and
+// requests to /.well-known/acme-challenge/ on the permitted addresses must be
+// forwarded to it.
+type IPValidation struct {
+	// Listen is the address the HTTP-01 challenge server listens on
+	// (host:port, defaults to :80).
+	Listen string `yaml:"listen"`
+	// Networks are the networks (CIDR notation) whose addresses can be
+	// validated using the challenge server.
+	Networks []string `yaml:"networks"`
+
+	prefixes []netip.Prefix
 }
 
 // LoadDNSConfig reads and validates the DNS validation configuration file.
@@ -55,9 +77,27 @@ func LoadDNSConfig(path string) (*DNSConfig, error) {
 	if err := yaml.Unmarshal(data, &cfg); err != nil {
 		return nil, fmt.Errorf("parsing DNS config %s: %w", path, err)
 	}
-	if len(cfg.Zones) == 0 {
+	if len(cfg.Zones) == 0 && cfg.IPValidation == nil {
 		return nil, fmt.Errorf("DNS config %s contains no zones", path)
 	}
+	if v := cfg.IPValidation; v != nil {
+		if v.Listen == "" {
+			v.Listen = ":80"
+		}
+		if _, _, err := net.SplitHostPort(v.Listen); err != nil {
+			return nil, fmt.Errorf("DNS config %s: invalid ip_validation listen address %q: %w", path, v.Listen, err)
+		}
+		if len(v.Networks) == 0 {
+			return nil, fmt.Errorf("DNS config %s: ip_validation contains no networks", path)
+		}
+		for _, n := range v.Networks {
+			prefix, err := netip.ParsePrefix(n)
+			if err != nil {
+				return nil, fmt.Errorf("DNS config %s: invalid ip_validation network %q: %w", path, n, err)
+			}
+			v.prefixes = append(v.prefixes, prefix.Masked())
+		}
+	}
 	for i := range cfg.Zones {
 		zone := &cfg.Zones[i]
 		if zone.Zone == "" {
@@ -108,13 +148,37 @@ func (c *DNSConfig) ZoneFor(domain string) *Zone {
 	return best
 }
 
-// Covers reports whether all given domains can be validated with the
-// configured zones.
+// CoversIP reports whether the given IP address can be validated using the
+// HTTP-01 challenge server.
+func (c *DNSConfig) CoversIP(ip netip.Addr) bool {
+	if c.IPValidation == nil {
+		return false
+	}
+	for _, prefix := range c.IPValidation.prefixes {
+		if prefix.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// Covers reports whether all given domains (or IP addresses) can be
+// validated with the configured zones and networks. IP addresses are
+// validated by a separate ACME client, so they cannot be combined with
+// domains.
 func (c *DNSConfig) Covers(domains []string) bool {
+	ips := 0
 	for _, domain := range domains {
+		if ip, ok := helper.ParseIP(domain); ok {
+			if !c.CoversIP(ip) {
+				return false
+			}
+			ips++
+			continue
+		}
 		if c.ZoneFor(domain) == nil {
 			return false
 		}
 	}
-	return true
+	return ips == 0 || ips == len(domains)
 }
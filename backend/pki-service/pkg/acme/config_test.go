@@ -1,6 +1,7 @@
 package acme
 
 import (
+	"net/netip"
 	"os"
 	"path/filepath"
 	"testing"
@@ -116,4 +117,48 @@ func TestCovers(t *testing.T) {
 	if cfg.Covers([]string{"www.hm.edu", "example.com"}) {
 		t.Error("expected domains not to be covered")
 	}
+
+	cfg.IPValidation = &IPValidation{prefixes: []netip.Prefix{netip.MustParsePrefix("129.187.0.0/16")}}
+	if !cfg.Covers([]string{"129.187.1.2"}) {
+		t.Error("expected address to be covered")
+	}
+	if cfg.Covers([]string{"www.hm.edu", "129.187.1.2"}) {
+		t.Error("expected domains and addresses not to be covered by one order")
+	}
+}
+
+func TestLoadDNSConfigIPValidation(t *testing.T) {
+	cfg, err := LoadDNSConfig(writeConfig(t, `
+ip_validation:
+  networks:
+    - 129.187.0.0/16
+    - 2001:db8::/32
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.IPValidation.Listen != ":80" {
+		t.Errorf("expected default listen address, got %s", cfg.IPValidation.Listen)
+	}
+	if !cfg.Covers([]string{"129.187.1.2", "2001:DB8:0:0::1", "[2001:db8::2]"}) {
+		t.Error("expected addresses to be covered")
+	}
+	if cfg.Covers([]string{"10.0.0.1"}) {
+		t.Error("expected address not to be covered")
+	}
+	if cfg.Covers([]string{"www.hm.edu"}) {
+		t.Error("expected domain not to be covered without zones")
+	}
+
+	for name, content := range map[string]string{
+		"no networks": "ip_validation:\n  listen: \":8089\"",
+		"bad network": "ip_validation:\n  networks: [foo]",
+		"bad listen":  "ip_validation:\n  listen: foo\n  networks: [10.0.0.0/8]",
+	} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := LoadDNSConfig(writeConfig(t, content)); err == nil {
+				t.Error("expected error, got nil")
+			}
+		})
+	}
 }
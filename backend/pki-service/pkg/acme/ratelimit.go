@@ -0,0 +1,179 @@
+package acme
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hm-edu/portal-common/helper"
+	"golang.org/x/net/publicsuffix"
+)
+
+// defaultRetryAfter is used if the ACME CA rejects an order because of a rate
+// limit without telling us when to retry.
+const defaultRetryAfter = time.Hour
+
+// RateLimitError is returned if the ACME CA rejected an order because one of
+// its rate limits (e.g. duplicate certificates or certificates per registered
+// domain) was exceeded.
+type RateLimitError struct {
+	// Detail is the human readable detail of the problem document.
+	Detail string
+	// RetryAfter is the time to wait before placing the order again.
+	RetryAfter time.Duration
+
+	err error
+}
+
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("rate limited by ACME CA (retry after %s): %s", e.RetryAfter, e.Detail)
+}
+
+func (e *RateLimitError) Unwrap() error {
+	return e.err
+}
+
+// RateLimits describes the rate limits of the ACME CA that are predicted
+// before an order is placed. Limits with a value of zero are not checked.
+type RateLimits struct {
+	// Window is the sliding window the limits are applied to.
+	Window time.Duration
+	// CertificatesPerDomain is the maximum number of new certificates per
+	// registered domain within the window.
+	CertificatesPerDomain int
+	// DuplicateCertificates is the maximum number of certificates for the
+	// exact same set of identifiers within the window.
+	DuplicateCertificates int
+}
+
+// Order is a previously placed ACME order used for the prediction.
+type Order struct {
+	Identifiers       string
+	RegisteredDomains []string
+	// Valid reports whether the order resulted in an issued certificate.
+	Valid      bool
+	Created    time.Time
+	RetryAfter *time.Time
+}
+
+// Prediction is the result of RateLimits.Predict if a limit would be hit.
+type Prediction struct {
+	// Reason describes the limit that would be hit.
+	Reason string
+	// Until is the earliest time a new order is expected to succeed.
+	Until time.Time
+}
+
+// IdentifierSet returns the normalized (lower-cased, sorted and
+// deduplicated) set of identifiers as a comma separated string.
+func IdentifierSet(names []string) string {
+	set := make([]string, 0, len(names))
+	for _, name := range names {
+		name = helper.NormalizeFqdn(name)
+		if name != "" && !slices.Contains(set, name) {
+			set = append(set, name)
+		}
+	}
+	slices.Sort(set)
+	return strings.Join(set, ",")
+}
+
+// RegisteredDomain returns the registered domain (eTLD+1) of the given name.
+// Names without a registered domain (e.g. public suffixes) are returned as is.
+// IPv4 addresses are counted individually, IPv6 addresses per /64 prefix.
+func RegisteredDomain(name string) string {
+	if ip, ok := helper.ParseIP(name); ok {
+		if ip.Is6() {
+			prefix, _ := ip.Prefix(64)
+			return prefix.String()
+		}
+		return ip.String()
+	}
+	name = normalizeDomain(name)
+	registered, err := publicsuffix.EffectiveTLDPlusOne(name)
+	if err != nil {
+		return name
+	}
+	return registered
+}
+
+// RegisteredDomains returns the sorted set of registered domains of the given
+// names.
+func RegisteredDomains(names []string) []string {
+	domains := []string{}
+	for _, name := range names {
+		registered := RegisteredDomain(name)
+		if registered != "" && !slices.Contains(domains, registered) {
+			domains = append(domains, registered)
+		}
+	}
+	slices.Sort(domains)
+	return domains
+}
+
+// Predict checks whether a new order for the given names would exceed one of
+// the rate limits given the previously placed orders. Renewals (i.e. an
+// identical set of identifiers was issued before) are exempt from the limit
+// per registered domain. It returns nil if the order is expected to succeed.
+func (l RateLimits) Predict(names []string, orders []Order, renewal bool, now time.Time) *Prediction {
+	set := IdentifierSet(names)
+	domains := RegisteredDomains(names)
+	since := now.Add(-l.Window)
+
+	// The CA explicitly asked us to back off.
+	for _, o := range orders {
+		if o.RetryAfter == nil || !o.RetryAfter.After(now) {
+			continue
+		}
+		if o.Identifiers == set || slices.ContainsFunc(o.RegisteredDomains, func(d string) bool { return slices.Contains(domains, d) }) {
+			return &Prediction{
+				Reason: fmt.Sprintf("ACME CA rate limit active until %s", o.RetryAfter.UTC().Format(time.RFC3339)),
+				Until:  *o.RetryAfter,
+			}
+		}
+	}
+
+	var duplicates []time.Time
+	perDomain := make(map[string][]time.Time)
+	for _, o := range orders {
+		if !o.Valid || o.Created.Before(since) {
+			continue
+		}
+		if o.Identifiers == set {
+			duplicates = append(duplicates, o.Created)
+		}
+		for _, d := range o.RegisteredDomains {
+			perDomain[d] = append(perDomain[d], o.Created)
+		}
+	}
+
+	if until, ok := l.exceeded(duplicates, l.DuplicateCertificates); ok {
+		return &Prediction{
+			Reason: fmt.Sprintf("duplicate certificate limit reached (%d within %s)", len(duplicates), l.Window),
+			Until:  until,
+		}
+	}
+	if renewal {
+		return nil
+	}
+	for _, d := range domains {
+		if until, ok := l.exceeded(perDomain[d], l.CertificatesPerDomain); ok {
+			return &Prediction{
+				Reason: fmt.Sprintf("certificates per registered domain limit reached for %s (%d within %s)", d, len(perDomain[d]), l.Window),
+				Until:  until,
+			}
+		}
+	}
+	return nil
+}
+
+// exceeded reports whether one more certificate would exceed the limit and
+// returns the time the oldest counted certificate leaves the window.
+func (l RateLimits) exceeded(issued []time.Time, limit int) (time.Time, bool) {
+	if limit <= 0 || len(issued) < limit {
+		return time.Time{}, false
+	}
+	slices.SortFunc(issued, func(a, b time.Time) int { return a.Compare(b) })
+	return issued[len(issued)-limit].Add(l.Window), true
+}
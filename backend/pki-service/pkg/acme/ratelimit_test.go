@@ -0,0 +1,76 @@
+package acme
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestIdentifierSet(t *testing.T) {
+	got := IdentifierSet([]string{"WWW.example.com", "example.com.", "www.example.com", ""})
+	if got != "example.com,www.example.com" {
+		t.Errorf("unexpected identifier set %q", got)
+	}
+}
+
+func TestRegisteredDomains(t *testing.T) {
+	got := RegisteredDomains([]string{"a.cs.hm.edu", "*.hm.edu", "www.example.co.uk", "example.co.uk", "129.187.1.2", "2001:db8::1", "2001:db8::2"})
+	want := []string{"129.187.1.2", "2001:db8::/64", "example.co.uk", "hm.edu"}
+	if !slices.Equal(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func TestPredict(t *testing.T) {
+	now := time.Now()
+	limits := RateLimits{Window: 7 * 24 * time.Hour, CertificatesPerDomain: 3, DuplicateCertificates: 2}
+	names := []string{"www.hm.edu", "hm.edu"}
+	set := IdentifierSet(names)
+	valid := func(identifiers string, age time.Duration) Order {
+		return Order{Identifiers: identifiers, RegisteredDomains: []string{"hm.edu"}, Valid: true, Created: now.Add(-age)}
+	}
+
+	if p := limits.Predict(names, nil, false, now); p != nil {
+		t.Errorf("expected no limit without orders, got %v", p.Reason)
+	}
+
+	// Orders outside of the window or not valid are not counted.
+	orders := []Order{
+		valid(set, 8*24*time.Hour),
+		valid(set, 8*24*time.Hour),
+		{Identifiers: set, RegisteredDomains: []string{"hm.edu"}, Created: now.Add(-time.Hour)},
+	}
+	if p := limits.Predict(names, orders, false, now); p != nil {
+		t.Errorf("expected no limit, got %v", p.Reason)
+	}
+
+	// Duplicate certificate limit.
+	orders = []Order{valid(set, 48*time.Hour), valid(set, 24*time.Hour)}
+	p := limits.Predict(names, orders, true, now)
+	if p == nil {
+		t.Fatal("expected duplicate certificate limit")
+	}
+	if want := now.Add(-48 * time.Hour).Add(limits.Window); !p.Until.Equal(want) {
+		t.Errorf("expected limit until %v, got %v", want, p.Until)
+	}
+
+	// Certificates per registered domain limit, renewals are exempt.
+	orders = []Order{valid("a.hm.edu", time.Hour), valid("b.hm.edu", time.Hour), valid("c.hm.edu", time.Hour)}
+	if p := limits.Predict(names, orders, false, now); p == nil {
+		t.Error("expected certificates per domain limit")
+	}
+	if p := limits.Predict(names, orders, true, now); p != nil {
+		t.Errorf("expected renewal to be exempt, got %v", p.Reason)
+	}
+
+	// Explicit back off requested by the CA.
+	retry := now.Add(time.Hour)
+	orders = []Order{{Identifiers: "other.hm.edu", RegisteredDomains: []string{"hm.edu"}, Created: now, RetryAfter: &retry}}
+	p = limits.Predict(names, orders, true, now)
+	if p == nil || !p.Until.Equal(retry) {
+		t.Errorf("expected back off until %v, got %v", retry, p)
+	}
+	if p := limits.Predict([]string{"example.com"}, orders, false, now); p != nil {
+		t.Errorf("expected no limit for unrelated domain, got %v", p.Reason)
+	}
+}
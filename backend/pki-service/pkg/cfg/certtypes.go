@@ -0,0 +1,178 @@
+package cfg
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// CertTypeRule allows certificate types for a set of domains.
+type CertTypeRule struct {
+	// Domains are the domain suffixes the rule applies to. All subdomains are
+	// covered as well; the most specific suffix wins.
+	Domains []string `yaml:"domains"`
+	// Types are the allowed HARICA certificate types (e.g. DV or OV).
+	Types []string `yaml:"types"`
+}
+
+// CertTypeGroupRule allows certificate types to the members of user groups.
+type CertTypeGroupRule struct {
+	// Groups are the names of the user groups (case insensitive).
+	Groups []string `yaml:"groups"`
+	// Types are the allowed HARICA certificate types (e.g. DV or OV).
+	Types []string `yaml:"types"`
+}
+
+// CertTypeConfig is the content of the certificate type allow-list file.
+type CertTypeConfig struct {
+	// Types are the certificate types allowed for domains without a
+	// matching rule. Defaults to the configured cert_type.
+	Types []string `yaml:"types"`
+	// Rules restrict or extend the allowed types for single domains.
+	Rules []CertTypeRule `yaml:"rules"`
+	// Groups extend the allowed types for the members of user groups
+	// independent of the domains.
+	Groups []CertTypeGroupRule `yaml:"groups"`
+}
+
+// LoadCertTypeConfig reads and validates the certificate type allow-list.
+func LoadCertTypeConfig(path string) (*CertTypeConfig, error) {
+	data, err := os.ReadFile(path) // #nosec G304 -- path is provided by the operator
+	if err != nil {
+		return nil, fmt.Errorf("reading certificate type config %s: %w", path, err)
+	}
+	var cfg CertTypeConfig
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("parsing certificate type config %s: %w", path, err)
+	}
+	cfg.Types = normalizeCertTypes(cfg.Types)
+	for i := range cfg.Rules {
+		rule := &cfg.Rules[i]
+		if len(rule.Domains) == 0 {
+			return nil, fmt.Errorf("certificate type config %s: rule %d has no domains", path, i)
+		}
+		if len(rule.Types) == 0 {
+			return nil, fmt.Errorf("certificate type config %s: rule %d has no types", path, i)
+		}
+		for j, d := range rule.Domains {
+			rule.Domains[j] = normalizeSuffix(d)
+		}
+		rule.Types = normalizeCertTypes(rule.Types)
+	}
+	for i := range cfg.Groups {
+		rule := &cfg.Groups[i]
+		if len(rule.Groups) == 0 {
+			return nil, fmt.Errorf("certificate type config %s: group rule %d has no groups", path, i)
+		}
+		if len(rule.Types) == 0 {
+			return nil, fmt.Errorf("certificate type config %s: group rule %d has no types", path, i)
+		}
+		for j, g := range rule.Groups {
+			rule.Groups[j] = strings.ToLower(strings.TrimSpace(g))
+		}
+		rule.Types = normalizeCertTypes(rule.Types)
+	}
+	return &cfg, nil
+}
+
+// NormalizeCertType normalizes the name of a certificate type.
+func NormalizeCertType(certType string) string {
+	return strings.ToUpper(strings.TrimSpace(certType))
+}
+
+func normalizeCertTypes(types []string) []string {
+	result := make([]string, 0, len(types))
+	for _, t := range types {
+		result = append(result, NormalizeCertType(t))
+	}
+	return result
+}
+
+// AllowedTypes returns the certificate types allowed for the given domain.
+// Without a configuration, only the default type is allowed.
+func (c *CertTypeConfig) AllowedTypes(domain, defaultType string) []string {
+	fallback := []string{NormalizeCertType(defaultType)}
+	if c == nil {
+		return fallback
+	}
+	domain = normalizeSuffix(domain)
+	var best *CertTypeRule
+	bestLen := 0
+	for i := range c.Rules {
+		rule := &c.Rules[i]
+		for _, suffix := range rule.Domains {
+			if domain != suffix && !strings.HasSuffix(domain, "."+suffix) {
+				continue
+			}
+			if len(suffix) > bestLen {
+				best, bestLen = rule, len(suffix)
+			}
+		}
+	}
+	if best != nil {
+		return best.Types
+	}
+	if len(c.Types) > 0 {
+		return c.Types
+	}
+	return fallback
+}
+
+// GroupTypes returns the certificate types allowed to members of the given
+// user groups for all domains.
+func (c *CertTypeConfig) GroupTypes(groups []string) []string {
+	if c == nil {
+		return nil
+	}
+	var types []string
+	for _, rule := range c.Groups {
+		member := slices.ContainsFunc(groups, func(g string) bool {
+			return slices.Contains(rule.Groups, strings.ToLower(strings.TrimSpace(g)))
+		})
+		if member {
+			types = append(types, rule.Types...)
+		}
+	}
+	return types
+}
+
+// CertTypeNotAllowedError is returned if the requested certificate type is
+// not allowed for some of the requested domains.
+type CertTypeNotAllowedError struct {
+	Type    string
+	Domains []string
+}
+
+func (e *CertTypeNotAllowedError) Error() string {
+	return fmt.Sprintf("certificate type %s is not allowed for: %s", e.Type, strings.Join(e.Domains, ", "))
+}
+
+// ResolveCertType returns the certificate type to use for the given domains
+// requested by a member of the given user groups. If no type is requested,
+// the default type is used. A type is allowed if the groups of the user or
+// the rules of all domains allow it. Otherwise a *CertTypeNotAllowedError is
+// returned.
+func (c *CertTypeConfig) ResolveCertType(requested, defaultType string, domains, groups []string) (string, error) {
+	certType := NormalizeCertType(requested)
+	if certType == "" {
+		certType = NormalizeCertType(defaultType)
+	}
+	if slices.Contains(c.GroupTypes(groups), certType) {
+		return certType, nil
+	}
+	var denied []string
+	for _, domain := range domains {
+		if !slices.Contains(c.AllowedTypes(domain, defaultType), certType) {
+			denied = append(denied, domain)
+		}
+	}
+	if len(denied) > 0 {
+		sort.Strings(denied)
+		return "", &CertTypeNotAllowedError{Type: certType, Domains: denied}
+	}
+	return certType, nil
+}
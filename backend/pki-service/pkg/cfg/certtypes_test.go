@@ -0,0 +1,78 @@
+package cfg
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestResolveCertType(t *testing.T) {
+	cfg, err := LoadCertTypeConfig(writeConfig(t, `
+types: [ov]
+rules:
+  - domains: [lab.hm.edu]
+    types: [DV, OV]
+  - domains: [dv.lab.hm.edu]
+    types: [DV]
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := cfg.AllowedTypes("www.hm.edu", "OV"); !slices.Equal(got, []string{"OV"}) {
+		t.Errorf("unexpected types %v", got)
+	}
+	if got := cfg.AllowedTypes("a.dv.lab.hm.edu", "OV"); !slices.Equal(got, []string{"DV"}) {
+		t.Errorf("unexpected types %v", got)
+	}
+
+	if got, err := cfg.ResolveCertType("", "OV", []string{"www.hm.edu", "lab.hm.edu"}, nil); err != nil || got != "OV" {
+		t.Errorf("expected default type, got %q (%v)", got, err)
+	}
+	if got, err := cfg.ResolveCertType("dv", "OV", []string{"www.lab.hm.edu"}, nil); err != nil || got != "DV" {
+		t.Errorf("expected DV, got %q (%v)", got, err)
+	}
+	_, err = cfg.ResolveCertType("DV", "OV", []string{"www.lab.hm.edu", "www.hm.edu"}, nil)
+	var notAllowed *CertTypeNotAllowedError
+	if !errors.As(err, &notAllowed) || !slices.Equal(notAllowed.Domains, []string{"www.hm.edu"}) {
+		t.Errorf("expected DV to be denied for www.hm.edu, got %v", err)
+	}
+	if _, err := cfg.ResolveCertType("", "OV", []string{"dv.lab.hm.edu"}, nil); err == nil {
+		t.Error("expected default type to be denied")
+	}
+
+	// Group rules allow types for all domains.
+	cfg.Groups = []CertTypeGroupRule{{Groups: []string{"fk07-admins"}, Types: []string{"EV"}}}
+	if got, err := cfg.ResolveCertType("ev", "OV", []string{"www.hm.edu", "dv.lab.hm.edu"}, []string{"FK07-Admins"}); err != nil || got != "EV" {
+		t.Errorf("expected EV for group members, got %q (%v)", got, err)
+	}
+	if _, err := cfg.ResolveCertType("EV", "OV", []string{"www.hm.edu"}, []string{"staff"}); err == nil {
+		t.Error("expected EV to be denied for other groups")
+	}
+	if got, err := cfg.ResolveCertType("DV", "OV", []string{"www.lab.hm.edu"}, []string{"fk07-admins"}); err != nil || got != "DV" {
+		t.Errorf("expected domain rules to apply to group members, got %q (%v)", got, err)
+	}
+
+	// Without a configuration only the default type is allowed.
+	var empty *CertTypeConfig
+	if got, err := empty.ResolveCertType("ov", "OV", []string{"www.hm.edu"}, nil); err != nil || got != "OV" {
+		t.Errorf("expected default type, got %q (%v)", got, err)
+	}
+	if _, err := empty.ResolveCertType("EV", "OV", []string{"www.hm.edu"}, nil); err == nil {
+		t.Error("expected EV to be denied")
+	}
+}
+
+func TestLoadCertTypeConfigInvalid(t *testing.T) {
+	for name, content := range map[string]string{
+		"missing domains": "rules:\n  - types: [DV]\n",
+		"missing types":   "rules:\n  - domains: [hm.edu]\n",
+		"missing groups":  "groups:\n  - types: [EV]\n",
+		"empty group":     "groups:\n  - groups: [admins]\n",
+	} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := LoadCertTypeConfig(writeConfig(t, content)); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package cfg
+
+import (
+	"crypto/x509"
+	_ "embed"
+	"fmt"
+	"os"
+
+	"github.com/hm-edu/pki-service/pkg/helper"
+)
+
+// haricaSmimeChain holds the intermediate and root certificates of the
+// HARICA S/MIME issuing CAs. It is used if no chain is configured.
+//
+//go:embed harica-smime-chain.pem
+var haricaSmimeChain []byte
+
+// LoadCertificateChain reads a PEM bundle containing the intermediate and
+// root certificates of the issuing CAs. Text outside of the PEM blocks (e.g.
+// comments) is ignored. Without a path, the bundled HARICA chain is
+// returned.
+func LoadCertificateChain(path string) ([]*x509.Certificate, error) {
+	data := haricaSmimeChain
+	if path != "" {
+		var err error
+		data, err = os.ReadFile(path) // #nosec G304 -- path is provided by the operator
+		if err != nil {
+			return nil, fmt.Errorf("reading certificate chain %s: %w", path, err)
+		}
+	} else {
+		path = "harica-smime-chain.pem"
+	}
+	certs, err := helper.ParseCertificates(data)
+	if err != nil {
+		return nil, fmt.Errorf("parsing certificate chain %s: %w", path, err)
+	}
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("certificate chain %s contains no certificates", path)
+	}
+	return certs, nil
+}
@@ -0,0 +1,18 @@
+package cfg
+
+import "testing"
+
+func TestLoadCertificateChain(t *testing.T) {
+	for _, path := range []string{"harica-smime-chain.pem", ""} {
+		certs, err := LoadCertificateChain(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(certs) != 2 || certs[0].Subject.CommonName != "GEANT S/MIME RSA 1" {
+			t.Errorf("unexpected chain %v", certs)
+		}
+	}
+	if _, err := LoadCertificateChain(writeConfig(t, "no certificates")); err == nil {
+		t.Error("expected error for a file without certificates")
+	}
+}
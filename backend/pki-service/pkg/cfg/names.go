@@ -0,0 +1,131 @@
+package cfg
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultNamePattern is the character set accepted by HARICA for the given
+// name and surname of personal S/MIME certificates: latin letters (including
+// diacritics), spaces, hyphens, apostrophes and dots.
+const DefaultNamePattern = `^\p{Latin}[\p{Latin}\p{Mn} '.\-]*$`
+
+// defaultTransliterations are applied to names that are not accepted as is.
+// Characters that are not listed lose their diacritics (e.g. é becomes e).
+var defaultTransliterations = map[string]string{
+	"Ä": "Ae", "Ö": "Oe", "Ü": "Ue", "ä": "ae", "ö": "oe", "ü": "ue", "ß": "ss", "ẞ": "SS",
+	"Æ": "AE", "æ": "ae", "Ø": "O", "ø": "o", "Å": "A", "å": "a", "Œ": "OE", "œ": "oe",
+	"Ł": "L", "ł": "l", "Đ": "D", "đ": "d", "Þ": "Th", "þ": "th", "Ð": "D", "ð": "d",
+}
+
+// NameConfig is the content of the S/MIME name configuration file.
+type NameConfig struct {
+	// Pattern is the regular expression names must match. Defaults to
+	// DefaultNamePattern; a stricter pattern (e.g. ASCII only) forces the
+	// transliteration of all other names.
+	Pattern string `yaml:"pattern"`
+	// Transliterations replace characters (or character sequences) of names
+	// that do not match the pattern. They extend and override the built-in
+	// rules (e.g. umlauts become ae, oe, ue).
+	Transliterations map[string]string `yaml:"transliterations"`
+
+	pattern  *regexp.Regexp
+	replacer *strings.Replacer
+}
+
+// LoadNameConfig reads and validates the S/MIME name configuration file.
+func LoadNameConfig(path string) (*NameConfig, error) {
+	data, err := os.ReadFile(path) // #nosec G304 -- path is provided by the operator
+	if err != nil {
+		return nil, fmt.Errorf("reading name config %s: %w", path, err)
+	}
+	var cfg NameConfig
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("parsing name config %s: %w", path, err)
+	}
+	if err := cfg.init(); err != nil {
+		return nil, fmt.Errorf("name config %s: %w", path, err)
+	}
+	return &cfg, nil
+}
+
+func (c *NameConfig) init() error {
+	pattern := c.Pattern
+	if pattern == "" {
+		pattern = DefaultNamePattern
+	}
+	var err error
+	if c.pattern, err = regexp.Compile(pattern); err != nil {
+		return fmt.Errorf("invalid pattern: %w", err)
+	}
+	rules := map[string]string{}
+	for from, to := range defaultTransliterations {
+		rules[from] = to
+	}
+	for from, to := range c.Transliterations {
+		if from == "" {
+			return errors.New("empty transliteration")
+		}
+		rules[norm.NFC.String(from)] = to
+	}
+	// Longer sequences are replaced first.
+	keys := make([]string, 0, len(rules))
+	for from := range rules {
+		keys = append(keys, from)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if len(keys[i]) != len(keys[j]) {
+			return len(keys[i]) > len(keys[j])
+		}
+		return keys[i] < keys[j]
+	})
+	pairs := make([]string, 0, 2*len(keys))
+	for _, from := range keys {
+		pairs = append(pairs, from, rules[from])
+	}
+	c.replacer = strings.NewReplacer(pairs...)
+	return nil
+}
+
+var defaultNameConfig = func() *NameConfig {
+	c := &NameConfig{}
+	if err := c.init(); err != nil {
+		panic(err)
+	}
+	return c
+}()
+
+// NormalizeName prepares a name for a personal S/MIME certificate. Names are
+// trimmed and composed (NFC). Names that do not match the pattern are
+// transliterated; the second return value reports whether this was the case.
+// An error is returned if the name cannot be represented.
+func (c *NameConfig) NormalizeName(name string) (string, bool, error) {
+	if c == nil {
+		c = defaultNameConfig
+	}
+	name = strings.Join(strings.Fields(norm.NFC.String(name)), " ")
+	if c.pattern.MatchString(name) {
+		return name, false, nil
+	}
+	transliterated := c.replacer.Replace(name)
+	// Remove the remaining diacritics.
+	var b strings.Builder
+	for _, r := range norm.NFD.String(transliterated) {
+		if !unicode.Is(unicode.Mn, r) {
+			b.WriteRune(r)
+		}
+	}
+	transliterated = norm.NFC.String(b.String())
+	if !c.pattern.MatchString(transliterated) {
+		return "", false, fmt.Errorf("the name %q contains unsupported characters", name)
+	}
+	return transliterated, true, nil
+}
@@ -0,0 +1,48 @@
+package cfg
+
+import "testing"
+
+func TestNormalizeName(t *testing.T) {
+	var defaults *NameConfig
+	ascii, err := LoadNameConfig(writeConfig(t, `
+pattern: "^[A-Za-z][A-Za-z '.-]*$"
+transliterations:
+  Ł: Lu
+  Ω: O
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range []struct {
+		cfg            *NameConfig
+		name, want     string
+		transliterated bool
+		err            bool
+	}{
+		{defaults, "Müller", "Müller", false, false},
+		{defaults, "  Jean-Luc   O'Neill ", "Jean-Luc O'Neill", false, false},
+		// decomposed input is composed
+		{defaults, "Mu\u0308ller", "Müller", false, false},
+		{defaults, "Łukasz", "Łukasz", false, false},
+		{defaults, "Иван", "", false, true},
+		{defaults, "", "", false, true},
+		{ascii, "Müller", "Mueller", true, false},
+		{ascii, "Groß", "Gross", true, false},
+		{ascii, "Łukasz", "Luukasz", true, false},
+		{ascii, "José", "Jose", true, false},
+		{ascii, "Ωmega", "Omega", true, false},
+		{ascii, "Smith", "Smith", false, false},
+		{ascii, "李", "", false, true},
+	} {
+		got, transliterated, err := tc.cfg.NormalizeName(tc.name)
+		if (err != nil) != tc.err || got != tc.want || transliterated != tc.transliterated {
+			t.Errorf("%q: got %q, %v, %v", tc.name, got, transliterated, err)
+		}
+	}
+}
+
+func TestLoadNameConfigInvalid(t *testing.T) {
+	if _, err := LoadNameConfig(writeConfig(t, "pattern: '['")); err == nil {
+		t.Error("expected error for invalid pattern")
+	}
+}
@@ -0,0 +1,182 @@
+package cfg
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Organization describes a single validated HARICA organization (e.g. a legal
+// entity or a faculty) and the names that belong to it.
+type Organization struct {
+	// ID is the HARICA organization ID.
+	ID string `yaml:"id"`
+	// Name is only used for logging and error messages.
+	Name string `yaml:"name"`
+	// Domains are the domain suffixes of the organization. All subdomains
+	// belong to the organization as well; the most specific suffix wins.
+	Domains []string `yaml:"domains"`
+	// EmailDomains are the mail domains of the organization used for S/MIME
+	// certificates. Subdomains are matched like Domains.
+	EmailDomains []string `yaml:"email_domains"`
+}
+
+// OrganizationConfig is the content of the organization mapping file.
+// Requests for names that are not mapped are rejected.
+type OrganizationConfig struct {
+	Organizations []Organization `yaml:"organizations"`
+}
+
+// LoadOrganizationConfig reads and validates the organization mapping file.
+func LoadOrganizationConfig(path string) (*OrganizationConfig, error) {
+	data, err := os.ReadFile(path) // #nosec G304 -- path is provided by the operator
+	if err != nil {
+		return nil, fmt.Errorf("reading organization config %s: %w", path, err)
+	}
+	var cfg OrganizationConfig
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("parsing organization config %s: %w", path, err)
+	}
+	domains := map[string]string{}
+	emailDomains := map[string]string{}
+	for i := range cfg.Organizations {
+		org := &cfg.Organizations[i]
+		if org.ID == "" {
+			return nil, fmt.Errorf("organization config %s: organization %d has no id", path, i)
+		}
+		if org.Name == "" {
+			org.Name = org.ID
+		}
+		if len(org.Domains) == 0 && len(org.EmailDomains) == 0 {
+			return nil, fmt.Errorf("organization config %s: organization %s has no domains", path, org.Name)
+		}
+		for j, d := range org.Domains {
+			d = normalizeSuffix(d)
+			if other, ok := domains[d]; ok && other != org.ID {
+				return nil, fmt.Errorf("organization config %s: domain %s is assigned to several organizations", path, d)
+			}
+			domains[d] = org.ID
+			org.Domains[j] = d
+		}
+		for j, d := range org.EmailDomains {
+			d = normalizeSuffix(d)
+			if other, ok := emailDomains[d]; ok && other != org.ID {
+				return nil, fmt.Errorf("organization config %s: email domain %s is assigned to several organizations", path, d)
+			}
+			emailDomains[d] = org.ID
+			org.EmailDomains[j] = d
+		}
+	}
+	return &cfg, nil
+}
+
+// normalizeSuffix lower-cases a domain and strips wildcard prefixes and
+// trailing dots so it can be compared label-wise.
+func normalizeSuffix(domain string) string {
+	domain = strings.ToLower(strings.TrimSuffix(strings.TrimSpace(domain), "."))
+	return strings.TrimPrefix(domain, "*.")
+}
+
+// match returns the organization with the most specific suffix matching the
+// given name.
+func (c *OrganizationConfig) match(name string, suffixes func(*Organization) []string) *Organization {
+	if c == nil {
+		return nil
+	}
+	name = normalizeSuffix(name)
+	var best *Organization
+	bestLen := 0
+	for i := range c.Organizations {
+		org := &c.Organizations[i]
+		for _, suffix := range suffixes(org) {
+			if name != suffix && !strings.HasSuffix(name, "."+suffix) {
+				continue
+			}
+			if len(suffix) > bestLen {
+				best, bestLen = org, len(suffix)
+			}
+		}
+	}
+	return best
+}
+
+// ForDomain returns the organization the given domain belongs to or nil if
+// the domain is not mapped.
+func (c *OrganizationConfig) ForDomain(domain string) *Organization {
+	return c.match(domain, func(o *Organization) []string { return o.Domains })
+}
+
+// ErrNotMapped is returned if none of the requested names belongs to a
+// configured organization.
+var ErrNotMapped = errors.New("the requested names do not belong to any configured organization")
+
+// ForEmail returns the organization the domain of the given mail address
+// belongs to. ErrNotMapped is returned if the domain is not mapped. Without a
+// configuration, nil is returned.
+func (c *OrganizationConfig) ForEmail(email string) (*Organization, error) {
+	if c == nil {
+		return nil, nil
+	}
+	var org *Organization
+	if at := strings.LastIndex(email, "@"); at >= 0 {
+		org = c.match(email[at+1:], func(o *Organization) []string { return o.EmailDomains })
+	}
+	if org == nil {
+		return nil, ErrNotMapped
+	}
+	return org, nil
+}
+
+// MultipleOrganizationsError is returned if the names of a single request
+// belong to several organizations.
+type MultipleOrganizationsError struct {
+	// Names maps the requested names to the names of their organizations.
+	Names map[string]string
+}
+
+func (e *MultipleOrganizationsError) Error() string {
+	names := make([]string, 0, len(e.Names))
+	for name, org := range e.Names {
+		names = append(names, fmt.Sprintf("%s (%s)", name, org))
+	}
+	sort.Strings(names)
+	return "the requested names belong to several organizations: " + strings.Join(names, ", ")
+}
+
+// ForDomains returns the organization all mapped domains belong to. Unmapped
+// domains are ignored; ErrNotMapped is returned if none of the domains is
+// mapped. If the domains belong to several organizations, a
+// *MultipleOrganizationsError is returned. Without a configuration, nil is
+// returned.
+func (c *OrganizationConfig) ForDomains(domains []string) (*Organization, error) {
+	if c == nil {
+		return nil, nil
+	}
+	var result *Organization
+	names := map[string]string{}
+	multiple := false
+	for _, domain := range domains {
+		org := c.ForDomain(domain)
+		if org == nil {
+			continue
+		}
+		names[domain] = org.Name
+		if result != nil && result.ID != org.ID {
+			multiple = true
+		}
+		if result == nil {
+			result = org
+		}
+	}
+	if multiple {
+		return nil, &MultipleOrganizationsError{Names: names}
+	}
+	if result == nil {
+		return nil, ErrNotMapped
+	}
+	return result, nil
+}
@@ -0,0 +1,97 @@
+package cfg
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeConfig(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "organizations.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadOrganizationConfig(t *testing.T) {
+	cfg, err := LoadOrganizationConfig(writeConfig(t, `
+organizations:
+  - id: hm
+    name: HM
+    domains: [HM.edu.]
+    email_domains: [hm.edu]
+  - id: cs
+    domains: ["*.cs.hm.edu"]
+    email_domains: [cs.hm.edu]
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, want := range map[string]string{
+		"hm.edu":        "hm",
+		"www.hm.edu":    "hm",
+		"cs.hm.edu":     "cs",
+		"*.a.cs.hm.edu": "cs",
+		"xhm.edu":       "",
+		"example.com":   "",
+	} {
+		org := cfg.ForDomain(name)
+		got := ""
+		if org != nil {
+			got = org.ID
+		}
+		if got != want {
+			t.Errorf("%s: expected organization %q, got %q", name, want, got)
+		}
+	}
+	if org, err := cfg.ForEmail("jane.doe@cs.hm.edu"); err != nil || org == nil || org.ID != "cs" || org.Name != "cs" {
+		t.Errorf("unexpected organization %+v (%v)", org, err)
+	}
+	if org, err := cfg.ForEmail("jane.doe@example.com"); !errors.Is(err, ErrNotMapped) {
+		t.Errorf("expected unmapped mail domain, got %+v (%v)", org, err)
+	}
+
+	org, err := cfg.ForDomains([]string{"a.cs.hm.edu", "b.cs.hm.edu", "example.com"})
+	if err != nil || org == nil || org.ID != "cs" {
+		t.Errorf("expected organization cs, got %+v (%v)", org, err)
+	}
+	org, err = cfg.ForDomains([]string{"example.com", "129.187.1.2"})
+	if !errors.Is(err, ErrNotMapped) {
+		t.Errorf("expected unmapped names, got %+v (%v)", org, err)
+	}
+	_, err = cfg.ForDomains([]string{"www.hm.edu", "www.cs.hm.edu"})
+	var multiple *MultipleOrganizationsError
+	if !errors.As(err, &multiple) {
+		t.Fatalf("expected multiple organizations error, got %v", err)
+	}
+	if err.Error() != "the requested names belong to several organizations: www.cs.hm.edu (cs), www.hm.edu (HM)" {
+		t.Errorf("unexpected error %q", err)
+	}
+
+	// Without a configuration nothing is mapped.
+	var empty *OrganizationConfig
+	if org, err := empty.ForDomains([]string{"www.hm.edu"}); org != nil || err != nil {
+		t.Errorf("expected no organization, got %+v (%v)", org, err)
+	}
+	if org, err := empty.ForEmail("jane.doe@hm.edu"); org != nil || err != nil {
+		t.Errorf("expected no organization, got %+v (%v)", org, err)
+	}
+}
+
+func TestLoadOrganizationConfigInvalid(t *testing.T) {
+	for name, content := range map[string]string{
+		"missing id":      "organizations:\n  - domains: [hm.edu]\n",
+		"missing domains": "organizations:\n  - id: hm\n",
+		"duplicate":       "organizations:\n  - id: a\n    domains: [hm.edu]\n  - id: b\n    domains: [HM.edu]\n",
+		"invalid yaml":    "organizations: [",
+	} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := LoadOrganizationConfig(writeConfig(t, content)); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
@@ -1,15 +1,18 @@
 package cfg
 
+import "time"
+
 // PKIConfiguration handles different configuration properties for the sectigo client
 type PKIConfiguration struct {
-	User               string `mapstructure:"user"`
-	Password           string `mapstructure:"password"`
-	TotpSeed           string `mapstructure:"totp_seed"`
-	ValidationUser     string `mapstructure:"validation_user"`
-	ValidationPassword string `mapstructure:"validation_password"`
-	ValidationTotpSeed string `mapstructure:"validation_totp_seed"`
-	SmimeKeyLength     string `mapstructure:"smime_key_length"`
-	CertType           string `mapstructure:"cert_type"`
+	User               string   `mapstructure:"user"`
+	Password           string   `mapstructure:"password"`
+	TotpSeed           string   `mapstructure:"totp_seed"`
+	ValidationUser     string   `mapstructure:"validation_user"`
+	ValidationPassword string   `mapstructure:"validation_password"`
+	ValidationTotpSeed string   `mapstructure:"validation_totp_seed"`
+	SmimeKeyLength     string   `mapstructure:"smime_key_length"`
+	SmimeKeyTypes      []string `mapstructure:"smime_key_types"`
+	CertType           string   `mapstructure:"cert_type"`
 
 	// SslCa selects the CA used for issuing server certificates
 	// ("harica" or "letsencrypt").
@@ -24,4 +27,65 @@ type PKIConfiguration struct {
 	// AcmeDNSConfig is the path to the YAML file mapping DNS zones to the
 	// TSIG keys used for the DNS-01 validation.
 	AcmeDNSConfig string `mapstructure:"acme_dns_config"`
+	// AcmeRateLimitWindow is the sliding window of the ACME CA rate limits.
+	AcmeRateLimitWindow time.Duration `mapstructure:"acme_rate_limit_window"`
+	// AcmeCertificatesPerDomain is the number of new certificates per
+	// registered domain the ACME CA issues within the window.
+	AcmeCertificatesPerDomain int `mapstructure:"acme_certificates_per_domain"`
+	// AcmeDuplicateCertificates is the number of certificates for the exact
+	// same set of names the ACME CA issues within the window.
+	AcmeDuplicateCertificates int `mapstructure:"acme_duplicate_certificates"`
+	// AcmeRateLimitFallback selects how requests hitting an ACME rate limit
+	// are handled ("harica" or "queue").
+	AcmeRateLimitFallback string `mapstructure:"acme_rate_limit_fallback"`
+	// AcmeQueueMaxAttempts is the number of failed ACME orders after which
+	// a queued certificate request is given up.
+	AcmeQueueMaxAttempts int `mapstructure:"acme_queue_max_attempts"`
+	// HaricaBreakerThreshold is the number of consecutive failed HARICA
+	// requests after which all requests fail fast.
+	HaricaBreakerThreshold int `mapstructure:"harica_breaker_threshold"`
+	// HaricaBreakerProbeInterval is the interval in which the availability
+	// of HARICA is probed while requests fail fast.
+	HaricaBreakerProbeInterval time.Duration `mapstructure:"harica_breaker_probe_interval"`
+	// HaricaOrganizations is the path to the YAML file mapping domains and
+	// mail domains to HARICA organizations.
+	HaricaOrganizations string `mapstructure:"harica_organizations"`
+	// SmimeKeyGeneration enables the generation of S/MIME keys by the
+	// service. The keys are returned as PKCS#12 bundle.
+	SmimeKeyGeneration bool `mapstructure:"smime_key_generation"`
+	// SmimeValidityDays is the validity of the S/MIME certificates issued by
+	// the HARICA profile. It is required to enforce validity limits of the
+	// eligibility policy.
+	SmimeValidityDays int `mapstructure:"smime_validity_days"`
+	// SmimeGeneratedKeyType is the type of the generated S/MIME keys
+	// (e.g. RSA-3072 or ECDSA-P256).
+	SmimeGeneratedKeyType string `mapstructure:"smime_generated_key_type"`
+	// SmimePkcs12Encryption selects the PKCS#12 encryption ("modern" or
+	// "legacy" for old clients).
+	SmimePkcs12Encryption string `mapstructure:"smime_pkcs12_encryption"`
+	// SmimeKeyEscrow is the path to the YAML file configuring the escrow of
+	// generated S/MIME keys. Keys are not escrowed if empty.
+	SmimeKeyEscrow string `mapstructure:"smime_key_escrow"`
+	// SmimeChain is the path to the PEM bundle with the intermediate and
+	// root certificates of the S/MIME issuing CAs. The bundled HARICA chain
+	// is used if empty.
+	SmimeChain string `mapstructure:"smime_chain"`
+	// SmimeVerificationURL is the (frontend) page linked in the mails
+	// confirming additional S/MIME addresses. The address and the code are
+	// appended as query parameters.
+	SmimeVerificationURL string `mapstructure:"smime_verification_url"`
+	// SmimeNames is the path to the YAML file configuring the character set
+	// and the transliteration of names in personal S/MIME certificates.
+	SmimeNames string `mapstructure:"smime_names"`
+	// Terms is the path to the YAML file describing the terms of use that
+	// must be accepted before certificates are issued. No acceptance is
+	// required if empty.
+	Terms string `mapstructure:"terms"`
+	// SmimeLdapPublisher is the path to the YAML file configuring the
+	// publication of S/MIME certificates to the LDAP directory. Certificates
+	// are not published if empty.
+	SmimeLdapPublisher string `mapstructure:"smime_ldap_publisher"`
+	// SslCertTypes is the path to the YAML file listing the certificate
+	// types that can be requested per domain.
+	SslCertTypes string `mapstructure:"ssl_cert_types"`
 }
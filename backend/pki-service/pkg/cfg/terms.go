@@ -0,0 +1,40 @@
+package cfg
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// TermsConfig describes the current version of the subscriber agreement /
+// terms of use that must be accepted before certificates are issued.
+type TermsConfig struct {
+	// Version identifies the terms. Changing it forces all users to accept
+	// the terms again.
+	Version string `yaml:"version"`
+	Title   string `yaml:"title"`
+	// URL points to the full text of the terms.
+	URL string `yaml:"url"`
+	// Text is shown to the users (e.g. a summary of the terms).
+	Text string `yaml:"text"`
+}
+
+// LoadTermsConfig reads and validates the terms configuration.
+func LoadTermsConfig(path string) (*TermsConfig, error) {
+	data, err := os.ReadFile(path) // #nosec G304 -- path is provided by the operator
+	if err != nil {
+		return nil, fmt.Errorf("reading terms config %s: %w", path, err)
+	}
+	var cfg TermsConfig
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("parsing terms config %s: %w", path, err)
+	}
+	if cfg.Version == "" {
+		return nil, fmt.Errorf("terms config %s: version is required", path)
+	}
+	if cfg.URL == "" && cfg.Text == "" {
+		return nil, fmt.Errorf("terms config %s: either url or text is required", path)
+	}
+	return &cfg, nil
+}
@@ -0,0 +1,26 @@
+package cfg
+
+import "testing"
+
+func TestLoadTermsConfig(t *testing.T) {
+	cfg, err := LoadTermsConfig(writeConfig(t, `
+version: "2026-10"
+title: Subscriber Agreement
+url: https://www.harica.gr/documents/TCS-Subscriber-Agreement.pdf
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Version != "2026-10" || cfg.Title != "Subscriber Agreement" {
+		t.Errorf("unexpected config %+v", cfg)
+	}
+
+	for _, content := range []string{
+		"url: https://example.com\n",
+		"version: v1\n",
+	} {
+		if _, err := LoadTermsConfig(writeConfig(t, content)); err == nil {
+			t.Errorf("expected error for %q", content)
+		}
+	}
+}
@@ -0,0 +1,313 @@
+// Package escrow implements the escrow of generated S/MIME keys. The keys
+// are encrypted with a random data key. The data key is either wrapped with
+// a recovery public key or split between several recovery officers (each
+// share is encrypted with the public key of the officer), so that the key
+// can only be recovered with the recovery private key or the shares of
+// several officers.
+package escrow
+
+import (
+	"crypto"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Escrow modes.
+const (
+	// ModeWrap wraps the data key with the recovery public key.
+	ModeWrap = "wrap"
+	// ModeSplit splits the data key between the recovery officers.
+	ModeSplit = "split"
+)
+
+// defaultApprovals is the number of distinct officers that must approve a
+// recovery (dual control).
+const defaultApprovals = 2
+
+// Officer is a recovery officer.
+type Officer struct {
+	// Email identifies the officer.
+	Email string `yaml:"email"`
+	// PublicKey is the path to the PEM encoded RSA public key of the officer
+	// the share is encrypted with (split mode only).
+	PublicKey string `yaml:"public_key"`
+
+	publicKey *rsa.PublicKey
+}
+
+// Config is the content of the key escrow configuration file.
+type Config struct {
+	// Mode is either "wrap" or "split".
+	Mode string `yaml:"mode"`
+	// RecoveryKey is the path to the PEM encoded RSA recovery public key
+	// (wrap mode only).
+	RecoveryKey string `yaml:"recovery_key"`
+	// RecoveryPrivateKey is the path to the PEM encoded recovery private key
+	// (wrap mode only). It is only required on the instance performing the
+	// recoveries.
+	RecoveryPrivateKey string `yaml:"recovery_private_key"`
+	// Officers are the recovery officers approving recoveries.
+	Officers []Officer `yaml:"officers"`
+	// Threshold is the number of shares required to recover a key (split
+	// mode only, defaults to the number of approvals).
+	Threshold int `yaml:"threshold"`
+	// Approvals is the number of distinct officers that must approve a
+	// recovery (defaults to 2).
+	Approvals int `yaml:"approvals"`
+
+	recoveryKey        *rsa.PublicKey
+	recoveryPrivateKey *rsa.PrivateKey
+}
+
+// Load reads and validates the key escrow configuration file.
+func Load(path string) (*Config, error) {
+	data, err := os.ReadFile(path) // #nosec G304 -- path is provided by the operator
+	if err != nil {
+		return nil, fmt.Errorf("reading key escrow config %s: %w", path, err)
+	}
+	var cfg Config
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("parsing key escrow config %s: %w", path, err)
+	}
+	if cfg.Approvals == 0 {
+		cfg.Approvals = defaultApprovals
+	}
+	if cfg.Approvals < defaultApprovals {
+		return nil, fmt.Errorf("key escrow config %s: at least %d approvals are required", path, defaultApprovals)
+	}
+	if len(cfg.Officers) < cfg.Approvals {
+		return nil, fmt.Errorf("key escrow config %s: %d approvals require at least as many officers", path, cfg.Approvals)
+	}
+	for i := range cfg.Officers {
+		officer := &cfg.Officers[i]
+		officer.Email = strings.ToLower(officer.Email)
+		if officer.Email == "" {
+			return nil, fmt.Errorf("key escrow config %s: officer %d has no email", path, i)
+		}
+	}
+	switch cfg.Mode {
+	case ModeWrap:
+		if cfg.recoveryKey, err = loadPublicKey(cfg.RecoveryKey); err != nil {
+			return nil, fmt.Errorf("key escrow config %s: %w", path, err)
+		}
+		if cfg.RecoveryPrivateKey != "" {
+			if cfg.recoveryPrivateKey, err = loadPrivateKey(cfg.RecoveryPrivateKey); err != nil {
+				return nil, fmt.Errorf("key escrow config %s: %w", path, err)
+			}
+		}
+	case ModeSplit:
+		if cfg.Threshold == 0 {
+			cfg.Threshold = cfg.Approvals
+		}
+		if cfg.Threshold < 2 || cfg.Threshold > len(cfg.Officers) || cfg.Threshold > cfg.Approvals {
+			return nil, fmt.Errorf("key escrow config %s: invalid threshold %d", path, cfg.Threshold)
+		}
+		for i := range cfg.Officers {
+			officer := &cfg.Officers[i]
+			if officer.publicKey, err = loadPublicKey(officer.PublicKey); err != nil {
+				return nil, fmt.Errorf("key escrow config %s: officer %s: %w", path, officer.Email, err)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("key escrow config %s: unsupported mode %q", path, cfg.Mode)
+	}
+	return &cfg, nil
+}
+
+func loadPublicKey(path string) (*rsa.PublicKey, error) {
+	data, err := os.ReadFile(path) // #nosec G304 -- path is provided by the operator
+	if err != nil {
+		return nil, fmt.Errorf("reading public key: %w", err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM block in %s", path)
+	}
+	var key any
+	if block.Type == "CERTIFICATE" {
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		key = cert.PublicKey
+	} else if key, err = x509.ParsePKIXPublicKey(block.Bytes); err != nil {
+		return nil, fmt.Errorf("parsing public key %s: %w", path, err)
+	}
+	rsaKey, ok := key.(*rsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("public key %s is no RSA key", path)
+	}
+	return rsaKey, nil
+}
+
+func loadPrivateKey(path string) (*rsa.PrivateKey, error) {
+	data, err := os.ReadFile(path) // #nosec G304 -- path is provided by the operator
+	if err != nil {
+		return nil, fmt.Errorf("reading private key: %w", err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM block in %s", path)
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("parsing private key %s: %w", path, err)
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("private key %s is no RSA key", path)
+	}
+	return rsaKey, nil
+}
+
+// IsOfficer reports whether the given mail address belongs to a recovery
+// officer.
+func (c *Config) IsOfficer(email string) bool {
+	for _, officer := range c.Officers {
+		if strings.EqualFold(officer.Email, email) {
+			return true
+		}
+	}
+	return false
+}
+
+// Sealed is an escrowed key.
+type Sealed struct {
+	Mode string
+	// Ciphertext is the PKCS#8 encoded key encrypted with the data key
+	// (AES-256-GCM, nonce prepended).
+	Ciphertext []byte
+	// WrappedKey is the data key encrypted with the recovery public key
+	// (wrap mode).
+	WrappedKey []byte
+	// Shares are the shares of the data key, encrypted with the public key
+	// of the officer (split mode).
+	Shares map[string][]byte
+}
+
+// Seal escrows the given key.
+func (c *Config) Seal(key crypto.Signer) (*Sealed, error) {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	dataKey := make([]byte, 32)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, err
+	}
+	ciphertext, err := encrypt(dataKey, der)
+	if err != nil {
+		return nil, err
+	}
+	sealed := &Sealed{Mode: c.Mode, Ciphertext: ciphertext}
+	switch c.Mode {
+	case ModeWrap:
+		sealed.WrappedKey, err = rsa.EncryptOAEP(sha256.New(), rand.Reader, c.recoveryKey, dataKey, nil)
+		if err != nil {
+			return nil, err
+		}
+	case ModeSplit:
+		shares, err := Split(dataKey, len(c.Officers), c.Threshold)
+		if err != nil {
+			return nil, err
+		}
+		sealed.Shares = map[string][]byte{}
+		for i, officer := range c.Officers {
+			sealed.Shares[officer.Email], err = rsa.EncryptOAEP(sha256.New(), rand.Reader, officer.publicKey, shares[i], nil)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return sealed, nil
+}
+
+// ErrRecoveryKeyMissing is returned if a wrapped key should be recovered on
+// an instance without the recovery private key.
+var ErrRecoveryKeyMissing = errors.New("recovery private key not configured")
+
+// Open recovers an escrowed key. Keys in split mode require the decrypted
+// shares of the officers.
+func (c *Config) Open(sealed *Sealed, shares [][]byte) (crypto.Signer, error) {
+	var dataKey []byte
+	var err error
+	switch sealed.Mode {
+	case ModeWrap:
+		if c.recoveryPrivateKey == nil {
+			return nil, ErrRecoveryKeyMissing
+		}
+		dataKey, err = rsa.DecryptOAEP(sha256.New(), rand.Reader, c.recoveryPrivateKey, sealed.WrappedKey, nil)
+	case ModeSplit:
+		dataKey, err = Combine(shares)
+	default:
+		err = fmt.Errorf("unsupported mode %q", sealed.Mode)
+	}
+	if err != nil {
+		return nil, err
+	}
+	der, err := decrypt(dataKey, sealed.Ciphertext)
+	if err != nil {
+		return nil, err
+	}
+	key, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		return nil, err
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, errors.New("unsupported key")
+	}
+	return signer, nil
+}
+
+// DecryptShare decrypts the share of an officer using the private key of the
+// officer. Officers usually perform this step offline (e.g. using openssl
+// pkeyutl with RSA-OAEP and SHA-256).
+func DecryptShare(share []byte, key *rsa.PrivateKey) ([]byte, error) {
+	return rsa.DecryptOAEP(sha256.New(), rand.Reader, key, share, nil)
+}
+
+func encrypt(key, plaintext []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+func decrypt(key, ciphertext []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	if len(ciphertext) < gcm.NonceSize() {
+		return nil, errors.New("ciphertext too short")
+	}
+	return gcm.Open(nil, ciphertext[:gcm.NonceSize()], ciphertext[gcm.NonceSize():], nil)
+}
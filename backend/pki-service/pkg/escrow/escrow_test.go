@@ -0,0 +1,194 @@
+package escrow
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeKey(t *testing.T, dir, name string) *rsa.PrivateKey {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pub, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubPEM := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pub})
+	privPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	if err := os.WriteFile(filepath.Join(dir, name+".pub"), pubPEM, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, name+".key"), privPEM, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+func writeConfig(t *testing.T, dir, content string) string {
+	t.Helper()
+	path := filepath.Join(dir, "escrow.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestSplitCombine(t *testing.T) {
+	secret := []byte("0123456789abcdef0123456789abcdef")
+	shares, err := Split(secret, 5, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, subset := range [][]int{{0, 1, 2}, {4, 2, 0}, {1, 3, 4}, {0, 1, 2, 3, 4}} {
+		var parts [][]byte
+		for _, i := range subset {
+			parts = append(parts, shares[i])
+		}
+		got, err := Combine(parts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, secret) {
+			t.Errorf("shares %v: recovered wrong secret", subset)
+		}
+	}
+	got, err := Combine([][]byte{shares[0], shares[1]})
+	if err == nil && bytes.Equal(got, secret) {
+		t.Error("secret recovered from less shares than the threshold")
+	}
+	if _, err := Split(secret, 2, 3); err == nil {
+		t.Error("expected error for threshold above the number of shares")
+	}
+}
+
+func TestWrap(t *testing.T) {
+	dir := t.TempDir()
+	writeKey(t, dir, "recovery")
+	path := writeConfig(t, dir, `
+mode: wrap
+recovery_key: `+filepath.Join(dir, "recovery.pub")+`
+recovery_private_key: `+filepath.Join(dir, "recovery.key")+`
+officers:
+  - email: Alice@hm.edu
+  - email: bob@hm.edu
+`)
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Approvals != 2 || !cfg.IsOfficer("alice@HM.edu") || cfg.IsOfficer("eve@hm.edu") {
+		t.Fatalf("unexpected config %+v", cfg)
+	}
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	sealed, err := cfg.Seal(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sealed.WrappedKey) == 0 || sealed.Shares != nil {
+		t.Fatalf("unexpected sealed key %+v", sealed)
+	}
+	opened, err := cfg.Open(sealed, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !key.Equal(opened) {
+		t.Error("recovered key differs")
+	}
+
+	// Instances without the private key can only escrow keys.
+	path = writeConfig(t, dir, `
+mode: wrap
+recovery_key: `+filepath.Join(dir, "recovery.pub")+`
+officers:
+  - email: alice@hm.edu
+  - email: bob@hm.edu
+`)
+	cfg, err = Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cfg.Open(sealed, nil); !errors.Is(err, ErrRecoveryKeyMissing) {
+		t.Errorf("expected ErrRecoveryKeyMissing, got %v", err)
+	}
+}
+
+func TestSplit(t *testing.T) {
+	dir := t.TempDir()
+	keys := map[string]*rsa.PrivateKey{}
+	for _, name := range []string{"alice", "bob", "carol"} {
+		keys[name+"@hm.edu"] = writeKey(t, dir, name)
+	}
+	path := writeConfig(t, dir, `
+mode: split
+officers:
+  - email: alice@hm.edu
+    public_key: `+filepath.Join(dir, "alice.pub")+`
+  - email: bob@hm.edu
+    public_key: `+filepath.Join(dir, "bob.pub")+`
+  - email: carol@hm.edu
+    public_key: `+filepath.Join(dir, "carol.pub")+`
+`)
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Threshold != 2 {
+		t.Fatalf("expected threshold 2, got %d", cfg.Threshold)
+	}
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sealed, err := cfg.Seal(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sealed.Shares) != 3 || sealed.WrappedKey != nil {
+		t.Fatalf("unexpected sealed key %+v", sealed)
+	}
+	var shares [][]byte
+	for _, officer := range []string{"carol@hm.edu", "alice@hm.edu"} {
+		share, err := DecryptShare(sealed.Shares[officer], keys[officer])
+		if err != nil {
+			t.Fatal(err)
+		}
+		shares = append(shares, share)
+	}
+	opened, err := cfg.Open(sealed, shares)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !key.Equal(opened) {
+		t.Error("recovered key differs")
+	}
+	if _, err := cfg.Open(sealed, shares[:1]); err == nil {
+		t.Error("expected error for a single share")
+	}
+}
+
+func TestLoadInvalid(t *testing.T) {
+	dir := t.TempDir()
+	writeKey(t, dir, "recovery")
+	for name, content := range map[string]string{
+		"mode":      "mode: plain\nofficers: [{email: a@hm.edu}, {email: b@hm.edu}]",
+		"officers":  "mode: wrap\nrecovery_key: " + filepath.Join(dir, "recovery.pub") + "\nofficers: [{email: a@hm.edu}]",
+		"approvals": "mode: wrap\napprovals: 1\nrecovery_key: " + filepath.Join(dir, "recovery.pub") + "\nofficers: [{email: a@hm.edu}, {email: b@hm.edu}]",
+		"key":       "mode: wrap\nrecovery_key: " + filepath.Join(dir, "missing.pub") + "\nofficers: [{email: a@hm.edu}, {email: b@hm.edu}]",
+		"threshold": "mode: split\nthreshold: 3\nofficers: [{email: a@hm.edu, public_key: " + filepath.Join(dir, "recovery.pub") + "}, {email: b@hm.edu, public_key: " + filepath.Join(dir, "recovery.pub") + "}]",
+	} {
+		if _, err := Load(writeConfig(t, dir, content)); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
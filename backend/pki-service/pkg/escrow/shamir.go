@@ -0,0 +1,110 @@
+package escrow
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+)
+
+// Shamir's secret sharing over GF(2^8). Every byte of the secret is shared
+// using its own random polynomial of degree threshold-1; a share consists of
+// the evaluations of all polynomials at the x coordinate of the share, which
+// is appended as last byte.
+
+// gfMul multiplies two elements of GF(2^8) (AES polynomial).
+func gfMul(a, b byte) byte {
+	var p byte
+	for b > 0 {
+		if b&1 != 0 {
+			p ^= a
+		}
+		carry := a & 0x80
+		a <<= 1
+		if carry != 0 {
+			a ^= 0x1b
+		}
+		b >>= 1
+	}
+	return p
+}
+
+// gfInv returns the multiplicative inverse (a^254) of a non-zero element.
+func gfInv(a byte) byte {
+	result := byte(1)
+	for i := 0; i < 254; i++ {
+		result = gfMul(result, a)
+	}
+	return result
+}
+
+// Split splits the secret into n shares, threshold of which are required to
+// reconstruct it.
+func Split(secret []byte, n, threshold int) ([][]byte, error) {
+	if threshold < 2 || threshold > n || n > 255 {
+		return nil, fmt.Errorf("invalid threshold %d of %d shares", threshold, n)
+	}
+	if len(secret) == 0 {
+		return nil, errors.New("empty secret")
+	}
+	shares := make([][]byte, n)
+	for i := range shares {
+		shares[i] = make([]byte, len(secret)+1)
+		shares[i][len(secret)] = byte(i + 1)
+	}
+	coefficients := make([]byte, threshold-1)
+	for idx, b := range secret {
+		if _, err := rand.Read(coefficients); err != nil {
+			return nil, err
+		}
+		for i := range shares {
+			x := byte(i + 1)
+			// Horner's method
+			y := byte(0)
+			for j := len(coefficients) - 1; j >= 0; j-- {
+				y = gfMul(y, x) ^ coefficients[j]
+			}
+			shares[i][idx] = gfMul(y, x) ^ b
+		}
+	}
+	return shares, nil
+}
+
+// Combine reconstructs the secret from the given shares using Lagrange
+// interpolation at x = 0.
+func Combine(shares [][]byte) ([]byte, error) {
+	if len(shares) < 2 {
+		return nil, errors.New("at least two shares are required")
+	}
+	length := len(shares[0])
+	if length < 2 {
+		return nil, errors.New("invalid share")
+	}
+	seen := map[byte]bool{}
+	for _, share := range shares {
+		if len(share) != length {
+			return nil, errors.New("shares have different lengths")
+		}
+		x := share[length-1]
+		if x == 0 || seen[x] {
+			return nil, errors.New("invalid or duplicate share")
+		}
+		seen[x] = true
+	}
+	secret := make([]byte, length-1)
+	for i, share := range shares {
+		xi := share[length-1]
+		// Lagrange basis polynomial evaluated at 0
+		basis := byte(1)
+		for j, other := range shares {
+			if i == j {
+				continue
+			}
+			xj := other[length-1]
+			basis = gfMul(basis, gfMul(xj, gfInv(xi^xj)))
+		}
+		for idx := range secret {
+			secret[idx] ^= gfMul(share[idx], basis)
+		}
+	}
+	return secret, nil
+}
@@ -0,0 +1,303 @@
+// Package events delivers the notifications of certificate and domain events
+// according to the preferences of the recipients.
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hm-edu/pki-service/ent"
+	"github.com/hm-edu/pki-service/ent/notificationpreference"
+	"github.com/hm-edu/pki-service/pkg/notify"
+	pb "github.com/hm-edu/portal-apis"
+	"go.uber.org/zap"
+)
+
+// ErrInvalidPreference is returned for preferences of unknown events or
+// channels.
+var ErrInvalidPreference = errors.New("invalid notification preference")
+
+// Event is a certificate or domain event.
+type Event struct {
+	// Type is one of notify.Events.
+	Type string
+	// Actor is the user that triggered the event.
+	Actor   string
+	Subject string
+	// Recipients are notified unless they disabled the event.
+	Recipients []string
+	Details    map[string]string
+}
+
+// Preference is the choice of a user for an event.
+type Preference struct {
+	Event   string
+	Enabled bool
+	// Channels are the names of the channels the event is delivered
+	// through. All channels handling the event are used if empty.
+	Channels []string
+}
+
+// Published events are delivered by publishWorkers workers. At most
+// publishQueueSize events wait for delivery; further events are dropped.
+const (
+	publishWorkers   = 4
+	publishQueueSize = 256
+)
+
+// publication is an event waiting for delivery.
+type publication struct {
+	ctx   context.Context
+	event Event
+}
+
+// Hub delivers events to their recipients. A nil hub drops all events.
+type Hub struct {
+	Db *ent.Client
+	// Dispatcher delivers the notifications. Events are dropped if nil
+	// (e.g. if notifications are disabled), preferences can still be
+	// managed.
+	Dispatcher *notify.Dispatcher
+	// Domains resolves the owners and delegates of the domains of
+	// certificates. Only the requester is notified about certificate
+	// events if nil.
+	Domains pb.DomainServiceClient
+	Logger  *zap.Logger
+
+	mu      sync.Mutex
+	queue   chan publication
+	workers sync.WaitGroup
+}
+
+// Known reports whether the event can be published.
+func Known(event string) bool {
+	return slices.Contains(notify.Events, event)
+}
+
+// Active reports whether events are delivered.
+func (h *Hub) Active() bool {
+	return h != nil && h.Dispatcher != nil
+}
+
+// Start starts the workers delivering the published events.
+func (h *Hub) Start() {
+	if !h.Active() {
+		return
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.queue != nil {
+		return
+	}
+	h.queue = make(chan publication, publishQueueSize)
+	for range publishWorkers {
+		h.workers.Add(1)
+		go func(queue <-chan publication) {
+			defer h.workers.Done()
+			for p := range queue {
+				h.deliver(p.ctx, p.event)
+			}
+		}(h.queue)
+	}
+}
+
+// Stop stops accepting events and waits until the queued events are
+// delivered.
+func (h *Hub) Stop() {
+	if h == nil {
+		return
+	}
+	h.mu.Lock()
+	queue := h.queue
+	h.queue = nil
+	h.mu.Unlock()
+	if queue == nil {
+		return
+	}
+	close(queue)
+	h.workers.Wait()
+}
+
+// Publish queues the event for delivery by the workers. Events are delivered
+// immediately if the workers are not running and dropped if the queue is
+// full. Failures are logged since the action that caused the event must not
+// fail because of them.
+func (h *Hub) Publish(ctx context.Context, event Event) {
+	if !h.Active() {
+		return
+	}
+	ctx = context.WithoutCancel(ctx)
+	h.mu.Lock()
+	if h.queue == nil {
+		h.mu.Unlock()
+		h.deliver(ctx, event)
+		return
+	}
+	select {
+	case h.queue <- publication{ctx: ctx, event: event}:
+	default:
+		h.Logger.Warn("Event queue full, dropping event notification", zap.String("event", event.Type), zap.String("subject", event.Subject))
+	}
+	h.mu.Unlock()
+}
+
+func (h *Hub) deliver(ctx context.Context, event Event) {
+	if err := h.Deliver(ctx, event); err != nil {
+		h.Logger.Error("Error delivering event notification", zap.String("event", event.Type), zap.String("subject", event.Subject), zap.Error(err))
+	}
+}
+
+// Deliver sends the notifications of the event. Every recipient receives a
+// separate notification, so the recipients do not learn about each other.
+func (h *Hub) Deliver(ctx context.Context, event Event) error {
+	if !Known(event.Type) {
+		return fmt.Errorf("unknown event %q", event.Type)
+	}
+	var recipients []string
+	for _, r := range event.Recipients {
+		if r = strings.ToLower(strings.TrimSpace(r)); r != "" && !slices.Contains(recipients, r) {
+			recipients = append(recipients, r)
+		}
+	}
+	if len(recipients) == 0 {
+		return nil
+	}
+	preferences, err := h.Db.NotificationPreference.Query().
+		Where(notificationpreference.Event(event.Type), notificationpreference.UserIn(recipients...)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	byUser := make(map[string]*ent.NotificationPreference)
+	for _, p := range preferences {
+		byUser[p.User] = p
+	}
+	data := notify.Event{Actor: event.Actor, Subject: event.Subject, Details: event.Details, Time: time.Now()}
+	var errs []error
+	for _, r := range recipients {
+		var channels []string
+		if p, ok := byUser[r]; ok {
+			if !p.Enabled {
+				continue
+			}
+			channels = p.Channels
+		}
+		msg := notify.Message{Event: event.Type, To: []string{r}, Data: data, Channels: channels}
+		if err := h.Dispatcher.Send(ctx, msg); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// CertificateRecipients returns the requester of a certificate and the
+// owners and delegates of its domains. Only the requester is returned if the
+// domain service is not configured or fails.
+func (h *Hub) CertificateRecipients(ctx context.Context, requester string, domains []string) []string {
+	var recipients []string
+	if requester != "" {
+		// Requests via ACME are marked like "jane.doe@hm.edu (ACME)".
+		recipients = append(recipients, strings.Split(requester, " ")[0])
+	}
+	if h.Domains == nil || len(domains) == 0 {
+		return recipients
+	}
+	resp, err := h.Domains.ListDomainContacts(ctx, &pb.ListDomainContactsRequest{Domains: domains})
+	if err != nil {
+		h.Logger.Warn("Error resolving domain owners, notifying the requester only", zap.Error(err))
+		return recipients
+	}
+	for _, c := range resp.Contacts {
+		if c.Owner != "" {
+			recipients = append(recipients, c.Owner)
+		}
+		recipients = append(recipients, c.Delegates...)
+	}
+	return recipients
+}
+
+// channelNames returns the names of the channels users may choose for the
+// event.
+func (h *Hub) channelNames(event string) []string {
+	if h.Dispatcher == nil {
+		return nil
+	}
+	return h.Dispatcher.Config().ChannelNames(event)
+}
+
+// Channels returns the names of all channels handling any event users can
+// subscribe to.
+func (h *Hub) Channels() []string {
+	var names []string
+	for _, event := range notify.Events {
+		for _, name := range h.channelNames(event) {
+			if !slices.Contains(names, name) {
+				names = append(names, name)
+			}
+		}
+	}
+	return names
+}
+
+// Preferences returns the preferences of the user for all events. Events
+// without stored preference are enabled on all channels.
+func (h *Hub) Preferences(ctx context.Context, user string) ([]Preference, error) {
+	stored, err := h.Db.NotificationPreference.Query().
+		Where(notificationpreference.User(strings.ToLower(user))).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	preferences := make([]Preference, 0, len(notify.Events))
+	for _, event := range notify.Events {
+		p := Preference{Event: event, Enabled: true}
+		for _, s := range stored {
+			if s.Event == event {
+				p.Enabled = s.Enabled
+				p.Channels = s.Channels
+			}
+		}
+		preferences = append(preferences, p)
+	}
+	return preferences, nil
+}
+
+// UpdatePreferences stores the preferences of the user. Events not contained
+// keep their preference. Channels must handle the event.
+func (h *Hub) UpdatePreferences(ctx context.Context, user string, preferences []Preference) error {
+	user = strings.ToLower(user)
+	if user == "" {
+		return fmt.Errorf("%w: missing user", ErrInvalidPreference)
+	}
+	for _, p := range preferences {
+		if !Known(p.Event) {
+			return fmt.Errorf("%w: unknown event %q", ErrInvalidPreference, p.Event)
+		}
+		available := h.channelNames(p.Event)
+		for _, channel := range p.Channels {
+			if !slices.Contains(available, channel) {
+				return fmt.Errorf("%w: channel %q does not handle %s", ErrInvalidPreference, channel, p.Event)
+			}
+		}
+	}
+	if len(preferences) == 0 {
+		return nil
+	}
+	builders := make([]*ent.NotificationPreferenceCreate, 0, len(preferences))
+	for _, p := range preferences {
+		builders = append(builders, h.Db.NotificationPreference.Create().
+			SetUser(user).
+			SetEvent(p.Event).
+			SetEnabled(p.Enabled).
+			SetChannels(p.Channels))
+	}
+	return h.Db.NotificationPreference.CreateBulk(builders...).
+		OnConflictColumns(notificationpreference.FieldUser, notificationpreference.FieldEvent).
+		UpdateNewValues().
+		Exec(ctx)
+}
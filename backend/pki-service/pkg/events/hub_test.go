@@ -0,0 +1,189 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/hm-edu/pki-service/ent"
+	"github.com/hm-edu/pki-service/ent/enttest"
+	"github.com/hm-edu/pki-service/pkg/notify"
+	pb "github.com/hm-edu/portal-apis"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+
+	// Importing the go-sqlite3 is required to create a sqlite3 database.
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// recorder collects the recipients of the payloads posted to a webhook.
+type recorder struct {
+	mu         sync.Mutex
+	recipients [][]string
+}
+
+func (r *recorder) ServeHTTP(_ http.ResponseWriter, req *http.Request) {
+	var payload struct {
+		Recipients []string `json:"recipients"`
+	}
+	_ = json.NewDecoder(req.Body).Decode(&payload)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.recipients = append(r.recipients, payload.Recipients)
+}
+
+func dispatcher(t *testing.T, ops, chat string) *notify.Dispatcher {
+	path := filepath.Join(t.TempDir(), "notifications.yaml")
+	content := "channels:\n" +
+		"  - {name: ops, type: webhook, url: " + ops + "}\n" +
+		"  - {name: chat, type: webhook, url: " + chat + ", events: [domain_approved]}\n"
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := notify.Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return notify.New(cfg)
+}
+
+func TestHub(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:events?mode=memory&cache=shared&_fk=1")
+	defer func(*ent.Client) {
+		_ = client.Close()
+	}(client)
+	ctx := context.Background()
+	ops, chat := &recorder{}, &recorder{}
+	opsServer, chatServer := httptest.NewServer(ops), httptest.NewServer(chat)
+	defer opsServer.Close()
+	defer chatServer.Close()
+	hub := &Hub{Db: client, Dispatcher: dispatcher(t, opsServer.URL, chatServer.URL), Logger: zap.L()}
+
+	preferences, err := hub.Preferences(ctx, "jane@hm.edu")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(preferences) != len(notify.Events) || !preferences[0].Enabled || preferences[0].Channels != nil {
+		t.Fatalf("expected all events to be enabled by default, got %+v", preferences)
+	}
+	if !slices.Equal(hub.Channels(), []string{"ops", "chat"}) {
+		t.Errorf("unexpected channels %v", hub.Channels())
+	}
+
+	for _, invalid := range []Preference{
+		{Event: "unknown", Enabled: true},
+		{Event: notify.EventDomainCreated, Enabled: true, Channels: []string{"chat"}},
+	} {
+		if err := hub.UpdatePreferences(ctx, "jane@hm.edu", []Preference{invalid}); !errors.Is(err, ErrInvalidPreference) {
+			t.Errorf("expected invalid preference for %+v, got %v", invalid, err)
+		}
+	}
+	if err := hub.UpdatePreferences(ctx, "Jane@hm.edu", []Preference{{Event: notify.EventDomainApproved, Enabled: true}}); err != nil {
+		t.Fatal(err)
+	}
+	// Stored preferences are replaced.
+	if err := hub.UpdatePreferences(ctx, "jane@hm.edu", []Preference{{Event: notify.EventDomainApproved, Enabled: true, Channels: []string{"chat"}}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := hub.UpdatePreferences(ctx, "john@hm.edu", []Preference{{Event: notify.EventDomainApproved, Enabled: false}}); err != nil {
+		t.Fatal(err)
+	}
+	preferences, err = hub.Preferences(ctx, "jane@hm.edu")
+	if err != nil {
+		t.Fatal(err)
+	}
+	approved := preferences[slices.IndexFunc(preferences, func(p Preference) bool { return p.Event == notify.EventDomainApproved })]
+	if !slices.Equal(approved.Channels, []string{"chat"}) {
+		t.Errorf("unexpected preference %+v", approved)
+	}
+
+	err = hub.Deliver(ctx, Event{
+		Type:       notify.EventDomainApproved,
+		Actor:      "admin@hm.edu",
+		Subject:    "example.org",
+		Recipients: []string{"jane@hm.edu", "John@hm.edu", "max@hm.edu", "MAX@hm.edu", "", "anna@hm.edu"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Jane only uses the chat, John disabled the event and Max and Anna use
+	// all channels. Every recipient is notified separately.
+	if !slices.EqualFunc(ops.recipients, [][]string{{"max@hm.edu"}, {"anna@hm.edu"}}, slices.Equal) {
+		t.Errorf("unexpected ops recipients %v", ops.recipients)
+	}
+	if !slices.EqualFunc(chat.recipients, [][]string{{"jane@hm.edu"}, {"max@hm.edu"}, {"anna@hm.edu"}}, slices.Equal) {
+		t.Errorf("unexpected chat recipients %v", chat.recipients)
+	}
+	if err := hub.Deliver(ctx, Event{Type: "unknown"}); err == nil {
+		t.Error("expected error for unknown event")
+	}
+}
+
+func TestPublish(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:eventspublish?mode=memory&cache=shared&_fk=1")
+	defer func(*ent.Client) {
+		_ = client.Close()
+	}(client)
+	ops, chat := &recorder{}, &recorder{}
+	opsServer, chatServer := httptest.NewServer(ops), httptest.NewServer(chat)
+	defer opsServer.Close()
+	defer chatServer.Close()
+	hub := &Hub{Db: client, Dispatcher: dispatcher(t, opsServer.URL, chatServer.URL), Logger: zap.L()}
+
+	hub.Start()
+	for range 10 {
+		hub.Publish(context.Background(), Event{Type: notify.EventDomainCreated, Subject: "example.org", Recipients: []string{"jane@hm.edu"}})
+	}
+	// Stopping waits for the queued events.
+	hub.Stop()
+	ops.mu.Lock()
+	delivered := len(ops.recipients)
+	ops.mu.Unlock()
+	if delivered != 10 {
+		t.Errorf("expected all queued events to be delivered, got %d", delivered)
+	}
+
+	// Events are delivered immediately once the hub is stopped.
+	hub.Publish(context.Background(), Event{Type: notify.EventDomainCreated, Subject: "example.org", Recipients: []string{"jane@hm.edu"}})
+	if len(ops.recipients) != 11 {
+		t.Errorf("expected the event to be delivered, got %d", len(ops.recipients))
+	}
+}
+
+// contactsClient answers ListDomainContacts from a static map.
+type contactsClient struct {
+	pb.DomainServiceClient
+	contacts map[string]*pb.DomainContacts
+}
+
+func (c *contactsClient) ListDomainContacts(_ context.Context, req *pb.ListDomainContactsRequest, _ ...grpc.CallOption) (*pb.ListDomainContactsResponse, error) {
+	resp := &pb.ListDomainContactsResponse{}
+	for _, name := range req.Domains {
+		if contacts, ok := c.contacts[name]; ok {
+			resp.Contacts = append(resp.Contacts, contacts)
+		}
+	}
+	return resp, nil
+}
+
+func TestCertificateRecipients(t *testing.T) {
+	hub := &Hub{Logger: zap.L()}
+	recipients := hub.CertificateRecipients(context.Background(), "jane@hm.edu (ACME)", []string{"www.example.org"})
+	if !slices.Equal(recipients, []string{"jane@hm.edu"}) {
+		t.Errorf("expected the requester only, got %v", recipients)
+	}
+	hub.Domains = &contactsClient{contacts: map[string]*pb.DomainContacts{
+		"www.example.org": {Domain: "www.example.org", Owner: "max@hm.edu", Delegates: []string{"john@hm.edu"}, Contacts: []string{"ops@hm.edu"}},
+	}}
+	recipients = hub.CertificateRecipients(context.Background(), "jane@hm.edu", []string{"www.example.org", "example.net"})
+	if !slices.Equal(recipients, []string{"jane@hm.edu", "max@hm.edu", "john@hm.edu"}) {
+		t.Errorf("unexpected recipients %v", recipients)
+	}
+}
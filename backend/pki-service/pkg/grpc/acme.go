@@ -0,0 +1,262 @@
+package grpc
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/getsentry/sentry-go"
+
+	"github.com/hm-edu/pki-service/ent"
+	"github.com/hm-edu/pki-service/ent/acmeorder"
+	"github.com/hm-edu/pki-service/ent/certificate"
+	"github.com/hm-edu/pki-service/pkg/acme"
+	pkiHelper "github.com/hm-edu/pki-service/pkg/helper"
+	"github.com/hm-edu/pki-service/pkg/notify"
+	pb "github.com/hm-edu/portal-apis"
+
+	"go.uber.org/zap"
+)
+
+// acmeQueueInterval is the interval in which queued ACME requests are retried.
+const acmeQueueInterval = 15 * time.Minute
+
+func (s *sslAPIServer) rateLimits() acme.RateLimits {
+	return acme.RateLimits{
+		Window:                s.cfg.AcmeRateLimitWindow,
+		CertificatesPerDomain: s.cfg.AcmeCertificatesPerDomain,
+		DuplicateCertificates: s.cfg.AcmeDuplicateCertificates,
+	}
+}
+
+// queueAcmeRequests reports whether requests hitting an ACME rate limit are
+// queued instead of being issued by HARICA.
+func (s *sslAPIServer) queueAcmeRequests() bool {
+	return s.cfg.AcmeRateLimitFallback == "queue"
+}
+
+// predictAcmeRateLimit checks the recorded ACME orders and reports whether a
+// new order for the given names would hit a rate limit of the ACME CA.
+func (s *sslAPIServer) predictAcmeRateLimit(ctx context.Context, sans []string) (*acme.Prediction, error) {
+	now := time.Now()
+	limits := s.rateLimits()
+	set := acme.IdentifierSet(sans)
+
+	orders, err := s.db.AcmeOrder.Query().
+		Where(acmeorder.Or(
+			acmeorder.CreateTimeGT(now.Add(-limits.Window)),
+			acmeorder.RetryAfterGT(now),
+		)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	renewal, err := s.db.AcmeOrder.Query().
+		Where(acmeorder.Identifiers(set), acmeorder.StatusEQ(acmeorder.StatusValid)).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	previous := make([]acme.Order, 0, len(orders))
+	for _, o := range orders {
+		previous = append(previous, acme.Order{
+			Identifiers:       o.Identifiers,
+			RegisteredDomains: o.RegisteredDomains,
+			Valid:             o.Status == acmeorder.StatusValid,
+			Created:           o.CreateTime,
+			RetryAfter:        o.RetryAfter,
+		})
+	}
+	return limits.Predict(sans, previous, renewal, now), nil
+}
+
+// obtainAcmeCertificate places an ACME order for the given certificate and
+// records the outcome of the order. Rate limit errors are returned as
+// *acme.RateLimitError so the caller can fall back to another CA.
+func (s *sslAPIServer) obtainAcmeCertificate(ctx context.Context, entry *ent.Certificate, sans []string, csr *x509.CertificateRequest) ([]byte, error) {
+	order, err := s.db.AcmeOrder.Create().
+		SetIdentifiers(acme.IdentifierSet(sans)).
+		SetRegisteredDomains(acme.RegisteredDomains(sans)).
+		SetCertificateID(entry.ID).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	certPEM, err := s.acme.ObtainForCSR(ctx, csr)
+
+	update := s.db.AcmeOrder.UpdateOneID(order.ID)
+	var limited *acme.RateLimitError
+	switch {
+	case err == nil:
+		update.SetStatus(acmeorder.StatusValid)
+	case errors.As(err, &limited):
+		update.SetStatus(acmeorder.StatusRateLimited).
+			SetRetryAfter(time.Now().Add(limited.RetryAfter)).
+			SetError(limited.Detail)
+	default:
+		update.SetStatus(acmeorder.StatusInvalid).SetError(err.Error())
+	}
+	if errUpdate := update.Exec(ctx); errUpdate != nil {
+		s.logger.Error("Error while recording ACME order", zap.Int("order", order.ID), zap.Error(errUpdate))
+	}
+	return certPEM, err
+}
+
+// queueAcmeCertificate keeps the certificate request for a later ACME order.
+// The returned transaction id can be used to collect the certificate.
+func (s *sslAPIServer) queueAcmeCertificate(ctx context.Context, logger *zap.Logger, hub *sentry.Hub, entry *ent.Certificate, csr string, reason string) (*pb.IssueSslResponse, error) {
+	transactionID := fmt.Sprintf("acme-%d", entry.ID)
+	_, err := s.db.Certificate.UpdateOneID(entry.ID).
+		SetCsr(csr).
+		SetCaReason(reason).
+		SetTransactionId(transactionID).
+		Save(ctx)
+	if err != nil {
+		return s.handleError("Error while queueing certificate", err, logger, hub)
+	}
+	logger.Info("ACME rate limit reached. Certificate request queued", zap.String("reason", reason), zap.String("transaction_id", transactionID))
+	return &pb.IssueSslResponse{TransactionId: transactionID}, nil
+}
+
+// storeAcmeCertificate persists the certificate obtained via ACME.
+func (s *sslAPIServer) storeAcmeCertificate(ctx context.Context, entry *ent.Certificate, certPEM []byte, start time.Time) ([]*x509.Certificate, error) {
+	stop := time.Now()
+	duration := stop.Sub(start)
+	s.duration = &duration
+	s.last = &stop
+	certs, err := pkiHelper.ParseCertificates(certPEM)
+	if err != nil {
+		return nil, err
+	}
+	leaf := certs[0]
+	serial := fmt.Sprintf("%032x", leaf.SerialNumber)
+	s.logger.Info("Certificate issued",
+		zap.Int("id", entry.ID),
+		zap.Duration("duration", duration),
+		zap.String("serial", serial))
+
+	entry, err = s.db.Certificate.UpdateOneID(entry.ID).
+		SetSerial(pkiHelper.NormalizeSerial(serial)).
+		SetStatus(certificate.StatusIssued).
+		SetNotAfter(leaf.NotAfter).
+		SetNotBefore(leaf.NotBefore).
+		SetCreated(stop).
+		SetCertificate(string(certPEM)).
+		ClearCsr().
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s.publishCertificate(ctx, notify.EventCertificateIssued, entry, "", nil)
+	return certs, nil
+}
+
+// giveUpAcmeCertificate marks a queued certificate request as invalid once
+// the configured number of ACME orders failed for reasons other than rate
+// limits. The last error is kept as reason for the requester.
+func (s *sslAPIServer) giveUpAcmeCertificate(ctx context.Context, logger *zap.Logger, entry *ent.Certificate, cause error) error {
+	if s.cfg.AcmeQueueMaxAttempts <= 0 {
+		return nil
+	}
+	attempts, err := s.db.AcmeOrder.Query().
+		Where(acmeorder.HasCertificateWith(certificate.ID(entry.ID)), acmeorder.StatusEQ(acmeorder.StatusInvalid)).
+		Count(ctx)
+	if err != nil {
+		return err
+	}
+	if attempts < s.cfg.AcmeQueueMaxAttempts {
+		return nil
+	}
+	reason := fmt.Sprintf("ACME order failed %d times: %v", attempts, cause)
+	logger.Error("Giving up queued certificate", zap.Int("attempts", attempts), zap.Error(cause))
+	return s.db.Certificate.UpdateOneID(entry.ID).
+		SetStatus(certificate.StatusInvalid).
+		SetCaReason(reason).
+		ClearCsr().
+		Exec(ctx)
+}
+
+// processAcmeQueue places the ACME orders of all queued certificate requests
+// that are no longer expected to hit a rate limit.
+func (s *sslAPIServer) processAcmeQueue(ctx context.Context) error {
+	queued, err := s.db.Certificate.Query().
+		WithDomains().
+		Where(
+			certificate.Ca("letsencrypt"),
+			certificate.StatusEQ(certificate.StatusRequested),
+			certificate.CsrNotNil(),
+		).
+		Order(ent.Asc(certificate.FieldCreateTime)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, entry := range queued {
+		logger := s.logger.With(zap.Int("id", entry.ID), zap.String("transaction_id", entry.TransactionId))
+		sans := make([]string, 0, len(entry.Edges.Domains))
+		for _, d := range entry.Edges.Domains {
+			sans = append(sans, d.Fqdn)
+		}
+		prediction, err := s.predictAcmeRateLimit(ctx, sans)
+		if err != nil {
+			return err
+		}
+		if prediction != nil {
+			logger.Debug("Queued certificate still rate limited", zap.String("reason", prediction.Reason), zap.Time("until", prediction.Until))
+			continue
+		}
+		block, _ := pem.Decode([]byte(*entry.Csr))
+		if block == nil {
+			logger.Error("Queued certificate has an invalid CSR")
+			continue
+		}
+		csr, err := x509.ParseCertificateRequest(block.Bytes)
+		if err != nil {
+			logger.Error("Queued certificate has an invalid CSR", zap.Error(err))
+			continue
+		}
+		logger.Info("Ordering queued certificate")
+		certPEM, err := s.obtainAcmeCertificate(ctx, entry, sans, csr)
+		var limited *acme.RateLimitError
+		if errors.As(err, &limited) {
+			logger.Warn("Ordering queued certificate rate limited", zap.Error(err))
+			continue
+		}
+		if err != nil {
+			logger.Warn("Ordering queued certificate failed", zap.Error(err))
+			if errGiveUp := s.giveUpAcmeCertificate(ctx, logger, entry, err); errGiveUp != nil {
+				logger.Error("Error while giving up queued certificate", zap.Error(errGiveUp))
+			}
+			continue
+		}
+		if _, err := s.storeAcmeCertificate(ctx, entry, certPEM, entry.CreateTime); err != nil {
+			logger.Error("Error while saving queued certificate", zap.Error(err))
+		}
+	}
+	return nil
+}
+
+// runAcmeQueue periodically processes the queued ACME requests until stopCh
+// is closed.
+func (s *sslAPIServer) runAcmeQueue(stopCh <-chan struct{}) {
+	ticker := time.NewTicker(acmeQueueInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stopCh:
+			return
+		case <-ticker.C:
+			if err := s.processAcmeQueue(context.Background()); err != nil {
+				s.logger.Error("Error while processing queued ACME requests", zap.Error(err))
+			}
+		}
+	}
+}
+
+// acmeCertType is the certificate type issued by ACME CAs.
+const acmeCertType = "DV"
@@ -0,0 +1,150 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	harica "github.com/hm-edu/harica/client"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultProbeInterval is used if no probe interval is configured.
+const defaultProbeInterval = 30 * time.Second
+
+// errHaricaUnavailable is returned without contacting HARICA while the
+// circuit breaker is open.
+var errHaricaUnavailable = errors.New("HARICA is temporarily unavailable")
+
+// circuitBreaker stops sending requests to HARICA after a number of
+// consecutive failures that indicate an outage (network errors, 5xx). While
+// the breaker is open, all requests fail fast and the recovery is probed in
+// the background; the first successful probe closes the breaker again.
+type circuitBreaker struct {
+	threshold     int
+	probeInterval time.Duration
+	// probe checks whether HARICA is available again.
+	probe func() error
+	// onChange is called whenever the breaker opens or closes.
+	onChange func(open bool)
+	logger   *zap.Logger
+
+	mu       sync.Mutex
+	failures int
+	open     bool
+}
+
+// Allow returns errHaricaUnavailable while the breaker is open. A nil breaker
+// always allows requests.
+func (b *circuitBreaker) Allow() error {
+	if b == nil {
+		return nil
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.open {
+		return errHaricaUnavailable
+	}
+	return nil
+}
+
+// Open reports whether the breaker is currently open.
+func (b *circuitBreaker) Open() bool {
+	return b.Allow() != nil
+}
+
+// Record records the result of a single HARICA request. Only errors
+// indicating an outage are counted; client errors (e.g. a certificate that
+// is not issued yet) are ignored.
+func (b *circuitBreaker) Record(err error) {
+	if b == nil {
+		return
+	}
+	if err != nil && !isUnavailableError(err) {
+		return
+	}
+	b.mu.Lock()
+	if err == nil {
+		b.failures = 0
+		b.mu.Unlock()
+		return
+	}
+	b.failures++
+	failures := b.failures
+	opened := !b.open && b.threshold > 0 && failures >= b.threshold
+	if opened {
+		b.open = true
+	}
+	b.mu.Unlock()
+
+	if opened {
+		b.logger.Warn("HARICA circuit breaker opened", zap.Int("failures", failures), zap.Error(err))
+		b.notify(true)
+		go b.recover()
+	}
+}
+
+// recover probes HARICA until it is available again and closes the breaker.
+func (b *circuitBreaker) recover() {
+	interval := b.probeInterval
+	if interval <= 0 {
+		interval = defaultProbeInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		if err := b.probe(); err != nil {
+			b.logger.Info("HARICA still unavailable", zap.Error(err))
+			continue
+		}
+		b.mu.Lock()
+		b.open = false
+		b.failures = 0
+		b.mu.Unlock()
+		b.logger.Info("HARICA available again, circuit breaker closed")
+		b.notify(false)
+		return
+	}
+}
+
+func (b *circuitBreaker) notify(open bool) {
+	if b.onChange != nil {
+		b.onChange(open)
+	}
+}
+
+// isUnavailableError reports whether an error indicates that HARICA itself is
+// unavailable (in contrast to a rejected request). Only transport errors,
+// timeouts and 5xx responses count; validation errors, rate limiting and any
+// other error are attributed to the request.
+func isUnavailableError(err error) bool {
+	if errors.Is(err, errHaricaUnavailable) || errors.Is(err, context.Canceled) {
+		return false
+	}
+	var codeErr *harica.UnexpectedResponseCodeError
+	if errors.As(err, &codeErr) {
+		return codeErr.Code >= http.StatusInternalServerError || codeErr.Code == http.StatusRequestTimeout
+	}
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+// haricaError maps an error of a HARICA request to a gRPC status. While the
+// circuit breaker is open, Unavailable is returned so callers can report the
+// outage instead of a generic error.
+func haricaError(err error, msg string) error {
+	if errors.Is(err, errHaricaUnavailable) {
+		return status.Error(codes.Unavailable, "CA temporarily unavailable")
+	}
+	return status.Error(codes.Internal, msg)
+}
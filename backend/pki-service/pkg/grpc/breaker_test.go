@@ -0,0 +1,95 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	harica "github.com/hm-edu/harica/client"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	var available atomic.Bool
+	changes := make(chan bool, 2)
+	b := &circuitBreaker{
+		threshold:     3,
+		probeInterval: 10 * time.Millisecond,
+		probe: func() error {
+			if available.Load() {
+				return nil
+			}
+			return errors.New("still down")
+		},
+		onChange: func(open bool) { changes <- open },
+		logger:   zap.NewNop(),
+	}
+
+	outage := &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+	pending := &harica.UnexpectedResponseCodeError{Code: http.StatusNotFound}
+
+	b.Record(outage)
+	b.Record(outage)
+	b.Record(nil)
+	b.Record(outage)
+	b.Record(pending)
+	b.Record(outage)
+	if err := b.Allow(); err != nil {
+		t.Fatal("expected breaker to be closed after a success reset the failures, got", err)
+	}
+	b.Record(&harica.UnexpectedResponseCodeError{Code: http.StatusBadGateway})
+	if err := b.Allow(); !errors.Is(err, errHaricaUnavailable) {
+		t.Fatal("expected breaker to be open, got", err)
+	}
+	if open := <-changes; !open {
+		t.Fatal("expected open notification")
+	}
+	if status.Code(haricaError(b.Allow(), "failed")) != codes.Unavailable {
+		t.Error("expected Unavailable while the breaker is open")
+	}
+
+	available.Store(true)
+	select {
+	case open := <-changes:
+		if open {
+			t.Fatal("expected close notification")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("breaker was not closed after a successful probe")
+	}
+	if err := b.Allow(); err != nil {
+		t.Error("expected breaker to be closed, got", err)
+	}
+}
+
+func TestIsUnavailableError(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want bool
+	}{
+		"network":    {&url.Error{Op: "Post", URL: "https://cm.harica.gr", Err: &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}}, true},
+		"timeout":    {fmt.Errorf("request: %w", context.DeadlineExceeded), true},
+		"eof":        {io.ErrUnexpectedEOF, true},
+		"5xx":        {&harica.UnexpectedResponseCodeError{Code: http.StatusServiceUnavailable}, true},
+		"4xx":        {&harica.UnexpectedResponseCodeError{Code: http.StatusBadRequest}, false},
+		"auth":       {&harica.UnexpectedResponseCodeError{Code: http.StatusUnauthorized}, false},
+		"rate limit": {&harica.UnexpectedResponseCodeError{Code: http.StatusTooManyRequests}, false},
+		"validation": {errors.New("invalid CSR"), false},
+		"canceled":   {context.Canceled, false},
+		"open":       {errHaricaUnavailable, false},
+	}
+	for name, c := range cases {
+		if got := isUnavailableError(c.err); got != c.want {
+			t.Errorf("%s: expected %v, got %v", name, c.want, got)
+		}
+	}
+}
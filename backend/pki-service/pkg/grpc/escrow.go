@@ -0,0 +1,510 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/getsentry/sentry-go"
+	harica "github.com/hm-edu/harica/client"
+	"github.com/hm-edu/pki-service/ent"
+	"github.com/hm-edu/pki-service/ent/keyescrow"
+	"github.com/hm-edu/pki-service/ent/keyrecovery"
+	"github.com/hm-edu/pki-service/ent/predicate"
+	"github.com/hm-edu/pki-service/ent/smimecertificate"
+	"github.com/hm-edu/pki-service/pkg/escrow"
+	pkiHelper "github.com/hm-edu/pki-service/pkg/helper"
+	pb "github.com/hm-edu/portal-apis"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Actions recorded in the escrow audit log.
+const (
+	auditEscrowed  = "escrowed"
+	auditRequested = "recovery_requested"
+	auditApproved  = "recovery_approved"
+	auditRejected  = "recovery_rejected"
+	auditReleased  = "recovery_released"
+	auditReset     = "recovery_reset"
+	auditDenied    = "access_denied"
+)
+
+// audit records an action on an escrowed key. Failures are only logged; the
+// action itself is logged in any case.
+func (s *smimeAPIServer) audit(ctx context.Context, logger *zap.Logger, action, actor, serial string, recovery int, details string) {
+	logger.Info("Key escrow audit",
+		zap.String("action", action),
+		zap.String("actor", actor),
+		zap.String("serial", serial),
+		zap.Int("recovery_id", recovery),
+		zap.String("details", details))
+	err := s.db.EscrowAudit.Create().
+		SetAction(action).
+		SetActor(actor).
+		SetSerial(serial).
+		SetRecoveryId(recovery).
+		SetDetails(details).
+		Exec(ctx)
+	if err != nil {
+		logger.Error("Error writing escrow audit log", zap.Error(err))
+	}
+}
+
+// saveCertificate stores an issued certificate together with its sealed
+// key in one transaction, so no certificate is stored without its escrowed
+// key.
+func (s *smimeAPIServer) saveCertificate(ctx context.Context, logger *zap.Logger, sealed *escrow.Sealed, create func(db *ent.Client) (*ent.SmimeCertificate, error)) (*ent.SmimeCertificate, error) {
+	if sealed == nil {
+		return create(s.db)
+	}
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cert, err := create(tx.Client())
+	if err == nil {
+		err = storeEscrow(ctx, tx.Client(), cert, sealed)
+	}
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			logger.Error("Error rolling back transaction", zap.Error(rerr))
+		}
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	cert = cert.Unwrap()
+	s.audit(ctx, logger, auditEscrowed, cert.Email, cert.Serial, 0, sealed.Mode)
+	return cert, nil
+}
+
+// storeEscrow stores the sealed key of the given certificate.
+func storeEscrow(ctx context.Context, db *ent.Client, cert *ent.SmimeCertificate, sealed *escrow.Sealed) error {
+	create := db.KeyEscrow.Create().
+		SetMode(keyescrow.Mode(sealed.Mode)).
+		SetCiphertext(sealed.Ciphertext).
+		SetCertificate(cert)
+	if sealed.WrappedKey != nil {
+		create.SetWrappedKey(sealed.WrappedKey)
+	}
+	if sealed.Shares != nil {
+		create.SetShares(sealed.Shares)
+	}
+	return create.Exec(ctx)
+}
+
+// revokeUnescrowed revokes a certificate whose key could not be escrowed.
+// Failures are only logged and must be resolved manually.
+func (s *smimeAPIServer) revokeUnescrowed(ctx context.Context, logger *zap.Logger, client *harica.Client, transactionID string) {
+	logger = logger.With(zap.String("transaction_id", transactionID))
+	const comment = "Key escrow failed"
+	reason, err := s.revocationReason(ctx, logger, client)
+	if err == nil {
+		err = retryHaricaVoid(ctx, logger, s.harica.breaker, client, "RevokeSmimeBulkCertificateEntry", func() error {
+			return client.RevokeSmimeBulkCertificateEntry(transactionID, comment, reason.Name)
+		})
+	}
+	if err != nil {
+		sentry.CaptureException(err)
+		logger.Error("Error revoking certificate without escrowed key, revoke it manually", zap.Error(err))
+		return
+	}
+	logger.Warn("Revoked certificate without escrowed key")
+}
+
+// recoveryShares holds the decrypted shares the officers submitted for
+// pending and approved recoveries (split mode). The shares are only kept in
+// memory and never stored, so the officers have to approve a recovery again
+// after a restart.
+type recoveryShares struct {
+	mu     sync.Mutex
+	shares map[int]map[string][]byte
+}
+
+// get returns a copy of the shares submitted for the recovery.
+func (r *recoveryShares) get(id int) map[string][]byte {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	shares := map[string][]byte{}
+	for officer, share := range r.shares[id] {
+		shares[officer] = share
+	}
+	return shares
+}
+
+func (r *recoveryShares) add(id int, officer string, share []byte) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.shares == nil {
+		r.shares = map[int]map[string][]byte{}
+	}
+	if r.shares[id] == nil {
+		r.shares[id] = map[string][]byte{}
+	}
+	r.shares[id][officer] = share
+}
+
+func (r *recoveryShares) remove(id int, officer string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.shares[id], officer)
+}
+
+func (r *recoveryShares) drop(id int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.shares, id)
+}
+
+// approvals returns the approvals of the recovery that are still valid. In
+// split mode, approvals whose share is no longer available (e.g. after a
+// restart) do not count and the officer must approve again.
+func (s *smimeAPIServer) approvals(x *ent.KeyRecovery, shares map[string][]byte) []string {
+	if x.Edges.Escrow == nil || x.Edges.Escrow.Mode != keyescrow.ModeSplit || x.Status != keyrecovery.StatusPending {
+		return x.Approvals
+	}
+	return slices.DeleteFunc(slices.Clone(x.Approvals), func(officer string) bool {
+		_, ok := shares[officer]
+		return !ok
+	})
+}
+
+func (s *smimeAPIServer) mapRecovery(x *ent.KeyRecovery, user string) *pb.KeyRecovery {
+	approvals := s.approvals(x, s.shares.get(x.ID))
+	r := &pb.KeyRecovery{
+		Id:          int32(x.ID),
+		RequestedBy: x.RequestedBy,
+		Reason:      x.Reason,
+		Status:      string(x.Status),
+		Approvals:   approvals,
+		Created:     timestamppb.New(x.CreateTime),
+	}
+	if e := x.Edges.Escrow; e != nil {
+		if c := e.Edges.Certificate; c != nil {
+			r.Serial = c.Serial
+			r.Email = c.Email
+		}
+		// Officers decrypt their share offline before approving.
+		if share, ok := e.Shares[strings.ToLower(user)]; ok && x.Status == keyrecovery.StatusPending && !slices.Contains(approvals, strings.ToLower(user)) {
+			r.EncryptedShare = share
+		}
+	}
+	return r
+}
+
+// resetRecovery returns a recovery to pending and removes its approvals, so
+// that the officers have to approve it again. It is used if the key cannot be
+// recovered with the submitted shares or the shares are no longer
+// available.
+func (s *smimeAPIServer) resetRecovery(ctx context.Context, logger *zap.Logger, recovery *ent.KeyRecovery, actor, details string) error {
+	s.shares.drop(recovery.ID)
+	err := s.db.KeyRecovery.UpdateOne(recovery).
+		Where(keyrecovery.StatusEQ(recovery.Status), approvalsCount(len(recovery.Approvals))).
+		SetStatus(keyrecovery.StatusPending).
+		ClearApprovals().
+		ClearDecidedBy().
+		Exec(ctx)
+	if ent.IsNotFound(err) {
+		return errConcurrentDecision
+	}
+	if err != nil {
+		logger.Error("Error resetting recovery", zap.Error(err))
+		return status.Error(codes.Internal, "Error updating recovery")
+	}
+	s.audit(ctx, logger, auditReset, actor, recovery.Edges.Escrow.Edges.Certificate.Serial, recovery.ID, details)
+	return nil
+}
+
+var errConcurrentDecision = status.Error(codes.Aborted, "The recovery was decided concurrently, please retry")
+
+// approvalsCount matches recoveries with exactly n approvals.
+func approvalsCount(n int) predicate.KeyRecovery {
+	count := predicate.KeyRecovery(func(s *sql.Selector) {
+		s.Where(sqljson.LenEQ(s.C(keyrecovery.FieldApprovals), n))
+	})
+	if n == 0 {
+		return keyrecovery.Or(keyrecovery.ApprovalsIsNil(), count)
+	}
+	return count
+}
+
+// sealedKey returns the escrowed key in the form expected by the escrow
+// configuration.
+func sealedKey(e *ent.KeyEscrow) *escrow.Sealed {
+	return &escrow.Sealed{
+		Mode:       string(e.Mode),
+		Ciphertext: e.Ciphertext,
+		WrappedKey: e.WrappedKey,
+	}
+}
+
+func (s *smimeAPIServer) escrowEnabled() error {
+	if s.escrow == nil {
+		return status.Error(codes.Unimplemented, "Key escrow is disabled")
+	}
+	return nil
+}
+
+// RequestKeyRecovery starts the recovery of an escrowed key. Recoveries can
+// be requested by the owner of the certificate or a recovery officer.
+func (s *smimeAPIServer) RequestKeyRecovery(ctx context.Context, req *pb.RequestKeyRecoveryRequest) (*pb.KeyRecovery, error) {
+	if err := s.escrowEnabled(); err != nil {
+		return nil, err
+	}
+	logger := s.logger.With(zap.String("serial", req.Serial), zap.String("user", req.RequestedBy))
+	cert, err := s.db.SmimeCertificate.Query().
+		Where(smimecertificate.Serial(req.Serial)).
+		WithKeyEscrow().
+		First(ctx)
+	if err != nil || cert.Edges.KeyEscrow == nil {
+		return nil, status.Error(codes.NotFound, "No escrowed key found for this certificate")
+	}
+	if !strings.EqualFold(cert.Email, req.RequestedBy) && !s.escrow.IsOfficer(req.RequestedBy) {
+		s.audit(ctx, logger, auditDenied, req.RequestedBy, cert.Serial, 0, "request")
+		return nil, status.Error(codes.PermissionDenied, "Only the owner or a recovery officer can request a recovery")
+	}
+	open, err := cert.Edges.KeyEscrow.QueryRecoveries().
+		Where(keyrecovery.StatusIn(keyrecovery.StatusPending, keyrecovery.StatusApproved)).
+		Exist(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Error querying recoveries")
+	}
+	if open {
+		return nil, status.Error(codes.AlreadyExists, "A recovery is already in progress for this certificate")
+	}
+	recovery, err := s.db.KeyRecovery.Create().
+		SetRequestedBy(strings.ToLower(req.RequestedBy)).
+		SetReason(req.Reason).
+		SetEscrow(cert.Edges.KeyEscrow).
+		Save(ctx)
+	if err != nil {
+		sentry.CaptureException(err)
+		logger.Error("Error creating recovery", zap.Error(err))
+		return nil, status.Error(codes.Internal, "Error creating recovery")
+	}
+	s.audit(ctx, logger, auditRequested, req.RequestedBy, cert.Serial, recovery.ID, req.Reason)
+	cert.Edges.KeyEscrow.Edges.Certificate = cert
+	recovery.Edges.Escrow = cert.Edges.KeyEscrow
+	return s.mapRecovery(recovery, req.RequestedBy), nil
+}
+
+// ListKeyRecoveries lists the recoveries visible to the given user. Officers
+// see all recoveries, all other users only the recoveries of their own
+// certificates.
+func (s *smimeAPIServer) ListKeyRecoveries(ctx context.Context, req *pb.ListKeyRecoveriesRequest) (*pb.ListKeyRecoveriesResponse, error) {
+	if err := s.escrowEnabled(); err != nil {
+		return nil, err
+	}
+	query := s.db.KeyRecovery.Query().
+		WithEscrow(func(q *ent.KeyEscrowQuery) { q.WithCertificate() }).
+		Order(ent.Desc(keyrecovery.FieldCreateTime))
+	if !s.escrow.IsOfficer(req.User) {
+		query = query.Where(keyrecovery.HasEscrowWith(keyescrow.HasCertificateWith(smimecertificate.EmailEqualFold(req.User))))
+	}
+	recoveries, err := query.All(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Error querying recoveries")
+	}
+	items := make([]*pb.KeyRecovery, 0, len(recoveries))
+	for _, r := range recoveries {
+		items = append(items, s.mapRecovery(r, req.User))
+	}
+	return &pb.ListKeyRecoveriesResponse{Items: items}, nil
+}
+
+// ApproveKeyRecovery approves or rejects a recovery. Only officers that
+// neither requested the recovery nor own the certificate can approve it; a
+// recovery is approved once the configured number of distinct officers
+// approved it (dual control). In split mode, every officer passes the
+// decrypted share; the shares are checked by recovering the key once enough
+// shares were submitted.
+func (s *smimeAPIServer) ApproveKeyRecovery(ctx context.Context, req *pb.ApproveKeyRecoveryRequest) (*pb.KeyRecovery, error) {
+	if err := s.escrowEnabled(); err != nil {
+		return nil, err
+	}
+	officer := strings.ToLower(req.Officer)
+	logger := s.logger.With(zap.Int32("recovery_id", req.Id), zap.String("officer", officer))
+	recovery, err := s.db.KeyRecovery.Query().
+		Where(keyrecovery.ID(int(req.Id))).
+		WithEscrow(func(q *ent.KeyEscrowQuery) { q.WithCertificate() }).
+		Only(ctx)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "Recovery not found")
+	}
+	cert := recovery.Edges.Escrow.Edges.Certificate
+	if !s.escrow.IsOfficer(officer) || officer == recovery.RequestedBy || strings.EqualFold(officer, cert.Email) {
+		s.audit(ctx, logger, auditDenied, officer, cert.Serial, recovery.ID, "approve")
+		return nil, status.Error(codes.PermissionDenied, "Only recovery officers that are neither requester nor owner can approve a recovery")
+	}
+	if recovery.Status != keyrecovery.StatusPending {
+		return nil, status.Errorf(codes.FailedPrecondition, "The recovery is already %s", strings.ToLower(string(recovery.Status)))
+	}
+	shares := s.shares.get(recovery.ID)
+	approvals := s.approvals(recovery, shares)
+	if slices.Contains(approvals, officer) {
+		return nil, status.Error(codes.FailedPrecondition, "The recovery was already approved by this officer")
+	}
+
+	// Approvals are only ever appended while a recovery is pending, so the
+	// number of approvals guards against concurrent decisions overwriting
+	// each other.
+	escrowed := recovery.Edges.Escrow
+	update := s.db.KeyRecovery.UpdateOne(recovery).
+		Where(keyrecovery.StatusEQ(keyrecovery.StatusPending), approvalsCount(len(recovery.Approvals)))
+	if req.Reject {
+		recovery, err = update.
+			SetStatus(keyrecovery.StatusRejected).
+			SetDecidedBy(officer).
+			Save(ctx)
+		if ent.IsNotFound(err) {
+			return nil, errConcurrentDecision
+		}
+		if err != nil {
+			return nil, status.Error(codes.Internal, "Error updating recovery")
+		}
+		s.shares.drop(recovery.ID)
+		s.audit(ctx, logger, auditRejected, officer, cert.Serial, recovery.ID, req.Comment)
+		recovery.Edges.Escrow = escrowed
+		return s.mapRecovery(recovery, officer), nil
+	}
+
+	split := escrowed.Mode == keyescrow.ModeSplit
+	if split {
+		if len(req.Share) == 0 {
+			return nil, status.Error(codes.InvalidArgument, "The decrypted share is required")
+		}
+		shares[officer] = req.Share
+	}
+	approvals = append(approvals, officer)
+	update.SetApprovals(approvals)
+	if len(approvals) >= s.escrow.Approvals && (!split || len(shares) >= s.escrow.Threshold) {
+		if split {
+			if _, err := s.escrow.Open(sealedKey(escrowed), slices.Collect(maps.Values(shares))); err != nil {
+				logger.Warn("Submitted shares do not recover the key", zap.Error(err))
+				if err := s.resetRecovery(ctx, logger, recovery, officer, "invalid shares"); err != nil {
+					return nil, err
+				}
+				return nil, status.Error(codes.InvalidArgument, "The submitted shares do not recover the key, all officers must approve the recovery again")
+			}
+		}
+		update.SetStatus(keyrecovery.StatusApproved).SetDecidedBy(officer)
+	}
+	// The share is added before the update, so that a recovery is never
+	// approved without its shares being available.
+	id := recovery.ID
+	if split {
+		s.shares.add(id, officer, req.Share)
+	}
+	recovery, err = update.Save(ctx)
+	if err != nil && split {
+		s.shares.remove(id, officer)
+	}
+	if ent.IsNotFound(err) {
+		return nil, errConcurrentDecision
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Error updating recovery")
+	}
+	s.audit(ctx, logger, auditApproved, officer, cert.Serial, recovery.ID, req.Comment)
+	recovery.Edges.Escrow = escrowed
+	return s.mapRecovery(recovery, officer), nil
+}
+
+// ReleaseKeyRecovery releases the key of an approved recovery as PKCS#12 to
+// the owner of the certificate. Every recovery can only be released once.
+func (s *smimeAPIServer) ReleaseKeyRecovery(ctx context.Context, req *pb.ReleaseKeyRecoveryRequest) (*pb.ReleaseKeyRecoveryResponse, error) {
+	if err := s.escrowEnabled(); err != nil {
+		return nil, err
+	}
+	logger := s.logger.With(zap.Int32("recovery_id", req.Id), zap.String("user", req.User))
+	recovery, err := s.db.KeyRecovery.Query().
+		Where(keyrecovery.ID(int(req.Id))).
+		WithEscrow(func(q *ent.KeyEscrowQuery) { q.WithCertificate() }).
+		Only(ctx)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "Recovery not found")
+	}
+	escrowed := recovery.Edges.Escrow
+	cert := escrowed.Edges.Certificate
+	if !strings.EqualFold(req.User, cert.Email) {
+		s.audit(ctx, logger, auditDenied, req.User, cert.Serial, recovery.ID, "release")
+		return nil, status.Error(codes.PermissionDenied, "The key is only released to the owner of the certificate")
+	}
+	if recovery.Status != keyrecovery.StatusApproved {
+		return nil, status.Errorf(codes.FailedPrecondition, "The recovery is %s", strings.ToLower(string(recovery.Status)))
+	}
+	if req.Pkcs12Password != "" && len(req.Pkcs12Password) < minPkcs12PasswordLength {
+		return nil, status.Errorf(codes.InvalidArgument, "The password must contain at least %d characters", minPkcs12PasswordLength)
+	}
+	if cert.Certificate == nil {
+		return nil, status.Error(codes.FailedPrecondition, "The certificate is not stored")
+	}
+	leaf, err := pkiHelper.ParseCertificates([]byte(*cert.Certificate))
+	if err != nil || len(leaf) == 0 {
+		return nil, status.Error(codes.Internal, "Error parsing certificate")
+	}
+
+	shares := s.shares.get(recovery.ID)
+	if escrowed.Mode == keyescrow.ModeSplit && len(shares) < s.escrow.Threshold {
+		logger.Warn("Shares of approved recovery are no longer available")
+		if err := s.resetRecovery(ctx, logger, recovery, req.User, "shares unavailable"); err != nil {
+			return nil, err
+		}
+		return nil, status.Error(codes.FailedPrecondition, "The shares of the officers are no longer available, the recovery must be approved again")
+	}
+	key, err := s.escrow.Open(sealedKey(escrowed), slices.Collect(maps.Values(shares)))
+	if errors.Is(err, escrow.ErrRecoveryKeyMissing) {
+		return nil, status.Error(codes.FailedPrecondition, "The recovery private key is not available on this instance")
+	}
+	if err != nil {
+		sentry.CaptureException(err)
+		logger.Error("Error recovering key", zap.Error(err))
+		// The recovery must not stay approved, otherwise no further
+		// recovery could be requested for the certificate.
+		if err := s.resetRecovery(ctx, logger, recovery, req.User, "recovery failed"); err != nil {
+			return nil, err
+		}
+		return nil, status.Error(codes.Internal, "Error recovering key, the recovery must be approved again")
+	}
+
+	password := req.Pkcs12Password
+	generated := ""
+	if password == "" {
+		if password, err = randomPassword(); err != nil {
+			return nil, status.Error(codes.Internal, "Error generating password")
+		}
+		generated = password
+	}
+	pfx, err := s.encodePkcs12(key, leaf[0], s.smimeChain(leaf[0]), password)
+	if err != nil {
+		logger.Error("Error encoding PKCS#12", zap.Error(err))
+		return nil, status.Error(codes.Internal, "Error encoding PKCS#12")
+	}
+
+	// Only the release that marks the recovery as released hands out the
+	// key.
+	released, err := s.db.KeyRecovery.Update().
+		Where(keyrecovery.ID(recovery.ID), keyrecovery.StatusEQ(keyrecovery.StatusApproved)).
+		SetStatus(keyrecovery.StatusReleased).
+		SetReleasedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Error updating recovery")
+	}
+	if released == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "The recovery was already released")
+	}
+	s.shares.drop(recovery.ID)
+	s.audit(ctx, logger, auditReleased, req.User, cert.Serial, recovery.ID, "")
+	return &pb.ReleaseKeyRecoveryResponse{Pkcs12: pfx, Pkcs12Password: generated}, nil
+}
@@ -0,0 +1,315 @@
+package grpc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hm-edu/pki-service/ent"
+	"github.com/hm-edu/pki-service/ent/enttest"
+	"github.com/hm-edu/pki-service/pkg/cfg"
+	"github.com/hm-edu/pki-service/pkg/escrow"
+	pb "github.com/hm-edu/portal-apis"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"software.sslmate.com/src/go-pkcs12"
+)
+
+func testEscrowConfig(t *testing.T) *escrow.Config {
+	t.Helper()
+	dir := t.TempDir()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pub, _ := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	files := map[string][]byte{
+		"recovery.pub": pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pub}),
+		"recovery.key": pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}),
+		"escrow.yaml": []byte("mode: wrap\n" +
+			"recovery_key: " + filepath.Join(dir, "recovery.pub") + "\n" +
+			"recovery_private_key: " + filepath.Join(dir, "recovery.key") + "\n" +
+			"officers: [{email: alice@hm.edu}, {email: bob@hm.edu}, {email: carol@hm.edu}]\n"),
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), content, 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	c, err := escrow.Load(filepath.Join(dir, "escrow.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestKeyRecovery(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:escrow?mode=memory&cache=shared&_fk=1")
+	defer func(*ent.Client) {
+		_ = client.Close()
+	}(client)
+	ctx := context.Background()
+	s := &smimeAPIServer{cfg: &cfg.PKIConfiguration{}, db: client, logger: zap.L(), escrow: testEscrowConfig(t)}
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:   big.NewInt(1234),
+		Subject:        pkix.Name{CommonName: "jane.doe@hm.edu"},
+		EmailAddresses: []string{"jane.doe@hm.edu"},
+		NotBefore:      time.Now(),
+		NotAfter:       time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leaf := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	sealed, err := s.escrow.Seal(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = s.saveCertificate(ctx, s.logger, sealed, func(db *ent.Client) (*ent.SmimeCertificate, error) {
+		return db.SmimeCertificate.Create().
+			SetSerial("04d2").
+			SetEmail("jane.doe@hm.edu").
+			SetCertificate(leaf).
+			SetCreated(time.Now()).
+			SetNotAfter(time.Now().Add(time.Hour)).
+			Save(ctx)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	escrowed, err := client.KeyEscrow.Query().Count(ctx)
+	if err != nil || escrowed != 1 {
+		t.Errorf("expected the key to be escrowed, got %d %v", escrowed, err)
+	}
+
+	if _, err := s.RequestKeyRecovery(ctx, &pb.RequestKeyRecoveryRequest{Serial: "04d2", RequestedBy: "eve@hm.edu"}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("expected PermissionDenied, got %v", err)
+	}
+	if _, err := s.RequestKeyRecovery(ctx, &pb.RequestKeyRecoveryRequest{Serial: "ffff", RequestedBy: "jane.doe@hm.edu"}); status.Code(err) != codes.NotFound {
+		t.Errorf("expected NotFound, got %v", err)
+	}
+	recovery, err := s.RequestKeyRecovery(ctx, &pb.RequestKeyRecoveryRequest{Serial: "04d2", RequestedBy: "Jane.Doe@hm.edu", Reason: "lost laptop"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if recovery.Status != "Pending" || recovery.Email != "jane.doe@hm.edu" {
+		t.Errorf("unexpected recovery %+v", recovery)
+	}
+	if _, err := s.RequestKeyRecovery(ctx, &pb.RequestKeyRecoveryRequest{Serial: "04d2", RequestedBy: "jane.doe@hm.edu"}); status.Code(err) != codes.AlreadyExists {
+		t.Errorf("expected AlreadyExists, got %v", err)
+	}
+
+	list, err := s.ListKeyRecoveries(ctx, &pb.ListKeyRecoveriesRequest{User: "john.doe@hm.edu"})
+	if err != nil || len(list.Items) != 0 {
+		t.Errorf("expected no recoveries for other users, got %v %v", list, err)
+	}
+	list, err = s.ListKeyRecoveries(ctx, &pb.ListKeyRecoveriesRequest{User: "alice@hm.edu"})
+	if err != nil || len(list.Items) != 1 {
+		t.Errorf("expected all recoveries for officers, got %v %v", list, err)
+	}
+
+	if _, err := s.ApproveKeyRecovery(ctx, &pb.ApproveKeyRecoveryRequest{Id: recovery.Id, Officer: "jane.doe@hm.edu"}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("expected PermissionDenied for the owner, got %v", err)
+	}
+	if _, err := s.ApproveKeyRecovery(ctx, &pb.ApproveKeyRecoveryRequest{Id: recovery.Id, Officer: "alice@hm.edu"}); err != nil {
+		t.Fatal(err)
+	}
+	// A decision based on the state before the first approval must not
+	// overwrite it.
+	_, err = client.KeyRecovery.UpdateOneID(int(recovery.Id)).
+		Where(approvalsCount(0)).
+		SetApprovals([]string{"bob@hm.edu"}).
+		Save(ctx)
+	if !ent.IsNotFound(err) {
+		t.Errorf("expected stale update to be rejected, got %v", err)
+	}
+	if _, err := s.ApproveKeyRecovery(ctx, &pb.ApproveKeyRecoveryRequest{Id: recovery.Id, Officer: "alice@hm.edu"}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("expected FailedPrecondition for a second approval, got %v", err)
+	}
+	if _, err := s.ReleaseKeyRecovery(ctx, &pb.ReleaseKeyRecoveryRequest{Id: recovery.Id, User: "jane.doe@hm.edu"}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("expected FailedPrecondition before dual control, got %v", err)
+	}
+	approved, err := s.ApproveKeyRecovery(ctx, &pb.ApproveKeyRecoveryRequest{Id: recovery.Id, Officer: "bob@hm.edu"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if approved.Status != "Approved" || len(approved.Approvals) != 2 {
+		t.Errorf("unexpected recovery %+v", approved)
+	}
+
+	if _, err := s.ReleaseKeyRecovery(ctx, &pb.ReleaseKeyRecoveryRequest{Id: recovery.Id, User: "alice@hm.edu"}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("expected PermissionDenied for officers, got %v", err)
+	}
+	released, err := s.ReleaseKeyRecovery(ctx, &pb.ReleaseKeyRecoveryRequest{Id: recovery.Id, User: "jane.doe@hm.edu"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	recovered, _, _, err := pkcs12.DecodeChain(released.Pkcs12, released.Pkcs12Password)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !key.Equal(recovered) {
+		t.Error("released key differs")
+	}
+	if _, err := s.ReleaseKeyRecovery(ctx, &pb.ReleaseKeyRecoveryRequest{Id: recovery.Id, User: "jane.doe@hm.edu"}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("expected FailedPrecondition for a second release, got %v", err)
+	}
+
+	actions, err := client.EscrowAudit.Query().Count(ctx)
+	if err != nil || actions < 7 {
+		t.Errorf("expected audit entries, got %d %v", actions, err)
+	}
+}
+
+func TestKeyRecoverySplit(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:escrowsplit?mode=memory&cache=shared&_fk=1")
+	defer func(*ent.Client) {
+		_ = client.Close()
+	}(client)
+	ctx := context.Background()
+
+	dir := t.TempDir()
+	officers := map[string]*rsa.PrivateKey{}
+	config := "mode: split\nthreshold: 2\nofficers:\n"
+	for _, name := range []string{"alice", "bob", "carol"} {
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		if err != nil {
+			t.Fatal(err)
+		}
+		officers[name+"@hm.edu"] = key
+		pub, _ := x509.MarshalPKIXPublicKey(&key.PublicKey)
+		path := filepath.Join(dir, name+".pub")
+		if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pub}), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		config += "  - {email: " + name + "@hm.edu, public_key: " + path + "}\n"
+	}
+	if err := os.WriteFile(filepath.Join(dir, "escrow.yaml"), []byte(config), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	escrowCfg, err := escrow.Load(filepath.Join(dir, "escrow.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &smimeAPIServer{cfg: &cfg.PKIConfiguration{}, db: client, logger: zap.L(), escrow: escrowCfg}
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:   big.NewInt(1234),
+		Subject:        pkix.Name{CommonName: "jane.doe@hm.edu"},
+		EmailAddresses: []string{"jane.doe@hm.edu"},
+		NotBefore:      time.Now(),
+		NotAfter:       time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sealed, err := s.escrow.Seal(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = s.saveCertificate(ctx, s.logger, sealed, func(db *ent.Client) (*ent.SmimeCertificate, error) {
+		return db.SmimeCertificate.Create().
+			SetSerial("04d2").
+			SetEmail("jane.doe@hm.edu").
+			SetCertificate(string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))).
+			SetCreated(time.Now()).
+			SetNotAfter(time.Now().Add(time.Hour)).
+			Save(ctx)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	recovery, err := s.RequestKeyRecovery(ctx, &pb.RequestKeyRecoveryRequest{Serial: "04d2", RequestedBy: "jane.doe@hm.edu"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	approve := func(officer string, share []byte) (*pb.KeyRecovery, error) {
+		if share == nil {
+			list, err := s.ListKeyRecoveries(ctx, &pb.ListKeyRecoveriesRequest{User: officer})
+			if err != nil || len(list.Items) != 1 || list.Items[0].EncryptedShare == nil {
+				t.Fatalf("expected the encrypted share of %s, got %v %v", officer, list, err)
+			}
+			if share, err = escrow.DecryptShare(list.Items[0].EncryptedShare, officers[officer]); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return s.ApproveKeyRecovery(ctx, &pb.ApproveKeyRecoveryRequest{Id: recovery.Id, Officer: officer, Share: share})
+	}
+
+	// Invalid shares are detected once enough shares were submitted and the
+	// recovery must be approved again.
+	if _, err := approve("alice@hm.edu", nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := approve("bob@hm.edu", []byte("invalid")); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected InvalidArgument for an invalid share, got %v", err)
+	}
+	reset, err := client.KeyRecovery.Get(ctx, int(recovery.Id))
+	if err != nil || reset.Status != "Pending" || len(reset.Approvals) != 0 {
+		t.Errorf("expected the recovery to be reset, got %+v %v", reset, err)
+	}
+
+	if _, err := approve("alice@hm.edu", nil); err != nil {
+		t.Fatal(err)
+	}
+	approved, err := approve("bob@hm.edu", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if approved.Status != "Approved" {
+		t.Errorf("unexpected recovery %+v", approved)
+	}
+	// Shares lost (e.g. by a restart) require new approvals.
+	s.shares.drop(int(recovery.Id))
+	if _, err := s.ReleaseKeyRecovery(ctx, &pb.ReleaseKeyRecoveryRequest{Id: recovery.Id, User: "jane.doe@hm.edu"}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("expected FailedPrecondition without shares, got %v", err)
+	}
+	if _, err := approve("carol@hm.edu", nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := approve("alice@hm.edu", nil); err != nil {
+		t.Fatal(err)
+	}
+
+	released, err := s.ReleaseKeyRecovery(ctx, &pb.ReleaseKeyRecoveryRequest{Id: recovery.Id, User: "jane.doe@hm.edu"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	recovered, _, _, err := pkcs12.DecodeChain(released.Pkcs12, released.Pkcs12Password)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !key.Equal(recovered) {
+		t.Error("released key differs")
+	}
+	if _, err := s.ReleaseKeyRecovery(ctx, &pb.ReleaseKeyRecoveryRequest{Id: recovery.Id, User: "jane.doe@hm.edu"}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("expected FailedPrecondition for a second release, got %v", err)
+	}
+}
+
+func TestKeyRecoveryDisabled(t *testing.T) {
+	s := &smimeAPIServer{cfg: &cfg.PKIConfiguration{}, logger: zap.L()}
+	if _, err := s.RequestKeyRecovery(context.Background(), &pb.RequestKeyRecoveryRequest{Serial: "04d2"}); status.Code(err) != codes.Unimplemented {
+		t.Errorf("expected Unimplemented, got %v", err)
+	}
+}
@@ -0,0 +1,85 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hm-edu/pki-service/ent"
+	"github.com/hm-edu/pki-service/ent/domain"
+	"github.com/hm-edu/pki-service/pkg/events"
+	"github.com/hm-edu/pki-service/pkg/notify"
+	"go.uber.org/zap"
+)
+
+// withoutActor removes the user that triggered an event from its
+// recipients.
+func withoutActor(recipients []string, actor string) []string {
+	var filtered []string
+	for _, r := range recipients {
+		if actor == "" || !strings.EqualFold(r, actor) {
+			filtered = append(filtered, r)
+		}
+	}
+	return filtered
+}
+
+// publishCertificate notifies the requester of a SSL certificate and the
+// owners and delegates of its domains. The requester is the actor of issued
+// certificates and is notified as well, since the issuance may take a while.
+func (s *sslAPIServer) publishCertificate(ctx context.Context, event string, c *ent.Certificate, actor string, details map[string]string) {
+	if !s.events.Active() {
+		return
+	}
+	domains, err := s.db.Certificate.QueryDomains(c).Select(domain.FieldFqdn).Strings(ctx)
+	if err != nil {
+		s.logger.Warn("Error loading the domains of the certificate", zap.Int("id", c.ID), zap.Error(err))
+	}
+	requester := ""
+	if c.IssuedBy != nil {
+		requester = *c.IssuedBy
+	}
+	recipients := s.events.CertificateRecipients(ctx, requester, domains)
+	if event == notify.EventCertificateIssued {
+		actor = termsUser(requester)
+	} else {
+		recipients = withoutActor(recipients, actor)
+	}
+	if details == nil {
+		details = make(map[string]string)
+	}
+	details["serial"] = c.Serial
+	details["domains"] = strings.Join(domains, ", ")
+	s.events.Publish(ctx, events.Event{
+		Type:       event,
+		Actor:      actor,
+		Subject:    c.CommonName,
+		Recipients: recipients,
+		Details:    details,
+	})
+}
+
+// publishCertificate notifies the mail address of a S/MIME certificate and
+// the user that requested it.
+func (s *smimeAPIServer) publishCertificate(ctx context.Context, event string, c *ent.SmimeCertificate, actor string, details map[string]string) {
+	if !s.events.Active() {
+		return
+	}
+	recipients := []string{c.Email}
+	if c.Owner != nil {
+		recipients = append(recipients, *c.Owner)
+	}
+	if event != notify.EventCertificateIssued {
+		recipients = withoutActor(recipients, actor)
+	}
+	if details == nil {
+		details = make(map[string]string)
+	}
+	details["serial"] = c.Serial
+	s.events.Publish(ctx, events.Event{
+		Type:       event,
+		Actor:      actor,
+		Subject:    c.Email,
+		Recipients: recipients,
+		Details:    details,
+	})
+}
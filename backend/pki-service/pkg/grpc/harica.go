@@ -33,6 +33,8 @@ const (
 // authorization failure).
 type haricaClients struct {
 	cfg *cfg.PKIConfiguration
+	// breaker protects HARICA (and the callers) during outages.
+	breaker *circuitBreaker
 
 	mu sync.Mutex
 	// client is bound to the regular account and used for requesting certificates.
@@ -43,9 +45,17 @@ type haricaClients struct {
 }
 
 // newHaricaClients performs a best-effort initial login. A failure is only
-// logged; the affected client is created on first use instead.
-func newHaricaClients(cfg *cfg.PKIConfiguration, logger *zap.Logger) *haricaClients {
+// logged; the affected client is created on first use instead. onChange is
+// called whenever HARICA becomes unavailable or available again.
+func newHaricaClients(cfg *cfg.PKIConfiguration, logger *zap.Logger, onChange func(open bool)) *haricaClients {
 	h := &haricaClients{cfg: cfg}
+	h.breaker = &circuitBreaker{
+		threshold:     cfg.HaricaBreakerThreshold,
+		probeInterval: cfg.HaricaBreakerProbeInterval,
+		probe:         h.probe,
+		onChange:      onChange,
+		logger:        logger,
+	}
 	if _, err := h.Client(); err != nil {
 		logger.Warn("Initial HARICA login failed, retrying on first use", zap.Error(err))
 	}
@@ -56,8 +66,20 @@ func newHaricaClients(cfg *cfg.PKIConfiguration, logger *zap.Logger) *haricaClie
 }
 
 // Client returns the shared client for the regular account, creating it (and
-// logging in) if that has not succeeded yet.
+// logging in) if that has not succeeded yet. While the circuit breaker is
+// open, errHaricaUnavailable is returned.
 func (h *haricaClients) Client() (*harica.Client, error) {
+	if err := h.breaker.Allow(); err != nil {
+		return nil, err
+	}
+	client, err := h.regular()
+	if err != nil {
+		h.breaker.Record(err)
+	}
+	return client, err
+}
+
+func (h *haricaClients) regular() (*harica.Client, error) {
 	h.mu.Lock()
 	defer h.mu.Unlock()
 	if h.client == nil {
@@ -77,8 +99,12 @@ func (h *haricaClients) Client() (*harica.Client, error) {
 }
 
 // Validation returns the shared client for the validation account, creating
-// it (and logging in) if that has not succeeded yet.
+// it (and logging in) if that has not succeeded yet. While the circuit
+// breaker is open, errHaricaUnavailable is returned.
 func (h *haricaClients) Validation() (*harica.Client, error) {
+	if err := h.breaker.Allow(); err != nil {
+		return nil, err
+	}
 	h.mu.Lock()
 	defer h.mu.Unlock()
 	if h.validation == nil {
@@ -89,6 +115,7 @@ func (h *haricaClients) Validation() (*harica.Client, error) {
 			harica.WithRefreshInterval(haricaRefreshInterval),
 			harica.WithRequestTimeout(haricaRequestTimeout),
 		)
+		h.breaker.Record(err)
 		if err != nil {
 			return nil, err
 		}
@@ -97,6 +124,16 @@ func (h *haricaClients) Validation() (*harica.Client, error) {
 	return h.validation, nil
 }
 
+// probe checks whether HARICA is reachable again by performing a fresh login
+// with the regular account.
+func (h *haricaClients) probe() error {
+	client, err := h.regular()
+	if err != nil {
+		return err
+	}
+	return client.SessionRefresh(true)
+}
+
 func isAuthError(err error) bool {
 	var codeErr *harica.UnexpectedResponseCodeError
 	if errors.As(err, &codeErr) {
@@ -149,12 +186,16 @@ func isRetryableError(err error) bool {
 // retryHarica runs fn with exponential backoff. Before each attempt the
 // session is refreshed lazily, i.e. the existing token is reused unless it is
 // (about to be) expired. If HARICA rejects the token anyway, a fresh login is
-// forced before the next attempt.
-func retryHarica[T any](ctx context.Context, logger *zap.Logger, client *harica.Client, op string, fn func() (T, error)) (T, error) {
+// forced before the next attempt. Every attempt is recorded by the circuit
+// breaker; once it is open, no further attempts are made.
+func retryHarica[T any](ctx context.Context, logger *zap.Logger, breaker *circuitBreaker, client *harica.Client, op string, fn func() (T, error)) (T, error) {
 	var zero T
 	var lastErr error
 	backoff := haricaInitialBackoff
 	for attempt := 1; attempt <= haricaMaxAttempts; attempt++ {
+		if err := breaker.Allow(); err != nil {
+			return zero, err
+		}
 		if attempt > 1 {
 			logger.Warn("Retrying HARICA request",
 				zap.String("operation", op),
@@ -169,10 +210,12 @@ func retryHarica[T any](ctx context.Context, logger *zap.Logger, client *harica.
 			backoff *= 2
 		}
 		if err := client.SessionRefresh(false); err != nil {
+			breaker.Record(err)
 			lastErr = err
 			continue
 		}
 		result, err := fn()
+		breaker.Record(err)
 		if err == nil {
 			return result, nil
 		}
@@ -202,8 +245,8 @@ func retryHarica[T any](ctx context.Context, logger *zap.Logger, client *harica.
 }
 
 // retryHaricaVoid is retryHarica for operations without a result.
-func retryHaricaVoid(ctx context.Context, logger *zap.Logger, client *harica.Client, op string, fn func() error) error {
-	_, err := retryHarica(ctx, logger, client, op, func() (struct{}, error) {
+func retryHaricaVoid(ctx context.Context, logger *zap.Logger, breaker *circuitBreaker, client *harica.Client, op string, fn func() error) error {
+	_, err := retryHarica(ctx, logger, breaker, client, op, func() (struct{}, error) {
 		return struct{}{}, fn()
 	})
 	return err
@@ -211,10 +254,16 @@ func retryHaricaVoid(ctx context.Context, logger *zap.Logger, client *harica.Cli
 
 // runHaricaOnce ensures a valid session and runs fn exactly once. It is used
 // for non-idempotent operations where a retry could create duplicates.
-func runHaricaOnce[T any](client *harica.Client, fn func() (T, error)) (T, error) {
+func runHaricaOnce[T any](breaker *circuitBreaker, client *harica.Client, fn func() (T, error)) (T, error) {
+	var zero T
+	if err := breaker.Allow(); err != nil {
+		return zero, err
+	}
 	if err := client.SessionRefresh(false); err != nil {
-		var zero T
+		breaker.Record(err)
 		return zero, err
 	}
-	return fn()
+	result, err := fn()
+	breaker.Record(err)
+	return result, err
 }
@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"context"
+	"sync"
 
 	"github.com/hm-edu/pki-service/pkg/database"
 	"go.uber.org/zap"
@@ -10,38 +11,75 @@ import (
 	"google.golang.org/grpc/status"
 )
 
-// HealthChecker is the basic structure of a GRPC health check.
-type HealthChecker struct{}
+// HealthChecker is the basic structure of a GRPC health check. The overall
+// status (empty service name) only reflects the database, the status of the
+// single services additionally reflects the availability of the CAs they
+// depend on.
+type HealthChecker struct {
+	mu       sync.RWMutex
+	statuses map[string]grpc_health_v1.HealthCheckResponse_ServingStatus
+}
+
+// SetServingStatus sets the serving status of the given service.
+func (s *HealthChecker) SetServingStatus(service string, servingStatus grpc_health_v1.HealthCheckResponse_ServingStatus) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.statuses[service] = servingStatus
+}
+
+func (s *HealthChecker) servingStatus(service string) (grpc_health_v1.HealthCheckResponse_ServingStatus, bool) {
+	if service == "" {
+		return grpc_health_v1.HealthCheckResponse_SERVING, true
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	servingStatus, ok := s.statuses[service]
+	return servingStatus, ok
+}
 
 // Check performs a single health check.
-func (s *HealthChecker) Check(ctx context.Context, _ *grpc_health_v1.HealthCheckRequest) (*grpc_health_v1.HealthCheckResponse, error) {
+func (s *HealthChecker) Check(ctx context.Context, req *grpc_health_v1.HealthCheckRequest) (*grpc_health_v1.HealthCheckResponse, error) {
 	zap.L().Debug("Serving the Check request for health check")
 	err := database.DB.Internal.PingContext(ctx)
 	if err != nil {
 		zap.L().Error("Failed to ping the database", zap.Error(err))
 		return nil, status.Error(codes.Unavailable, "Service unavailable")
 	}
+	servingStatus, ok := s.servingStatus(req.GetService())
+	if !ok {
+		return nil, status.Error(codes.NotFound, "Unknown service")
+	}
 	return &grpc_health_v1.HealthCheckResponse{
-		Status: grpc_health_v1.HealthCheckResponse_SERVING,
+		Status: servingStatus,
 	}, nil
 }
 
 // Watch implements the Watch method of the HealthServer interface.
-func (s *HealthChecker) Watch(_ *grpc_health_v1.HealthCheckRequest, server grpc_health_v1.Health_WatchServer) error {
+func (s *HealthChecker) Watch(req *grpc_health_v1.HealthCheckRequest, server grpc_health_v1.Health_WatchServer) error {
 	zap.L().Debug("Serving the Watch request for health check")
+	servingStatus, ok := s.servingStatus(req.GetService())
+	if !ok {
+		servingStatus = grpc_health_v1.HealthCheckResponse_SERVICE_UNKNOWN
+	}
 	return server.Send(&grpc_health_v1.HealthCheckResponse{
-		Status: grpc_health_v1.HealthCheckResponse_SERVING,
+		Status: servingStatus,
 	})
 }
 
 // List implements the List method of the HealthServer interface.
 func (s *HealthChecker) List(_ context.Context, _ *grpc_health_v1.HealthListRequest) (*grpc_health_v1.HealthListResponse, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	statuses := make(map[string]*grpc_health_v1.HealthCheckResponse, len(s.statuses))
+	for service, servingStatus := range s.statuses {
+		statuses[service] = &grpc_health_v1.HealthCheckResponse{Status: servingStatus}
+	}
 	return &grpc_health_v1.HealthListResponse{
-		Statuses: map[string]*grpc_health_v1.HealthCheckResponse{},
+		Statuses: statuses,
 	}, nil
 }
 
 // NewHealthChecker returns a new HealthChecker.
 func NewHealthChecker() *HealthChecker {
-	return &HealthChecker{}
+	return &HealthChecker{statuses: map[string]grpc_health_v1.HealthCheckResponse_ServingStatus{}}
 }
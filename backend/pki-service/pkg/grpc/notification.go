@@ -0,0 +1,86 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/getsentry/sentry-go"
+	"github.com/hm-edu/pki-service/pkg/events"
+	pb "github.com/hm-edu/portal-apis"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type notificationAPIServer struct {
+	pb.UnimplementedNotificationServiceServer
+	events *events.Hub
+	logger *zap.Logger
+}
+
+func newNotificationAPIServer(hub *events.Hub) *notificationAPIServer {
+	return &notificationAPIServer{events: hub, logger: zap.L()}
+}
+
+// PublishEvent notifies the recipients of an event raised by another service
+// (e.g. a domain approval). The notifications are delivered in the
+// background.
+func (s *notificationAPIServer) PublishEvent(ctx context.Context, req *pb.NotificationEvent) (*emptypb.Empty, error) {
+	if !events.Known(req.Type) {
+		return nil, status.Errorf(codes.InvalidArgument, "Unknown event %s", req.Type)
+	}
+	s.logger.Info("Publishing event",
+		zap.String("event", req.Type),
+		zap.String("subject", req.Subject),
+		zap.String("actor", req.Actor),
+		zap.Int("recipients", len(req.Recipients)))
+	s.events.Publish(ctx, events.Event{
+		Type:       req.Type,
+		Actor:      req.Actor,
+		Subject:    req.Subject,
+		Recipients: req.Recipients,
+		Details:    req.Data,
+	})
+	return &emptypb.Empty{}, nil
+}
+
+func (s *notificationAPIServer) mapPreferences(preferences []events.Preference) *pb.NotificationPreferences {
+	resp := &pb.NotificationPreferences{Channels: s.events.Channels()}
+	for _, p := range preferences {
+		resp.Preferences = append(resp.Preferences, &pb.NotificationPreference{Event: p.Event, Enabled: p.Enabled, Channels: p.Channels})
+	}
+	return resp
+}
+
+// GetNotificationPreferences returns the preferences of the user for all
+// events and the channels the user can choose from.
+func (s *notificationAPIServer) GetNotificationPreferences(ctx context.Context, req *pb.GetNotificationPreferencesRequest) (*pb.NotificationPreferences, error) {
+	if req.User == "" {
+		return nil, status.Error(codes.InvalidArgument, "User is required")
+	}
+	preferences, err := s.events.Preferences(ctx, req.User)
+	if err != nil {
+		s.logger.Error("Error loading notification preferences", zap.Error(err))
+		return nil, status.Error(codes.Internal, "Error loading the notification preferences")
+	}
+	return s.mapPreferences(preferences), nil
+}
+
+// UpdateNotificationPreferences stores the preferences of the user for the
+// given events and returns the preferences for all events.
+func (s *notificationAPIServer) UpdateNotificationPreferences(ctx context.Context, req *pb.UpdateNotificationPreferencesRequest) (*pb.NotificationPreferences, error) {
+	var preferences []events.Preference
+	for _, p := range req.Preferences {
+		preferences = append(preferences, events.Preference{Event: p.Event, Enabled: p.Enabled, Channels: p.Channels})
+	}
+	if err := s.events.UpdatePreferences(ctx, req.User, preferences); err != nil {
+		if errors.Is(err, events.ErrInvalidPreference) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		sentry.CaptureException(err)
+		s.logger.Error("Error storing notification preferences", zap.Error(err))
+		return nil, status.Error(codes.Internal, "Error storing the notification preferences")
+	}
+	return s.GetNotificationPreferences(ctx, &pb.GetNotificationPreferencesRequest{User: req.User})
+}
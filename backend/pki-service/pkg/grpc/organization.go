@@ -0,0 +1,42 @@
+package grpc
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hm-edu/pki-service/pkg/cfg"
+)
+
+// errNoOrganization is returned if HARICA does not offer any organization
+// for a request.
+var errNoOrganization = errors.New("no matching organization")
+
+// organizationNotValidatedError is returned if the configured organization
+// is not offered by HARICA for a request (e.g. because the domain is not
+// validated for it).
+type organizationNotValidatedError struct {
+	org *cfg.Organization
+}
+
+func (e *organizationNotValidatedError) Error() string {
+	return fmt.Sprintf("organization %s is not validated for the request", e.org.Name)
+}
+
+// selectOrganization selects the configured organization from the
+// organizations offered by HARICA. Without a configured organization the
+// first offered organization is used.
+func selectOrganization[T any](offered []T, id func(T) string, org *cfg.Organization) (T, error) {
+	var empty T
+	if len(offered) == 0 {
+		return empty, errNoOrganization
+	}
+	if org == nil {
+		return offered[0], nil
+	}
+	for _, o := range offered {
+		if id(o) == org.ID {
+			return o, nil
+		}
+	}
+	return empty, &organizationNotValidatedError{org: org}
+}
@@ -0,0 +1,28 @@
+package grpc
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hm-edu/harica/models"
+	"github.com/hm-edu/pki-service/pkg/cfg"
+)
+
+func TestSelectOrganization(t *testing.T) {
+	id := func(o models.OrganizationResponse) string { return o.ID }
+	offered := []models.OrganizationResponse{{ID: "hm"}, {ID: "cs"}}
+
+	if o, err := selectOrganization(offered, id, nil); err != nil || o.ID != "hm" {
+		t.Errorf("expected first organization, got %+v (%v)", o, err)
+	}
+	if o, err := selectOrganization(offered, id, &cfg.Organization{ID: "cs"}); err != nil || o.ID != "cs" {
+		t.Errorf("expected configured organization, got %+v (%v)", o, err)
+	}
+	var notValidated *organizationNotValidatedError
+	if _, err := selectOrganization(offered, id, &cfg.Organization{ID: "other", Name: "Other"}); !errors.As(err, &notValidated) {
+		t.Errorf("expected organization not validated error, got %v", err)
+	}
+	if _, err := selectOrganization(nil, id, nil); !errors.Is(err, errNoOrganization) {
+		t.Errorf("expected no organization error, got %v", err)
+	}
+}
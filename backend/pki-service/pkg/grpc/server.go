@@ -16,8 +16,14 @@ import (
 	"github.com/hm-edu/pki-service/ent"
 	"github.com/hm-edu/pki-service/pkg/acme"
 	"github.com/hm-edu/pki-service/pkg/cfg"
+	"github.com/hm-edu/pki-service/pkg/escrow"
+	"github.com/hm-edu/pki-service/pkg/events"
+	"github.com/hm-edu/pki-service/pkg/publisher"
 	"github.com/hm-edu/portal-common/interceptor"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/health/grpc_health_v1"
@@ -30,6 +36,7 @@ type Server struct {
 	config *Config
 	pkiCfg *cfg.PKIConfiguration
 	db     *ent.Client
+	events *events.Hub
 }
 
 // Config is the basic structure of the GRPC configuration
@@ -40,12 +47,13 @@ type Config struct {
 }
 
 // NewServer creates a new GRPC server
-func NewServer(config *Config, logger *zap.Logger, pkiCfg *cfg.PKIConfiguration, db *ent.Client) (*Server, error) {
+func NewServer(config *Config, logger *zap.Logger, pkiCfg *cfg.PKIConfiguration, db *ent.Client, hub *events.Hub) (*Server, error) {
 	srv := &Server{
 		logger: logger,
 		pkiCfg: pkiCfg,
 		config: config,
 		db:     db,
+		events: hub,
 	}
 
 	return srv, nil
@@ -111,7 +119,33 @@ func (s *Server) ListenAndServe(stopCh <-chan struct{}) {
 	// The HARICA clients are shared between the SSL and the SMIME server so
 	// the sessions are reused across all requests. The initial login is
 	// best-effort: HARICA outages must not prevent the service from starting.
-	clients := newHaricaClients(s.pkiCfg, s.logger)
+	// The SMIME service always depends on HARICA, the SSL service only if
+	// HARICA is the configured CA. While HARICA is unavailable, the affected
+	// services are reported as NOT_SERVING.
+	haricaServices := []string{pb.SmimeService_ServiceDesc.ServiceName}
+	if s.pkiCfg.SslCa != "letsencrypt" {
+		haricaServices = append(haricaServices, pb.SSLService_ServiceDesc.ServiceName)
+	}
+	server.SetServingStatus(pb.SSLService_ServiceDesc.ServiceName, grpc_health_v1.HealthCheckResponse_SERVING)
+	server.SetServingStatus(pb.SmimeService_ServiceDesc.ServiceName, grpc_health_v1.HealthCheckResponse_SERVING)
+	clients := newHaricaClients(s.pkiCfg, s.logger, func(open bool) {
+		servingStatus := grpc_health_v1.HealthCheckResponse_SERVING
+		if open {
+			servingStatus = grpc_health_v1.HealthCheckResponse_NOT_SERVING
+		}
+		for _, service := range haricaServices {
+			server.SetServingStatus(service, servingStatus)
+		}
+	})
+	_ = promauto.NewGaugeFunc(prometheus.GaugeOpts{
+		Name: "harica_circuit_breaker_open",
+		Help: "Whether requests to HARICA are currently rejected by the circuit breaker",
+	}, func() float64 {
+		if clients.breaker.Open() {
+			return 1
+		}
+		return 0
+	})
 
 	// The ACME client (e.g. Let's Encrypt) is created once at startup so the
 	// account and the ACME session are reused across all requests.
@@ -127,8 +161,87 @@ func (s *Server) ListenAndServe(stopCh <-chan struct{}) {
 		}
 	}
 
-	pb.RegisterSSLServiceServer(srv, newSslAPIServer(s.pkiCfg, s.db, clients, acmeClient))
-	pb.RegisterSmimeServiceServer(srv, newSmimeAPIServer(s.pkiCfg, s.db, clients))
+	// Without a mapping, the organization is selected by HARICA.
+	var orgs *cfg.OrganizationConfig
+	if s.pkiCfg.HaricaOrganizations != "" {
+		var err error
+		orgs, err = cfg.LoadOrganizationConfig(s.pkiCfg.HaricaOrganizations)
+		if err != nil {
+			s.logger.Fatal("failed to load HARICA organization config", zap.Error(err))
+		}
+	}
+
+	// Without an allow-list, only the default certificate type is allowed.
+	var types *cfg.CertTypeConfig
+	if s.pkiCfg.SslCertTypes != "" {
+		var err error
+		types, err = cfg.LoadCertTypeConfig(s.pkiCfg.SslCertTypes)
+		if err != nil {
+			s.logger.Fatal("failed to load certificate type config", zap.Error(err))
+		}
+	}
+
+	// Without a configuration, no acceptance of terms is required.
+	var terms *cfg.TermsConfig
+	if s.pkiCfg.Terms != "" {
+		var err error
+		terms, err = cfg.LoadTermsConfig(s.pkiCfg.Terms)
+		if err != nil {
+			s.logger.Fatal("failed to load terms config", zap.Error(err))
+		}
+	}
+
+	sslServer := newSslAPIServer(s.pkiCfg, s.db, clients, acmeClient, orgs, types, terms, s.events)
+	if acmeClient != nil && sslServer.queueAcmeRequests() {
+		go sslServer.runAcmeQueue(stopCh)
+	}
+
+	pb.RegisterSSLServiceServer(srv, sslServer)
+	// Generated S/MIME keys are only escrowed if configured.
+	var escrowCfg *escrow.Config
+	if s.pkiCfg.SmimeKeyEscrow != "" {
+		var err error
+		escrowCfg, err = escrow.Load(s.pkiCfg.SmimeKeyEscrow)
+		if err != nil {
+			s.logger.Fatal("failed to load key escrow config", zap.Error(err))
+		}
+	}
+
+	// Without a configuration, the bundled HARICA chain is used.
+	chain, err := cfg.LoadCertificateChain(s.pkiCfg.SmimeChain)
+	if err != nil {
+		s.logger.Fatal("failed to load S/MIME certificate chain", zap.Error(err))
+	}
+
+	// Without a configuration, the default character set and
+	// transliterations apply.
+	var names *cfg.NameConfig
+	if s.pkiCfg.SmimeNames != "" {
+		var err error
+		names, err = cfg.LoadNameConfig(s.pkiCfg.SmimeNames)
+		if err != nil {
+			s.logger.Fatal("failed to load S/MIME name config", zap.Error(err))
+		}
+	}
+
+	// Certificates are only published to the directory if configured.
+	var pub publisher.Publisher
+	if s.pkiCfg.SmimeLdapPublisher != "" {
+		ldapCfg, err := publisher.Load(s.pkiCfg.SmimeLdapPublisher)
+		if err != nil {
+			s.logger.Fatal("failed to load LDAP publisher config", zap.Error(err))
+		}
+		pub = ldapCfg
+	}
+
+	smimeServer := newSmimeAPIServer(s.pkiCfg, s.db, clients, orgs, escrowCfg, names, chain, pub, terms, s.events)
+	if s.pkiCfg.SmimeKeyGeneration {
+		if _, err := smimeServer.generatedKeyType(); err != nil {
+			s.logger.Fatal("invalid S/MIME key generation config", zap.Error(err))
+		}
+	}
+	pb.RegisterSmimeServiceServer(srv, smimeServer)
+	pb.RegisterNotificationServiceServer(srv, newNotificationAPIServer(s.events))
 	grpc_health_v1.RegisterHealthServer(srv, server)
 
 	go func() {
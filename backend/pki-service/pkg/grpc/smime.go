@@ -2,19 +2,22 @@ package grpc
 
 import (
 	"context"
-	"crypto/rsa"
+	"crypto"
 	"crypto/x509"
 	"encoding/pem"
-	"fmt"
-	"regexp"
 	"time"
 
 	"github.com/TheZeroSlave/zapsentry"
 	"github.com/getsentry/sentry-go"
+	harica "github.com/hm-edu/harica/client"
 	"github.com/hm-edu/harica/models"
 	"github.com/hm-edu/pki-service/ent"
 	"github.com/hm-edu/pki-service/ent/smimecertificate"
 	"github.com/hm-edu/pki-service/pkg/cfg"
+	"github.com/hm-edu/pki-service/pkg/escrow"
+	"github.com/hm-edu/pki-service/pkg/events"
+	"github.com/hm-edu/pki-service/pkg/notify"
+	"github.com/hm-edu/pki-service/pkg/publisher"
 	pb "github.com/hm-edu/portal-apis"
 
 	"go.uber.org/zap"
@@ -30,89 +33,44 @@ type smimeAPIServer struct {
 	logger *zap.Logger
 	db     *ent.Client
 	harica *haricaClients
+	orgs   *cfg.OrganizationConfig
+	escrow *escrow.Config
+	names  *cfg.NameConfig
+	// chain holds the intermediate and root certificates of the issuing CAs.
+	chain []*x509.Certificate
+	// publisher publishes the certificates to the directory (optional).
+	publisher publisher.Publisher
+	// terms must be accepted before certificates are issued (optional).
+	terms *cfg.TermsConfig
+	// events notifies users about issued and revoked certificates
+	// (optional).
+	events *events.Hub
+	// mailer sends the codes confirming additional addresses (optional).
+	mailer mailer
+	// shares holds the shares submitted for key recoveries.
+	shares recoveryShares
 }
 
-func newSmimeAPIServer(cfg *cfg.PKIConfiguration, db *ent.Client, clients *haricaClients) *smimeAPIServer {
-	return &smimeAPIServer{
-		cfg:    cfg,
-		logger: zap.L(),
-		db:     db,
-		harica: clients,
+func newSmimeAPIServer(cfg *cfg.PKIConfiguration, db *ent.Client, clients *haricaClients, orgs *cfg.OrganizationConfig, escrowCfg *escrow.Config, names *cfg.NameConfig, chain []*x509.Certificate, pub publisher.Publisher, terms *cfg.TermsConfig, hub *events.Hub) *smimeAPIServer {
+	srv := &smimeAPIServer{
+		cfg:       cfg,
+		logger:    zap.L(),
+		db:        db,
+		harica:    clients,
+		orgs:      orgs,
+		escrow:    escrowCfg,
+		names:     names,
+		chain:     chain,
+		publisher: pub,
+		terms:     terms,
+		events:    hub,
 	}
+	if hub.Active() {
+		srv.mailer = hub.Dispatcher
+	}
+	return srv
 }
 
-const (
-	geantIssuer = `-----BEGIN CERTIFICATE-----
-MIIGRDCCBCygAwIBAgIQFfmubKqNLtTTb3h/Htx7ATANBgkqhkiG9w0BAQsFADBv
-MQswCQYDVQQGEwJHUjE3MDUGA1UECgwuSGVsbGVuaWMgQWNhZGVtaWMgYW5kIFJl
-c2VhcmNoIEluc3RpdHV0aW9ucyBDQTEnMCUGA1UEAwweSEFSSUNBIENsaWVudCBS
-U0EgUm9vdCBDQSAyMDIxMB4XDTI1MDEwMzExMTMwOFoXDTM5MTIzMTExMTMwN1ow
-YzELMAkGA1UEBhMCR1IxNzA1BgNVBAoMLkhlbGxlbmljIEFjYWRlbWljIGFuZCBS
-ZXNlYXJjaCBJbnN0aXR1dGlvbnMgQ0ExGzAZBgNVBAMMEkdFQU5UIFMvTUlNRSBS
-U0EgMTCCAaIwDQYJKoZIhvcNAQEBBQADggGPADCCAYoCggGBAKu4bq/+byKjHo25
-Xz32YBmO+Wrkmc+UmfcdXSCI7yawwU9JSMEHAAKAASaJpLr9JAyt+tlB/rn/Sazn
-SwY4ipBIffR0D5k/ndfiI553dWgI4i/tkOGlNej/7JyE2CS9kTlOOs6pg5HaDpwq
-jAhCkje+IByg5gKWH6lzvMJo5jQOtsGB2q6e5cYKwa9LJOAcR8iquds9LFssbHSM
-uVdSuTjpAjcGLqWfW++C0YXpWD+UonjQ6lNEuiKUDmrFc+SEtLw56lYtp4uuxm4L
-W/HQSsx+oGwMBqaR6HhBQ3LydONjsbcbegRqJZFJoLsnwIHorEag44UIvjXzYJAx
-/NTiwVdHldO7cEvWscDbyQLR9koBoliq2HrgYFQs7NQxU+7MLNSh8i6znWVNISUE
-g36M//I8BZl4VqD70ELlhKKN7rx+i7BwKOd2gxdWgFJhkPyQu9o+82R9epXiRblo
-/rdkyv+2BFR7VpbgPUzncdi8/0h4dP/qQFYnA+Df0FFj7gYczwIDAQABo4IBZjCC
-AWIwEgYDVR0TAQH/BAgwBgEB/wIBADAfBgNVHSMEGDAWgBSg1gc9XiT3e6BELiRS
-DRmqKwSRpzBQBggrBgEFBQcBAQREMEIwQAYIKwYBBQUHMAKGNGh0dHA6Ly9jcnQu
-aGFyaWNhLmdyL0hBUklDQS1DbGllbnQtUm9vdC0yMDIxLVJTQS5jZXIwRAYDVR0g
-BD0wOzA5BgRVHSAAMDEwLwYIKwYBBQUHAgEWI2h0dHA6Ly9yZXBvLmhhcmljYS5n
-ci9kb2N1bWVudHMvQ1BTMB0GA1UdJQQWMBQGCCsGAQUFBwMCBggrBgEFBQcDBDBF
-BgNVHR8EPjA8MDqgOKA2hjRodHRwOi8vY3JsLmhhcmljYS5nci9IQVJJQ0EtQ2xp
-ZW50LVJvb3QtMjAyMS1SU0EuY3JsMB0GA1UdDgQWBBTrsi87/a4CzCpEBl0lzR0S
-ImiwRzAOBgNVHQ8BAf8EBAMCAYYwDQYJKoZIhvcNAQELBQADggIBADveuEX23Dwr
-kygKtsF7DmcTGmi8SE20jmJLe0TMT8Nws1NqppE0ACym1agtY1IjUFm5MWabG/Ic
-vRTh8sB9cRZgDQMqZLNCLofqL4aj/dKBXH4bwH2MVdjNHBoGvZkyhRz/kBE+x1va
-WXclhWQMOX5nVvRMfiEJiYotMP7KM88IaVZ9DkGJJEVftsnUWuvCWUtjagD6XWlq
-LHjNl+LufiZ/h9lDvaWqG1/obfdStgofMc30RL+ES6gYKRwZpCA1coFzXV7Cnwx8
-toTl8bReqCNXexKzxlqAcRXPOmlKkJQuqRI297oNuMPnoNZCY+yLnxyd4kZuu0Xc
-OTNTpVjM8bvg8ACqhSYanrNDi/zTiTk7gwm9GyH1X45fFNGNEFgpIaApjT2UELuk
-DOmP18ZwC4EQeHawPJIqffMEmUJm6qbRPKGnNmcyygh4iZU3QbkRLLp3Z6QV3WoT
-Eqyf5mL9qTGS6WJG65L8oaKw1Xh/bdGuVIDyBahpfP2c2pCd0UH6+x73Rrq9GFlO
-ijVr2OQSvKhzETNG917SvcURCBhMnIQFUXqHQyIY60eH1po6WtNOq/1K5kpOG6Sq
-1RVc02LEit48uK4tRMVUKekSOjruGXW38DmAriPcMHjI6VQbqjc0Sq1VPz76ee4F
-M5uLviSUZHYqDDqMWa8LFImK9iiKI8E3
------END CERTIFICATE-----`
-	haricaRoot = `-----BEGIN CERTIFICATE-----
-MIIFqjCCA5KgAwIBAgIQVVL4HtsbJCyeu5YYzQIoPjANBgkqhkiG9w0BAQsFADBv
-MQswCQYDVQQGEwJHUjE3MDUGA1UECgwuSGVsbGVuaWMgQWNhZGVtaWMgYW5kIFJl
-c2VhcmNoIEluc3RpdHV0aW9ucyBDQTEnMCUGA1UEAwweSEFSSUNBIENsaWVudCBS
-U0EgUm9vdCBDQSAyMDIxMB4XDTIxMDIxOTEwNTg0NloXDTQ1MDIxMzEwNTg0NVow
-bzELMAkGA1UEBhMCR1IxNzA1BgNVBAoMLkhlbGxlbmljIEFjYWRlbWljIGFuZCBS
-ZXNlYXJjaCBJbnN0aXR1dGlvbnMgQ0ExJzAlBgNVBAMMHkhBUklDQSBDbGllbnQg
-UlNBIFJvb3QgQ0EgMjAyMTCCAiIwDQYJKoZIhvcNAQEBBQADggIPADCCAgoCggIB
-AIHbV0KQLHQ19Pi4dBlNqwlad0WBc2KwNZ/40LczAIcTtparDlQSMAe8m7dI19EZ
-g66O2KnxqQCEsIxenugMj1Rpv/bUCE8mcP4YQWMaszKLQPgHq1cx8MYWdmeatN0v
-8tFrxdCShJFxbg8uY+kfU6TdUhPMCYMpgQzFU3VEsQ5nUxjQwx+IS5+UJLQpvLvo
-Tv1v0hUdSdyNcPIRGiBRVRG6iG/E91B51qox4oQ9XjLIdypQceULL+m26u+rCjM5
-Dv2PpWdDgo6YaQkJG0DNOGdH6snsl3ES3iT1cjzR90NMJveQsonpRUtVPTEFekHi
-lbpDwBfFtoU9GY1kcPNbrM2f0yl1h0uVZ2qm+NHdvJCGiUMpqTdb9V2wJlpTQnaQ
-K8+eVmwrVM9cmmXfW4tIYDh8+8ULz3YEYwIzKn31g2fn+sZD/SsP1CYvd6QywSTq
-ZJ2/szhxMUTyR7iiZkGh+5t7vMdGanW/WqKM6GpEwbiWtcAyCC17dDVzssrG/q8R
-chj258jCz6Uq6nvWWeh8oLJqQAlpDqWW29EAufGIbjbwiLKd8VLyw3y/MIk8Cmn5
-IqRl4ZvgdMaxhZeWLK6Uj1CmORIfvkfygXjTdTaefVogl+JSrpmfxnybZvP+2M/u
-vZcGHS2F3D42U5Z7ILroyOGtlmI+EXyzAISep0xxq0o3AgMBAAGjQjBAMA8GA1Ud
-EwEB/wQFMAMBAf8wHQYDVR0OBBYEFKDWBz1eJPd7oEQuJFINGaorBJGnMA4GA1Ud
-DwEB/wQEAwIBhjANBgkqhkiG9w0BAQsFAAOCAgEADUf5CWYxUux57sKo8mg+7ZZF
-yzqmmGM/6itNTgPQHILhy9Pl1qtbZyi8nf4MmQqAVafOGyNhDbBX8P7gyr7mkNuD
-LL6DjvR5tv7QDUKnWB9p6oH1BaX+RmjrbHjJ4Orn5t4xxdLVLIJjKJ1dqBp+iObn
-K/Es1dAFntwtvTdm1ASip62/OsKoO63/jZ0z4LmahKGHH3b0gnTXDvkwSD5biD6q
-XGvWLwzojnPCGJGDObZmWtAfYCddTeP2Og1mUJx4e6vzExCuDy+r6GSzGCCdRjVk
-JXPqmxBcWDWJsUZIp/Ss1B2eW8yppRoTTyRQqtkbbbFA+53dWHTEwm8UcuzbNZ+4
-VHVFw6bIGig1Oq5l8qmYzq9byTiMMTt/zNyW/eJb1tBZ9Ha6C8tPgxDHQNAdYOkq
-5UhYdwxFab4ZcQQk4uMkH0rIwT6Z9ZaYOEgloRWwG9fihBhb9nE1mmh7QMwYXAwk
-ndSV9ZmqRuqurL/0FBkk6Izs4/W8BmiKKgwFXwqXdafcfsD913oY3zDROEsfsJhw
-v8x8c/BuxDGlpJcdrL/ObCFKvicjZ/MGVoEKkY624QMFMyzaNAhNTlAjrR+lxdR6
-/uoJ7KcoYItGfLXqm91P+edrFcaIz0Pb5SfcBFZub0YV8VYt6FwMc8MjgTggy8kM
-ac8sqzuEYDMZUv1pFDM=
------END CERTIFICATE-----`
-)
-
 func (s *smimeAPIServer) ListCertificates(ctx context.Context, req *pb.ListSmimeRequest) (*pb.ListSmimeResponse, error) {
 	hub := sentry.GetHubFromContext(ctx)
 	if hub == nil {
@@ -153,15 +111,79 @@ func (s *smimeAPIServer) IssueCertificate(ctx context.Context, req *pb.IssueSmim
 	}
 	hub.AddBreadcrumb(&sentry.Breadcrumb{Message: "Issuing new smime certificate", Category: "info"}, nil)
 
-	logger := log.With(zap.String("user", req.Email))
+	logger := log.With(zap.String("user", req.Email), zap.Bool("generate_key", req.GenerateKey), zap.Bool("functional_mailbox", req.FunctionalMailbox))
 	logger.Info("Issuing new smime certificate")
-	block, _ := pem.Decode([]byte(req.Csr))
+
+	requester := req.PrimaryEmail
+	if requester == "" {
+		requester = req.Email
+	}
+	if err := checkTerms(ctx, logger, s.db, s.terms, requester); err != nil {
+		return nil, err
+	}
+
+	// Subjects differing from the names of the user (transliterated names or
+	// names that cannot be represented) must be confirmed by the user.
+	subject := s.smimeSubject(req)
+	logger = logger.With(zap.String("cert_type", subject.CertType))
+	if !certTypePermitted(req, subject.CertType) {
+		logger.Info("Certificate type not permitted", zap.Strings("allowed_cert_types", req.AllowedCertTypes))
+		return nil, status.Errorf(codes.PermissionDenied, "Certificates of type %s are not permitted for you", subject.CertType)
+	}
+	// HARICA issues all certificates with the validity of the profile, so
+	// shorter limits can only be enforced by rejecting the request.
+	if req.MaxValidityDays > 0 && (s.cfg.SmimeValidityDays <= 0 || s.cfg.SmimeValidityDays > int(req.MaxValidityDays)) {
+		logger.Info("Certificate validity not permitted", zap.Int32("max_validity_days", req.MaxValidityDays), zap.Int("validity_days", s.cfg.SmimeValidityDays))
+		return nil, status.Errorf(codes.PermissionDenied, "Certificates valid for more than %d days are not permitted for you", req.MaxValidityDays)
+	}
+	if subject.confirmationRequired() && req.ConfirmedSubject != subject.String() {
+		logger.Info("Subject not confirmed", zap.String("subject", subject.String()), zap.String("reason", subject.Reason))
+		return nil, status.Errorf(codes.FailedPrecondition, "The subject %q must be confirmed before issuance", subject.String())
+	}
+
+	// The generated key is only kept in memory and returned as PKCS#12.
+	// If key escrow is enabled, the encrypted key is stored as well.
+	csrPEM := req.Csr
+	var key crypto.Signer
+	var sealed *escrow.Sealed
+	if req.GenerateKey {
+		if !s.cfg.SmimeKeyGeneration {
+			return nil, status.Error(codes.Unimplemented, "Server-side key generation is disabled")
+		}
+		if req.Csr != "" {
+			return nil, status.Error(codes.InvalidArgument, "A CSR must not be passed if the key is generated")
+		}
+		if req.Pkcs12Password != "" && len(req.Pkcs12Password) < minPkcs12PasswordLength {
+			return nil, status.Errorf(codes.InvalidArgument, "The password must contain at least %d characters", minPkcs12PasswordLength)
+		}
+		var err error
+		key, csrPEM, err = s.generateSmimeKey(req.Email)
+		if err != nil {
+			hub.CaptureException(err)
+			logger.Error("Error generating key", zap.Error(err))
+			return nil, status.Error(codes.Internal, "Error generating key")
+		}
+		// The key is sealed before requesting the certificate, so no
+		// certificate is issued whose key cannot be escrowed.
+		if s.escrow != nil {
+			sealed, err = s.escrow.Seal(key)
+			if err != nil {
+				hub.CaptureException(err)
+				logger.Error("Error escrowing key", zap.Error(err))
+				return nil, status.Error(codes.Internal, "Error escrowing key")
+			}
+		}
+	}
+	block, _ := pem.Decode([]byte(csrPEM))
+	if block == nil {
+		return nil, status.Error(codes.InvalidArgument, "Invalid CSR")
+	}
 
 	client, err := s.harica.Validation()
 	if err != nil {
 		hub.CaptureException(err)
 		logger.Error("Error while connecting to HARICA", zap.Error(err))
-		return nil, status.Error(codes.Internal, "Error connecting to HARICA")
+		return nil, haricaError(err, "Error connecting to HARICA")
 	}
 
 	// Validate the passed CSR to comply the server-side requirements (e.g. key-strength, key-type, etc.)
@@ -180,48 +202,56 @@ func (s *smimeAPIServer) IssueCertificate(ctx context.Context, req *pb.IssueSmim
 		return nil, status.Error(codes.InvalidArgument, "Invalid CSR")
 	}
 
-	if csr.PublicKeyAlgorithm != x509.RSA {
-		return nil, status.Error(codes.InvalidArgument, "Only RSA keys are supported")
+	keyType, err := s.checkSmimeKey(csr.PublicKey)
+	if err != nil {
+		logger.Warn("Unsupported key", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	logger = logger.With(zap.String("key_type", keyType))
 
-	// Get the public key from the CSR
-	pubKey, ok := csr.PublicKey.(*rsa.PublicKey)
-	size := pubKey.Size() * 8
-	if !ok || fmt.Sprintf("%d", size) != s.cfg.SmimeKeyLength {
-		logger.Warn("Invalid key length", zap.String("key_length", fmt.Sprintf("%d", size)))
-		return nil, status.Error(codes.InvalidArgument, "Invalid CSR")
+	// Fetch the available groups and use the one configured for the mail
+	// domain (or the first one if no organizations are configured)
+	org, err := s.orgs.ForEmail(req.Email)
+	if err != nil {
+		logger.Warn("No organization for the mail domain", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-
-	// Fetch the available groups and use the first one (should be the only one)
-	groups, err := retryHarica(ctx, logger, client, "GetOrganizationsBulk", func() ([]models.Organization, error) {
+	groups, err := retryHarica(ctx, logger, s.harica.breaker, client, "GetOrganizationsBulk", func() ([]models.Organization, error) {
 		return client.GetOrganizationsBulk()
 	})
 	if err != nil {
 		hub.CaptureException(err)
 		logger.Error("Error fetching organizations", zap.Error(err))
-		return nil, status.Error(codes.Internal, "Error fetching organizations")
+		return nil, haricaError(err, "Error fetching organizations")
+	}
+	group, err := selectOrganization(groups, func(o models.Organization) string { return o.OrganizationID }, org)
+	if err != nil {
+		logger.Warn("No matching organization", zap.Error(err))
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	logger = logger.With(zap.String("organization", group.OrganizationID))
+	// Certificates of functional mailboxes are named after the organization
+	// instead of the requesting user.
+	friendlyName := req.CommonName
+	if req.FunctionalMailbox {
+		friendlyName = group.Organization
 	}
 	params := models.SmimeBulkRequest{
 		Email:        req.Email,
-		FriendlyName: req.CommonName,
-		CertType:     "email_only",
-		CSR:          req.Csr,
-	}
-	if ok, err := regexp.MatchString(`^[a-zA-Z \-]+$`, req.FirstName); ok && err == nil {
-		if ok, err := regexp.MatchString(`^[a-zA-Z \-]+$`, req.LastName); ok && err == nil {
-			params.GivenName = req.FirstName
-			params.Surname = req.LastName
-			params.CertType = "natural_legal_lcp"
-		}
+		FriendlyName: friendlyName,
+		CertType:     subject.CertType,
+		GivenName:    subject.GivenName,
+		Surname:      subject.Surname,
+		CSR:          csrPEM,
 	}
 	// Not retried: a repeated bulk request would issue duplicate certificates.
-	cert, err := runHaricaOnce(client, func() (*models.SmimeBulkResponse, error) {
-		return client.RequestSmimeBulkCertificates(groups[0].OrganizationID, params)
+	cert, err := runHaricaOnce(s.harica.breaker, client, func() (*models.SmimeBulkResponse, error) {
+		return client.RequestSmimeBulkCertificates(group.OrganizationID, params)
 	})
 	if err != nil {
 		hub.CaptureException(err)
 		logger.Error("Error requesting certificate", zap.Error(err))
-		return nil, status.Error(codes.Internal, "Error requesting certificate")
+		return nil, haricaError(err, "Error requesting certificate")
 	}
 
 	block, _ = pem.Decode([]byte(cert.Certificate))
@@ -237,31 +267,92 @@ func (s *smimeAPIServer) IssueCertificate(ctx context.Context, req *pb.IssueSmim
 		return nil, status.Error(codes.Internal, "Error parsing certificate")
 	}
 
-	_, err = s.db.SmimeCertificate.Create().
-		SetCreateTime(time.Now()).
-		SetEmail(req.Email).
-		SetSerial(certX509.SerialNumber.String()).
-		SetNotAfter(certX509.NotAfter).
-		SetNotBefore(certX509.NotBefore).
-		SetStatus(smimecertificate.StatusIssued).
-		SetTransactionId(cert.TransactionID).Save(ctx)
-
+	// The primary address of the user additionally receives the expiry
+	// reminders.
+	var owner *string
+	if req.PrimaryEmail != "" {
+		owner = &req.PrimaryEmail
+	}
+	entry, err := s.saveCertificate(ctx, logger, sealed, func(db *ent.Client) (*ent.SmimeCertificate, error) {
+		return db.SmimeCertificate.Create().
+			SetCreateTime(time.Now()).
+			SetEmail(req.Email).
+			SetSerial(certX509.SerialNumber.String()).
+			SetNotAfter(certX509.NotAfter).
+			SetNotBefore(certX509.NotBefore).
+			SetStatus(smimecertificate.StatusIssued).
+			SetOrganization(group.OrganizationID).
+			SetCertificate(string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certX509.Raw}))).
+			SetNillableOwner(owner).
+			SetTransactionId(cert.TransactionID).
+			Save(ctx)
+	})
 	if err != nil {
 		hub.CaptureException(err)
 		logger.Error("Error saving certificate", zap.Error(err))
+		// A certificate whose key cannot be recovered must not stay valid.
+		if sealed != nil {
+			s.revokeUnescrowed(ctx, logger, client, cert.TransactionID)
+		}
 		return nil, status.Error(codes.Internal, "Error saving certificate")
 	}
 
-	cert.Certificate = fmt.Sprintf("%s\n%s\n%s",
-		cert.Certificate,
-		geantIssuer,
-		haricaRoot,
-	)
+	s.publish(logger, req.Email, certX509)
+	s.publishCertificate(ctx, notify.EventCertificateIssued, entry, req.PrimaryEmail, nil)
+
+	chain := s.smimeChain(certX509, pkcs7Certificates(cert.Pkcs7)...)
+	if chain == nil {
+		logger.Warn("Unknown issuer, returning certificate without chain", zap.String("issuer", certX509.Issuer.String()))
+	}
+	cert.Certificate = encodePEM(append([]*x509.Certificate{certX509}, chain...))
+
+	if key == nil {
+		return &pb.IssueSmimeResponse{
+			Certificate: cert.Certificate,
+		}, nil
+	}
 
+	password := req.Pkcs12Password
+	generated := ""
+	if password == "" {
+		password, err = randomPassword()
+		if err != nil {
+			hub.CaptureException(err)
+			logger.Error("Error generating password", zap.Error(err))
+			return nil, status.Error(codes.Internal, "Error generating password")
+		}
+		generated = password
+	}
+	pfx, err := s.encodePkcs12(key, certX509, chain, password)
+	if err != nil {
+		hub.CaptureException(err)
+		logger.Error("Error encoding PKCS#12", zap.Error(err))
+		return nil, status.Error(codes.Internal, "Error encoding PKCS#12")
+	}
 	return &pb.IssueSmimeResponse{
-		Certificate: cert.Certificate,
+		Certificate:    cert.Certificate,
+		Pkcs12:         pfx,
+		Pkcs12Password: generated,
 	}, nil
 }
+
+// revocationReason returns the HARICA revocation reason used for all
+// revocations.
+func (s *smimeAPIServer) revocationReason(ctx context.Context, logger *zap.Logger, client *harica.Client) (*models.RevocationReasonsResponse, error) {
+	reasons, err := retryHarica(ctx, logger, s.harica.breaker, client, "GetRevocationReasons", func() ([]models.RevocationReasonsResponse, error) {
+		return client.GetRevocationReasons()
+	})
+	if err != nil {
+		return nil, haricaError(err, "Error fetching revocation reasons")
+	}
+	for _, r := range reasons {
+		if r.Name == "4.9.1.1.1.1" {
+			return &r, nil
+		}
+	}
+	return nil, status.Error(codes.Internal, "Error fetching revocation reasons")
+}
+
 func (s *smimeAPIServer) RevokeCertificate(ctx context.Context, req *pb.RevokeSmimeRequest) (*emptypb.Empty, error) {
 	hub := sentry.GetHubFromContext(ctx)
 	if hub == nil {
@@ -277,23 +368,11 @@ func (s *smimeAPIServer) RevokeCertificate(ctx context.Context, req *pb.RevokeSm
 	client, err := s.harica.Validation()
 	if err != nil {
 		logger.Error("Error while connecting to HARICA", zap.Error(err))
-		return nil, status.Error(codes.Internal, "Error connecting to HARICA")
+		return nil, haricaError(err, "Error connecting to HARICA")
 	}
-	reasons, err := retryHarica(ctx, logger, client, "GetRevocationReasons", func() ([]models.RevocationReasonsResponse, error) {
-		return client.GetRevocationReasons()
-	})
+	reason, err := s.revocationReason(ctx, logger, client)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "Error fetching revocation reasons")
-	}
-	var reason *models.RevocationReasonsResponse
-	for _, r := range reasons {
-		if r.Name == "4.9.1.1.1.1" {
-			reason = &r
-			break
-		}
-	}
-	if reason == nil {
-		return nil, status.Error(codes.Internal, "Error fetching revocation reasons")
+		return nil, err
 	}
 
 	switch req.Identifier.(type) {
@@ -309,16 +388,18 @@ func (s *smimeAPIServer) RevokeCertificate(ctx context.Context, req *pb.RevokeSm
 		}
 		for _, cert := range certs {
 			s.logger.Info("Revoking smime certificate", zap.String("serial", req.GetSerial()), zap.String("email", cert.Email), zap.String("transaction_id", cert.TransactionId))
-			err := retryHaricaVoid(ctx, logger, client, "RevokeSmimeBulkCertificateEntry", func() error {
+			err := retryHaricaVoid(ctx, logger, s.harica.breaker, client, "RevokeSmimeBulkCertificateEntry", func() error {
 				return client.RevokeSmimeBulkCertificateEntry(cert.TransactionId, req.Reason, reason.Name)
 			})
 			if err != nil {
-				return nil, status.Error(codes.Internal, "Error revoking certificate")
+				return nil, haricaError(err, "Error revoking certificate")
 			}
 			_, err = s.db.SmimeCertificate.UpdateOneID(cert.ID).SetStatus(smimecertificate.StatusRevoked).Save(ctx)
 			if err != nil {
 				return nil, status.Error(codes.Internal, "Error updating certificate")
 			}
+			s.withdraw(logger, cert)
+			s.publishCertificate(ctx, notify.EventCertificateRevoked, cert, req.Actor, map[string]string{"reason": req.Reason})
 
 		}
 
@@ -334,16 +415,18 @@ func (s *smimeAPIServer) RevokeCertificate(ctx context.Context, req *pb.RevokeSm
 		}
 		for _, cert := range certs {
 			s.logger.Info("Revoking smime certificate", zap.String("serial", req.GetSerial()), zap.String("email", cert.Email), zap.String("transaction_id", cert.TransactionId))
-			err := retryHaricaVoid(ctx, logger, client, "RevokeSmimeBulkCertificateEntry", func() error {
+			err := retryHaricaVoid(ctx, logger, s.harica.breaker, client, "RevokeSmimeBulkCertificateEntry", func() error {
 				return client.RevokeSmimeBulkCertificateEntry(cert.TransactionId, req.Reason, reason.Name)
 			})
 			if err != nil {
-				return nil, status.Error(codes.Internal, "Error revoking certificate")
+				return nil, haricaError(err, "Error revoking certificate")
 			}
 			_, err = s.db.SmimeCertificate.UpdateOneID(cert.ID).SetStatus(smimecertificate.StatusRevoked).Save(ctx)
 			if err != nil {
 				return nil, status.Error(codes.Internal, "Error updating certificate")
 			}
+			s.withdraw(logger, cert)
+			s.publishCertificate(ctx, notify.EventCertificateRevoked, cert, req.Actor, map[string]string{"reason": req.Reason})
 		}
 
 		return &emptypb.Empty{}, nil
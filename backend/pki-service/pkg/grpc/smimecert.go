@@ -0,0 +1,105 @@
+package grpc
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"strings"
+
+	"github.com/hm-edu/pki-service/ent"
+	"github.com/hm-edu/pki-service/ent/smimecertificate"
+	pkiHelper "github.com/hm-edu/pki-service/pkg/helper"
+	pb "github.com/hm-edu/portal-apis"
+	"github.com/smallstep/pkcs7"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// smimeChain returns the chain of the given S/MIME certificate built from
+// the configured intermediate and root certificates and the additional
+// certificates (e.g. those returned by HARICA). nil is returned if the
+// issuer is unknown.
+func (s *smimeAPIServer) smimeChain(leaf *x509.Certificate, additional ...*x509.Certificate) []*x509.Certificate {
+	candidates := append(append([]*x509.Certificate{}, s.chain...), additional...)
+	var chain []*x509.Certificate
+	current := leaf
+	for len(chain) <= len(candidates) {
+		var issuer *x509.Certificate
+		for _, c := range candidates {
+			if bytes.Equal(current.RawIssuer, c.RawSubject) && !c.Equal(current) {
+				issuer = c
+				break
+			}
+		}
+		if issuer == nil {
+			break
+		}
+		chain = append(chain, issuer)
+		current = issuer
+	}
+	return chain
+}
+
+// pkcs7Certificates returns the certificates of a PEM or base64 encoded
+// PKCS#7 bundle. Invalid bundles contain no certificates.
+func pkcs7Certificates(bundle string) []*x509.Certificate {
+	var der []byte
+	if block, _ := pem.Decode([]byte(bundle)); block != nil {
+		der = block.Bytes
+	} else if decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(bundle)); err == nil {
+		der = decoded
+	}
+	if len(der) == 0 {
+		return nil
+	}
+	p7, err := pkcs7.Parse(der)
+	if err != nil {
+		return nil
+	}
+	return p7.Certificates
+}
+
+// encodePEM encodes the given certificates as PEM bundle.
+func encodePEM(certs []*x509.Certificate) string {
+	var buf bytes.Buffer
+	for _, c := range certs {
+		_ = pem.Encode(&buf, &pem.Block{Type: "CERTIFICATE", Bytes: c.Raw})
+	}
+	return buf.String()
+}
+
+// GetSmimeCertificate returns a stored S/MIME certificate and its chain. The
+// caller is responsible for checking that the certificate belongs to the
+// user.
+func (s *smimeAPIServer) GetSmimeCertificate(ctx context.Context, req *pb.GetSmimeCertificateRequest) (*pb.GetSmimeCertificateResponse, error) {
+	logger := s.logger.With(zap.String("serial", req.Serial))
+	cert, err := s.db.SmimeCertificate.Query().Where(smimecertificate.Serial(req.Serial)).First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, status.Error(codes.NotFound, "Certificate not found")
+		}
+		logger.Error("Error fetching certificate", zap.Error(err))
+		return nil, status.Error(codes.Internal, "Error fetching certificate")
+	}
+	if cert.Certificate == nil {
+		// Certificates issued before the certificates were stored.
+		return nil, status.Error(codes.NotFound, "The certificate is not stored")
+	}
+	leaf, err := pkiHelper.ParseCertificates([]byte(*cert.Certificate))
+	if err != nil || len(leaf) == 0 {
+		logger.Error("Error parsing stored certificate", zap.Error(err))
+		return nil, status.Error(codes.Internal, "Error parsing certificate")
+	}
+	return &pb.GetSmimeCertificateResponse{
+		Serial:      cert.Serial,
+		Email:       cert.Email,
+		Status:      string(cert.Status),
+		Certificate: *cert.Certificate,
+		Chain:       encodePEM(s.smimeChain(leaf[0])),
+		Expires:     timestamppb.New(cert.NotAfter),
+	}, nil
+}
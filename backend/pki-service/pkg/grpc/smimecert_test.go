@@ -0,0 +1,105 @@
+package grpc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/hm-edu/pki-service/ent"
+	"github.com/hm-edu/pki-service/ent/enttest"
+	"github.com/hm-edu/pki-service/pkg/cfg"
+	pkiHelper "github.com/hm-edu/pki-service/pkg/helper"
+	pb "github.com/hm-edu/portal-apis"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func testCertificate(t *testing.T, name string, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  parent == nil || name != "leaf",
+		BasicConstraintsValid: true,
+	}
+	if parent == nil {
+		parent, parentKey = tmpl, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, parent, &key.PublicKey, parentKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert, key
+}
+
+func TestGetSmimeCertificate(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:smimecert?mode=memory&cache=shared&_fk=1")
+	defer func(*ent.Client) {
+		_ = client.Close()
+	}(client)
+	ctx := context.Background()
+
+	root, rootKey := testCertificate(t, "root", nil, nil)
+	intermediate, intermediateKey := testCertificate(t, "intermediate", root, rootKey)
+	other, _ := testCertificate(t, "other", nil, nil)
+	leaf, _ := testCertificate(t, "leaf", intermediate, intermediateKey)
+
+	s := &smimeAPIServer{cfg: &cfg.PKIConfiguration{}, db: client, logger: zap.L(), chain: []*x509.Certificate{other, root, intermediate}}
+	chain := s.smimeChain(leaf)
+	if len(chain) != 2 || !chain[0].Equal(intermediate) || !chain[1].Equal(root) {
+		t.Fatalf("unexpected chain %v", chain)
+	}
+	if chain := s.smimeChain(other); len(chain) != 0 {
+		t.Errorf("expected no chain for a root, got %d certificates", len(chain))
+	}
+
+	_, err := client.SmimeCertificate.Create().
+		SetSerial(leaf.SerialNumber.String()).
+		SetEmail("jane.doe@hm.edu").
+		SetNotAfter(leaf.NotAfter).
+		SetCertificate(string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: leaf.Raw}))).
+		Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.SmimeCertificate.Create().SetSerial("1").SetEmail("jane.doe@hm.edu").SetNotAfter(time.Now()).Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := s.GetSmimeCertificate(ctx, &pb.GetSmimeCertificateRequest{Serial: leaf.SerialNumber.String()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Email != "jane.doe@hm.edu" {
+		t.Errorf("unexpected email %s", res.Email)
+	}
+	certs, err := pkiHelper.ParseCertificates([]byte(res.Certificate + res.Chain))
+	if err != nil || len(certs) != 3 || !certs[0].Equal(leaf) {
+		t.Errorf("unexpected certificates %v (%v)", certs, err)
+	}
+	if _, err := s.GetSmimeCertificate(ctx, &pb.GetSmimeCertificateRequest{Serial: "1"}); status.Code(err) != codes.NotFound {
+		t.Errorf("expected NotFound for certificates without stored leaf, got %v", err)
+	}
+	if _, err := s.GetSmimeCertificate(ctx, &pb.GetSmimeCertificateRequest{Serial: "2"}); status.Code(err) != codes.NotFound {
+		t.Errorf("expected NotFound, got %v", err)
+	}
+}
@@ -0,0 +1,58 @@
+package grpc
+
+import (
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// defaultSmimeKeyTypes are the key types accepted for S/MIME certificates if
+// nothing else is configured. HARICA derives the key algorithm from the CSR,
+// so the key type is only checked locally.
+var defaultSmimeKeyTypes = []string{"RSA-2048", "RSA-3072", "RSA-4096", "ECDSA-P256", "ECDSA-P384"}
+
+// smimeKeyType returns the key type (e.g. RSA-3072 or ECDSA-P256) of a public
+// key.
+func smimeKeyType(pub any) (string, error) {
+	switch key := pub.(type) {
+	case *rsa.PublicKey:
+		return fmt.Sprintf("RSA-%d", key.N.BitLen()), nil
+	case *ecdsa.PublicKey:
+		return "ECDSA-" + strings.ReplaceAll(key.Curve.Params().Name, "-", ""), nil
+	default:
+		return "", fmt.Errorf("unsupported key algorithm %T", pub)
+	}
+}
+
+// smimeKeyTypes returns the accepted key types. The deprecated key length
+// only accepts RSA keys of exactly that size.
+func (s *smimeAPIServer) smimeKeyTypes() []string {
+	if s.cfg.SmimeKeyLength != "" && s.cfg.SmimeKeyLength != "0" {
+		return []string{"RSA-" + s.cfg.SmimeKeyLength}
+	}
+	if len(s.cfg.SmimeKeyTypes) == 0 {
+		return defaultSmimeKeyTypes
+	}
+	return s.cfg.SmimeKeyTypes
+}
+
+// acceptsKeyType reports whether keys of the given type are accepted.
+func (s *smimeAPIServer) acceptsKeyType(keyType string) bool {
+	return slices.ContainsFunc(s.smimeKeyTypes(), func(t string) bool { return strings.EqualFold(t, keyType) })
+}
+
+// checkSmimeKey returns the key type of the public key or an error describing
+// why the key is not accepted.
+func (s *smimeAPIServer) checkSmimeKey(pub any) (string, error) {
+	accepted := s.smimeKeyTypes()
+	keyType, err := smimeKeyType(pub)
+	if err != nil {
+		return "", fmt.Errorf("%w, supported key types: %s", err, strings.Join(accepted, ", "))
+	}
+	if !s.acceptsKeyType(keyType) {
+		return "", fmt.Errorf("unsupported key type %s, supported key types: %s", keyType, strings.Join(accepted, ", "))
+	}
+	return keyType, nil
+}
@@ -0,0 +1,171 @@
+package grpc
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/hm-edu/pki-service/pkg/cfg"
+	"github.com/smallstep/pkcs7"
+	"software.sslmate.com/src/go-pkcs12"
+)
+
+func TestCheckSmimeKey(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p256, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	p384, _ := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	p521, _ := ecdsa.GenerateKey(elliptic.P521(), rand.Reader)
+	edKey, _, _ := ed25519.GenerateKey(rand.Reader)
+
+	s := &smimeAPIServer{cfg: &cfg.PKIConfiguration{}}
+	for key, want := range map[any]string{
+		&rsaKey.PublicKey: "RSA-2048",
+		&p256.PublicKey:   "ECDSA-P256",
+		&p384.PublicKey:   "ECDSA-P384",
+	} {
+		if got, err := s.checkSmimeKey(key); err != nil || got != want {
+			t.Errorf("expected %s, got %s (%v)", want, got, err)
+		}
+	}
+	if _, err := s.checkSmimeKey(&p521.PublicKey); err == nil || err.Error() != "unsupported key type ECDSA-P521, supported key types: RSA-2048, RSA-3072, RSA-4096, ECDSA-P256, ECDSA-P384" {
+		t.Errorf("unexpected error %v", err)
+	}
+	if _, err := s.checkSmimeKey(edKey); err == nil {
+		t.Error("expected ed25519 keys to be rejected")
+	}
+
+	s.cfg.SmimeKeyTypes = []string{"ecdsa-p256"}
+	if _, err := s.checkSmimeKey(&p256.PublicKey); err != nil {
+		t.Errorf("expected configured key type to be accepted, got %v", err)
+	}
+	if _, err := s.checkSmimeKey(&rsaKey.PublicKey); err == nil {
+		t.Error("expected RSA keys to be rejected")
+	}
+
+	// The deprecated key length only accepts RSA keys of that size.
+	s.cfg.SmimeKeyLength = "4096"
+	if _, err := s.checkSmimeKey(&rsaKey.PublicKey); err == nil {
+		t.Error("expected RSA-2048 to be rejected")
+	}
+	s.cfg.SmimeKeyLength = "0"
+	if _, err := s.checkSmimeKey(&p256.PublicKey); err != nil {
+		t.Errorf("expected disabled key length to be ignored, got %v", err)
+	}
+}
+
+func TestEncodePkcs12(t *testing.T) {
+	s := &smimeAPIServer{cfg: &cfg.PKIConfiguration{SmimeGeneratedKeyType: "ECDSA-P384"}}
+	key, csrPEM, err := s.generateSmimeKey("jane.doe@hm.edu")
+	if err != nil {
+		t.Fatal(err)
+	}
+	block, _ := pem.Decode([]byte(csrPEM))
+	csr, err := x509.ParseCertificateRequest(block.Bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if keyType, err := s.checkSmimeKey(csr.PublicKey); err != nil || keyType != "ECDSA-P384" {
+		t.Errorf("unexpected key type %s (%v)", keyType, err)
+	}
+
+	tmpl := &x509.Certificate{SerialNumber: big.NewInt(1), EmailAddresses: []string{"jane.doe@hm.edu"}, NotBefore: time.Now(), NotAfter: time.Now().Add(time.Hour)}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, key.Public(), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leaf, _ := x509.ParseCertificate(der)
+	if chain := s.smimeChain(leaf); chain != nil {
+		t.Errorf("expected no chain for unknown issuer, got %d certificates", len(chain))
+	}
+	password, err := randomPassword()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pfx, err := s.encodePkcs12(key, leaf, nil, password)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, cert, _, err := pkcs12.DecodeChain(pfx, password)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cert.SerialNumber.Cmp(leaf.SerialNumber) != 0 || !decoded.(*ecdsa.PrivateKey).Equal(key) {
+		t.Error("unexpected PKCS#12 content")
+	}
+
+	if _, err := generateKey("RSA-1024"); err == nil {
+		t.Error("expected RSA-1024 to be rejected")
+	}
+}
+
+func TestGeneratedKeyType(t *testing.T) {
+	s := &smimeAPIServer{cfg: &cfg.PKIConfiguration{SmimeKeyTypes: []string{"RSA-3072", "RSA-4096"}}}
+	if keyType, err := s.generatedKeyType(); err != nil || keyType != "RSA-3072" {
+		t.Errorf("expected default key type, got %s (%v)", keyType, err)
+	}
+	s.cfg.SmimeGeneratedKeyType = "ECDSA-P256"
+	if _, _, err := s.generateSmimeKey("jane.doe@hm.edu"); err == nil {
+		t.Error("expected key type not accepted for CSRs to be rejected")
+	}
+}
+
+func TestPkcs12Chain(t *testing.T) {
+	caKey, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	caTmpl := &x509.Certificate{SerialNumber: big.NewInt(1), Subject: pkix.Name{CommonName: "HARICA Client ECC Root CA"}, IsCA: true, BasicConstraintsValid: true, NotBefore: time.Now(), NotAfter: time.Now().Add(time.Hour)}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTmpl, caTmpl, caKey.Public(), caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ca, _ := x509.ParseCertificate(caDER)
+
+	s := &smimeAPIServer{cfg: &cfg.PKIConfiguration{SmimeGeneratedKeyType: "ECDSA-P256"}}
+	key, _, err := s.generateSmimeKey("jane.doe@hm.edu")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{SerialNumber: big.NewInt(2), Subject: pkix.Name{CommonName: "Jane Doe"}, EmailAddresses: []string{"jane.doe@hm.edu"}, NotBefore: time.Now(), NotAfter: time.Now().Add(time.Hour)}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca, key.Public(), caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leaf, _ := x509.ParseCertificate(der)
+
+	// The chain returned by HARICA is used if the issuer is not configured.
+	bundle, err := pkcs7.DegenerateCertificate(caDER)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain := s.smimeChain(leaf, pkcs7Certificates(base64.StdEncoding.EncodeToString(bundle))...)
+	if len(chain) != 1 || !chain[0].Equal(ca) {
+		t.Fatalf("expected the CA as chain, got %d certificates", len(chain))
+	}
+	pfx, err := s.encodePkcs12(key, leaf, chain, "password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _, caCerts, err := pkcs12.DecodeChain(pfx, "password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(caCerts) != 1 || !caCerts[0].Equal(ca) {
+		t.Errorf("expected the chain in the PKCS#12 bundle, got %d certificates", len(caCerts))
+	}
+	if pkcs7Certificates("invalid") != nil {
+		t.Error("expected no certificates for an invalid bundle")
+	}
+}
@@ -0,0 +1,96 @@
+package grpc
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base32"
+	"encoding/pem"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"software.sslmate.com/src/go-pkcs12"
+)
+
+// minPkcs12PasswordLength is the minimal length of a user provided PKCS#12
+// password.
+const minPkcs12PasswordLength = 8
+
+// generateKey generates a key of the given type (e.g. RSA-3072 or
+// ECDSA-P256).
+func generateKey(keyType string) (crypto.Signer, error) {
+	keyType = strings.ToUpper(keyType)
+	switch {
+	case keyType == "ECDSA-P256":
+		return ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case keyType == "ECDSA-P384":
+		return ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	case strings.HasPrefix(keyType, "RSA-"):
+		bits, err := strconv.Atoi(strings.TrimPrefix(keyType, "RSA-"))
+		if err != nil || bits < 2048 {
+			return nil, fmt.Errorf("invalid key type %s", keyType)
+		}
+		return rsa.GenerateKey(rand.Reader, bits)
+	default:
+		return nil, fmt.Errorf("invalid key type %s", keyType)
+	}
+}
+
+// generatedKeyType returns the configured type of generated keys. An error
+// is returned if the type is not among the accepted key types.
+func (s *smimeAPIServer) generatedKeyType() (string, error) {
+	keyType := s.cfg.SmimeGeneratedKeyType
+	if keyType == "" {
+		keyType = "RSA-3072"
+	}
+	if !s.acceptsKeyType(keyType) {
+		return "", fmt.Errorf("generated key type %s is not among the supported key types: %s", keyType, strings.Join(s.smimeKeyTypes(), ", "))
+	}
+	return keyType, nil
+}
+
+// generateSmimeKey generates a key as configured and a CSR for the given
+// mail address. The subject is replaced by HARICA anyway.
+func (s *smimeAPIServer) generateSmimeKey(email string) (crypto.Signer, string, error) {
+	keyType, err := s.generatedKeyType()
+	if err != nil {
+		return nil, "", err
+	}
+	key, err := generateKey(keyType)
+	if err != nil {
+		return nil, "", err
+	}
+	der, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:        pkix.Name{CommonName: email},
+		EmailAddresses: []string{email},
+	}, key)
+	if err != nil {
+		return nil, "", err
+	}
+	return key, string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der})), nil
+}
+
+// randomPassword generates a random password for a PKCS#12 bundle.
+func randomPassword() (string, error) {
+	b := make([]byte, 15)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base32.StdEncoding.EncodeToString(b), nil
+}
+
+// encodePkcs12 encodes the key, the certificate and its chain as password
+// protected PKCS#12 bundle. The legacy encryption is only required for old
+// clients (e.g. older macOS and Windows versions).
+func (s *smimeAPIServer) encodePkcs12(key crypto.Signer, leaf *x509.Certificate, chain []*x509.Certificate, password string) ([]byte, error) {
+	encoder := pkcs12.Modern
+	if strings.EqualFold(s.cfg.SmimePkcs12Encryption, "legacy") {
+		encoder = pkcs12.Legacy
+	}
+	return encoder.Encode(key, leaf, chain, password)
+}
@@ -0,0 +1,106 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	pb "github.com/hm-edu/portal-apis"
+)
+
+// HARICA certificate types of S/MIME certificates.
+const (
+	smimeTypePersonal  = "natural_legal_lcp"
+	smimeTypeEmailOnly = "email_only"
+)
+
+// smimeSubject is the subject of a S/MIME certificate as requested from
+// HARICA.
+type smimeSubject struct {
+	CertType  string
+	GivenName string
+	Surname   string
+	Email     string
+	// Transliterated reports whether the names had to be transliterated.
+	Transliterated bool
+	// Reason explains why a personal certificate cannot be issued.
+	Reason string
+}
+
+// String returns the subject as shown to the user. The user confirms this
+// representation.
+func (s smimeSubject) String() string {
+	if s.CertType == smimeTypePersonal {
+		return fmt.Sprintf("GN=%s, SN=%s, E=%s", s.GivenName, s.Surname, s.Email)
+	}
+	return "E=" + s.Email
+}
+
+// confirmationRequired reports whether the subject differs from the names of
+// the user, so it must be confirmed before the certificate is issued.
+func (s smimeSubject) confirmationRequired() bool {
+	return s.Transliterated || s.Reason != ""
+}
+
+// smimeSubject builds the subject for the given request. Personal
+// certificates are requested if the names of the user can be represented
+// (after transliteration if required) and the eligibility policy permits
+// them. Certificates of functional mailboxes never contain the names of the
+// requesting user.
+func (s *smimeAPIServer) smimeSubject(req *pb.IssueSmimeRequest) smimeSubject {
+	subject := s.personalSubject(req)
+	if subject.CertType == smimeTypePersonal && !certTypePermitted(req, smimeTypePersonal) && certTypePermitted(req, smimeTypeEmailOnly) {
+		return smimeSubject{CertType: smimeTypeEmailOnly, Email: req.Email, Reason: "Only certificates without names are permitted for you"}
+	}
+	return subject
+}
+
+// certTypePermitted reports whether the eligibility policy permits the
+// certificate type. All types are permitted if the policy sets no limit.
+func certTypePermitted(req *pb.IssueSmimeRequest, certType string) bool {
+	return len(req.AllowedCertTypes) == 0 || slices.Contains(req.AllowedCertTypes, certType)
+}
+
+// personalSubject builds the subject preferring personal certificates.
+func (s *smimeAPIServer) personalSubject(req *pb.IssueSmimeRequest) smimeSubject {
+	subject := smimeSubject{CertType: smimeTypeEmailOnly, Email: req.Email}
+	if req.FunctionalMailbox || strings.TrimSpace(req.FirstName) == "" || strings.TrimSpace(req.LastName) == "" {
+		return subject
+	}
+	givenName, givenChanged, err := s.names.NormalizeName(req.FirstName)
+	if err != nil {
+		subject.Reason = err.Error()
+		return subject
+	}
+	surname, surnameChanged, err := s.names.NormalizeName(req.LastName)
+	if err != nil {
+		subject.Reason = err.Error()
+		return subject
+	}
+	subject.CertType = smimeTypePersonal
+	subject.GivenName = givenName
+	subject.Surname = surname
+	subject.Transliterated = givenChanged || surnameChanged
+	return subject
+}
+
+func mapSmimeSubject(subject smimeSubject) *pb.SmimeSubject {
+	return &pb.SmimeSubject{
+		CertType:             subject.CertType,
+		GivenName:            subject.GivenName,
+		Surname:              subject.Surname,
+		Email:                subject.Email,
+		Subject:              subject.String(),
+		Transliterated:       subject.Transliterated,
+		ConfirmationRequired: subject.confirmationRequired(),
+		Reason:               subject.Reason,
+	}
+}
+
+// PreviewSmimeSubject returns the subject a certificate for the given request
+// would be issued with. Subjects that differ from the names of the user must
+// be confirmed by passing the returned subject as ConfirmedSubject.
+func (s *smimeAPIServer) PreviewSmimeSubject(_ context.Context, req *pb.IssueSmimeRequest) (*pb.SmimeSubject, error) {
+	return mapSmimeSubject(s.smimeSubject(req)), nil
+}
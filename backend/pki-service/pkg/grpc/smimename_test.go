@@ -0,0 +1,77 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hm-edu/pki-service/pkg/cfg"
+	pb "github.com/hm-edu/portal-apis"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSmimeSubject(t *testing.T) {
+	s := &smimeAPIServer{cfg: &cfg.PKIConfiguration{}, logger: zap.L()}
+
+	subject := s.smimeSubject(&pb.IssueSmimeRequest{Email: "a.mueller@hm.edu", FirstName: "Anna", LastName: "Müller"})
+	if subject.CertType != smimeTypePersonal || subject.Surname != "Müller" || subject.confirmationRequired() {
+		t.Errorf("unexpected subject %+v", subject)
+	}
+	if subject.String() != "GN=Anna, SN=Müller, E=a.mueller@hm.edu" {
+		t.Errorf("unexpected subject %s", subject)
+	}
+
+	subject = s.smimeSubject(&pb.IssueSmimeRequest{Email: "team@hm.edu"})
+	if subject.CertType != smimeTypeEmailOnly || subject.confirmationRequired() {
+		t.Errorf("unexpected subject %+v", subject)
+	}
+
+	// Certificates of functional mailboxes never contain the names of the
+	// requesting user.
+	subject = s.smimeSubject(&pb.IssueSmimeRequest{Email: "it-support@hm.edu", FirstName: "Anna", LastName: "Müller", FunctionalMailbox: true})
+	if subject.CertType != smimeTypeEmailOnly || subject.GivenName != "" || subject.confirmationRequired() {
+		t.Errorf("unexpected subject %+v", subject)
+	}
+
+	// Names that cannot be represented are not downgraded silently.
+	req := &pb.IssueSmimeRequest{Email: "ivan@hm.edu", FirstName: "Иван", LastName: "Petrov"}
+	preview, err := s.PreviewSmimeSubject(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if preview.CertType != smimeTypeEmailOnly || !preview.ConfirmationRequired || preview.Subject != "E=ivan@hm.edu" || preview.Reason == "" {
+		t.Errorf("unexpected preview %+v", preview)
+	}
+	if _, err := s.IssueCertificate(context.Background(), req); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("expected FailedPrecondition for unconfirmed subject, got %v", err)
+	}
+	req.ConfirmedSubject = "GN=Ivan, SN=Petrov, E=ivan@hm.edu"
+	if _, err := s.IssueCertificate(context.Background(), req); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("expected FailedPrecondition for a different subject, got %v", err)
+	}
+}
+
+func TestSmimeSubjectPolicy(t *testing.T) {
+	s := &smimeAPIServer{cfg: &cfg.PKIConfiguration{SmimeValidityDays: 730}, logger: zap.L()}
+
+	// Personal certificates are downgraded if the policy only permits
+	// email-only certificates; the user must confirm the new subject.
+	req := &pb.IssueSmimeRequest{Email: "a.mueller@hm.edu", FirstName: "Anna", LastName: "Müller", AllowedCertTypes: []string{smimeTypeEmailOnly}}
+	subject := s.smimeSubject(req)
+	if subject.CertType != smimeTypeEmailOnly || !subject.confirmationRequired() {
+		t.Errorf("unexpected subject %+v", subject)
+	}
+
+	// Email-only certificates are rejected if only personal certificates
+	// are permitted.
+	req = &pb.IssueSmimeRequest{Email: "team@hm.edu", AllowedCertTypes: []string{smimeTypePersonal}}
+	if _, err := s.IssueCertificate(context.Background(), req); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("expected PermissionDenied for a certificate type, got %v", err)
+	}
+
+	req = &pb.IssueSmimeRequest{Email: "team@hm.edu", MaxValidityDays: 365}
+	if _, err := s.IssueCertificate(context.Background(), req); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("expected PermissionDenied for the validity, got %v", err)
+	}
+}
@@ -0,0 +1,47 @@
+package grpc
+
+import (
+	"crypto/x509"
+	"errors"
+
+	"github.com/hm-edu/pki-service/ent"
+	pkiHelper "github.com/hm-edu/pki-service/pkg/helper"
+	"github.com/hm-edu/pki-service/pkg/publisher"
+	"go.uber.org/zap"
+)
+
+// publish adds an issued certificate to the directory. Failures do not fail
+// the issuance; the directory is repaired by the resync command.
+func (s *smimeAPIServer) publish(logger *zap.Logger, email string, cert *x509.Certificate) {
+	if s.publisher == nil {
+		return
+	}
+	err := s.publisher.Publish(email, cert)
+	if errors.Is(err, publisher.ErrEntryNotFound) {
+		logger.Info("No directory entry found, certificate is not published")
+		return
+	}
+	if err != nil {
+		logger.Error("Error publishing certificate to the directory", zap.Error(err))
+		return
+	}
+	logger.Info("Published certificate to the directory")
+}
+
+// withdraw removes a revoked certificate from the directory. Failures are
+// only logged.
+func (s *smimeAPIServer) withdraw(logger *zap.Logger, cert *ent.SmimeCertificate) {
+	if s.publisher == nil || cert.Certificate == nil {
+		return
+	}
+	parsed, err := pkiHelper.ParseCertificates([]byte(*cert.Certificate))
+	if err != nil || len(parsed) == 0 {
+		logger.Error("Error parsing stored certificate", zap.Error(err))
+		return
+	}
+	if err := s.publisher.Withdraw(cert.Email, parsed[0]); err != nil {
+		logger.Error("Error withdrawing certificate from the directory", zap.Error(err))
+		return
+	}
+	logger.Info("Withdrew certificate from the directory", zap.String("serial", cert.Serial))
+}
@@ -0,0 +1,214 @@
+package grpc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/hex"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/getsentry/sentry-go"
+	"github.com/hm-edu/pki-service/ent"
+	"github.com/hm-edu/pki-service/ent/emailverification"
+	"github.com/hm-edu/pki-service/pkg/notify"
+	pb "github.com/hm-edu/portal-apis"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// verificationCodeTTL is the lifetime of a one-time code.
+	verificationCodeTTL = 24 * time.Hour
+	// verificationResendDelay is the minimum delay between two codes for the
+	// same address.
+	verificationResendDelay = time.Minute
+	// verificationMaxAttempts is the number of wrong codes after which the
+	// pending code is discarded.
+	verificationMaxAttempts = 5
+)
+
+// mailer delivers mails, it is implemented by notify.Dispatcher.
+type mailer interface {
+	SendMail(ctx context.Context, msg notify.Message) error
+}
+
+// verificationLink returns the confirmation link for the code or an empty
+// string if no page is configured.
+func verificationLink(page, email, code string) string {
+	if page == "" {
+		return ""
+	}
+	u, err := url.Parse(page)
+	if err != nil {
+		return ""
+	}
+	q := u.Query()
+	q.Set("email", email)
+	q.Set("code", code)
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+// newVerificationCode generates a random one-time code and its hash.
+func newVerificationCode() (string, string, error) {
+	buf := make([]byte, 5)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", err
+	}
+	code := base32.StdEncoding.EncodeToString(buf)
+	return code, hashVerificationCode(code), nil
+}
+
+func hashVerificationCode(code string) string {
+	sum := sha256.Sum256([]byte(strings.ToUpper(strings.TrimSpace(code))))
+	return hex.EncodeToString(sum[:])
+}
+
+func mapVerifiedEmail(v *ent.EmailVerification) *pb.VerifiedEmail {
+	r := &pb.VerifiedEmail{Email: v.Email}
+	if v.VerifiedAt != nil {
+		r.VerifiedAt = timestamppb.New(*v.VerifiedAt)
+	}
+	if v.ValidUntil != nil {
+		r.ValidUntil = timestamppb.New(*v.ValidUntil)
+	}
+	return r
+}
+
+// StartEmailVerification creates a new one-time code for an address of the
+// user and mails it to the address. The code is not returned to the caller.
+func (s *smimeAPIServer) StartEmailVerification(ctx context.Context, req *pb.StartEmailVerificationRequest) (*pb.EmailVerificationChallenge, error) {
+	user, email := strings.ToLower(req.User), strings.ToLower(req.Email)
+	if user == "" || email == "" {
+		return nil, status.Error(codes.InvalidArgument, "User and email are required")
+	}
+	if s.mailer == nil {
+		return nil, status.Error(codes.Unimplemented, "Verification mails require the notifications to be enabled")
+	}
+	logger := s.logger.With(zap.String("user", user), zap.String("email", email))
+	existing, err := s.db.EmailVerification.Query().
+		Where(emailverification.User(user), emailverification.Email(email)).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, status.Error(codes.Internal, "Error querying verifications")
+	}
+	now := time.Now()
+	if existing != nil && existing.CodeExpires != nil &&
+		existing.CodeExpires.Add(-verificationCodeTTL).Add(verificationResendDelay).After(now) {
+		return nil, status.Error(codes.ResourceExhausted, "A code was sent recently, please try again later")
+	}
+	code, hash, err := newVerificationCode()
+	if err != nil {
+		sentry.CaptureException(err)
+		return nil, status.Error(codes.Internal, "Error generating code")
+	}
+	expires := now.Add(verificationCodeTTL)
+	if existing == nil {
+		err = s.db.EmailVerification.Create().
+			SetUser(user).
+			SetEmail(email).
+			SetCodeHash(hash).
+			SetCodeExpires(expires).
+			Exec(ctx)
+	} else {
+		err = existing.Update().
+			SetCodeHash(hash).
+			SetCodeExpires(expires).
+			SetAttempts(0).
+			Exec(ctx)
+	}
+	if err != nil {
+		sentry.CaptureException(err)
+		logger.Error("Error storing verification code", zap.Error(err))
+		return nil, status.Error(codes.Internal, "Error storing verification code")
+	}
+	err = s.mailer.SendMail(ctx, notify.Message{
+		Event: notify.EventEmailVerification,
+		To:    []string{email},
+		Data: notify.EmailVerification{
+			User:    user,
+			Email:   email,
+			Code:    code,
+			Expires: expires,
+			Link:    verificationLink(s.cfg.SmimeVerificationURL, email, code),
+		},
+	})
+	if err != nil {
+		sentry.CaptureException(err)
+		logger.Error("Error sending verification mail", zap.Error(err))
+		return nil, status.Error(codes.Internal, "Error sending the verification mail")
+	}
+	logger.Info("Email verification started", zap.Time("expires", expires))
+	return &pb.EmailVerificationChallenge{Email: email, Expires: timestamppb.New(expires)}, nil
+}
+
+// ConfirmEmailVerification checks the one-time code and marks the address as
+// verified for the requested number of days.
+func (s *smimeAPIServer) ConfirmEmailVerification(ctx context.Context, req *pb.ConfirmEmailVerificationRequest) (*pb.VerifiedEmail, error) {
+	user, email := strings.ToLower(req.User), strings.ToLower(req.Email)
+	if req.ValidDays <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "The validity must be positive")
+	}
+	logger := s.logger.With(zap.String("user", user), zap.String("email", email))
+	v, err := s.db.EmailVerification.Query().
+		Where(emailverification.User(user), emailverification.Email(email)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, status.Error(codes.FailedPrecondition, "No pending verification for this address")
+		}
+		return nil, status.Error(codes.Internal, "Error querying verifications")
+	}
+	now := time.Now()
+	if v.CodeHash == "" || v.CodeExpires == nil || v.CodeExpires.Before(now) {
+		return nil, status.Error(codes.FailedPrecondition, "No pending verification for this address")
+	}
+	if subtle.ConstantTimeCompare([]byte(v.CodeHash), []byte(hashVerificationCode(req.Code))) != 1 {
+		update := v.Update().AddAttempts(1)
+		if v.Attempts+1 >= verificationMaxAttempts {
+			update.ClearCodeHash().ClearCodeExpires()
+		}
+		if err := update.Exec(ctx); err != nil {
+			logger.Error("Error updating verification", zap.Error(err))
+		}
+		logger.Warn("Invalid verification code", zap.Int("attempts", v.Attempts+1))
+		return nil, status.Error(codes.InvalidArgument, "Invalid code")
+	}
+	v, err = v.Update().
+		ClearCodeHash().
+		ClearCodeExpires().
+		SetAttempts(0).
+		SetVerifiedAt(now).
+		SetValidUntil(now.AddDate(0, 0, int(req.ValidDays))).
+		Save(ctx)
+	if err != nil {
+		sentry.CaptureException(err)
+		logger.Error("Error storing verification", zap.Error(err))
+		return nil, status.Error(codes.Internal, "Error storing verification")
+	}
+	logger.Info("Email verified", zap.Time("valid_until", *v.ValidUntil))
+	return mapVerifiedEmail(v), nil
+}
+
+// ListVerifiedEmails returns the addresses of the user with a valid
+// verification.
+func (s *smimeAPIServer) ListVerifiedEmails(ctx context.Context, req *pb.ListVerifiedEmailsRequest) (*pb.ListVerifiedEmailsResponse, error) {
+	items, err := s.db.EmailVerification.Query().
+		Where(emailverification.User(strings.ToLower(req.User)), emailverification.ValidUntilGT(time.Now())).
+		Order(ent.Asc(emailverification.FieldEmail)).
+		All(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Error querying verifications")
+	}
+	emails := make([]*pb.VerifiedEmail, 0, len(items))
+	for _, v := range items {
+		emails = append(emails, mapVerifiedEmail(v))
+	}
+	return &pb.ListVerifiedEmailsResponse{Emails: emails}, nil
+}
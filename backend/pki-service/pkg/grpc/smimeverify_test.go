@@ -0,0 +1,132 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hm-edu/pki-service/ent"
+	"github.com/hm-edu/pki-service/ent/emailverification"
+	"github.com/hm-edu/pki-service/ent/enttest"
+	"github.com/hm-edu/pki-service/pkg/cfg"
+	"github.com/hm-edu/pki-service/pkg/notify"
+	pb "github.com/hm-edu/portal-apis"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// capturingMailer records the sent mails.
+type capturingMailer struct {
+	messages []notify.Message
+}
+
+func (m *capturingMailer) SendMail(_ context.Context, msg notify.Message) error {
+	m.messages = append(m.messages, msg)
+	return nil
+}
+
+func TestEmailVerification(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:emailverification?mode=memory&cache=shared&_fk=1")
+	defer func(*ent.Client) {
+		_ = client.Close()
+	}(client)
+	ctx := context.Background()
+	s := &smimeAPIServer{cfg: &cfg.PKIConfiguration{SmimeVerificationURL: "https://pki.hm.edu/verify"}, db: client, logger: zap.L()}
+
+	if _, err := s.StartEmailVerification(ctx, &pb.StartEmailVerificationRequest{User: "jane.doe@hm.edu", Email: "jane@cs.hm.edu"}); status.Code(err) != codes.Unimplemented {
+		t.Fatalf("expected Unimplemented without mailer, got %v", err)
+	}
+	mailer := &capturingMailer{}
+	s.mailer = mailer
+
+	_, err := s.ConfirmEmailVerification(ctx, &pb.ConfirmEmailVerificationRequest{User: "jane.doe@hm.edu", Email: "jane@cs.hm.edu", Code: "X", ValidDays: 30})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition, got %v", err)
+	}
+
+	challenge, err := s.StartEmailVerification(ctx, &pb.StartEmailVerificationRequest{User: "jane.doe@hm.edu", Email: "Jane@cs.hm.edu"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if challenge.Code != "" || challenge.Email != "jane@cs.hm.edu" {
+		t.Fatalf("unexpected challenge %+v", challenge)
+	}
+	if len(mailer.messages) != 1 || mailer.messages[0].Event != notify.EventEmailVerification || mailer.messages[0].To[0] != "jane@cs.hm.edu" {
+		t.Fatalf("unexpected mails %+v", mailer.messages)
+	}
+	sent := mailer.messages[0].Data.(notify.EmailVerification)
+	if sent.User != "jane.doe@hm.edu" || sent.Link != "https://pki.hm.edu/verify?code="+sent.Code+"&email=jane%40cs.hm.edu" {
+		t.Fatalf("unexpected mail data %+v", sent)
+	}
+	challenge.Code = sent.Code
+	if _, err := s.StartEmailVerification(ctx, &pb.StartEmailVerificationRequest{User: "jane.doe@hm.edu", Email: "jane@cs.hm.edu"}); status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("expected ResourceExhausted, got %v", err)
+	}
+
+	// Codes are bound to the user.
+	_, err = s.ConfirmEmailVerification(ctx, &pb.ConfirmEmailVerificationRequest{User: "john.doe@hm.edu", Email: "jane@cs.hm.edu", Code: challenge.Code, ValidDays: 30})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition, got %v", err)
+	}
+	_, err = s.ConfirmEmailVerification(ctx, &pb.ConfirmEmailVerificationRequest{User: "jane.doe@hm.edu", Email: "jane@cs.hm.edu", Code: "WRONG", ValidDays: 30})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument, got %v", err)
+	}
+
+	list, _ := s.ListVerifiedEmails(ctx, &pb.ListVerifiedEmailsRequest{User: "jane.doe@hm.edu"})
+	if len(list.Emails) != 0 {
+		t.Fatalf("expected no verified emails, got %d", len(list.Emails))
+	}
+
+	verified, err := s.ConfirmEmailVerification(ctx, &pb.ConfirmEmailVerificationRequest{User: "jane.doe@hm.edu", Email: "jane@cs.hm.edu", Code: " " + challenge.Code + " ", ValidDays: 30})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d := time.Until(verified.ValidUntil.AsTime()); d < 29*24*time.Hour || d > 31*24*time.Hour {
+		t.Fatalf("unexpected validity %v", verified.ValidUntil.AsTime())
+	}
+	// The code can only be used once.
+	_, err = s.ConfirmEmailVerification(ctx, &pb.ConfirmEmailVerificationRequest{User: "jane.doe@hm.edu", Email: "jane@cs.hm.edu", Code: challenge.Code, ValidDays: 30})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition, got %v", err)
+	}
+
+	list, _ = s.ListVerifiedEmails(ctx, &pb.ListVerifiedEmailsRequest{User: "jane.doe@hm.edu"})
+	if len(list.Emails) != 1 || list.Emails[0].Email != "jane@cs.hm.edu" {
+		t.Fatalf("unexpected verified emails %+v", list.Emails)
+	}
+
+	// Expired verifications are not listed.
+	client.EmailVerification.Update().Where(emailverification.Email("jane@cs.hm.edu")).SetValidUntil(time.Now().Add(-time.Minute)).ExecX(ctx)
+	list, _ = s.ListVerifiedEmails(ctx, &pb.ListVerifiedEmailsRequest{User: "jane.doe@hm.edu"})
+	if len(list.Emails) != 0 {
+		t.Fatalf("expected no verified emails, got %d", len(list.Emails))
+	}
+}
+
+func TestEmailVerificationAttempts(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:emailverificationattempts?mode=memory&cache=shared&_fk=1")
+	defer func(*ent.Client) {
+		_ = client.Close()
+	}(client)
+	ctx := context.Background()
+	mailer := &capturingMailer{}
+	s := &smimeAPIServer{cfg: &cfg.PKIConfiguration{}, db: client, logger: zap.L(), mailer: mailer}
+
+	if _, err := s.StartEmailVerification(ctx, &pb.StartEmailVerificationRequest{User: "jane.doe@hm.edu", Email: "jane@cs.hm.edu"}); err != nil {
+		t.Fatal(err)
+	}
+	code := mailer.messages[0].Data.(notify.EmailVerification).Code
+	for range verificationMaxAttempts {
+		_, err := s.ConfirmEmailVerification(ctx, &pb.ConfirmEmailVerificationRequest{User: "jane.doe@hm.edu", Email: "jane@cs.hm.edu", Code: "WRONG", ValidDays: 30})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("expected InvalidArgument, got %v", err)
+		}
+	}
+	// The code is discarded after too many attempts.
+	_, err := s.ConfirmEmailVerification(ctx, &pb.ConfirmEmailVerificationRequest{User: "jane.doe@hm.edu", Email: "jane@cs.hm.edu", Code: code, ValidDays: 30})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition, got %v", err)
+	}
+}
@@ -4,8 +4,8 @@ import (
 	"context"
 	"crypto/x509"
 	"encoding/pem"
+	"errors"
 	"fmt"
-	"strings"
 	"sync"
 	"time"
 
@@ -20,7 +20,9 @@ import (
 	"github.com/hm-edu/pki-service/ent/predicate"
 	"github.com/hm-edu/pki-service/pkg/acme"
 	"github.com/hm-edu/pki-service/pkg/cfg"
+	"github.com/hm-edu/pki-service/pkg/events"
 	pkiHelper "github.com/hm-edu/pki-service/pkg/helper"
+	"github.com/hm-edu/pki-service/pkg/notify"
 	pb "github.com/hm-edu/portal-apis"
 	"github.com/hm-edu/portal-common/helper"
 
@@ -57,6 +59,10 @@ func mapCertificate(x *ent.Certificate) *pb.SslCertificateDetails {
 	if x.Ca != nil {
 		ca = *x.Ca
 	}
+	certType := ""
+	if x.CertType != nil {
+		certType = *x.CertType
+	}
 	return &pb.SslCertificateDetails{
 		Id:                      int32(x.SslId),
 		DbId:                    int32(x.ID),
@@ -71,6 +77,7 @@ func mapCertificate(x *ent.Certificate) *pb.SslCertificateDetails {
 		Created:                 created,
 		Ca:                      ca,
 		TransactionId:           x.TransactionId,
+		CertType:                certType,
 	}
 }
 
@@ -84,6 +91,10 @@ func flattenCertificates(certs []*x509.Certificate) string {
 }
 
 func (s *sslAPIServer) handleError(msg string, err error, logger *zap.Logger, hub *sentry.Hub) (*pb.IssueSslResponse, error) {
+	if errors.Is(err, errHaricaUnavailable) {
+		logger.Warn(msg, zap.Error(err))
+		return nil, haricaError(err, msg)
+	}
 	hub.AddBreadcrumb(&sentry.Breadcrumb{Message: msg, Category: "error", Level: sentry.LevelError}, nil)
 	hub.CaptureException(err)
 	logger.Error(msg, zap.Error(err))
@@ -97,18 +108,29 @@ type sslAPIServer struct {
 	logger *zap.Logger
 	harica *haricaClients
 	acme   *acme.Client
+	orgs   *cfg.OrganizationConfig
+	types  *cfg.CertTypeConfig
+	// terms must be accepted before certificates are issued (optional).
+	terms *cfg.TermsConfig
+	// events notifies users about issued and revoked certificates
+	// (optional).
+	events *events.Hub
 
 	last     *time.Time
 	duration *time.Duration
 }
 
-func newSslAPIServer(cfg *cfg.PKIConfiguration, db *ent.Client, clients *haricaClients, acmeClient *acme.Client) *sslAPIServer {
+func newSslAPIServer(cfg *cfg.PKIConfiguration, db *ent.Client, clients *haricaClients, acmeClient *acme.Client, orgs *cfg.OrganizationConfig, types *cfg.CertTypeConfig, terms *cfg.TermsConfig, hub *events.Hub) *sslAPIServer {
 	instance := &sslAPIServer{
 		cfg:    cfg,
 		logger: zap.L(),
 		db:     db,
 		harica: clients,
 		acme:   acmeClient,
+		orgs:   orgs,
+		types:  types,
+		terms:  terms,
+		events: hub,
 	}
 	_ = promauto.NewGaugeFunc(prometheus.GaugeOpts{
 		Name: "ssl_issue_last_duration",
@@ -183,6 +205,10 @@ func (s *sslAPIServer) IssueCertificate(ctx context.Context, req *pb.IssueSslReq
 
 	logger := log.With(zap.String("issuer", req.Issuer))
 
+	if err := checkTerms(ctx, logger, s.db, s.terms, req.Issuer); err != nil {
+		return nil, err
+	}
+
 	block, _ := pem.Decode([]byte(req.Csr))
 
 	if block == nil {
@@ -201,24 +227,59 @@ func (s *sslAPIServer) IssueCertificate(ctx context.Context, req *pb.IssueSslReq
 	if err := csr.CheckSignature(); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "Invalid CSR signature")
 	}
+	// IP addresses and domain names are normalized so they match the
+	// stored fqdn values regardless of their textual representation.
 	var sans []string
 	if csr.Subject.CommonName != "" {
-		sans = []string{csr.Subject.CommonName}
+		sans = []string{helper.NormalizeFqdn(csr.Subject.CommonName)}
 	}
 
 	for _, domain := range req.SubjectAlternativeNames {
-		if domain != csr.Subject.CommonName && domain != "" {
+		domain = helper.NormalizeFqdn(domain)
+		if domain != "" && !helper.Contains(sans, domain) {
 			sans = append(sans, domain)
 		}
 	}
 	ids := []int{}
 
+	// Certificates can only be requested for a single configured
+	// organization. The check is performed for all CAs since ACME requests
+	// may fall back to HARICA.
+	org, err := s.orgs.ForDomains(sans)
+	if err != nil {
+		logger.Warn("No single organization for the request", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	useAcme := s.canUseAcme(csr, sans, logger)
+	certType, err := s.resolveCertType(req.CertType, useAcme, sans, req.Groups)
+	if err != nil {
+		logger.Warn("Certificate type not allowed", zap.Error(err))
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
 	ca := "harica"
-	if s.canUseAcme(csr, sans, logger) {
+	var caReason *string
+	queue := false
+	// ACME CAs only issue domain validated certificates.
+	if useAcme && certType == acmeCertType {
 		ca = "letsencrypt"
+		prediction, err := s.predictAcmeRateLimit(ctx, sans)
+		if err != nil {
+			return s.handleError("Error while checking ACME rate limits", err, logger, hub)
+		}
+		if prediction != nil {
+			logger.Warn("ACME rate limit expected", zap.String("reason", prediction.Reason), zap.Time("until", prediction.Until))
+			caReason = &prediction.Reason
+			if s.queueAcmeRequests() && !req.WaitForIssue {
+				queue = true
+			} else {
+				ca = "harica"
+			}
+		}
 	}
 
-	logger = logger.With(zap.Strings("subject_alternative_names", sans), zap.String("ca", ca))
+	logger = logger.With(zap.Strings("subject_alternative_names", sans), zap.String("ca", ca), zap.String("cert_type", certType))
 	logger.Info("Issuing new server certificate")
 
 	for _, fqdn := range sans {
@@ -239,6 +300,8 @@ func (s *sslAPIServer) IssueCertificate(ctx context.Context, req *pb.IssueSslReq
 		SetIssuedBy(req.Issuer).
 		SetSource(req.Source).
 		SetCa(ca).
+		SetNillableCaReason(caReason).
+		SetCertType(certType).
 		AddDomainIDs(ids...).
 		Save(ctx)
 
@@ -254,7 +317,24 @@ func (s *sslAPIServer) IssueCertificate(ctx context.Context, req *pb.IssueSslReq
 	}
 
 	if ca == "letsencrypt" {
-		return s.issueAcmeCertificate(ctx, logger, hub, entry, csr, time.Now())
+		if queue {
+			return s.queueAcmeCertificate(ctx, logger, hub, entry, req.Csr, *caReason)
+		}
+		resp, err := s.issueAcmeCertificate(ctx, logger, hub, entry, sans, csr, time.Now())
+		var limited *acme.RateLimitError
+		if !errors.As(err, &limited) {
+			return resp, err
+		}
+		reason := limited.Error()
+		logger.Warn("ACME order rate limited", zap.Error(err))
+		if s.queueAcmeRequests() && !req.WaitForIssue {
+			return s.queueAcmeCertificate(ctx, logger, hub, entry, req.Csr, reason)
+		}
+		logger.Info("Falling back to HARICA")
+		entry, err = s.db.Certificate.UpdateOneID(entry.ID).SetCa("harica").SetCaReason(reason).Save(ctx)
+		if err != nil {
+			return s.handleError("Error while storing certificate", err, logger, hub)
+		}
 	}
 
 	client, err := s.harica.Client()
@@ -267,26 +347,39 @@ func (s *sslAPIServer) IssueCertificate(ctx context.Context, req *pb.IssueSslReq
 	}
 
 	// Check which organization the domains belong to
-	orgs, err := retryHarica(ctx, logger, client, "CheckMatchingOrganization", func() ([]models.OrganizationResponse, error) {
+	orgs, err := retryHarica(ctx, logger, s.harica.breaker, client, "CheckMatchingOrganization", func() ([]models.OrganizationResponse, error) {
 		return client.CheckMatchingOrganization(sans)
 	})
-	if err != nil || len(orgs) == 0 {
+	if err != nil {
+		return s.handleError("Error while checking organization", err, logger, hub)
+	}
+	selected, err := selectOrganization(orgs, func(o models.OrganizationResponse) string { return o.ID }, org)
+	var notValidated *organizationNotValidatedError
+	if errors.As(err, &notValidated) {
+		logger.Warn("Configured organization not offered by HARICA", zap.Error(err))
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err != nil {
 		return s.handleError("Error while checking organization", err, logger, hub)
 	}
+	logger = logger.With(zap.String("organization", selected.ID))
 
-	transaction, err := retryHarica(ctx, logger, client, "RequestCertificate", func() (*models.CertificateRequestResponse, error) {
-		return client.RequestCertificate(sans, req.Csr, s.cfg.CertType, orgs[0])
+	transaction, err := retryHarica(ctx, logger, s.harica.breaker, client, "RequestCertificate", func() (*models.CertificateRequestResponse, error) {
+		return client.RequestCertificate(sans, req.Csr, certType, selected)
 	})
 	if err != nil {
 		return s.handleError("Error while requesting certificate", err, logger, hub)
 	}
 
-	entry, err = s.db.Certificate.UpdateOneID(entry.ID).SetTransactionId(transaction.TransactionID).Save(ctx)
+	entry, err = s.db.Certificate.UpdateOneID(entry.ID).
+		SetTransactionId(transaction.TransactionID).
+		SetOrganization(selected.ID).
+		Save(ctx)
 	if err != nil {
 		return s.handleError("Error while storing certificate", err, logger, hub)
 	}
 
-	reviews, err := retryHarica(ctx, logger, validationClient, "GetPendingReviews", func() ([]models.ReviewResponse, error) {
+	reviews, err := retryHarica(ctx, logger, s.harica.breaker, validationClient, "GetPendingReviews", func() ([]models.ReviewResponse, error) {
 		return validationClient.GetPendingReviews()
 	})
 	if err != nil {
@@ -297,7 +390,7 @@ func (s *sslAPIServer) IssueCertificate(ctx context.Context, req *pb.IssueSslReq
 	for _, r := range reviews {
 		if r.TransactionID == transaction.TransactionID {
 			for _, sub := range r.ReviewGetDTOs {
-				err = retryHaricaVoid(ctx, logger, validationClient, "ApproveRequest", func() error {
+				err = retryHaricaVoid(ctx, logger, s.harica.breaker, validationClient, "ApproveRequest", func() error {
 					return validationClient.ApproveRequest(sub.ReviewID, "Auto Approval", sub.ReviewValue)
 				})
 				if err != nil {
@@ -313,7 +406,7 @@ func (s *sslAPIServer) IssueCertificate(ctx context.Context, req *pb.IssueSslReq
 		return &pb.IssueSslResponse{TransactionId: transaction.TransactionID}, nil
 	}
 	logger.Info("Request approved. Collecting certificate")
-	cert, err := retryHarica(ctx, logger, client, "GetCertificate", func() (*models.CertificateResponse, error) {
+	cert, err := retryHarica(ctx, logger, s.harica.breaker, client, "GetCertificate", func() (*models.CertificateResponse, error) {
 		return client.GetCertificate(transaction.TransactionID)
 	})
 	if err != nil {
@@ -348,6 +441,27 @@ func (s *sslAPIServer) CollectCertificate(ctx context.Context, req *pb.CollectSs
 		return nil, status.Error(codes.NotFound, "Certificate not found")
 	}
 
+	// Queued ACME requests are processed in the background.
+	if entry.Ca != nil && *entry.Ca == "letsencrypt" {
+		if entry.Status == certificate.StatusInvalid {
+			reason := "ACME order failed"
+			if entry.CaReason != nil {
+				reason = *entry.CaReason
+			}
+			logger.Warn("Queued certificate was given up", zap.String("reason", reason))
+			return nil, status.Error(codes.FailedPrecondition, reason)
+		}
+		if entry.Status != certificate.StatusIssued || entry.Certificate == nil {
+			logger.Info("Certificate not issued yet")
+			return &pb.IssueSslResponse{TransactionId: req.TransactionId}, nil
+		}
+		certs, err := pkiHelper.ParseCertificates([]byte(*entry.Certificate))
+		if err != nil {
+			return s.handleError("Error parsing certificate", err, logger, hub)
+		}
+		return &pb.IssueSslResponse{Certificate: flattenCertificates(certs), TransactionId: req.TransactionId}, nil
+	}
+
 	client, err := s.harica.Client()
 	if err != nil {
 		return s.handleError("Error while connecting to HARICA", err, logger, hub)
@@ -361,7 +475,7 @@ func (s *sslAPIServer) CollectCertificate(ctx context.Context, req *pb.CollectSs
 	// is requested. Re-check the reviews on every poll until the certificate
 	// is issued so a late review does not stall the transaction forever.
 	if entry.Status != certificate.StatusIssued {
-		reviews, err := runHaricaOnce(validationClient, func() ([]models.ReviewResponse, error) {
+		reviews, err := runHaricaOnce(s.harica.breaker, validationClient, func() ([]models.ReviewResponse, error) {
 			return validationClient.GetPendingReviews()
 		})
 		if err != nil {
@@ -376,7 +490,7 @@ func (s *sslAPIServer) CollectCertificate(ctx context.Context, req *pb.CollectSs
 				}
 				logger.Info("Approving pending request")
 				for _, sub := range r.ReviewGetDTOs {
-					if _, err := runHaricaOnce(validationClient, func() (struct{}, error) {
+					if _, err := runHaricaOnce(s.harica.breaker, validationClient, func() (struct{}, error) {
 						return struct{}{}, validationClient.ApproveRequest(sub.ReviewID, "Auto Approval", sub.ReviewValue)
 					}); err != nil {
 						logger.Warn("Approving request failed", zap.Error(err))
@@ -387,7 +501,7 @@ func (s *sslAPIServer) CollectCertificate(ctx context.Context, req *pb.CollectSs
 		}
 	}
 
-	cert, err := runHaricaOnce(client, func() (*models.CertificateResponse, error) {
+	cert, err := runHaricaOnce(s.harica.breaker, client, func() (*models.CertificateResponse, error) {
 		return client.GetCertificate(req.TransactionId)
 	})
 	if err != nil {
@@ -427,7 +541,7 @@ func (s *sslAPIServer) storeCollectedCertificate(ctx context.Context, logger *za
 		zap.Duration("duration", duration),
 		zap.String("serial", serial))
 
-	_, err = s.db.Certificate.UpdateOneID(entry.ID).
+	entry, err = s.db.Certificate.UpdateOneID(entry.ID).
 		SetSerial(pkiHelper.NormalizeSerial(serial)).
 		SetStatus(certificate.StatusIssued).
 		SetNotAfter(pem.NotAfter).
@@ -439,14 +553,49 @@ func (s *sslAPIServer) storeCollectedCertificate(ctx context.Context, logger *za
 	if err != nil {
 		return s.handleError("Error while saving collected certificate", err, logger, hub)
 	}
+	s.publishCertificate(ctx, notify.EventCertificateIssued, entry, "", nil)
 
 	return &pb.IssueSslResponse{Certificate: flattenCertificates(certs), TransactionId: transactionID}, nil
 }
 
+// resolveCertType resolves the certificate type of a request and checks it
+// against the allow-list. Requests without a type are issued by the ACME CA
+// as DV certificates if possible and DV is allowed for all domains.
+// Otherwise, the default type is used if allowed and the first type allowed
+// for all domains if not. Without an allow-list, requests without a type are
+// issued by the ACME CA whenever possible and with the default type
+// otherwise.
+func (s *sslAPIServer) resolveCertType(requested string, useAcme bool, sans, groups []string) (string, error) {
+	if requested != "" {
+		return s.types.ResolveCertType(requested, s.cfg.CertType, sans, groups)
+	}
+	if s.types == nil {
+		if useAcme {
+			return acmeCertType, nil
+		}
+		return cfg.NormalizeCertType(s.cfg.CertType), nil
+	}
+	var candidates []string
+	if useAcme {
+		candidates = append(candidates, acmeCertType)
+	}
+	candidates = append(candidates, s.cfg.CertType)
+	if len(sans) > 0 {
+		candidates = append(candidates, s.types.AllowedTypes(sans[0], s.cfg.CertType)...)
+	}
+	candidates = append(candidates, s.types.GroupTypes(groups)...)
+	for _, candidate := range candidates {
+		if certType, err := s.types.ResolveCertType(candidate, s.cfg.CertType, sans, groups); err == nil {
+			return certType, nil
+		}
+	}
+	return s.types.ResolveCertType("", s.cfg.CertType, sans, groups)
+}
+
 // canUseAcme reports whether the requested certificate can be issued by the
 // ACME CA. The ACME order is derived from the CSR, so the CSR must contain
-// exactly the requested domains and all of them must be covered by the DNS
-// validation config. Requests that do not qualify fall back to HARICA so
+// exactly the requested domains and IP addresses and all of them must be
+// covered by the validation config. Requests that do not qualify fall back to HARICA so
 // zones can be migrated one by one.
 func (s *sslAPIServer) canUseAcme(csr *x509.CertificateRequest, sans []string, logger *zap.Logger) bool {
 	if s.acme == nil {
@@ -454,14 +603,17 @@ func (s *sslAPIServer) canUseAcme(csr *x509.CertificateRequest, sans []string, l
 	}
 	csrDomains := make(map[string]bool)
 	if csr.Subject.CommonName != "" {
-		csrDomains[strings.ToLower(csr.Subject.CommonName)] = true
+		csrDomains[helper.NormalizeFqdn(csr.Subject.CommonName)] = true
 	}
 	for _, d := range csr.DNSNames {
-		csrDomains[strings.ToLower(d)] = true
+		csrDomains[helper.NormalizeFqdn(d)] = true
+	}
+	for _, ip := range csr.IPAddresses {
+		csrDomains[helper.NormalizeFqdn(ip.String())] = true
 	}
 	requested := make(map[string]bool)
 	for _, san := range sans {
-		requested[strings.ToLower(san)] = true
+		requested[helper.NormalizeFqdn(san)] = true
 	}
 	for san := range requested {
 		if !csrDomains[san] {
@@ -482,35 +634,20 @@ func (s *sslAPIServer) canUseAcme(csr *x509.CertificateRequest, sans []string, l
 	return true
 }
 
-func (s *sslAPIServer) issueAcmeCertificate(ctx context.Context, logger *zap.Logger, hub *sentry.Hub, entry *ent.Certificate, csr *x509.CertificateRequest, start time.Time) (*pb.IssueSslResponse, error) {
-	certPEM, err := s.acme.ObtainForCSR(ctx, csr)
+// issueAcmeCertificate obtains the certificate via ACME. If the order is
+// rejected because of a rate limit, the *acme.RateLimitError is returned
+// unchanged so the caller can fall back to HARICA or queue the request.
+func (s *sslAPIServer) issueAcmeCertificate(ctx context.Context, logger *zap.Logger, hub *sentry.Hub, entry *ent.Certificate, sans []string, csr *x509.CertificateRequest, start time.Time) (*pb.IssueSslResponse, error) {
+	certPEM, err := s.obtainAcmeCertificate(ctx, entry, sans, csr)
 	if err != nil {
+		var limited *acme.RateLimitError
+		if errors.As(err, &limited) {
+			return nil, err
+		}
 		return s.handleError("Error while requesting certificate", err, logger, hub)
 	}
 	hub.AddBreadcrumb(&sentry.Breadcrumb{Message: "Certificate collected", Category: "info", Level: sentry.LevelInfo}, nil)
-	stop := time.Now()
-	duration := stop.Sub(start)
-	s.duration = &duration
-	s.last = &stop
-	certs, err := pkiHelper.ParseCertificates(certPEM)
-	if err != nil {
-		return s.handleError("Error parsing certificate", err, logger, hub)
-	}
-	leaf := certs[0]
-	serial := fmt.Sprintf("%032x", leaf.SerialNumber)
-	logger.Info("Certificate issued",
-		zap.Duration("duration", duration),
-		zap.String("serial", serial))
-
-	_, err = s.db.Certificate.UpdateOneID(entry.ID).
-		SetSerial(pkiHelper.NormalizeSerial(serial)).
-		SetStatus(certificate.StatusIssued).
-		SetNotAfter(leaf.NotAfter).
-		SetNotBefore(leaf.NotBefore).
-		SetCreated(stop).
-		SetCertificate(string(certPEM)).
-		Save(ctx)
-
+	certs, err := s.storeAcmeCertificate(ctx, entry, certPEM, start)
 	if err != nil {
 		return s.handleError("Error while saving collected certificate", err, logger, hub)
 	}
@@ -532,7 +669,7 @@ func (s *sslAPIServer) RevokeCertificate(ctx context.Context, req *pb.RevokeSslR
 
 	errorReturn := func(err error, logger *zap.Logger) (*emptypb.Empty, error) {
 		logger.Error("Failed to revoke certificate", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "Failed to revoke certificate")
+		return nil, haricaError(err, "Failed to revoke certificate")
 	}
 
 	// The HARICA revocation reason is only required (and fetched) if a
@@ -542,7 +679,7 @@ func (s *sslAPIServer) RevokeCertificate(ctx context.Context, req *pb.RevokeSslR
 		if err != nil {
 			return nil, err
 		}
-		reasons, err := retryHarica(ctx, logger, client, "GetRevocationReasons", func() ([]models.RevocationReasonsResponse, error) {
+		reasons, err := retryHarica(ctx, logger, s.harica.breaker, client, "GetRevocationReasons", func() ([]models.RevocationReasonsResponse, error) {
 			return client.GetRevocationReasons()
 		})
 		if err != nil {
@@ -590,7 +727,7 @@ func (s *sslAPIServer) RevokeCertificate(ctx context.Context, req *pb.RevokeSslR
 				return err
 			}
 			logger.Info("Revoking certificate", zap.String("transaction_id", c.TransactionId), zap.String("reason", reason.Name), zap.String("description", req.Reason))
-			err = retryHaricaVoid(ctx, logger, validationClient, "RevokeCertificate", func() error {
+			err = retryHaricaVoid(ctx, logger, s.harica.breaker, validationClient, "RevokeCertificate", func() error {
 				return validationClient.RevokeCertificate(*reason, "", c.TransactionId)
 			})
 			if err != nil {
@@ -600,8 +737,11 @@ func (s *sslAPIServer) RevokeCertificate(ctx context.Context, req *pb.RevokeSslR
 			logger.Info("Skipping certificate. Not issued by HARICA or ACME", zap.Int("id", c.ID))
 			return nil
 		}
-		_, err := s.db.Certificate.UpdateOneID(c.ID).SetStatus(certificate.StatusRevoked).Save(ctx)
-		return err
+		if _, err := s.db.Certificate.UpdateOneID(c.ID).SetStatus(certificate.StatusRevoked).Save(ctx); err != nil {
+			return err
+		}
+		s.publishCertificate(ctx, notify.EventCertificateRevoked, c, req.Actor, map[string]string{"reason": req.Reason})
+		return nil
 	}
 
 	switch req.Identifier.(type) {
@@ -638,19 +778,19 @@ func (s *sslAPIServer) RevokeCertificate(ctx context.Context, req *pb.RevokeSslR
 				ret <- struct{ err error }{revokeOne(c, logger)}
 			}(c, ret)
 		}
-		var errors []error
+		var errs []error
 		for i := 0; i < len(certs); i++ {
 			select {
 			case err := <-ret:
 				if err.err != nil {
-					errors = append(errors, err.err)
+					errs = append(errs, err.err)
 				}
 			case <-ctx.Done():
 				return nil, status.Error(codes.Canceled, "Canceled")
 			}
 		}
-		if len(errors) > 0 {
-			return errorReturn(fmt.Errorf("%v", errors), logger)
+		if len(errs) > 0 {
+			return errorReturn(errors.Join(errs...), logger)
 		}
 	}
 	return &emptypb.Empty{}, nil
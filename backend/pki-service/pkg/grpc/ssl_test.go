@@ -2,12 +2,17 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/hm-edu/pki-service/ent"
+	"github.com/hm-edu/pki-service/ent/acmeorder"
+	"github.com/hm-edu/pki-service/ent/certificate"
 	"github.com/hm-edu/pki-service/ent/enttest"
+	"github.com/hm-edu/pki-service/pkg/cfg"
 	pb "github.com/hm-edu/portal-apis"
+	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -87,3 +92,89 @@ func TestCollectCertificate(t *testing.T) {
 		t.Error("Expected NotFound for unknown transaction id, got", err)
 	}
 }
+
+func TestGiveUpAcmeCertificate(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:acmequeue?mode=memory&cache=shared&_fk=1")
+	defer func(*ent.Client) {
+		_ = client.Close()
+	}(client)
+	ctx := context.Background()
+	server := sslAPIServer{db: client, logger: zap.L(), cfg: &cfg.PKIConfiguration{AcmeQueueMaxAttempts: 2}}
+
+	entry := client.Certificate.Create().
+		SetCommonName("test.com").
+		SetCa("letsencrypt").
+		SetStatus(certificate.StatusRequested).
+		SetCsr("csr").
+		SetTransactionId("acme-1").
+		SaveX(ctx)
+	fail := func() {
+		client.AcmeOrder.Create().SetIdentifiers("test.com").SetRegisteredDomains([]string{"test.com"}).SetCertificateID(entry.ID).SetStatus(acmeorder.StatusInvalid).SaveX(ctx)
+	}
+
+	// The request stays queued until the maximum number of attempts failed.
+	fail()
+	assert.NoError(t, server.giveUpAcmeCertificate(ctx, zap.L(), entry, errors.New("dns timeout")))
+	assert.Equal(t, certificate.StatusRequested, client.Certificate.GetX(ctx, entry.ID).Status)
+	_, err := server.CollectCertificate(ctx, &pb.CollectSslRequest{TransactionId: "acme-1"})
+	assert.NoError(t, err)
+
+	fail()
+	assert.NoError(t, server.giveUpAcmeCertificate(ctx, zap.L(), entry, errors.New("dns timeout")))
+	updated := client.Certificate.GetX(ctx, entry.ID)
+	assert.Equal(t, certificate.StatusInvalid, updated.Status)
+	assert.Nil(t, updated.Csr)
+	assert.Equal(t, "ACME order failed 2 times: dns timeout", *updated.CaReason)
+	_, err = server.CollectCertificate(ctx, &pb.CollectSslRequest{TransactionId: "acme-1"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestResolveCertType(t *testing.T) {
+	types := &cfg.CertTypeConfig{
+		Types: []string{"OV"},
+		Rules: []cfg.CertTypeRule{
+			{Domains: []string{"lab.hm.edu"}, Types: []string{"DV", "OV"}},
+			{Domains: []string{"dv.hm.edu"}, Types: []string{"DV"}},
+		},
+	}
+	server := sslAPIServer{cfg: &cfg.PKIConfiguration{CertType: "OV"}, types: types}
+	tc := []struct {
+		Name      string
+		Requested string
+		UseAcme   bool
+		Domains   []string
+		Expected  string
+		Denied    bool
+	}{
+		{Name: "default", Domains: []string{"www.hm.edu"}, UseAcme: true, Expected: "OV"},
+		{Name: "acme", Domains: []string{"www.lab.hm.edu"}, UseAcme: true, Expected: "DV"},
+		{Name: "no acme", Domains: []string{"www.lab.hm.edu"}, Expected: "OV"},
+		{Name: "default not allowed", Domains: []string{"www.dv.hm.edu"}, Expected: "DV"},
+		{Name: "no common type", Domains: []string{"www.dv.hm.edu", "www.hm.edu"}, Denied: true},
+		{Name: "explicit", Requested: "ov", Domains: []string{"www.lab.hm.edu"}, UseAcme: true, Expected: "OV"},
+		{Name: "explicit denied", Requested: "DV", Domains: []string{"www.hm.edu"}, UseAcme: true, Denied: true},
+	}
+	for _, c := range tc {
+		t.Run(c.Name, func(t *testing.T) {
+			certType, err := server.resolveCertType(c.Requested, c.UseAcme, c.Domains, nil)
+			if c.Denied {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, c.Expected, certType)
+		})
+	}
+
+	// Without an allow-list, requests without a type are issued by ACME
+	// whenever possible.
+	server.types = nil
+	certType, err := server.resolveCertType("", true, []string{"www.hm.edu"}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "DV", certType)
+	certType, err = server.resolveCertType("", false, []string{"www.hm.edu"}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "OV", certType)
+	_, err = server.resolveCertType("DV", true, []string{"www.hm.edu"}, nil)
+	assert.Error(t, err)
+}
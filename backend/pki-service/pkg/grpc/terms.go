@@ -0,0 +1,132 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/getsentry/sentry-go"
+	"github.com/hm-edu/pki-service/ent"
+	"github.com/hm-edu/pki-service/ent/termsacceptance"
+	"github.com/hm-edu/pki-service/pkg/cfg"
+	pb "github.com/hm-edu/portal-apis"
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// termsNotAccepted is the reason attached to the error returned if the user
+// has not accepted the current terms, so clients can tell it apart from
+// other failed preconditions.
+const termsNotAccepted = "TERMS_NOT_ACCEPTED"
+
+// termsUser extracts the user from the issuer of a request. The issuer may
+// contain additional information after the mail address.
+func termsUser(issuer string) string {
+	fields := strings.Fields(issuer)
+	if len(fields) == 0 {
+		return ""
+	}
+	return strings.ToLower(fields[0])
+}
+
+// acceptedTerms returns the acceptance of the current terms by the user or
+// nil if the user has not accepted them yet.
+func acceptedTerms(ctx context.Context, db *ent.Client, terms *cfg.TermsConfig, user string) (*ent.TermsAcceptance, error) {
+	acceptance, err := db.TermsAcceptance.Query().
+		Where(termsacceptance.User(termsUser(user)), termsacceptance.Version(terms.Version)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	return acceptance, err
+}
+
+// checkTerms returns an error if terms are configured and the user has not
+// accepted their current version.
+func checkTerms(ctx context.Context, logger *zap.Logger, db *ent.Client, terms *cfg.TermsConfig, user string) error {
+	if terms == nil {
+		return nil
+	}
+	acceptance, err := acceptedTerms(ctx, db, terms, user)
+	if err != nil {
+		logger.Error("Error checking terms acceptance", zap.Error(err))
+		return status.Error(codes.Internal, "Error checking the acceptance of the terms")
+	}
+	if acceptance != nil {
+		return nil
+	}
+	logger.Info("Terms not accepted", zap.String("terms_version", terms.Version))
+	msg := fmt.Sprintf("The terms of use (version %s) must be accepted first", terms.Version)
+	st, err := status.New(codes.FailedPrecondition, msg).WithDetails(&errdetails.ErrorInfo{
+		Reason:   termsNotAccepted,
+		Domain:   "pki-service",
+		Metadata: map[string]string{"version": terms.Version},
+	})
+	if err != nil {
+		return status.Error(codes.FailedPrecondition, msg)
+	}
+	return st.Err()
+}
+
+func (s *smimeAPIServer) mapTerms(acceptance *ent.TermsAcceptance) *pb.Terms {
+	terms := &pb.Terms{
+		Version:  s.terms.Version,
+		Title:    s.terms.Title,
+		Url:      s.terms.URL,
+		Text:     s.terms.Text,
+		Accepted: acceptance != nil,
+	}
+	if acceptance != nil {
+		terms.AcceptedAt = timestamppb.New(acceptance.CreateTime)
+	}
+	return terms
+}
+
+// GetTerms returns the current terms and whether the user accepted them.
+func (s *smimeAPIServer) GetTerms(ctx context.Context, req *pb.GetTermsRequest) (*pb.Terms, error) {
+	if s.terms == nil {
+		return nil, status.Error(codes.NotFound, "No terms configured")
+	}
+	acceptance, err := acceptedTerms(ctx, s.db, s.terms, req.User)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Error checking the acceptance of the terms")
+	}
+	return s.mapTerms(acceptance), nil
+}
+
+// AcceptTerms records the acceptance of the current terms. Only the current
+// version can be accepted; accepting it again returns the first acceptance.
+func (s *smimeAPIServer) AcceptTerms(ctx context.Context, req *pb.AcceptTermsRequest) (*pb.Terms, error) {
+	if s.terms == nil {
+		return nil, status.Error(codes.NotFound, "No terms configured")
+	}
+	user := termsUser(req.User)
+	if user == "" {
+		return nil, status.Error(codes.InvalidArgument, "User is required")
+	}
+	if req.Version != s.terms.Version {
+		return nil, status.Errorf(codes.FailedPrecondition, "Version %s is not the current version of the terms", req.Version)
+	}
+	logger := s.logger.With(zap.String("user", user), zap.String("terms_version", req.Version), zap.String("source", req.Source))
+	acceptance, err := acceptedTerms(ctx, s.db, s.terms, user)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Error checking the acceptance of the terms")
+	}
+	if acceptance == nil {
+		acceptance, err = s.db.TermsAcceptance.Create().
+			SetUser(user).
+			SetVersion(req.Version).
+			SetSource(req.Source).
+			Save(ctx)
+		if err != nil {
+			sentry.CaptureException(err)
+			logger.Error("Error storing terms acceptance", zap.Error(err))
+			return nil, status.Error(codes.Internal, "Error storing the acceptance of the terms")
+		}
+		logger.Info("Terms accepted")
+	}
+	return s.mapTerms(acceptance), nil
+}
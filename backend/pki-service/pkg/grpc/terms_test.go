@@ -0,0 +1,97 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hm-edu/pki-service/ent"
+	"github.com/hm-edu/pki-service/ent/enttest"
+	"github.com/hm-edu/pki-service/pkg/cfg"
+	pb "github.com/hm-edu/portal-apis"
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// isTermsError reports whether the error requests the acceptance of the terms.
+func isTermsError(err error) bool {
+	st := status.Convert(err)
+	if st.Code() != codes.FailedPrecondition {
+		return false
+	}
+	for _, d := range st.Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok && info.Reason == termsNotAccepted {
+			return true
+		}
+	}
+	return false
+}
+
+func TestTerms(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:terms?mode=memory&cache=shared&_fk=1")
+	defer func(*ent.Client) {
+		_ = client.Close()
+	}(client)
+	ctx := context.Background()
+	terms := &cfg.TermsConfig{Version: "v1", Title: "Subscriber Agreement", URL: "https://example.com/terms.pdf"}
+	s := &smimeAPIServer{cfg: &cfg.PKIConfiguration{}, db: client, logger: zap.L(), terms: terms}
+	ssl := &sslAPIServer{cfg: &cfg.PKIConfiguration{}, db: client, logger: zap.L(), terms: terms}
+
+	current, err := s.GetTerms(ctx, &pb.GetTermsRequest{User: "jane.doe@hm.edu"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if current.Version != "v1" || current.Accepted {
+		t.Fatalf("unexpected terms %+v", current)
+	}
+
+	// Issuance is blocked until the terms are accepted.
+	_, err = s.IssueCertificate(ctx, &pb.IssueSmimeRequest{Email: "team@hm.edu", PrimaryEmail: "jane.doe@hm.edu"})
+	if !isTermsError(err) {
+		t.Fatalf("expected terms error, got %v", err)
+	}
+	_, err = ssl.IssueCertificate(ctx, &pb.IssueSslRequest{Issuer: "jane.doe@hm.edu"})
+	if !isTermsError(err) {
+		t.Fatalf("expected terms error, got %v", err)
+	}
+
+	if _, err := s.AcceptTerms(ctx, &pb.AcceptTermsRequest{User: "jane.doe@hm.edu", Version: "v0", Source: "web"}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition for an old version, got %v", err)
+	}
+	accepted, err := s.AcceptTerms(ctx, &pb.AcceptTermsRequest{User: "Jane.Doe@hm.edu", Version: "v1", Source: "web"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !accepted.Accepted || accepted.AcceptedAt == nil {
+		t.Fatalf("unexpected terms %+v", accepted)
+	}
+	again, err := s.AcceptTerms(ctx, &pb.AcceptTermsRequest{User: "jane.doe@hm.edu", Version: "v1", Source: "API"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !again.AcceptedAt.AsTime().Equal(accepted.AcceptedAt.AsTime()) {
+		t.Fatalf("expected the first acceptance to be kept")
+	}
+
+	// Accepted terms no longer block the request; it fails later on.
+	_, err = s.IssueCertificate(ctx, &pb.IssueSmimeRequest{Email: "team@hm.edu", PrimaryEmail: "jane.doe@hm.edu", AllowedCertTypes: []string{smimeTypePersonal}})
+	if isTermsError(err) || status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected PermissionDenied, got %v", err)
+	}
+	_, err = ssl.IssueCertificate(ctx, &pb.IssueSslRequest{Issuer: "jane.doe@hm.edu (ACME)"})
+	if isTermsError(err) || status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument, got %v", err)
+	}
+
+	// A new version must be accepted again.
+	terms.Version = "v2"
+	_, err = ssl.IssueCertificate(ctx, &pb.IssueSslRequest{Issuer: "jane.doe@hm.edu"})
+	if !isTermsError(err) {
+		t.Fatalf("expected terms error, got %v", err)
+	}
+	current, _ = s.GetTerms(ctx, &pb.GetTermsRequest{User: "jane.doe@hm.edu"})
+	if current.Accepted {
+		t.Fatalf("expected new version to be unaccepted")
+	}
+}
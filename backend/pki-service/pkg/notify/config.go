@@ -0,0 +1,266 @@
+// Package notify delivers notifications (e.g. expiry reminders) through the
+// configured channels. Messages are rendered from text/template and
+// html/template files in German or English, depending on the recipient, and
+// delivered by mail (SMTP), generic webhooks or Matrix/Teams-compatible
+// incoming webhooks.
+package notify
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Supported languages of the templates.
+const (
+	LanguageGerman  = "de"
+	LanguageEnglish = "en"
+)
+
+// Channel types.
+const (
+	TypeSMTP    = "smtp"
+	TypeWebhook = "webhook"
+	TypeMatrix  = "matrix"
+	TypeTeams   = "teams"
+)
+
+// TLS modes of SMTP channels.
+const (
+	// TLSOpportunistic uses STARTTLS if offered by the server.
+	TLSOpportunistic = ""
+	// TLSStartTLS requires STARTTLS.
+	TLSStartTLS = "starttls"
+	// TLSImplicit connects using TLS (e.g. port 465).
+	TLSImplicit = "implicit"
+	// TLSNone never encrypts the connection.
+	TLSNone = "none"
+)
+
+// defaultTimeout is the timeout of a delivery.
+const defaultTimeout = 30 * time.Second
+
+// LanguageRule selects the language for recipients.
+type LanguageRule struct {
+	// Recipients are patterns of mail addresses (e.g. "*@example.org").
+	Recipients []string `yaml:"recipients"`
+	Language   string   `yaml:"language"`
+}
+
+// ChannelConfig configures a single channel.
+type ChannelConfig struct {
+	// Name identifies the channel in the logs and the delivered reminders.
+	// It defaults to the type and must be unique.
+	Name string `yaml:"name"`
+	// Type is one of smtp, webhook, matrix or teams.
+	Type string `yaml:"type"`
+	// Events limits the events delivered through the channel. All events
+	// are delivered if empty.
+	Events []string `yaml:"events"`
+	// Language is the language of the messages sent to webhooks. Mails use
+	// the language of the recipient.
+	Language string `yaml:"language"`
+	// Timeout of a delivery (defaults to 30s).
+	Timeout time.Duration `yaml:"timeout"`
+
+	// Host and Port of the SMTP server.
+	Host string `yaml:"host"`
+	Port int    `yaml:"port"`
+	// TLS is the TLS mode of the SMTP connection: starttls, implicit, none
+	// or empty to use STARTTLS if offered.
+	TLS string `yaml:"tls"`
+	// CACertificate is the path to the PEM bundle used to verify the server
+	// (defaults to the system pool).
+	CACertificate string `yaml:"ca_certificate"`
+	Username      string `yaml:"username"`
+	PasswordFile  string `yaml:"password_file"`
+	// From is the sender address of the mails.
+	From string `yaml:"from"`
+
+	// URL of the webhook.
+	URL string `yaml:"url"`
+	// Headers are added to the webhook requests (e.g. Authorization).
+	Headers map[string]string `yaml:"headers"`
+
+	password  string
+	tlsConfig *tls.Config
+}
+
+// Config is the content of the notification configuration file.
+type Config struct {
+	// DefaultLanguage is used for recipients without matching rule
+	// (defaults to de).
+	DefaultLanguage string `yaml:"default_language"`
+	// Languages select the language per recipient; the first match wins.
+	Languages []LanguageRule `yaml:"languages"`
+	// Templates is an optional directory with templates overriding the
+	// built-in ones (<event>.<language>.txt and <event>.<language>.html).
+	Templates string          `yaml:"templates"`
+	Channels  []ChannelConfig `yaml:"channels"`
+}
+
+// Load reads and validates the notification configuration file.
+func Load(path string) (*Config, error) {
+	data, err := os.ReadFile(path) // #nosec G304 -- path is provided by the operator
+	if err != nil {
+		return nil, fmt.Errorf("reading notification config %s: %w", path, err)
+	}
+	var cfg Config
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("parsing notification config %s: %w", path, err)
+	}
+	if err := cfg.init(); err != nil {
+		return nil, fmt.Errorf("notification config %s: %w", path, err)
+	}
+	return &cfg, nil
+}
+
+// MailConfig returns the configuration of a single SMTP channel, as used
+// before the notification configuration file existed.
+func MailConfig(host string, port int, from, username, password string) (*Config, error) {
+	cfg := &Config{Channels: []ChannelConfig{{
+		Name:     "mail",
+		Type:     TypeSMTP,
+		Host:     host,
+		Port:     port,
+		From:     from,
+		Username: username,
+		password: password,
+	}}}
+	if err := cfg.init(); err != nil {
+		return nil, fmt.Errorf("mail config: %w", err)
+	}
+	return cfg, nil
+}
+
+func validLanguage(language string) bool {
+	return language == LanguageGerman || language == LanguageEnglish
+}
+
+func (c *Config) init() error {
+	if c.DefaultLanguage == "" {
+		c.DefaultLanguage = LanguageGerman
+	}
+	if !validLanguage(c.DefaultLanguage) {
+		return fmt.Errorf("unsupported default_language %q", c.DefaultLanguage)
+	}
+	for i, rule := range c.Languages {
+		if !validLanguage(rule.Language) {
+			return fmt.Errorf("language rule %d: unsupported language %q", i, rule.Language)
+		}
+		for _, pattern := range rule.Recipients {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return fmt.Errorf("language rule %d: invalid pattern %q: %w", i, pattern, err)
+			}
+		}
+	}
+	if c.Templates != "" {
+		if info, err := os.Stat(c.Templates); err != nil || !info.IsDir() {
+			return fmt.Errorf("templates %s is not a directory", c.Templates)
+		}
+	}
+	names := make(map[string]bool, len(c.Channels))
+	for i := range c.Channels {
+		if err := c.Channels[i].init(c.DefaultLanguage); err != nil {
+			return fmt.Errorf("channel %s: %w", c.Channels[i].Name, err)
+		}
+		if names[c.Channels[i].Name] {
+			return fmt.Errorf("channel %s: duplicate name", c.Channels[i].Name)
+		}
+		names[c.Channels[i].Name] = true
+	}
+	return nil
+}
+
+func (c *ChannelConfig) init(defaultLanguage string) error {
+	if c.Name == "" {
+		c.Name = c.Type
+	}
+	if c.Language == "" {
+		c.Language = defaultLanguage
+	}
+	if !validLanguage(c.Language) {
+		return fmt.Errorf("unsupported language %q", c.Language)
+	}
+	if c.Timeout <= 0 {
+		c.Timeout = defaultTimeout
+	}
+	switch c.Type {
+	case TypeSMTP:
+		if c.Host == "" || c.From == "" {
+			return errors.New("host and from are required")
+		}
+		if !slices.Contains([]string{TLSOpportunistic, TLSStartTLS, TLSImplicit, TLSNone}, c.TLS) {
+			return fmt.Errorf("unsupported tls mode %q", c.TLS)
+		}
+		if c.Port == 0 {
+			c.Port = 25
+			if c.TLS == TLSImplicit {
+				c.Port = 465
+			}
+		}
+		if c.PasswordFile != "" {
+			password, err := os.ReadFile(c.PasswordFile) // #nosec G304 -- path is provided by the operator
+			if err != nil {
+				return fmt.Errorf("reading password: %w", err)
+			}
+			c.password = strings.TrimSpace(string(password))
+		}
+		c.tlsConfig = &tls.Config{ServerName: c.Host, MinVersion: tls.VersionTLS12}
+		if c.CACertificate != "" {
+			data, err := os.ReadFile(c.CACertificate) // #nosec G304 -- path is provided by the operator
+			if err != nil {
+				return fmt.Errorf("reading CA certificate: %w", err)
+			}
+			pool := x509.NewCertPool()
+			if !pool.AppendCertsFromPEM(data) {
+				return fmt.Errorf("no certificates found in %s", c.CACertificate)
+			}
+			c.tlsConfig.RootCAs = pool
+		}
+	case TypeWebhook, TypeMatrix, TypeTeams:
+		if !strings.HasPrefix(c.URL, "https://") && !strings.HasPrefix(c.URL, "http://") {
+			return fmt.Errorf("invalid url %q", c.URL)
+		}
+	default:
+		return fmt.Errorf("unsupported type %q", c.Type)
+	}
+	return nil
+}
+
+// Language returns the language of the messages for the recipient.
+func (c *Config) Language(recipient string) string {
+	recipient = strings.ToLower(recipient)
+	for _, rule := range c.Languages {
+		for _, pattern := range rule.Recipients {
+			if ok, _ := path.Match(strings.ToLower(pattern), recipient); ok {
+				return rule.Language
+			}
+		}
+	}
+	return c.DefaultLanguage
+}
+
+// accepts reports whether the channel delivers the event.
+func (c *ChannelConfig) accepts(event string) bool {
+	return len(c.Events) == 0 || slices.Contains(c.Events, event)
+}
+
+// ChannelNames returns the names of the channels handling the event.
+func (c *Config) ChannelNames(event string) []string {
+	var names []string
+	for i := range c.Channels {
+		if c.Channels[i].accepts(event) {
+			names = append(names, c.Channels[i].Name)
+		}
+	}
+	return names
+}
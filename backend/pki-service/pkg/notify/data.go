@@ -0,0 +1,56 @@
+package notify
+
+import "time"
+
+// CertificateExpiry is passed to the templates of the certificate_expiring
+// event.
+type CertificateExpiry struct {
+	// Domains are the domains whose most recent certificate expires.
+	Domains []string `json:"domains"`
+	// CertificateDomains are all domains of the certificate.
+	CertificateDomains []string  `json:"certificate_domains"`
+	Serial             string    `json:"serial"`
+	NotAfter           time.Time `json:"not_after"`
+	// Days until the expiry.
+	Days int `json:"days"`
+}
+
+// SmimeExpiry is passed to the templates of the smime_expiring event.
+type SmimeExpiry struct {
+	Email    string    `json:"email"`
+	Serial   string    `json:"serial"`
+	NotAfter time.Time `json:"not_after"`
+	// Days until the expiry.
+	Days int `json:"days"`
+}
+
+// ExpiryDigest is passed to the templates of the expiry_digest event.
+type ExpiryDigest struct {
+	Certificates      []CertificateExpiry `json:"certificates"`
+	SmimeCertificates []SmimeExpiry       `json:"smime_certificates"`
+}
+
+// EmailVerification is passed to the templates of the email_verification
+// event.
+type EmailVerification struct {
+	// User is the account that wants to use the address.
+	User    string    `json:"user"`
+	Email   string    `json:"email"`
+	Code    string    `json:"code"`
+	Expires time.Time `json:"expires"`
+	// Link points to the confirmation page (optional).
+	Link string `json:"link,omitempty"`
+}
+
+// Event is passed to the templates of the certificate and domain events.
+type Event struct {
+	// Actor is the user that triggered the event (empty for the system).
+	Actor string `json:"actor,omitempty"`
+	// Subject is the domain, the common name of a SSL certificate or the
+	// mail address of a S/MIME certificate.
+	Subject string `json:"subject"`
+	// Details depend on the event, e.g. serial, reason, owner,
+	// previous_owner or delegate.
+	Details map[string]string `json:"details,omitempty"`
+	Time    time.Time         `json:"time"`
+}
@@ -0,0 +1,160 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// Message is a notification to deliver.
+type Message struct {
+	// Event selects the templates and the channels.
+	Event string
+	// To are the recipients of mails. Webhooks receive them as part of the
+	// payload.
+	To []string
+	// Bcc are additional recipients of mails that are not visible to the
+	// others.
+	Bcc []string
+	// Data is passed to the templates.
+	Data any
+	// Channels restricts the delivery to the named channels. All channels
+	// handling the event are used if empty.
+	Channels []string
+}
+
+// Dispatcher renders and delivers notifications through the configured
+// channels.
+type Dispatcher struct {
+	cfg    *Config
+	client *http.Client
+}
+
+// New returns a dispatcher for the configuration.
+func New(cfg *Config) *Dispatcher {
+	return &Dispatcher{cfg: cfg, client: &http.Client{}}
+}
+
+// Config returns the configuration of the dispatcher.
+func (d *Dispatcher) Config() *Config {
+	return d.cfg
+}
+
+// Send delivers the message through all channels handling its event. Mails
+// are rendered in the language of the recipients, so recipients with
+// different languages receive separate mails. Delivery continues if a
+// channel fails; the errors are returned together.
+func (d *Dispatcher) Send(ctx context.Context, msg Message) error {
+	_, err := d.Deliver(ctx, msg)
+	return err
+}
+
+// Deliver delivers the message like Send and additionally returns the names
+// of the channels that delivered it, so callers can retry the failed
+// channels only.
+func (d *Dispatcher) Deliver(ctx context.Context, msg Message) ([]string, error) {
+	var delivered []string
+	var errs []error
+	for i := range d.cfg.Channels {
+		channel := &d.cfg.Channels[i]
+		if !channel.accepts(msg.Event) || (len(msg.Channels) > 0 && !slices.Contains(msg.Channels, channel.Name)) {
+			continue
+		}
+		var err error
+		if channel.Type == TypeSMTP {
+			err = d.sendMail(ctx, channel, &msg)
+		} else {
+			err = d.sendWebhook(ctx, channel, &msg)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("channel %s: %w", channel.Name, err))
+			continue
+		}
+		delivered = append(delivered, channel.Name)
+	}
+	return delivered, errors.Join(errs...)
+}
+
+// ErrNoMailChannel is returned by SendMail if no mail channel handles the
+// event.
+var ErrNoMailChannel = errors.New("no mail channel handles the event")
+
+// SendMail delivers the message through the first mail channel handling its
+// event only. It is used for messages that must not be posted to webhooks,
+// e.g. one-time codes.
+func (d *Dispatcher) SendMail(ctx context.Context, msg Message) error {
+	for i := range d.cfg.Channels {
+		channel := &d.cfg.Channels[i]
+		if channel.Type == TypeSMTP && channel.accepts(msg.Event) {
+			return d.sendMail(ctx, channel, &msg)
+		}
+	}
+	return ErrNoMailChannel
+}
+
+// languageGroup collects the recipients sharing a language.
+type languageGroup struct {
+	language string
+	to       []string
+	bcc      []string
+}
+
+func (d *Dispatcher) groups(msg *Message) []*languageGroup {
+	var groups []*languageGroup
+	group := func(recipient string) *languageGroup {
+		language := d.cfg.Language(recipient)
+		for _, g := range groups {
+			if g.language == language {
+				return g
+			}
+		}
+		g := &languageGroup{language: language}
+		groups = append(groups, g)
+		return g
+	}
+	seen := make(map[string]bool)
+	for _, recipient := range msg.To {
+		if key := strings.ToLower(recipient); !seen[key] {
+			seen[key] = true
+			g := group(recipient)
+			g.to = append(g.to, recipient)
+		}
+	}
+	for _, recipient := range msg.Bcc {
+		if key := strings.ToLower(recipient); !seen[key] {
+			seen[key] = true
+			g := group(recipient)
+			g.bcc = append(g.bcc, recipient)
+		}
+	}
+	return groups
+}
+
+func (d *Dispatcher) sendMail(ctx context.Context, channel *ChannelConfig, msg *Message) error {
+	var errs []error
+	for _, g := range d.groups(msg) {
+		rendered, err := d.cfg.Render(msg.Event, g.language, msg.Data)
+		if err != nil {
+			return err
+		}
+		content, err := mailMessage(channel.From, g.to, rendered)
+		if err != nil {
+			return err
+		}
+		if err := channel.sendMail(ctx, slices.Concat(g.to, g.bcc), content); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (d *Dispatcher) sendWebhook(ctx context.Context, channel *ChannelConfig, msg *Message) error {
+	rendered, err := d.cfg.Render(msg.Event, channel.Language, msg.Data)
+	if err != nil {
+		return err
+	}
+	return channel.post(ctx, d.client, channel.payload(msg, rendered))
+}
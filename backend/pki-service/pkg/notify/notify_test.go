@@ -0,0 +1,539 @@
+package notify
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"io"
+	"math/big"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/mail"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+var notAfter = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func expiry() CertificateExpiry {
+	return CertificateExpiry{
+		Domains:            []string{"www.example.org"},
+		CertificateDomains: []string{"www.example.org", "example.org"},
+		NotAfter:           notAfter,
+		Days:               14,
+	}
+}
+
+func TestRender(t *testing.T) {
+	cfg := &Config{}
+	if err := cfg.init(); err != nil {
+		t.Fatal(err)
+	}
+	de, err := cfg.Render(EventCertificateExpiring, LanguageGerman, expiry())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if de.Subject != "Informationen zu Zertifikatsablauf www.example.org" {
+		t.Errorf("unexpected subject %q", de.Subject)
+	}
+	if !strings.Contains(de.Text, "01.03.2026") || !strings.Contains(de.Text, "www.example.org, example.org") {
+		t.Errorf("unexpected text %q", de.Text)
+	}
+	if !strings.Contains(de.HTML, "<li>example.org</li>") {
+		t.Errorf("unexpected html %q", de.HTML)
+	}
+	en, err := cfg.Render(EventSmimeExpiring, LanguageEnglish, SmimeExpiry{Email: "<jane>@example.org", Serial: "01", NotAfter: notAfter})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(en.Text, "March 1, 2026") || !strings.Contains(en.Text, "<jane>@example.org") {
+		t.Errorf("unexpected text %q", en.Text)
+	}
+	if !strings.Contains(en.HTML, "&lt;jane&gt;@example.org") {
+		t.Errorf("expected escaped html, got %q", en.HTML)
+	}
+
+	// Templates in the override directory replace the built-in ones.
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "smime_expiring.en.txt"), []byte(`{{define "subject"}}Renew {{.Email}}{{end}}Renew now`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	cfg.Templates = dir
+	en, err = cfg.Render(EventSmimeExpiring, LanguageEnglish, SmimeExpiry{Email: "jane@example.org"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if en.Subject != "Renew jane@example.org" || en.Text != "Renew now" || en.HTML == "" {
+		t.Errorf("unexpected rendering %+v", en)
+	}
+	if _, err := cfg.Render("unknown", LanguageEnglish, nil); err == nil {
+		t.Error("expected error for unknown event")
+	}
+}
+
+func TestRenderEvents(t *testing.T) {
+	cfg := &Config{}
+	if err := cfg.init(); err != nil {
+		t.Fatal(err)
+	}
+	data := Event{Actor: "max@hm.edu", Subject: "www.example.org", Time: notAfter, Details: map[string]string{
+		"serial": "01", "reason": "key compromise", "owner": "jane@hm.edu", "previous_owner": "max@hm.edu", "delegate": "john@hm.edu",
+		"comment": "not our zone", "justification": "web server",
+	}}
+	for _, event := range Events {
+		for _, language := range []string{LanguageGerman, LanguageEnglish} {
+			rendered, err := cfg.Render(event, language, data)
+			if err != nil {
+				t.Fatalf("%s.%s: %v", event, language, err)
+			}
+			if !strings.Contains(rendered.Subject, "www.example.org") || strings.Contains(rendered.Text, "<no value>") {
+				t.Errorf("%s.%s: unexpected rendering %+v", event, language, rendered)
+			}
+		}
+	}
+	// Missing details are left out.
+	rendered, err := cfg.Render(EventCertificateRevoked, LanguageEnglish, Event{Subject: "www.example.org", Time: notAfter})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(rendered.Text, "<no value>") || strings.Contains(rendered.Text, "Reason") {
+		t.Errorf("unexpected text %q", rendered.Text)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	password := filepath.Join(dir, "password")
+	if err := os.WriteFile(password, []byte("secret\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, "notifications.yaml")
+	content := `
+default_language: en
+languages:
+  - recipients: ["*@hm.edu"]
+    language: de
+channels:
+  - type: smtp
+    host: mail.example.org
+    tls: implicit
+    from: pki@example.org
+    username: pki
+    password_file: ` + password + `
+  - name: ops
+    type: teams
+    url: https://example.webhook.office.com/hook
+    events: [certificate_expiring]
+`
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Channels[0].Port != 465 || cfg.Channels[0].password != "secret" || cfg.Channels[0].Name != TypeSMTP {
+		t.Errorf("unexpected smtp channel %+v", cfg.Channels[0])
+	}
+	if cfg.Channels[1].Language != LanguageEnglish || cfg.Channels[1].accepts(EventSmimeExpiring) {
+		t.Errorf("unexpected teams channel %+v", cfg.Channels[1])
+	}
+	if cfg.Language("Jane.Doe@HM.edu") != LanguageGerman || cfg.Language("jane@example.org") != LanguageEnglish {
+		t.Error("unexpected language selection")
+	}
+
+	for _, invalid := range []string{
+		"channels: [{type: smtp, host: mail.example.org}]",
+		"channels: [{type: smtp, host: mail.example.org, from: pki@example.org, tls: ssl}]",
+		"channels: [{type: webhook, url: ftp://example.org}]",
+		"channels: [{type: pager}]",
+		"channels: [{type: webhook, url: https://example.org/a}, {type: webhook, url: https://example.org/b}]",
+		"default_language: fr",
+	} {
+		if err := os.WriteFile(path, []byte(invalid), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := Load(path); err == nil {
+			t.Errorf("expected error for %q", invalid)
+		}
+	}
+}
+
+// receivedMail is a mail accepted by the fake SMTP server.
+type receivedMail struct {
+	auth       string
+	recipients []string
+	data       string
+}
+
+// smtpServer is a minimal SMTP server accepting all mails.
+type smtpServer struct {
+	listener net.Listener
+	mu       sync.Mutex
+	mails    []receivedMail
+}
+
+func newSMTPServer(t *testing.T, tlsConfig *tls.Config) *smtpServer {
+	var listener net.Listener
+	var err error
+	if tlsConfig != nil {
+		listener, err = tls.Listen("tcp", "127.0.0.1:0", tlsConfig)
+	} else {
+		listener, err = net.Listen("tcp", "127.0.0.1:0")
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &smtpServer{listener: listener}
+	t.Cleanup(func() { _ = listener.Close() })
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+	return s
+}
+
+func (s *smtpServer) port() int {
+	return s.listener.Addr().(*net.TCPAddr).Port
+}
+
+func (s *smtpServer) serve(conn net.Conn) {
+	defer func() { _ = conn.Close() }()
+	c := textproto.NewConn(conn)
+	_ = c.PrintfLine("220 localhost ESMTP")
+	var current receivedMail
+	for {
+		line, err := c.ReadLine()
+		if err != nil {
+			return
+		}
+		cmd := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+		switch cmd {
+		case "EHLO", "HELO":
+			_ = c.PrintfLine("250-localhost")
+			_ = c.PrintfLine("250 AUTH PLAIN")
+		case "AUTH":
+			current.auth = line
+			_ = c.PrintfLine("235 ok")
+		case "MAIL":
+			_ = c.PrintfLine("250 ok")
+		case "RCPT":
+			current.recipients = append(current.recipients, strings.Trim(strings.TrimPrefix(line, "RCPT TO:"), "<>"))
+			_ = c.PrintfLine("250 ok")
+		case "DATA":
+			_ = c.PrintfLine("354 go ahead")
+			data, err := c.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			current.data = string(data)
+			s.mu.Lock()
+			s.mails = append(s.mails, current)
+			s.mu.Unlock()
+			current = receivedMail{auth: current.auth}
+			_ = c.PrintfLine("250 ok")
+		case "QUIT":
+			_ = c.PrintfLine("221 bye")
+			return
+		default:
+			_ = c.PrintfLine("502 not implemented")
+		}
+	}
+}
+
+func (s *smtpServer) received() []receivedMail {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]receivedMail{}, s.mails...)
+}
+
+// selfSigned returns a server certificate for 127.0.0.1 and its PEM encoding.
+func selfSigned(t *testing.T) (tls.Certificate, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "127.0.0.1"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+// parts returns the decoded text and html part of a mail.
+func parts(t *testing.T, data string) (string, string, *mail.Message) {
+	msg, err := mail.ReadMessage(strings.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	reader := multipart.NewReader(msg.Body, params["boundary"])
+	var text, html string
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		content, _ := io.ReadAll(quotedprintable.NewReader(part))
+		if strings.HasPrefix(part.Header.Get("Content-Type"), "text/html") {
+			html = string(content)
+		} else {
+			text = string(content)
+		}
+	}
+	return text, html, msg
+}
+
+func TestSendMail(t *testing.T) {
+	server := newSMTPServer(t, nil)
+	cfg := &Config{
+		Languages: []LanguageRule{{Recipients: []string{"*@example.org"}, Language: LanguageEnglish}},
+		Channels: []ChannelConfig{{
+			Type: TypeSMTP,
+			Host: "127.0.0.1",
+			Port: server.port(),
+			From: "pki@hm.edu",
+		}},
+	}
+	if err := cfg.init(); err != nil {
+		t.Fatal(err)
+	}
+	err := New(cfg).Send(context.Background(), Message{
+		Event: EventCertificateExpiring,
+		To:    []string{"jane@hm.edu", "john@example.org"},
+		Bcc:   []string{"audit@hm.edu"},
+		Data:  expiry(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	mails := server.received()
+	if len(mails) != 2 {
+		t.Fatalf("expected one mail per language, got %d", len(mails))
+	}
+	german, english := mails[0], mails[1]
+	if strings.Join(german.recipients, ",") != "jane@hm.edu,audit@hm.edu" || strings.Join(english.recipients, ",") != "john@example.org" {
+		t.Errorf("unexpected recipients %v / %v", german.recipients, english.recipients)
+	}
+	text, html, msg := parts(t, german.data)
+	subject, _ := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	if subject != "Informationen zu Zertifikatsablauf www.example.org" {
+		t.Errorf("unexpected subject %q", subject)
+	}
+	if msg.Header.Get("To") != "jane@hm.edu" {
+		t.Errorf("bcc recipient must not be visible, got %q", msg.Header.Get("To"))
+	}
+	if !strings.Contains(text, "Sehr geehrte(r)") || !strings.Contains(html, "<li>example.org</li>") {
+		t.Errorf("unexpected content %q / %q", text, html)
+	}
+	text, _, _ = parts(t, english.data)
+	if !strings.Contains(text, "Dear user") {
+		t.Errorf("expected english text, got %q", text)
+	}
+}
+
+func TestSendMailTextOnly(t *testing.T) {
+	server := newSMTPServer(t, nil)
+	cfg := &Config{Channels: []ChannelConfig{{
+		Type: TypeSMTP,
+		Host: "127.0.0.1",
+		Port: server.port(),
+		From: "pki@hm.edu",
+	}}}
+	if err := cfg.init(); err != nil {
+		t.Fatal(err)
+	}
+	err := New(cfg).Send(context.Background(), Message{
+		Event: EventCertificateIssued,
+		To:    []string{"jane@hm.edu"},
+		Data:  Event{Subject: "www.example.org", Time: notAfter, Details: map[string]string{"serial": "01"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	mails := server.received()
+	if len(mails) != 1 {
+		t.Fatalf("expected one mail, got %d", len(mails))
+	}
+	msg, err := mail.ReadMessage(strings.NewReader(mails[0].data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(msg.Header.Get("Content-Type"), "text/plain") {
+		t.Errorf("expected a text mail, got %q", msg.Header.Get("Content-Type"))
+	}
+	text, _ := io.ReadAll(quotedprintable.NewReader(msg.Body))
+	if !strings.Contains(string(text), "Seriennummer 01") {
+		t.Errorf("unexpected body %q", text)
+	}
+}
+
+func TestSendMailOnly(t *testing.T) {
+	server := newSMTPServer(t, nil)
+	hooks := 0
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hooks++
+	}))
+	defer webhook.Close()
+	cfg := &Config{Channels: []ChannelConfig{
+		{Type: TypeWebhook, URL: webhook.URL},
+		{Type: TypeSMTP, Host: "127.0.0.1", Port: server.port(), From: "pki@hm.edu"},
+		{Name: "second", Type: TypeSMTP, Host: "127.0.0.1", Port: server.port(), From: "pki@hm.edu"},
+	}}
+	if err := cfg.init(); err != nil {
+		t.Fatal(err)
+	}
+	msg := Message{
+		Event: EventEmailVerification,
+		To:    []string{"jane@cs.hm.edu"},
+		Data:  EmailVerification{User: "jane.doe@hm.edu", Email: "jane@cs.hm.edu", Code: "ABCDEFGH", Expires: notAfter},
+	}
+	if err := New(cfg).SendMail(context.Background(), msg); err != nil {
+		t.Fatal(err)
+	}
+	mails := server.received()
+	if len(mails) != 1 || hooks != 0 {
+		t.Fatalf("expected a single mail and no webhook, got %d mails and %d webhooks", len(mails), hooks)
+	}
+	if !strings.Contains(mails[0].data, "ABCDEFGH") || !strings.Contains(mails[0].data, "01.03.2026") {
+		t.Errorf("unexpected mail %q", mails[0].data)
+	}
+
+	cfg.Channels = cfg.Channels[:1]
+	if err := New(cfg).SendMail(context.Background(), msg); !errors.Is(err, ErrNoMailChannel) {
+		t.Errorf("expected ErrNoMailChannel, got %v", err)
+	}
+}
+
+func TestSendMailImplicitTLS(t *testing.T) {
+	cert, certPEM := selfSigned(t)
+	server := newSMTPServer(t, &tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS12})
+	dir := t.TempDir()
+	ca := filepath.Join(dir, "ca.pem")
+	if err := os.WriteFile(ca, certPEM, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	cfg := &Config{Channels: []ChannelConfig{{
+		Type:          TypeSMTP,
+		Host:          "127.0.0.1",
+		Port:          server.port(),
+		TLS:           TLSImplicit,
+		CACertificate: ca,
+		From:          "pki@hm.edu",
+		Username:      "pki",
+		password:      "secret",
+	}}}
+	if err := cfg.init(); err != nil {
+		t.Fatal(err)
+	}
+	msg := Message{Event: EventSmimeExpiring, To: []string{"jane@hm.edu"}, Data: SmimeExpiry{Email: "jane@hm.edu", Serial: "01", NotAfter: notAfter}}
+	if err := New(cfg).Send(context.Background(), msg); err != nil {
+		t.Fatal(err)
+	}
+	mails := server.received()
+	if len(mails) != 1 || !strings.HasPrefix(mails[0].auth, "AUTH PLAIN") {
+		t.Fatalf("expected an authenticated mail, got %+v", mails)
+	}
+
+	// STARTTLS is required but not offered by the server.
+	plain := newSMTPServer(t, nil)
+	cfg.Channels[0].TLS = TLSStartTLS
+	cfg.Channels[0].Port = plain.port()
+	if err := New(cfg).Send(context.Background(), msg); err == nil || !strings.Contains(err.Error(), "STARTTLS") {
+		t.Fatalf("expected STARTTLS error, got %v", err)
+	}
+}
+
+func TestSendWebhooks(t *testing.T) {
+	bodies := make(map[string]map[string]any)
+	var mu sync.Mutex
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if r.URL.Path == "/webhook" && r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		mu.Lock()
+		bodies[r.URL.Path] = body
+		mu.Unlock()
+	}))
+	defer server.Close()
+	cfg := &Config{Channels: []ChannelConfig{
+		{Type: TypeWebhook, URL: server.URL + "/webhook", Headers: map[string]string{"Authorization": "Bearer token"}},
+		{Type: TypeMatrix, URL: server.URL + "/matrix", Language: LanguageEnglish},
+		{Type: TypeTeams, URL: server.URL + "/teams", Events: []string{EventSmimeExpiring}},
+		{Name: "broken", Type: TypeWebhook, URL: server.URL + "/webhook"},
+	}}
+	if err := cfg.init(); err != nil {
+		t.Fatal(err)
+	}
+	err := New(cfg).Send(context.Background(), Message{Event: EventCertificateExpiring, To: []string{"jane@hm.edu"}, Data: expiry()})
+	if err == nil || !strings.Contains(err.Error(), "channel broken") {
+		t.Fatalf("expected error of the broken channel, got %v", err)
+	}
+	if len(bodies) != 2 {
+		t.Fatalf("expected two deliveries, got %v", bodies)
+	}
+	webhook := bodies["/webhook"]
+	if webhook["event"] != EventCertificateExpiring || webhook["subject"] != "Informationen zu Zertifikatsablauf www.example.org" {
+		t.Errorf("unexpected webhook payload %v", webhook)
+	}
+	if webhook["data"].(map[string]any)["days"] != float64(14) {
+		t.Errorf("expected data in webhook payload, got %v", webhook["data"])
+	}
+	matrix := bodies["/matrix"]
+	if !strings.HasPrefix(matrix["text"].(string), "Certificate expiry for www.example.org") || !strings.HasPrefix(matrix["html"].(string), "<h4>") {
+		t.Errorf("unexpected matrix payload %v", matrix)
+	}
+	if _, ok := bodies["/teams"]; ok {
+		t.Error("teams channel must not receive other events")
+	}
+
+	if err := New(cfg).Send(context.Background(), Message{Event: EventSmimeExpiring, Data: SmimeExpiry{Email: "jane@hm.edu"}}); err == nil {
+		t.Fatal("expected error of the broken channel")
+	}
+	teams := bodies["/teams"]
+	if teams["@type"] != "MessageCard" || !strings.Contains(teams["title"].(string), "jane@hm.edu") {
+		t.Errorf("unexpected teams payload %v", teams)
+	}
+}
@@ -0,0 +1,160 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/smtp"
+	"net/textproto"
+	"strings"
+	"time"
+)
+
+// mailMessage builds a MIME mail with a text and an optional html part.
+func mailMessage(from string, to []string, rendered *Rendered) ([]byte, error) {
+	var buf bytes.Buffer
+	header := textproto.MIMEHeader{}
+	header.Set("From", fmt.Sprintf("PKI <%s>", from))
+	if len(to) == 0 {
+		header.Set("To", "undisclosed-recipients:;")
+	} else {
+		header.Set("To", strings.Join(to, ", "))
+	}
+	header.Set("Subject", mime.QEncoding.Encode("utf-8", rendered.Subject))
+	header.Set("Date", time.Now().Format(time.RFC1123Z))
+	header.Set("Message-ID", messageID(from))
+	header.Set("MIME-Version", "1.0")
+
+	textPart := textproto.MIMEHeader{}
+	textPart.Set("Content-Type", "text/plain; charset=utf-8")
+	textPart.Set("Content-Transfer-Encoding", "quoted-printable")
+
+	if rendered.HTML == "" {
+		for k, v := range textPart {
+			header[k] = v
+		}
+		writeHeader(&buf, header)
+		if err := writeQuotedPrintable(&buf, rendered.Text); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	}
+
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	header.Set("Content-Type", fmt.Sprintf("multipart/alternative; boundary=%q", mw.Boundary()))
+	htmlPart := textproto.MIMEHeader{}
+	htmlPart.Set("Content-Type", "text/html; charset=utf-8")
+	htmlPart.Set("Content-Transfer-Encoding", "quoted-printable")
+	for _, part := range []struct {
+		header  textproto.MIMEHeader
+		content string
+	}{{textPart, rendered.Text}, {htmlPart, rendered.HTML}} {
+		w, err := mw.CreatePart(part.header)
+		if err != nil {
+			return nil, err
+		}
+		if err := writeQuotedPrintable(w, part.content); err != nil {
+			return nil, err
+		}
+	}
+	if err := mw.Close(); err != nil {
+		return nil, err
+	}
+	writeHeader(&buf, header)
+	buf.Write(body.Bytes())
+	return buf.Bytes(), nil
+}
+
+func writeHeader(buf *bytes.Buffer, header textproto.MIMEHeader) {
+	for _, k := range []string{"From", "To", "Subject", "Date", "Message-ID", "MIME-Version", "Content-Type", "Content-Transfer-Encoding"} {
+		if v := header.Get(k); v != "" {
+			fmt.Fprintf(buf, "%s: %s\r\n", k, v)
+		}
+	}
+	buf.WriteString("\r\n")
+}
+
+func writeQuotedPrintable(w io.Writer, content string) error {
+	qp := quotedprintable.NewWriter(w)
+	if _, err := qp.Write([]byte(content)); err != nil {
+		return err
+	}
+	return qp.Close()
+}
+
+func messageID(from string) string {
+	domain := "localhost"
+	if i := strings.LastIndex(from, "@"); i >= 0 {
+		domain = from[i+1:]
+	}
+	id := make([]byte, 12)
+	_, _ = rand.Read(id)
+	return fmt.Sprintf("<%s@%s>", hex.EncodeToString(id), domain)
+}
+
+// sendMail delivers the message via the SMTP channel.
+func (c *ChannelConfig) sendMail(ctx context.Context, recipients []string, msg []byte) error {
+	addr := net.JoinHostPort(c.Host, fmt.Sprint(c.Port))
+	dialer := &net.Dialer{Timeout: c.Timeout}
+	var conn net.Conn
+	var err error
+	if c.TLS == TLSImplicit {
+		conn, err = (&tls.Dialer{NetDialer: dialer, Config: c.tlsConfig}).DialContext(ctx, "tcp", addr)
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return fmt.Errorf("connecting to %s: %w", addr, err)
+	}
+	_ = conn.SetDeadline(time.Now().Add(c.Timeout))
+	client, err := smtp.NewClient(conn, c.Host)
+	if err != nil {
+		_ = conn.Close()
+		return err
+	}
+	defer func() { _ = client.Close() }()
+
+	if c.TLS == TLSOpportunistic || c.TLS == TLSStartTLS {
+		if ok, _ := client.Extension("STARTTLS"); ok {
+			if err := client.StartTLS(c.tlsConfig); err != nil {
+				return fmt.Errorf("starting TLS: %w", err)
+			}
+		} else if c.TLS == TLSStartTLS {
+			return errors.New("server does not support STARTTLS")
+		}
+	}
+	if c.Username != "" && c.password != "" {
+		if err := client.Auth(smtp.PlainAuth("", c.Username, c.password, c.Host)); err != nil {
+			return fmt.Errorf("authenticating: %w", err)
+		}
+	}
+	if err := client.Mail(c.From); err != nil {
+		return err
+	}
+	for _, rcpt := range recipients {
+		if err := client.Rcpt(rcpt); err != nil {
+			return fmt.Errorf("recipient %s: %w", rcpt, err)
+		}
+	}
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(msg); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
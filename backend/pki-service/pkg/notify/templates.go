@@ -0,0 +1,137 @@
+package notify
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	texttemplate "text/template"
+	"time"
+)
+
+//go:embed templates
+var builtin embed.FS
+
+// Events with built-in templates.
+const (
+	EventCertificateExpiring = "certificate_expiring"
+	EventSmimeExpiring       = "smime_expiring"
+	// EventExpiryDigest bundles several expiring certificates of a
+	// recipient.
+	EventExpiryDigest = "expiry_digest"
+	// EventEmailVerification carries the one-time code confirming an
+	// additional S/MIME address. It is only delivered by mail.
+	EventEmailVerification = "email_verification"
+
+	EventCertificateIssued     = "certificate_issued"
+	EventCertificateRevoked    = "certificate_revoked"
+	EventDomainCreated         = "domain_created"
+	EventDomainPendingApproval = "domain_pending_approval"
+	EventDomainApproved        = "domain_approved"
+	EventDomainRejected        = "domain_rejected"
+	EventDomainTransferred     = "domain_transferred"
+	EventDelegationAdded       = "delegation_added"
+	EventDelegationRemoved     = "delegation_removed"
+)
+
+// Events are the events users can subscribe to. Expiry reminders are always
+// sent.
+var Events = []string{
+	EventCertificateIssued,
+	EventCertificateRevoked,
+	EventDomainCreated,
+	EventDomainPendingApproval,
+	EventDomainApproved,
+	EventDomainRejected,
+	EventDomainTransferred,
+	EventDelegationAdded,
+	EventDelegationRemoved,
+}
+
+// Rendered is a message rendered for one language.
+type Rendered struct {
+	Subject string
+	Text    string
+	// HTML is empty if there is no html template for the event.
+	HTML string
+}
+
+// dateFormats are the formats of the date template function per language.
+var dateFormats = map[string]string{
+	LanguageGerman:  "02.01.2006",
+	LanguageEnglish: "January 2, 2006",
+}
+
+func funcs(language string) map[string]any {
+	return map[string]any{
+		"join": strings.Join,
+		"date": func(t time.Time) string { return t.Format(dateFormats[language]) },
+	}
+}
+
+// readTemplate returns the template from the override directory or the
+// built-in template. It returns fs.ErrNotExist if neither exists.
+func (c *Config) readTemplate(name string) (string, error) {
+	if c.Templates != "" {
+		data, err := os.ReadFile(filepath.Join(c.Templates, name)) // #nosec G304 -- directory is provided by the operator
+		if err == nil {
+			return string(data), nil
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return "", err
+		}
+	}
+	data, err := builtin.ReadFile("templates/" + name)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// Render renders the templates of the event in the given language. The text
+// template defines the subject in a template named "subject".
+func (c *Config) Render(event, language string, data any) (*Rendered, error) {
+	base := fmt.Sprintf("%s.%s", event, language)
+	text, err := c.readTemplate(base + ".txt")
+	if err != nil {
+		return nil, fmt.Errorf("reading template %s.txt: %w", base, err)
+	}
+	tmpl, err := texttemplate.New(base).Funcs(funcs(language)).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("parsing template %s.txt: %w", base, err)
+	}
+	if tmpl.Lookup("subject") == nil {
+		return nil, fmt.Errorf("template %s.txt does not define a subject", base)
+	}
+	var subject, body bytes.Buffer
+	if err := tmpl.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return nil, fmt.Errorf("rendering subject of %s: %w", base, err)
+	}
+	if err := tmpl.Execute(&body, data); err != nil {
+		return nil, fmt.Errorf("rendering %s.txt: %w", base, err)
+	}
+	rendered := &Rendered{Subject: strings.TrimSpace(subject.String()), Text: body.String()}
+
+	html, err := c.readTemplate(base + ".html")
+	if errors.Is(err, fs.ErrNotExist) {
+		return rendered, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading template %s.html: %w", base, err)
+	}
+	htmlTmpl, err := htmltemplate.New(base).Funcs(funcs(language)).Parse(html)
+	if err != nil {
+		return nil, fmt.Errorf("parsing template %s.html: %w", base, err)
+	}
+	body.Reset()
+	if err := htmlTmpl.Execute(&body, data); err != nil {
+		return nil, fmt.Errorf("rendering %s.html: %w", base, err)
+	}
+	rendered.HTML = body.String()
+	return rendered, nil
+}
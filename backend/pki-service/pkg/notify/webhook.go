@@ -0,0 +1,95 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+)
+
+// webhookPayload is posted to generic webhooks.
+type webhookPayload struct {
+	Event      string   `json:"event"`
+	Subject    string   `json:"subject"`
+	Text       string   `json:"text"`
+	HTML       string   `json:"html,omitempty"`
+	Recipients []string `json:"recipients,omitempty"`
+	Data       any      `json:"data,omitempty"`
+}
+
+// matrixPayload is understood by Matrix incoming webhooks (e.g. hookshot).
+type matrixPayload struct {
+	Text    string `json:"text"`
+	HTML    string `json:"html,omitempty"`
+	MsgType string `json:"msgtype"`
+}
+
+// teamsPayload is a MessageCard understood by Teams incoming webhooks.
+type teamsPayload struct {
+	Type    string `json:"@type"`
+	Context string `json:"@context"`
+	Summary string `json:"summary"`
+	Title   string `json:"title"`
+	Text    string `json:"text"`
+}
+
+// payload returns the body posted to the webhook.
+func (c *ChannelConfig) payload(msg *Message, rendered *Rendered) any {
+	switch c.Type {
+	case TypeMatrix:
+		text := rendered.Subject + "\n\n" + rendered.Text
+		formatted := ""
+		if rendered.HTML != "" {
+			formatted = "<h4>" + html.EscapeString(rendered.Subject) + "</h4>" + rendered.HTML
+		}
+		return matrixPayload{Text: text, HTML: formatted, MsgType: "m.notice"}
+	case TypeTeams:
+		return teamsPayload{
+			Type:    "MessageCard",
+			Context: "https://schema.org/extensions",
+			Summary: rendered.Subject,
+			Title:   rendered.Subject,
+			Text:    rendered.Text,
+		}
+	default:
+		return webhookPayload{
+			Event:      msg.Event,
+			Subject:    rendered.Subject,
+			Text:       rendered.Text,
+			HTML:       rendered.HTML,
+			Recipients: append(append([]string{}, msg.To...), msg.Bcc...),
+			Data:       msg.Data,
+		}
+	}
+}
+
+// post delivers the payload to the webhook.
+func (c *ChannelConfig) post(ctx context.Context, client *http.Client, payload any) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, c.Timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range c.Headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
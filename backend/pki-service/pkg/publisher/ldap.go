@@ -0,0 +1,330 @@
+// Package publisher publishes issued S/MIME certificates to the institutional
+// LDAP directory, where mail clients look up the encryption certificates of
+// recipients. Certificates are added to the entry matching the mail address
+// of the certificate and removed again once they are revoked or expired.
+package publisher
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/go-ldap/ldap/v3"
+	"github.com/smallstep/pkcs7"
+	"gopkg.in/yaml.v3"
+)
+
+// Attribute value formats.
+const (
+	// FormatDER stores the DER encoded certificate (e.g.
+	// userCertificate;binary).
+	FormatDER = "der"
+	// FormatPKCS7 stores the certificate as degenerate PKCS#7 SignedData
+	// (e.g. userSMIMECertificate).
+	FormatPKCS7 = "pkcs7"
+)
+
+// defaultFilter selects the directory entry of a mail address.
+const defaultFilter = "(mail=%s)"
+
+// defaultTimeout is the timeout of the connection and of every request.
+const defaultTimeout = 10 * time.Second
+
+// ErrEntryNotFound is returned if the directory has no entry for the mail
+// address of a certificate.
+var ErrEntryNotFound = errors.New("no directory entry found")
+
+// Publisher publishes S/MIME certificates to a directory.
+type Publisher interface {
+	// Publish adds the certificate to the directory entry of the given mail
+	// address.
+	Publish(email string, cert *x509.Certificate) error
+	// Withdraw removes the certificate from the directory entry of the given
+	// mail address.
+	Withdraw(email string, cert *x509.Certificate) error
+}
+
+// Attribute maps a directory attribute to the format of its values.
+type Attribute struct {
+	// Name is the name of the attribute including options (e.g.
+	// userCertificate;binary).
+	Name string `yaml:"name"`
+	// Format is either "der" or "pkcs7".
+	Format string `yaml:"format"`
+}
+
+// Config is the content of the LDAP publisher configuration file.
+type Config struct {
+	// URL of the directory server (ldap:// or ldaps://).
+	URL string `yaml:"url"`
+	// StartTLS upgrades ldap:// connections to TLS.
+	StartTLS bool `yaml:"start_tls"`
+	// CACertificate is the path to the PEM bundle used to verify the
+	// certificate of the directory server. The system roots are used if
+	// empty.
+	CACertificate string `yaml:"ca_certificate"`
+	// BindDN is the DN the publisher binds as. It needs write access to the
+	// mapped attributes.
+	BindDN string `yaml:"bind_dn"`
+	// BindPasswordFile is the path to the file containing the bind password.
+	BindPasswordFile string `yaml:"bind_password_file"`
+	// SearchBase is the base DN the entries are searched in.
+	SearchBase string `yaml:"search_base"`
+	// Filter selects the entry of a mail address. The (escaped) address
+	// replaces every %s. Defaults to (mail=%s).
+	Filter string `yaml:"filter"`
+	// Attributes are the attributes the certificates are published to.
+	// Defaults to userCertificate;binary (DER).
+	Attributes []Attribute `yaml:"attributes"`
+	// Timeout of the connection and of every request (defaults to 10s).
+	Timeout time.Duration `yaml:"timeout"`
+
+	bindPassword string
+	tlsConfig    *tls.Config
+	dial         func() (conn, error)
+}
+
+// conn is the part of *ldap.Conn used by the publisher.
+type conn interface {
+	Bind(username, password string) error
+	Search(req *ldap.SearchRequest) (*ldap.SearchResult, error)
+	Modify(req *ldap.ModifyRequest) error
+	Close() error
+}
+
+// Load reads and validates the LDAP publisher configuration file.
+func Load(path string) (*Config, error) {
+	data, err := os.ReadFile(path) // #nosec G304 -- path is provided by the operator
+	if err != nil {
+		return nil, fmt.Errorf("reading LDAP publisher config %s: %w", path, err)
+	}
+	var cfg Config
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("parsing LDAP publisher config %s: %w", path, err)
+	}
+	if err := cfg.init(); err != nil {
+		return nil, fmt.Errorf("LDAP publisher config %s: %w", path, err)
+	}
+	return &cfg, nil
+}
+
+func (c *Config) init() error {
+	if c.URL == "" {
+		return errors.New("url is required")
+	}
+	if c.SearchBase == "" {
+		return errors.New("search_base is required")
+	}
+	if c.Filter == "" {
+		c.Filter = defaultFilter
+	}
+	if !strings.Contains(c.Filter, "%s") {
+		return fmt.Errorf("filter %q must contain %%s", c.Filter)
+	}
+	if _, err := ldap.CompileFilter(c.filter("user@example.org")); err != nil {
+		return fmt.Errorf("invalid filter %q: %w", c.Filter, err)
+	}
+	if len(c.Attributes) == 0 {
+		c.Attributes = []Attribute{{Name: "userCertificate;binary", Format: FormatDER}}
+	}
+	for i, attr := range c.Attributes {
+		if attr.Name == "" {
+			return fmt.Errorf("attribute %d has no name", i)
+		}
+		if attr.Format == "" {
+			c.Attributes[i].Format = FormatDER
+		} else if attr.Format != FormatDER && attr.Format != FormatPKCS7 {
+			return fmt.Errorf("attribute %s: unknown format %q", attr.Name, attr.Format)
+		}
+	}
+	if c.Timeout <= 0 {
+		c.Timeout = defaultTimeout
+	}
+	if c.BindDN != "" {
+		if c.BindPasswordFile == "" {
+			return errors.New("bind_password_file is required with bind_dn")
+		}
+		password, err := os.ReadFile(c.BindPasswordFile) // #nosec G304 -- path is provided by the operator
+		if err != nil {
+			return fmt.Errorf("reading bind password: %w", err)
+		}
+		c.bindPassword = strings.TrimSpace(string(password))
+	}
+	c.tlsConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+	if c.CACertificate != "" {
+		data, err := os.ReadFile(c.CACertificate) // #nosec G304 -- path is provided by the operator
+		if err != nil {
+			return fmt.Errorf("reading CA certificate: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(data) {
+			return fmt.Errorf("no certificates found in %s", c.CACertificate)
+		}
+		c.tlsConfig.RootCAs = pool
+	}
+	return nil
+}
+
+// connect opens an authenticated connection to the directory server.
+func (c *Config) connect() (conn, error) {
+	if c.dial != nil {
+		return c.dial()
+	}
+	l, err := ldap.DialURL(c.URL,
+		ldap.DialWithTLSConfig(c.tlsConfig),
+		ldap.DialWithDialer(&net.Dialer{Timeout: c.Timeout}))
+	if err != nil {
+		return nil, fmt.Errorf("connecting to %s: %w", c.URL, err)
+	}
+	l.SetTimeout(c.Timeout)
+	if c.StartTLS {
+		if err := l.StartTLS(c.tlsConfig); err != nil {
+			_ = l.Close()
+			return nil, fmt.Errorf("starting TLS: %w", err)
+		}
+	}
+	if c.BindDN != "" {
+		if err := l.Bind(c.BindDN, c.bindPassword); err != nil {
+			_ = l.Close()
+			return nil, fmt.Errorf("binding as %s: %w", c.BindDN, err)
+		}
+	}
+	return l, nil
+}
+
+// filter returns the search filter of the given mail address.
+func (c *Config) filter(email string) string {
+	return strings.ReplaceAll(c.Filter, "%s", ldap.EscapeFilter(email))
+}
+
+// entry returns the directory entry of the given mail address including the
+// mapped attributes.
+func (c *Config) entry(l conn, email string) (*ldap.Entry, error) {
+	names := make([]string, 0, len(c.Attributes))
+	for _, attr := range c.Attributes {
+		names = append(names, attr.Name)
+	}
+	res, err := l.Search(ldap.NewSearchRequest(
+		c.SearchBase, ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 0, int(c.Timeout.Seconds()), false,
+		c.filter(email), names, nil))
+	if err != nil {
+		return nil, fmt.Errorf("searching entry of %s: %w", email, err)
+	}
+	switch len(res.Entries) {
+	case 0:
+		return nil, fmt.Errorf("%w for %s", ErrEntryNotFound, email)
+	case 1:
+		return res.Entries[0], nil
+	default:
+		return nil, fmt.Errorf("multiple directory entries found for %s", email)
+	}
+}
+
+// encode returns the attribute value of the certificate in the given
+// format.
+func encode(format string, cert *x509.Certificate) ([]byte, error) {
+	if format == FormatPKCS7 {
+		return pkcs7.DegenerateCertificate(cert.Raw)
+	}
+	return cert.Raw, nil
+}
+
+// contains reports whether the attribute value holds the certificate.
+func contains(format string, value []byte, cert *x509.Certificate) bool {
+	if format != FormatPKCS7 {
+		return bytes.Equal(value, cert.Raw)
+	}
+	p7, err := pkcs7.Parse(value)
+	if err != nil {
+		return false
+	}
+	for _, c := range p7.Certificates {
+		if c.Equal(cert) {
+			return true
+		}
+	}
+	return false
+}
+
+// Publish adds the certificate to all mapped attributes of the directory
+// entry of the given mail address. Certificates already present are not
+// added again. ErrEntryNotFound is returned if the address has no entry.
+func (c *Config) Publish(email string, cert *x509.Certificate) error {
+	l, err := c.connect()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = l.Close() }()
+	e, err := c.entry(l, email)
+	if err != nil {
+		return err
+	}
+	req := ldap.NewModifyRequest(e.DN, nil)
+	for _, attr := range c.Attributes {
+		present := false
+		for _, value := range e.GetRawAttributeValues(attr.Name) {
+			if contains(attr.Format, value, cert) {
+				present = true
+				break
+			}
+		}
+		if present {
+			continue
+		}
+		value, err := encode(attr.Format, cert)
+		if err != nil {
+			return fmt.Errorf("encoding certificate for %s: %w", attr.Name, err)
+		}
+		req.Add(attr.Name, []string{string(value)})
+	}
+	if len(req.Changes) == 0 {
+		return nil
+	}
+	if err := l.Modify(req); err != nil {
+		return fmt.Errorf("publishing certificate to %s: %w", e.DN, err)
+	}
+	return nil
+}
+
+// Withdraw removes the certificate from all mapped attributes of the
+// directory entry of the given mail address. Other certificates of the entry
+// are kept. Nothing is done if the address has no entry.
+func (c *Config) Withdraw(email string, cert *x509.Certificate) error {
+	l, err := c.connect()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = l.Close() }()
+	e, err := c.entry(l, email)
+	if errors.Is(err, ErrEntryNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	req := ldap.NewModifyRequest(e.DN, nil)
+	for _, attr := range c.Attributes {
+		var remove []string
+		for _, value := range e.GetRawAttributeValues(attr.Name) {
+			if contains(attr.Format, value, cert) {
+				remove = append(remove, string(value))
+			}
+		}
+		if len(remove) > 0 {
+			req.Delete(attr.Name, remove)
+		}
+	}
+	if len(req.Changes) == 0 {
+		return nil
+	}
+	if err := l.Modify(req); err != nil {
+		return fmt.Errorf("withdrawing certificate from %s: %w", e.DN, err)
+	}
+	return nil
+}
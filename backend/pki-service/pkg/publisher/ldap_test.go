@@ -0,0 +1,263 @@
+package publisher
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/go-ldap/ldap/v3"
+)
+
+func testCertificate(t *testing.T, email string) *x509.Certificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:   big.NewInt(time.Now().UnixNano()),
+		Subject:        pkix.Name{CommonName: email},
+		EmailAddresses: []string{email},
+		NotBefore:      time.Now(),
+		NotAfter:       time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert
+}
+
+// fakeDirectory is an in-memory directory holding entries selected by
+// (mail=...).
+type fakeDirectory struct {
+	entries map[string]map[string][]string
+	modify  int
+}
+
+func (d *fakeDirectory) Bind(string, string) error { return nil }
+func (d *fakeDirectory) Close() error              { return nil }
+
+func (d *fakeDirectory) Search(req *ldap.SearchRequest) (*ldap.SearchResult, error) {
+	res := &ldap.SearchResult{}
+	for dn, attrs := range d.entries {
+		if req.Filter != fmt.Sprintf("(mail=%s)", ldap.EscapeFilter(attrs["mail"][0])) {
+			continue
+		}
+		selected := map[string][]string{}
+		for _, name := range req.Attributes {
+			selected[name] = attrs[name]
+		}
+		res.Entries = append(res.Entries, ldap.NewEntry(dn, selected))
+	}
+	return res, nil
+}
+
+func (d *fakeDirectory) Modify(req *ldap.ModifyRequest) error {
+	d.modify++
+	attrs := d.entries[req.DN]
+	for _, change := range req.Changes {
+		name := change.Modification.Type
+		for _, value := range change.Modification.Vals {
+			switch change.Operation {
+			case ldap.AddAttribute:
+				if slices.Contains(attrs[name], value) {
+					return ldap.NewError(ldap.LDAPResultAttributeOrValueExists, errors.New("value exists"))
+				}
+				attrs[name] = append(attrs[name], value)
+			case ldap.DeleteAttribute:
+				i := slices.Index(attrs[name], value)
+				if i < 0 {
+					return ldap.NewError(ldap.LDAPResultNoSuchAttribute, errors.New("no such value"))
+				}
+				attrs[name] = slices.Delete(attrs[name], i, i+1)
+			}
+		}
+	}
+	return nil
+}
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	password := filepath.Join(dir, "password")
+	if err := os.WriteFile(password, []byte("secret\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	write := func(content string) string {
+		path := filepath.Join(dir, "ldap.yaml")
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	cfg, err := Load(write(fmt.Sprintf(`
+url: ldap://localhost:389
+bind_dn: cn=pki,dc=hm,dc=edu
+bind_password_file: %s
+search_base: ou=people,dc=hm,dc=edu
+`, password)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Filter != defaultFilter || cfg.Timeout != defaultTimeout || cfg.bindPassword != "secret" {
+		t.Errorf("unexpected defaults %q %v %q", cfg.Filter, cfg.Timeout, cfg.bindPassword)
+	}
+	if len(cfg.Attributes) != 1 || cfg.Attributes[0].Name != "userCertificate;binary" || cfg.Attributes[0].Format != FormatDER {
+		t.Errorf("unexpected attributes %v", cfg.Attributes)
+	}
+
+	for _, content := range []string{
+		"search_base: dc=hm,dc=edu",
+		"url: ldap://localhost",
+		"url: ldap://localhost\nsearch_base: dc=hm,dc=edu\nfilter: (mail=*)",
+		"url: ldap://localhost\nsearch_base: dc=hm,dc=edu\nfilter: (mail=%s",
+		"url: ldap://localhost\nsearch_base: dc=hm,dc=edu\nattributes: [{name: userSMIMECertificate, format: pem}]",
+		"url: ldap://localhost\nsearch_base: dc=hm,dc=edu\nbind_dn: cn=pki,dc=hm,dc=edu",
+	} {
+		if _, err := Load(write(content)); err == nil {
+			t.Errorf("expected error for %q", content)
+		}
+	}
+}
+
+func TestPublishWithdraw(t *testing.T) {
+	directory := &fakeDirectory{entries: map[string]map[string][]string{
+		"uid=jane,ou=people,dc=hm,dc=edu": {"mail": {"jane.doe@hm.edu"}},
+	}}
+	cfg := &Config{
+		URL:        "ldap://localhost",
+		SearchBase: "ou=people,dc=hm,dc=edu",
+		Attributes: []Attribute{
+			{Name: "userCertificate;binary"},
+			{Name: "userSMIMECertificate", Format: FormatPKCS7},
+		},
+	}
+	if err := cfg.init(); err != nil {
+		t.Fatal(err)
+	}
+	cfg.dial = func() (conn, error) { return directory, nil }
+	entry := directory.entries["uid=jane,ou=people,dc=hm,dc=edu"]
+
+	foreign := testCertificate(t, "jane.doe@hm.edu")
+	entry["userCertificate;binary"] = []string{string(foreign.Raw)}
+
+	cert := testCertificate(t, "jane.doe@hm.edu")
+	if err := cfg.Publish("jane.doe@hm.edu", cert); err != nil {
+		t.Fatal(err)
+	}
+	if len(entry["userCertificate;binary"]) != 2 || entry["userCertificate;binary"][1] != string(cert.Raw) {
+		t.Errorf("certificate not published as DER")
+	}
+	if len(entry["userSMIMECertificate"]) != 1 || !contains(FormatPKCS7, []byte(entry["userSMIMECertificate"][0]), cert) {
+		t.Errorf("certificate not published as PKCS#7")
+	}
+
+	// Publishing is idempotent.
+	if err := cfg.Publish("jane.doe@hm.edu", cert); err != nil {
+		t.Fatal(err)
+	}
+	if directory.modify != 1 {
+		t.Errorf("expected a single modification, got %d", directory.modify)
+	}
+
+	if err := cfg.Publish("john.doe@hm.edu", cert); !errors.Is(err, ErrEntryNotFound) {
+		t.Errorf("expected ErrEntryNotFound, got %v", err)
+	}
+	if err := cfg.Withdraw("john.doe@hm.edu", cert); err != nil {
+		t.Errorf("withdrawing without entry failed: %v", err)
+	}
+
+	// Only the withdrawn certificate is removed.
+	if err := cfg.Withdraw("jane.doe@hm.edu", cert); err != nil {
+		t.Fatal(err)
+	}
+	if len(entry["userCertificate;binary"]) != 1 || entry["userCertificate;binary"][0] != string(foreign.Raw) {
+		t.Errorf("unexpected DER values after withdrawal")
+	}
+	if len(entry["userSMIMECertificate"]) != 0 {
+		t.Errorf("unexpected PKCS#7 values after withdrawal")
+	}
+	if err := cfg.Withdraw("jane.doe@hm.edu", cert); err != nil {
+		t.Fatal(err)
+	}
+	if directory.modify != 2 {
+		t.Errorf("expected two modifications, got %d", directory.modify)
+	}
+}
+
+// TestLocalServer publishes a certificate to a local directory server. It is
+// only run if LDAP_TEST_URL is set, e.g.
+//
+//	docker run -p 1389:1389 -e LDAP_ADMIN_PASSWORD=secret bitnami/openldap
+//	LDAP_TEST_URL=ldap://localhost:1389 LDAP_TEST_BIND_DN=cn=admin,dc=example,dc=org \
+//	LDAP_TEST_BIND_PASSWORD=secret LDAP_TEST_SEARCH_BASE=dc=example,dc=org \
+//	LDAP_TEST_EMAIL=user01@example.org go test ./pkg/publisher/
+//
+// The entry of LDAP_TEST_EMAIL must exist and allow the userCertificate
+// attribute (e.g. inetOrgPerson).
+func TestLocalServer(t *testing.T) {
+	url := os.Getenv("LDAP_TEST_URL")
+	if url == "" {
+		t.Skip("LDAP_TEST_URL not set")
+	}
+	password := filepath.Join(t.TempDir(), "password")
+	if err := os.WriteFile(password, []byte(os.Getenv("LDAP_TEST_BIND_PASSWORD")), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	cfg := &Config{
+		URL:              url,
+		BindDN:           os.Getenv("LDAP_TEST_BIND_DN"),
+		BindPasswordFile: password,
+		SearchBase:       os.Getenv("LDAP_TEST_SEARCH_BASE"),
+	}
+	if err := cfg.init(); err != nil {
+		t.Fatal(err)
+	}
+	email := os.Getenv("LDAP_TEST_EMAIL")
+	cert := testCertificate(t, email)
+	published := func() bool {
+		l, err := cfg.connect()
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer func() { _ = l.Close() }()
+		e, err := cfg.entry(l, email)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, value := range e.GetRawAttributeValues("userCertificate;binary") {
+			if contains(FormatDER, value, cert) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if err := cfg.Publish(email, cert); err != nil {
+		t.Fatal(err)
+	}
+	if !published() {
+		t.Error("certificate not published")
+	}
+	if err := cfg.Withdraw(email, cert); err != nil {
+		t.Fatal(err)
+	}
+	if published() {
+		t.Error("certificate not withdrawn")
+	}
+}
@@ -2,15 +2,20 @@ package worker
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/hm-edu/pki-service/ent"
 	"github.com/hm-edu/pki-service/ent/certificate"
+	"github.com/hm-edu/pki-service/ent/smimecertificate"
+	"github.com/hm-edu/pki-service/pkg/publisher"
 	"go.uber.org/zap"
 )
 
-// Cleanup checks for expired certificates and marks them as expired
-func Cleanup(logger *zap.Logger, db *ent.Client) error {
+// Cleanup checks for expired server and S/MIME certificates and marks them as
+// expired. Expired S/MIME certificates are withdrawn from the directory if a
+// publisher is given.
+func Cleanup(logger *zap.Logger, db *ent.Client, pub publisher.Publisher) error {
 
 	certs, err := db.Certificate.Query().Where(certificate.And(certificate.StatusEQ(certificate.StatusIssued), certificate.NotAfterLT(time.Now()))).All(context.Background())
 	if err != nil {
@@ -23,5 +28,32 @@ func Cleanup(logger *zap.Logger, db *ent.Client) error {
 		}
 		logger.Info("Certificate expired", zap.String("common_name", cert.CommonName), zap.String("serial_number", cert.Serial))
 	}
+
+	var failed []int
+	if pub != nil {
+		if failed, err = withdrawExpired(context.Background(), logger, db, pub); err != nil {
+			return err
+		}
+	}
+
+	// Certificates that could not be withdrawn stay issued, so the
+	// withdrawal is retried on the next run.
+	expired, err := db.SmimeCertificate.Update().
+		Where(
+			smimecertificate.StatusEQ(smimecertificate.StatusIssued),
+			smimecertificate.NotAfterLT(time.Now()),
+			smimecertificate.IDNotIn(failed...),
+		).
+		SetStatus(smimecertificate.StatusExpired).
+		Save(context.Background())
+	if err != nil {
+		return err
+	}
+	if expired > 0 {
+		logger.Info("S/MIME certificates expired", zap.Int("count", expired))
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("%d expired S/MIME certificates could not be withdrawn", len(failed))
+	}
 	return nil
 }
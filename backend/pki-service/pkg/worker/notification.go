@@ -3,113 +3,423 @@ package worker
 import (
 	"context"
 	"fmt"
-	"net/smtp"
+	"slices"
+	"sort"
 	"strings"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/hm-edu/pki-service/ent"
 	"github.com/hm-edu/pki-service/ent/certificate"
 	"github.com/hm-edu/pki-service/ent/domain"
+	"github.com/hm-edu/pki-service/ent/predicate"
+	"github.com/hm-edu/pki-service/ent/sentnotification"
+	"github.com/hm-edu/pki-service/pkg/notify"
+	pb "github.com/hm-edu/portal-apis"
 	"go.uber.org/zap"
 )
 
-// Notifier holds all required information for mail notifications related to certificate expiry
+// DefaultIntervals are the days before the expiry of a SSL certificate on
+// which reminders are sent by default.
+var DefaultIntervals = []int{30, 14, 7, 3, 1}
+
+// Notifier holds all required information for notifications related to certificate expiry
 type Notifier struct {
-	MailFrom     string
-	MailHost     string
-	MailPort     int
-	MailTo       string
-	MailToBcc    string
-	Db           *ent.Client
-	Force        bool
-	MailUsername string
-	MailPassword string
+	// Dispatcher delivers the notifications through the configured channels.
+	Dispatcher *notify.Dispatcher
+	// MailTo receives all reminders instead of their recipients (e.g. for
+	// testing). The reminders are recorded for the original recipients.
+	MailTo    string
+	MailToBcc string
+	Db        *ent.Client
+	// Intervals are the days before the expiry of a SSL certificate on
+	// which reminders are sent.
+	Intervals []int
+	// SmimeIntervals are the days before the expiry of a S/MIME certificate
+	// on which reminders are sent.
+	SmimeIntervals []int
+	// Domains resolves the owners, delegates and technical contacts of the
+	// domains of expiring SSL certificates. Only the requester is reminded
+	// if nil or if the domain service fails.
+	Domains pb.DomainServiceClient
+	// FallbackRecipient (e.g. a team mailbox) is reminded about SSL
+	// certificates without responsible owner for any of their domains.
+	FallbackRecipient string
 }
 
 type certificateItem struct {
 	cert    *ent.Certificate
 	domains []string
+	// thresholds are the thresholds reached by the certificate.
+	thresholds []int
 }
 
-func (w *Notifier) loadCertificates() (map[int]certificateItem, error) {
+// reachedThresholds returns the intervals reached by a certificate expiring
+// at notAfter. An interval is reached once less than its number of days
+// remain. Intervals reached earlier (e.g. because the job did not run) are
+// included, so their reminders are sent late instead of never.
+func reachedThresholds(intervals []int, notAfter time.Time) []int {
+	remaining := time.Until(notAfter)
+	var reached []int
+	for _, interval := range intervals {
+		if remaining < time.Duration(interval)*24*time.Hour {
+			reached = append(reached, interval)
+		}
+	}
+	return reached
+}
+
+func (w *Notifier) intervals() []int {
+	if len(w.Intervals) == 0 {
+		return DefaultIntervals
+	}
+	return w.Intervals
+}
+
+// latestCertificate joins the domains with their most recent certificate
+// that is neither revoked nor invalid and selects its ID. Only certificates
+// expiring in the given window are kept; domains whose latest certificate
+// expired or does not expire soon are dropped.
+func latestCertificate(after, before time.Time) predicate.Domain {
+	return func(s *sql.Selector) {
+		cd := sql.Table(certificate.DomainsTable)
+		c := sql.Table(certificate.Table)
+		latest := sql.Select().From(cd)
+		latest.Join(c).On(cd.C(certificate.DomainsPrimaryKey[0]), c.C(certificate.FieldID))
+		latest.Select(
+			sql.As(cd.C(certificate.DomainsPrimaryKey[0]), "certificate_id"),
+			sql.As(cd.C(certificate.DomainsPrimaryKey[1]), "domain_id"),
+			c.C(certificate.FieldNotAfter),
+		).
+			AppendSelectExprAs(sql.RowNumber().
+				PartitionBy(cd.C(certificate.DomainsPrimaryKey[1])).
+				OrderBy(sql.Desc(c.C(certificate.FieldNotAfter)), sql.Desc(c.C(certificate.FieldID))), "position").
+			Where(sql.NotIn(c.C(certificate.FieldStatus), certificate.StatusRevoked.String(), certificate.StatusInvalid.String())).
+			As("latest")
+		s.Join(latest).On(s.C(domain.FieldID), latest.C("domain_id")).
+			Where(sql.And(
+				sql.EQ(latest.C("position"), 1),
+				sql.GT(latest.C(certificate.FieldNotAfter), after),
+				sql.LT(latest.C(certificate.FieldNotAfter), before),
+			)).
+			AppendSelect(latest.C("certificate_id"))
+	}
+}
 
-	domains, err := w.Db.Domain.Query().All(context.Background())
+// loadCertificates returns the most recent certificates of the domains that
+// reached a threshold. The latest certificate of each domain is determined
+// by a single query instead of one query per domain.
+func (w *Notifier) loadCertificates() (map[int]certificateItem, error) {
+	ctx := context.Background()
+	intervals := w.intervals()
+	now := time.Now()
+	before := now.AddDate(0, 0, slices.Max(intervals))
+	var latest []struct {
+		Fqdn          string `json:"fqdn"`
+		CertificateID int    `json:"certificate_id"`
+	}
+	err := w.Db.Domain.Query().
+		Where(latestCertificate(now, before)).
+		Order(ent.Asc(domain.FieldID)).
+		Select(domain.FieldFqdn).
+		Scan(ctx, &latest)
 	if err != nil {
 		return nil, err
 	}
+	var ids []int
+	seen := make(map[int]bool)
+	for _, l := range latest {
+		if !seen[l.CertificateID] {
+			seen[l.CertificateID] = true
+			ids = append(ids, l.CertificateID)
+		}
+	}
 	doneCertificates := make(map[int]certificateItem)
-	for _, d := range domains {
-		certificate, err := w.Db.Certificate.Query().WithDomains().Where(certificate.HasDomainsWith(domain.FqdnEQ(d.Fqdn)),
-			certificate.StatusNEQ(certificate.StatusRevoked),
-			certificate.StatusNEQ(certificate.StatusInvalid)).Order(ent.Desc(certificate.FieldNotAfter)).Limit(1).All(context.Background())
-		if err != nil {
-			return nil, err
+	if len(ids) == 0 {
+		return doneCertificates, nil
+	}
+	certificates, err := w.Db.Certificate.Query().WithDomains().Where(certificate.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int]*ent.Certificate, len(certificates))
+	for _, c := range certificates {
+		byID[c.ID] = c
+	}
+	for _, l := range latest {
+		cert, ok := byID[l.CertificateID]
+		if !ok {
+			continue
 		}
-		if len(certificate) == 0 {
+		if item, ok := doneCertificates[cert.ID]; ok {
+			item.domains = append(item.domains, l.Fqdn)
+			doneCertificates[cert.ID] = item
 			continue
 		}
-		if certificate[0].NotAfter.Before(time.Now().AddDate(0, 0, 30)) && certificate[0].NotAfter.After(time.Now()) {
+		thresholds := reachedThresholds(intervals, cert.NotAfter)
+		if len(thresholds) == 0 {
+			continue
+		}
+		doneCertificates[cert.ID] = certificateItem{cert: cert, domains: []string{l.Fqdn}, thresholds: thresholds}
+	}
+	return doneCertificates, nil
+}
+
+// sentKey identifies the reminders of a certificate sent to a recipient
+// through a channel.
+type sentKey struct {
+	kind      sentnotification.Kind
+	id        int
+	recipient string
+	channel   string
+}
+
+// loadSent returns the thresholds already sent for the certificates.
+func (w *Notifier) loadSent(ctx context.Context, kind sentnotification.Kind, ids []int) (map[sentKey][]int, error) {
+	sent := make(map[sentKey][]int)
+	if len(ids) == 0 {
+		return sent, nil
+	}
+	records, err := w.Db.SentNotification.Query().
+		Where(sentnotification.KindEQ(kind), sentnotification.CertificateIdIn(ids...)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range records {
+		key := sentKey{kind: r.Kind, id: r.CertificateId, recipient: r.Recipient, channel: r.Channel}
+		sent[key] = append(sent[key], r.Threshold)
+	}
+	return sent, nil
+}
+
+// digest collects the reminders for a single recipient and channel.
+type digest struct {
+	recipient    string
+	channel      string
+	certificates []notify.CertificateExpiry
+	smime        []notify.SmimeExpiry
+	records      []*ent.SentNotificationCreate
+}
 
-			days := time.Until(certificate[0].NotAfter).Hours() / 24
-			if int(days)%7 == 0 || w.Force {
-				if _, ok := doneCertificates[certificate[0].ID]; !ok {
-					doneCertificates[certificate[0].ID] = certificateItem{cert: certificate[0], domains: []string{d.Fqdn}}
-				} else {
-					doneCertificates[certificate[0].ID] = certificateItem{cert: certificate[0], domains: append(doneCertificates[certificate[0].ID].domains, d.Fqdn)}
+// digestKey identifies the digest of a recipient for a channel.
+type digestKey struct {
+	recipient string
+	channel   string
+}
+
+// digests groups the reminders by recipient and channel. Thresholds already
+// sent to a recipient through a channel are skipped; certificates without
+// pending thresholds are left out.
+type digests struct {
+	db       *ent.Client
+	sent     map[sentKey][]int
+	channels []string
+	byKey    map[digestKey]*digest
+}
+
+// add adds a certificate to the digests of its recipients. It returns the
+// recipients the certificate is added for on any channel.
+func (d *digests) add(kind sentnotification.Kind, id int, thresholds []int, recipients []string, addTo func(*digest)) []string {
+	var added []string
+	for _, recipient := range recipients {
+		recipient = strings.ToLower(recipient)
+		if recipient == "" || slices.Contains(added, recipient) {
+			continue
+		}
+		// Records without channel count for all channels.
+		legacy := d.sent[sentKey{kind: kind, id: id, recipient: recipient}]
+		pendingAny := false
+		for _, channel := range d.channels {
+			sent := d.sent[sentKey{kind: kind, id: id, recipient: recipient, channel: channel}]
+			var pending []int
+			for _, threshold := range thresholds {
+				if !slices.Contains(sent, threshold) && !slices.Contains(legacy, threshold) {
+					pending = append(pending, threshold)
 				}
 			}
+			if len(pending) == 0 {
+				continue
+			}
+			key := digestKey{recipient: recipient, channel: channel}
+			dg, ok := d.byKey[key]
+			if !ok {
+				dg = &digest{recipient: recipient, channel: channel}
+				d.byKey[key] = dg
+			}
+			addTo(dg)
+			for _, threshold := range pending {
+				dg.records = append(dg.records, d.db.SentNotification.Create().
+					SetKind(kind).
+					SetCertificateId(id).
+					SetThreshold(threshold).
+					SetRecipient(recipient).
+					SetChannel(channel))
+			}
+			pendingAny = true
+		}
+		if pendingAny {
+			added = append(added, recipient)
 		}
 	}
-	return doneCertificates, err
+	return added
+}
+
+// message returns the notification for the digest. A single certificate is
+// announced using its own template.
+func (dg *digest) message() notify.Message {
+	msg := notify.Message{To: []string{dg.recipient}, Channels: []string{dg.channel}}
+	switch {
+	case len(dg.certificates) == 1 && len(dg.smime) == 0:
+		msg.Event = notify.EventCertificateExpiring
+		msg.Data = dg.certificates[0]
+	case len(dg.certificates) == 0 && len(dg.smime) == 1:
+		msg.Event = notify.EventSmimeExpiring
+		msg.Data = dg.smime[0]
+	default:
+		msg.Event = notify.EventExpiryDigest
+		msg.Data = notify.ExpiryDigest{Certificates: dg.certificates, SmimeCertificates: dg.smime}
+	}
+	return msg
 }
 
-// Notify triggers an email for each
+// Notify reminds the users of their expiring SSL and S/MIME certificates.
+// Each recipient receives a single digest per channel and run. Reminders are
+// recorded per certificate, threshold, recipient and channel once they have
+// been delivered, so thresholds missed (e.g. because the job or a channel
+// failed) are sent on the next run and no channel delivers a reminder twice.
 func (w *Notifier) Notify(logger *zap.Logger) error {
+	ctx := context.Background()
 	doneCertificates, err := w.loadCertificates()
 	if err != nil {
 		return err
 	}
+	smimeItems, err := w.loadSmimeCertificates()
+	if err != nil {
+		return err
+	}
+	var ids []int
+	for id := range doneCertificates {
+		ids = append(ids, id)
+	}
+	sent, err := w.loadSent(ctx, sentnotification.KindSsl, ids)
+	if err != nil {
+		return err
+	}
+	ids = nil
+	for _, item := range smimeItems {
+		ids = append(ids, item.cert.ID)
+	}
+	sentSmime, err := w.loadSent(ctx, sentnotification.KindSmime, ids)
+	if err != nil {
+		return err
+	}
+	for k, v := range sentSmime {
+		sent[k] = v
+	}
+	contacts, err := w.domainContacts(ctx, doneCertificates)
+	if err != nil {
+		// The reminders of the domain contacts stay pending and are sent
+		// on the next run.
+		logger.Warn("Error resolving domain contacts, notifying the requesters only", zap.Error(err))
+		contacts = nil
+	}
+	d := &digests{db: w.Db, sent: sent, channels: w.channels(), byKey: make(map[digestKey]*digest)}
+
 	for _, certificate := range doneCertificates {
-		days := time.Until(certificate.cert.NotAfter).Hours() / 24
-		logger.Info(fmt.Sprintf("Certificate for %v expires in %d days, sending notification.", certificate.domains, int(days)))
-		certDomains := certificate.cert.Edges.Domains[0].Fqdn
-		for _, x := range certificate.cert.Edges.Domains[1:] {
-			certDomains = fmt.Sprintf("%s, %s", certDomains, x.Fqdn)
+		days := int(time.Until(certificate.cert.NotAfter).Hours() / 24)
+		var certDomains []string
+		for _, x := range certificate.cert.Edges.Domains {
+			certDomains = append(certDomains, x.Fqdn)
 		}
-		to := []string{strings.Split(*certificate.cert.IssuedBy, " ")[0]}
-		if w.MailTo != "" {
-			to = []string{w.MailTo}
+		data := notify.CertificateExpiry{
+			Domains:            certificate.domains,
+			CertificateDomains: certDomains,
+			Serial:             certificate.cert.Serial,
+			NotAfter:           certificate.cert.NotAfter,
+			Days:               days,
+		}
+		recipients := d.add(sentnotification.KindSsl, certificate.cert.ID, certificate.thresholds,
+			w.certificateRecipients(certificate.cert, contacts),
+			func(dg *digest) { dg.certificates = append(dg.certificates, data) })
+		if len(recipients) > 0 {
+			logger.Info(fmt.Sprintf("Certificate for %v expires in %d days, sending notification.", certificate.domains, days))
 		}
-		if w.MailToBcc != "" && w.MailToBcc != to[0] {
-			to = append(to, w.MailToBcc)
+	}
+	for _, item := range smimeItems {
+		days := int(time.Until(item.cert.NotAfter).Hours() / 24)
+		data := notify.SmimeExpiry{
+			Email:    item.cert.Email,
+			Serial:   item.cert.Serial,
+			NotAfter: item.cert.NotAfter,
+			Days:     days,
 		}
-		var auth smtp.Auth
-		if w.MailUsername != "" && w.MailPassword != "" {
-			auth = smtp.PlainAuth("", w.MailUsername, w.MailPassword, w.MailHost)
+		recipients := d.add(sentnotification.KindSmime, item.cert.ID, item.thresholds, item.recipients,
+			func(dg *digest) { dg.smime = append(dg.smime, data) })
+		if len(recipients) > 0 {
+			logger.Info("S/MIME certificate expires soon, sending notification.",
+				zap.String("email", item.cert.Email),
+				zap.String("serial", item.cert.Serial),
+				zap.Int("days", days))
 		}
+	}
 
-		err = smtp.SendMail(fmt.Sprintf("%s:%d", w.MailHost, w.MailPort), auth, w.MailFrom, to, fmt.Appendf(nil, `From: PKI <%s>
-To: %s
-Subject: Infomationen zu Zertifikatsablauf %s
-
-Sehr geehrte(r) Nutzer(in) des PKI-Portals,
-
-Das letzte ausgetellte Zertifikat für die Domain(s) %s wird am %s ablaufen.
-Bitte erneuern Sie dieses Zertifikat zeitnah.
-Das betreffende Zertifikat ist für folgende (weitere) Domains ausgestellt:
-
-%s.
-
-Sollten Sie Fragen haben, wenden Sie sich bitte an den Support.
-
-Mit freundlichen Grüßen,
-Ihre Zentrale IT
-				`, w.MailFrom, strings.Split(*certificate.cert.IssuedBy, " ")[0], strings.Join(certificate.domains, ", "), strings.Join(certificate.domains, ", "), certificate.cert.NotAfter.Format("02.01.2006"), certDomains))
+	keys := make([]digestKey, 0, len(d.byKey))
+	for key := range d.byKey {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].recipient != keys[j].recipient {
+			return keys[i].recipient < keys[j].recipient
+		}
+		return keys[i].channel < keys[j].channel
+	})
+	for _, key := range keys {
+		dg := d.byKey[key]
+		sortDigest(dg)
+		msg := dg.message()
+		if w.MailTo != "" {
+			msg.To = []string{w.MailTo}
+		}
+		if w.MailToBcc != "" && !strings.EqualFold(w.MailToBcc, msg.To[0]) {
+			msg.Bcc = []string{w.MailToBcc}
+		}
+		delivered, err := w.Dispatcher.Deliver(ctx, msg)
 		if err != nil {
-			logger.Error("Error sending mail", zap.Error(err))
+			logger.Error("Error sending notification", zap.String("recipient", key.recipient), zap.String("channel", key.channel), zap.Error(err))
+		}
+		// Channels not handling the event of the digest deliver nothing;
+		// the reminders stay pending for them.
+		if !slices.Contains(delivered, key.channel) {
+			continue
 		}
+		if err := w.Db.SentNotification.CreateBulk(dg.records...).Exec(ctx); err != nil {
+			logger.Error("Error recording sent notification", zap.String("recipient", key.recipient), zap.String("channel", key.channel), zap.Error(err))
+		}
+	}
+	return nil
+}
 
+// channels returns the names of the channels delivering reminders.
+func (w *Notifier) channels() []string {
+	cfg := w.Dispatcher.Config()
+	var channels []string
+	for _, event := range []string{notify.EventCertificateExpiring, notify.EventSmimeExpiring, notify.EventExpiryDigest} {
+		for _, name := range cfg.ChannelNames(event) {
+			if !slices.Contains(channels, name) {
+				channels = append(channels, name)
+			}
+		}
 	}
+	return channels
+}
 
-	return nil
+// sortDigest orders the certificates of the digest by their expiry.
+func sortDigest(dg *digest) {
+	sort.SliceStable(dg.certificates, func(i, j int) bool {
+		return dg.certificates[i].NotAfter.Before(dg.certificates[j].NotAfter)
+	})
+	sort.SliceStable(dg.smime, func(i, j int) bool {
+		return dg.smime[i].NotAfter.Before(dg.smime[j].NotAfter)
+	})
 }
@@ -0,0 +1,335 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hm-edu/pki-service/ent"
+	"github.com/hm-edu/pki-service/ent/certificate"
+	"github.com/hm-edu/pki-service/ent/enttest"
+	"github.com/hm-edu/pki-service/ent/sentnotification"
+	"github.com/hm-edu/pki-service/ent/smimecertificate"
+	"github.com/hm-edu/pki-service/pkg/notify"
+	pb "github.com/hm-edu/portal-apis"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+// webhookRecorder collects the payloads posted to a generic webhook.
+type webhookRecorder struct {
+	mu       sync.Mutex
+	fail     bool
+	payloads []map[string]any
+}
+
+func (r *webhookRecorder) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.fail {
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+	var payload map[string]any
+	_ = json.NewDecoder(req.Body).Decode(&payload)
+	r.payloads = append(r.payloads, payload)
+}
+
+func (r *webhookRecorder) take() []map[string]any {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	payloads := r.payloads
+	r.payloads = nil
+	return payloads
+}
+
+func webhookDispatcher(t *testing.T, url string) *notify.Dispatcher {
+	path := filepath.Join(t.TempDir(), "notifications.yaml")
+	if err := os.WriteFile(path, []byte("channels: [{type: webhook, url: "+url+"}]"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := notify.Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return notify.New(cfg)
+}
+
+func TestNotifyDigest(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:digest?mode=memory&cache=shared&_fk=1")
+	defer func(*ent.Client) {
+		_ = client.Close()
+	}(client)
+	ctx := context.Background()
+	recorder := &webhookRecorder{}
+	server := httptest.NewServer(recorder)
+	defer server.Close()
+	n := Notifier{Db: client, Dispatcher: webhookDispatcher(t, server.URL)}
+	in := func(days int) time.Time { return time.Now().Add(time.Duration(days)*24*time.Hour - time.Hour) }
+
+	d1 := client.Domain.Create().SetFqdn("www.example.com").SaveX(ctx)
+	d2 := client.Domain.Create().SetFqdn("example.com").SaveX(ctx)
+	d3 := client.Domain.Create().SetFqdn("mail.example.com").SaveX(ctx)
+	client.Certificate.Create().SetCommonName("www.example.com").SetSerial("a").SetIssuedBy("jane.doe@hm.edu (ACME)").
+		SetNotAfter(in(6)).SetStatus(certificate.StatusIssued).AddDomains(d1, d2).SaveX(ctx)
+	client.Certificate.Create().SetCommonName("mail.example.com").SetSerial("b").SetIssuedBy("john.doe@hm.edu").
+		SetNotAfter(in(20)).SetStatus(certificate.StatusIssued).AddDomains(d3).SaveX(ctx)
+	client.SmimeCertificate.Create().SetSerial("1").SetEmail("Jane.Doe@hm.edu").SetNotAfter(in(13)).
+		SetStatus(smimecertificate.StatusIssued).SaveX(ctx)
+
+	if err := n.Notify(zap.L()); err != nil {
+		t.Fatal(err)
+	}
+	payloads := recorder.take()
+	if len(payloads) != 2 {
+		t.Fatalf("expected one notification per recipient, got %d", len(payloads))
+	}
+	// Recipients are notified in alphabetical order.
+	jane, john := payloads[0], payloads[1]
+	if jane["event"] != notify.EventExpiryDigest {
+		t.Errorf("expected digest for jane, got %v", jane["event"])
+	}
+	data := jane["data"].(map[string]any)
+	if len(data["certificates"].([]any)) != 1 || len(data["smime_certificates"].([]any)) != 1 {
+		t.Errorf("unexpected digest %v", data)
+	}
+	if john["event"] != notify.EventCertificateExpiring || john["recipients"].([]any)[0] != "john.doe@hm.edu" {
+		t.Errorf("unexpected notification for john %v", john)
+	}
+	// Missed thresholds are recorded along with the current one.
+	if count := client.SentNotification.Query().Where(sentnotification.KindEQ(sentnotification.KindSsl)).CountX(ctx); count != 4 {
+		t.Errorf("expected 4 recorded SSL thresholds (30, 14, 7 and 30), got %d", count)
+	}
+	if count := client.SentNotification.Query().Where(sentnotification.KindEQ(sentnotification.KindSmime)).CountX(ctx); count != 2 {
+		t.Errorf("expected 2 recorded S/MIME thresholds (30 and 14), got %d", count)
+	}
+
+	// Reminders are not sent twice.
+	if err := n.Notify(zap.L()); err != nil {
+		t.Fatal(err)
+	}
+	if payloads := recorder.take(); len(payloads) != 0 {
+		t.Fatalf("expected no notifications, got %v", payloads)
+	}
+
+	// Undelivered reminders are sent on the next run.
+	client.SmimeCertificate.Create().SetSerial("2").SetEmail("max@hm.edu").SetNotAfter(in(2)).
+		SetStatus(smimecertificate.StatusIssued).SaveX(ctx)
+	recorder.fail = true
+	if err := n.Notify(zap.L()); err != nil {
+		t.Fatal(err)
+	}
+	if client.SentNotification.Query().Where(sentnotification.Recipient("max@hm.edu")).ExistX(ctx) {
+		t.Fatal("undelivered reminders must not be recorded")
+	}
+	recorder.fail = false
+	if err := n.Notify(zap.L()); err != nil {
+		t.Fatal(err)
+	}
+	payloads = recorder.take()
+	if len(payloads) != 1 || payloads[0]["event"] != notify.EventSmimeExpiring {
+		t.Fatalf("expected the pending reminder, got %v", payloads)
+	}
+}
+
+func TestNotifyChannels(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:digestchannels?mode=memory&cache=shared&_fk=1")
+	defer func(*ent.Client) {
+		_ = client.Close()
+	}(client)
+	ctx := context.Background()
+	primary, secondary := &webhookRecorder{}, &webhookRecorder{fail: true}
+	primaryServer, secondaryServer := httptest.NewServer(primary), httptest.NewServer(secondary)
+	defer primaryServer.Close()
+	defer secondaryServer.Close()
+	path := filepath.Join(t.TempDir(), "notifications.yaml")
+	config := "channels: [{name: primary, type: webhook, url: " + primaryServer.URL + "}, {name: secondary, type: webhook, url: " + secondaryServer.URL + "}]"
+	if err := os.WriteFile(path, []byte(config), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := notify.Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := Notifier{Db: client, Dispatcher: notify.New(cfg)}
+	client.SmimeCertificate.Create().SetSerial("1").SetEmail("jane.doe@hm.edu").SetNotAfter(time.Now().Add(2 * 24 * time.Hour)).
+		SetStatus(smimecertificate.StatusIssued).SaveX(ctx)
+
+	if err := n.Notify(zap.L()); err != nil {
+		t.Fatal(err)
+	}
+	if len(primary.take()) != 1 {
+		t.Fatal("expected the reminder on the primary channel")
+	}
+	if !client.SentNotification.Query().Where(sentnotification.Channel("primary")).ExistX(ctx) ||
+		client.SentNotification.Query().Where(sentnotification.Channel("secondary")).ExistX(ctx) {
+		t.Fatal("expected the reminder to be recorded for the primary channel only")
+	}
+
+	// Only the failed channel is retried.
+	secondary.fail = false
+	if err := n.Notify(zap.L()); err != nil {
+		t.Fatal(err)
+	}
+	if len(primary.take()) != 0 || len(secondary.take()) != 1 {
+		t.Fatal("expected the reminder on the secondary channel only")
+	}
+	if err := n.Notify(zap.L()); err != nil {
+		t.Fatal(err)
+	}
+	if len(primary.take()) != 0 || len(secondary.take()) != 0 {
+		t.Fatal("expected no further reminders")
+	}
+}
+
+func TestNotifyMailTo(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:digestmailto?mode=memory&cache=shared&_fk=1")
+	defer func(*ent.Client) {
+		_ = client.Close()
+	}(client)
+	ctx := context.Background()
+	recorder := &webhookRecorder{}
+	server := httptest.NewServer(recorder)
+	defer server.Close()
+	n := Notifier{Db: client, Dispatcher: webhookDispatcher(t, server.URL), MailTo: "test@hm.edu"}
+	for i, email := range []string{"jane.doe@hm.edu", "john.doe@hm.edu"} {
+		client.SmimeCertificate.Create().SetSerial(fmt.Sprint(i)).SetEmail(email).SetNotAfter(time.Now().Add(2 * 24 * time.Hour)).
+			SetStatus(smimecertificate.StatusIssued).SaveX(ctx)
+	}
+
+	if err := n.Notify(zap.L()); err != nil {
+		t.Fatal(err)
+	}
+	payloads := recorder.take()
+	if len(payloads) != 2 {
+		t.Fatalf("expected one notification per recipient, got %d", len(payloads))
+	}
+	for _, payload := range payloads {
+		if recipients := payload["recipients"].([]any); len(recipients) != 1 || recipients[0] != "test@hm.edu" {
+			t.Errorf("expected the notification to be sent to the override address, got %v", recipients)
+		}
+	}
+	// The reminders are recorded for the original recipients.
+	for _, email := range []string{"jane.doe@hm.edu", "john.doe@hm.edu"} {
+		if !client.SentNotification.Query().Where(sentnotification.Recipient(email)).ExistX(ctx) {
+			t.Errorf("expected the reminder to be recorded for %s", email)
+		}
+	}
+	if client.SentNotification.Query().Where(sentnotification.Recipient("test@hm.edu")).ExistX(ctx) {
+		t.Error("expected no reminder recorded for the override address")
+	}
+}
+
+// domainContactsClient answers ListDomainContacts from a static map.
+type domainContactsClient struct {
+	pb.DomainServiceClient
+	contacts map[string]*pb.DomainContacts
+	err      error
+}
+
+func (c *domainContactsClient) ListDomainContacts(_ context.Context, req *pb.ListDomainContactsRequest, _ ...grpc.CallOption) (*pb.ListDomainContactsResponse, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	resp := &pb.ListDomainContactsResponse{}
+	for _, name := range req.Domains {
+		if contacts, ok := c.contacts[name]; ok {
+			resp.Contacts = append(resp.Contacts, contacts)
+		}
+	}
+	return resp, nil
+}
+
+func TestNotifyDomainContacts(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:domaincontacts?mode=memory&cache=shared&_fk=1")
+	defer func(*ent.Client) {
+		_ = client.Close()
+	}(client)
+	ctx := context.Background()
+	recorder := &webhookRecorder{}
+	server := httptest.NewServer(recorder)
+	defer server.Close()
+	n := Notifier{
+		Db:         client,
+		Dispatcher: webhookDispatcher(t, server.URL),
+		Domains: &domainContactsClient{contacts: map[string]*pb.DomainContacts{
+			"www.example.com": {Domain: "www.example.com", Owner: "max@hm.edu", Delegates: []string{"erika@hm.edu"}},
+			"example.com":     {Domain: "example.com", Owner: "Max@hm.edu", Contacts: []string{"ops@hm.edu"}},
+		}},
+		FallbackRecipient: "pki-team@hm.edu",
+	}
+	in := func(days int) time.Time { return time.Now().Add(time.Duration(days)*24*time.Hour - time.Hour) }
+
+	d1 := client.Domain.Create().SetFqdn("www.example.com").SaveX(ctx)
+	d2 := client.Domain.Create().SetFqdn("example.com").SaveX(ctx)
+	d3 := client.Domain.Create().SetFqdn("legacy.example.org").SaveX(ctx)
+	client.Certificate.Create().SetCommonName("www.example.com").SetIssuedBy("jane.doe@hm.edu").
+		SetNotAfter(in(6)).SetStatus(certificate.StatusIssued).AddDomains(d1, d2).SaveX(ctx)
+	client.Certificate.Create().SetCommonName("legacy.example.org").SetIssuedBy("john.doe@hm.edu").
+		SetNotAfter(in(6)).SetStatus(certificate.StatusIssued).AddDomains(d3).SaveX(ctx)
+
+	if err := n.Notify(zap.L()); err != nil {
+		t.Fatal(err)
+	}
+	var recipients []string
+	for _, payload := range recorder.take() {
+		recipients = append(recipients, payload["recipients"].([]any)[0].(string))
+	}
+	// Recipients are deduplicated across the domains; the team mailbox is
+	// only notified about the certificate without responsible owner.
+	expected := []string{"erika@hm.edu", "jane.doe@hm.edu", "john.doe@hm.edu", "max@hm.edu", "ops@hm.edu", "pki-team@hm.edu"}
+	if !slices.Equal(recipients, expected) {
+		t.Fatalf("expected recipients %v, got %v", expected, recipients)
+	}
+	if !client.SentNotification.Query().Where(sentnotification.Recipient("pki-team@hm.edu"), sentnotification.Threshold(7)).ExistX(ctx) {
+		t.Error("expected the reminder of the team mailbox to be recorded")
+	}
+}
+
+func TestNotifyDomainContactsUnavailable(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:domaincontactsunavailable?mode=memory&cache=shared&_fk=1")
+	defer func(*ent.Client) {
+		_ = client.Close()
+	}(client)
+	ctx := context.Background()
+	recorder := &webhookRecorder{}
+	server := httptest.NewServer(recorder)
+	defer server.Close()
+	domains := &domainContactsClient{
+		contacts: map[string]*pb.DomainContacts{"www.example.com": {Domain: "www.example.com", Owner: "max@hm.edu"}},
+		err:      errors.New("unavailable"),
+	}
+	n := Notifier{Db: client, Dispatcher: webhookDispatcher(t, server.URL), Domains: domains, FallbackRecipient: "pki-team@hm.edu"}
+
+	d := client.Domain.Create().SetFqdn("www.example.com").SaveX(ctx)
+	client.Certificate.Create().SetCommonName("www.example.com").SetIssuedBy("jane.doe@hm.edu").
+		SetNotAfter(time.Now().Add(6 * 24 * time.Hour)).SetStatus(certificate.StatusIssued).AddDomains(d).SaveX(ctx)
+
+	// The requester is reminded even if the domain service fails.
+	if err := n.Notify(zap.L()); err != nil {
+		t.Fatal(err)
+	}
+	payloads := recorder.take()
+	if len(payloads) != 1 || payloads[0]["recipients"].([]any)[0] != "jane.doe@hm.edu" {
+		t.Fatalf("expected a reminder for the requester only, got %v", payloads)
+	}
+
+	// The owner is reminded once the domain service is available again.
+	domains.err = nil
+	if err := n.Notify(zap.L()); err != nil {
+		t.Fatal(err)
+	}
+	payloads = recorder.take()
+	if len(payloads) != 1 || payloads[0]["recipients"].([]any)[0] != "max@hm.edu" {
+		t.Fatalf("expected a reminder for the owner only, got %v", payloads)
+	}
+}
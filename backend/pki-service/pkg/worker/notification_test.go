@@ -2,6 +2,7 @@ package worker
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -32,6 +33,10 @@ func TestLoadCertificates(t *testing.T) {
 	client.Certificate.Create().SetCommonName("test.example.com").SetNotAfter(time.Now().Add(29*24*time.Hour)).SetStatus(certificate.StatusIssued).AddDomains(d1, d2, d4).SaveX(context.Background())
 	client.Certificate.Create().SetCommonName("test2.example.com").SetNotAfter(time.Now().Add(22*24*time.Hour)).SetStatus(certificate.StatusIssued).AddDomains(d2, d3).SaveX(context.Background())
 	client.Certificate.Create().SetCommonName("test3.example.com").SetNotAfter(time.Now().Add(31 * 24 * time.Hour)).SetStatus(certificate.StatusIssued).AddDomains(d4).SaveX(context.Background())
+	// Revoked certificates are skipped and expired ones are not reminded.
+	d5 := client.Domain.Create().SetFqdn("test4.example.com").SaveX(context.Background())
+	client.Certificate.Create().SetCommonName("test4.example.com").SetNotAfter(time.Now().Add(40 * 24 * time.Hour)).SetStatus(certificate.StatusRevoked).AddDomains(d5).SaveX(context.Background())
+	client.Certificate.Create().SetCommonName("test4.example.com").SetNotAfter(time.Now().Add(-24 * time.Hour)).SetStatus(certificate.StatusIssued).AddDomains(d5).SaveX(context.Background())
 
 	certs, err := n.loadCertificates()
 	if err != nil {
@@ -44,3 +49,39 @@ func TestLoadCertificates(t *testing.T) {
 		t.Errorf("Expected 2 domains, got %d", len(certs[1].domains))
 	}
 }
+
+// BenchmarkLoadCertificates scans a fixture of 30000 domains whose
+// certificates expire throughout the year.
+func BenchmarkLoadCertificates(b *testing.B) {
+	client := enttest.Open(b, "sqlite3", "file:bench?mode=memory&cache=shared&_fk=1")
+	defer func(*ent.Client) {
+		_ = client.Close()
+	}(client)
+	ctx := context.Background()
+	const domains = 30000
+	const batch = 1000
+	for i := 0; i < domains; i += batch {
+		builders := make([]*ent.DomainCreate, 0, batch)
+		for j := i; j < i+batch; j++ {
+			builders = append(builders, client.Domain.Create().SetFqdn(fmt.Sprintf("host%d.example.com", j)))
+		}
+		created := client.Domain.CreateBulk(builders...).SaveX(ctx)
+		certs := make([]*ent.CertificateCreate, 0, 2*batch)
+		for j, d := range created {
+			n := i + j
+			notAfter := time.Now().Add(time.Duration(n%365) * 24 * time.Hour)
+			// Each domain has a renewed certificate and an older one.
+			certs = append(certs,
+				client.Certificate.Create().SetCommonName(d.Fqdn).SetNotAfter(notAfter).SetStatus(certificate.StatusIssued).AddDomains(d),
+				client.Certificate.Create().SetCommonName(d.Fqdn).SetNotAfter(notAfter.Add(-90*24*time.Hour)).SetStatus(certificate.StatusIssued).AddDomains(d))
+		}
+		client.Certificate.CreateBulk(certs...).ExecX(ctx)
+	}
+	n := Notifier{Db: client}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := n.loadCertificates(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hm-edu/pki-service/ent"
+	"github.com/hm-edu/pki-service/ent/smimecertificate"
+	pkiHelper "github.com/hm-edu/pki-service/pkg/helper"
+	"github.com/hm-edu/pki-service/pkg/publisher"
+	"go.uber.org/zap"
+)
+
+// ResyncReport summarizes a resync of the directory.
+type ResyncReport struct {
+	Published int
+	Withdrawn int
+	// Skipped counts certificates without stored certificate or directory
+	// entry.
+	Skipped int
+	Failed  int
+}
+
+// Resync publishes all valid S/MIME certificates to the directory and
+// withdraws all revoked and expired ones. Certificates unknown to the
+// database are not touched.
+func Resync(ctx context.Context, logger *zap.Logger, db *ent.Client, pub publisher.Publisher) (ResyncReport, error) {
+	var report ResyncReport
+	certs, err := db.SmimeCertificate.Query().Order(ent.Asc(smimecertificate.FieldID)).All(ctx)
+	if err != nil {
+		return report, err
+	}
+	now := time.Now()
+	for _, cert := range certs {
+		logger := logger.With(zap.String("email", cert.Email), zap.String("serial", cert.Serial))
+		if cert.Certificate == nil {
+			report.Skipped++
+			continue
+		}
+		parsed, err := pkiHelper.ParseCertificates([]byte(*cert.Certificate))
+		if err != nil || len(parsed) == 0 {
+			logger.Warn("Error parsing stored certificate", zap.Error(err))
+			report.Failed++
+			continue
+		}
+		if cert.Status == smimecertificate.StatusIssued && cert.NotAfter.After(now) {
+			err = pub.Publish(cert.Email, parsed[0])
+			if errors.Is(err, publisher.ErrEntryNotFound) {
+				logger.Debug("No directory entry found")
+				report.Skipped++
+				continue
+			}
+			if err != nil {
+				logger.Error("Error publishing certificate", zap.Error(err))
+				report.Failed++
+				continue
+			}
+			report.Published++
+			continue
+		}
+		if err := pub.Withdraw(cert.Email, parsed[0]); err != nil {
+			logger.Error("Error withdrawing certificate", zap.Error(err))
+			report.Failed++
+			continue
+		}
+		report.Withdrawn++
+	}
+	logger.Info("Directory resynced",
+		zap.Int("published", report.Published),
+		zap.Int("withdrawn", report.Withdrawn),
+		zap.Int("skipped", report.Skipped),
+		zap.Int("failed", report.Failed))
+	if report.Failed > 0 {
+		return report, fmt.Errorf("%d certificates could not be synced", report.Failed)
+	}
+	return report, nil
+}
+
+// withdrawExpired removes the certificates that are about to be marked as
+// expired from the directory. It returns the IDs of the certificates whose
+// withdrawal failed; they must stay issued so the withdrawal is retried on
+// the next run.
+func withdrawExpired(ctx context.Context, logger *zap.Logger, db *ent.Client, pub publisher.Publisher) ([]int, error) {
+	certs, err := db.SmimeCertificate.Query().
+		Where(
+			smimecertificate.StatusEQ(smimecertificate.StatusIssued),
+			smimecertificate.NotAfterLT(time.Now()),
+			smimecertificate.CertificateNotNil(),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var failed []int
+	for _, cert := range certs {
+		parsed, err := pkiHelper.ParseCertificates([]byte(*cert.Certificate))
+		if err != nil || len(parsed) == 0 {
+			logger.Warn("Error parsing stored certificate", zap.String("serial", cert.Serial), zap.Error(err))
+			continue
+		}
+		if err := pub.Withdraw(cert.Email, parsed[0]); err != nil {
+			logger.Error("Error withdrawing expired certificate", zap.String("serial", cert.Serial), zap.Error(err))
+			failed = append(failed, cert.ID)
+		}
+	}
+	return failed, nil
+}
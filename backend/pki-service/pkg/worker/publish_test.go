@@ -0,0 +1,132 @@
+package worker
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/hm-edu/pki-service/ent"
+	"github.com/hm-edu/pki-service/ent/enttest"
+	"github.com/hm-edu/pki-service/ent/smimecertificate"
+	"github.com/hm-edu/pki-service/pkg/publisher"
+	"go.uber.org/zap"
+)
+
+type fakePublisher struct {
+	entries   map[string]bool
+	published []string
+	withdrawn []string
+	// fail lets withdrawals fail.
+	fail bool
+}
+
+func (p *fakePublisher) Publish(email string, cert *x509.Certificate) error {
+	if !p.entries[email] {
+		return publisher.ErrEntryNotFound
+	}
+	p.published = append(p.published, cert.SerialNumber.String())
+	return nil
+}
+
+func (p *fakePublisher) Withdraw(_ string, cert *x509.Certificate) error {
+	if p.fail {
+		return errors.New("directory unavailable")
+	}
+	p.withdrawn = append(p.withdrawn, cert.SerialNumber.String())
+	return nil
+}
+
+func testPEM(t *testing.T, serial int64, notAfter time.Time) string {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: "test"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func TestResync(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:resync?mode=memory&cache=shared&_fk=1")
+	defer func(*ent.Client) {
+		_ = client.Close()
+	}(client)
+	ctx := context.Background()
+	valid := time.Now().Add(24 * time.Hour)
+	expired := time.Now().Add(-time.Hour)
+
+	client.SmimeCertificate.Create().SetSerial("1").SetEmail("jane.doe@hm.edu").SetNotAfter(valid).SetStatus(smimecertificate.StatusIssued).SetCertificate(testPEM(t, 1, valid)).SaveX(ctx)
+	client.SmimeCertificate.Create().SetSerial("2").SetEmail("jane.doe@hm.edu").SetNotAfter(valid).SetStatus(smimecertificate.StatusRevoked).SetCertificate(testPEM(t, 2, valid)).SaveX(ctx)
+	client.SmimeCertificate.Create().SetSerial("3").SetEmail("jane.doe@hm.edu").SetNotAfter(expired).SetStatus(smimecertificate.StatusIssued).SetCertificate(testPEM(t, 3, expired)).SaveX(ctx)
+	// Certificates without directory entry or stored certificate are skipped.
+	client.SmimeCertificate.Create().SetSerial("4").SetEmail("team@hm.edu").SetNotAfter(valid).SetStatus(smimecertificate.StatusIssued).SetCertificate(testPEM(t, 4, valid)).SaveX(ctx)
+	client.SmimeCertificate.Create().SetSerial("5").SetEmail("jane.doe@hm.edu").SetNotAfter(valid).SetStatus(smimecertificate.StatusIssued).SaveX(ctx)
+
+	pub := &fakePublisher{entries: map[string]bool{"jane.doe@hm.edu": true}}
+	report, err := Resync(ctx, zap.L(), client, pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Published != 1 || report.Withdrawn != 2 || report.Skipped != 2 || report.Failed != 0 {
+		t.Errorf("unexpected report %+v", report)
+	}
+	if len(pub.published) != 1 || pub.published[0] != "1" {
+		t.Errorf("unexpected published certificates %v", pub.published)
+	}
+	if len(pub.withdrawn) != 2 || pub.withdrawn[0] != "2" || pub.withdrawn[1] != "3" {
+		t.Errorf("unexpected withdrawn certificates %v", pub.withdrawn)
+	}
+}
+
+func TestCleanupWithdraws(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:cleanupwithdraw?mode=memory&cache=shared&_fk=1")
+	defer func(*ent.Client) {
+		_ = client.Close()
+	}(client)
+	ctx := context.Background()
+	valid := time.Now().Add(24 * time.Hour)
+	expired := time.Now().Add(-time.Hour)
+
+	client.SmimeCertificate.Create().SetSerial("1").SetEmail("jane.doe@hm.edu").SetNotAfter(expired).SetStatus(smimecertificate.StatusIssued).SetCertificate(testPEM(t, 1, expired)).SaveX(ctx)
+	client.SmimeCertificate.Create().SetSerial("2").SetEmail("jane.doe@hm.edu").SetNotAfter(valid).SetStatus(smimecertificate.StatusIssued).SetCertificate(testPEM(t, 2, valid)).SaveX(ctx)
+
+	// Certificates that could not be withdrawn stay issued and are retried.
+	pub := &fakePublisher{fail: true}
+	if err := Cleanup(zap.L(), client, pub); err == nil {
+		t.Error("expected the failed withdrawal to be reported")
+	}
+	if client.SmimeCertificate.Query().Where(smimecertificate.StatusEQ(smimecertificate.StatusExpired)).ExistX(ctx) {
+		t.Error("expected the certificate to stay issued")
+	}
+	pub.fail = false
+	if err := Cleanup(zap.L(), client, pub); err != nil {
+		t.Fatal(err)
+	}
+	if len(pub.withdrawn) != 1 || pub.withdrawn[0] != "1" {
+		t.Errorf("unexpected withdrawn certificates %v", pub.withdrawn)
+	}
+	// Already expired certificates are not withdrawn again.
+	if err := Cleanup(zap.L(), client, pub); err != nil {
+		t.Fatal(err)
+	}
+	if len(pub.withdrawn) != 1 {
+		t.Errorf("unexpected withdrawn certificates %v", pub.withdrawn)
+	}
+}
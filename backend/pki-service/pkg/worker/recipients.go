@@ -0,0 +1,67 @@
+package worker
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hm-edu/pki-service/ent"
+	pb "github.com/hm-edu/portal-apis"
+)
+
+// domainContacts returns the owner, the delegates and the technical contacts
+// responsible for the domains of the certificates, indexed by the lowercased
+// domain name. Domains without responsible owner are omitted. It returns nil
+// if the domain service is not configured.
+func (w *Notifier) domainContacts(ctx context.Context, certificates map[int]certificateItem) (map[string][]string, error) {
+	if w.Domains == nil {
+		return nil, nil
+	}
+	contacts := make(map[string][]string)
+	if len(certificates) == 0 {
+		return contacts, nil
+	}
+	seen := make(map[string]bool)
+	var names []string
+	for _, item := range certificates {
+		for _, d := range item.cert.Edges.Domains {
+			if name := strings.ToLower(d.Fqdn); !seen[name] {
+				seen[name] = true
+				names = append(names, name)
+			}
+		}
+	}
+	resp, err := w.Domains.ListDomainContacts(ctx, &pb.ListDomainContactsRequest{Domains: names})
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range resp.Contacts {
+		if c.Owner == "" {
+			continue
+		}
+		recipients := append([]string{c.Owner}, c.Delegates...)
+		contacts[strings.ToLower(c.Domain)] = append(recipients, c.Contacts...)
+	}
+	return contacts, nil
+}
+
+// certificateRecipients returns the recipients of the reminders for a SSL
+// certificate: the requester and everyone responsible for one of its
+// domains. The fallback recipient is added if no domain has a responsible
+// owner; it is not added if the contacts could not be resolved (nil).
+func (w *Notifier) certificateRecipients(cert *ent.Certificate, contacts map[string][]string) []string {
+	var recipients []string
+	if cert.IssuedBy != nil {
+		recipients = append(recipients, strings.Split(*cert.IssuedBy, " ")[0])
+	}
+	resolved := false
+	for _, d := range cert.Edges.Domains {
+		if c, ok := contacts[strings.ToLower(d.Fqdn)]; ok {
+			resolved = true
+			recipients = append(recipients, c...)
+		}
+	}
+	if !resolved && contacts != nil && w.FallbackRecipient != "" {
+		recipients = append(recipients, w.FallbackRecipient)
+	}
+	return recipients
+}
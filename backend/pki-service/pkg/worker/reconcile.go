@@ -0,0 +1,340 @@
+package worker
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	harica "github.com/hm-edu/harica/client"
+	"github.com/hm-edu/harica/models"
+	"github.com/hm-edu/pki-service/ent"
+	"github.com/hm-edu/pki-service/ent/certificate"
+	"github.com/hm-edu/pki-service/ent/smimecertificate"
+	pkiHelper "github.com/hm-edu/pki-service/pkg/helper"
+	"go.uber.org/zap"
+)
+
+// TransactionSource provides the HARICA transactions of the organization. It
+// is implemented by the HARICA client of the validation account. HARICA
+// offers no listing of all transactions, so the locally known transactions
+// are looked up one by one and unknown transactions are only discovered while
+// they are pending review.
+type TransactionSource interface {
+	SessionRefresh(force bool) error
+	GetPendingReviews() ([]models.ReviewResponse, error)
+	GetCertificate(id string) (*models.CertificateResponse, error)
+}
+
+// Drift issues reported by the reconciliation.
+const (
+	DriftStatus             = "status"
+	DriftSerial             = "serial"
+	DriftUnknownTransaction = "unknown_transaction"
+	DriftMissingRemote      = "missing_remote"
+)
+
+// DriftEntry describes a single difference between HARICA and the local
+// database.
+type DriftEntry struct {
+	// Kind is either "ssl" or "smime".
+	Kind          string `json:"kind"`
+	TransactionID string `json:"transaction_id"`
+	Issue         string `json:"issue"`
+	Local         string `json:"local,omitempty"`
+	Remote        string `json:"remote,omitempty"`
+	// Fixed reports whether the local database was updated.
+	Fixed bool   `json:"fixed"`
+	Error string `json:"error,omitempty"`
+}
+
+// DriftReport is the result of a single reconciliation run.
+type DriftReport struct {
+	Started      time.Time    `json:"started"`
+	Finished     time.Time    `json:"finished"`
+	DryRun       bool         `json:"dry_run"`
+	Transactions int          `json:"transactions"`
+	Entries      []DriftEntry `json:"entries"`
+}
+
+// Reconciler compares the HARICA transactions with the local certificates,
+// fixes statuses and serials of issued certificates and reports transactions
+// that are unknown on either side.
+type Reconciler struct {
+	Db     *ent.Client
+	Harica TransactionSource
+	// DryRun only reports the drift without changing the database.
+	DryRun bool
+	// ReportDir is the directory the drift reports are written to. No report
+	// file is written if empty.
+	ReportDir string
+}
+
+// Reconcile runs a single reconciliation and writes the drift report.
+func (r *Reconciler) Reconcile(ctx context.Context, logger *zap.Logger) (*DriftReport, error) {
+	report := &DriftReport{Started: time.Now(), DryRun: r.DryRun, Entries: []DriftEntry{}}
+	if err := r.Harica.SessionRefresh(false); err != nil {
+		return nil, err
+	}
+	reviews, err := r.Harica.GetPendingReviews()
+	if err != nil {
+		return nil, err
+	}
+	pending := make(map[string]bool, len(reviews))
+	for _, review := range reviews {
+		pending[review.TransactionID] = true
+	}
+
+	certs, err := r.Db.Certificate.Query().
+		Where(certificate.Ca("harica"), certificate.TransactionIdNEQ(""), certificate.StatusNotIn(finalStatuses...)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	smimes, err := r.Db.SmimeCertificate.Query().
+		Where(smimecertificate.Ca("harica"), smimecertificate.TransactionIdNEQ(""), smimecertificate.StatusNotIn(finalSmimeStatuses...)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	logger.Info("Reconciling HARICA transactions",
+		zap.Int("certificates", len(certs)+len(smimes)),
+		zap.Int("pending_reviews", len(reviews)),
+		zap.Bool("dry_run", r.DryRun))
+
+	for _, c := range certs {
+		if pending[c.TransactionId] {
+			continue
+		}
+		entries, err := r.reconcileSsl(ctx, c)
+		if err != nil {
+			return nil, err
+		}
+		report.Entries = append(report.Entries, entries...)
+	}
+	for _, c := range smimes {
+		if pending[c.TransactionId] {
+			continue
+		}
+		entries, err := r.reconcileSmime(ctx, c)
+		if err != nil {
+			return nil, err
+		}
+		report.Entries = append(report.Entries, entries...)
+	}
+	unknown, err := r.unknownReviews(ctx, reviews)
+	if err != nil {
+		return nil, err
+	}
+	report.Entries = append(report.Entries, unknown...)
+	report.Transactions = len(certs) + len(smimes) + len(unknown)
+	report.Finished = time.Now()
+
+	for _, e := range report.Entries {
+		logger.Info("Drift detected",
+			zap.String("kind", e.Kind),
+			zap.String("transaction_id", e.TransactionID),
+			zap.String("issue", e.Issue),
+			zap.String("local", e.Local),
+			zap.String("remote", e.Remote),
+			zap.Bool("fixed", e.Fixed))
+	}
+	logger.Info("Reconciliation finished", zap.Int("drift", len(report.Entries)))
+
+	if r.ReportDir != "" {
+		path := filepath.Join(r.ReportDir, fmt.Sprintf("reconcile-%s.json", report.Started.UTC().Format("20060102T150405Z")))
+		if err := WriteReport(report, path); err != nil {
+			return report, err
+		}
+		logger.Info("Drift report written", zap.String("path", path))
+	}
+	return report, nil
+}
+
+// WriteReport writes the drift report as JSON to the given path. "-" writes
+// the report to stdout.
+func WriteReport(report *DriftReport, path string) error {
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+	if path == "-" {
+		_, err = fmt.Println(string(data))
+		return err
+	}
+	return os.WriteFile(path, data, 0o600)
+}
+
+// finalStatuses are the statuses that are not reconciled. HARICA does not
+// report revocations through the certificate endpoint, so revoked and
+// rejected certificates cannot drift back.
+var (
+	finalStatuses = []certificate.Status{
+		certificate.StatusRevoked, certificate.StatusRejected, certificate.StatusDeclined,
+		certificate.StatusReplaced, certificate.StatusExpired,
+	}
+	finalSmimeStatuses = []smimecertificate.Status{
+		smimecertificate.StatusRevoked, smimecertificate.StatusRejected, smimecertificate.StatusDeclined,
+		smimecertificate.StatusReplaced, smimecertificate.StatusExpired,
+	}
+)
+
+// remoteCertificate fetches the certificate of a transaction. A nil
+// certificate is returned as long as HARICA has not issued it yet; missing
+// reports whether HARICA rejected the lookup with a client error, i.e. the
+// transaction is not (or no longer) known.
+func (r *Reconciler) remoteCertificate(transactionID string) (leaf *x509.Certificate, missing bool, err error) {
+	cert, err := r.Harica.GetCertificate(transactionID)
+	if err != nil {
+		var codeErr *harica.UnexpectedResponseCodeError
+		if errors.As(err, &codeErr) && codeErr.Code >= http.StatusBadRequest && codeErr.Code < http.StatusInternalServerError &&
+			codeErr.Code != http.StatusUnauthorized && codeErr.Code != http.StatusForbidden {
+			return nil, true, nil
+		}
+		return nil, false, err
+	}
+	if cert == nil || cert.PemBundle == "" {
+		return nil, false, nil
+	}
+	certs, err := pkiHelper.ParseCertificates([]byte(cert.PemBundle))
+	if err != nil || len(certs) == 0 {
+		return nil, false, fmt.Errorf("certificate of transaction %s cannot be parsed: %w", transactionID, err)
+	}
+	return certs[0], false, nil
+}
+
+// remoteStatus derives the expected local status from an issued certificate.
+func remoteStatus(leaf *x509.Certificate, now time.Time) string {
+	if leaf.NotAfter.Before(now) {
+		return "Expired"
+	}
+	return "Issued"
+}
+
+// isIssued reports whether the local status claims an issued certificate.
+func isIssued(status string) bool {
+	return status == "Issued" || status == "Unmanaged"
+}
+
+// keepStatus reports whether the local status must not be changed. Unmanaged
+// certificates stay unmanaged as long as they are valid.
+func keepStatus(local, remote string) bool {
+	return local == remote || (local == "Unmanaged" && remote == "Issued")
+}
+
+// applyFix executes the update for the given drift entries unless this is a
+// dry run and records the result in the entries.
+func (r *Reconciler) applyFix(entries []DriftEntry, exec func() error) {
+	if len(entries) == 0 || r.DryRun {
+		return
+	}
+	fixed := true
+	errMsg := ""
+	if err := exec(); err != nil {
+		fixed = false
+		errMsg = err.Error()
+	}
+	for i := range entries {
+		entries[i].Fixed = fixed
+		entries[i].Error = errMsg
+	}
+}
+
+func (r *Reconciler) reconcileSsl(ctx context.Context, local *ent.Certificate) ([]DriftEntry, error) {
+	leaf, missing, err := r.remoteCertificate(local.TransactionId)
+	if err != nil {
+		return nil, err
+	}
+	if missing {
+		if !isIssued(string(local.Status)) {
+			return nil, nil
+		}
+		return []DriftEntry{{Kind: "ssl", TransactionID: local.TransactionId, Issue: DriftMissingRemote, Local: string(local.Status)}}, nil
+	}
+	if leaf == nil {
+		return nil, nil
+	}
+
+	var entries []DriftEntry
+	update := r.Db.Certificate.UpdateOneID(local.ID)
+	if status := remoteStatus(leaf, time.Now()); !keepStatus(string(local.Status), status) {
+		entries = append(entries, DriftEntry{Kind: "ssl", TransactionID: local.TransactionId, Issue: DriftStatus, Local: string(local.Status), Remote: status})
+		update.SetStatus(certificate.Status(status))
+	}
+	serial := pkiHelper.NormalizeSerial(fmt.Sprintf("%032x", leaf.SerialNumber))
+	if local.Serial != serial {
+		entries = append(entries, DriftEntry{Kind: "ssl", TransactionID: local.TransactionId, Issue: DriftSerial, Local: local.Serial, Remote: serial})
+		update.SetSerial(serial).
+			SetNotBefore(leaf.NotBefore).
+			SetNotAfter(leaf.NotAfter)
+		if local.Created == nil {
+			update.SetCreated(leaf.NotBefore)
+		}
+	}
+	r.applyFix(entries, func() error { return update.Exec(ctx) })
+	return entries, nil
+}
+
+func (r *Reconciler) reconcileSmime(ctx context.Context, local *ent.SmimeCertificate) ([]DriftEntry, error) {
+	leaf, missing, err := r.remoteCertificate(local.TransactionId)
+	if err != nil {
+		return nil, err
+	}
+	if missing {
+		if !isIssued(string(local.Status)) {
+			return nil, nil
+		}
+		return []DriftEntry{{Kind: "smime", TransactionID: local.TransactionId, Issue: DriftMissingRemote, Local: string(local.Status)}}, nil
+	}
+	if leaf == nil {
+		return nil, nil
+	}
+
+	var entries []DriftEntry
+	update := r.Db.SmimeCertificate.UpdateOneID(local.ID)
+	if status := remoteStatus(leaf, time.Now()); !keepStatus(string(local.Status), status) {
+		entries = append(entries, DriftEntry{Kind: "smime", TransactionID: local.TransactionId, Issue: DriftStatus, Local: string(local.Status), Remote: status})
+		update.SetStatus(smimecertificate.Status(status))
+	}
+	if local.Serial != leaf.SerialNumber.String() {
+		entries = append(entries, DriftEntry{Kind: "smime", TransactionID: local.TransactionId, Issue: DriftSerial, Local: local.Serial, Remote: leaf.SerialNumber.String()})
+		update.SetSerial(leaf.SerialNumber.String()).
+			SetNotBefore(leaf.NotBefore).
+			SetNotAfter(leaf.NotAfter)
+	}
+	r.applyFix(entries, func() error { return update.Exec(ctx) })
+	return entries, nil
+}
+
+// unknownReviews reports pending HARICA reviews whose transaction is not
+// known locally, e.g. requests created manually in the HARICA UI. They cannot
+// be imported since they carry no certificate yet.
+func (r *Reconciler) unknownReviews(ctx context.Context, reviews []models.ReviewResponse) ([]DriftEntry, error) {
+	var entries []DriftEntry
+	seen := map[string]bool{}
+	for _, review := range reviews {
+		if seen[review.TransactionID] {
+			continue
+		}
+		seen[review.TransactionID] = true
+		known, err := r.Db.Certificate.Query().Where(certificate.TransactionId(review.TransactionID)).Exist(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !known {
+			known, err = r.Db.SmimeCertificate.Query().Where(smimecertificate.TransactionId(review.TransactionID)).Exist(ctx)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if !known {
+			entries = append(entries, DriftEntry{Kind: "ssl", TransactionID: review.TransactionID, Issue: DriftUnknownTransaction, Remote: "Pending"})
+		}
+	}
+	return entries, nil
+}
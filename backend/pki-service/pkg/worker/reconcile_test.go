@@ -0,0 +1,137 @@
+package worker
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	harica "github.com/hm-edu/harica/client"
+	"github.com/hm-edu/harica/models"
+	"github.com/hm-edu/pki-service/ent"
+	"github.com/hm-edu/pki-service/ent/certificate"
+	"github.com/hm-edu/pki-service/ent/enttest"
+	"github.com/hm-edu/pki-service/ent/smimecertificate"
+	"go.uber.org/zap"
+)
+
+// fakeTransactions maps transaction ids to the PEM encoded certificates. An
+// empty certificate marks a transaction that is not issued yet.
+type fakeTransactions struct {
+	certs   map[string]string
+	reviews []models.ReviewResponse
+}
+
+func (f fakeTransactions) SessionRefresh(bool) error { return nil }
+
+func (f fakeTransactions) GetPendingReviews() ([]models.ReviewResponse, error) { return f.reviews, nil }
+
+func (f fakeTransactions) GetCertificate(id string) (*models.CertificateResponse, error) {
+	cert, ok := f.certs[id]
+	if !ok {
+		return nil, &harica.UnexpectedResponseCodeError{Code: http.StatusNotFound}
+	}
+	return &models.CertificateResponse{PemBundle: cert}, nil
+}
+
+func selfSigned(t *testing.T, serial int64, tmpl x509.Certificate) string {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl.SerialNumber = big.NewInt(serial)
+	tmpl.NotBefore = time.Now().Add(-time.Hour)
+	tmpl.NotAfter = time.Now().Add(24 * time.Hour)
+	der, err := x509.CreateCertificate(rand.Reader, &tmpl, &tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func TestReconcile(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:reconcile?mode=memory&cache=shared&_fk=1")
+	defer func(*ent.Client) {
+		_ = client.Close()
+	}(client)
+	ctx := context.Background()
+
+	server := x509.Certificate{Subject: pkix.Name{CommonName: "www.hm.edu"}, DNSNames: []string{"www.hm.edu"}, ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}}
+	mail := x509.Certificate{Subject: pkix.Name{CommonName: "Jane Doe"}, EmailAddresses: []string{"jane.doe@hm.edu"}, ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageEmailProtection}}
+
+	d := client.Domain.Create().SetFqdn("www.hm.edu").SaveX(ctx)
+	ssl := client.Certificate.Create().SetCommonName("www.hm.edu").SetTransactionId("tx-ssl").SetCa("harica").SetSerial("01").SetStatus(certificate.StatusIssued).AddDomains(d).SaveX(ctx)
+	collect := client.Certificate.Create().SetCommonName("www.hm.edu").SetTransactionId("tx-collect").SetCa("harica").SetStatus(certificate.StatusApproved).AddDomains(d).SaveX(ctx)
+	smime := client.SmimeCertificate.Create().SetEmail("jane.doe@hm.edu").SetTransactionId("tx-smime").SetCa("harica").SetSerial("2").SetNotAfter(time.Now().Add(time.Hour)).SetNotBefore(time.Now()).SetStatus(smimecertificate.StatusIssued).SaveX(ctx)
+	client.Certificate.Create().SetCommonName("gone.hm.edu").SetTransactionId("tx-gone").SetCa("harica").SetStatus(certificate.StatusIssued).SaveX(ctx)
+	client.Certificate.Create().SetCommonName("review.hm.edu").SetTransactionId("tx-review").SetCa("harica").SetStatus(certificate.StatusRequested).SaveX(ctx)
+	// Revoked certificates are not looked up (the fake reports them missing).
+	client.Certificate.Create().SetCommonName("old.hm.edu").SetTransactionId("tx-revoked").SetCa("harica").SetStatus(certificate.StatusRevoked).SaveX(ctx)
+
+	transactions := fakeTransactions{
+		certs: map[string]string{
+			"tx-ssl":     selfSigned(t, 0x1234, server),
+			"tx-collect": selfSigned(t, 0x5678, server),
+			"tx-smime":   selfSigned(t, 2, mail),
+			"tx-review":  "",
+		},
+		reviews: []models.ReviewResponse{{TransactionID: "tx-review"}, {TransactionID: "tx-new"}},
+	}
+
+	// A dry run only reports the drift.
+	r := Reconciler{Db: client, Harica: transactions, DryRun: true}
+	report, err := r.Reconcile(ctx, zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+	issues := map[string]int{}
+	for _, e := range report.Entries {
+		issues[e.Issue]++
+		if e.Fixed {
+			t.Errorf("expected no fixes during a dry run, got %+v", e)
+		}
+	}
+	// Serial of tx-ssl, status and serial of tx-collect, tx-new unknown,
+	// tx-gone missing.
+	if issues[DriftStatus] != 1 || issues[DriftSerial] != 2 || issues[DriftUnknownTransaction] != 1 || issues[DriftMissingRemote] != 1 {
+		t.Errorf("unexpected drift %v", issues)
+	}
+	if c := client.Certificate.GetX(ctx, collect.ID); c.Status != certificate.StatusApproved {
+		t.Errorf("expected dry run to keep the status, got %v", c.Status)
+	}
+
+	r.DryRun = false
+	if _, err := r.Reconcile(ctx, zap.NewNop()); err != nil {
+		t.Fatal(err)
+	}
+	if c := client.Certificate.GetX(ctx, ssl.ID); c.Status != certificate.StatusIssued || c.Serial != "00000000000000000000000000001234" {
+		t.Errorf("unexpected certificate %+v", c)
+	}
+	if c := client.Certificate.GetX(ctx, collect.ID); c.Status != certificate.StatusIssued || c.Serial != "00000000000000000000000000005678" || c.Created == nil {
+		t.Errorf("expected collected certificate to be issued, got %+v", c)
+	}
+	if s := client.SmimeCertificate.GetX(ctx, smime.ID); s.Status != smimecertificate.StatusIssued {
+		t.Errorf("expected S/MIME status to be unchanged, got %v", s.Status)
+	}
+
+	// A second run only reports the drift that cannot be fixed.
+	report, err = r.Reconcile(ctx, zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+	issues = map[string]int{}
+	for _, e := range report.Entries {
+		issues[e.Issue]++
+	}
+	if len(report.Entries) != 2 || issues[DriftUnknownTransaction] != 1 || issues[DriftMissingRemote] != 1 {
+		t.Errorf("expected only the unknown and missing transactions, got %+v", report.Entries)
+	}
+}
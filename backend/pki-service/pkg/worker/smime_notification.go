@@ -0,0 +1,75 @@
+package worker
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hm-edu/pki-service/ent"
+	"github.com/hm-edu/pki-service/ent/smimecertificate"
+)
+
+// DefaultSmimeIntervals are the days before the expiry of a S/MIME
+// certificate on which reminders are sent by default.
+var DefaultSmimeIntervals = []int{30, 14, 7, 1}
+
+type smimeItem struct {
+	cert       *ent.SmimeCertificate
+	recipients []string
+	// thresholds are the thresholds reached by the certificate.
+	thresholds []int
+}
+
+func (w *Notifier) smimeIntervals() []int {
+	if len(w.SmimeIntervals) == 0 {
+		return DefaultSmimeIntervals
+	}
+	return w.SmimeIntervals
+}
+
+// loadSmimeCertificates returns the S/MIME certificates that reached a
+// threshold. Certificates are skipped if a newer valid certificate for
+// the same address exists.
+func (w *Notifier) loadSmimeCertificates() ([]smimeItem, error) {
+	ctx := context.Background()
+	intervals := w.smimeIntervals()
+	now := time.Now()
+	certs, err := w.Db.SmimeCertificate.Query().
+		Where(
+			smimecertificate.StatusEQ(smimecertificate.StatusIssued),
+			smimecertificate.NotAfterGT(now),
+			smimecertificate.NotAfterLT(now.AddDate(0, 0, slices.Max(intervals))),
+		).
+		Order(ent.Asc(smimecertificate.FieldNotAfter)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var items []smimeItem
+	for _, cert := range certs {
+		thresholds := reachedThresholds(intervals, cert.NotAfter)
+		if len(thresholds) == 0 {
+			continue
+		}
+		renewed, err := w.Db.SmimeCertificate.Query().
+			Where(
+				smimecertificate.EmailEqualFold(cert.Email),
+				smimecertificate.StatusEQ(smimecertificate.StatusIssued),
+				smimecertificate.NotAfterGT(cert.NotAfter),
+			).
+			Exist(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if renewed {
+			continue
+		}
+		recipients := []string{cert.Email}
+		if cert.Owner != nil && *cert.Owner != "" && !strings.EqualFold(*cert.Owner, cert.Email) {
+			recipients = append(recipients, *cert.Owner)
+		}
+		items = append(items, smimeItem{cert: cert, recipients: recipients, thresholds: thresholds})
+	}
+	return items, nil
+}
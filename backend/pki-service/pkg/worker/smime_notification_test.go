@@ -0,0 +1,73 @@
+package worker
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/hm-edu/pki-service/ent"
+	"github.com/hm-edu/pki-service/ent/enttest"
+	"github.com/hm-edu/pki-service/ent/smimecertificate"
+	"go.uber.org/zap"
+)
+
+func TestLoadSmimeCertificates(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:smime?mode=memory&cache=shared&_fk=1")
+	defer func(*ent.Client) {
+		_ = client.Close()
+	}(client)
+	ctx := context.Background()
+	n := Notifier{Db: client, SmimeIntervals: []int{14, 7}}
+	in := func(days int) time.Time { return time.Now().Add(time.Duration(days)*24*time.Hour - time.Hour) }
+
+	client.SmimeCertificate.Create().SetSerial("1").SetEmail("jane.doe@hm.edu").SetOwner("jane.doe@hm.edu").SetNotAfter(in(7)).SetStatus(smimecertificate.StatusIssued).SaveX(ctx)
+	client.SmimeCertificate.Create().SetSerial("2").SetEmail("team@hm.edu").SetOwner("john.doe@hm.edu").SetNotAfter(in(14)).SetStatus(smimecertificate.StatusIssued).SaveX(ctx)
+	client.SmimeCertificate.Create().SetSerial("3").SetEmail("max@hm.edu").SetNotAfter(in(10)).SetStatus(smimecertificate.StatusIssued).SaveX(ctx)
+	client.SmimeCertificate.Create().SetSerial("4").SetEmail("erika@hm.edu").SetNotAfter(in(7)).SetStatus(smimecertificate.StatusRevoked).SaveX(ctx)
+	// Renewed certificates are not reminded.
+	client.SmimeCertificate.Create().SetSerial("5").SetEmail("anna@hm.edu").SetNotAfter(in(7)).SetStatus(smimecertificate.StatusIssued).SaveX(ctx)
+	client.SmimeCertificate.Create().SetSerial("6").SetEmail("Anna@hm.edu").SetNotAfter(in(365)).SetStatus(smimecertificate.StatusIssued).SaveX(ctx)
+
+	items, err := n.loadSmimeCertificates()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 3 {
+		t.Fatalf("Expected 3 certificates, got %d", len(items))
+	}
+	if items[0].cert.Serial != "1" || len(items[0].recipients) != 1 || !slices.Equal(items[0].thresholds, []int{14, 7}) {
+		t.Errorf("unexpected item %v %v %v", items[0].cert.Serial, items[0].recipients, items[0].thresholds)
+	}
+	// The 14 days threshold of a certificate expiring in 10 days was missed.
+	if items[1].cert.Serial != "3" || !slices.Equal(items[1].thresholds, []int{14}) {
+		t.Errorf("unexpected item %v %v", items[1].cert.Serial, items[1].thresholds)
+	}
+	if items[2].cert.Serial != "2" || len(items[2].recipients) != 2 || items[2].recipients[1] != "john.doe@hm.edu" {
+		t.Errorf("unexpected item %v %v", items[2].cert.Serial, items[2].recipients)
+	}
+}
+
+func TestCleanupSmime(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:smimecleanup?mode=memory&cache=shared&_fk=1")
+	defer func(*ent.Client) {
+		_ = client.Close()
+	}(client)
+	ctx := context.Background()
+	expired := client.SmimeCertificate.Create().SetSerial("1").SetEmail("jane.doe@hm.edu").SetNotAfter(time.Now().Add(-time.Hour)).SetStatus(smimecertificate.StatusIssued).SaveX(ctx)
+	valid := client.SmimeCertificate.Create().SetSerial("2").SetEmail("jane.doe@hm.edu").SetNotAfter(time.Now().Add(time.Hour)).SetStatus(smimecertificate.StatusIssued).SaveX(ctx)
+	revoked := client.SmimeCertificate.Create().SetSerial("3").SetEmail("jane.doe@hm.edu").SetNotAfter(time.Now().Add(-time.Hour)).SetStatus(smimecertificate.StatusRevoked).SaveX(ctx)
+
+	if err := Cleanup(zap.L(), client, nil); err != nil {
+		t.Fatal(err)
+	}
+	for cert, want := range map[*ent.SmimeCertificate]smimecertificate.Status{
+		expired: smimecertificate.StatusExpired,
+		valid:   smimecertificate.StatusIssued,
+		revoked: smimecertificate.StatusRevoked,
+	} {
+		if got := client.SmimeCertificate.GetX(ctx, cert.ID).Status; got != want {
+			t.Errorf("certificate %s: expected %s, got %s", cert.Serial, want, got)
+		}
+	}
+}